@@ -48,6 +48,11 @@ var (
 		Value:   cli.NewUintSlice(benchmark.DefaultConcurrency),
 		Usage:   "The number of subscribers for each load target",
 	}
+	flagPipelineSize = &cli.UintSliceFlag{
+		Name:  "pipeline-size",
+		Value: cli.NewUintSlice(0),
+		Usage: "The number of batches that each appender sends without waiting for their results for each load target; zero means that appenders wait for each batch",
+	}
 	flagDuration = &cli.DurationFlag{
 		Name:  "duration",
 		Value: benchmark.DefaultDuration,
@@ -74,6 +79,7 @@ func newCommandTest() *cli.Command {
 			flagBatchSize,
 			flagAppenders,
 			flagSubscribers,
+			flagPipelineSize,
 			flagDuration,
 			flagReportInterval,
 			flagPrintJSON,
@@ -126,6 +132,9 @@ func runCommandTest(c *cli.Context) error {
 	targets = setSizes(targets, c.UintSlice(flagSubscribers.Name), func(idx int, size uint) {
 		targets[idx].SubscribersCount = size
 	})
+	targets = setSizes(targets, c.UintSlice(flagPipelineSize.Name), func(idx int, size uint) {
+		targets[idx].PipelineSize = size
+	})
 
 	duration := c.Duration(flagDuration.Name)
 
@@ -9,7 +9,9 @@ import (
 	"go.uber.org/zap/zapcore"
 
 	"github.com/kakao/varlog/internal/admin"
+	"github.com/kakao/varlog/internal/admin/consumergroup"
 	"github.com/kakao/varlog/internal/admin/mrmanager"
+	"github.com/kakao/varlog/internal/admin/retention"
 	"github.com/kakao/varlog/internal/admin/snmanager"
 	"github.com/kakao/varlog/internal/admin/snwatcher"
 	"github.com/kakao/varlog/pkg/types"
@@ -40,6 +42,7 @@ func newStartCommand() *cli.Command {
 			flagLogStreamGCTimeout.DurationFlag(false, admin.DefaultLogStreamGCTimeout),
 			flagDisableAutoLogStreamSync.BoolFlag(),
 			flagAutoUnseal.BoolFlag(),
+			flagConsumerGroupSessionTimeout.DurationFlag(false, consumergroup.DefaultSessionTimeout),
 
 			flagMetadataRepository.StringSliceFlag(true, nil),
 			flagInitMRConnRetryCount.IntFlag(false, mrmanager.DefaultInitialMRConnectRetryCount),
@@ -50,6 +53,8 @@ func newStartCommand() *cli.Command {
 			flagSNWatcherHeartbeatCheckDeadline.DurationFlag(false, snwatcher.DefaultHeartbeatDeadline),
 			flagSNWatcherReportDeadline.DurationFlag(false, snwatcher.DefaultReportDeadline),
 
+			flagRetentionCheckInterval.DurationFlag(false, retention.DefaultCheckInterval),
+
 			flagLogDir.StringFlag(false, ""),
 			flagLogToStderr.BoolFlag(),
 			flagLogFileRetentionDays.IntFlag(false, 0),
@@ -100,12 +105,16 @@ func start(c *cli.Context) error {
 		admin.WithListenAddress(c.String(flagListen.Name)),
 		admin.WithReplicationFactor(c.Uint(flagReplicationFactor.Name)),
 		admin.WithLogStreamGCTimeout(c.Duration(flagLogStreamGCTimeout.Name)),
+		admin.WithConsumerGroupSessionTimeout(c.Duration(flagConsumerGroupSessionTimeout.Name)),
 		admin.WithMetadataRepositoryManager(mrMgr),
 		admin.WithStorageNodeManager(snMgr),
 		admin.WithStorageNodeWatcherOptions(
 			snwatcher.WithHeartbeatCheckDeadline(c.Duration(flagSNWatcherHeartbeatCheckDeadline.Name)),
 			snwatcher.WithReportDeadline(c.Duration(flagSNWatcherReportDeadline.Name)),
 		),
+		admin.WithRetentionOptions(
+			retention.WithCheckInterval(c.Duration(flagRetentionCheckInterval.Name)),
+		),
 	}
 	if c.Bool(flagDisableAutoLogStreamSync.Name) {
 		opts = append(opts, admin.WithoutAutoLogStreamSync())
@@ -25,6 +25,11 @@ var (
 		Name: "disable-auto-log-stream-sync",
 		Envs: []string{"DISABLE_AUTO_LOG_STREAM_SYNC"},
 	}
+	flagConsumerGroupSessionTimeout = flags.FlagDesc{
+		Name:  "consumer-group-session-timeout",
+		Usage: "session timeout of members in consumer groups",
+		Envs:  []string{"CONSUMER_GROUP_SESSION_TIMEOUT"},
+	}
 	flagAutoUnseal = flags.FlagDesc{
 		Name:    "auto-unseal",
 		Aliases: []string{"enable-auto-unseal", "with-auto-unseal"},
@@ -61,6 +66,12 @@ var (
 		Envs: []string{"SN_WATCHER_REPORT_DEADLINE"},
 	}
 
+	flagRetentionCheckInterval = flags.FlagDesc{
+		Name:  "retention-check-interval",
+		Usage: "interval between checks of the retention policies of topics",
+		Envs:  []string{"RETENTION_CHECK_INTERVAL"},
+	}
+
 	flagLogDir = flags.FlagDesc{
 		Name:    "logdir",
 		Aliases: []string{"log-dir"},
@@ -10,6 +10,7 @@ import (
 	"github.com/kakao/varlog/internal/flags"
 	"github.com/kakao/varlog/internal/varlogcli"
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/varlog"
 )
 
 func main() {
@@ -44,6 +45,10 @@ const (
 
 var (
 	flagBatchSize = flags.FlagDesc{Name: "batch-size"}
+	flagFrom      = flags.FlagDesc{
+		Name:  "from",
+		Usage: "position to subscribe from: earliest, latest or now",
+	}
 )
 
 func newAppend() *cli.Command {
@@ -62,7 +67,10 @@ func newSubscribe() *cli.Command {
 	return &cli.Command{
 		Name:   cmdSubscribe,
 		Action: commandAction,
-		Flags:  commonFlags(),
+		Flags: append(
+			commonFlags(),
+			flagFrom.StringFlag(false, varlog.Earliest.String()),
+		),
 	}
 }
 
@@ -109,7 +117,11 @@ func commandAction(c *cli.Context) error {
 		if c.IsSet(flags.LogStreamID().Name) {
 			return varlogcli.SubscribeTo(mrAddrs, clusterID, topicID, logStreamID)
 		}
-		return varlogcli.Subscribe(mrAddrs, clusterID, topicID)
+		from, err := varlog.ParsePosition(c.String(flagFrom.Name))
+		if err != nil {
+			return err
+		}
+		return varlogcli.Subscribe(mrAddrs, clusterID, topicID, from)
 	}
 	return errors.Errorf("unexpected command: %s", c.Command.Name)
 }
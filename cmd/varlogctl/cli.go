@@ -32,6 +32,7 @@ func newVarlogControllerApp() *cli.App {
 			newTopicCommand(),
 			newLogStreamCommand(),
 			newMetadataRepositoryCommand(),
+			newClusterCommand(),
 		},
 	}
 	return app
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/kakao/varlog/internal/varlogctl"
+	"github.com/kakao/varlog/internal/varlogctl/cluster"
+)
+
+func newClusterCommand() *cli.Command {
+	const (
+		cmdBackup = "backup"
+	)
+	action := func(c *cli.Context) error {
+		if c.NArg() > 0 {
+			return fmt.Errorf("cluster command: unexpected args: %v", c.Args().Slice())
+		}
+
+		var f varlogctl.ExecuteFunc
+		switch c.Command.Name {
+		case cmdBackup:
+			f = cluster.Backup(c.String(flagBackupPath.name))
+		default:
+			return fmt.Errorf("cluster command: unknown command: %s", c.Command.Name)
+		}
+		return execute(c, f)
+	}
+	return &cli.Command{
+		Name: "cluster",
+		Subcommands: []*cli.Command{
+			{
+				Name:  cmdBackup,
+				Usage: "take a point-in-time backup of the cluster",
+				Description: "The admin server and all storage nodes write the backup into the same directory; " +
+					"thus, the path must be in a file system shared by them, for instance, NFS, and mounted at the same absolute path on each of them. " +
+					"The admin server checks that every storage node can access the path before taking the backup. " +
+					"If the cold tier is enabled, segments of the backup are copied into the cold tier under the prefixes in the manifest, " +
+					"which must be deleted together with the backup.",
+				Action: action,
+				Flags: commonFlags(
+					flagBackupPath.StringFlag(true, ""),
+				),
+			},
+		},
+	}
+}
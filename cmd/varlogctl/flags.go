@@ -39,6 +39,19 @@ var (
 		aliases: []string{"tpid"},
 	}
 
+	flagRetentionMaxAge = flagDesc{
+		name:  "max-age",
+		usage: "maximum age of log entries, zero means no limit",
+	}
+	flagRetentionMaxBytes = flagDesc{
+		name:  "max-bytes",
+		usage: "maximum size of each log stream in bytes, zero means no limit",
+	}
+	flagRetentionMaxEntries = flagDesc{
+		name:  "max-entries",
+		usage: "maximum number of log entries in each log stream, zero means no limit",
+	}
+
 	flagLogStreamID = flagDesc{
 		name:    "log-stream-id",
 		aliases: []string{"logstream-id", "lsid"},
@@ -49,4 +62,18 @@ var (
 	flagSyncDst = flagDesc{
 		name: "dst",
 	}
+
+	flagStorageNodePath = flagDesc{
+		name:  "storage-node-path",
+		usage: "storage node path to import into, the first one of the storage node if not set",
+	}
+	flagArchive = flagDesc{
+		name:  "archive",
+		usage: "path to the archive file",
+	}
+
+	flagBackupPath = flagDesc{
+		name:  "path",
+		usage: "directory to store the backup, which must not exist; it must be in a file system shared by the admin server and all storage nodes, mounted at the same absolute path on each of them",
+	}
 )
@@ -18,6 +18,8 @@ func newLogStreamCommand() *cli.Command {
 		cmdSync     = "sync"
 		cmdDescribe = "get"
 		cmdRecover  = "recover"
+		cmdExport   = "export"
+		cmdImport   = "import"
 	)
 
 	action := func(c *cli.Context) error {
@@ -70,6 +72,21 @@ func newLogStreamCommand() *cli.Command {
 			}
 		case cmdRecover:
 			panic("not implemented")
+		case cmdExport:
+			var snid types.StorageNodeID
+			if c.IsSet(flagStorageNodeID.name) {
+				snid, err = types.ParseStorageNodeID(c.String(flagStorageNodeID.name))
+				if err != nil {
+					return fmt.Errorf("log stream command: %w", err)
+				}
+			}
+			f = logstream.Export(topicID, logStreamID, snid, c.String(flagArchive.name))
+		case cmdImport:
+			snid, err := types.ParseStorageNodeID(c.String(flagStorageNodeID.name))
+			if err != nil {
+				return fmt.Errorf("log stream command: %w", err)
+			}
+			f = logstream.Import(topicID, logStreamID, snid, c.String(flagStorageNodePath.name), c.String(flagArchive.name))
 		}
 		return execute(c, f)
 	}
@@ -128,6 +145,29 @@ func newLogStreamCommand() *cli.Command {
 					// TODO: define necessary flags
 				),
 			},
+			{
+				Name:   cmdExport,
+				Usage:  "export committed log entries of a log stream into an archive; set --timeout long enough for large log streams",
+				Action: action,
+				Flags: commonFlags(
+					flagTopicID.StringFlag(true, ""),
+					flagLogStreamID.StringFlag(true, ""),
+					flagStorageNodeID.StringFlag(false, ""),
+					flagArchive.StringFlag(true, ""),
+				),
+			},
+			{
+				Name:   cmdImport,
+				Usage:  "import an archive into a storage node as a log stream replica",
+				Action: action,
+				Flags: commonFlags(
+					flagTopicID.StringFlag(true, ""),
+					flagLogStreamID.StringFlag(true, ""),
+					flagStorageNodeID.StringFlag(true, ""),
+					flagStorageNodePath.StringFlag(false, ""),
+					flagArchive.StringFlag(true, ""),
+				),
+			},
 		},
 	}
 }
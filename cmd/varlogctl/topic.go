@@ -8,6 +8,7 @@ import (
 	"github.com/kakao/varlog/internal/varlogctl"
 	"github.com/kakao/varlog/internal/varlogctl/topic"
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 func newTopicCommand() *cli.Command {
@@ -15,6 +16,7 @@ func newTopicCommand() *cli.Command {
 		cmdDescribe = "get"
 		cmdAdd      = "add"
 		cmdRemove   = "remove"
+		cmdRetain   = "retention"
 	)
 
 	action := func(c *cli.Context) error {
@@ -43,6 +45,12 @@ func newTopicCommand() *cli.Command {
 			f = topic.Add()
 		case cmdRemove:
 			f = topic.Remove(tpid)
+		case cmdRetain:
+			f = topic.SetRetentionPolicy(tpid, varlogpb.RetentionPolicy{
+				MaxAge:     c.Duration(flagRetentionMaxAge.name),
+				MaxBytes:   c.Uint64(flagRetentionMaxBytes.name),
+				MaxEntries: c.Uint64(flagRetentionMaxEntries.name),
+			})
 		default:
 			return fmt.Errorf("topic command: unknown command: %s", c.Command.Name)
 		}
@@ -75,6 +83,17 @@ func newTopicCommand() *cli.Command {
 					flagTopicID.StringFlag(true, ""),
 				),
 			},
+			{
+				Name:   cmdRetain,
+				Usage:  "set the retention policy of a topic",
+				Action: action,
+				Flags: commonFlags(
+					flagTopicID.StringFlag(true, ""),
+					flagRetentionMaxAge.DurationFlag(false, 0),
+					flagRetentionMaxBytes.Uint64Flag(false, 0),
+					flagRetentionMaxEntries.Uint64Flag(false, 0),
+				),
+			},
 		},
 	}
 }
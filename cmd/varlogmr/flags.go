@@ -157,4 +157,10 @@ var (
 		Usage:   "Log Dir",
 		Envs:    []string{"LOG_DIR"},
 	}
+
+	flagRestoreFrom = flags.FlagDesc{
+		Name:  "restore-from",
+		Usage: "Backup directory to restore the state machine from when the cluster has no metadata",
+		Envs:  []string{"RESTORE_FROM"},
+	}
 )
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
@@ -10,10 +11,12 @@ import (
 	"github.com/urfave/cli/v2"
 	_ "go.uber.org/automaxprocs"
 
+	"github.com/kakao/varlog/internal/backup"
 	"github.com/kakao/varlog/internal/metarepos"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/log"
 	"github.com/kakao/varlog/pkg/util/units"
+	"github.com/kakao/varlog/proto/mrpb"
 )
 
 func main() {
@@ -76,6 +79,16 @@ func start(c *cli.Context) error {
 	if c.Bool(flagJoin.Name) {
 		opts = append(opts, metarepos.JoinCluster())
 	}
+	if c.IsSet(flagRestoreFrom.Name) {
+		if c.Bool(flagJoin.Name) {
+			return errors.New("restore: cannot join the cluster")
+		}
+		sm, err := readBackup(c.String(flagRestoreFrom.Name), cid)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, metarepos.WithRestoreStateMachine(sm))
+	}
 
 	mr := metarepos.NewRaftMetadataRepository(opts...)
 	mr.Run()
@@ -91,6 +104,23 @@ func start(c *cli.Context) error {
 	return nil
 }
 
+// readBackup reads the state machine of the metadata repository from the
+// backup directory dir taken from the cluster cid.
+func readBackup(dir string, cid types.ClusterID) (*mrpb.MetadataRepositoryDescriptor, error) {
+	manifest, err := backup.ReadManifest(dir)
+	if err != nil {
+		return nil, fmt.Errorf("restore: %w", err)
+	}
+	if manifest.ClusterID != cid {
+		return nil, fmt.Errorf("restore: cluster id %d, expected %d", manifest.ClusterID, cid)
+	}
+	sm, err := backup.ReadStateMachine(dir, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("restore: %w", err)
+	}
+	return sm, nil
+}
+
 func initCLI() *cli.App {
 	return &cli.App{
 		Name:    "metadata_repository",
@@ -126,6 +156,7 @@ func initCLI() *cli.App {
 				flagTelemetryCollectorName.StringFlag(false, metarepos.DefaultTelemetryCollectorName),
 				flagTelemetryCollectorEndpoint.StringFlag(false, metarepos.DefaultTelmetryCollectorEndpoint),
 				flagLogDir.StringFlag(false, metarepos.DefaultLogDir),
+				flagRestoreFrom.StringFlag(false, ""),
 			},
 		}},
 	}
@@ -38,10 +38,12 @@ func newStartCommand() *cli.Command {
 			flagStorageNodeID.StringFlag(false, types.StorageNodeID(1).String()),
 			flagListen.StringFlag(false, "127.0.0.1:9091"),
 			flagAdvertise.StringFlag(false, ""),
+			flagTags.StringSliceFlag(false, nil),
 			flagBallastSize.StringFlag(false, storagenode.DefaultBallastSize),
 
 			// volumes
 			flagVolumes.StringSliceFlag(true, nil),
+			flagRestoreFrom.StringFlag(false, ""),
 
 			flagServerReadBufferSize.StringFlag(false, units.ToByteSizeString(storagenode.DefaultServerReadBufferSize)),
 			flagServerWriteBufferSize.StringFlag(false, units.ToByteSizeString(storagenode.DefaultServerWriteBufferSize)),
@@ -54,6 +56,7 @@ func newStartCommand() *cli.Command {
 			flagLogStreamExecutorWriteQueueCapacity.IntFlag(false, logstream.DefaultWriteQueueCapacity),
 			flagLogStreamExecutorCommitQueueCapacity.IntFlag(false, logstream.DefaultCommitQueueCapacity),
 			flagLogStreamExecutorReplicateclientQueueCapacity.IntFlag(false, logstream.DefaultReplicateClientQueueCapacity),
+			flagLogStreamExecutorProducerStateTTL.DurationFlag(false, logstream.DefaultProducerStateTTL),
 			flagMaxLogStreamReplicasCount,
 
 			// storage options
@@ -68,6 +71,10 @@ func newStartCommand() *cli.Command {
 			flagStorageMaxConcurrentCompaction.IntFlag(false, storage.DefaultMaxConcurrentCompactions),
 			flagStorageMetricsLogInterval,
 			flagStorageVerbose.BoolFlag(),
+			flagStorageColdTierURL.StringFlag(false, ""),
+			flagStorageColdTierAge,
+			flagStorageColdTierCheckInterval,
+			flagStorageColdTierSegmentSize,
 
 			flagLogDir.StringFlag(false, ""),
 			flagLogToStderr.BoolFlag(),
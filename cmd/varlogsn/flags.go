@@ -22,6 +22,12 @@ var (
 		Aliases: []string{"advertise-address"},
 		Envs:    []string{"ADVERTISE", "ADVERTISE_ADDRESS"},
 	}
+	flagTags = flags.FlagDesc{
+		Name:    "tags",
+		Aliases: []string{"tag"},
+		Envs:    []string{"TAGS", "TAG"},
+		Usage:   "tags of the storage node in the form of key=value, for instance, zone=a",
+	}
 	flagBallastSize = flags.FlagDesc{
 		Name:  "ballast-size",
 		Envs:  []string{"BALLAST_SIZE"},
@@ -32,6 +38,11 @@ var (
 		Aliases: []string{"volume"},
 		Envs:    []string{"VOLUMES", "VOLUME"},
 	}
+	flagRestoreFrom = flags.FlagDesc{
+		Name:  "restore-from",
+		Envs:  []string{"RESTORE_FROM"},
+		Usage: "backup directory to restore log stream replicas that do not exist in the volumes from; segments of the backup in the cold tier are copied, and restoring fails if the cold tier has objects of the replica already",
+	}
 
 	flagMaxLogStreamReplicasCount = &cli.IntFlag{
 		Name:  "max-logstream-replicas-count",
@@ -84,6 +95,11 @@ var (
 		Name:    "logstream-executor-replicate-client-queue-capacity",
 		Aliases: []string{"lse-replicate-client-queue-capacity"},
 	}
+	flagLogStreamExecutorProducerStateTTL = flags.FlagDesc{
+		Name:    "logstream-executor-producer-state-ttl",
+		Aliases: []string{"lse-producer-state-ttl"},
+		Usage:   "how long a log stream replica keeps the state of an idle idempotent producer; zero or negative keeps it forever",
+	}
 
 	// flags for storage.
 	flagStorageDisableWAL = flags.FlagDesc{
@@ -134,6 +150,28 @@ var (
 		Name: "storage-verbose",
 		Envs: []string{"STORAGE_VERBOSE"},
 	}
+	flagStorageColdTierURL = flags.FlagDesc{
+		Name:  "storage-cold-tier-url",
+		Envs:  []string{"STORAGE_COLD_TIER_URL"},
+		Usage: "object store for the cold tier, for instance, file:///path/to/dir or s3://bucket?endpoint=http://host:port&region=us-east-1, disabled if empty",
+	}
+	flagStorageColdTierAge = &cli.DurationFlag{
+		Name:    "storage-cold-tier-age",
+		EnvVars: []string{"STORAGE_COLD_TIER_AGE"},
+		Value:   storage.DefaultColdTierAge,
+		Usage:   "log entries committed earlier than this are moved to the cold tier",
+	}
+	flagStorageColdTierCheckInterval = &cli.DurationFlag{
+		Name:    "storage-cold-tier-check-interval",
+		EnvVars: []string{"STORAGE_COLD_TIER_CHECK_INTERVAL"},
+		Value:   storage.DefaultColdTierCheckInterval,
+	}
+	flagStorageColdTierSegmentSize = &cli.StringFlag{
+		Name:    "storage-cold-tier-segment-size",
+		EnvVars: []string{"STORAGE_COLD_TIER_SEGMENT_SIZE"},
+		Value:   units.ToByteSizeString(storage.DefaultColdTierSegmentSize),
+		Usage:   "B, KiB, MiB, GiB",
+	}
 
 	// flags for logging.
 	flagLogDir = flags.FlagDesc{
@@ -25,6 +25,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/internal/storage/objstore"
 	"github.com/kakao/varlog/internal/storagenode"
 	"github.com/kakao/varlog/internal/storagenode/logstream"
 	"github.com/kakao/varlog/pkg/types"
@@ -88,6 +89,11 @@ func start(c *cli.Context) error {
 		return err
 	}
 
+	tags, err := parseTags(c.StringSlice(flagTags.Name))
+	if err != nil {
+		return err
+	}
+
 	ballastSize, err := units.FromByteSizeString(c.String(flagBallastSize.Name))
 	if err != nil {
 		return err
@@ -159,14 +165,32 @@ func start(c *cli.Context) error {
 	if c.Bool(flagStorageVerbose.Name) {
 		storageOpts = append(storageOpts, storage.WithVerboseLogging())
 	}
+	if coldTierURL := c.String(flagStorageColdTierURL.Name); len(coldTierURL) > 0 {
+		store, err := objstore.Open(coldTierURL)
+		if err != nil {
+			return err
+		}
+		segmentSize, err := units.FromByteSizeString(c.String(flagStorageColdTierSegmentSize.Name))
+		if err != nil {
+			return err
+		}
+		storageOpts = append(storageOpts,
+			storage.WithColdTier(store),
+			storage.WithColdTierAge(c.Duration(flagStorageColdTierAge.Name)),
+			storage.WithColdTierCheckInterval(c.Duration(flagStorageColdTierCheckInterval.Name)),
+			storage.WithColdTierSegmentSize(int(segmentSize)),
+		)
+	}
 
 	sn, err := storagenode.NewStorageNode(
 		storagenode.WithClusterID(clusterID),
 		storagenode.WithStorageNodeID(storageNodeID),
 		storagenode.WithListenAddress(c.String(flagListen.Name)),
 		storagenode.WithAdvertiseAddress(c.String(flagAdvertise.Name)),
+		storagenode.WithTags(tags),
 		storagenode.WithBallastSize(ballastSize),
 		storagenode.WithVolumes(c.StringSlice(flagVolumes.Name)...),
+		storagenode.WithRestoreFrom(c.String(flagRestoreFrom.Name)),
 		storagenode.WithGRPCServerReadBufferSize(readBufferSize),
 		storagenode.WithGRPCServerWriteBufferSize(writeBufferSize),
 		storagenode.WithGRPCServerMaxRecvMsgSize(maxRecvMsgSize),
@@ -177,6 +201,7 @@ func start(c *cli.Context) error {
 			logstream.WithWriteQueueCapacity(c.Int(flagLogStreamExecutorWriteQueueCapacity.Name)),
 			logstream.WithCommitQueueCapacity(c.Int(flagLogStreamExecutorCommitQueueCapacity.Name)),
 			logstream.WithReplicateClientQueueCapacity(c.Int(flagLogStreamExecutorReplicateclientQueueCapacity.Name)),
+			logstream.WithProducerStateTTL(c.Duration(flagLogStreamExecutorProducerStateTTL.Name)),
 		),
 		storagenode.WithMaxLogStreamReplicasCount(int32(c.Int(flagMaxLogStreamReplicasCount.Name))),
 		storagenode.WithDefaultStorageOptions(storageOpts...),
@@ -257,3 +282,19 @@ func initTelemetry(ctx context.Context, c *cli.Context, snid types.StorageNodeID
 
 	return telemetry.NewMeterProvider(meterProviderOpts...)
 }
+
+// parseTags parses tags in the form of key=value.
+func parseTags(kvs []string) (map[string]string, error) {
+	if len(kvs) == 0 {
+		return nil, nil
+	}
+	tags := make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok || len(key) == 0 {
+			return nil, fmt.Errorf("tags: invalid tag %q", kv)
+		}
+		tags[key] = value
+	}
+	return tags, nil
+}
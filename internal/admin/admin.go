@@ -2,7 +2,10 @@ package admin
 
 import (
 	"context"
+	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"sort"
 	"sync"
 	"time"
@@ -15,12 +18,17 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 
+	"github.com/kakao/varlog/internal/admin/consumergroup"
+	"github.com/kakao/varlog/internal/admin/retention"
 	"github.com/kakao/varlog/internal/admin/snwatcher"
+	"github.com/kakao/varlog/internal/backup"
+	"github.com/kakao/varlog/pkg/rpc"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/netutil"
 	"github.com/kakao/varlog/pkg/verrors"
@@ -38,6 +46,7 @@ type Admin struct {
 	closed       bool
 	lis          net.Listener
 	server       *grpc.Server
+	handlers     *rpc.HandlerGroup
 	serverAddr   string
 	healthServer *health.Server
 
@@ -46,8 +55,10 @@ type Admin struct {
 	muLogStreamStatus [numLogStreamMutex]sync.Mutex
 
 	snw     *snwatcher.StorageNodeWatcher
+	ret     *retention.Enforcer
 	lsidGen *LogStreamIDGenerator
 	tpidGen *TopicIDGenerator
+	groups  *consumergroup.Coordinator
 }
 
 // New creates an Admin.
@@ -71,8 +82,10 @@ func New(ctx context.Context, opts ...Option) (*Admin, error) {
 		return nil, err
 	}
 
+	handlers := &rpc.HandlerGroup{}
 	grpcServer := grpc.NewServer(
 		grpcmiddleware.WithUnaryServerChain(
+			handlers.UnaryServerInterceptor(),
 			grpcctxtags.UnaryServerInterceptor(),
 			grpczap.UnaryServerInterceptor(cfg.logger, grpczap.WithDecider(
 				func(fullMethodName string, err error) bool {
@@ -80,13 +93,16 @@ func New(ctx context.Context, opts ...Option) (*Admin, error) {
 				},
 			)),
 		),
+		grpc.StreamInterceptor(handlers.StreamServerInterceptor()),
 	)
 
 	cm := &Admin{
 		config:       cfg,
 		lsidGen:      logStreamIDGen,
 		tpidGen:      topicIDGen,
+		groups:       consumergroup.New(cfg.cgSessionTimeout),
 		server:       grpcServer,
+		handlers:     handlers,
 		healthServer: health.NewServer(),
 	}
 	cm.snw, err = snwatcher.New(append(
@@ -97,6 +113,16 @@ func New(ctx context.Context, opts ...Option) (*Admin, error) {
 		snwatcher.WithStorageNodeWatcherHandler(cm),
 		snwatcher.WithLogger(cm.logger),
 	)...)
+	if err != nil {
+		return nil, err
+	}
+	cm.ret, err = retention.New(append(
+		cm.retentionOpts,
+		retention.WithClusterID(cm.cid),
+		retention.WithClusterMetadataView(cmView),
+		retention.WithTrimmer(cm),
+		retention.WithLogger(cm.logger),
+	)...)
 	return cm, err
 }
 
@@ -133,14 +159,25 @@ func (adm *Admin) Serve() error {
 		adm.mu.Unlock()
 		return err
 	}
+
+	// Retention
+	if err := adm.ret.Start(); err != nil {
+		adm.mu.Unlock()
+		return err
+	}
 	adm.mu.Unlock()
 
 	return adm.server.Serve(lis)
 }
 
 // Close closes the admin.
-// This method closes the gRPC server immediately.
+// This method closes the gRPC server immediately. Since it cancels running
+// RPCs and waits for their handlers first, a long-running RPC holding the
+// mutex, for instance, Backup, does not block closing the admin.
 func (adm *Admin) Close() (err error) {
+	adm.server.Stop()
+	adm.handlers.Wait()
+
 	adm.mu.Lock()
 	defer adm.mu.Unlock()
 	if adm.closed {
@@ -152,8 +189,9 @@ func (adm *Admin) Close() (err error) {
 
 	// SN Watcher
 	err = adm.snw.Stop()
+	// Retention
+	err = multierr.Combine(err, adm.ret.Stop())
 	err = multierr.Combine(err, adm.snmgr.Close(), adm.mrmgr.Close())
-	adm.server.Stop()
 	return err
 }
 
@@ -416,6 +454,37 @@ func (adm *Admin) unregisterTopic(ctx context.Context, tpid types.TopicID) error
 	return adm.mrmgr.UnregisterTopic(ctx, tpid)
 }
 
+func (adm *Admin) setRetentionPolicy(ctx context.Context, tpid types.TopicID, policy *varlogpb.RetentionPolicy) (*varlogpb.TopicDescriptor, error) {
+	adm.mu.Lock()
+	defer adm.mu.Unlock()
+
+	if policy != nil && policy.MaxAge < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "set retention policy: negative max age %v", policy.MaxAge)
+	}
+
+	md, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "set retention policy: %s", err.Error())
+	}
+	if md.GetTopic(tpid) == nil {
+		return nil, status.Errorf(codes.NotFound, "set retention policy: no such topic %d", tpid)
+	}
+
+	if err := adm.mrmgr.SetRetentionPolicy(ctx, tpid, policy); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "set retention policy: %s", err.Error())
+	}
+
+	md, err = adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "set retention policy: %s", err.Error())
+	}
+	td := md.GetTopic(tpid)
+	if td == nil {
+		return nil, status.Errorf(codes.NotFound, "set retention policy: no such topic %d", tpid)
+	}
+	return td, nil
+}
+
 func (adm *Admin) getLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID) (*varlogpb.LogStreamDescriptor, error) {
 	md, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
 	if err != nil {
@@ -904,6 +973,160 @@ func (adm *Admin) trim(ctx context.Context, tpid types.TopicID, lastGLSN types.G
 	return adm.snmgr.Trim(ctx, tpid, lastGLSN)
 }
 
+// TrimTopic implements retention.Trimmer.
+// It trims all log streams of the topic at the same GLSN, and fails if any
+// replica fails to trim.
+func (adm *Admin) TrimTopic(ctx context.Context, tpid types.TopicID, lastGLSN types.GLSN) error {
+	results, err := adm.trim(ctx, tpid, lastGLSN)
+	if err != nil {
+		return err
+	}
+	for _, res := range results {
+		if len(res.Error) > 0 {
+			err = multierr.Append(err, fmt.Errorf("snid %d, lsid %d: %s", res.StorageNodeID, res.LogStreamID, res.Error))
+		}
+	}
+	return err
+}
+
+// backup takes a point-in-time backup of the cluster into the directory dir.
+// It first checks whether storage nodes can access the directory, which must
+// be in a file system shared with them. The metadata repository freezes a
+// consistent cut at its last commit, and then every replica of every log
+// stream copies itself at the cut. It holds the read lock to keep the
+// replicas of log streams from being changed during the backup.
+func (adm *Admin) backup(ctx context.Context, dir string) (_ *admpb.BackupManifest, err error) {
+	adm.mu.RLock()
+	defer adm.mu.RUnlock()
+
+	if len(dir) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "backup: no path")
+	}
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "backup: %s", err.Error())
+	}
+	if _, err := os.Stat(dir); err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "backup: %s already exists", dir)
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, status.Errorf(codes.Internal, "backup: %s", err.Error())
+	}
+	defer func() {
+		if err != nil {
+			_ = os.RemoveAll(dir)
+		}
+	}()
+
+	// Storage nodes write copies of their replicas into the directory; thus,
+	// they must share it with the admin server. The token of the probe also
+	// identifies the backup.
+	token, err := backup.WriteProbe(dir)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "backup: %s", err.Error())
+	}
+	if err := adm.checkBackupPath(ctx, dir, token); err != nil {
+		return nil, err
+	}
+	if err := backup.RemoveProbe(dir); err != nil {
+		return nil, status.Errorf(codes.Internal, "backup: %s", err.Error())
+	}
+
+	rsp, err := adm.mrmgr.Backup(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "backup: %s", err.Error())
+	}
+	sm := rsp.StateMachine
+	if err := backup.WriteStateMachine(dir, sm); err != nil {
+		return nil, status.Errorf(codes.Internal, "backup: %s", err.Error())
+	}
+
+	lastCommitResults := sm.GetLastCommitResults()
+	manifest := &admpb.BackupManifest{
+		ClusterID:          adm.cid,
+		Version:            lastCommitResults.GetVersion(),
+		AppliedIndex:       rsp.AppliedIndex,
+		CreateTime:         time.Now().UTC(),
+		MetadataRepository: backup.MetadataRepositoryFile,
+	}
+	for _, lsd := range sm.GetMetadata().GetLogStreams() {
+		// Like the metadata repository does when sending commit results to
+		// storage nodes, it fills the version and high watermark.
+		commitResult, _, ok := lastCommitResults.LookupCommitResult(lsd.TopicID, lsd.LogStreamID, -1)
+		if ok {
+			commitResult.HighWatermark, _ = lastCommitResults.LastHighWatermark(lsd.TopicID, -1)
+		} else {
+			// The log stream has not committed yet.
+			commitResult = snpb.LogStreamCommitResult{
+				TopicID:             lsd.TopicID,
+				LogStreamID:         lsd.LogStreamID,
+				CommittedLLSNOffset: types.MinLLSN,
+				CommittedGLSNOffset: types.MinGLSN,
+			}
+		}
+		commitResult.Version = lastCommitResults.GetVersion()
+		for _, rd := range lsd.Replicas {
+			manifest.Replicas = append(manifest.Replicas, admpb.BackupReplica{
+				StorageNodeID:  rd.StorageNodeID,
+				TopicID:        lsd.TopicID,
+				LogStreamID:    lsd.LogStreamID,
+				Path:           backup.ReplicaPath(adm.cid, rd.StorageNodeID, lsd.TopicID, lsd.LogStreamID),
+				CommitResult:   commitResult,
+				ColdTierPrefix: backup.ColdTierPrefix(token, adm.cid, rd.StorageNodeID, lsd.TopicID, lsd.LogStreamID),
+			})
+		}
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	for i := range manifest.Replicas {
+		replica := manifest.Replicas[i]
+		g.Go(func() error {
+			_, err := adm.snmgr.Checkpoint(gctx, replica.StorageNodeID, replica.TopicID, replica.LogStreamID, filepath.Join(dir, replica.Path), replica.ColdTierPrefix, replica.CommitResult)
+			if err != nil {
+				return status.Errorf(status.Code(err), "snid=%d, tpid=%d, lsid=%d: %s", replica.StorageNodeID, replica.TopicID, replica.LogStreamID, err.Error())
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, status.Errorf(status.Code(err), "backup: %s", err.Error())
+	}
+
+	if err := backup.WriteManifest(dir, manifest); err != nil {
+		return nil, status.Errorf(codes.Internal, "backup: %s", err.Error())
+	}
+	return manifest, nil
+}
+
+// checkBackupPath checks whether all storage nodes having log stream replicas
+// can access the backup directory dir having the probe file with the token.
+func (adm *Admin) checkBackupPath(ctx context.Context, dir, token string) error {
+	md, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "backup: %s", err.Error())
+	}
+	snids := make(map[types.StorageNodeID]bool)
+	for _, lsd := range md.GetLogStreams() {
+		for _, rd := range lsd.Replicas {
+			snids[rd.StorageNodeID] = true
+		}
+	}
+	g, gctx := errgroup.WithContext(ctx)
+	for snid := range snids {
+		snid := snid
+		g.Go(func() error {
+			if err := adm.snmgr.CheckBackupPath(gctx, snid, dir, token); err != nil {
+				return status.Errorf(status.Code(err), "snid=%d: %s", snid, err.Error())
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return status.Errorf(status.Code(err), "backup: %s", err.Error())
+	}
+	return nil
+}
+
 func (adm *Admin) getMetadataRepositoryNode(ctx context.Context, nid types.NodeID) (*varlogpb.MetadataRepositoryNode, error) {
 	ci, err := adm.mrmgr.GetClusterInfo(ctx)
 	if err != nil {
@@ -1021,6 +1244,71 @@ func (adm *Admin) removeMRPeer(ctx context.Context, raftURL string) error {
 	return nil
 }
 
+// consumerGroupHeartbeat renews the session of the member in the consumer
+// group and returns log streams assigned to it. Log streams of the topic are
+// fetched from the cluster metadata so that the group rebalances when new log
+// streams are added to the topic.
+func (adm *Admin) consumerGroupHeartbeat(ctx context.Context, group string, tpid types.TopicID, memberID string, generation uint64, owned []types.LogStreamID) (consumergroup.Assignment, error) {
+	if len(group) == 0 || len(memberID) == 0 {
+		return consumergroup.Assignment{}, status.Error(codes.InvalidArgument, "consumer group heartbeat: no group or member")
+	}
+	md, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
+	if err != nil {
+		return consumergroup.Assignment{}, status.Errorf(codes.Unavailable, "consumer group heartbeat: %s", err.Error())
+	}
+	td := md.GetTopic(tpid)
+	if td == nil {
+		return consumergroup.Assignment{}, status.Errorf(codes.NotFound, "consumer group heartbeat: no such topic: tpid %d", tpid)
+	}
+	lsids := make([]types.LogStreamID, 0, len(td.LogStreams))
+	for _, lsid := range td.LogStreams {
+		if md.GetLogStream(lsid) != nil {
+			lsids = append(lsids, lsid)
+		}
+	}
+	asg, err := adm.groups.Heartbeat(group, tpid, memberID, generation, owned, lsids)
+	if err != nil {
+		return consumergroup.Assignment{}, status.Errorf(codes.FailedPrecondition, "consumer group heartbeat: %s", err.Error())
+	}
+	return asg, nil
+}
+
+// commitConsumerGroupOffset commits the offset of the log stream to the
+// metadata repository only if the coordinator accepts the member and its
+// generation.
+func (adm *Admin) commitConsumerGroupOffset(ctx context.Context, group string, tpid types.TopicID, memberID string, generation uint64, lsid types.LogStreamID, llsn types.LLSN) error {
+	if len(group) == 0 || len(memberID) == 0 {
+		return status.Error(codes.InvalidArgument, "commit consumer group offset: no group or member")
+	}
+	md, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "commit consumer group offset: %s", err.Error())
+	}
+	if md.GetTopic(tpid) == nil || md.GetLogStream(lsid) == nil {
+		return status.Errorf(codes.NotFound, "commit consumer group offset: no such log stream: tpid %d, lsid %d", tpid, lsid)
+	}
+	if err := adm.groups.CheckCommit(group, tpid, memberID, generation, lsid); err != nil {
+		return status.Errorf(codes.FailedPrecondition, "commit consumer group offset: %s", err.Error())
+	}
+	offset := mrpb.ConsumerGroupOffset{
+		TopicID:     tpid,
+		LogStreamID: lsid,
+		LLSN:        llsn,
+	}
+	if err := adm.mrmgr.CommitOffset(ctx, group, offset); err != nil {
+		return status.Errorf(codes.Unavailable, "commit consumer group offset: %s", err.Error())
+	}
+	return nil
+}
+
+func (adm *Admin) leaveConsumerGroup(group string, tpid types.TopicID, memberID string) error {
+	if len(group) == 0 || len(memberID) == 0 {
+		return status.Error(codes.InvalidArgument, "leave consumer group: no group or member")
+	}
+	adm.groups.Leave(group, tpid, memberID)
+	return nil
+}
+
 func (adm *Admin) HandleHeartbeatTimeout(ctx context.Context, snid types.StorageNodeID) {
 	meta, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
 	if err != nil {
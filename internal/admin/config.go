@@ -7,7 +7,9 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/kakao/varlog/internal/admin/consumergroup"
 	"github.com/kakao/varlog/internal/admin/mrmanager"
+	"github.com/kakao/varlog/internal/admin/retention"
 	"github.com/kakao/varlog/internal/admin/snmanager"
 	"github.com/kakao/varlog/internal/admin/snwatcher"
 	"github.com/kakao/varlog/internal/admin/stats"
@@ -28,11 +30,13 @@ type config struct {
 	logStreamGCTimeout       time.Duration
 	disableAutoLogStreamSync bool
 	enableAutoUnseal         bool
+	cgSessionTimeout         time.Duration
 	mrmgr                    mrmanager.MetadataRepositoryManager
 	snmgr                    snmanager.StorageNodeManager
 	snSelector               ReplicaSelector
 	statRepository           stats.Repository
 	snwatcherOpts            []snwatcher.Option
+	retentionOpts            []retention.Option
 	logger                   *zap.Logger
 }
 
@@ -42,6 +46,7 @@ func newConfig(opts []Option) (config, error) {
 		listenAddress:      DefaultListenAddress,
 		replicationFactor:  DefaultReplicationFactor,
 		logStreamGCTimeout: DefaultLogStreamGCTimeout,
+		cgSessionTimeout:   consumergroup.DefaultSessionTimeout,
 		logger:             zap.NewNop(),
 	}
 
@@ -73,6 +78,9 @@ func (cfg config) validate() error {
 	if cfg.snmgr == nil {
 		return errors.New("sn manager is nil")
 	}
+	if cfg.cgSessionTimeout <= 0 {
+		return errors.New("non-positive consumer group session timeout")
+	}
 	if cfg.logger == nil {
 		return errors.New("logger is nil")
 	}
@@ -150,6 +158,15 @@ func WithAutoUnseal() Option {
 	})
 }
 
+// WithConsumerGroupSessionTimeout sets how long the admin server keeps a
+// member of a consumer group without heartbeats. Once the session expires, log
+// streams assigned to the member are reassigned to other members.
+func WithConsumerGroupSessionTimeout(sessionTimeout time.Duration) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.cgSessionTimeout = sessionTimeout
+	})
+}
+
 func WithLogger(logger *zap.Logger) Option {
 	return newFuncOption(func(cfg *config) {
 		cfg.logger = logger
@@ -185,3 +202,11 @@ func WithStorageNodeWatcherOptions(opts ...snwatcher.Option) Option {
 		cfg.snwatcherOpts = opts
 	})
 }
+
+// WithRetentionOptions sets options of the enforcer that applies retention
+// policies of topics.
+func WithRetentionOptions(opts ...retention.Option) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.retentionOpts = opts
+	})
+}
@@ -0,0 +1,295 @@
+package consumergroup
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/kakao/varlog/pkg/types"
+)
+
+const DefaultSessionTimeout = 10 * time.Second
+
+// ErrFenced is returned when a member whose session has expired, or that uses
+// a stale generation, tries to renew its session or commit offsets. The
+// member should stop consuming all log streams without committing their
+// offsets and join the group again.
+var ErrFenced = errors.New("consumergroup: fenced")
+
+// Assignment is the result of a heartbeat of a member.
+type Assignment struct {
+	// Generation increases whenever members of the group or log streams of
+	// the topic change.
+	Generation uint64
+	// LogStreamIDs are log streams the member should consume. They are
+	// sorted in ascending order.
+	LogStreamIDs []types.LogStreamID
+	// SessionTimeout is how long the member may consume the log streams
+	// after sending the heartbeat unless it renews its session.
+	SessionTimeout time.Duration
+}
+
+// Coordinator divides log streams of a topic among members of a consumer
+// group. Members join the group by sending heartbeats and leave it by calling
+// Leave or by stopping heartbeats for longer than the session timeout.
+//
+// A member whose session expires is fenced: its next heartbeat and offset
+// commits fail with ErrFenced until it joins the group again. Membership is
+// kept in memory; hence, members are fenced and join the group again if the
+// admin server restarts.
+type Coordinator struct {
+	sessionTimeout time.Duration
+
+	mu     sync.Mutex
+	groups map[groupKey]*group
+}
+
+type groupKey struct {
+	name string
+	tpid types.TopicID
+}
+
+type group struct {
+	generation uint64
+	members    map[string]*member
+	logStreams []types.LogStreamID
+	// targets are log streams assigned to each member in the current
+	// generation.
+	targets map[string][]types.LogStreamID
+}
+
+type member struct {
+	lastHeartbeat time.Time
+	// generation is the generation returned by its last heartbeat.
+	generation uint64
+	// owned are log streams the member consumes, which are reported by its
+	// last heartbeat.
+	owned []types.LogStreamID
+	// granted are log streams returned by its last heartbeat. The member
+	// may consume them even though it has not reported them yet.
+	granted []types.LogStreamID
+}
+
+// New creates a Coordinator. Members that do not send heartbeats for the
+// argument sessionTimeout are removed from their groups.
+func New(sessionTimeout time.Duration) *Coordinator {
+	if sessionTimeout <= 0 {
+		sessionTimeout = DefaultSessionTimeout
+	}
+	return &Coordinator{
+		sessionTimeout: sessionTimeout,
+		groups:         make(map[groupKey]*group),
+	}
+}
+
+// SessionTimeout returns how long sessions of members last without
+// heartbeats.
+func (c *Coordinator) SessionTimeout() time.Duration {
+	return c.sessionTimeout
+}
+
+// Heartbeat renews the session of the member, which joins the group if it is
+// not a member yet, and returns log streams assigned to it. The argument
+// generation is the generation of the last assignment the member received, or
+// zero if the member joins the group. The argument owned is log streams the
+// member consumes now, and the argument logStreams is all log streams of the
+// topic.
+//
+// It returns ErrFenced if the member is not in the group, but the argument
+// generation is not zero, which means that the session of the member has
+// expired. Since the group may already have assigned its log streams to
+// other members, it should not consume or commit them anymore.
+//
+// Log streams are assigned to members in a round-robin manner. When the
+// group rebalances, a log stream moves to its new owner only after the
+// previous owner stops consuming it and reports so by its heartbeat. Thus, no
+// two live members consume the same log stream at the same time.
+func (c *Coordinator) Heartbeat(name string, tpid types.TopicID, memberID string, generation uint64, owned, logStreams []types.LogStreamID) (Assignment, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := groupKey{name: name, tpid: tpid}
+	g, ok := c.groups[key]
+	if !ok {
+		if generation != 0 {
+			return Assignment{}, ErrFenced
+		}
+		g = &group{members: make(map[string]*member)}
+		c.groups[key] = g
+	}
+
+	now := time.Now()
+	changed := c.expire(g, now)
+	m, ok := g.members[memberID]
+	if !ok {
+		if generation != 0 {
+			if changed {
+				g.rebalance()
+			}
+			return Assignment{}, ErrFenced
+		}
+		m = &member{}
+		g.members[memberID] = m
+		changed = true
+	}
+	m.lastHeartbeat = now
+	m.owned = sortedLogStreamIDs(owned)
+
+	logStreams = sortedLogStreamIDs(logStreams)
+	if !slices.Equal(g.logStreams, logStreams) {
+		g.logStreams = logStreams
+		changed = true
+	}
+	if changed {
+		g.rebalance()
+	}
+
+	assigned := make([]types.LogStreamID, 0, len(g.targets[memberID]))
+	for _, lsid := range g.targets[memberID] {
+		if !g.heldByOthers(memberID, lsid) {
+			assigned = append(assigned, lsid)
+		}
+	}
+	m.granted = assigned
+	m.generation = g.generation
+	return Assignment{
+		Generation:     g.generation,
+		LogStreamIDs:   assigned,
+		SessionTimeout: c.sessionTimeout,
+	}, nil
+}
+
+// CheckCommit checks whether the member can commit the offset of the log
+// stream. The member can commit it only if its session is alive, the argument
+// generation is the generation returned by its last heartbeat, and it either
+// consumes or is granted the log stream. It returns ErrFenced otherwise.
+//
+// Since no other member is granted the log stream until the member reports
+// that it stops consuming it or its session expires, the commit does not
+// overwrite offsets committed by the next owner unless the commit is delayed
+// longer than the session timeout.
+func (c *Coordinator) CheckCommit(name string, tpid types.TopicID, memberID string, generation uint64, lsid types.LogStreamID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	g, ok := c.groups[groupKey{name: name, tpid: tpid}]
+	if !ok {
+		return ErrFenced
+	}
+	if c.expire(g, time.Now()) {
+		g.rebalance()
+	}
+	m, ok := g.members[memberID]
+	if !ok || m.generation != generation {
+		return ErrFenced
+	}
+	if _, ok := slices.BinarySearch(m.owned, lsid); ok {
+		return nil
+	}
+	if _, ok := slices.BinarySearch(m.granted, lsid); ok {
+		return nil
+	}
+	return ErrFenced
+}
+
+// Leave removes the member from the group. It is okay to leave the group that
+// the member does not belong to.
+func (c *Coordinator) Leave(name string, tpid types.TopicID, memberID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := groupKey{name: name, tpid: tpid}
+	g, ok := c.groups[key]
+	if !ok {
+		return
+	}
+	changed := c.expire(g, time.Now())
+	if _, ok := g.members[memberID]; ok {
+		delete(g.members, memberID)
+		changed = true
+	}
+	if len(g.members) == 0 {
+		delete(c.groups, key)
+		return
+	}
+	if changed {
+		g.rebalance()
+	}
+}
+
+// Members returns IDs of live members of the group in ascending order.
+func (c *Coordinator) Members(name string, tpid types.TopicID) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	g, ok := c.groups[groupKey{name: name, tpid: tpid}]
+	if !ok {
+		return nil
+	}
+	if c.expire(g, time.Now()) {
+		g.rebalance()
+	}
+	return g.memberIDs()
+}
+
+// expire removes members whose sessions are expired, and returns true if any
+// member is removed.
+func (c *Coordinator) expire(g *group, now time.Time) bool {
+	expired := false
+	for id, m := range g.members {
+		if now.Sub(m.lastHeartbeat) > c.sessionTimeout {
+			delete(g.members, id)
+			expired = true
+		}
+	}
+	return expired
+}
+
+func (g *group) rebalance() {
+	g.generation++
+	ids := g.memberIDs()
+	g.targets = make(map[string][]types.LogStreamID, len(ids))
+	if len(ids) == 0 {
+		return
+	}
+	for idx, lsid := range g.logStreams {
+		id := ids[idx%len(ids)]
+		g.targets[id] = append(g.targets[id], lsid)
+	}
+}
+
+func (g *group) memberIDs() []string {
+	ids := make([]string, 0, len(g.members))
+	for id := range g.members {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// heldByOthers returns true if a member other than the argument memberID
+// either consumes or is granted the log stream.
+func (g *group) heldByOthers(memberID string, lsid types.LogStreamID) bool {
+	for id, m := range g.members {
+		if id == memberID {
+			continue
+		}
+		if _, ok := slices.BinarySearch(m.owned, lsid); ok {
+			return true
+		}
+		if _, ok := slices.BinarySearch(m.granted, lsid); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedLogStreamIDs(lsids []types.LogStreamID) []types.LogStreamID {
+	ret := make([]types.LogStreamID, len(lsids))
+	copy(ret, lsids)
+	slices.Sort(ret)
+	return ret
+}
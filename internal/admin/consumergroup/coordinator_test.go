@@ -0,0 +1,161 @@
+package consumergroup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/types"
+)
+
+func TestCoordinator(t *testing.T) {
+	const (
+		name = "group"
+		tpid = types.TopicID(1)
+	)
+	logStreams := []types.LogStreamID{3, 1, 2, 4}
+
+	c := New(time.Minute)
+	generations := make(map[string]uint64)
+	heartbeat := func(tpid types.TopicID, memberID string, owned []types.LogStreamID) Assignment {
+		asg, err := c.Heartbeat(name, tpid, memberID, generations[memberID], owned, logStreams)
+		require.NoError(t, err)
+		require.Equal(t, time.Minute, asg.SessionTimeout)
+		generations[memberID] = asg.Generation
+		return asg
+	}
+
+	// A single member consumes all log streams.
+	asg := heartbeat(tpid, "a", nil)
+	assert.Equal(t, uint64(1), asg.Generation)
+	assert.Equal(t, []types.LogStreamID{1, 2, 3, 4}, asg.LogStreamIDs)
+
+	// The second member joins, but it has to wait for the first member to
+	// release log streams assigned to it.
+	asg = heartbeat(tpid, "b", nil)
+	assert.Equal(t, uint64(2), asg.Generation)
+	assert.Empty(t, asg.LogStreamIDs)
+
+	asg = heartbeat(tpid, "a", []types.LogStreamID{1, 2, 3, 4})
+	assert.Equal(t, uint64(2), asg.Generation)
+	assert.Equal(t, []types.LogStreamID{1, 3}, asg.LogStreamIDs)
+
+	asg = heartbeat(tpid, "b", nil)
+	assert.Empty(t, asg.LogStreamIDs)
+
+	asg = heartbeat(tpid, "a", []types.LogStreamID{1, 3})
+	assert.Equal(t, []types.LogStreamID{1, 3}, asg.LogStreamIDs)
+
+	asg = heartbeat(tpid, "b", nil)
+	assert.Equal(t, []types.LogStreamID{2, 4}, asg.LogStreamIDs)
+
+	// A new log stream triggers rebalancing.
+	logStreams = append(logStreams, 5)
+	asg = heartbeat(tpid, "a", []types.LogStreamID{1, 3})
+	assert.Equal(t, uint64(3), asg.Generation)
+	assert.Equal(t, []types.LogStreamID{1, 3, 5}, asg.LogStreamIDs)
+
+	// Groups are distinguished by topics.
+	asg = heartbeat(tpid+1, "c", nil)
+	assert.Equal(t, uint64(1), asg.Generation)
+	assert.Equal(t, []types.LogStreamID{1, 2, 3, 4, 5}, asg.LogStreamIDs)
+
+	require.Equal(t, []string{"a", "b"}, c.Members(name, tpid))
+
+	// The member leaves the group.
+	c.Leave(name, tpid, "b")
+	require.Equal(t, []string{"a"}, c.Members(name, tpid))
+	asg = heartbeat(tpid, "a", []types.LogStreamID{1, 3, 5})
+	assert.Equal(t, uint64(4), asg.Generation)
+	assert.Equal(t, []types.LogStreamID{1, 2, 3, 4, 5}, asg.LogStreamIDs)
+
+	c.Leave(name, tpid, "a")
+	require.Empty(t, c.Members(name, tpid))
+}
+
+func TestCoordinator_SessionTimeout(t *testing.T) {
+	const (
+		name           = "group"
+		tpid           = types.TopicID(1)
+		sessionTimeout = 100 * time.Millisecond
+	)
+	logStreams := []types.LogStreamID{1, 2}
+
+	c := New(sessionTimeout)
+
+	asgA, err := c.Heartbeat(name, tpid, "a", 0, nil, logStreams)
+	require.NoError(t, err)
+	asg, err := c.Heartbeat(name, tpid, "b", 0, nil, logStreams)
+	require.NoError(t, err)
+	assert.Empty(t, asg.LogStreamIDs)
+
+	// The member "a" does not send heartbeats, thus its session expires.
+	require.Eventually(t, func() bool {
+		asg, err = c.Heartbeat(name, tpid, "b", asg.Generation, asg.LogStreamIDs, logStreams)
+		require.NoError(t, err)
+		return len(asg.LogStreamIDs) == 2
+	}, time.Second, sessionTimeout/10)
+	assert.Equal(t, []string{"b"}, c.Members(name, tpid))
+
+	// The expired member is fenced until it joins the group again.
+	_, err = c.Heartbeat(name, tpid, "a", asgA.Generation, asgA.LogStreamIDs, logStreams)
+	require.ErrorIs(t, err, ErrFenced)
+	err = c.CheckCommit(name, tpid, "a", asgA.Generation, 1)
+	require.ErrorIs(t, err, ErrFenced)
+	assert.Equal(t, []string{"b"}, c.Members(name, tpid))
+
+	_, err = c.Heartbeat(name, tpid, "a", 0, nil, logStreams)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, c.Members(name, tpid))
+}
+
+func TestCoordinator_CheckCommit(t *testing.T) {
+	const (
+		name = "group"
+		tpid = types.TopicID(1)
+	)
+	logStreams := []types.LogStreamID{1, 2}
+
+	c := New(time.Minute)
+
+	// unknown group
+	err := c.CheckCommit(name, tpid, "a", 1, 1)
+	require.ErrorIs(t, err, ErrFenced)
+
+	asgA, err := c.Heartbeat(name, tpid, "a", 0, nil, logStreams)
+	require.NoError(t, err)
+	require.NoError(t, c.CheckCommit(name, tpid, "a", asgA.Generation, 1))
+	require.NoError(t, c.CheckCommit(name, tpid, "a", asgA.Generation, 2))
+
+	// unknown member
+	err = c.CheckCommit(name, tpid, "b", asgA.Generation, 1)
+	require.ErrorIs(t, err, ErrFenced)
+
+	// The member "b" joins; the member "a" still consumes both log streams.
+	asgB, err := c.Heartbeat(name, tpid, "b", 0, nil, logStreams)
+	require.NoError(t, err)
+	err = c.CheckCommit(name, tpid, "b", asgB.Generation, 2)
+	require.ErrorIs(t, err, ErrFenced)
+
+	// A stale generation is fenced.
+	asgA, err = c.Heartbeat(name, tpid, "a", asgA.Generation, []types.LogStreamID{1, 2}, logStreams)
+	require.NoError(t, err)
+	require.Equal(t, []types.LogStreamID{1}, asgA.LogStreamIDs)
+	err = c.CheckCommit(name, tpid, "a", asgA.Generation-1, 2)
+	require.ErrorIs(t, err, ErrFenced)
+
+	// The member "a" can commit the revoked log stream until it reports that
+	// it stops consuming it.
+	require.NoError(t, c.CheckCommit(name, tpid, "a", asgA.Generation, 2))
+	asgA, err = c.Heartbeat(name, tpid, "a", asgA.Generation, []types.LogStreamID{1}, logStreams)
+	require.NoError(t, err)
+	err = c.CheckCommit(name, tpid, "a", asgA.Generation, 2)
+	require.ErrorIs(t, err, ErrFenced)
+
+	asgB, err = c.Heartbeat(name, tpid, "b", asgB.Generation, nil, logStreams)
+	require.NoError(t, err)
+	require.Equal(t, []types.LogStreamID{2}, asgB.LogStreamIDs)
+	require.NoError(t, c.CheckCommit(name, tpid, "b", asgB.Generation, 2))
+}
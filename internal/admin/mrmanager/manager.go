@@ -54,6 +54,8 @@ type MetadataRepositoryManager interface {
 
 	UnregisterTopic(ctx context.Context, topicID types.TopicID) error
 
+	SetRetentionPolicy(ctx context.Context, topicID types.TopicID, policy *varlogpb.RetentionPolicy) error
+
 	RegisterLogStream(ctx context.Context, logStreamDesc *varlogpb.LogStreamDescriptor) error
 
 	UnregisterLogStream(ctx context.Context, logStreamID types.LogStreamID) error
@@ -69,6 +71,12 @@ type MetadataRepositoryManager interface {
 
 	GetClusterInfo(ctx context.Context) (*mrpb.ClusterInfo, error)
 
+	// Backup returns a copy of the state machine of the metadata repository.
+	Backup(ctx context.Context) (*mrpb.BackupResponse, error)
+
+	// CommitOffset stores the offset committed by the consumer group.
+	CommitOffset(ctx context.Context, group string, offset mrpb.ConsumerGroupOffset) error
+
 	AddPeer(ctx context.Context, nodeID types.NodeID, peerURL, rpcURL string) error
 
 	RemovePeer(ctx context.Context, nodeID types.NodeID) error
@@ -248,6 +256,26 @@ func (mrm *mrManager) UnregisterTopic(ctx context.Context, topicID types.TopicID
 	return err
 }
 
+func (mrm *mrManager) SetRetentionPolicy(ctx context.Context, topicID types.TopicID, policy *varlogpb.RetentionPolicy) error {
+	mrm.mu.Lock()
+	defer func() {
+		mrm.dirty = true
+		mrm.mu.Unlock()
+	}()
+
+	cli, err := mrm.c()
+	if err != nil {
+		return errors.WithMessage(err, "mrmanager: not accessible")
+	}
+
+	if err := cli.SetRetentionPolicy(ctx, topicID, policy); err != nil {
+		_ = cli.Close()
+		return err
+	}
+
+	return err
+}
+
 func (mrm *mrManager) RegisterLogStream(ctx context.Context, logStreamDesc *varlogpb.LogStreamDescriptor) error {
 	mrm.mu.Lock()
 	defer func() {
@@ -361,6 +389,39 @@ func (mrm *mrManager) GetClusterInfo(ctx context.Context) (*mrpb.ClusterInfo, er
 	return rsp.GetClusterInfo(), err
 }
 
+func (mrm *mrManager) Backup(ctx context.Context) (*mrpb.BackupResponse, error) {
+	mrm.mu.Lock()
+	defer mrm.mu.Unlock()
+
+	cli, err := mrm.c()
+	if err != nil {
+		return nil, errors.WithMessage(err, "mrmanager: not accessible")
+	}
+
+	rsp, err := cli.Backup(ctx)
+	if err != nil {
+		_ = cli.Close()
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func (mrm *mrManager) CommitOffset(ctx context.Context, group string, offset mrpb.ConsumerGroupOffset) error {
+	mrm.mu.Lock()
+	defer mrm.mu.Unlock()
+
+	cli, err := mrm.c()
+	if err != nil {
+		return errors.WithMessage(err, "mrmanager: not accessible")
+	}
+
+	if err := cli.CommitOffset(ctx, group, offset); err != nil {
+		_ = cli.Close()
+		return err
+	}
+	return nil
+}
+
 func (mrm *mrManager) AddPeer(ctx context.Context, nodeID types.NodeID, peerURL, rpcURL string) error {
 	mrm.mu.Lock()
 	defer mrm.mu.Unlock()
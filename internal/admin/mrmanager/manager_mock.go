@@ -105,6 +105,21 @@ func (mr *MockMetadataRepositoryManagerMockRecorder) AddPeer(arg0, arg1, arg2, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPeer", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).AddPeer), arg0, arg1, arg2, arg3)
 }
 
+// Backup mocks base method.
+func (m *MockMetadataRepositoryManager) Backup(arg0 context.Context) (*mrpb.BackupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup", arg0)
+	ret0, _ := ret[0].(*mrpb.BackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockMetadataRepositoryManagerMockRecorder) Backup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).Backup), arg0)
+}
+
 // Close mocks base method.
 func (m *MockMetadataRepositoryManager) Close() error {
 	m.ctrl.T.Helper()
@@ -133,6 +148,20 @@ func (mr *MockMetadataRepositoryManagerMockRecorder) ClusterMetadataView() *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterMetadataView", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).ClusterMetadataView))
 }
 
+// CommitOffset mocks base method.
+func (m *MockMetadataRepositoryManager) CommitOffset(arg0 context.Context, arg1 string, arg2 mrpb.ConsumerGroupOffset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitOffset", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitOffset indicates an expected call of CommitOffset.
+func (mr *MockMetadataRepositoryManagerMockRecorder) CommitOffset(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitOffset", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).CommitOffset), arg0, arg1, arg2)
+}
+
 // GetClusterInfo mocks base method.
 func (m *MockMetadataRepositoryManager) GetClusterInfo(arg0 context.Context) (*mrpb.ClusterInfo, error) {
 	m.ctrl.T.Helper()
@@ -233,6 +262,20 @@ func (mr *MockMetadataRepositoryManagerMockRecorder) Seal(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).Seal), arg0, arg1)
 }
 
+// SetRetentionPolicy mocks base method.
+func (m *MockMetadataRepositoryManager) SetRetentionPolicy(arg0 context.Context, arg1 types.TopicID, arg2 *varlogpb.RetentionPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRetentionPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRetentionPolicy indicates an expected call of SetRetentionPolicy.
+func (mr *MockMetadataRepositoryManagerMockRecorder) SetRetentionPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetentionPolicy", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).SetRetentionPolicy), arg0, arg1, arg2)
+}
+
 // UnregisterLogStream mocks base method.
 func (m *MockMetadataRepositoryManager) UnregisterLogStream(arg0 context.Context, arg1 types.LogStreamID) error {
 	m.ctrl.T.Helper()
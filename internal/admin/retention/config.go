@@ -0,0 +1,104 @@
+package retention
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/internal/admin/mrmanager"
+	"github.com/kakao/varlog/pkg/types"
+)
+
+const (
+	DefaultCheckInterval = time.Minute
+)
+
+type config struct {
+	cid           types.ClusterID
+	cmview        mrmanager.ClusterMetadataView
+	trimmer       Trimmer
+	checkInterval time.Duration
+	logger        *zap.Logger
+}
+
+func newConfig(opts []Option) (config, error) {
+	cfg := config{
+		checkInterval: DefaultCheckInterval,
+		logger:        zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt.apply(&cfg)
+	}
+	if err := cfg.validate(); err != nil {
+		return cfg, err
+	}
+	cfg.logger = cfg.logger.Named("retention")
+	return cfg, nil
+}
+
+func (cfg *config) validate() error {
+	if cfg.cmview == nil {
+		return errors.New("retention: cluster metadata view is nil")
+	}
+	if cfg.trimmer == nil {
+		return errors.New("retention: trimmer is nil")
+	}
+	if cfg.checkInterval <= 0 {
+		return fmt.Errorf("retention: invalid check interval %v", cfg.checkInterval)
+	}
+	if cfg.logger == nil {
+		return errors.New("retention: logger is nil")
+	}
+	return nil
+}
+
+type Option interface {
+	apply(*config)
+}
+
+type funcOption struct {
+	f func(*config)
+}
+
+func newFuncOption(f func(*config)) *funcOption {
+	return &funcOption{f: f}
+}
+
+func (fo *funcOption) apply(cfg *config) {
+	fo.f(cfg)
+}
+
+func WithClusterID(cid types.ClusterID) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.cid = cid
+	})
+}
+
+func WithClusterMetadataView(cmview mrmanager.ClusterMetadataView) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.cmview = cmview
+	})
+}
+
+func WithTrimmer(trimmer Trimmer) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.trimmer = trimmer
+	})
+}
+
+// WithCheckInterval sets how often the enforcer checks the log streams of
+// topics having retention policies.
+// Log entries can outlive their retention policy by up to the interval.
+func WithCheckInterval(checkInterval time.Duration) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.checkInterval = checkInterval
+	})
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.logger = logger
+	})
+}
@@ -0,0 +1,297 @@
+package retention
+
+//go:generate mockgen -build_flags -mod=vendor -self_package github.com/kakao/varlog/internal/admin/retention -package retention -destination retention_mock.go . Trimmer
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/internal/storagenode/client"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/util/runner"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// Trimmer trims topics on behalf of the Enforcer.
+type Trimmer interface {
+	// TrimTopic removes log entries prefixed by lastGLSN from all log
+	// streams of the topic specified by the argument tpid.
+	TrimTopic(ctx context.Context, tpid types.TopicID, lastGLSN types.GLSN) error
+}
+
+// replicaReader reads a log stream replica to decide how far the log stream
+// should be trimmed. It is implemented by client.LogClient.
+type replicaReader interface {
+	LogStreamReplicaMetadata(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID) (snpb.LogStreamReplicaMetadataDescriptor, error)
+	SeekTime(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, t time.Time) (types.GLSN, bool, error)
+	ReadWithLLSN(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, llsn types.LLSN) (varlogpb.LogEntry, error)
+}
+
+// Enforcer applies the retention policies of topics.
+// It fetches cluster metadata from mrmanager.ClusterMetadataView
+// periodically, and for each topic having a retention policy, it computes the
+// last log entry violating the policy in each log stream and trims the topic
+// by using the Trimmer.
+//
+// The trim point of a log stream is decided by a single replica of the log
+// stream:
+//   - MaxAge: log entries committed before the max age are trimmed.
+//   - MaxEntries: the oldest log entries exceeding the max entries are
+//     trimmed.
+//   - MaxBytes: the oldest log entries whose estimated size exceeds the max
+//     bytes are trimmed. The size of each log entry is estimated by dividing
+//     the live storage size of the replica by the number of log entries.
+//
+// Subscribers of a topic read all log streams from the same GLSN, thus all log
+// streams of a topic are trimmed at the same GLSN: the smallest trim point of
+// the log streams violating the policy. A violating log stream can therefore
+// keep more log entries than its policy allows, and a log stream not violating
+// the policy loses log entries preceding that GLSN. The topic is not trimmed
+// unless all of its log streams are running, since sealed log streams reject
+// trims.
+type Enforcer struct {
+	config
+
+	clients *client.Manager[*client.LogClient]
+	// getReplica is replica; tests replace it.
+	getReplica func(ctx context.Context, md *varlogpb.MetadataDescriptor, lsd *varlogpb.LogStreamDescriptor) (replicaReader, snpb.LogStreamReplicaMetadataDescriptor, error)
+
+	runner *runner.Runner
+	cancel context.CancelFunc
+}
+
+func New(opts ...Option) (*Enforcer, error) {
+	cfg, err := newConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	clients, err := client.NewManager[*client.LogClient](
+		client.WithClusterID(cfg.cid),
+		client.WithLogger(cfg.logger),
+	)
+	if err != nil {
+		return nil, err
+	}
+	e := &Enforcer{
+		config:  cfg,
+		clients: clients,
+		runner:  runner.New("retention", cfg.logger),
+	}
+	e.getReplica = e.replica
+	return e, nil
+}
+
+func (e *Enforcer) Start() error {
+	cancel, err := e.runner.Run(e.run)
+	if err != nil {
+		return err
+	}
+	e.cancel = cancel
+	return nil
+}
+
+func (e *Enforcer) Stop() error {
+	if e.cancel != nil {
+		e.cancel()
+	}
+	e.runner.Stop()
+	return e.clients.Close()
+}
+
+func (e *Enforcer) run(ctx context.Context) {
+	ticker := time.NewTicker(e.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := e.enforce(ctx, time.Now()); err != nil {
+				e.logger.Warn("enforce", zap.Error(err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (e *Enforcer) enforce(ctx context.Context, now time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, e.checkInterval)
+	defer cancel()
+
+	md, err := e.cmview.ClusterMetadata(ctx)
+	if err != nil {
+		return fmt.Errorf("retention: %w", err)
+	}
+
+	var errs []error
+	for _, td := range md.Topics {
+		if td.Status.Deleted() || td.RetentionPolicy.Empty() {
+			continue
+		}
+		if err := e.enforceTopic(ctx, md, td, now); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return multierr.Combine(errs...)
+}
+
+func (e *Enforcer) enforceTopic(ctx context.Context, md *varlogpb.MetadataDescriptor, td *varlogpb.TopicDescriptor, now time.Time) error {
+	tpid := td.TopicID
+
+	// lastGLSN is the largest GLSN at which no log stream violating the
+	// policy loses log entries to be kept. Log streams not violating the
+	// policy, for instance, idle ones, do not limit it; they lose only log
+	// entries older than the ones trimmed from the violating log streams.
+	lastGLSN := types.MaxGLSN
+	// maxGLSN is the largest GLSN that all replicas accept.
+	maxGLSN := types.MaxGLSN
+	var lwms []types.GLSN
+	for _, lsid := range td.LogStreams {
+		lsd := md.GetLogStream(lsid)
+		if lsd == nil {
+			continue
+		}
+		if !lsd.Status.Running() {
+			return nil
+		}
+
+		rr, lsrmd, err := e.getReplica(ctx, md, lsd)
+		if err != nil {
+			return err
+		}
+		if !lsrmd.Status.Running() {
+			return nil
+		}
+		// The replica rejects trims beyond the global high watermark it
+		// knows.
+		if lsrmd.GlobalHighWatermark < maxGLSN {
+			maxGLSN = lsrmd.GlobalHighWatermark
+		}
+
+		glsn, err := trimPoint(ctx, rr, td.RetentionPolicy, lsrmd, now)
+		if err != nil {
+			return fmt.Errorf("retention: tpid %d, lsid %d: %w", tpid, lsid, err)
+		}
+		if glsn.Invalid() {
+			continue
+		}
+		lwms = append(lwms, lsrmd.LocalLowWatermark.GLSN)
+		if glsn < lastGLSN {
+			lastGLSN = glsn
+		}
+	}
+	if len(lwms) == 0 {
+		return nil
+	}
+	if maxGLSN < lastGLSN {
+		lastGLSN = maxGLSN
+	}
+
+	trimmed := false
+	for _, lwm := range lwms {
+		if lwm <= lastGLSN {
+			trimmed = true
+			break
+		}
+	}
+	if !trimmed {
+		return nil
+	}
+
+	if err := e.trimmer.TrimTopic(ctx, tpid, lastGLSN); err != nil {
+		return fmt.Errorf("retention: tpid %d: trim %d: %w", tpid, lastGLSN, err)
+	}
+	e.logger.Info("trimmed",
+		zap.Int32("tpid", int32(tpid)),
+		zap.Uint64("last_glsn", uint64(lastGLSN)),
+	)
+	return nil
+}
+
+// replica returns a reader of the first replica of the log stream that
+// responds, along with its metadata.
+func (e *Enforcer) replica(ctx context.Context, md *varlogpb.MetadataDescriptor, lsd *varlogpb.LogStreamDescriptor) (replicaReader, snpb.LogStreamReplicaMetadataDescriptor, error) {
+	var errs []error
+	for _, rd := range lsd.Replicas {
+		snd := md.GetStorageNode(rd.StorageNodeID)
+		if snd == nil {
+			continue
+		}
+		cli, err := e.clients.GetOrConnect(ctx, snd.StorageNodeID, snd.Address)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		lsrmd, err := cli.LogStreamReplicaMetadata(ctx, lsd.TopicID, lsd.LogStreamID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		return cli, lsrmd, nil
+	}
+	return nil, snpb.LogStreamReplicaMetadataDescriptor{}, fmt.Errorf("retention: tpid %d, lsid %d: no available replica: %w", lsd.TopicID, lsd.LogStreamID, multierr.Combine(errs...))
+}
+
+// trimPoint returns the GLSN of the last log entry that violates the
+// retention policy in the log stream replica described by the argument lsrmd.
+// It returns types.InvalidGLSN if no log entry violates the policy. The
+// MaxBytes rule is not evaluated if the replica does not report its live
+// storage size.
+func trimPoint(ctx context.Context, rr replicaReader, policy *varlogpb.RetentionPolicy, lsrmd snpb.LogStreamReplicaMetadataDescriptor, now time.Time) (lastGLSN types.GLSN, err error) {
+	tpid, lsid := lsrmd.TopicID, lsrmd.LogStreamID
+	lwm, hwm := lsrmd.LocalLowWatermark, lsrmd.LocalHighWatermark
+	if lwm.Invalid() || hwm.Invalid() || hwm.LLSN < lwm.LLSN {
+		return types.InvalidGLSN, nil
+	}
+
+	if policy.MaxAge > 0 {
+		glsn, _, err := rr.SeekTime(ctx, tpid, lsid, now.Add(-policy.MaxAge))
+		if err != nil {
+			return types.InvalidGLSN, err
+		}
+		// The glsn is the first log entry to be kept.
+		if glsn > types.MinGLSN {
+			lastGLSN = glsn - 1
+		}
+		if lastGLSN > hwm.GLSN {
+			lastGLSN = hwm.GLSN
+		}
+	}
+
+	var llsn types.LLSN
+	numEntries := uint64(hwm.LLSN-lwm.LLSN) + 1
+	if policy.MaxEntries > 0 && numEntries > policy.MaxEntries {
+		llsn = hwm.LLSN - types.LLSN(policy.MaxEntries)
+	}
+	if policy.MaxBytes > 0 && lsrmd.LiveStorageSizeBytes > policy.MaxBytes {
+		size := lsrmd.LiveStorageSizeBytes
+		numTrim := uint64(math.Ceil(float64(size-policy.MaxBytes) / float64(size) * float64(numEntries)))
+		if numTrim < 1 {
+			numTrim = 1
+		} else if numTrim > numEntries {
+			numTrim = numEntries
+		}
+		if cand := lwm.LLSN + types.LLSN(numTrim) - 1; cand > llsn {
+			llsn = cand
+		}
+	}
+	if llsn >= lwm.LLSN {
+		le, err := rr.ReadWithLLSN(ctx, tpid, lsid, llsn)
+		if err != nil {
+			return types.InvalidGLSN, err
+		}
+		if le.GLSN > lastGLSN {
+			lastGLSN = le.GLSN
+		}
+	}
+	if lastGLSN < lwm.GLSN {
+		return types.InvalidGLSN, nil
+	}
+	return lastGLSN, nil
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/kakao/varlog/internal/admin/retention (interfaces: Trimmer)
+
+// Package retention is a generated GoMock package.
+package retention
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	types "github.com/kakao/varlog/pkg/types"
+)
+
+// MockTrimmer is a mock of Trimmer interface.
+type MockTrimmer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrimmerMockRecorder
+}
+
+// MockTrimmerMockRecorder is the mock recorder for MockTrimmer.
+type MockTrimmerMockRecorder struct {
+	mock *MockTrimmer
+}
+
+// NewMockTrimmer creates a new mock instance.
+func NewMockTrimmer(ctrl *gomock.Controller) *MockTrimmer {
+	mock := &MockTrimmer{ctrl: ctrl}
+	mock.recorder = &MockTrimmerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrimmer) EXPECT() *MockTrimmerMockRecorder {
+	return m.recorder
+}
+
+// TrimTopic mocks base method.
+func (m *MockTrimmer) TrimTopic(arg0 context.Context, arg1 types.TopicID, arg2 types.GLSN) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrimTopic", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrimTopic indicates an expected call of TrimTopic.
+func (mr *MockTrimmerMockRecorder) TrimTopic(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrimTopic", reflect.TypeOf((*MockTrimmer)(nil).TrimTopic), arg0, arg1, arg2)
+}
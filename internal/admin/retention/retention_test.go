@@ -0,0 +1,250 @@
+package retention
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/internal/admin/mrmanager"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func TestEnforcer_InvalidConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cmview := mrmanager.NewMockClusterMetadataView(ctrl)
+	trimmer := NewMockTrimmer(ctrl)
+
+	// no cmview
+	_, err := New(WithTrimmer(trimmer))
+	assert.Error(t, err)
+
+	// no trimmer
+	_, err = New(WithClusterMetadataView(cmview))
+	assert.Error(t, err)
+
+	// invalid check interval
+	_, err = New(
+		WithClusterMetadataView(cmview),
+		WithTrimmer(trimmer),
+		WithCheckInterval(0),
+	)
+	assert.Error(t, err)
+
+	// no logger
+	_, err = New(
+		WithClusterMetadataView(cmview),
+		WithTrimmer(trimmer),
+		WithLogger(nil),
+	)
+	assert.Error(t, err)
+}
+
+// testReplica is a log stream replica whose log entry with LLSN i has GLSN
+// 2*i and was committed at base+i seconds. It has log entries from LLSN first
+// to last.
+type testReplica struct {
+	base  time.Time
+	first types.LLSN
+	last  types.LLSN
+}
+
+var _ replicaReader = (*testReplica)(nil)
+
+func (r *testReplica) LogStreamReplicaMetadata(context.Context, types.TopicID, types.LogStreamID) (snpb.LogStreamReplicaMetadataDescriptor, error) {
+	panic("not implemented")
+}
+
+func (r *testReplica) SeekTime(_ context.Context, _ types.TopicID, _ types.LogStreamID, t time.Time) (types.GLSN, bool, error) {
+	secs := int64(t.Sub(r.base) / time.Second)
+	if t.After(r.base.Add(time.Duration(secs) * time.Second)) {
+		secs++
+	}
+	if secs < int64(r.first) {
+		secs = int64(r.first)
+	}
+	llsn := types.LLSN(secs)
+	if llsn > r.last {
+		return types.GLSN(2*r.last + 1), false, nil
+	}
+	return types.GLSN(2 * llsn), true, nil
+}
+
+func (r *testReplica) ReadWithLLSN(_ context.Context, _ types.TopicID, _ types.LogStreamID, llsn types.LLSN) (varlogpb.LogEntry, error) {
+	return varlogpb.LogEntry{
+		LogEntryMeta: varlogpb.LogEntryMeta{
+			LLSN: llsn,
+			GLSN: types.GLSN(2 * llsn),
+		},
+	}, nil
+}
+
+func TestTrimPoint(t *testing.T) {
+	base := time.Now()
+	rr := &testReplica{base: base, first: 11, last: 20}
+
+	// LLSNs from 11 to 20, 100 bytes in total excluding trimmed ones.
+	lsrmd := snpb.LogStreamReplicaMetadataDescriptor{
+		Status:               varlogpb.LogStreamStatusRunning,
+		LocalLowWatermark:    varlogpb.LogSequenceNumber{LLSN: 11, GLSN: 22},
+		LocalHighWatermark:   varlogpb.LogSequenceNumber{LLSN: 20, GLSN: 40},
+		StorageSizeBytes:     200,
+		LiveStorageSizeBytes: 100,
+	}
+	now := base.Add(20 * time.Second)
+
+	tcs := []struct {
+		name   string
+		policy varlogpb.RetentionPolicy
+		lsrmd  snpb.LogStreamReplicaMetadataDescriptor
+		now    time.Time
+		want   types.GLSN
+	}{
+		{
+			name:   "NoViolation",
+			policy: varlogpb.RetentionPolicy{MaxAge: time.Minute, MaxEntries: 10, MaxBytes: 100},
+			lsrmd:  lsrmd,
+			want:   types.InvalidGLSN,
+		},
+		{
+			name:   "Empty",
+			policy: varlogpb.RetentionPolicy{MaxEntries: 1},
+			lsrmd:  snpb.LogStreamReplicaMetadataDescriptor{},
+			want:   types.InvalidGLSN,
+		},
+		{
+			name:   "MaxAge",
+			policy: varlogpb.RetentionPolicy{MaxAge: 5 * time.Second},
+			lsrmd:  lsrmd,
+			// The first log entry to be kept has LLSN 15.
+			want: types.GLSN(2*15 - 1),
+		},
+		{
+			name:   "MaxAgeAll",
+			policy: varlogpb.RetentionPolicy{MaxAge: time.Second},
+			lsrmd:  lsrmd,
+			now:    now.Add(time.Minute),
+			want:   lsrmd.LocalHighWatermark.GLSN,
+		},
+		{
+			name:   "MaxEntries",
+			policy: varlogpb.RetentionPolicy{MaxEntries: 3},
+			lsrmd:  lsrmd,
+			want:   types.GLSN(2 * 17),
+		},
+		{
+			name:   "MaxBytes",
+			policy: varlogpb.RetentionPolicy{MaxBytes: 75},
+			lsrmd:  lsrmd,
+			want:   types.GLSN(2 * 13),
+		},
+		{
+			name:   "MaxBytesNoLiveSize",
+			policy: varlogpb.RetentionPolicy{MaxBytes: 75},
+			lsrmd: func() snpb.LogStreamReplicaMetadataDescriptor {
+				lsrmd := lsrmd
+				lsrmd.LiveStorageSizeBytes = 0
+				return lsrmd
+			}(),
+			want: types.InvalidGLSN,
+		},
+		{
+			name:   "Largest",
+			policy: varlogpb.RetentionPolicy{MaxAge: 8 * time.Second, MaxEntries: 6, MaxBytes: 75},
+			lsrmd:  lsrmd,
+			want:   types.GLSN(2 * 14),
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.now.IsZero() {
+				tc.now = now
+			}
+			got, err := trimPoint(context.Background(), rr, &tc.policy, tc.lsrmd, tc.now)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestEnforcer_IdleLogStream(t *testing.T) {
+	const tpid = types.TopicID(1)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	base := time.Now()
+	policy := &varlogpb.RetentionPolicy{MaxBytes: 75}
+	td := &varlogpb.TopicDescriptor{
+		TopicID:         tpid,
+		Status:          varlogpb.TopicStatusRunning,
+		LogStreams:      []types.LogStreamID{1, 2},
+		RetentionPolicy: policy,
+	}
+	md := &varlogpb.MetadataDescriptor{
+		Topics: []*varlogpb.TopicDescriptor{td},
+		LogStreams: []*varlogpb.LogStreamDescriptor{
+			{TopicID: tpid, LogStreamID: 1, Status: varlogpb.LogStreamStatusRunning},
+			{TopicID: tpid, LogStreamID: 2, Status: varlogpb.LogStreamStatusRunning},
+		},
+	}
+	replicas := map[types.LogStreamID]struct {
+		rr    *testReplica
+		lsrmd snpb.LogStreamReplicaMetadataDescriptor
+	}{
+		// The idle log stream has a single small log entry.
+		1: {
+			rr: &testReplica{base: base, first: 1, last: 1},
+			lsrmd: snpb.LogStreamReplicaMetadataDescriptor{
+				Status:               varlogpb.LogStreamStatusRunning,
+				GlobalHighWatermark:  40,
+				LocalLowWatermark:    varlogpb.LogSequenceNumber{LLSN: 1, GLSN: 2},
+				LocalHighWatermark:   varlogpb.LogSequenceNumber{LLSN: 1, GLSN: 2},
+				LiveStorageSizeBytes: 10,
+			},
+		},
+		// The oversized log stream has LLSNs from 11 to 20.
+		2: {
+			rr: &testReplica{base: base, first: 11, last: 20},
+			lsrmd: snpb.LogStreamReplicaMetadataDescriptor{
+				Status:               varlogpb.LogStreamStatusRunning,
+				GlobalHighWatermark:  40,
+				LocalLowWatermark:    varlogpb.LogSequenceNumber{LLSN: 11, GLSN: 22},
+				LocalHighWatermark:   varlogpb.LogSequenceNumber{LLSN: 20, GLSN: 40},
+				LiveStorageSizeBytes: 100,
+			},
+		},
+	}
+
+	trimmer := NewMockTrimmer(ctrl)
+	e, err := New(
+		WithClusterMetadataView(mrmanager.NewMockClusterMetadataView(ctrl)),
+		WithTrimmer(trimmer),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, e.Stop())
+	}()
+	e.getReplica = func(_ context.Context, _ *varlogpb.MetadataDescriptor, lsd *varlogpb.LogStreamDescriptor) (replicaReader, snpb.LogStreamReplicaMetadataDescriptor, error) {
+		r := replicas[lsd.LogStreamID]
+		return r.rr, r.lsrmd, nil
+	}
+
+	// The idle log stream does not keep the oversized one from being
+	// trimmed.
+	trimmer.EXPECT().TrimTopic(gomock.Any(), tpid, types.GLSN(2*13)).Return(nil)
+	require.NoError(t, e.enforceTopic(context.Background(), md, td, base.Add(20*time.Second)))
+
+	// Neither log stream violates the policy.
+	policy.MaxBytes = 100
+	require.NoError(t, e.enforceTopic(context.Background(), md, td, base.Add(20*time.Second)))
+}
@@ -82,6 +82,14 @@ func (s *server) UnregisterTopic(ctx context.Context, req *admpb.UnregisterTopic
 	return &admpb.UnregisterTopicResponse{}, nil
 }
 
+func (s *server) SetRetentionPolicy(ctx context.Context, req *admpb.SetRetentionPolicyRequest) (*admpb.SetRetentionPolicyResponse, error) {
+	td, err := s.admin.setRetentionPolicy(ctx, req.TopicID, req.RetentionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return &admpb.SetRetentionPolicyResponse{Topic: td}, nil
+}
+
 func (s *server) GetLogStream(ctx context.Context, req *admpb.GetLogStreamRequest) (*admpb.GetLogStreamResponse, error) {
 	lsd, err := s.admin.getLogStream(ctx, req.TopicID, req.LogStreamID)
 	return &admpb.GetLogStreamResponse{LogStream: lsd}, err
@@ -199,3 +207,39 @@ func (s *server) Trim(ctx context.Context, req *admpb.TrimRequest) (*admpb.TrimR
 	res, err := s.admin.trim(ctx, req.TopicID, req.LastGLSN)
 	return &admpb.TrimResponse{Results: res}, verrors.ToStatusError(err)
 }
+
+func (s *server) Backup(ctx context.Context, req *admpb.BackupRequest) (*admpb.BackupResponse, error) {
+	manifest, err := s.admin.backup(ctx, req.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &admpb.BackupResponse{Manifest: *manifest}, nil
+}
+
+func (s *server) ConsumerGroupHeartbeat(ctx context.Context, req *admpb.ConsumerGroupHeartbeatRequest) (*admpb.ConsumerGroupHeartbeatResponse, error) {
+	asg, err := s.admin.consumerGroupHeartbeat(ctx, req.Group, req.TopicID, req.MemberID, req.Generation, req.OwnedLogStreamIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &admpb.ConsumerGroupHeartbeatResponse{
+		Generation:     asg.Generation,
+		LogStreamIDs:   asg.LogStreamIDs,
+		SessionTimeout: asg.SessionTimeout,
+	}, nil
+}
+
+func (s *server) LeaveConsumerGroup(_ context.Context, req *admpb.LeaveConsumerGroupRequest) (*admpb.LeaveConsumerGroupResponse, error) {
+	err := s.admin.leaveConsumerGroup(req.Group, req.TopicID, req.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	return &admpb.LeaveConsumerGroupResponse{}, nil
+}
+
+func (s *server) CommitConsumerGroupOffset(ctx context.Context, req *admpb.CommitConsumerGroupOffsetRequest) (*admpb.CommitConsumerGroupOffsetResponse, error) {
+	err := s.admin.commitConsumerGroupOffset(ctx, req.Group, req.TopicID, req.MemberID, req.Generation, req.LogStreamID, req.LLSN)
+	if err != nil {
+		return nil, err
+	}
+	return &admpb.CommitConsumerGroupOffsetResponse{}, nil
+}
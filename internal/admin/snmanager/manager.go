@@ -59,6 +59,17 @@ type StorageNodeManager interface {
 
 	Trim(ctx context.Context, topicID types.TopicID, lastGLSN types.GLSN) ([]admpb.TrimResult, error)
 
+	// Checkpoint copies the log stream replica in the storage node whose ID
+	// is the argument snid into the directory path at the commit result. It
+	// returns the absolute path of the copy. Segments in the cold tier are
+	// copied under the prefix coldTierPrefix.
+	Checkpoint(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID, path, coldTierPrefix string, commitResult snpb.LogStreamCommitResult) (string, error)
+
+	// CheckBackupPath checks whether the storage node whose ID is the
+	// argument snid can access the backup directory path having the probe
+	// file with the token.
+	CheckBackupPath(ctx context.Context, snid types.StorageNodeID, path, token string) error
+
 	Close() error
 }
 
@@ -401,6 +412,24 @@ func (sm *snManager) Trim(ctx context.Context, tpid types.TopicID, lastGLSN type
 	return results, err
 }
 
+func (sm *snManager) Checkpoint(ctx context.Context, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID, path, coldTierPrefix string, commitResult snpb.LogStreamCommitResult) (string, error) {
+	cli, err := sm.clients.Get(snid)
+	if err != nil {
+		sm.refresh(ctx) //nolint:errcheck,revive // TODO:: Handle an error returned.
+		return "", errors.Wrap(verrors.ErrNotExist, "storage node")
+	}
+	return cli.Checkpoint(ctx, tpid, lsid, path, coldTierPrefix, commitResult)
+}
+
+func (sm *snManager) CheckBackupPath(ctx context.Context, snid types.StorageNodeID, path, token string) error {
+	cli, err := sm.clients.Get(snid)
+	if err != nil {
+		sm.refresh(ctx) //nolint:errcheck,revive // TODO:: Handle an error returned.
+		return errors.Wrap(verrors.ErrNotExist, "storage node")
+	}
+	return cli.CheckBackupPath(ctx, path, token)
+}
+
 func (sm *snManager) replicaDescriptors(ctx context.Context, lsid types.LogStreamID) ([]*varlogpb.ReplicaDescriptor, error) {
 	clusmeta, err := sm.cmview.ClusterMetadata(ctx)
 	if err != nil {
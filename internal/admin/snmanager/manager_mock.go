@@ -81,6 +81,35 @@ func (mr *MockStorageNodeManagerMockRecorder) AddStorageNode(arg0, arg1, arg2 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddStorageNode", reflect.TypeOf((*MockStorageNodeManager)(nil).AddStorageNode), arg0, arg1, arg2)
 }
 
+// CheckBackupPath mocks base method.
+func (m *MockStorageNodeManager) CheckBackupPath(arg0 context.Context, arg1 types.StorageNodeID, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckBackupPath", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckBackupPath indicates an expected call of CheckBackupPath.
+func (mr *MockStorageNodeManagerMockRecorder) CheckBackupPath(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBackupPath", reflect.TypeOf((*MockStorageNodeManager)(nil).CheckBackupPath), arg0, arg1, arg2, arg3)
+}
+
+// Checkpoint mocks base method.
+func (m *MockStorageNodeManager) Checkpoint(arg0 context.Context, arg1 types.StorageNodeID, arg2 types.TopicID, arg3 types.LogStreamID, arg4, arg5 string, arg6 snpb.LogStreamCommitResult) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkpoint", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkpoint indicates an expected call of Checkpoint.
+func (mr *MockStorageNodeManagerMockRecorder) Checkpoint(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkpoint", reflect.TypeOf((*MockStorageNodeManager)(nil).Checkpoint), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
 // Close mocks base method.
 func (m *MockStorageNodeManager) Close() error {
 	m.ctrl.T.Helper()
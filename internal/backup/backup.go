@@ -0,0 +1,275 @@
+// Package backup defines the layout of a point-in-time backup of a cluster.
+//
+// A backup directory has the manifest, the state machine of the metadata
+// repository, and copies of all log stream replicas:
+//
+//	<backup>/MANIFEST.json
+//	<backup>/metadata_repository.snap
+//	<backup>/replicas/cid_<cid>_snid_<snid>/tpid_<tpid>_lsid_<lsid>/
+//
+// The admin server writes a backup, and the metadata repository and storage
+// nodes restore themselves from it when they start. Since storage nodes write
+// copies of their replicas into the backup directory, it must be in a file
+// system shared by the admin server and all storage nodes. The admin server
+// checks it by asking storage nodes to read a probe file before copying.
+//
+// If the cold tier is enabled, segments of each copy are copied into the cold
+// tier under a prefix owned by the backup, see ColdTierPrefix.
+package backup
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"go.uber.org/multierr"
+
+	"github.com/kakao/varlog/internal/storagenode/volume"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/admpb"
+	"github.com/kakao/varlog/proto/mrpb"
+)
+
+const (
+	// ManifestFile is the name of the manifest in the backup directory.
+	ManifestFile = "MANIFEST.json"
+	// MetadataRepositoryFile is the name of the file having the state
+	// machine of the metadata repository in the backup directory.
+	MetadataRepositoryFile = "metadata_repository.snap"
+	// ProbeFile is the name of the file in the backup directory to check
+	// whether storage nodes can access the directory. It is removed before
+	// the manifest is written.
+	ProbeFile = "PROBE"
+
+	replicasDir = "replicas"
+	fileMode    = 0o644
+)
+
+// ReplicaPath returns the directory of the copy of the log stream replica
+// relative to the backup directory.
+func ReplicaPath(cid types.ClusterID, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID) string {
+	return filepath.Join(replicasDir, volume.StorageNodeDirName(cid, snid), volume.LogStreamDirName(tpid, lsid))
+}
+
+// ColdTierPrefix returns the prefix in the cold tier for segments of the copy
+// of the log stream replica in the backup identified by the argument id.
+func ColdTierPrefix(id string, cid types.ClusterID, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID) string {
+	return path.Join("backup", id, volume.StorageNodeDirName(cid, snid), volume.LogStreamDirName(tpid, lsid))
+}
+
+// WriteProbe writes the probe file having a random token into the backup
+// directory dir. It returns the token, which also identifies the backup.
+func WriteProbe(dir string) (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(buf)
+	if err := writeFile(filepath.Join(dir, ProbeFile), []byte(token)); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// CheckProbe checks whether the backup directory dir has the probe file with
+// the token and whether the caller can write files into the directory.
+func CheckProbe(dir, token string) error {
+	buf, err := os.ReadFile(filepath.Join(dir, ProbeFile))
+	if err != nil {
+		return fmt.Errorf("backup: probe: %w", err)
+	}
+	if string(buf) != token {
+		return fmt.Errorf("backup: probe: token mismatch in %s", dir)
+	}
+	f, err := os.CreateTemp(dir, "."+ProbeFile+"-")
+	if err != nil {
+		return fmt.Errorf("backup: probe: %w", err)
+	}
+	if err := multierr.Append(f.Close(), os.Remove(f.Name())); err != nil {
+		return fmt.Errorf("backup: probe: %w", err)
+	}
+	return nil
+}
+
+// RemoveProbe removes the probe file from the backup directory dir.
+func RemoveProbe(dir string) error {
+	return os.Remove(filepath.Join(dir, ProbeFile))
+}
+
+// WriteManifest writes the manifest into the backup directory dir. Since the
+// manifest is written last, a backup directory without it is incomplete.
+func WriteManifest(dir string, manifest *admpb.BackupManifest) error {
+	buf, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFile(filepath.Join(dir, ManifestFile), buf)
+}
+
+// ReadManifest reads the manifest from the backup directory dir.
+func ReadManifest(dir string) (*admpb.BackupManifest, error) {
+	buf, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("backup: manifest: %w", err)
+	}
+	manifest := &admpb.BackupManifest{}
+	if err := json.Unmarshal(buf, manifest); err != nil {
+		return nil, fmt.Errorf("backup: manifest: %w", err)
+	}
+	return manifest, nil
+}
+
+// WriteStateMachine writes the state machine of the metadata repository into
+// the backup directory dir.
+func WriteStateMachine(dir string, sm *mrpb.MetadataRepositoryDescriptor) error {
+	buf, err := sm.Marshal()
+	if err != nil {
+		return err
+	}
+	return writeFile(filepath.Join(dir, MetadataRepositoryFile), buf)
+}
+
+// ReadStateMachine reads the state machine of the metadata repository from
+// the backup directory dir described by the manifest.
+func ReadStateMachine(dir string, manifest *admpb.BackupManifest) (*mrpb.MetadataRepositoryDescriptor, error) {
+	buf, err := os.ReadFile(filepath.Join(dir, manifest.MetadataRepository))
+	if err != nil {
+		return nil, fmt.Errorf("backup: state machine: %w", err)
+	}
+	sm := &mrpb.MetadataRepositoryDescriptor{}
+	if err := sm.Unmarshal(buf); err != nil {
+		return nil, fmt.Errorf("backup: state machine: %w", err)
+	}
+	if ver := sm.GetLastCommitResults().GetVersion(); ver != manifest.Version {
+		return nil, fmt.Errorf("backup: state machine: version %d, expected %d", ver, manifest.Version)
+	}
+	return sm, nil
+}
+
+// RestoreReplicas copies replicas of the storage node snid in the backup
+// directory dir into the first one of the storage node paths snPaths, for
+// instance, "/volume/cid_1_snid_1". It skips replicas whose directories
+// already exist in any of snPaths, so calling it again after restoring is
+// harmless. It returns the number of restored replicas.
+//
+// The function prepare is called with each copy before the copy is moved to
+// the storage node path, for instance, to copy its segments in the cold tier.
+// If it fails, the copy is discarded.
+func RestoreReplicas(dir string, manifest *admpb.BackupManifest, snid types.StorageNodeID, snPaths []string, prepare func(replica admpb.BackupReplica, dir string) error) (restored int, err error) {
+	if len(snPaths) == 0 {
+		return 0, errors.New("backup: restore: no storage node path")
+	}
+	for _, replica := range manifest.Replicas {
+		if replica.StorageNodeID != snid {
+			continue
+		}
+		lsDirName := volume.LogStreamDirName(replica.TopicID, replica.LogStreamID)
+		exist, err := existsIn(snPaths, lsDirName)
+		if err != nil {
+			return restored, err
+		}
+		if exist {
+			continue
+		}
+		err = copyDir(filepath.Join(dir, replica.Path), filepath.Join(snPaths[0], lsDirName), func(tmp string) error {
+			return prepare(replica, tmp)
+		})
+		if err != nil {
+			return restored, fmt.Errorf("backup: restore %s: %w", replica.Path, err)
+		}
+		restored++
+	}
+	return restored, nil
+}
+
+func existsIn(dirs []string, name string) (bool, error) {
+	for _, dir := range dirs {
+		_, err := os.Stat(filepath.Join(dir, name))
+		if err == nil {
+			return true, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// copyDir copies the directory src into dst. It copies into a temporary
+// directory, calls the function prepare with it, and renames it to dst to
+// avoid leaving a partial copy. A temporary directory left by a crash is
+// removed when copying again.
+func copyDir(src, dst string, prepare func(tmp string) error) (err error) {
+	tmp := dst + ".restoring"
+	if err := os.RemoveAll(tmp); err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.RemoveAll(tmp)
+		}
+	}()
+	err = filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(tmp, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, os.ModePerm)
+		}
+		return copyFile(path, target)
+	})
+	if err != nil {
+		return err
+	}
+	if err := prepare(tmp); err != nil {
+		return err
+	}
+	return os.Rename(tmp, dst)
+}
+
+func copyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, in.Close())
+	}()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, fileMode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, out.Close())
+	}()
+	if _, err := io.Copy(out, in); err != nil {
+		return err
+	}
+	return out.Sync()
+}
+
+func writeFile(name string, buf []byte) (err error) {
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, fileMode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, f.Close())
+	}()
+	if _, err := f.Write(buf); err != nil {
+		return err
+	}
+	return f.Sync()
+}
@@ -0,0 +1,159 @@
+package backup
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/internal/storagenode/volume"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/admpb"
+	"github.com/kakao/varlog/proto/mrpb"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func TestBackup_ManifestStateMachine(t *testing.T) {
+	const (
+		cid  = types.ClusterID(1)
+		snid = types.StorageNodeID(2)
+		tpid = types.TopicID(3)
+		lsid = types.LogStreamID(4)
+		ver  = types.Version(5)
+	)
+	dir := t.TempDir()
+
+	sm := &mrpb.MetadataRepositoryDescriptor{
+		Metadata: &varlogpb.MetadataDescriptor{
+			Topics: []*varlogpb.TopicDescriptor{{TopicID: tpid}},
+		},
+		LogStream: &mrpb.MetadataRepositoryDescriptor_LogStreamDescriptor{
+			CommitHistory: []*mrpb.LogStreamCommitResults{{Version: ver}},
+		},
+	}
+	require.NoError(t, WriteStateMachine(dir, sm))
+
+	commitResult := snpb.LogStreamCommitResult{
+		TopicID:             tpid,
+		LogStreamID:         lsid,
+		CommittedLLSNOffset: 1,
+		CommittedGLSNOffset: 10,
+		CommittedGLSNLength: 3,
+		Version:             ver,
+		HighWatermark:       12,
+	}
+	manifest := &admpb.BackupManifest{
+		ClusterID:          cid,
+		Version:            ver,
+		AppliedIndex:       100,
+		CreateTime:         time.Now().UTC().Round(time.Millisecond),
+		MetadataRepository: MetadataRepositoryFile,
+		Replicas: []admpb.BackupReplica{{
+			StorageNodeID: snid,
+			TopicID:       tpid,
+			LogStreamID:   lsid,
+			Path:          ReplicaPath(cid, snid, tpid, lsid),
+			CommitResult:  commitResult,
+		}},
+	}
+	require.NoError(t, WriteManifest(dir, manifest))
+	require.Error(t, WriteManifest(dir, manifest))
+
+	got, err := ReadManifest(dir)
+	require.NoError(t, err)
+	require.Equal(t, manifest, got)
+
+	gotSM, err := ReadStateMachine(dir, got)
+	require.NoError(t, err)
+	require.Equal(t, sm, gotSM)
+
+	got.Version++
+	_, err = ReadStateMachine(dir, got)
+	require.Error(t, err)
+
+	_, err = ReadManifest(t.TempDir())
+	require.Error(t, err)
+}
+
+func TestBackup_RestoreReplicas(t *testing.T) {
+	const cid = types.ClusterID(1)
+	dir := t.TempDir()
+
+	manifest := &admpb.BackupManifest{ClusterID: cid}
+	for snid := types.StorageNodeID(1); snid <= 2; snid++ {
+		for lsid := types.LogStreamID(1); lsid <= 2; lsid++ {
+			replica := admpb.BackupReplica{
+				StorageNodeID: snid,
+				TopicID:       1,
+				LogStreamID:   lsid,
+				Path:          ReplicaPath(cid, snid, 1, lsid),
+			}
+			manifest.Replicas = append(manifest.Replicas, replica)
+			src := filepath.Join(dir, replica.Path)
+			require.NoError(t, os.MkdirAll(src, os.ModePerm))
+			require.NoError(t, os.WriteFile(filepath.Join(src, "data"), []byte(replica.Path), fileMode))
+		}
+	}
+
+	snPaths := []string{
+		filepath.Join(t.TempDir(), volume.StorageNodeDirName(cid, 1)),
+		filepath.Join(t.TempDir(), volume.StorageNodeDirName(cid, 1)),
+	}
+	for _, snPath := range snPaths {
+		require.NoError(t, os.MkdirAll(snPath, os.ModePerm))
+	}
+	// The replica of the log stream 2 already exists in the second path.
+	require.NoError(t, os.Mkdir(filepath.Join(snPaths[1], volume.LogStreamDirName(1, 2)), os.ModePerm))
+
+	// A copy failed to prepare is discarded.
+	_, err := RestoreReplicas(dir, manifest, 1, snPaths, func(admpb.BackupReplica, string) error {
+		return errors.New("prepare")
+	})
+	require.Error(t, err)
+	require.NoDirExists(t, filepath.Join(snPaths[0], volume.LogStreamDirName(1, 1)))
+
+	var prepared []types.LogStreamID
+	prepare := func(replica admpb.BackupReplica, dir string) error {
+		require.FileExists(t, filepath.Join(dir, "data"))
+		prepared = append(prepared, replica.LogStreamID)
+		return nil
+	}
+	restored, err := RestoreReplicas(dir, manifest, 1, snPaths, prepare)
+	require.NoError(t, err)
+	require.Equal(t, 1, restored)
+	require.Equal(t, []types.LogStreamID{1}, prepared)
+
+	data, err := os.ReadFile(filepath.Join(snPaths[0], volume.LogStreamDirName(1, 1), "data"))
+	require.NoError(t, err)
+	require.Equal(t, ReplicaPath(cid, 1, 1, 1), string(data))
+	require.NoDirExists(t, filepath.Join(snPaths[0], volume.LogStreamDirName(1, 2)))
+
+	// Restoring again does nothing.
+	restored, err = RestoreReplicas(dir, manifest, 1, snPaths, prepare)
+	require.NoError(t, err)
+	require.Zero(t, restored)
+
+	_, err = RestoreReplicas(dir, manifest, 1, nil, prepare)
+	require.Error(t, err)
+}
+
+func TestBackup_Probe(t *testing.T) {
+	dir := t.TempDir()
+	token, err := WriteProbe(dir)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+
+	require.NoError(t, CheckProbe(dir, token))
+	require.Error(t, CheckProbe(dir, token+"x"))
+	require.Error(t, CheckProbe(t.TempDir(), token))
+
+	require.NoError(t, RemoveProbe(dir))
+	require.Error(t, CheckProbe(dir, token))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
@@ -121,6 +121,10 @@ func (loader *Loader) Close() error {
 }
 
 func (loader *Loader) appendLoop(ctx context.Context, c varlog.Log) error {
+	if loader.PipelineSize > 0 {
+		return loader.appendLoopAsync(ctx, c)
+	}
+
 	begin := true
 	var am AppendMetrics
 	for {
@@ -167,6 +171,79 @@ func (loader *Loader) appendLoop(ctx context.Context, c varlog.Log) error {
 	}
 }
 
+// appendLoopAsync appends log entries through a varlog.Batcher, which sends
+// batches of BatchSize without waiting for the results of previous ones up to
+// PipelineSize.
+func (loader *Loader) appendLoopAsync(ctx context.Context, c varlog.Log) error {
+	opts := []varlog.BatcherOption{
+		varlog.WithBatcherMaxRecords(int(loader.BatchSize)),
+		varlog.WithBatcherPipelineSize(int(loader.PipelineSize)),
+	}
+	if !loader.LogStreamID.Invalid() {
+		opts = append(opts, varlog.WithBatcherLogStream(loader.LogStreamID))
+	}
+	b, err := c.NewBatcher(loader.TopicID, opts...)
+	if err != nil {
+		return fmt.Errorf("append: %w", err)
+	}
+	defer func() {
+		_ = b.Close()
+	}()
+
+	var (
+		begin = true
+		am    AppendMetrics
+		cnt   uint
+		ts    = time.Now()
+		errC  = make(chan error, 1)
+	)
+	// Callbacks of the batcher are not called concurrently.
+	callback := func(meta varlogpb.LogEntryMeta, err error) {
+		if err != nil {
+			select {
+			case errC <- err:
+			default:
+			}
+			return
+		}
+		if begin {
+			loader.begin.ch <- varlogpb.LogSequenceNumber{
+				LLSN: meta.LLSN,
+				GLSN: meta.GLSN,
+			}
+			begin = false
+		}
+		cnt++
+		if cnt < loader.BatchSize {
+			return
+		}
+		cnt = 0
+		now := time.Now()
+		am.bytes += int64(loader.BatchSize * loader.MessageSize)
+		am.requests++
+		am.durationMS += now.Sub(ts).Milliseconds()
+		ts = now
+		if loader.metrics.ReportAppendMetrics(am) {
+			am = AppendMetrics{}
+		}
+	}
+
+	msg := loader.batch[0]
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errC:
+			return fmt.Errorf("append: %w", err)
+		default:
+		}
+
+		if err := b.AppendAsync(msg, nil, callback); err != nil {
+			return fmt.Errorf("append: %w", err)
+		}
+	}
+}
+
 func (loader *Loader) subscribeLoop(ctx context.Context, c varlog.Log) error {
 	var sm SubscribeMetrics
 	if loader.LogStreamID.Invalid() {
@@ -14,6 +14,10 @@ type Target struct {
 	BatchSize        uint
 	AppendersCount   uint
 	SubscribersCount uint
+	// PipelineSize is the number of batches that each appender sends
+	// without waiting for their results. If it is zero, each appender
+	// waits for the result of a batch before sending the next one.
+	PipelineSize uint
 }
 
 func (tgt Target) Valid() error {
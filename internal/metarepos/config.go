@@ -12,6 +12,7 @@ import (
 
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/netutil"
+	"github.com/kakao/varlog/proto/mrpb"
 )
 
 const (
@@ -85,6 +86,7 @@ type config struct {
 	maxLogStreamsCountPerTopic     int32
 	telemetryCollectorName         string
 	telemetryCollectorEndpoint     string
+	restoreStateMachine            *mrpb.MetadataRepositoryDescriptor
 	logger                         *zap.Logger
 }
 
@@ -364,6 +366,15 @@ func WithTelemetryCollectorEndpoint(telemetryCollectorEndpoint string) Option {
 	})
 }
 
+// WithRestoreStateMachine makes a new cluster start from the state machine,
+// for instance, read from a backup. The state machine is restored only if the
+// cluster is empty.
+func WithRestoreStateMachine(stateMachine *mrpb.MetadataRepositoryDescriptor) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.restoreStateMachine = stateMachine
+	})
+}
+
 func WithLogger(logger *zap.Logger) Option {
 	return newFuncOption(func(cfg *config) {
 		cfg.logger = logger
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -70,6 +71,22 @@ func (rc *EmptyStorageNodeClient) Trim(context.Context, types.TopicID, types.GLS
 	panic("not implemented")
 }
 
+func (rc *EmptyStorageNodeClient) Checkpoint(context.Context, types.TopicID, types.LogStreamID, string, string, snpb.LogStreamCommitResult) (string, error) {
+	panic("not implemented")
+}
+
+func (rc *EmptyStorageNodeClient) CheckBackupPath(context.Context, string, string) error {
+	panic("not implemented")
+}
+
+func (rc *EmptyStorageNodeClient) Export(context.Context, types.TopicID, types.LogStreamID, io.Writer) error {
+	panic("not implemented")
+}
+
+func (rc *EmptyStorageNodeClient) Import(context.Context, types.TopicID, types.LogStreamID, string, io.Reader) (snpb.LogStreamReplicaMetadataDescriptor, error) {
+	panic("not implemented")
+}
+
 type EmptyStorageNodeClientFactory struct {
 }
 
@@ -514,3 +531,19 @@ func (r *DummyStorageNodeClient) Sync(context.Context, types.TopicID, types.LogS
 func (r *DummyStorageNodeClient) Trim(context.Context, types.TopicID, types.GLSN) (map[types.LogStreamID]error, error) {
 	panic("not implemented")
 }
+
+func (r *DummyStorageNodeClient) Checkpoint(context.Context, types.TopicID, types.LogStreamID, string, string, snpb.LogStreamCommitResult) (string, error) {
+	panic("not implemented")
+}
+
+func (r *DummyStorageNodeClient) CheckBackupPath(context.Context, string, string) error {
+	panic("not implemented")
+}
+
+func (r *DummyStorageNodeClient) Export(context.Context, types.TopicID, types.LogStreamID, io.Writer) error {
+	panic("not implemented")
+}
+
+func (r *DummyStorageNodeClient) Import(context.Context, types.TopicID, types.LogStreamID, string, io.Reader) (snpb.LogStreamReplicaMetadataDescriptor, error) {
+	panic("not implemented")
+}
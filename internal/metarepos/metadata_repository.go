@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/mrpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
 
@@ -16,7 +17,14 @@ type MetadataRepository interface {
 	UnregisterLogStream(context.Context, types.LogStreamID) error
 	UpdateLogStream(context.Context, *varlogpb.LogStreamDescriptor) error
 	GetMetadata(context.Context) (*varlogpb.MetadataDescriptor, error)
+	WatchMetadata(ctx context.Context, appliedIndex uint64, send func(*varlogpb.MetadataDescriptor) error) error
 	Seal(context.Context, types.LogStreamID) (types.GLSN, error)
 	Unseal(context.Context, types.LogStreamID) error
+	SetRetentionPolicy(ctx context.Context, topicID types.TopicID, policy *varlogpb.RetentionPolicy) error
+	CommitOffset(ctx context.Context, group string, offset mrpb.ConsumerGroupOffset) error
+	FetchOffset(ctx context.Context, group string, topicID types.TopicID, logStreamID types.LogStreamID) (mrpb.ConsumerGroupOffset, error)
+	DeleteConsumerGroup(ctx context.Context, group string) error
+	GetHighWatermark(ctx context.Context, topicID types.TopicID) (types.GLSN, error)
+	Backup(ctx context.Context) (*mrpb.BackupResponse, error)
 	Close() error
 }
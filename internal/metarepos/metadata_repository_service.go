@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/kakao/varlog/proto/mrpb"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 type MetadataRepositoryService struct {
@@ -67,6 +68,12 @@ func (s *MetadataRepositoryService) GetMetadata(ctx context.Context, req *mrpb.G
 	}, err
 }
 
+func (s *MetadataRepositoryService) WatchMetadata(req *mrpb.WatchMetadataRequest, stream mrpb.MetadataRepositoryService_WatchMetadataServer) error {
+	return s.metaRepos.WatchMetadata(stream.Context(), req.AppliedIndex, func(metadata *varlogpb.MetadataDescriptor) error {
+		return stream.Send(&mrpb.WatchMetadataResponse{Metadata: metadata})
+	})
+}
+
 func (s *MetadataRepositoryService) Seal(ctx context.Context, req *mrpb.SealRequest) (*mrpb.SealResponse, error) {
 	lastCommittedGLSN, err := s.metaRepos.Seal(ctx, req.GetLogStreamID())
 	return &mrpb.SealResponse{LastCommittedGLSN: lastCommittedGLSN}, err
@@ -76,3 +83,32 @@ func (s *MetadataRepositoryService) Unseal(ctx context.Context, req *mrpb.Unseal
 	err := s.metaRepos.Unseal(ctx, req.GetLogStreamID())
 	return &mrpb.UnsealResponse{}, err
 }
+
+func (s *MetadataRepositoryService) SetRetentionPolicy(ctx context.Context, req *mrpb.SetRetentionPolicyRequest) (*types.Empty, error) {
+	err := s.metaRepos.SetRetentionPolicy(ctx, req.TopicID, req.RetentionPolicy)
+	return &types.Empty{}, err
+}
+
+func (s *MetadataRepositoryService) CommitOffset(ctx context.Context, req *mrpb.CommitOffsetRequest) (*types.Empty, error) {
+	err := s.metaRepos.CommitOffset(ctx, req.Group, req.Offset)
+	return &types.Empty{}, err
+}
+
+func (s *MetadataRepositoryService) FetchOffset(ctx context.Context, req *mrpb.FetchOffsetRequest) (*mrpb.FetchOffsetResponse, error) {
+	offset, err := s.metaRepos.FetchOffset(ctx, req.Group, req.TopicID, req.LogStreamID)
+	return &mrpb.FetchOffsetResponse{Offset: offset}, err
+}
+
+func (s *MetadataRepositoryService) DeleteConsumerGroup(ctx context.Context, req *mrpb.DeleteConsumerGroupRequest) (*types.Empty, error) {
+	err := s.metaRepos.DeleteConsumerGroup(ctx, req.Group)
+	return &types.Empty{}, err
+}
+
+func (s *MetadataRepositoryService) GetHighWatermark(ctx context.Context, req *mrpb.GetHighWatermarkRequest) (*mrpb.GetHighWatermarkResponse, error) {
+	hwm, err := s.metaRepos.GetHighWatermark(ctx, req.TopicID)
+	return &mrpb.GetHighWatermarkResponse{HighWatermark: hwm}, err
+}
+
+func (s *MetadataRepositoryService) Backup(ctx context.Context, _ *mrpb.BackupRequest) (*mrpb.BackupResponse, error) {
+	return s.metaRepos.Backup(ctx)
+}
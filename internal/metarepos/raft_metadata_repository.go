@@ -60,6 +60,8 @@ type RaftMetadataRepository struct {
 	// for ack
 	requestNum uint64
 	requestMap sync.Map
+	// backupMap has channels to receive backups proposed by this node.
+	backupMap sync.Map
 
 	// for raft
 	proposeC      chan *mrpb.RaftEntry
@@ -194,6 +196,12 @@ func (mr *RaftMetadataRepository) Run() {
 			mr.logger.Panic("could not run", zap.Error(err))
 		}
 
+		if mr.restoreStateMachine != nil {
+			if err := mr.runner.RunC(mctx, mr.restore); err != nil {
+				mr.logger.Panic("could not run", zap.Error(err))
+			}
+		}
+
 		mr.logger.Info("listening", zap.String("address", mr.rpcAddr))
 		lis, err := netutil.NewStoppableListener(mctx, mr.rpcAddr)
 		if err != nil {
@@ -590,6 +598,18 @@ func (mr *RaftMetadataRepository) apply(c *committedEntry) {
 			mr.applyRemovePeer(r, c.confState, e.AppliedIndex)
 		case *mrpb.Endpoint:
 			mr.applyEndpoint(r, e.NodeIndex, e.RequestIndex)
+		case *mrpb.CommitOffset:
+			mr.applyCommitOffset(r, e.NodeIndex, e.RequestIndex)
+		case *mrpb.SetRetentionPolicy:
+			mr.applySetRetentionPolicy(r, e.NodeIndex, e.RequestIndex)
+		case *mrpb.RecoverStateMachine:
+			mr.applyRecoverStateMachine(r, e.NodeIndex, e.RequestIndex, e.AppliedIndex)
+		case *mrpb.Backup:
+			mr.applyBackup(r, e.NodeIndex, e.RequestIndex, e.AppliedIndex)
+		case *mrpb.DeleteConsumerGroup:
+			mr.applyDeleteConsumerGroup(r, e.NodeIndex, e.RequestIndex)
+		case *mrpb.ReadBarrier:
+			mr.sendAck(e.NodeIndex, e.RequestIndex, nil)
 		}
 
 		mr.storage.UpdateAppliedIndex(e.AppliedIndex)
@@ -670,6 +690,71 @@ UnregisterLS:
 	return nil
 }
 
+func (mr *RaftMetadataRepository) applyCommitOffset(r *mrpb.CommitOffset, nodeIndex, requestIndex uint64) error {
+	return mr.storage.CommitOffset(r.Group, r.Offset, nodeIndex, requestIndex)
+}
+
+func (mr *RaftMetadataRepository) applyDeleteConsumerGroup(r *mrpb.DeleteConsumerGroup, nodeIndex, requestIndex uint64) error {
+	return mr.storage.DeleteConsumerGroup(r.Group, nodeIndex, requestIndex)
+}
+
+func (mr *RaftMetadataRepository) applySetRetentionPolicy(r *mrpb.SetRetentionPolicy, nodeIndex, requestIndex uint64) error {
+	return mr.storage.SetRetentionPolicy(r.TopicID, r.RetentionPolicy, nodeIndex, requestIndex)
+}
+
+// applyRecoverStateMachine replaces the state machine with the one in the
+// request, for instance, restored from a backup. It is applied only to an
+// empty state machine to keep a restore from overwriting a running cluster.
+func (mr *RaftMetadataRepository) applyRecoverStateMachine(r *mrpb.RecoverStateMachine, nodeIndex, requestIndex, appliedIndex uint64) error {
+	if r.StateMachine == nil || !mr.storage.IsEmpty() {
+		mr.sendAck(nodeIndex, requestIndex, verrors.ErrExist)
+		return verrors.ErrExist
+	}
+
+	mr.reportCollector.Reset()
+
+	err := mr.storage.RecoverStateMachine(r.StateMachine, appliedIndex, nodeIndex, requestIndex)
+	if err != nil {
+		return err
+	}
+	mr.topicEndPos = make(map[types.TopicID]int)
+
+	err = mr.reportCollector.Recover(
+		mr.storage.GetStorageNodes(),
+		mr.storage.GetLogStreams(),
+		mr.storage.GetFirstCommitResults().GetVersion(),
+	)
+	if err != nil && err != verrors.ErrStopped {
+		mr.logger.Panic("recover report collector fail", zap.Error(err))
+	}
+
+	return nil
+}
+
+// applyBackup hands a copy of the state machine to the Backup waiting for it
+// if this node proposed the entry.
+func (mr *RaftMetadataRepository) applyBackup(r *mrpb.Backup, nodeIndex, requestIndex, appliedIndex uint64) error {
+	defer mr.sendAck(nodeIndex, requestIndex, nil)
+
+	if r.NodeID != mr.nodeID {
+		return nil
+	}
+	f, ok := mr.backupMap.Load(r.BackupID)
+	if !ok {
+		return nil
+	}
+
+	rsp := &mrpb.BackupResponse{
+		StateMachine: mr.storage.CloneStateMachine(),
+		AppliedIndex: appliedIndex,
+	}
+	select {
+	case f.(chan *mrpb.BackupResponse) <- rsp:
+	default:
+	}
+	return nil
+}
+
 func (mr *RaftMetadataRepository) applyRegisterLogStream(r *mrpb.RegisterLogStream, nodeIndex, requestIndex uint64) error {
 	err := mr.storage.RegisterLogStream(r.LogStream, nodeIndex, requestIndex)
 	if err != nil {
@@ -848,29 +933,20 @@ func (mr *RaftMetadataRepository) applyCommit(r *mrpb.Commit, appliedIndex uint6
 
 		mr.nrReportSinceCommit = 0
 
-		if mr.storage.NumUpdateSinceCommit() > 0 {
+		topicLSIDs := mr.storage.GetSortedTopicLogStreamIDs()
+		if mr.storage.NumUpdateSinceCommit() > 0 || mr.hasTxnSpans(topicLSIDs) {
 			st := time.Now()
 
-			topicLSIDs := mr.storage.GetSortedTopicLogStreamIDs()
 			crs.CommitResults = make([]snpb.LogStreamCommitResult, 0, len(topicLSIDs))
 
 			commitResultsMap := make(map[types.Version]*mrpb.LogStreamCommitResults)
 
-			committedOffset := types.InvalidGLSN
-
-			//TODO:: apply topic
+			// Log entries of transactions are held back until they
+			// can be committed together. Hence, the committable range
+			// of every log stream is decided before assigning GLSNs.
+			lsids := make([]types.LogStreamID, 0, len(topicLSIDs))
+			lss := make(map[types.LogStreamID]*txnLogStream, len(topicLSIDs))
 			for idx, topicLSID := range topicLSIDs {
-				beginTopic, endTopic := topicBoundary(topicLSIDs, idx)
-
-				if beginTopic {
-					hpos := mr.topicEndPos[topicLSID.TopicID]
-
-					committedOffset, hpos = prevCommitResults.LastHighWatermark(topicLSID.TopicID, hpos)
-					committedOffset += types.GLSN(1)
-
-					mr.topicEndPos[topicLSID.TopicID] = hpos
-				}
-
 				reports := mr.storage.LookupUncommitReports(topicLSID.LogStreamID)
 				knownVer, minVer, knownHWM, nrUncommit := mr.calculateCommit(reports)
 				if reports.Status.Sealed() {
@@ -930,12 +1006,49 @@ func (mr *RaftMetadataRepository) applyCommit(r *mrpb.Commit, appliedIndex uint6
 					committedLLSNOffset = prevCommitResult.CommittedLLSNOffset + types.LLSN(prevCommitResult.CommittedGLSNLength)
 				}
 
+				ls := &txnLogStream{
+					topicID: topicLSID.TopicID,
+					begin:   committedLLSNOffset,
+					end:     committedLLSNOffset + types.LLSN(nrUncommit),
+				}
+				if nrUncommit > 0 {
+					ls.spans = reportedTxnSpans(reports, committedLLSNOffset)
+				}
+				lsids = append(lsids, topicLSID.LogStreamID)
+				lss[topicLSID.LogStreamID] = ls
+			}
+
+			var now time.Time
+			if r != nil {
+				now = r.CreatedTime
+			}
+			holdTxns(lss, lsids, now)
+
+			committedOffset := types.InvalidGLSN
+
+			//TODO:: apply topic
+			for idx, topicLSID := range topicLSIDs {
+				beginTopic, endTopic := topicBoundary(topicLSIDs, idx)
+
+				if beginTopic {
+					hpos := mr.topicEndPos[topicLSID.TopicID]
+
+					committedOffset, hpos = prevCommitResults.LastHighWatermark(topicLSID.TopicID, hpos)
+					committedOffset += types.GLSN(1)
+
+					mr.topicEndPos[topicLSID.TopicID] = hpos
+				}
+
+				ls := lss[topicLSID.LogStreamID]
+				nrUncommit := uint64(ls.end - ls.begin)
+
 				commit := snpb.LogStreamCommitResult{
 					TopicID:             topicLSID.TopicID,
 					LogStreamID:         topicLSID.LogStreamID,
-					CommittedLLSNOffset: committedLLSNOffset,
+					CommittedLLSNOffset: ls.begin,
 					CommittedGLSNOffset: committedOffset,
 					CommittedGLSNLength: nrUncommit,
+					AbortedTxnSpans:     ls.aborted,
 				}
 
 				if nrUncommit > 0 {
@@ -1106,6 +1219,18 @@ func (mr *RaftMetadataRepository) calculateCommit(reports *mrpb.LogStreamUncommi
 	return knownVer, trimVer, highWatermark, uint64(endLLSN - beginLLSN)
 }
 
+// hasTxnSpans returns true if any log stream reports log entries of
+// transactions. Since they can be aborted by their deadlines, commits should
+// be calculated even if no report is updated.
+func (mr *RaftMetadataRepository) hasTxnSpans(topicLSIDs []TopicLSID) bool {
+	for _, topicLSID := range topicLSIDs {
+		if hasTxnSpans(mr.storage.LookupUncommitReports(topicLSID.LogStreamID)) {
+			return true
+		}
+	}
+	return false
+}
+
 func (mr *RaftMetadataRepository) getLastCommitted(topicID types.TopicID, lsID types.LogStreamID, hintPos int) types.GLSN {
 	crs := mr.storage.GetLastCommitResults()
 	if crs == nil {
@@ -1301,6 +1426,127 @@ func (mr *RaftMetadataRepository) UpdateLogStream(ctx context.Context, ls *varlo
 	return nil
 }
 
+func (mr *RaftMetadataRepository) SetRetentionPolicy(ctx context.Context, topicID types.TopicID, policy *varlogpb.RetentionPolicy) error {
+	r := &mrpb.SetRetentionPolicy{
+		TopicID:         topicID,
+		RetentionPolicy: policy,
+	}
+
+	return mr.propose(ctx, r, true)
+}
+
+func (mr *RaftMetadataRepository) CommitOffset(ctx context.Context, group string, offset mrpb.ConsumerGroupOffset) error {
+	r := &mrpb.CommitOffset{
+		Group:  group,
+		Offset: offset,
+	}
+
+	return mr.propose(ctx, r, true)
+}
+
+// FetchOffset returns the offset of the consumer group. It proposes a read
+// barrier and reads the offset once the barrier is applied to this node;
+// hence, the offset is not stale even if it was committed through another
+// node.
+func (mr *RaftMetadataRepository) FetchOffset(ctx context.Context, group string, topicID types.TopicID, logStreamID types.LogStreamID) (mrpb.ConsumerGroupOffset, error) {
+	if !mr.IsMember() {
+		return mrpb.ConsumerGroupOffset{}, verrors.ErrNotMember
+	}
+
+	if err := mr.propose(ctx, &mrpb.ReadBarrier{}, true); err != nil {
+		return mrpb.ConsumerGroupOffset{}, err
+	}
+
+	offset, ok := mr.storage.LookupOffset(group, topicID, logStreamID)
+	if !ok {
+		return mrpb.ConsumerGroupOffset{}, verrors.ErrNotExist
+	}
+	return offset, nil
+}
+
+func (mr *RaftMetadataRepository) DeleteConsumerGroup(ctx context.Context, group string) error {
+	r := &mrpb.DeleteConsumerGroup{
+		Group: group,
+	}
+
+	return mr.propose(ctx, r, true)
+}
+
+// GetHighWatermark returns the high watermark of the topic in the last commit
+// results applied to this node. It can lag behind other nodes.
+func (mr *RaftMetadataRepository) GetHighWatermark(_ context.Context, topicID types.TopicID) (types.GLSN, error) {
+	if !mr.IsMember() {
+		return types.InvalidGLSN, verrors.ErrNotMember
+	}
+	return mr.storage.GetHighWatermark(topicID)
+}
+
+// Backup returns a copy of the state machine taken when the raft entry
+// proposed by it is applied. The copy has all commit results up to its last
+// one, thus, it is a consistent cut of the cluster.
+func (mr *RaftMetadataRepository) Backup(ctx context.Context) (*mrpb.BackupResponse, error) {
+	if !mr.IsMember() {
+		return nil, verrors.ErrNotMember
+	}
+
+	c := make(chan *mrpb.BackupResponse, 1)
+	backupID := atomic.AddUint64(&mr.requestNum, 1)
+	mr.backupMap.Store(backupID, c)
+	defer mr.backupMap.Delete(backupID)
+
+	r := &mrpb.Backup{
+		NodeID:   mr.nodeID,
+		BackupID: backupID,
+	}
+	if err := mr.propose(ctx, r, true); err != nil {
+		return nil, err
+	}
+
+	// The entry has been applied before the acknowledgement.
+	return <-c, nil
+}
+
+// restore proposes the state machine given by WithRestoreStateMachine once the
+// cluster has a leader. It does nothing if the state machine is not empty,
+// for instance, the node restarts after the restore.
+func (mr *RaftMetadataRepository) restore(ctx context.Context) {
+	ticker := time.NewTicker(mr.raftNode.raftTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !mr.hasLeader() {
+			continue
+		}
+		if !mr.storage.IsEmpty() {
+			mr.logger.Info("skip restore: state machine is not empty")
+			return
+		}
+
+		r := &mrpb.RecoverStateMachine{
+			StateMachine: mr.restoreStateMachine,
+		}
+		err := mr.propose(ctx, r, true)
+		switch err {
+		case nil:
+			mr.logger.Info("restored state machine",
+				zap.Uint64("version", uint64(mr.restoreStateMachine.GetLastCommitResults().GetVersion())),
+			)
+			return
+		case verrors.ErrExist:
+			mr.logger.Info("skip restore: state machine is not empty")
+			return
+		default:
+			mr.logger.Warn("could not restore state machine", zap.Error(err))
+		}
+	}
+}
+
 func (mr *RaftMetadataRepository) GetMetadata(context.Context) (*varlogpb.MetadataDescriptor, error) {
 	if !mr.IsMember() {
 		return nil, verrors.ErrNotMember
@@ -1314,6 +1560,30 @@ func (mr *RaftMetadataRepository) GetMetadata(context.Context) (*varlogpb.Metada
 	return m, nil
 }
 
+// WatchMetadata calls the argument send with the metadata whenever its applied
+// index becomes greater than the argument appliedIndex and the one sent last.
+// It returns when the context is canceled or send fails.
+func (mr *RaftMetadataRepository) WatchMetadata(ctx context.Context, appliedIndex uint64, send func(*varlogpb.MetadataDescriptor) error) error {
+	if !mr.IsMember() {
+		return verrors.ErrNotMember
+	}
+
+	for {
+		m, changeC := mr.storage.WatchMetadata()
+		if m.GetAppliedIndex() > appliedIndex {
+			if err := send(m); err != nil {
+				return err
+			}
+			appliedIndex = m.GetAppliedIndex()
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changeC:
+		}
+	}
+}
+
 func (mr *RaftMetadataRepository) Seal(ctx context.Context, lsID types.LogStreamID) (types.GLSN, error) {
 	r := &mrpb.Seal{
 		LogStreamID: lsID,
@@ -24,6 +24,7 @@ import (
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/testutil"
 	"github.com/kakao/varlog/pkg/util/testutil/ports"
+	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/mrpb"
 	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
@@ -40,11 +41,12 @@ type metadataRepoCluster struct {
 	logger            *zap.Logger
 	portLease         *ports.Lease
 	rpcTimeout        time.Duration // conn + call
+	opts              []Option
 }
 
 var testSnapCount uint64
 
-func newMetadataRepoCluster(n, nrRep int, increseUncommit bool) *metadataRepoCluster {
+func newMetadataRepoCluster(n, nrRep int, increseUncommit bool, opts ...Option) *metadataRepoCluster {
 	portLease, err := ports.ReserveWeaklyWithRetry(10000)
 	if err != nil {
 		panic(err)
@@ -66,6 +68,7 @@ func newMetadataRepoCluster(n, nrRep int, increseUncommit bool) *metadataRepoClu
 		logger:            zap.L(),
 		portLease:         portLease,
 		rpcTimeout:        rpcTimeout,
+		opts:              opts,
 	}
 
 	for i := range clus.peers {
@@ -114,6 +117,7 @@ func (clus *metadataRepoCluster) createMetadataRepo(idx int, join bool) error {
 	if join {
 		opts = append(opts, JoinCluster())
 	}
+	opts = append(opts, clus.opts...)
 
 	clus.nodes[idx] = NewRaftMetadataRepository(opts...)
 	return nil
@@ -2574,6 +2578,121 @@ func TestMRUnregisterTopic(t *testing.T) {
 	})
 }
 
+func TestMRWatchMetadata(t *testing.T) {
+	Convey("Given a metadata repository", t, func(ctx C) {
+		clus := newMetadataRepoCluster(1, 1, false)
+		Reset(func() {
+			clus.closeNoErrors(t)
+		})
+
+		So(clus.Start(), ShouldBeNil)
+		So(testutil.CompareWaitN(10, func() bool {
+			return clus.healthCheckAll()
+		}), ShouldBeTrue)
+
+		mr := clus.nodes[0]
+		sn := &varlogpb.StorageNodeDescriptor{
+			StorageNode: varlogpb.StorageNode{
+				StorageNodeID: types.StorageNodeID(1),
+			},
+		}
+		So(mr.RegisterStorageNode(context.TODO(), sn), ShouldBeNil)
+		meta, err := mr.GetMetadata(context.TODO())
+		So(err, ShouldBeNil)
+
+		Convey("WatchMetadata should send metadata whenever it changes", func(ctx C) {
+			watchCtx, cancel := context.WithCancel(context.Background())
+			metaC := make(chan *varlogpb.MetadataDescriptor, 16)
+			errC := make(chan error, 1)
+			go func() {
+				errC <- mr.WatchMetadata(watchCtx, meta.GetAppliedIndex(), func(md *varlogpb.MetadataDescriptor) error {
+					metaC <- md
+					return nil
+				})
+			}()
+
+			So(mr.RegisterTopic(context.TODO(), types.TopicID(1)), ShouldBeNil)
+			var watched *varlogpb.MetadataDescriptor
+			So(testutil.CompareWaitN(10, func() bool {
+				select {
+				case watched = <-metaC:
+					return watched.GetTopic(types.TopicID(1)) != nil
+				default:
+					return false
+				}
+			}), ShouldBeTrue)
+			So(watched.GetAppliedIndex(), ShouldBeGreaterThan, meta.GetAppliedIndex())
+
+			cancel()
+			So(<-errC, ShouldEqual, context.Canceled)
+		})
+	})
+}
+
+func TestMRBackupRestore(t *testing.T) {
+	Convey("Given a metadata repository having committed", t, func(ctx C) {
+		clus := newMetadataRepoCluster(1, 1, true)
+		Reset(func() {
+			clus.closeNoErrors(t)
+		})
+
+		So(clus.Start(), ShouldBeNil)
+		So(testutil.CompareWaitN(10, func() bool {
+			return clus.healthCheckAll()
+		}), ShouldBeTrue)
+
+		mr := clus.nodes[0]
+		So(clus.initDummyStorageNode(2, 1), ShouldBeNil)
+		So(testutil.CompareWaitN(50, func() bool {
+			return mr.storage.GetLastCommitVersion() > 0
+		}), ShouldBeTrue)
+
+		Convey("Backup should return a copy of the state machine", func(ctx C) {
+			rsp, err := mr.Backup(context.TODO())
+			So(err, ShouldBeNil)
+			sm := rsp.StateMachine
+			So(sm.GetMetadata().GetStorageNodes(), ShouldHaveLength, 2)
+			So(sm.GetMetadata().GetLogStreams(), ShouldHaveLength, 2)
+			So(sm.GetLastCommitResults().GetVersion(), ShouldBeGreaterThan, types.InvalidVersion)
+			So(rsp.AppliedIndex, ShouldBeGreaterThan, 0)
+
+			// The copy is not affected by later changes.
+			So(mr.RegisterTopic(context.TODO(), types.TopicID(10)), ShouldBeNil)
+			So(sm.GetMetadata().GetTopic(types.TopicID(10)), ShouldBeNil)
+
+			Convey("A new metadata repository should restore the state machine", func(ctx C) {
+				restored := newMetadataRepoCluster(1, 1, false, WithRestoreStateMachine(sm))
+				Reset(func() {
+					restored.closeNoErrors(t)
+				})
+				So(restored.Start(), ShouldBeNil)
+
+				rmr := restored.nodes[0]
+				So(testutil.CompareWaitN(50, func() bool {
+					return !rmr.storage.IsEmpty()
+				}), ShouldBeTrue)
+
+				meta, err := rmr.GetMetadata(context.TODO())
+				So(err, ShouldBeNil)
+				So(meta.GetStorageNodes(), ShouldHaveLength, 2)
+				So(meta.GetLogStreams(), ShouldHaveLength, 2)
+				So(meta.GetTopic(types.TopicID(10)), ShouldBeNil)
+				So(rmr.storage.GetLastCommitVersion(), ShouldEqual, sm.GetLastCommitResults().GetVersion())
+
+				// Log streams wait for storage nodes to report again.
+				for _, ls := range rmr.storage.GetLogStreams() {
+					So(ls.GetStatus(), ShouldEqual, varlogpb.LogStreamStatusSealing)
+				}
+
+				Convey("It should not restore again", func(ctx C) {
+					err := rmr.propose(context.TODO(), &mrpb.RecoverStateMachine{StateMachine: sm}, true)
+					So(err, ShouldEqual, verrors.ErrExist)
+				})
+			})
+		})
+	})
+}
+
 func TestMetadataRepository_MaxTopicsCount(t *testing.T) {
 	const numNodes = 1
 	const repFactor = 1
@@ -104,6 +104,8 @@ type MetadataStorage struct {
 
 	// immutable metadata cache for client request
 	metaCache *varlogpb.MetadataDescriptor
+	// closed and replaced whenever metaCache changes
+	metaCacheC chan struct{}
 	// change of metadata sequence number
 	metaAppliedIndex uint64
 	// callback after cache is completed
@@ -121,6 +123,7 @@ type MetadataStorage struct {
 	prMu sync.RWMutex // mutex for Peers
 	ssMu sync.RWMutex // mutex for Snapshot
 	mcMu sync.RWMutex // mutex for Metadata Cache
+	cgMu sync.RWMutex // mutex for ConsumerGroups
 
 	// async job (snapshot, cache)
 	jobC chan *storageAsyncJob
@@ -166,7 +169,11 @@ func NewMetadataStorage(cb func(uint64, uint64, error), snapCount uint64, logger
 	ms.origStateMachine.Endpoints = make(map[types.NodeID]string)
 	ms.diffStateMachine.Endpoints = make(map[types.NodeID]string)
 
+	ms.origStateMachine.ConsumerGroups = make(map[string]*mrpb.ConsumerGroupDescriptor)
+	ms.diffStateMachine.ConsumerGroups = make(map[string]*mrpb.ConsumerGroupDescriptor)
+
 	ms.metaCache = &varlogpb.MetadataDescriptor{}
+	ms.metaCacheC = make(chan struct{})
 
 	ms.jobC = make(chan *storageAsyncJob, 4096)
 	ms.running.Store(false)
@@ -699,6 +706,53 @@ func (ms *MetadataStorage) registerTopic(topic *varlogpb.TopicDescriptor) error
 	return nil
 }
 
+// SetRetentionPolicy replaces the retention policy of the topic. An empty
+// retention policy removes it from the topic.
+func (ms *MetadataStorage) SetRetentionPolicy(topicID types.TopicID, policy *varlogpb.RetentionPolicy, nodeIndex, requestIndex uint64) error {
+	err := ms.setRetentionPolicy(topicID, policy)
+	if err != nil {
+		if ms.cacheCompleteCB != nil {
+			ms.cacheCompleteCB(nodeIndex, requestIndex, err)
+		}
+		return err
+	}
+
+	ms.triggerMetadataCache(nodeIndex, requestIndex)
+	return nil
+}
+
+func (ms *MetadataStorage) setRetentionPolicy(topicID types.TopicID, policy *varlogpb.RetentionPolicy) error {
+	if policy != nil && policy.MaxAge < 0 {
+		return status.Errorf(codes.InvalidArgument, "negative max age %v", policy.MaxAge)
+	}
+	if policy.Empty() {
+		policy = nil
+	}
+
+	topic := ms.lookupTopic(topicID)
+	if topic == nil {
+		return status.Errorf(codes.NotFound, "topic %d", topicID)
+	}
+	if topic.RetentionPolicy.Equal(policy) {
+		// To ensure that it is applied to the meta cache
+		return nil
+	}
+
+	_, cur := ms.getStateMachine()
+
+	ms.mtMu.Lock()
+	defer ms.mtMu.Unlock()
+
+	topic = proto.Clone(topic).(*varlogpb.TopicDescriptor)
+	topic.RetentionPolicy = policy
+	if err := cur.Metadata.UpsertTopic(topic); err != nil {
+		return status.Errorf(codes.ResourceExhausted, "upsert topic %d, %s", topicID, err.Error())
+	}
+
+	ms.metaAppliedIndex++
+	return nil
+}
+
 func (ms *MetadataStorage) UnregisterTopic(topicID types.TopicID, nodeIndex, requestIndex uint64) error {
 	err := ms.unregisterTopic(topicID)
 	if err != nil {
@@ -1087,6 +1141,124 @@ func (ms *MetadataStorage) LookupEndpoint(nodeID types.NodeID) string {
 	return ""
 }
 
+// CommitOffset stores the offset of the consumer group. It replaces the offset
+// previously committed by the group for the same topic or log stream.
+func (ms *MetadataStorage) CommitOffset(group string, offset mrpb.ConsumerGroupOffset, nodeIndex, requestIndex uint64) error {
+	err := ms.commitOffset(group, offset)
+	if ms.cacheCompleteCB != nil {
+		ms.cacheCompleteCB(nodeIndex, requestIndex, err)
+	}
+	return err
+}
+
+func (ms *MetadataStorage) commitOffset(group string, offset mrpb.ConsumerGroupOffset) error {
+	ms.mtMu.RLock()
+	topic := ms.lookupTopic(offset.TopicID)
+	var ls *varlogpb.LogStreamDescriptor
+	if !offset.LogStreamID.Invalid() {
+		ls = ms.lookupLogStream(offset.LogStreamID)
+	}
+	ms.mtMu.RUnlock()
+
+	if topic == nil {
+		return status.Errorf(codes.NotFound, "topic %d", offset.TopicID)
+	}
+	if !offset.LogStreamID.Invalid() && (ls == nil || ls.TopicID != offset.TopicID) {
+		return status.Errorf(codes.NotFound, "log stream %d in topic %d", offset.LogStreamID, offset.TopicID)
+	}
+
+	ms.cgMu.Lock()
+	defer ms.cgMu.Unlock()
+
+	pre, cur := ms.getStateMachine()
+	cgd, ok := cur.ConsumerGroups[group]
+	if !ok || cgd.Deleted {
+		deleted := ok
+		cgd = &mrpb.ConsumerGroupDescriptor{}
+		if old, ok := pre.ConsumerGroups[group]; ok && !deleted {
+			// The pre can be being written to the snapshot; thus, the
+			// offsets are copied.
+			cgd.Offsets = append(cgd.Offsets, old.Offsets...)
+		}
+		cur.ConsumerGroups[group] = cgd
+	}
+
+	idx := sort.Search(len(cgd.Offsets), func(i int) bool {
+		o := cgd.Offsets[i]
+		if o.TopicID == offset.TopicID {
+			return o.LogStreamID >= offset.LogStreamID
+		}
+		return o.TopicID > offset.TopicID
+	})
+	if idx < len(cgd.Offsets) && cgd.Offsets[idx].TopicID == offset.TopicID && cgd.Offsets[idx].LogStreamID == offset.LogStreamID {
+		cgd.Offsets[idx] = offset
+		return nil
+	}
+	cgd.Offsets = append(cgd.Offsets, mrpb.ConsumerGroupOffset{})
+	copy(cgd.Offsets[idx+1:], cgd.Offsets[idx:])
+	cgd.Offsets[idx] = offset
+	return nil
+}
+
+// LookupOffset returns the offset committed by the consumer group for the
+// topic, or for the log stream if the logStreamID is valid.
+func (ms *MetadataStorage) LookupOffset(group string, topicID types.TopicID, logStreamID types.LogStreamID) (mrpb.ConsumerGroupOffset, bool) {
+	ms.cgMu.RLock()
+	defer ms.cgMu.RUnlock()
+
+	cgd, ok := ms.lookupConsumerGroupNoLock(group)
+	if !ok {
+		return mrpb.ConsumerGroupOffset{}, false
+	}
+	for _, offset := range cgd.Offsets {
+		if offset.TopicID == topicID && offset.LogStreamID == logStreamID {
+			return offset, true
+		}
+	}
+	return mrpb.ConsumerGroupOffset{}, false
+}
+
+// DeleteConsumerGroup deletes the consumer group and all offsets committed by
+// it. It returns verrors.ErrNotExist if the group does not exist.
+func (ms *MetadataStorage) DeleteConsumerGroup(group string, nodeIndex, requestIndex uint64) error {
+	err := ms.deleteConsumerGroup(group)
+	if ms.cacheCompleteCB != nil {
+		ms.cacheCompleteCB(nodeIndex, requestIndex, err)
+	}
+	return err
+}
+
+func (ms *MetadataStorage) deleteConsumerGroup(group string) error {
+	ms.cgMu.Lock()
+	defer ms.cgMu.Unlock()
+
+	if _, ok := ms.lookupConsumerGroupNoLock(group); !ok {
+		return verrors.ErrNotExist
+	}
+
+	pre, cur := ms.getStateMachine()
+	if pre == cur {
+		delete(cur.ConsumerGroups, group)
+		return nil
+	}
+	// The pre can be being written to the snapshot; thus, the deletion is
+	// marked in the cur and applied when it is merged.
+	cur.ConsumerGroups[group] = &mrpb.ConsumerGroupDescriptor{Deleted: true}
+	return nil
+}
+
+func (ms *MetadataStorage) lookupConsumerGroupNoLock(group string) (*mrpb.ConsumerGroupDescriptor, bool) {
+	pre, cur := ms.getStateMachine()
+	cgd, ok := cur.ConsumerGroups[group]
+	if !ok {
+		cgd, ok = pre.ConsumerGroups[group]
+	}
+	if !ok || cgd.Deleted {
+		return nil, false
+	}
+	return cgd, true
+}
+
 func (ms *MetadataStorage) lookupNextCommitResultsNoLock(ver types.Version) *mrpb.LogStreamCommitResults {
 	pre, cur := ms.getStateMachine()
 	if pre != cur {
@@ -1331,6 +1503,26 @@ func (ms *MetadataStorage) GetLastCommitResults() *mrpb.LogStreamCommitResults {
 	return ms.getLastCommitResultsNoLock()
 }
 
+// GetHighWatermark returns the high watermark of the topic in the last commit
+// results. It returns an error with the code NotFound if the topic does not
+// exist.
+func (ms *MetadataStorage) GetHighWatermark(topicID types.TopicID) (types.GLSN, error) {
+	ms.mtMu.RLock()
+	topic := ms.lookupTopic(topicID)
+	ms.mtMu.RUnlock()
+	if topic == nil {
+		return types.InvalidGLSN, status.Errorf(codes.NotFound, "topic %d", topicID)
+	}
+
+	crs := ms.GetLastCommitResults()
+	hwm, idx := crs.LastHighWatermark(topicID, -1)
+	if idx < 0 || crs.CommitResults[idx].TopicID != topicID {
+		// No log entry has been committed to the topic yet.
+		return types.InvalidGLSN, nil
+	}
+	return hwm, nil
+}
+
 func (ms *MetadataStorage) GetMetadata() *varlogpb.MetadataDescriptor {
 	ms.mcMu.RLock()
 	defer ms.mcMu.RUnlock()
@@ -1338,6 +1530,15 @@ func (ms *MetadataStorage) GetMetadata() *varlogpb.MetadataDescriptor {
 	return ms.metaCache
 }
 
+// WatchMetadata returns the current metadata cache and a channel that is
+// closed when the cache is replaced.
+func (ms *MetadataStorage) WatchMetadata() (*varlogpb.MetadataDescriptor, <-chan struct{}) {
+	ms.mcMu.RLock()
+	defer ms.mcMu.RUnlock()
+
+	return ms.metaCache, ms.metaCacheC
+}
+
 func (ms *MetadataStorage) GetLogStreamCommitResults() []*mrpb.LogStreamCommitResults {
 	ver := ms.origStateMachine.LogStream.TrimVersion
 	if ms.origStateMachine.LogStream.TrimVersion < ms.diffStateMachine.LogStream.TrimVersion {
@@ -1414,6 +1615,10 @@ func (ms *MetadataStorage) ApplySnapshot(snap []byte, snapConfState *raftpb.Conf
 		stateMachine.Endpoints = make(map[types.NodeID]string)
 	}
 
+	if stateMachine.ConsumerGroups == nil {
+		stateMachine.ConsumerGroups = make(map[string]*mrpb.ConsumerGroupDescriptor)
+	}
+
 	running := ms.running.Load()
 
 	ms.Close()
@@ -1455,9 +1660,15 @@ func (ms *MetadataStorage) RecoverStateMachine(stateMachine *mrpb.MetadataReposi
 	ms.releaseCopyOnWrite()
 
 	ms.mergePeers()
+	ms.mergeConsumerGroups()
 
 	stateMachine.Endpoints = ms.origStateMachine.Endpoints
 	stateMachine.PeersMap = ms.origStateMachine.PeersMap
+	// Storage nodes do not know offsets of consumer groups, but a backup of
+	// the state machine has them.
+	if stateMachine.ConsumerGroups == nil {
+		stateMachine.ConsumerGroups = ms.origStateMachine.ConsumerGroups
+	}
 
 	ms.recoverLogStreams(stateMachine)
 	ms.recoverCache(stateMachine, appliedIndex)
@@ -1515,7 +1726,7 @@ func (ms *MetadataStorage) recoverCache(stateMachine *mrpb.MetadataRepositoryDes
 	cache.AppliedIndex = appliedIndex
 
 	ms.mcMu.Lock()
-	ms.metaCache = cache
+	ms.setMetadataCacheNoLock(cache)
 	ms.mcMu.Unlock()
 }
 
@@ -1523,6 +1734,7 @@ func (ms *MetadataStorage) recoverStateMachine(stateMachine *mrpb.MetadataReposi
 	ms.mtMu.Lock()
 	ms.lsMu.Lock()
 	ms.prMu.Lock()
+	ms.cgMu.Lock()
 
 	ms.origStateMachine = stateMachine
 
@@ -1532,6 +1744,7 @@ func (ms *MetadataStorage) recoverStateMachine(stateMachine *mrpb.MetadataReposi
 	ms.diffStateMachine.LogStream.UncommitReports = make(map[types.LogStreamID]*mrpb.LogStreamUncommitReports)
 	ms.diffStateMachine.PeersMap.Peers = make(map[types.NodeID]*mrpb.MetadataRepositoryDescriptor_PeerDescriptor)
 	ms.diffStateMachine.Endpoints = make(map[types.NodeID]string)
+	ms.diffStateMachine.ConsumerGroups = make(map[string]*mrpb.ConsumerGroupDescriptor)
 
 	ms.metaAppliedIndex = appliedIndex
 	ms.appliedIndex = appliedIndex
@@ -1551,6 +1764,7 @@ func (ms *MetadataStorage) recoverStateMachine(stateMachine *mrpb.MetadataReposi
 		return ms.sortedTopicLSIDs[i].TopicID < ms.sortedTopicLSIDs[j].TopicID
 	})
 
+	ms.cgMu.Unlock()
 	ms.prMu.Unlock()
 	ms.lsMu.Unlock()
 	ms.mtMu.Unlock()
@@ -1637,6 +1851,109 @@ func (ms *MetadataStorage) GetLogStreams() []*varlogpb.LogStreamDescriptor {
 	return m
 }
 
+// IsEmpty returns true if the state machine has neither storage nodes,
+// topics, log streams nor commits.
+func (ms *MetadataStorage) IsEmpty() bool {
+	if len(ms.GetStorageNodes()) > 0 || len(ms.GetLogStreams()) > 0 {
+		return false
+	}
+	for _, topic := range ms.origStateMachine.Metadata.Topics {
+		if !topic.Status.Deleted() {
+			return false
+		}
+	}
+	for _, topic := range ms.diffStateMachine.Metadata.Topics {
+		if !topic.Status.Deleted() {
+			return false
+		}
+	}
+	return ms.GetLastCommitVersion() == types.InvalidVersion
+}
+
+// CloneStateMachine returns a deep copy of the state machine including changes
+// not merged yet. It should be called while applying entries since it reads
+// the state machine that only the applier modifies.
+func (ms *MetadataStorage) CloneStateMachine() *mrpb.MetadataRepositoryDescriptor {
+	sm := proto.Clone(ms.origStateMachine).(*mrpb.MetadataRepositoryDescriptor)
+	if !ms.isCopyOnWrite() {
+		return sm
+	}
+
+	// Cloning drops empty maps.
+	if sm.LogStream.UncommitReports == nil {
+		sm.LogStream.UncommitReports = make(map[types.LogStreamID]*mrpb.LogStreamUncommitReports)
+	}
+	if sm.PeersMap.Peers == nil {
+		sm.PeersMap.Peers = make(map[types.NodeID]*mrpb.MetadataRepositoryDescriptor_PeerDescriptor)
+	}
+	if sm.Endpoints == nil {
+		sm.Endpoints = make(map[types.NodeID]string)
+	}
+	if sm.ConsumerGroups == nil {
+		sm.ConsumerGroups = make(map[string]*mrpb.ConsumerGroupDescriptor)
+	}
+
+	diff := proto.Clone(ms.diffStateMachine).(*mrpb.MetadataRepositoryDescriptor)
+	for _, sn := range diff.Metadata.StorageNodes {
+		if sn.Status.Deleted() {
+			sm.Metadata.DeleteStorageNode(sn.StorageNodeID) //nolint:errcheck,revive // TODO:: Handle an error returned.
+		} else {
+			sm.Metadata.InsertStorageNode(sn) //nolint:errcheck,revive // TODO:: Handle an error returned.
+		}
+	}
+	for _, topic := range diff.Metadata.Topics {
+		if topic.Status.Deleted() {
+			sm.Metadata.DeleteTopic(topic.TopicID) //nolint:errcheck,revive // TODO:: Handle an error returned.
+		} else if sm.Metadata.InsertTopic(topic) != nil {
+			sm.Metadata.UpdateTopic(topic) //nolint:errcheck,revive // TODO:: Handle an error returned.
+		}
+	}
+	for _, ls := range diff.Metadata.LogStreams {
+		if ls.Status.Deleted() {
+			sm.Metadata.DeleteLogStream(ls.LogStreamID) //nolint:errcheck,revive // TODO:: Handle an error returned.
+		} else if sm.Metadata.InsertLogStream(ls) != nil {
+			sm.Metadata.UpdateLogStream(ls) //nolint:errcheck,revive // TODO:: Handle an error returned.
+		}
+	}
+
+	for lsID, lm := range diff.LogStream.UncommitReports {
+		if lm.Status.Deleted() {
+			delete(sm.LogStream.UncommitReports, lsID)
+		} else {
+			sm.LogStream.UncommitReports[lsID] = lm
+		}
+	}
+	if sm.LogStream.TrimVersion < diff.LogStream.TrimVersion {
+		sm.LogStream.TrimVersion = diff.LogStream.TrimVersion
+	}
+	sm.LogStream.CommitHistory = append(sm.LogStream.CommitHistory, diff.LogStream.CommitHistory...)
+
+	for nodeID, peer := range diff.PeersMap.Peers {
+		if peer == nil {
+			delete(sm.PeersMap.Peers, nodeID)
+		} else {
+			sm.PeersMap.Peers[nodeID] = peer
+		}
+	}
+	for nodeID, url := range diff.Endpoints {
+		if url == "" {
+			delete(sm.Endpoints, nodeID)
+		} else {
+			sm.Endpoints[nodeID] = url
+		}
+	}
+	sm.PeersMap.AppliedIndex = mathutil.MaxUint64(sm.PeersMap.AppliedIndex, diff.PeersMap.AppliedIndex)
+
+	for group, cgd := range diff.ConsumerGroups {
+		if cgd.Deleted {
+			delete(sm.ConsumerGroups, group)
+		} else {
+			sm.ConsumerGroups[group] = cgd
+		}
+	}
+	return sm
+}
+
 func (ms *MetadataStorage) isCopyOnWrite() bool {
 	return ms.copyOnWrite.Load()
 }
@@ -1722,7 +2039,15 @@ func (ms *MetadataStorage) createMetadataCache(job *jobMetadataCache) {
 	defer ms.mcMu.Unlock()
 
 	cache.AppliedIndex = ms.metaAppliedIndex
+	ms.setMetadataCacheNoLock(cache)
+}
+
+// setMetadataCacheNoLock replaces the metadata cache and wakes up watchers of
+// the metadata. The caller should hold mcMu.
+func (ms *MetadataStorage) setMetadataCacheNoLock(cache *varlogpb.MetadataDescriptor) {
 	ms.metaCache = cache
+	close(ms.metaCacheC)
+	ms.metaCacheC = make(chan struct{})
 }
 
 func (ms *MetadataStorage) mergeMetadata() {
@@ -1819,6 +2144,24 @@ func (ms *MetadataStorage) mergePeers() {
 	ms.diffStateMachine.Endpoints = make(map[types.NodeID]string)
 }
 
+func (ms *MetadataStorage) mergeConsumerGroups() {
+	ms.cgMu.Lock()
+	defer ms.cgMu.Unlock()
+
+	if len(ms.diffStateMachine.ConsumerGroups) == 0 {
+		return
+	}
+
+	for group, cgd := range ms.diffStateMachine.ConsumerGroups {
+		if cgd.Deleted {
+			delete(ms.origStateMachine.ConsumerGroups, group)
+		} else {
+			ms.origStateMachine.ConsumerGroups[group] = cgd
+		}
+	}
+	ms.diffStateMachine.ConsumerGroups = make(map[string]*mrpb.ConsumerGroupDescriptor)
+}
+
 func (ms *MetadataStorage) mergeConfState() {
 	if ms.diffConfState != nil {
 		ms.origConfState = ms.diffConfState
@@ -1860,6 +2203,7 @@ func (ms *MetadataStorage) mergeStateMachine() {
 	ms.mergeMetadata()
 	ms.mergeLogStream()
 	ms.mergePeers()
+	ms.mergeConsumerGroups()
 	ms.mergeConfState()
 
 	ms.releaseCopyOnWrite()
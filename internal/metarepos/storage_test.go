@@ -18,6 +18,7 @@ import (
 
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/testutil"
+	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/mrpb"
 	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
@@ -1899,6 +1900,40 @@ func TestStoragUnregisterTopic(t *testing.T) {
 	})
 }
 
+func TestStorageSetRetentionPolicy(t *testing.T) {
+	Convey("set retention policy of non-exist topic should return ErrNotExist", t, func(ctx C) {
+		ms := NewMetadataStorage(nil, DefaultSnapshotCount, zap.NewNop())
+
+		err := ms.setRetentionPolicy(types.TopicID(1), &varlogpb.RetentionPolicy{MaxEntries: 1})
+		So(status.Code(err), ShouldEqual, codes.NotFound)
+	})
+
+	Convey("set retention policy of exist topic", t, func(ctx C) {
+		ms := NewMetadataStorage(nil, DefaultSnapshotCount, zap.NewNop())
+
+		err := ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: types.TopicID(1)})
+		So(err, ShouldBeNil)
+
+		err = ms.setRetentionPolicy(types.TopicID(1), &varlogpb.RetentionPolicy{MaxAge: -time.Second})
+		So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+
+		policy := &varlogpb.RetentionPolicy{MaxAge: time.Hour, MaxEntries: 10}
+		err = ms.setRetentionPolicy(types.TopicID(1), policy)
+		So(err, ShouldBeNil)
+
+		topic := ms.lookupTopic(types.TopicID(1))
+		So(topic.RetentionPolicy.Equal(policy), ShouldBeTrue)
+
+		Convey("empty retention policy should remove the retention policy", func(ctx C) {
+			err := ms.setRetentionPolicy(types.TopicID(1), &varlogpb.RetentionPolicy{})
+			So(err, ShouldBeNil)
+
+			topic := ms.lookupTopic(types.TopicID(1))
+			So(topic.RetentionPolicy, ShouldBeNil)
+		})
+	})
+}
+
 func TestStorage_MaxTopicsCount(t *testing.T) {
 	tcs := []struct {
 		name           string
@@ -2045,3 +2080,136 @@ func TestStorageSortedTopicLogStreamIDs(t *testing.T) {
 		}), ShouldBeTrue)
 	})
 }
+
+func TestStorage_CommitOffset(t *testing.T) {
+	const (
+		group = "group"
+		tpid  = types.TopicID(1)
+	)
+
+	ms := NewMetadataStorage(nil, DefaultSnapshotCount, zaptest.NewLogger(t))
+	require.NoError(t, ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: tpid}))
+
+	// unknown topic
+	err := ms.commitOffset(group, mrpb.ConsumerGroupOffset{TopicID: tpid + 1, GLSN: 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// unknown log stream
+	err = ms.commitOffset(group, mrpb.ConsumerGroupOffset{TopicID: tpid, LogStreamID: 1, LLSN: 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, ok := ms.LookupOffset(group, tpid, 0)
+	require.False(t, ok)
+
+	require.NoError(t, ms.commitOffset(group, mrpb.ConsumerGroupOffset{TopicID: tpid, GLSN: 10}))
+	offset, ok := ms.LookupOffset(group, tpid, 0)
+	require.True(t, ok)
+	require.Equal(t, types.GLSN(10), offset.GLSN)
+
+	// Offsets committed while copyOnWrite are merged later.
+	ms.setCopyOnWrite()
+	require.NoError(t, ms.commitOffset(group, mrpb.ConsumerGroupOffset{TopicID: tpid, GLSN: 20}))
+	require.NoError(t, ms.commitOffset("other", mrpb.ConsumerGroupOffset{TopicID: tpid, GLSN: 5}))
+
+	pre, _ := ms.getStateMachine()
+	require.Equal(t, types.GLSN(10), pre.ConsumerGroups[group].Offsets[0].GLSN)
+	offset, ok = ms.LookupOffset(group, tpid, 0)
+	require.True(t, ok)
+	require.Equal(t, types.GLSN(20), offset.GLSN)
+
+	ms.mergeStateMachine()
+	require.False(t, ms.isCopyOnWrite())
+	pre, _ = ms.getStateMachine()
+	require.Equal(t, types.GLSN(20), pre.ConsumerGroups[group].Offsets[0].GLSN)
+	require.Equal(t, types.GLSN(5), pre.ConsumerGroups["other"].Offsets[0].GLSN)
+
+	// Offsets survive snapshots.
+	snap, err := pre.Marshal()
+	require.NoError(t, err)
+	restored := NewMetadataStorage(nil, DefaultSnapshotCount, zaptest.NewLogger(t))
+	require.NoError(t, restored.ApplySnapshot(snap, &raftpb.ConfState{}, 1))
+	offset, ok = restored.LookupOffset(group, tpid, 0)
+	require.True(t, ok)
+	require.Equal(t, types.GLSN(20), offset.GLSN)
+}
+
+func TestStorage_DeleteConsumerGroup(t *testing.T) {
+	const tpid = types.TopicID(1)
+
+	ms := NewMetadataStorage(nil, DefaultSnapshotCount, zaptest.NewLogger(t))
+	require.NoError(t, ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: tpid}))
+
+	// unknown group
+	err := ms.deleteConsumerGroup("group")
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+
+	require.NoError(t, ms.commitOffset("group", mrpb.ConsumerGroupOffset{TopicID: tpid, GLSN: 10}))
+	require.NoError(t, ms.commitOffset("other", mrpb.ConsumerGroupOffset{TopicID: tpid, GLSN: 5}))
+	require.NoError(t, ms.deleteConsumerGroup("group"))
+	_, ok := ms.LookupOffset("group", tpid, 0)
+	require.False(t, ok)
+	err = ms.deleteConsumerGroup("group")
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+
+	// Groups deleted while copyOnWrite are deleted from the origin later.
+	ms.setCopyOnWrite()
+	require.NoError(t, ms.deleteConsumerGroup("other"))
+	_, ok = ms.LookupOffset("other", tpid, 0)
+	require.False(t, ok)
+	err = ms.deleteConsumerGroup("other")
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+	pre, _ := ms.getStateMachine()
+	require.Contains(t, pre.ConsumerGroups, "other")
+
+	// A group committing again after deletion starts over.
+	require.NoError(t, ms.commitOffset("other", mrpb.ConsumerGroupOffset{TopicID: tpid, LogStreamID: 0, GLSN: 7}))
+	offset, ok := ms.LookupOffset("other", tpid, 0)
+	require.True(t, ok)
+	require.Equal(t, types.GLSN(7), offset.GLSN)
+	require.NoError(t, ms.deleteConsumerGroup("other"))
+
+	ms.mergeStateMachine()
+	require.False(t, ms.isCopyOnWrite())
+	pre, _ = ms.getStateMachine()
+	require.Empty(t, pre.ConsumerGroups)
+}
+
+func TestStorage_GetHighWatermark(t *testing.T) {
+	ms := NewMetadataStorage(nil, DefaultSnapshotCount, zaptest.NewLogger(t))
+	for tpid := types.TopicID(1); tpid <= 3; tpid++ {
+		require.NoError(t, ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: tpid}))
+	}
+
+	// unknown topic
+	_, err := ms.GetHighWatermark(4)
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// no commit yet
+	hwm, err := ms.GetHighWatermark(1)
+	require.NoError(t, err)
+	require.Equal(t, types.InvalidGLSN, hwm)
+
+	// The topic 2 has no log stream; hence, its high watermark should not be
+	// the one of the topic 1.
+	ms.AppendLogStreamCommitHistory(&mrpb.LogStreamCommitResults{
+		Version: types.MinVersion,
+		CommitResults: []snpb.LogStreamCommitResult{
+			{TopicID: 1, LogStreamID: 1, CommittedGLSNOffset: 1, CommittedGLSNLength: 3},
+			{TopicID: 1, LogStreamID: 2, CommittedGLSNOffset: 4, CommittedGLSNLength: 2, HighWatermark: 5},
+			{TopicID: 3, LogStreamID: 3, CommittedGLSNOffset: 1, CommittedGLSNLength: 7, HighWatermark: 7},
+		},
+	})
+	tcs := []struct {
+		tpid     types.TopicID
+		expected types.GLSN
+	}{
+		{tpid: 1, expected: 5},
+		{tpid: 2, expected: types.InvalidGLSN},
+		{tpid: 3, expected: 7},
+	}
+	for _, tc := range tcs {
+		hwm, err := ms.GetHighWatermark(tc.tpid)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, hwm)
+	}
+}
@@ -0,0 +1,146 @@
+package metarepos
+
+import (
+	"sort"
+	"time"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/mrpb"
+	"github.com/kakao/varlog/proto/snpb"
+)
+
+// txnLogStream is the range of log entries in a log stream that a commit is
+// going to commit. Log entries of transactions in the range are held back
+// until all log entries of each transaction can be committed at once.
+type txnLogStream struct {
+	topicID types.TopicID
+	// The range [begin, end) is committable. holdTxns moves the end
+	// backward to hold back transactions.
+	begin types.LLSN
+	end   types.LLSN
+	// spans are runs of log entries of transactions from the begin in the
+	// order of LLSN.
+	spans []snpb.TxnSpan
+	// aborted are spans in the committable range whose transactions are
+	// aborted.
+	aborted []snpb.TxnSpan
+}
+
+// reportedTxnSpans returns spans of transactions reported by replicas of a log
+// stream that start at or after the argument begin. Replicas report the same
+// spans unless they lag behind; for a span reported differently, it takes the
+// longest range and the latest deadline so that the result does not depend on
+// the order of replicas.
+func reportedTxnSpans(reports *mrpb.LogStreamUncommitReports, begin types.LLSN) []snpb.TxnSpan {
+	var spans map[types.LLSN]snpb.TxnSpan
+	for _, r := range reports.Replicas {
+		for _, span := range r.TxnSpans {
+			if span.LLSNBegin < begin {
+				continue
+			}
+			if spans == nil {
+				spans = make(map[types.LLSN]snpb.TxnSpan)
+			}
+			prev, ok := spans[span.LLSNBegin]
+			if ok && prev.LLSNEnd > span.LLSNEnd {
+				span.LLSNEnd = prev.LLSNEnd
+			}
+			if ok && prev.Deadline.After(span.Deadline) {
+				span.Deadline = prev.Deadline
+			}
+			spans[span.LLSNBegin] = span
+		}
+	}
+	ret := make([]snpb.TxnSpan, 0, len(spans))
+	for _, span := range spans {
+		ret = append(ret, span)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].LLSNBegin < ret[j].LLSNBegin
+	})
+	return ret
+}
+
+// hasTxnSpans returns true if any replica of the log stream reports spans of
+// transactions.
+func hasTxnSpans(reports *mrpb.LogStreamUncommitReports) bool {
+	if reports == nil || reports.Status.Sealed() {
+		return false
+	}
+	for _, r := range reports.Replicas {
+		if len(r.TxnSpans) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// holdTxns moves the ends of committable ranges of the log streams backward
+// so that every transaction in the ranges is either committed or aborted as a
+// whole. A transaction can be committed if the committable ranges have all of
+// its log entries in every participant. Otherwise, its log entries are held
+// back until its deadline, and then aborted. The argument now is the time of
+// the commit proposed by the leader; if it is zero, no transaction is
+// aborted.
+//
+// The argument lsids decides the order of log streams to make the result
+// deterministic. Log entries held back in a log stream also hold back the
+// following log entries in the same log stream.
+func holdTxns(lss map[types.LogStreamID]*txnLogStream, lsids []types.LogStreamID, now time.Time) {
+	committable := func(txn *snpb.Txn) bool {
+		for _, p := range txn.Participants {
+			ls, ok := lss[p.LogStreamID]
+			if !ok || ls.topicID != p.TopicID {
+				return false
+			}
+			length := uint64(0)
+			for _, span := range ls.spans {
+				if span.LLSNEnd > ls.end {
+					break
+				}
+				if span.Txn.ID == txn.ID {
+					length += uint64(span.LLSNEnd - span.LLSNBegin)
+				}
+			}
+			if length < p.Length {
+				return false
+			}
+		}
+		return true
+	}
+	expired := func(span *snpb.TxnSpan) bool {
+		return !now.IsZero() && now.After(span.Deadline)
+	}
+
+	for held := true; held; {
+		held = false
+		for _, lsid := range lsids {
+			ls := lss[lsid]
+			for i := range ls.spans {
+				span := &ls.spans[i]
+				if span.LLSNBegin >= ls.end {
+					break
+				}
+				if span.LLSNEnd <= ls.end && (committable(&span.Txn) || expired(span)) {
+					continue
+				}
+				ls.end = span.LLSNBegin
+				held = true
+				break
+			}
+		}
+	}
+
+	for _, lsid := range lsids {
+		ls := lss[lsid]
+		for i := range ls.spans {
+			span := &ls.spans[i]
+			if span.LLSNBegin >= ls.end {
+				break
+			}
+			if !committable(&span.Txn) {
+				ls.aborted = append(ls.aborted, *span)
+			}
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package metarepos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/mrpb"
+	"github.com/kakao/varlog/proto/snpb"
+)
+
+func TestHoldTxns(t *testing.T) {
+	const tpid = types.TopicID(1)
+	now := time.Now()
+
+	txn := func(id string, lengths map[types.LogStreamID]uint64) snpb.Txn {
+		ret := snpb.Txn{ID: id, Timeout: time.Second}
+		for lsid := types.LogStreamID(1); lsid <= 3; lsid++ {
+			if length, ok := lengths[lsid]; ok {
+				ret.Participants = append(ret.Participants, snpb.TxnParticipant{
+					TopicID:     tpid,
+					LogStreamID: lsid,
+					Length:      length,
+				})
+			}
+		}
+		return ret
+	}
+	span := func(txn snpb.Txn, begin, end types.LLSN, deadline time.Time) snpb.TxnSpan {
+		return snpb.TxnSpan{Txn: txn, LLSNBegin: begin, LLSNEnd: end, Deadline: deadline}
+	}
+
+	x := txn("x", map[types.LogStreamID]uint64{1: 2, 2: 1})
+	y := txn("y", map[types.LogStreamID]uint64{1: 1, 2: 1})
+	z := txn("z", map[types.LogStreamID]uint64{2: 1, 3: 1})
+	later := now.Add(time.Minute)
+	earlier := now.Add(-time.Minute)
+
+	tcs := []struct {
+		name        string
+		lss         map[types.LogStreamID]*txnLogStream
+		now         time.Time
+		wantEnds    map[types.LogStreamID]types.LLSN
+		wantAborted map[types.LogStreamID][]string
+	}{
+		{
+			name: "Complete",
+			lss: map[types.LogStreamID]*txnLogStream{
+				1: {begin: 1, end: 5, spans: []snpb.TxnSpan{span(x, 2, 4, later)}},
+				2: {begin: 1, end: 2, spans: []snpb.TxnSpan{span(x, 1, 2, later)}},
+			},
+			now:      now,
+			wantEnds: map[types.LogStreamID]types.LLSN{1: 5, 2: 2},
+		},
+		{
+			name: "MissingParticipant",
+			lss: map[types.LogStreamID]*txnLogStream{
+				1: {begin: 1, end: 5, spans: []snpb.TxnSpan{span(x, 2, 4, later)}},
+				2: {begin: 1, end: 1},
+			},
+			now:      now,
+			wantEnds: map[types.LogStreamID]types.LLSN{1: 2, 2: 1},
+		},
+		{
+			name: "PartiallyReported",
+			lss: map[types.LogStreamID]*txnLogStream{
+				1: {begin: 1, end: 3, spans: []snpb.TxnSpan{span(x, 2, 4, later)}},
+				2: {begin: 1, end: 2, spans: []snpb.TxnSpan{span(x, 1, 2, later)}},
+			},
+			now:      now,
+			wantEnds: map[types.LogStreamID]types.LLSN{1: 2, 2: 1},
+		},
+		{
+			name: "Expired",
+			lss: map[types.LogStreamID]*txnLogStream{
+				1: {begin: 1, end: 5, spans: []snpb.TxnSpan{span(x, 2, 4, earlier)}},
+				2: {begin: 1, end: 1},
+			},
+			now:         now,
+			wantEnds:    map[types.LogStreamID]types.LLSN{1: 5, 2: 1},
+			wantAborted: map[types.LogStreamID][]string{1: {"x"}},
+		},
+		{
+			name: "ExpiredWithoutCommitTime",
+			lss: map[types.LogStreamID]*txnLogStream{
+				1: {begin: 1, end: 5, spans: []snpb.TxnSpan{span(x, 2, 4, earlier)}},
+				2: {begin: 1, end: 1},
+			},
+			wantEnds: map[types.LogStreamID]types.LLSN{1: 2, 2: 1},
+		},
+		{
+			name: "CrossOrder",
+			lss: map[types.LogStreamID]*txnLogStream{
+				1: {begin: 1, end: 4, spans: []snpb.TxnSpan{span(x, 1, 3, later), span(y, 3, 4, later)}},
+				2: {begin: 1, end: 3, spans: []snpb.TxnSpan{span(y, 1, 2, later), span(x, 2, 3, later)}},
+			},
+			now:      now,
+			wantEnds: map[types.LogStreamID]types.LLSN{1: 4, 2: 3},
+		},
+		{
+			name: "HeldBehindAnotherTxn",
+			lss: map[types.LogStreamID]*txnLogStream{
+				1: {begin: 1, end: 3, spans: []snpb.TxnSpan{span(x, 1, 3, later)}},
+				2: {begin: 1, end: 3, spans: []snpb.TxnSpan{span(z, 1, 2, later), span(x, 2, 3, later)}},
+				3: {begin: 1, end: 1},
+			},
+			now:      now,
+			wantEnds: map[types.LogStreamID]types.LLSN{1: 1, 2: 1, 3: 1},
+		},
+		{
+			name: "AbortedAndCommitted",
+			lss: map[types.LogStreamID]*txnLogStream{
+				1: {begin: 1, end: 3, spans: []snpb.TxnSpan{span(x, 1, 3, later)}},
+				2: {begin: 1, end: 3, spans: []snpb.TxnSpan{span(z, 1, 2, earlier), span(x, 2, 3, later)}},
+				3: {begin: 1, end: 1},
+			},
+			now:         now,
+			wantEnds:    map[types.LogStreamID]types.LLSN{1: 3, 2: 3, 3: 1},
+			wantAborted: map[types.LogStreamID][]string{2: {"z"}},
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var lsids []types.LogStreamID
+			for lsid := types.LogStreamID(1); lsid <= 3; lsid++ {
+				if ls, ok := tc.lss[lsid]; ok {
+					ls.topicID = tpid
+					lsids = append(lsids, lsid)
+				}
+			}
+			holdTxns(tc.lss, lsids, tc.now)
+			for _, lsid := range lsids {
+				ls := tc.lss[lsid]
+				require.Equal(t, tc.wantEnds[lsid], ls.end, "log stream %d", lsid)
+				var aborted []string
+				for _, span := range ls.aborted {
+					aborted = append(aborted, span.Txn.ID)
+				}
+				require.Equal(t, tc.wantAborted[lsid], aborted, "log stream %d", lsid)
+			}
+		})
+	}
+}
+
+func TestReportedTxnSpans(t *testing.T) {
+	now := time.Now()
+	txn := snpb.Txn{ID: "x"}
+	reports := &mrpb.LogStreamUncommitReports{
+		Replicas: map[types.StorageNodeID]snpb.LogStreamUncommitReport{
+			1: {
+				LogStreamID: 1,
+				TxnSpans: []snpb.TxnSpan{
+					{Txn: txn, LLSNBegin: 1, LLSNEnd: 2, Deadline: now},
+					{Txn: txn, LLSNBegin: 5, LLSNEnd: 7, Deadline: now},
+				},
+			},
+			2: {
+				LogStreamID: 1,
+				TxnSpans: []snpb.TxnSpan{
+					{Txn: txn, LLSNBegin: 5, LLSNEnd: 6, Deadline: now.Add(time.Second)},
+					{Txn: txn, LLSNBegin: 3, LLSNEnd: 4, Deadline: now},
+				},
+			},
+		},
+	}
+	require.True(t, hasTxnSpans(reports))
+
+	spans := reportedTxnSpans(reports, 2)
+	require.Len(t, spans, 2)
+	require.Equal(t, types.LLSN(3), spans[0].LLSNBegin)
+	require.Equal(t, types.LLSN(5), spans[1].LLSNBegin)
+	require.Equal(t, types.LLSN(7), spans[1].LLSNEnd)
+	require.True(t, spans[1].Deadline.Equal(now.Add(time.Second)))
+
+	require.False(t, hasTxnSpans(&mrpb.LogStreamUncommitReports{}))
+}
@@ -6,61 +6,133 @@ import (
 	"github.com/cockroachdb/pebble"
 
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 var appendBatchPool = sync.Pool{
 	New: func() interface{} {
 		return &AppendBatch{
 			dk: make([]byte, dataKeyLength),
+			hk: make([]byte, headerKeyLength),
+			sk: make([]byte, checksumKeyLength),
+			sv: make([]byte, checksumValueLength),
 			ck: make([]byte, commitKeyLength),
 			cc: make([]byte, commitContextLength),
+			tk: make([]byte, timeKeyLength),
+			tv: make([]byte, timeValueLength),
 		}
 	},
 }
 
 // AppendBatch is a batch to put one or more log entries.
 type AppendBatch struct {
+	stg       *Storage
 	batch     *pebble.Batch
 	writeOpts *pebble.WriteOptions
 	dk        []byte
+	hk        []byte
+	sk        []byte
+	sv        []byte
 	ck        []byte
 	cc        []byte
+	tk        []byte
+	tv        []byte
+
+	// commitTime is the time set by SetCommitTime in nanoseconds. It is zero
+	// if the time is not set.
+	commitTime int64
 }
 
-func newAppendBatch(batch *pebble.Batch, writeOpts *pebble.WriteOptions) *AppendBatch {
+func newAppendBatch(stg *Storage, batch *pebble.Batch, writeOpts *pebble.WriteOptions) *AppendBatch {
 	ab := appendBatchPool.Get().(*AppendBatch)
+	ab.stg = stg
 	ab.batch = batch
 	ab.writeOpts = writeOpts
 	return ab
 }
 
 func (ab *AppendBatch) release() {
+	ab.stg = nil
 	ab.batch = nil
+	ab.commitTime = 0
 	ab.writeOpts = nil
 	appendBatchPool.Put(ab)
 }
 
-// SetLogEntry inserts a log entry.
-func (ab *AppendBatch) SetLogEntry(llsn types.LLSN, glsn types.GLSN, data []byte) error {
+// SetLogEntry inserts a log entry. The argument checksum is the CRC32C
+// checksum of the data. Since it overwrites the log entry at the same LLSN, it
+// also removes the headers of the overwritten one. Call SetHeaders to insert
+// headers of the log entry.
+func (ab *AppendBatch) SetLogEntry(llsn types.LLSN, glsn types.GLSN, data []byte, checksum uint32) error {
 	dk := encodeDataKeyInternal(llsn, ab.dk)
 	ck := encodeCommitKeyInternal(glsn, ab.ck)
 	if err := ab.batch.Set(dk, data, nil); err != nil {
 		return err
 	}
+	if err := ab.batch.Set(encodeChecksumKeyInternal(llsn, ab.sk), encodeChecksumValue(checksum, ab.sv), nil); err != nil {
+		return err
+	}
 	if err := ab.batch.Set(ck, dk, nil); err != nil {
 		return err
 	}
+	if err := ab.batch.Delete(encodeHeaderKeyInternal(llsn, ab.hk), nil); err != nil {
+		return err
+	}
 	return nil
 }
 
+// SetHeaders inserts headers of the log entry at the given LLSN. It does
+// nothing if the headers are empty.
+func (ab *AppendBatch) SetHeaders(llsn types.LLSN, headers map[string][]byte) error {
+	if len(headers) == 0 {
+		return nil
+	}
+	return ab.batch.Set(encodeHeaderKeyInternal(llsn, ab.hk), encodeHeaders(headers), nil)
+}
+
+// SetProducerState inserts the state of an idempotent producer. It
+// overwrites the previous state of the same producer.
+func (ab *AppendBatch) SetProducerState(ps varlogpb.ProducerState) error {
+	return setProducerState(ab.batch, ps)
+}
+
 // SetCommitContext inserts a commit context.
 func (ab *AppendBatch) SetCommitContext(cc CommitContext) error {
 	return ab.batch.Set(commitContextKey, encodeCommitContext(cc, ab.cc), nil)
 }
 
+// SetCommitTime inserts an entry of the time index copied from another
+// replica. Like CommitBatch.SetCommitTime, the commit time is moved to be
+// later than the latest one in the index if necessary.
+func (ab *AppendBatch) SetCommitTime(ct CommitTime) error {
+	if ct.GLSNBegin >= ct.GLSNEnd {
+		return nil
+	}
+	unixNano := ct.UnixNano
+	last := ab.stg.lastCommitTime.Load()
+	if last < ab.commitTime {
+		last = ab.commitTime
+	}
+	if unixNano <= last {
+		unixNano = last + 1
+	}
+	err := ab.batch.Set(encodeTimeKeyInternal(unixNano, ab.tk), encodeTimeValue(ct.GLSNBegin, ct.GLSNEnd, ab.tv), nil)
+	if err != nil {
+		return err
+	}
+	ab.commitTime = unixNano
+	return nil
+}
+
 // Apply saves a batch of appended log entries to the storage.
 func (ab *AppendBatch) Apply() error {
-	return ab.batch.Commit(ab.writeOpts)
+	if err := ab.batch.Commit(ab.writeOpts); err != nil {
+		return err
+	}
+	if ab.commitTime > 0 {
+		ab.stg.lastCommitTime.Store(ab.commitTime)
+	}
+	return nil
 }
 
 // Close releases an AppendBatch.
@@ -0,0 +1,416 @@
+package storage
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"go.uber.org/multierr"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+const (
+	archiveMagic = "VLGARCHV"
+	// archiveVersion is the version of archives written by Export. Archives of
+	// version 1 have neither commit times nor producer states.
+	archiveVersion   = byte(2)
+	archiveVersionV1 = byte(1)
+
+	archiveRecordLogEntry      = byte(1)
+	archiveRecordCommitContext = byte(2)
+	archiveRecordCommitTime    = byte(3)
+	archiveRecordProducerState = byte(4)
+
+	// maxArchiveRecordSize limits the size of a record to keep a corrupted
+	// length from allocating a huge buffer.
+	maxArchiveRecordSize = 1 << 30
+
+	// commitTimeRecordLength is the length of the unix nano and two GLSNs.
+	commitTimeRecordLength = 24
+)
+
+var ErrInvalidArchive = errors.New("storage: invalid archive")
+
+// Export writes committed log entries, their commit times, states of
+// idempotent producers, and the commit context of the storage into the writer
+// w as an archive, which can be restored by Import. It returns
+// ErrNoCommitContext if the storage has never committed.
+//
+// Log entries of aborted transactions are written without their data since
+// nobody reads them; they keep their positions so that LLSNs in the archive
+// have no gaps.
+//
+// An archive has the magic and version followed by records, and ends with the
+// record of the commit context:
+//
+//	magic | version | record | ... | record
+//
+// Each record has its kind, the length of its payload, the payload, and the
+// CRC32C checksum of all preceding bytes in the record:
+//
+//	kind | len(payload) | payload | checksum
+//
+// The payload of a log entry is encoded in the same way as one in a segment
+// of the cold tier, that of a commit time has the fields of CommitTime, and
+// that of a producer state is the marshaled varlogpb.ProducerState. The
+// payload of the commit context has the number of records of each kind in the
+// archive to detect missing records:
+//
+//	GLSN | LLSN | len(data) | data | len(headers) | headers
+//	unix nano | GLSN begin | GLSN end
+//	commit context | #log entries | #commit times | #producer states
+func (s *Storage) Export(w io.Writer) (cc CommitContext, err error) {
+	cc, err = s.ReadCommitContext()
+	if err != nil {
+		return cc, err
+	}
+	llsnEnd := cc.CommittedLLSNBegin + types.LLSN(cc.CommittedGLSNEnd-cc.CommittedGLSNBegin)
+
+	aw := &archiveWriter{w: bufio.NewWriter(w)}
+	aw.writeHeader()
+
+	var counts [3]uint64
+	scanner := s.NewScanner(WithGLSN(types.MinGLSN, cc.CommittedGLSNEnd))
+	defer func() {
+		err = multierr.Append(err, scanner.Close())
+	}()
+	for scanner.Valid() {
+		le, err := scanner.Value()
+		if err != nil {
+			return cc, err
+		}
+		if snpb.IsTxnAborted(le.Headers) {
+			le.Data = nil
+		}
+		aw.writeLogEntry(le)
+		counts[0]++
+		scanner.Next()
+	}
+
+	cts, err := s.ReadCommitTimes(types.MinGLSN, cc.CommittedGLSNEnd)
+	if err != nil {
+		return cc, err
+	}
+	var ctbuf [commitTimeRecordLength]byte
+	for _, ct := range cts {
+		binary.BigEndian.PutUint64(ctbuf[:], uint64(ct.UnixNano))
+		binary.BigEndian.PutUint64(ctbuf[8:], uint64(ct.GLSNBegin))
+		binary.BigEndian.PutUint64(ctbuf[8+types.GLSNLen:], uint64(ct.GLSNEnd))
+		aw.writeRecord(archiveRecordCommitTime, ctbuf[:])
+		counts[1]++
+	}
+
+	states, err := s.ReadProducerStates()
+	if err != nil {
+		return cc, err
+	}
+	for _, ps := range states {
+		// The state may have been updated by commits after the commit
+		// context. Such a producer can append its last batch again, as it
+		// can after Truncate.
+		if len(ps.LastBatch) > 0 && ps.LastBatch[len(ps.LastBatch)-1].LLSN >= llsnEnd {
+			continue
+		}
+		buf, err := ps.Marshal()
+		if err != nil {
+			return cc, err
+		}
+		aw.writeRecord(archiveRecordProducerState, buf)
+		counts[2]++
+	}
+
+	payload := make([]byte, commitContextLength+len(counts)*binary.MaxVarintLen64)
+	encodeCommitContext(cc, payload)
+	n := commitContextLength
+	for _, count := range counts {
+		n += binary.PutUvarint(payload[n:], count)
+	}
+	aw.writeRecord(archiveRecordCommitContext, payload[:n])
+	if aw.err != nil {
+		return cc, aw.err
+	}
+	return cc, aw.w.Flush()
+}
+
+// Import restores log entries, their commit times, states of idempotent
+// producers, and the commit context from the archive created by Export. The
+// storage must be empty; see NewIngester.
+func (s *Storage) Import(r io.Reader) (cc CommitContext, err error) {
+	ing, err := s.NewIngester()
+	if err != nil {
+		return cc, err
+	}
+	defer func() {
+		err = multierr.Append(err, ing.Close())
+	}()
+
+	cc, err = readArchive(r, archiveHandler{
+		logEntry:      ing.Add,
+		commitTime:    ing.AddCommitTime,
+		producerState: ing.AddProducerState,
+	})
+	if err != nil {
+		return cc, err
+	}
+	return cc, ing.Finish(cc)
+}
+
+// VerifyArchive reads the archive created by Export and checks its
+// integrity. It returns the commit context of the archive.
+func VerifyArchive(r io.Reader) (CommitContext, error) {
+	return readArchive(r, archiveHandler{})
+}
+
+// archiveHandler has callbacks for records read from an archive. A nil
+// callback ignores records of its kind.
+type archiveHandler struct {
+	logEntry      func(varlogpb.LogEntry) error
+	commitTime    func(CommitTime) error
+	producerState func(varlogpb.ProducerState) error
+}
+
+// readArchive reads records from the archive, passes them to the argument h,
+// and returns the commit context at the end of the archive.
+func readArchive(r io.Reader, h archiveHandler) (cc CommitContext, err error) {
+	ar := &archiveReader{r: bufio.NewReader(r)}
+	version, err := ar.readHeader()
+	if err != nil {
+		return cc, err
+	}
+
+	var counts [3]uint64
+	for {
+		kind, payload, err := ar.readRecord()
+		if err != nil {
+			if err == io.EOF {
+				err = fmt.Errorf("%w: no commit context", ErrInvalidArchive)
+			}
+			return cc, err
+		}
+		switch kind {
+		case archiveRecordLogEntry:
+			le, err := decodeArchiveLogEntry(payload)
+			if err != nil {
+				return cc, err
+			}
+			if h.logEntry != nil {
+				if err := h.logEntry(le); err != nil {
+					return cc, err
+				}
+			}
+			counts[0]++
+		case archiveRecordCommitTime:
+			if version == archiveVersionV1 || len(payload) != commitTimeRecordLength {
+				return cc, ErrInvalidArchive
+			}
+			ct := CommitTime{
+				UnixNano:  int64(binary.BigEndian.Uint64(payload)),
+				GLSNBegin: types.GLSN(binary.BigEndian.Uint64(payload[8:])),
+				GLSNEnd:   types.GLSN(binary.BigEndian.Uint64(payload[8+types.GLSNLen:])),
+			}
+			if h.commitTime != nil {
+				if err := h.commitTime(ct); err != nil {
+					return cc, err
+				}
+			}
+			counts[1]++
+		case archiveRecordProducerState:
+			var ps varlogpb.ProducerState
+			if version == archiveVersionV1 || ps.Unmarshal(payload) != nil {
+				return cc, ErrInvalidArchive
+			}
+			if h.producerState != nil {
+				if err := h.producerState(ps); err != nil {
+					return cc, err
+				}
+			}
+			counts[2]++
+		case archiveRecordCommitContext:
+			if len(payload) < commitContextLength {
+				return cc, ErrInvalidArchive
+			}
+			cc = decodeCommitContext(payload[:commitContextLength])
+			payload = payload[commitContextLength:]
+			numCounts := len(counts)
+			if version == archiveVersionV1 {
+				numCounts = 1
+			}
+			for i := 0; i < numCounts; i++ {
+				n, sz := binary.Uvarint(payload)
+				if sz <= 0 {
+					return cc, ErrInvalidArchive
+				}
+				payload = payload[sz:]
+				if n != counts[i] {
+					return cc, fmt.Errorf("%w: %d records of kind %d, expected %d", ErrInvalidArchive, counts[i], i, n)
+				}
+			}
+			if len(payload) != 0 {
+				return cc, ErrInvalidArchive
+			}
+			if _, _, err := ar.readRecord(); err != io.EOF {
+				if err == nil {
+					err = fmt.Errorf("%w: records after commit context", ErrInvalidArchive)
+				}
+				return cc, err
+			}
+			return cc, nil
+		default:
+			return cc, fmt.Errorf("%w: unknown record %d", ErrInvalidArchive, kind)
+		}
+	}
+}
+
+type archiveWriter struct {
+	w   *bufio.Writer
+	buf []byte
+	err error
+}
+
+func (aw *archiveWriter) writeHeader() {
+	_, aw.err = aw.w.WriteString(archiveMagic)
+	if aw.err == nil {
+		aw.err = aw.w.WriteByte(archiveVersion)
+	}
+}
+
+func (aw *archiveWriter) writeLogEntry(le varlogpb.LogEntry) {
+	var headers []byte
+	if len(le.Headers) > 0 {
+		headers = encodeHeaders(le.Headers)
+	}
+	size := types.GLSNLen + types.LLSNLen + 2*binary.MaxVarintLen64 + len(le.Data) + len(headers)
+	if cap(aw.buf) < size {
+		aw.buf = make([]byte, size)
+	}
+	buf := aw.buf[:size]
+	binary.BigEndian.PutUint64(buf, uint64(le.GLSN))
+	offset := types.GLSNLen
+	binary.BigEndian.PutUint64(buf[offset:], uint64(le.LLSN))
+	offset += types.LLSNLen
+	offset += binary.PutUvarint(buf[offset:], uint64(len(le.Data)))
+	offset += copy(buf[offset:], le.Data)
+	offset += binary.PutUvarint(buf[offset:], uint64(len(headers)))
+	offset += copy(buf[offset:], headers)
+	aw.writeRecord(archiveRecordLogEntry, buf[:offset])
+}
+
+func (aw *archiveWriter) writeRecord(kind byte, payload []byte) {
+	if aw.err != nil {
+		return
+	}
+	var hdr [1 + binary.MaxVarintLen64]byte
+	hdr[0] = kind
+	n := 1 + binary.PutUvarint(hdr[1:], uint64(len(payload)))
+	crc := varlogpb.Checksum(hdr[:n])
+	crc = varlogpb.UpdateChecksum(crc, payload)
+	var sum [crc32.Size]byte
+	binary.BigEndian.PutUint32(sum[:], crc)
+
+	for _, b := range [][]byte{hdr[:n], payload, sum[:]} {
+		if _, aw.err = aw.w.Write(b); aw.err != nil {
+			return
+		}
+	}
+}
+
+type archiveReader struct {
+	r   *bufio.Reader
+	buf []byte
+}
+
+// readHeader reads the magic and returns the version of the archive.
+func (ar *archiveReader) readHeader() (byte, error) {
+	var hdr [len(archiveMagic) + 1]byte
+	if _, err := io.ReadFull(ar.r, hdr[:]); err != nil || string(hdr[:len(archiveMagic)]) != archiveMagic {
+		return 0, ErrInvalidArchive
+	}
+	version := hdr[len(archiveMagic)]
+	if version != archiveVersion && version != archiveVersionV1 {
+		return 0, fmt.Errorf("%w: unknown version %d", ErrInvalidArchive, version)
+	}
+	return version, nil
+}
+
+// readRecord returns the kind and payload of the next record. It returns
+// io.EOF if there are no more records. The payload is valid until the next
+// call.
+func (ar *archiveReader) readRecord() (kind byte, payload []byte, err error) {
+	kind, err = ar.r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	size, err := binary.ReadUvarint(ar.r)
+	if err != nil || size > maxArchiveRecordSize {
+		return 0, nil, fmt.Errorf("%w: truncated or invalid record", ErrInvalidArchive)
+	}
+
+	var hdr [1 + binary.MaxVarintLen64]byte
+	hdr[0] = kind
+	n := 1 + binary.PutUvarint(hdr[1:], size)
+
+	if uint64(cap(ar.buf)) < size+crc32.Size {
+		ar.buf = make([]byte, size+crc32.Size)
+	}
+	buf := ar.buf[:size+crc32.Size]
+	if _, err := io.ReadFull(ar.r, buf); err != nil {
+		return 0, nil, fmt.Errorf("%w: truncated record", ErrInvalidArchive)
+	}
+	payload = buf[:size]
+	crc := varlogpb.Checksum(hdr[:n])
+	crc = varlogpb.UpdateChecksum(crc, payload)
+	if binary.BigEndian.Uint32(buf[size:]) != crc {
+		return 0, nil, fmt.Errorf("%w: checksum mismatch", ErrInvalidArchive)
+	}
+	return kind, payload, nil
+}
+
+// decodeArchiveLogEntry decodes the payload of a log entry record. Since the
+// payload is reused by the reader, the returned log entry does not refer to
+// it.
+func decodeArchiveLogEntry(payload []byte) (le varlogpb.LogEntry, err error) {
+	if len(payload) < types.GLSNLen+types.LLSNLen {
+		return le, ErrInvalidArchive
+	}
+	le.GLSN = types.GLSN(binary.BigEndian.Uint64(payload))
+	le.LLSN = types.LLSN(binary.BigEndian.Uint64(payload[types.GLSNLen:]))
+	payload = payload[types.GLSNLen+types.LLSNLen:]
+
+	readBytes := func() ([]byte, error) {
+		n, sz := binary.Uvarint(payload)
+		if sz <= 0 || uint64(len(payload)-sz) < n {
+			return nil, ErrInvalidArchive
+		}
+		ret := payload[sz : sz+int(n)]
+		payload = payload[sz+int(n):]
+		return ret, nil
+	}
+
+	data, err := readBytes()
+	if err != nil {
+		return le, err
+	}
+	if len(data) > 0 {
+		le.Data = append([]byte(nil), data...)
+	}
+	headers, err := readBytes()
+	if err != nil {
+		return le, err
+	}
+	if len(headers) > 0 {
+		le.Headers, err = decodeHeaders(headers)
+		if err != nil {
+			return le, err
+		}
+	}
+	if len(payload) != 0 {
+		return le, ErrInvalidArchive
+	}
+	return le, nil
+}
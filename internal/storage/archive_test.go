@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func TestStorage_ExportImport(t *testing.T) {
+	src := TestNewStorage(t)
+	defer func() {
+		require.NoError(t, src.Close())
+	}()
+
+	// Log entries having LLSN 1 and 2 are trimmed, the one having LLSN 11 is
+	// committed as a log entry of an aborted transaction, and the one having
+	// LLSN 12 is written but not committed.
+	base := time.Now()
+	testColdTierCommit(t, src, base, 1, 11)
+	require.NoError(t, src.Trim(4))
+	wb := src.NewWriteBatch()
+	require.NoError(t, wb.Set(11, []byte("aborted"), varlogpb.Checksum([]byte("aborted"))))
+	require.NoError(t, wb.Set(12, []byte("uncommitted"), varlogpb.Checksum([]byte("uncommitted"))))
+	require.NoError(t, wb.Apply())
+	require.NoError(t, wb.Close())
+
+	abortedHeaders := map[string][]byte{snpb.TxnAbortedHeader: []byte("txn")}
+	committed := varlogpb.ProducerState{
+		ProducerID:        "committed",
+		LastBatchSequence: 1,
+		LastBatch:         []varlogpb.LogEntryMeta{{LLSN: 11, GLSN: 22}},
+	}
+	cb, err := src.NewCommitBatch(CommitContext{
+		Version:            11,
+		HighWatermark:      22,
+		CommittedGLSNBegin: 22,
+		CommittedGLSNEnd:   23,
+		CommittedLLSNBegin: 11,
+	})
+	require.NoError(t, err)
+	require.NoError(t, cb.Set(11, 22))
+	require.NoError(t, cb.SetHeaders(11, abortedHeaders))
+	require.NoError(t, cb.SetCommitTime(base.Add(11*time.Second)))
+	require.NoError(t, cb.SetProducerState(committed))
+	// The state of a producer whose last batch is not committed yet.
+	require.NoError(t, cb.SetProducerState(varlogpb.ProducerState{
+		ProducerID:        "uncommitted",
+		LastBatchSequence: 1,
+		LastBatch:         []varlogpb.LogEntryMeta{{LLSN: 12}},
+	}))
+	require.NoError(t, cb.Apply())
+	require.NoError(t, cb.Close())
+
+	var buf bytes.Buffer
+	cc, err := src.Export(&buf)
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(23), cc.CommittedGLSNEnd)
+	archive := buf.Bytes()
+
+	got, err := VerifyArchive(bytes.NewReader(archive))
+	require.NoError(t, err)
+	require.Equal(t, cc, got)
+
+	dst := TestNewStorage(t)
+	defer func() {
+		require.NoError(t, dst.Close())
+	}()
+	got, err = dst.Import(bytes.NewReader(archive))
+	require.NoError(t, err)
+	require.Equal(t, cc, got)
+
+	// The log stream replica recovers from the restored storage as it does
+	// from the source one.
+	want, err := src.ReadRecoveryPoints()
+	require.NoError(t, err)
+	rp, err := dst.ReadRecoveryPoints()
+	require.NoError(t, err)
+	require.Equal(t, want, rp)
+
+	// The data of the aborted log entry is not exported.
+	var les []varlogpb.LogEntry
+	for llsn := types.LLSN(3); llsn < 11; llsn++ {
+		les = append(les, testColdTierLogEntry(llsn, true))
+	}
+	les = append(les, varlogpb.LogEntry{
+		LogEntryMeta: varlogpb.LogEntryMeta{LLSN: 11, GLSN: 22},
+		Headers:      abortedHeaders,
+		Checksum:     varlogpb.Checksum(nil),
+	})
+	require.Equal(t, les, testColdTierScanGLSN(t, dst, types.MinGLSN, types.MaxGLSN))
+
+	le, err := dst.Read(AtLLSN(10))
+	require.NoError(t, err)
+	require.Equal(t, testColdTierLogEntry(10, true), le)
+	_, err = dst.Read(AtLLSN(12))
+	require.ErrorIs(t, err, ErrNoLogEntry)
+
+	// Commit times are restored, and so are states of producers whose last
+	// batches are committed.
+	wantCTs, err := src.ReadCommitTimes(types.MinGLSN, types.MaxGLSN)
+	require.NoError(t, err)
+	require.NotEmpty(t, wantCTs)
+	cts, err := dst.ReadCommitTimes(types.MinGLSN, types.MaxGLSN)
+	require.NoError(t, err)
+	require.Equal(t, wantCTs, cts)
+	glsn, err := dst.SeekTime(base.Add(5 * time.Second))
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(10), glsn)
+
+	states, err := dst.ReadProducerStates()
+	require.NoError(t, err)
+	require.Equal(t, []varlogpb.ProducerState{committed}, states)
+
+	// The storage is not empty anymore.
+	_, err = dst.Import(bytes.NewReader(archive))
+	require.ErrorIs(t, err, ErrNotEmpty)
+}
+
+func TestStorage_ImportInvalidArchive(t *testing.T) {
+	src := TestNewStorage(t)
+	defer func() {
+		require.NoError(t, src.Close())
+	}()
+
+	_, err := src.Export(&bytes.Buffer{})
+	require.ErrorIs(t, err, ErrNoCommitContext)
+
+	testColdTierCommit(t, src, time.Now(), 1, 5)
+	var buf bytes.Buffer
+	_, err = src.Export(&buf)
+	require.NoError(t, err)
+	archive := buf.Bytes()
+
+	corrupted := append([]byte(nil), archive...)
+	corrupted[len(archiveMagic)+10] ^= 0xff
+
+	tcs := map[string][]byte{
+		"Empty":     nil,
+		"Magic":     []byte("VARLOG"),
+		"Truncated": archive[:len(archive)-1],
+		"Corrupted": corrupted,
+		"Trailing":  append(append([]byte(nil), archive...), archiveRecordLogEntry),
+	}
+	for name, archive := range tcs {
+		archive := archive
+		t.Run(name, func(t *testing.T) {
+			dst := TestNewStorage(t)
+			defer func() {
+				require.NoError(t, dst.Close())
+			}()
+
+			_, err := dst.Import(bytes.NewReader(archive))
+			require.ErrorIs(t, err, ErrInvalidArchive)
+
+			// Nothing is restored.
+			_, err = dst.ReadCommitContext()
+			require.ErrorIs(t, err, ErrNoCommitContext)
+			require.Empty(t, testColdTierScanGLSN(t, dst, types.MinGLSN, types.MaxGLSN))
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 func BenchmarkStorage_WriteBatch(b *testing.B) {
@@ -39,7 +40,7 @@ func BenchmarkStorage_WriteBatch(b *testing.B) {
 				for i := 0; i < b.N; i++ {
 					wb := stg.NewWriteBatch()
 					for j := 0; j < batchLen; j++ {
-						_ = wb.Set(types.LLSN(i), data)
+						_ = wb.Set(types.LLSN(i), data, varlogpb.Checksum(data))
 					}
 					if err := wb.Apply(); err != nil {
 						b.Error(err)
@@ -0,0 +1,236 @@
+package storage
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/cockroachdb/pebble"
+	"go.uber.org/multierr"
+
+	"github.com/kakao/varlog/internal/storage/objstore"
+	"github.com/kakao/varlog/pkg/types"
+)
+
+// Checkpoint creates a consistent copy of the storage in the directory dir,
+// which must not exist, and opens it. Since the copy is made of hard links to
+// immutable files of the storage where possible, it is cheap even for a large
+// storage. The caller must close the returned copy.
+//
+// If the cold tier is enabled, the copy must not share segments with the
+// storage since each of them deletes its segments when trimming log entries.
+// Hence, Checkpoint copies the segments into the cold tier under the argument
+// coldTierPrefix, which must have no objects, and the copy refers to them. It
+// keeps the storage from changing the cold tier until the segments are
+// copied. The copy is opened with the cold tier and coldTierPrefix, but it
+// does not move log entries to the cold tier.
+func (s *Storage) Checkpoint(dir, coldTierPrefix string) (*Storage, error) {
+	cfg := s.config
+	cfg.path = dir
+	cfg.readOnly = false
+	cfg.metricsLogInterval = 0
+	if s.coldTier.store != nil {
+		if coldTierPrefix == s.coldTier.prefix {
+			return nil, fmt.Errorf("storage: checkpoint: cold tier prefix %s shared with the storage", coldTierPrefix)
+		}
+		cfg.coldTier.prefix = coldTierPrefix
+		cfg.coldTier.noOffload = true
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkpoint(dir, coldTierPrefix); err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, fmt.Errorf("storage: checkpoint: %w", err)
+	}
+	cp, err := open(cfg)
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, fmt.Errorf("storage: checkpoint: %w", err)
+	}
+	return cp, nil
+}
+
+func (s *Storage) checkpoint(dir, coldTierPrefix string) error {
+	if s.coldTier.store == nil {
+		return s.db.Checkpoint(dir, pebble.WithFlushedWAL())
+	}
+	s.tier.opMu.Lock()
+	defer s.tier.opMu.Unlock()
+	if err := s.db.Checkpoint(dir, pebble.WithFlushedWAL()); err != nil {
+		return err
+	}
+	return copySegments(dir, s.coldTier.store, coldTierPrefix)
+}
+
+// CopyColdTier makes the storage in the directory dir own its segments in the
+// cold tier, for instance, after restoring the storage from a checkpoint. It
+// copies the segments the storage refers to into the cold tier set by the
+// options under the prefix set by WithColdTierPrefix, and the storage refers
+// to the copies. Since the storage deletes unreferenced objects under its
+// prefix when opened, it refuses the prefix having any objects, which may
+// belong to another storage. It does nothing if the cold tier is not set.
+func CopyColdTier(dir string, opts ...Option) error {
+	cfg, err := newConfig(append(opts, WithPath(dir)))
+	if err != nil {
+		return err
+	}
+	if cfg.coldTier.store == nil {
+		return nil
+	}
+	return copySegments(dir, cfg.coldTier.store, cfg.coldTier.prefix)
+}
+
+// copySegments copies segments referenced by the database in the directory
+// dir into the object store under the prefix, and then makes the database
+// refer to the copies. The prefix must have no objects.
+func copySegments(dir string, store objstore.ObjectStore, prefix string) (err error) {
+	ctx := context.Background()
+	keys, err := store.List(ctx, prefix+"/")
+	if err != nil {
+		return fmt.Errorf("storage: cold tier: %w", err)
+	}
+	if len(keys) > 0 {
+		return fmt.Errorf("storage: cold tier: prefix %s has objects already", prefix)
+	}
+
+	db, err := pebble.Open(dir, &pebble.Options{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, db.Close())
+	}()
+
+	it := db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{segmentKeyPrefix},
+		UpperBound: []byte{segmentKeySentinelPrefix},
+	})
+	var segs []segmentMeta
+	for it.First(); it.Valid(); it.Next() {
+		segs = append(segs, decodeSegmentMeta(it.Key(), it.Value()))
+	}
+	if err := multierr.Append(it.Error(), it.Close()); err != nil {
+		return err
+	}
+
+	batch := db.NewBatch()
+	defer func() {
+		_ = batch.Close()
+	}()
+	for _, seg := range segs {
+		buf, err := store.Get(ctx, seg.objectKey)
+		if err != nil {
+			return fmt.Errorf("storage: cold tier: %s: %w", seg.objectKey, err)
+		}
+		seg.objectKey = path.Join(prefix, path.Base(seg.objectKey))
+		if err := store.Put(ctx, seg.objectKey, buf); err != nil {
+			return fmt.Errorf("storage: cold tier: %w", err)
+		}
+		_ = batch.Set(encodeSegmentKey(seg.last.GLSN), encodeSegmentValue(seg), nil)
+	}
+	return batch.Commit(pebble.Sync)
+}
+
+// Truncate deletes log entries committed after the argument cc and ones not
+// committed yet, and then stores cc as the commit context. It rolls the
+// storage back to the commit, which should be one of the commits the storage
+// has already applied, for instance, to align a checkpoint with a
+// consistent cut of the cluster. It fails if log entries to be deleted are
+// in the cold tier.
+func (s *Storage) Truncate(cc CommitContext) error {
+	glsnEnd := cc.CommittedGLSNEnd
+	llsnEnd := cc.CommittedLLSNBegin + types.LLSN(cc.CommittedGLSNEnd-cc.CommittedGLSNBegin)
+
+	// A storage that has never committed can be truncated to a commit having
+	// no log entries.
+	curLLSNEnd := types.MinLLSN
+	cur, err := s.ReadCommitContext()
+	if err == nil {
+		curLLSNEnd = cur.CommittedLLSNBegin + types.LLSN(cur.CommittedGLSNEnd-cur.CommittedGLSNBegin)
+	} else if !errors.Is(err, ErrNoCommitContext) {
+		return err
+	}
+	if curLLSNEnd < llsnEnd {
+		return fmt.Errorf("storage: truncate: commit context %+v beyond %+v", cc, cur)
+	}
+
+	it := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{segmentKeyPrefix},
+		UpperBound: []byte{segmentKeySentinelPrefix},
+	})
+	if it.Last() && types.GLSN(binary.BigEndian.Uint64(it.Key()[1:])) >= glsnEnd {
+		_ = it.Close()
+		return errors.New("storage: truncate: log entries in the cold tier")
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	defer func() {
+		_ = batch.Close()
+	}()
+
+	ck := make([]byte, commitKeyLength)
+	_ = batch.DeleteRange(encodeCommitKeyInternal(glsnEnd, ck), []byte{commitKeySentinelPrefix}, nil)
+	dk := make([]byte, dataKeyLength)
+	_ = batch.DeleteRange(encodeDataKeyInternal(llsnEnd, dk), []byte{dataKeySentinelPrefix}, nil)
+	sk := make([]byte, checksumKeyLength)
+	_ = batch.DeleteRange(encodeChecksumKeyInternal(llsnEnd, sk), []byte{checksumKeySentinelPrefix}, nil)
+	hk := make([]byte, headerKeyLength)
+	_ = batch.DeleteRange(encodeHeaderKeyInternal(llsnEnd, hk), []byte{headerKeySentinelPrefix}, nil)
+	if err := s.truncateTimeIndex(batch, glsnEnd); err != nil {
+		return err
+	}
+
+	// Producers whose last batches are deleted can append them again.
+	states, err := s.ReadProducerStates()
+	if err != nil {
+		return err
+	}
+	for _, ps := range states {
+		if len(ps.LastBatch) > 0 && ps.LastBatch[len(ps.LastBatch)-1].LLSN >= llsnEnd {
+			_ = batch.Delete(encodeProducerKey(ps.ProducerID), nil)
+		}
+	}
+
+	_ = batch.Set(commitContextKey, encodeCommitContext(cc, make([]byte, commitContextLength)), nil)
+	if err := batch.Commit(s.writeOpts); err != nil {
+		return err
+	}
+
+	s.lastCommitTime.Store(0)
+	return s.loadLastCommitTime()
+}
+
+// truncateTimeIndex deletes entries of the time index for commits whose log
+// entries are all deleted, that is, their GLSNs are greater than or equal to
+// the argument glsn.
+func (s *Storage) truncateTimeIndex(batch *pebble.Batch, glsn types.GLSN) error {
+	it := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{timeKeyPrefix},
+		UpperBound: []byte{timeKeySentinelPrefix},
+	})
+	defer func() {
+		_ = it.Close()
+	}()
+	var lower []byte
+	for it.Last(); it.Valid(); it.Prev() {
+		if begin, _ := decodeTimeValue(it.Value()); begin < glsn {
+			break
+		}
+		lower = append(lower[:0], it.Key()...)
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if lower == nil {
+		return nil
+	}
+	return batch.DeleteRange(lower, []byte{timeKeySentinelPrefix}, nil)
+}
@@ -0,0 +1,144 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/internal/storage/objstore"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func TestStorage_CheckpointTruncate(t *testing.T) {
+	base := time.Now()
+	stg := TestNewStorage(t)
+	defer func() {
+		require.NoError(t, stg.Close())
+	}()
+	testColdTierCommit(t, stg, base, 1, 11)
+
+	dir := filepath.Join(t.TempDir(), "checkpoint")
+	cp, err := stg.Checkpoint(dir, "")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, cp.Close())
+	}()
+
+	// Changes after the checkpoint are not visible to the copy.
+	testColdTierCommit(t, stg, base, 11, 13)
+
+	cc, err := cp.ReadCommitContext()
+	require.NoError(t, err)
+	require.Equal(t, types.Version(10), cc.Version)
+
+	// The commit context is not yet applied.
+	err = cp.Truncate(CommitContext{
+		Version:            11,
+		HighWatermark:      22,
+		CommittedGLSNBegin: 22,
+		CommittedGLSNEnd:   23,
+		CommittedLLSNBegin: 11,
+	})
+	require.Error(t, err)
+
+	cc = CommitContext{
+		Version:            5,
+		HighWatermark:      10,
+		CommittedGLSNBegin: 10,
+		CommittedGLSNEnd:   11,
+		CommittedLLSNBegin: 5,
+	}
+	require.NoError(t, cp.Truncate(cc))
+
+	got, err := cp.ReadCommitContext()
+	require.NoError(t, err)
+	require.Equal(t, cc, got)
+
+	var les []varlogpb.LogEntry
+	for llsn := types.LLSN(1); llsn <= 5; llsn++ {
+		les = append(les, testColdTierLogEntry(llsn, true))
+	}
+	require.Equal(t, les, testColdTierScanGLSN(t, cp, types.MinGLSN, types.MaxGLSN))
+
+	_, err = cp.Read(AtLLSN(6))
+	require.ErrorIs(t, err, ErrNoLogEntry)
+
+	rp, err := cp.ReadRecoveryPoints()
+	require.NoError(t, err)
+	require.Equal(t, &varlogpb.LogEntryMeta{GLSN: 10, LLSN: 5}, rp.CommittedLogEntry.Last)
+
+	glsn, err := cp.SeekTime(base.Add(5 * time.Second))
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(10), glsn)
+	_, err = cp.SeekTime(base.Add(6 * time.Second))
+	require.ErrorIs(t, err, ErrNoLogEntry)
+
+	// The source storage is not affected.
+	require.Len(t, testColdTierScanGLSN(t, stg, types.MinGLSN, types.MaxGLSN), 12)
+}
+
+func TestStorage_CheckpointColdTier(t *testing.T) {
+	ctx := context.Background()
+	store, err := objstore.NewFileSystem(t.TempDir())
+	require.NoError(t, err)
+	opts := []Option{
+		WithColdTier(store),
+		WithColdTierSegmentSize(12),
+		WithColdTierCheckInterval(time.Hour),
+	}
+	stg := TestNewStorage(t, append(opts, WithColdTierPrefix("ls"))...)
+	defer func() {
+		require.NoError(t, stg.Close())
+	}()
+
+	base := time.Now()
+	testColdTierCommit(t, stg, base, 1, 11)
+	require.NoError(t, stg.offload(base.Add(5500*time.Millisecond)))
+
+	var want []varlogpb.LogEntry
+	for llsn := types.LLSN(1); llsn <= 10; llsn++ {
+		want = append(want, testColdTierLogEntry(llsn, true))
+	}
+
+	// The copy cannot share the prefix with the storage.
+	_, err = stg.Checkpoint(filepath.Join(t.TempDir(), "checkpoint"), "ls")
+	require.Error(t, err)
+
+	dir := filepath.Join(t.TempDir(), "checkpoint")
+	cp, err := stg.Checkpoint(dir, "backup/ls")
+	require.NoError(t, err)
+	require.Equal(t, want, testColdTierScanGLSN(t, cp, types.MinGLSN, types.MaxGLSN))
+	require.NoError(t, cp.Close())
+
+	// Trimming the storage deletes its segments, but not ones of the copy.
+	require.NoError(t, stg.Trim(types.GLSN(20)))
+	keys, err := store.List(ctx, "ls/")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+	keys, err = store.List(ctx, "backup/ls/")
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+
+	// The copy refers to segments not under the prefix of the storage.
+	_, err = New(append(opts, WithPath(dir), WithColdTierPrefix("restored"))...)
+	require.Error(t, err)
+
+	// Restoring refuses the prefix having objects of another storage.
+	require.NoError(t, store.Put(ctx, "restored/other.seg", []byte("other")))
+	require.Error(t, CopyColdTier(dir, append(opts, WithColdTierPrefix("restored"))...))
+	require.NoError(t, store.Delete(ctx, "restored/other.seg"))
+
+	require.NoError(t, CopyColdTier(dir, append(opts, WithColdTierPrefix("restored"))...))
+	restored := TestNewStorage(t, append(opts, WithPath(dir), WithColdTierPrefix("restored"))...)
+	defer func() {
+		require.NoError(t, restored.Close())
+	}()
+	require.Equal(t, want, testColdTierScanGLSN(t, restored, types.MinGLSN, types.MaxGLSN))
+	keys, err = store.List(ctx, "backup/ls/")
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+}
@@ -2,10 +2,12 @@ package storage
 
 import (
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/pebble"
 
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 var commitBatchPool = sync.Pool{
@@ -14,28 +16,46 @@ var commitBatchPool = sync.Pool{
 			cc: make([]byte, commitContextLength),
 			ck: make([]byte, commitKeyLength),
 			dk: make([]byte, dataKeyLength),
+			tk: make([]byte, timeKeyLength),
+			tv: make([]byte, timeValueLength),
+			hk: make([]byte, headerKeyLength),
 		}
 	},
 }
 
 type CommitBatch struct {
+	stg       *Storage
 	batch     *pebble.Batch
 	writeOpts *pebble.WriteOptions
 	cc        []byte
 	ck        []byte
 	dk        []byte
+	tk        []byte
+	tv        []byte
+	hk        []byte
+	// glsnBegin and glsnEnd are the range of GLSNs committed by the batch.
+	glsnBegin types.GLSN
+	glsnEnd   types.GLSN
+	// commitTime is the time set by SetCommitTime in nanoseconds. It is
+	// zero if the time is not set.
+	commitTime int64
 }
 
-func newCommitBatch(batch *pebble.Batch, writeOpts *pebble.WriteOptions) *CommitBatch {
+func newCommitBatch(stg *Storage, cc CommitContext, batch *pebble.Batch, writeOpts *pebble.WriteOptions) *CommitBatch {
 	cb := commitBatchPool.Get().(*CommitBatch)
+	cb.stg = stg
 	cb.batch = batch
 	cb.writeOpts = writeOpts
+	cb.glsnBegin = cc.CommittedGLSNBegin
+	cb.glsnEnd = cc.CommittedGLSNEnd
 	return cb
 }
 
 func (cb *CommitBatch) release() {
+	cb.stg = nil
 	cb.batch = nil
 	cb.writeOpts = nil
+	cb.commitTime = 0
 	commitBatchPool.Put(cb)
 }
 
@@ -43,8 +63,54 @@ func (cb *CommitBatch) Set(llsn types.LLSN, glsn types.GLSN) error {
 	return cb.batch.Set(encodeCommitKeyInternal(glsn, cb.ck), encodeDataKeyInternal(llsn, cb.dk), nil)
 }
 
+// SetHeaders replaces the headers of the log entry at the llsn. It is used to
+// mark log entries committed by the batch, for instance, as members of an
+// aborted transaction.
+func (cb *CommitBatch) SetHeaders(llsn types.LLSN, headers map[string][]byte) error {
+	return cb.batch.Set(encodeHeaderKeyInternal(llsn, cb.hk), encodeHeaders(headers), nil)
+}
+
+// SetProducerState inserts the state of an idempotent producer. It
+// overwrites the previous state of the same producer.
+func (cb *CommitBatch) SetProducerState(ps varlogpb.ProducerState) error {
+	return setProducerState(cb.batch, ps)
+}
+
+// DeleteProducerState deletes the state of an idempotent producer. It does
+// nothing if there is no state of the producer.
+func (cb *CommitBatch) DeleteProducerState(producerID string) error {
+	return cb.batch.Delete(encodeProducerKey(producerID), nil)
+}
+
+// SetCommitTime inserts an entry of the time index, which maps the argument
+// commitTime to the range of GLSNs committed by the batch. It does nothing if
+// the batch commits no log entries.
+// Times in the index always increase even if the clock goes backward; hence,
+// the commit time can be slightly later than the argument commitTime.
+func (cb *CommitBatch) SetCommitTime(commitTime time.Time) error {
+	if cb.glsnBegin >= cb.glsnEnd {
+		return nil
+	}
+	unixNano := commitTime.UnixNano()
+	if last := cb.stg.lastCommitTime.Load(); unixNano <= last {
+		unixNano = last + 1
+	}
+	err := cb.batch.Set(encodeTimeKeyInternal(unixNano, cb.tk), encodeTimeValue(cb.glsnBegin, cb.glsnEnd, cb.tv), nil)
+	if err != nil {
+		return err
+	}
+	cb.commitTime = unixNano
+	return nil
+}
+
 func (cb *CommitBatch) Apply() error {
-	return cb.batch.Commit(cb.writeOpts)
+	if err := cb.batch.Commit(cb.writeOpts); err != nil {
+		return err
+	}
+	if cb.commitTime > 0 {
+		cb.stg.lastCommitTime.Store(cb.commitTime)
+	}
+	return nil
 }
 
 func (cb *CommitBatch) Close() error {
@@ -6,6 +6,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/kakao/varlog/internal/storage/objstore"
 	"github.com/kakao/varlog/pkg/types"
 )
 
@@ -18,6 +19,9 @@ const (
 	DefaultMemTableStopWritesThreshold = 2
 	DefaultMaxConcurrentCompactions    = 1
 	DefaultMetricsLogInterval          = time.Duration(0)
+	DefaultColdTierAge                 = 7 * 24 * time.Hour
+	DefaultColdTierCheckInterval       = time.Minute
+	DefaultColdTierSegmentSize         = 16 << 20
 )
 
 type config struct {
@@ -36,6 +40,17 @@ type config struct {
 	metricsLogInterval          time.Duration
 	logger                      *zap.Logger
 
+	coldTier struct {
+		store         objstore.ObjectStore
+		prefix        string
+		age           time.Duration
+		checkInterval time.Duration
+		segmentSize   int
+		// noOffload disables moving log entries to the cold tier, for
+		// instance, for a checkpoint.
+		noOffload bool
+	}
+
 	readOnly bool
 }
 
@@ -56,6 +71,9 @@ func newConfig(opts []Option) (config, error) {
 		metricsLogInterval: DefaultMetricsLogInterval,
 		logger:             zap.NewNop(),
 	}
+	cfg.coldTier.age = DefaultColdTierAge
+	cfg.coldTier.checkInterval = DefaultColdTierCheckInterval
+	cfg.coldTier.segmentSize = DefaultColdTierSegmentSize
 	for _, opt := range opts {
 		opt.apply(&cfg)
 	}
@@ -72,6 +90,20 @@ func (cfg config) validate() error {
 	if cfg.sync && !cfg.wal {
 		return errors.New("storage: sync, but wal disabled")
 	}
+	if cfg.coldTier.store != nil {
+		if len(cfg.coldTier.prefix) == 0 {
+			return errors.New("storage: cold tier: no prefix")
+		}
+		if cfg.coldTier.age <= 0 {
+			return errors.New("storage: cold tier: non-positive age")
+		}
+		if cfg.coldTier.checkInterval <= 0 {
+			return errors.New("storage: cold tier: non-positive check interval")
+		}
+		if cfg.coldTier.segmentSize <= 0 {
+			return errors.New("storage: cold tier: non-positive segment size")
+		}
+	}
 	return nil
 }
 
@@ -169,8 +201,59 @@ func WithLogger(logger *zap.Logger) Option {
 	})
 }
 
-// ReadOnly makes storage read-only. It is helpful only for testing. Usually,
-// users do not have to call it.
+// WithColdTier sets the object store used as the cold tier. Log entries
+// committed earlier than the age set by WithColdTierAge are moved from the
+// local disk to the object store as immutable segments, and reads for them
+// are served from the object store transparently. The cold tier is disabled
+// if the store is nil, which is the default.
+//
+// Storages sharing an object store must have distinct prefixes set by
+// WithColdTierPrefix.
+func WithColdTier(store objstore.ObjectStore) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.coldTier.store = store
+	})
+}
+
+// WithColdTierPrefix sets the prefix of keys of segments stored in the cold
+// tier. It is required if the cold tier is enabled. The storage regards all
+// objects under the prefix as its own; it deletes objects not referenced by
+// it when opened. Note that removing the storage directory leaves its
+// segments in the cold tier until a storage with the same prefix is opened.
+func WithColdTierPrefix(prefix string) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.coldTier.prefix = prefix
+	})
+}
+
+// WithColdTierAge sets how long log entries stay on the local disk after
+// being committed. Its default is DefaultColdTierAge.
+func WithColdTierAge(age time.Duration) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.coldTier.age = age
+	})
+}
+
+// WithColdTierCheckInterval sets the interval for checking log entries to be
+// moved to the cold tier. Its default is DefaultColdTierCheckInterval.
+func WithColdTierCheckInterval(checkInterval time.Duration) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.coldTier.checkInterval = checkInterval
+	})
+}
+
+// WithColdTierSegmentSize sets the maximum size of a segment in bytes. A
+// segment can be larger than it if a single log entry is larger. Its default
+// is DefaultColdTierSegmentSize.
+func WithColdTierSegmentSize(segmentSize int) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.coldTier.segmentSize = segmentSize
+	})
+}
+
+// ReadOnly makes storage read-only. It is helpful for testing and for tools
+// reading a storage that no storage node opens, for instance, exporting a log
+// stream replica. Usually, users do not have to call it.
 func ReadOnly() Option {
 	return newFuncOption(func(cfg *config) {
 		cfg.readOnly = true
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"encoding/binary"
+	"sort"
 	"unsafe"
 
 	"github.com/kakao/varlog/pkg/types"
@@ -12,10 +13,32 @@ const (
 	dataKeySentinelPrefix = byte(0x41)
 	dataKeyLength         = 9 // prefix(1) + LLSN(8)
 
+	headerKeyPrefix         = byte(0x50)
+	headerKeySentinelPrefix = byte(0x51)
+	headerKeyLength         = 9 // prefix(1) + LLSN(8)
+
+	producerKeyPrefix         = byte(0x60)
+	producerKeySentinelPrefix = byte(0x61)
+
+	checksumKeyPrefix         = byte(0x70)
+	checksumKeySentinelPrefix = byte(0x71)
+	checksumKeyLength         = 9 // prefix(1) + LLSN(8)
+	checksumValueLength       = 4 // CRC32C(4)
+
 	commitKeyPrefix         = byte(0x80)
 	commitKeySentinelPrefix = byte(0x81)
 	commitKeyLength         = 9 // prefix(1) + GLSN(8)
 
+	timeKeyPrefix         = byte(0x90)
+	timeKeySentinelPrefix = byte(0x91)
+	timeKeyLength         = 9  // prefix(1) + UnixNano(8)
+	timeValueLength       = 16 // GLSNBegin(8) + GLSNEnd(8)
+
+	segmentKeyPrefix         = byte(0xa0)
+	segmentKeySentinelPrefix = byte(0xa1)
+	segmentKeyLength         = 9  // prefix(1) + LastGLSN(8)
+	segmentValueMinLength    = 24 // FirstGLSN(8) + FirstLLSN(8) + LastLLSN(8)
+
 	commitContextKeyMarker = byte(0xc0)
 	commitContextLength    = 40
 )
@@ -35,6 +58,108 @@ func decodeDataKey(k []byte) types.LLSN {
 	return types.LLSN(binary.BigEndian.Uint64(k[1:]))
 }
 
+// encodeChecksumKeyInternal returns a key for the checksum of the data of the
+// log entry at the llsn. Checksums are stored apart from data so that values
+// of data keys written before checksums were introduced remain readable.
+func encodeChecksumKeyInternal(llsn types.LLSN, key []byte) []byte {
+	key[0] = checksumKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], uint64(llsn))
+	return key
+}
+
+func encodeChecksumValue(checksum uint32, value []byte) []byte {
+	binary.BigEndian.PutUint32(value, checksum)
+	return value
+}
+
+func decodeChecksumValue(v []byte) uint32 {
+	if len(v) != checksumValueLength {
+		panic("storage: invalid value type")
+	}
+	return binary.BigEndian.Uint32(v)
+}
+
+func encodeHeaderKeyInternal(llsn types.LLSN, key []byte) []byte {
+	key[0] = headerKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], uint64(llsn))
+	return key
+}
+
+// encodeHeaders serializes headers of a log entry into a byte slice. Keys are
+// sorted to make the result deterministic. Each key and value is prefixed by
+// its length, and the number of headers comes first:
+//
+//	count | len(key1) | key1 | len(value1) | value1 | ...
+func encodeHeaders(headers map[string][]byte) []byte {
+	keys := make([]string, 0, len(headers))
+	size := binary.MaxVarintLen64
+	for key, value := range headers {
+		keys = append(keys, key)
+		size += 2*binary.MaxVarintLen64 + len(key) + len(value)
+	}
+	sort.Strings(keys)
+
+	buf := make([]byte, size)
+	offset := binary.PutUvarint(buf, uint64(len(keys)))
+	for _, key := range keys {
+		value := headers[key]
+		offset += binary.PutUvarint(buf[offset:], uint64(len(key)))
+		offset += copy(buf[offset:], key)
+		offset += binary.PutUvarint(buf[offset:], uint64(len(value)))
+		offset += copy(buf[offset:], value)
+	}
+	return buf[:offset]
+}
+
+// decodeHeaders deserializes headers encoded by encodeHeaders. It copies
+// the keys and values; thus, the argument buf can be reused.
+func decodeHeaders(buf []byte) (map[string][]byte, error) {
+	readBytes := func() ([]byte, error) {
+		n, sz := binary.Uvarint(buf)
+		if sz <= 0 || uint64(len(buf)-sz) < n {
+			return nil, ErrInvalidHeaders
+		}
+		ret := buf[sz : sz+int(n)]
+		buf = buf[sz+int(n):]
+		return ret, nil
+	}
+
+	count, sz := binary.Uvarint(buf)
+	if sz <= 0 {
+		return nil, ErrInvalidHeaders
+	}
+	buf = buf[sz:]
+	// Each header needs at least two bytes for lengths of the key and value.
+	if count > uint64(len(buf))/2 {
+		return nil, ErrInvalidHeaders
+	}
+	headers := make(map[string][]byte, count)
+	for i := uint64(0); i < count; i++ {
+		key, err := readBytes()
+		if err != nil {
+			return nil, err
+		}
+		value, err := readBytes()
+		if err != nil {
+			return nil, err
+		}
+		headers[string(key)] = append([]byte(nil), value...)
+	}
+	if len(buf) != 0 {
+		return nil, ErrInvalidHeaders
+	}
+	return headers, nil
+}
+
+// encodeProducerKey returns a key for the state of the producer. The key
+// consists of the prefix and the producer ID.
+func encodeProducerKey(producerID string) []byte {
+	key := make([]byte, 1+len(producerID))
+	key[0] = producerKeyPrefix
+	copy(key[1:], producerID)
+	return key
+}
+
 func encodeCommitKeyInternal(glsn types.GLSN, key []byte) []byte {
 	key[0] = commitKeyPrefix
 	binary.BigEndian.PutUint64(key[1:], uint64(glsn))
@@ -48,6 +173,80 @@ func decodeCommitKey(k []byte) types.GLSN {
 	return types.GLSN(binary.BigEndian.Uint64(k[1:]))
 }
 
+// encodeTimeKeyInternal returns a key of the time index. Since the key has the
+// time in nanoseconds in big-endian, keys of the time index are sorted by
+// time.
+func encodeTimeKeyInternal(unixNano int64, key []byte) []byte {
+	key[0] = timeKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], uint64(unixNano))
+	return key
+}
+
+func decodeTimeKey(k []byte) int64 {
+	if k[0] != timeKeyPrefix || len(k) != timeKeyLength {
+		panic("storage: invalid key type")
+	}
+	return int64(binary.BigEndian.Uint64(k[1:]))
+}
+
+// encodeTimeValue serializes the range of GLSNs committed at a time.
+func encodeTimeValue(begin, end types.GLSN, value []byte) []byte {
+	binary.BigEndian.PutUint64(value[0:types.GLSNLen], uint64(begin))
+	binary.BigEndian.PutUint64(value[types.GLSNLen:], uint64(end))
+	return value
+}
+
+func decodeTimeValue(v []byte) (begin, end types.GLSN) {
+	if len(v) != timeValueLength {
+		panic("storage: invalid value type")
+	}
+	begin = types.GLSN(binary.BigEndian.Uint64(v[0:types.GLSNLen]))
+	end = types.GLSN(binary.BigEndian.Uint64(v[types.GLSNLen:]))
+	return begin, end
+}
+
+// encodeSegmentKey returns a key of the catalog of segments in the cold tier.
+// Since the key has the last GLSN of the segment, seeking the key of a GLSN
+// finds the segment that can contain the log entry.
+func encodeSegmentKey(lastGLSN types.GLSN) []byte {
+	key := make([]byte, segmentKeyLength)
+	key[0] = segmentKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], uint64(lastGLSN))
+	return key
+}
+
+func decodeSegmentKey(k []byte) types.GLSN {
+	if k[0] != segmentKeyPrefix || len(k) != segmentKeyLength {
+		panic("storage: invalid key type")
+	}
+	return types.GLSN(binary.BigEndian.Uint64(k[1:]))
+}
+
+// encodeSegmentValue serializes the rest of the metadata of a segment, which
+// is not in the key:
+//
+//	FirstGLSN | FirstLLSN | LastLLSN | ObjectKey
+func encodeSegmentValue(seg segmentMeta) []byte {
+	value := make([]byte, segmentValueMinLength+len(seg.objectKey))
+	binary.BigEndian.PutUint64(value[0:], uint64(seg.first.GLSN))
+	binary.BigEndian.PutUint64(value[8:], uint64(seg.first.LLSN))
+	binary.BigEndian.PutUint64(value[16:], uint64(seg.last.LLSN))
+	copy(value[segmentValueMinLength:], seg.objectKey)
+	return value
+}
+
+func decodeSegmentMeta(k, v []byte) (seg segmentMeta) {
+	if len(v) < segmentValueMinLength {
+		panic("storage: invalid value type")
+	}
+	seg.last.GLSN = decodeSegmentKey(k)
+	seg.first.GLSN = types.GLSN(binary.BigEndian.Uint64(v[0:]))
+	seg.first.LLSN = types.LLSN(binary.BigEndian.Uint64(v[8:]))
+	seg.last.LLSN = types.LLSN(binary.BigEndian.Uint64(v[16:]))
+	seg.objectKey = string(v[segmentValueMinLength:])
+	return seg
+}
+
 // encodeCommitContext serializes commit context into byte slice.
 func encodeCommitContext(cc CommitContext, key []byte) []byte {
 	sz := types.GLSNLen
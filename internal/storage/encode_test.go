@@ -19,6 +19,45 @@ func TestEncodeCommitContextUnsafe(t *testing.T) {
 	require.Equal(t, expected, actual)
 }
 
+func TestEncodeHeaders(t *testing.T) {
+	tcs := []map[string][]byte{
+		{},
+		{"key": nil},
+		{"key": []byte("value")},
+		{"foo": []byte("1"), "bar": []byte("22"), "": []byte("empty key")},
+	}
+	for _, expected := range tcs {
+		buf := encodeHeaders(expected)
+		actual, err := decodeHeaders(buf)
+		require.NoError(t, err)
+		require.Len(t, actual, len(expected))
+		for key, value := range expected {
+			require.Contains(t, actual, key)
+			require.Equal(t, string(value), string(actual[key]))
+		}
+	}
+
+	// deterministic
+	headers := map[string][]byte{"a": []byte("1"), "b": []byte("2"), "c": []byte("3")}
+	require.Equal(t, encodeHeaders(headers), encodeHeaders(headers))
+}
+
+func TestDecodeHeaders_Invalid(t *testing.T) {
+	buf := encodeHeaders(map[string][]byte{"key": []byte("value")})
+	tcs := map[string][]byte{
+		"Empty":        {},
+		"Truncated":    buf[:len(buf)-1],
+		"TrailingByte": append(append([]byte(nil), buf...), 0),
+		"HugeCount":    {0xff, 0xff, 0xff, 0xff, 0x0f},
+	}
+	for name, buf := range tcs {
+		t.Run(name, func(t *testing.T) {
+			_, err := decodeHeaders(buf)
+			require.ErrorIs(t, err, ErrInvalidHeaders)
+		})
+	}
+}
+
 func BenchmarkCommitContext_Decode(b *testing.B) {
 	tcs := []struct {
 		name   string
@@ -0,0 +1,241 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/sstable"
+	"go.uber.org/multierr"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+var ErrNotEmpty = errors.New("storage: not empty")
+
+// Ingester loads committed log entries into an empty storage in bulk. Unlike
+// batches, it writes log entries into sstables and ingests them into the
+// storage at once, which is much faster to restore a large number of log
+// entries. Log entries must be added in order of LLSN without gaps.
+//
+// Commit times of ingested log entries are added by AddCommitTime. Without
+// them, the ingested log entries cannot be found by their commit times.
+// Likewise, states of idempotent producers are added by AddProducerState;
+// without them, producers can append their last batches again.
+type Ingester struct {
+	stg *Storage
+	dir string
+
+	data      ingestTable
+	checksums ingestTable
+	headers   ingestTable
+	commits   ingestTable
+	times     ingestTable
+
+	first varlogpb.LogEntryMeta
+	last  varlogpb.LogEntryMeta
+
+	// lastCommitTime is the commit time added lastly in nanoseconds.
+	lastCommitTime int64
+
+	producerStates []varlogpb.ProducerState
+
+	dk []byte
+	sk []byte
+	sv []byte
+	hk []byte
+	ck []byte
+	tk []byte
+	tv []byte
+}
+
+type ingestTable struct {
+	path string
+	w    *sstable.Writer
+}
+
+// NewIngester creates an ingester. It returns ErrNotEmpty if the storage has
+// any log entry or commit context.
+func (s *Storage) NewIngester() (*Ingester, error) {
+	if s.readOnly {
+		return nil, errors.New("storage: read-only")
+	}
+	if _, err := s.ReadCommitContext(); err != ErrNoCommitContext {
+		if err != nil {
+			return nil, err
+		}
+		return nil, ErrNotEmpty
+	}
+	for _, prefix := range []byte{dataKeyPrefix, commitKeyPrefix, timeKeyPrefix, segmentKeyPrefix} {
+		it := s.db.NewIter(&pebble.IterOptions{
+			LowerBound: []byte{prefix},
+			UpperBound: []byte{prefix + 1},
+		})
+		found := it.First()
+		if err := multierr.Append(it.Error(), it.Close()); err != nil {
+			return nil, err
+		}
+		if found {
+			return nil, ErrNotEmpty
+		}
+	}
+
+	// The sstables are created in the storage directory so that the storage
+	// can link them rather than copy them while ingesting.
+	dir, err := os.MkdirTemp(s.path, "ingest-")
+	if err != nil {
+		return nil, err
+	}
+	return &Ingester{
+		stg:       s,
+		dir:       dir,
+		data:      ingestTable{path: filepath.Join(dir, "data.sst")},
+		checksums: ingestTable{path: filepath.Join(dir, "checksums.sst")},
+		headers:   ingestTable{path: filepath.Join(dir, "headers.sst")},
+		commits:   ingestTable{path: filepath.Join(dir, "commits.sst")},
+		times:     ingestTable{path: filepath.Join(dir, "times.sst")},
+		dk:        make([]byte, dataKeyLength),
+		sk:        make([]byte, checksumKeyLength),
+		sv:        make([]byte, checksumValueLength),
+		hk:        make([]byte, headerKeyLength),
+		ck:        make([]byte, commitKeyLength),
+		tk:        make([]byte, timeKeyLength),
+		tv:        make([]byte, timeValueLength),
+	}, nil
+}
+
+// Add adds the committed log entry. Its LLSN must follow the LLSN of the
+// previously added one, and so must its GLSN.
+func (ing *Ingester) Add(le varlogpb.LogEntry) error {
+	if le.LLSN.Invalid() || le.GLSN.Invalid() {
+		return fmt.Errorf("storage: ingest: invalid log entry %+v", le.LogEntryMeta)
+	}
+	if !ing.last.LLSN.Invalid() && (le.LLSN != ing.last.LLSN+1 || le.GLSN <= ing.last.GLSN) {
+		return fmt.Errorf("storage: ingest: log entry %+v out of order, last %+v", le.LogEntryMeta, ing.last)
+	}
+
+	dk := encodeDataKeyInternal(le.LLSN, ing.dk)
+	if err := ing.set(&ing.data, dk, le.Data); err != nil {
+		return err
+	}
+	checksum := varlogpb.Checksum(le.Data)
+	if err := ing.set(&ing.checksums, encodeChecksumKeyInternal(le.LLSN, ing.sk), encodeChecksumValue(checksum, ing.sv)); err != nil {
+		return err
+	}
+	if len(le.Headers) > 0 {
+		if err := ing.set(&ing.headers, encodeHeaderKeyInternal(le.LLSN, ing.hk), encodeHeaders(le.Headers)); err != nil {
+			return err
+		}
+	}
+	if err := ing.set(&ing.commits, encodeCommitKeyInternal(le.GLSN, ing.ck), dk); err != nil {
+		return err
+	}
+
+	if ing.first.LLSN.Invalid() {
+		ing.first = varlogpb.LogEntryMeta{GLSN: le.GLSN, LLSN: le.LLSN}
+	}
+	ing.last = varlogpb.LogEntryMeta{GLSN: le.GLSN, LLSN: le.LLSN}
+	return nil
+}
+
+// AddCommitTime adds an entry of the time index. Entries must be added in
+// order of both commit time and GLSN.
+func (ing *Ingester) AddCommitTime(ct CommitTime) error {
+	if ct.UnixNano <= 0 || ct.GLSNBegin.Invalid() || ct.GLSNBegin >= ct.GLSNEnd {
+		return fmt.Errorf("storage: ingest: invalid commit time %+v", ct)
+	}
+	if ct.UnixNano <= ing.lastCommitTime {
+		return fmt.Errorf("storage: ingest: commit time %+v out of order, last %d", ct, ing.lastCommitTime)
+	}
+	if err := ing.set(&ing.times, encodeTimeKeyInternal(ct.UnixNano, ing.tk), encodeTimeValue(ct.GLSNBegin, ct.GLSNEnd, ing.tv)); err != nil {
+		return err
+	}
+	ing.lastCommitTime = ct.UnixNano
+	return nil
+}
+
+// AddProducerState adds the state of an idempotent producer. The last batch
+// of the state should be in the added log entries.
+func (ing *Ingester) AddProducerState(ps varlogpb.ProducerState) error {
+	if len(ps.ProducerID) == 0 {
+		return fmt.Errorf("storage: ingest: invalid producer state %+v", ps)
+	}
+	ing.producerStates = append(ing.producerStates, ps)
+	return nil
+}
+
+// set creates the sstable lazily since pebble cannot ingest an empty one.
+func (ing *Ingester) set(t *ingestTable, key, value []byte) error {
+	if t.w == nil {
+		f, err := os.Create(t.path)
+		if err != nil {
+			return err
+		}
+		t.w = sstable.NewWriter(f, sstable.WriterOptions{
+			TableFormat: ing.stg.db.FormatMajorVersion().MaxTableFormat(),
+		})
+	}
+	return t.w.Set(key, value)
+}
+
+// Finish ingests added log entries into the storage and then stores the
+// argument cc as the commit context along with added producer states. The
+// commit context must be consistent with the last log entry, that is, the last
+// committed log entry in the commit context must be the last added one.
+func (ing *Ingester) Finish(cc CommitContext) error {
+	if !ing.last.LLSN.Invalid() {
+		if cc.CommittedLLSNBegin+types.LLSN(cc.CommittedGLSNEnd-cc.CommittedGLSNBegin)-1 != ing.last.LLSN ||
+			cc.CommittedGLSNEnd-1 != ing.last.GLSN {
+			return fmt.Errorf("storage: ingest: commit context %+v inconsistent with last log entry %+v", cc, ing.last)
+		}
+	}
+
+	var paths []string
+	for _, t := range []*ingestTable{&ing.data, &ing.checksums, &ing.headers, &ing.commits, &ing.times} {
+		if t.w == nil {
+			continue
+		}
+		err := t.w.Close()
+		t.w = nil
+		if err != nil {
+			return err
+		}
+		paths = append(paths, t.path)
+	}
+	if len(paths) > 0 {
+		if err := ing.stg.db.Ingest(paths); err != nil {
+			return err
+		}
+	}
+	if ing.lastCommitTime > 0 {
+		ing.stg.lastCommitTime.Store(ing.lastCommitTime)
+	}
+
+	batch := ing.stg.db.NewBatch()
+	defer func() {
+		_ = batch.Close()
+	}()
+	for _, ps := range ing.producerStates {
+		if err := setProducerState(batch, ps); err != nil {
+			return err
+		}
+	}
+	buf := make([]byte, commitContextLength)
+	_ = batch.Set(commitContextKey, encodeCommitContext(cc, buf), nil)
+	return batch.Commit(ing.stg.writeOpts)
+}
+
+// Close releases resources of the ingester. It discards added log entries if
+// Finish has not been called.
+func (ing *Ingester) Close() (err error) {
+	for _, t := range []*ingestTable{&ing.data, &ing.checksums, &ing.headers, &ing.commits, &ing.times} {
+		if t.w != nil {
+			err = multierr.Append(err, t.w.Close())
+			t.w = nil
+		}
+	}
+	return multierr.Append(err, os.RemoveAll(ing.dir))
+}
@@ -0,0 +1,123 @@
+package objstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FileSystem is an object store backed by a directory of the local file
+// system. Each object is stored as a file whose path relative to the
+// directory is the key of the object.
+type FileSystem struct {
+	dir string
+}
+
+var _ ObjectStore = (*FileSystem)(nil)
+
+// NewFileSystem returns an object store that keeps objects in the directory
+// dir. It creates the directory if it does not exist.
+func NewFileSystem(dir string) (*FileSystem, error) {
+	if len(dir) == 0 {
+		return nil, errors.New("objstore: no directory")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("objstore: %w", err)
+	}
+	return &FileSystem{dir: dir}, nil
+}
+
+func (fsys *FileSystem) path(key string) (string, error) {
+	if len(key) == 0 || !fs.ValidPath(key) {
+		return "", fmt.Errorf("objstore: invalid key %q", key)
+	}
+	return filepath.Join(fsys.dir, filepath.FromSlash(key)), nil
+}
+
+// Put writes the object into a temporary file and renames it so that readers
+// never observe a partially written object.
+func (fsys *FileSystem) Put(_ context.Context, key string, data []byte) error {
+	name, err := fsys.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return fmt.Errorf("objstore: %w", err)
+	}
+	f, err := os.CreateTemp(filepath.Dir(name), ".tmp-")
+	if err != nil {
+		return fmt.Errorf("objstore: %w", err)
+	}
+	tmp := f.Name()
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp, name)
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("objstore: %w", err)
+	}
+	return nil
+}
+
+func (fsys *FileSystem) Get(_ context.Context, key string) ([]byte, error) {
+	name, err := fsys.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotExist, key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("objstore: %w", err)
+	}
+	return data, nil
+}
+
+func (fsys *FileSystem) Delete(_ context.Context, key string) error {
+	name, err := fsys.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("objstore: %w", err)
+	}
+	return nil
+}
+
+func (fsys *FileSystem) List(_ context.Context, prefix string) ([]string, error) {
+	var keys []string
+	err := filepath.WalkDir(fsys.dir, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".tmp-") {
+			return nil
+		}
+		rel, err := filepath.Rel(fsys.dir, name)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("objstore: %w", err)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
@@ -0,0 +1,60 @@
+// Package objstore provides object stores used as the cold tier of the
+// storage.
+//
+// Objects are immutable byte blobs identified by slash-separated keys. The
+// storage writes each object once and never modifies it afterward; therefore,
+// object stores need not support partial updates.
+package objstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+)
+
+// ErrNotExist is returned when an object does not exist.
+var ErrNotExist = errors.New("objstore: object does not exist")
+
+// ObjectStore is a store of immutable objects.
+type ObjectStore interface {
+	// Put stores the object with the given key, replacing an existing one.
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns the object with the given key. It returns ErrNotExist if
+	// there is no such object.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete deletes the object with the given key. Deleting a nonexistent
+	// object is not an error.
+	Delete(ctx context.Context, key string) error
+	// List returns keys of objects starting with the given prefix in
+	// lexicographical order.
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+// Open returns an object store specified by the URL rawURL:
+//
+//   - file:///path/to/dir stores objects in a local directory.
+//   - s3://bucket?endpoint=http://host:port&region=us-east-1 stores objects
+//     in a bucket of an S3-compatible service. The credentials are read from
+//     the environment variables AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY.
+func Open(rawURL string) (ObjectStore, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("objstore: %w", err)
+	}
+	switch u.Scheme {
+	case "file":
+		return NewFileSystem(u.Path)
+	case "s3":
+		q := u.Query()
+		return NewS3(
+			WithS3Endpoint(q.Get("endpoint")),
+			WithS3Bucket(u.Host),
+			WithS3Region(q.Get("region")),
+			WithS3Credentials(os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY")),
+		)
+	default:
+		return nil, fmt.Errorf("objstore: unknown scheme %q", u.Scheme)
+	}
+}
@@ -0,0 +1,392 @@
+package objstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultS3Region  = "us-east-1"
+	DefaultS3Timeout = 30 * time.Second
+
+	s3Algorithm      = "AWS4-HMAC-SHA256"
+	s3Service        = "s3"
+	s3DateFormat     = "20060102"
+	s3DateTimeFormat = "20060102T150405Z"
+)
+
+// S3 is an object store backed by a bucket of an S3-compatible service, for
+// instance, Amazon S3 or MinIO. It addresses the bucket in path style, that
+// is, an object is located at endpoint/bucket/key, and signs requests with
+// AWS Signature Version 4.
+type S3 struct {
+	s3Config
+	endpoint *url.URL
+}
+
+var _ ObjectStore = (*S3)(nil)
+
+// NewS3 returns an object store that keeps objects in a bucket of an
+// S3-compatible service.
+func NewS3(opts ...S3Option) (*S3, error) {
+	cfg, err := newS3Config(opts)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := url.Parse(cfg.endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("objstore: s3 endpoint: %w", err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("objstore: s3 endpoint: unknown scheme %q", endpoint.Scheme)
+	}
+	return &S3{s3Config: cfg, endpoint: endpoint}, nil
+}
+
+func (s *S3) Put(ctx context.Context, key string, data []byte) error {
+	rsp, err := s.do(ctx, http.MethodPut, key, nil, data)
+	if err != nil {
+		return err
+	}
+	defer closeResponse(rsp)
+	if rsp.StatusCode != http.StatusOK {
+		return s3Error(rsp, key)
+	}
+	return nil
+}
+
+func (s *S3) Get(ctx context.Context, key string) ([]byte, error) {
+	rsp, err := s.do(ctx, http.MethodGet, key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(rsp)
+	if rsp.StatusCode != http.StatusOK {
+		return nil, s3Error(rsp, key)
+	}
+	data, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("objstore: s3: get %s: %w", key, err)
+	}
+	return data, nil
+}
+
+func (s *S3) Delete(ctx context.Context, key string) error {
+	rsp, err := s.do(ctx, http.MethodDelete, key, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer closeResponse(rsp)
+	switch rsp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return s3Error(rsp, key)
+	}
+}
+
+type s3ListBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func (s *S3) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	token := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", prefix)
+		if len(token) > 0 {
+			query.Set("continuation-token", token)
+		}
+		result, err := s.list(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		for _, content := range result.Contents {
+			keys = append(keys, content.Key)
+		}
+		if !result.IsTruncated || len(result.NextContinuationToken) == 0 {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *S3) list(ctx context.Context, query url.Values) (result s3ListBucketResult, err error) {
+	rsp, err := s.do(ctx, http.MethodGet, "", query, nil)
+	if err != nil {
+		return result, err
+	}
+	defer closeResponse(rsp)
+	if rsp.StatusCode != http.StatusOK {
+		return result, s3Error(rsp, "")
+	}
+	if err := xml.NewDecoder(rsp.Body).Decode(&result); err != nil {
+		return result, fmt.Errorf("objstore: s3: list: %w", err)
+	}
+	return result, nil
+}
+
+func (s *S3) do(ctx context.Context, method, key string, query url.Values, body []byte) (*http.Response, error) {
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.bucket
+	if len(key) > 0 {
+		u.Path += "/" + key
+	}
+	u.RawPath = ""
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("objstore: s3: %w", err)
+	}
+	req.ContentLength = int64(len(body))
+	payloadHash := sha256.Sum256(body)
+	signV4(req, hex.EncodeToString(payloadHash[:]), s.accessKey, s.secretKey, s.region, s.now())
+
+	rsp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("objstore: s3: %s %s: %w", method, key, err)
+	}
+	return rsp, nil
+}
+
+type s3ErrorResponse struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+func s3Error(rsp *http.Response, key string) error {
+	if rsp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", ErrNotExist, key)
+	}
+	var e s3ErrorResponse
+	if body, err := io.ReadAll(io.LimitReader(rsp.Body, 64<<10)); err == nil {
+		_ = xml.Unmarshal(body, &e)
+	}
+	return fmt.Errorf("objstore: s3: %s: %s: %s %s", key, rsp.Status, e.Code, e.Message)
+}
+
+func closeResponse(rsp *http.Response) {
+	_, _ = io.Copy(io.Discard, rsp.Body)
+	_ = rsp.Body.Close()
+}
+
+// signV4 signs the request with AWS Signature Version 4. All headers of the
+// request are signed in addition to the host, x-amz-content-sha256, and
+// x-amz-date headers set by this function.
+func signV4(req *http.Request, payloadHash, accessKey, secretKey, region string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format(s3DateTimeFormat)
+	date := now.Format(s3DateFormat)
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		s3EscapePath(req.URL.Path),
+		s3CanonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+
+	scope := strings.Join([]string{date, region, s3Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, accessKey, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func s3CanonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var params []string
+	for _, key := range keys {
+		values := query[key]
+		sort.Strings(values)
+		for _, value := range values {
+			params = append(params, s3Escape(key, true)+"="+s3Escape(value, true))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+func s3EscapePath(path string) string {
+	if len(path) == 0 {
+		return "/"
+	}
+	return s3Escape(path, false)
+}
+
+// s3Escape percent-encodes every byte except the unreserved characters
+// defined by RFC 3986. The slash is also kept unless the argument encodeSlash
+// is true.
+func s3Escape(s string, encodeSlash bool) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			sb.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			sb.WriteByte(c)
+		default:
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+	return sb.String()
+}
+
+type s3Config struct {
+	endpoint  string
+	bucket    string
+	region    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+	now       func() time.Time
+}
+
+func newS3Config(opts []S3Option) (s3Config, error) {
+	cfg := s3Config{
+		region: DefaultS3Region,
+		client: &http.Client{Timeout: DefaultS3Timeout},
+		now:    time.Now,
+	}
+	for _, opt := range opts {
+		opt.applyS3(&cfg)
+	}
+	if err := cfg.validate(); err != nil {
+		return cfg, err
+	}
+	return cfg, nil
+}
+
+func (cfg s3Config) validate() error {
+	if len(cfg.endpoint) == 0 {
+		return errors.New("objstore: s3: no endpoint")
+	}
+	if len(cfg.bucket) == 0 {
+		return errors.New("objstore: s3: no bucket")
+	}
+	if len(cfg.region) == 0 {
+		return errors.New("objstore: s3: no region")
+	}
+	if len(cfg.accessKey) == 0 || len(cfg.secretKey) == 0 {
+		return errors.New("objstore: s3: no credentials")
+	}
+	if cfg.client == nil {
+		return errors.New("objstore: s3: no http client")
+	}
+	return nil
+}
+
+type S3Option interface {
+	applyS3(*s3Config)
+}
+
+type funcS3Option struct {
+	f func(*s3Config)
+}
+
+func newFuncS3Option(f func(*s3Config)) *funcS3Option {
+	return &funcS3Option{f: f}
+}
+
+func (fo *funcS3Option) applyS3(cfg *s3Config) {
+	fo.f(cfg)
+}
+
+// WithS3Endpoint sets the URL of the S3-compatible service, for instance,
+// https://s3.us-east-1.amazonaws.com or http://127.0.0.1:9000.
+func WithS3Endpoint(endpoint string) S3Option {
+	return newFuncS3Option(func(cfg *s3Config) {
+		cfg.endpoint = endpoint
+	})
+}
+
+func WithS3Bucket(bucket string) S3Option {
+	return newFuncS3Option(func(cfg *s3Config) {
+		cfg.bucket = bucket
+	})
+}
+
+// WithS3Region sets the region used to sign requests. If it is empty,
+// DefaultS3Region is used.
+func WithS3Region(region string) S3Option {
+	return newFuncS3Option(func(cfg *s3Config) {
+		if len(region) > 0 {
+			cfg.region = region
+		}
+	})
+}
+
+func WithS3Credentials(accessKey, secretKey string) S3Option {
+	return newFuncS3Option(func(cfg *s3Config) {
+		cfg.accessKey = accessKey
+		cfg.secretKey = secretKey
+	})
+}
+
+func WithS3HTTPClient(client *http.Client) S3Option {
+	return newFuncS3Option(func(cfg *s3Config) {
+		cfg.client = client
+	})
+}
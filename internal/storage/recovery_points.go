@@ -48,7 +48,10 @@ func (s *Storage) readLastCommitContext() (*CommitContext, error) {
 }
 
 func (s *Storage) readLogEntryBoundaries() (first, last *varlogpb.LogEntryMeta, err error) {
-	it := s.db.NewIter(&pebble.IterOptions{
+	r, catalog, closeView := s.newView()
+	defer closeView()
+
+	it := r.NewIter(&pebble.IterOptions{
 		LowerBound: []byte{commitKeyPrefix},
 		UpperBound: []byte{commitKeySentinelPrefix},
 	})
@@ -56,15 +59,26 @@ func (s *Storage) readLogEntryBoundaries() (first, last *varlogpb.LogEntryMeta,
 		_ = it.Close()
 	}()
 
+	// Log entries in the cold tier precede ones on the local disk.
+	if len(catalog) > 0 {
+		first = &varlogpb.LogEntryMeta{}
+		*first = catalog[0].first
+	}
 	if !it.First() {
-		return nil, nil, nil
+		if len(catalog) > 0 {
+			last = &varlogpb.LogEntryMeta{}
+			*last = catalog[len(catalog)-1].last
+		}
+		return first, last, nil
 	}
-	firstGLSN := decodeCommitKey(it.Key())
-	firstLE, err := s.readGLSN(firstGLSN)
-	if err != nil {
-		return nil, nil, err
+	if first == nil {
+		firstGLSN := decodeCommitKey(it.Key())
+		firstLE, err := s.readGLSN(firstGLSN)
+		if err != nil {
+			return nil, nil, err
+		}
+		first = &firstLE.LogEntryMeta
 	}
-	first = &firstLE.LogEntryMeta
 
 	_ = it.Last()
 	lastGLSN := decodeCommitKey(it.Key())
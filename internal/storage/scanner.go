@@ -1,8 +1,8 @@
 package storage
 
 import (
-	"fmt"
 	"io"
+	"sort"
 	"sync"
 
 	"github.com/cockroachdb/pebble"
@@ -16,24 +16,43 @@ var scannerPool = sync.Pool{
 		s := &Scanner{}
 		s.cks.lower = make([]byte, commitKeyLength)
 		s.cks.upper = make([]byte, commitKeyLength)
+		s.cks.seek = make([]byte, commitKeyLength)
 		s.dks.lower = make([]byte, dataKeyLength)
 		s.dks.upper = make([]byte, dataKeyLength)
+		s.hk = make([]byte, headerKeyLength)
+		s.sk = make([]byte, checksumKeyLength)
 		return s
 	},
 }
 
 type Scanner struct {
 	scanConfig
-	stg *Storage
-	it  *pebble.Iterator
+	stg       *Storage
+	r         reader
+	closeView func()
+	it        *pebble.Iterator
+	// cold is the cursor for log entries in the cold tier. Since they
+	// precede log entries on the local disk, the scanner reads them first.
+	cold struct {
+		segments []segmentMeta
+		idx      int
+		entries  []varlogpb.LogEntry
+		pos      int
+		err      error
+	}
+	// eof is set if the scanner stops due to an error in the cold tier.
+	eof bool
 	cks struct {
 		lower []byte
 		upper []byte
+		seek  []byte
 	}
 	dks struct {
 		lower []byte
 		upper []byte
 	}
+	hk []byte
+	sk []byte
 }
 
 func newScanner() *Scanner {
@@ -41,7 +60,10 @@ func newScanner() *Scanner {
 }
 
 func (s *Scanner) Valid() bool {
-	return s.it.Valid()
+	if s.eof {
+		return false
+	}
+	return s.cold.err != nil || s.coldValid() || s.it.Valid()
 }
 
 func (s *Scanner) Value() (le varlogpb.LogEntry, err error) {
@@ -49,6 +71,16 @@ func (s *Scanner) Value() (le varlogpb.LogEntry, err error) {
 		// TODO: replace io.EOF with ErrNoLogEntry.
 		return le, io.EOF
 	}
+	if s.cold.err != nil {
+		return le, s.cold.err
+	}
+	if s.coldValid() {
+		le = cloneLogEntry(s.cold.entries[s.cold.pos])
+		if !s.withGLSN {
+			le.GLSN = types.InvalidGLSN
+		}
+		return le, nil
+	}
 	if s.withGLSN {
 		return s.valueByGLSN()
 	}
@@ -56,48 +88,161 @@ func (s *Scanner) Value() (le varlogpb.LogEntry, err error) {
 }
 
 func (s *Scanner) Next() bool {
+	if s.cold.err != nil {
+		s.eof = true
+		return false
+	}
+	if s.coldValid() {
+		s.cold.pos++
+		if s.cold.pos == len(s.cold.entries) {
+			s.loadCold(s.cold.idx + 1)
+		}
+		return s.Valid()
+	}
 	return s.it.Next()
 }
 
+// SeekGLSN moves the scanner to the log entry whose GLSN is the argument glsn.
+// It returns false if the storage does not have the log entry; in that case,
+// the scanner is positioned at the next log entry if it exists. It can be
+// called only by the scanner created with WithGLSN.
+func (s *Scanner) SeekGLSN(glsn types.GLSN) bool {
+	if !s.withGLSN {
+		panic("storage: scanner not created by WithGLSN")
+	}
+	if len(s.cold.segments) > 0 {
+		s.eof = false
+		s.cold.err = nil
+		s.loadCold(sort.Search(len(s.cold.segments), func(i int) bool {
+			return s.cold.segments[i].last.GLSN >= glsn
+		}))
+		if s.coldValid() {
+			s.cold.pos = sort.Search(len(s.cold.entries), func(i int) bool {
+				return s.cold.entries[i].GLSN >= glsn
+			})
+			if s.cold.pos == len(s.cold.entries) {
+				s.loadCold(s.cold.idx + 1)
+			}
+		}
+		if s.cold.err != nil {
+			return false
+		}
+		if s.coldValid() {
+			_ = s.it.First()
+			return s.cold.entries[s.cold.pos].GLSN == glsn
+		}
+	}
+	if !s.it.SeekGE(encodeCommitKeyInternal(glsn, s.cks.seek)) {
+		return false
+	}
+	return decodeCommitKey(s.it.Key()) == glsn
+}
+
 func (s *Scanner) Close() error {
 	err := s.it.Close()
+	s.closeView()
 	s.release()
 	return err
 }
 
-func (s *Scanner) valueByGLSN() (le varlogpb.LogEntry, err error) {
-	ck := s.it.Key()
-	dk := s.it.Value()
-	data, closer, err := s.stg.db.Get(dk)
-	if err != nil {
-		if err == pebble.ErrNotFound {
-			return le, fmt.Errorf("%s: %w", s.stg.path, ErrInconsistentWriteCommitState)
-		}
-		return le, err
+// initCold sets the cursor for the cold tier to the first log entry in the
+// range among segments in the catalog.
+func (s *Scanner) initCold(catalog []segmentMeta) {
+	var lower, upper int
+	if s.withGLSN {
+		lower = sort.Search(len(catalog), func(i int) bool {
+			return catalog[i].last.GLSN >= s.begin.GLSN
+		})
+		upper = sort.Search(len(catalog), func(i int) bool {
+			return catalog[i].first.GLSN >= s.end.GLSN
+		})
+	} else {
+		lower = sort.Search(len(catalog), func(i int) bool {
+			return catalog[i].last.LLSN >= s.begin.LLSN
+		})
+		upper = sort.Search(len(catalog), func(i int) bool {
+			return catalog[i].first.LLSN >= s.end.LLSN
+		})
+	}
+	if lower >= upper {
+		return
 	}
-	le.GLSN = decodeCommitKey(ck)
-	le.LLSN = decodeDataKey(dk)
-	if len(data) > 0 {
-		le.Data = make([]byte, len(data))
-		copy(le.Data, data)
+	s.cold.segments = catalog[lower:upper]
+	s.loadCold(0)
+}
+
+// loadCold loads the first segment from the idx-th one that has log entries
+// in the range.
+func (s *Scanner) loadCold(idx int) {
+	s.cold.entries, s.cold.pos = nil, 0
+	for ; idx < len(s.cold.segments); idx++ {
+		s.cold.idx = idx
+		seg := s.cold.segments[idx]
+		les, err := s.stg.loadSegment(seg)
+		if err != nil {
+			s.cold.err = err
+			return
+		}
+		// Log entries trimmed already are excluded by the catalog.
+		les = les[searchGLSN(les, seg.first.GLSN):searchGLSN(les, seg.last.GLSN+1)]
+		if s.withGLSN {
+			les = les[searchGLSN(les, s.begin.GLSN):searchGLSN(les, s.end.GLSN)]
+		} else {
+			les = les[searchLLSN(les, s.begin.LLSN):searchLLSN(les, s.end.LLSN)]
+		}
+		if len(les) > 0 {
+			s.cold.entries = les
+			return
+		}
 	}
-	_ = closer.Close()
-	return le, nil
+	s.cold.idx = len(s.cold.segments)
+}
+
+func (s *Scanner) coldValid() bool {
+	return s.cold.pos < len(s.cold.entries)
+}
+
+// searchGLSN returns the index of the first log entry whose GLSN is greater
+// than or equal to the argument glsn.
+func searchGLSN(les []varlogpb.LogEntry, glsn types.GLSN) int {
+	return sort.Search(len(les), func(i int) bool {
+		return les[i].GLSN >= glsn
+	})
+}
+
+// searchLLSN returns the index of the first log entry whose LLSN is greater
+// than or equal to the argument llsn.
+func searchLLSN(les []varlogpb.LogEntry, llsn types.LLSN) int {
+	return sort.Search(len(les), func(i int) bool {
+		return les[i].LLSN >= llsn
+	})
+}
+
+func (s *Scanner) valueByGLSN() (le varlogpb.LogEntry, err error) {
+	return s.stg.readCommitted(s.r, s.it.Key(), s.it.Value(), s.hk, s.sk)
 }
 
 func (s *Scanner) valueByLLSN() (le varlogpb.LogEntry, err error) {
 	le.LLSN = decodeDataKey(s.it.Key())
-	if len(s.it.Value()) > 0 {
-		le.Data = make([]byte, len(s.it.Value()))
-		copy(le.Data, s.it.Value())
+	if err := s.stg.readData(s.r, s.it.Value(), s.sk, &le); err != nil {
+		return le, err
 	}
-	return le, nil
+	le.Headers, err = s.stg.readHeaders(s.r, le.LLSN, s.hk)
+	return le, err
 }
 
 func (s *Scanner) release() {
 	s.scanConfig = scanConfig{}
 	s.stg = nil
+	s.r = nil
+	s.closeView = nil
 	s.it = nil
+	s.cold.segments = nil
+	s.cold.idx = 0
+	s.cold.entries = nil
+	s.cold.pos = 0
+	s.cold.err = nil
+	s.eof = false
 	scannerPool.Put(s)
 }
 
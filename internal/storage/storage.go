@@ -2,7 +2,10 @@ package storage
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cockroachdb/pebble"
@@ -17,6 +20,8 @@ var (
 	ErrNoLogEntry                   = errors.New("storage: no log entry")
 	ErrNoCommitContext              = errors.New("storage: no commit context")
 	ErrInconsistentWriteCommitState = errors.New("storage: inconsistent write and commit")
+	ErrInvalidHeaders               = errors.New("storage: invalid headers")
+	ErrChecksumMismatch             = errors.New("storage: checksum mismatch")
 )
 
 type Storage struct {
@@ -25,11 +30,32 @@ type Storage struct {
 	db        *pebble.DB
 	writeOpts *pebble.WriteOptions
 
+	// lastCommitTime is the latest time in the time index in nanoseconds.
+	lastCommitTime atomic.Int64
+
 	metricsLogger struct {
 		wg     sync.WaitGroup
 		ticker *time.Ticker
 		stop   chan struct{}
 	}
+
+	tier struct {
+		// opMu serializes operations changing the cold tier, that is,
+		// moving log entries to the cold tier and trimming them.
+		opMu sync.Mutex
+		// mu protects the catalog. Writers hold it while committing changes
+		// of the catalog to the database so that readers can see the
+		// database and catalog consistent with each other.
+		mu sync.RWMutex
+		// catalog is the list of segments in the cold tier sorted by GLSN.
+		// It is never modified in place, except for appending.
+		catalog []segmentMeta
+		cache   segmentCache
+
+		wg     sync.WaitGroup
+		ticker *time.Ticker
+		stop   chan struct{}
+	}
 }
 
 // New creates a new storage.
@@ -38,7 +64,10 @@ func New(opts ...Option) (*Storage, error) {
 	if err != nil {
 		return nil, err
 	}
+	return open(cfg)
+}
 
+func open(cfg config) (*Storage, error) {
 	pebbleOpts := &pebble.Options{
 		DisableWAL:                  !cfg.wal,
 		L0CompactionThreshold:       cfg.l0CompactionThreshold,
@@ -100,7 +129,16 @@ func New(opts ...Option) (*Storage, error) {
 		db:        db,
 		writeOpts: &pebble.WriteOptions{Sync: cfg.sync},
 	}
+	if err := stg.loadLastCommitTime(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	if err := stg.openColdTier(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
 	stg.startMetricsLogger()
+	stg.startColdTier()
 	return stg, nil
 }
 
@@ -111,7 +149,7 @@ func (s *Storage) NewWriteBatch() *WriteBatch {
 
 // NewCommitBatch creates a batch for commit operations.
 func (s *Storage) NewCommitBatch(cc CommitContext) (*CommitBatch, error) {
-	cb := newCommitBatch(s.db.NewBatch(), s.writeOpts)
+	cb := newCommitBatch(s, cc, s.db.NewBatch(), s.writeOpts)
 	if err := cb.batch.Set(commitContextKey, encodeCommitContext(cc, cb.cc), nil); err != nil {
 		_ = cb.Close()
 		return nil, err
@@ -122,7 +160,7 @@ func (s *Storage) NewCommitBatch(cc CommitContext) (*CommitBatch, error) {
 // NewAppendBatch creates a batch for appending log entries. It does not put
 // commit context.
 func (s *Storage) NewAppendBatch() *AppendBatch {
-	return newAppendBatch(s.db.NewBatch(), s.writeOpts)
+	return newAppendBatch(s, s.db.NewBatch(), s.writeOpts)
 }
 
 // NewScanner creates a scanner for the given key range.
@@ -130,6 +168,8 @@ func (s *Storage) NewScanner(opts ...ScanOption) *Scanner {
 	scanner := newScanner()
 	scanner.scanConfig = newScanConfig(opts)
 	scanner.stg = s
+	var catalog []segmentMeta
+	scanner.r, catalog, scanner.closeView = s.newView()
 	itOpt := &pebble.IterOptions{}
 	if scanner.withGLSN {
 		itOpt.LowerBound = encodeCommitKeyInternal(scanner.begin.GLSN, scanner.cks.lower)
@@ -138,8 +178,11 @@ func (s *Storage) NewScanner(opts ...ScanOption) *Scanner {
 		itOpt.LowerBound = encodeDataKeyInternal(scanner.begin.LLSN, scanner.dks.lower)
 		itOpt.UpperBound = encodeDataKeyInternal(scanner.end.LLSN, scanner.dks.upper)
 	}
-	scanner.it = s.db.NewIter(itOpt)
+	scanner.it = scanner.r.NewIter(itOpt)
 	_ = scanner.it.First()
+	if len(catalog) > 0 {
+		scanner.initCold(catalog)
+	}
 	return scanner
 }
 
@@ -164,7 +207,27 @@ func (s *Storage) readGLSN(glsn types.GLSN) (le varlogpb.LogEntry, err error) {
 }
 
 func (s *Storage) readLLSN(llsn types.LLSN) (le varlogpb.LogEntry, err error) {
-	it := s.db.NewIter(&pebble.IterOptions{
+	r, catalog, closeView := s.newView()
+	defer closeView()
+
+	idx := sort.Search(len(catalog), func(i int) bool {
+		return catalog[i].last.LLSN >= llsn
+	})
+	if idx < len(catalog) && catalog[idx].first.LLSN <= llsn {
+		les, err := s.loadSegment(catalog[idx])
+		if err != nil {
+			return le, err
+		}
+		pos := sort.Search(len(les), func(i int) bool {
+			return les[i].LLSN >= llsn
+		})
+		if pos == len(les) || les[pos].LLSN != llsn {
+			return le, ErrNoLogEntry
+		}
+		return cloneLogEntry(les[pos]), nil
+	}
+
+	it := r.NewIter(&pebble.IterOptions{
 		LowerBound: []byte{commitKeyPrefix},
 		UpperBound: []byte{commitKeySentinelPrefix},
 	})
@@ -192,6 +255,114 @@ func (s *Storage) readLLSN(llsn types.LLSN) (le varlogpb.LogEntry, err error) {
 	return le, ErrNoLogEntry
 }
 
+// readCommitted reads the log entry of the commit key ck whose value is the
+// data key dk from the reader r. The arguments hk and sk are buffers for the
+// header key and the checksum key.
+func (s *Storage) readCommitted(r reader, ck, dk, hk, sk []byte) (le varlogpb.LogEntry, err error) {
+	data, closer, err := r.Get(dk)
+	if err != nil {
+		if err == pebble.ErrNotFound {
+			return le, fmt.Errorf("%s: %w", s.path, ErrInconsistentWriteCommitState)
+		}
+		return le, err
+	}
+	le.GLSN = decodeCommitKey(ck)
+	le.LLSN = decodeDataKey(dk)
+	err = s.readData(r, data, sk, &le)
+	_ = closer.Close()
+	if err != nil {
+		return le, err
+	}
+	le.Headers, err = s.readHeaders(r, le.LLSN, hk)
+	return le, err
+}
+
+// readData copies the argument data, which is the value of a data key, into
+// the log entry after verifying it against the checksum stored for the LLSN
+// of the log entry. The LLSN of the log entry should be set already. The
+// argument sk is a buffer for the checksum key.
+//
+// Log entries written before checksums were introduced have no checksum key.
+// Their data are returned without verification, and their checksums are left
+// zero.
+func (s *Storage) readData(r reader, data, sk []byte, le *varlogpb.LogEntry) error {
+	buf, closer, err := r.Get(encodeChecksumKeyInternal(le.LLSN, sk))
+	switch err {
+	case nil:
+		le.Checksum = decodeChecksumValue(buf)
+		_ = closer.Close()
+		if actual := varlogpb.Checksum(data); actual != le.Checksum {
+			return fmt.Errorf("%s: llsn %d: %w: expected %08x, actual %08x", s.path, le.LLSN, ErrChecksumMismatch, le.Checksum, actual)
+		}
+	case pebble.ErrNotFound:
+	default:
+		return err
+	}
+	if len(data) > 0 {
+		le.Data = make([]byte, len(data))
+		copy(le.Data, data)
+	}
+	return nil
+}
+
+// readHeaders reads headers of the log entry at the llsn from the reader r.
+// The argument hk is a buffer for the header key. It returns nil if the log
+// entry has no headers.
+func (s *Storage) readHeaders(r reader, llsn types.LLSN, hk []byte) (map[string][]byte, error) {
+	buf, closer, err := r.Get(encodeHeaderKeyInternal(llsn, hk))
+	if err != nil {
+		if err == pebble.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer func() {
+		_ = closer.Close()
+	}()
+	return decodeHeaders(buf)
+}
+
+// DeleteHeaders deletes headers of log entries whose LLSNs are greater than or
+// equal to the argument llsn. Since headers are written only for log entries
+// having them, a log stream replica should call it before reusing LLSNs of
+// uncommitted log entries; otherwise, new log entries could have headers of
+// the discarded ones.
+func (s *Storage) DeleteHeaders(llsn types.LLSN) error {
+	begin := make([]byte, headerKeyLength)
+	begin = encodeHeaderKeyInternal(llsn, begin)
+	return s.db.DeleteRange(begin, []byte{headerKeySentinelPrefix}, s.writeOpts)
+}
+
+// ReadProducerStates returns the states of all idempotent producers stored in
+// the storage.
+func (s *Storage) ReadProducerStates() ([]varlogpb.ProducerState, error) {
+	it := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{producerKeyPrefix},
+		UpperBound: []byte{producerKeySentinelPrefix},
+	})
+	defer func() {
+		_ = it.Close()
+	}()
+
+	var states []varlogpb.ProducerState
+	for it.First(); it.Valid(); it.Next() {
+		var ps varlogpb.ProducerState
+		if err := ps.Unmarshal(it.Value()); err != nil {
+			return nil, err
+		}
+		states = append(states, ps)
+	}
+	return states, nil
+}
+
+func setProducerState(batch *pebble.Batch, ps varlogpb.ProducerState) error {
+	buf, err := ps.Marshal()
+	if err != nil {
+		return err
+	}
+	return batch.Set(encodeProducerKey(ps.ProducerID), buf, nil)
+}
+
 func (s *Storage) ReadCommitContext() (cc CommitContext, err error) {
 	buf, closer, err := s.db.Get(commitContextKey)
 	if err != nil {
@@ -207,10 +378,17 @@ func (s *Storage) ReadCommitContext() (cc CommitContext, err error) {
 }
 
 // Trim deletes log entries whose GLSNs are less than or equal to the argument
-// glsn. Internally, it removes records for both data and commits but does not
-// remove the commit context.
+// glsn. Internally, it removes records for data, checksums, headers, commits,
+// and the time index but does not remove the commit context.
+// If the cold tier is enabled, it also deletes segments of the trimmed log
+// entries from the cold tier.
 // It returns the ErrNoLogEntry if there are no logs to delete.
 func (s *Storage) Trim(glsn types.GLSN) error {
+	if s.coldTier.store != nil {
+		s.tier.opMu.Lock()
+		defer s.tier.opMu.Unlock()
+	}
+
 	lem, err := s.findLTE(glsn)
 	if err != nil {
 		return err
@@ -237,7 +415,176 @@ func (s *Storage) Trim(glsn types.GLSN) error {
 	dkEnd = encodeDataKeyInternal(trimLLSN+1, dkEnd)
 	_ = batch.DeleteRange(dkBegin, dkEnd, nil)
 
-	return batch.Commit(s.writeOpts)
+	// checksum
+	skBegin := make([]byte, checksumKeyLength)
+	skBegin = encodeChecksumKeyInternal(types.MinLLSN, skBegin)
+	skEnd := make([]byte, checksumKeyLength)
+	skEnd = encodeChecksumKeyInternal(trimLLSN+1, skEnd)
+	_ = batch.DeleteRange(skBegin, skEnd, nil)
+
+	// header
+	hkBegin := make([]byte, headerKeyLength)
+	hkBegin = encodeHeaderKeyInternal(types.MinLLSN, hkBegin)
+	hkEnd := make([]byte, headerKeyLength)
+	hkEnd = encodeHeaderKeyInternal(trimLLSN+1, hkEnd)
+	_ = batch.DeleteRange(hkBegin, hkEnd, nil)
+
+	// time index
+	if err := s.trimTimeIndex(batch, trimGLSN); err != nil {
+		return err
+	}
+
+	if s.coldTier.store == nil {
+		return batch.Commit(s.writeOpts)
+	}
+
+	// cold tier
+	removed, catalog, err := s.trimColdTier(batch, lem)
+	if err != nil {
+		return err
+	}
+	s.tier.mu.Lock()
+	if err := batch.Commit(s.writeOpts); err != nil {
+		s.tier.mu.Unlock()
+		return err
+	}
+	s.tier.catalog = catalog
+	s.tier.mu.Unlock()
+	s.deleteSegments(removed)
+	return nil
+}
+
+// SeekTime returns the first GLSN of the first commit whose commit time is
+// equal to or later than the argument t. It returns ErrNoLogEntry if there is
+// no such commit.
+//
+// Note that commit times are recorded by each replica when it commits log
+// entries; hence, they can differ slightly across replicas.
+func (s *Storage) SeekTime(t time.Time) (types.GLSN, error) {
+	// Commit times are always after the Unix epoch, and the UnixNano of time
+	// before it is negative.
+	var unixNano int64
+	if t.After(time.Unix(0, 0)) {
+		unixNano = t.UnixNano()
+	}
+	lower := make([]byte, timeKeyLength)
+	lower = encodeTimeKeyInternal(unixNano, lower)
+	it := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: lower,
+		UpperBound: []byte{timeKeySentinelPrefix},
+	})
+	defer func() {
+		_ = it.Close()
+	}()
+	if !it.First() {
+		return types.InvalidGLSN, ErrNoLogEntry
+	}
+	begin, _ := decodeTimeValue(it.Value())
+	return begin, nil
+}
+
+func (s *Storage) loadLastCommitTime() error {
+	it := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{timeKeyPrefix},
+		UpperBound: []byte{timeKeySentinelPrefix},
+	})
+	defer func() {
+		_ = it.Close()
+	}()
+	if it.Last() {
+		s.lastCommitTime.Store(decodeTimeKey(it.Key()))
+	}
+	return it.Error()
+}
+
+// trimTimeIndex deletes entries of the time index for commits whose log
+// entries are all trimmed, that is, their GLSNs are less than or equal to the
+// argument glsn.
+func (s *Storage) trimTimeIndex(batch *pebble.Batch, glsn types.GLSN) error {
+	upper := []byte{timeKeySentinelPrefix}
+	if glsn < types.MaxGLSN {
+		it := s.db.NewIter(&pebble.IterOptions{
+			LowerBound: []byte{timeKeyPrefix},
+			UpperBound: []byte{timeKeySentinelPrefix},
+		})
+		if seekTimeIndexEndAfter(it, glsn+1) {
+			upper = append([]byte(nil), it.Key()...)
+		}
+		if err := multierr.Append(it.Error(), it.Close()); err != nil {
+			return err
+		}
+	}
+	return batch.DeleteRange([]byte{timeKeyPrefix}, upper, nil)
+}
+
+// seekTimeIndexEndAfter moves the iterator over the time index to the first
+// entry whose end GLSN is greater than the argument glsn. It returns false if
+// there is no such entry.
+//
+// Since both commit times and GLSNs in the time index increase, end GLSNs are
+// sorted in the order of keys. Hence, it finds the entry by binary search over
+// commit times rather than scanning the index from the first entry.
+func seekTimeIndexEndAfter(it *pebble.Iterator, glsn types.GLSN) bool {
+	after := func() bool {
+		_, end := decodeTimeValue(it.Value())
+		return end > glsn
+	}
+	if !it.First() || after() {
+		return it.Valid()
+	}
+	lo := decodeTimeKey(it.Key())
+	if !it.Last() || !after() {
+		return false
+	}
+	hi := decodeTimeKey(it.Key())
+
+	// The entry at lo is not after the glsn, but the first entry at or after
+	// hi is.
+	key := make([]byte, timeKeyLength)
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		if it.SeekGE(encodeTimeKeyInternal(mid, key)) && !after() {
+			lo = decodeTimeKey(it.Key())
+		} else {
+			hi = mid
+		}
+	}
+	return it.SeekGE(encodeTimeKeyInternal(hi, key))
+}
+
+// CommitTime is an entry of the time index. It maps the time when a replica
+// committed log entries to the range of their GLSNs.
+type CommitTime struct {
+	// UnixNano is the commit time in nanoseconds.
+	UnixNano int64
+	// GLSNBegin is the first GLSN of the committed log entries.
+	GLSNBegin types.GLSN
+	// GLSNEnd is the next GLSN of the last committed log entry.
+	GLSNEnd types.GLSN
+}
+
+// ReadCommitTimes returns entries of the time index whose GLSN ranges overlap
+// the range [begin, end). They are sorted by the commit time.
+func (s *Storage) ReadCommitTimes(begin, end types.GLSN) (cts []CommitTime, err error) {
+	it := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{timeKeyPrefix},
+		UpperBound: []byte{timeKeySentinelPrefix},
+	})
+	defer func() {
+		err = multierr.Append(err, it.Close())
+	}()
+	for valid := seekTimeIndexEndAfter(it, begin); valid; valid = it.Next() {
+		glsnBegin, glsnEnd := decodeTimeValue(it.Value())
+		if glsnBegin >= end {
+			break
+		}
+		cts = append(cts, CommitTime{
+			UnixNano:  decodeTimeKey(it.Key()),
+			GLSNBegin: glsnBegin,
+			GLSNEnd:   glsnEnd,
+		})
+	}
+	return cts, it.Error()
 }
 
 func (s *Storage) findLTE(glsn types.GLSN) (lem varlogpb.LogEntryMeta, err error) {
@@ -249,18 +596,42 @@ func (s *Storage) findLTE(glsn types.GLSN) (lem varlogpb.LogEntryMeta, err error
 		upper = []byte{commitKeySentinelPrefix}
 	}
 
-	it := s.db.NewIter(&pebble.IterOptions{
+	r, catalog, closeView := s.newView()
+	defer closeView()
+
+	it := r.NewIter(&pebble.IterOptions{
 		LowerBound: []byte{commitKeyPrefix},
 		UpperBound: upper,
 	})
 	defer func() {
 		_ = it.Close()
 	}()
-	if !it.Last() {
+	if it.Last() {
+		lem.GLSN = decodeCommitKey(it.Key())
+		lem.LLSN = decodeDataKey(it.Value())
+		return lem, nil
+	}
+
+	// Log entries in the cold tier precede ones on the local disk.
+	idx := sort.Search(len(catalog), func(i int) bool {
+		return catalog[i].first.GLSN > glsn
+	}) - 1
+	if idx < 0 {
 		return lem, ErrNoLogEntry
 	}
-	lem.GLSN = decodeCommitKey(it.Key())
-	lem.LLSN = decodeDataKey(it.Value())
+	seg := catalog[idx]
+	if seg.last.GLSN <= glsn {
+		return seg.last, nil
+	}
+	les, err := s.loadSegment(seg)
+	if err != nil {
+		return lem, err
+	}
+	pos := sort.Search(len(les), func(i int) bool {
+		return les[i].GLSN > glsn
+	}) - 1
+	lem.GLSN = les[pos].GLSN
+	lem.LLSN = les[pos].LLSN
 	return lem, nil
 }
 
@@ -273,6 +644,27 @@ func (s *Storage) DiskUsage() uint64 {
 	return s.db.Metrics().DiskSpaceUsage()
 }
 
+// LiveDiskUsage estimates the size of log entries whose LLSNs are greater than
+// or equal to the argument begin. Unlike DiskUsage, it excludes files having
+// only trimmed log entries, which are not reclaimed until compaction. Since it
+// counts only flushed files, it is approximate.
+func (s *Storage) LiveDiskUsage(begin types.LLSN) (uint64, error) {
+	ranges := [][2][]byte{
+		{encodeDataKeyInternal(begin, make([]byte, dataKeyLength)), {dataKeySentinelPrefix}},
+		{encodeChecksumKeyInternal(begin, make([]byte, checksumKeyLength)), {checksumKeySentinelPrefix}},
+		{encodeHeaderKeyInternal(begin, make([]byte, headerKeyLength)), {headerKeySentinelPrefix}},
+	}
+	var total uint64
+	for _, r := range ranges {
+		size, err := s.db.EstimateDiskUsage(r[0], r[1])
+		if err != nil {
+			return 0, err
+		}
+		total += size
+	}
+	return total, nil
+}
+
 func (s *Storage) startMetricsLogger() {
 	if s.metricsLogInterval <= 0 {
 		return
@@ -304,6 +696,7 @@ func (s *Storage) stopMetricsLogger() {
 
 // Close closes the storage.
 func (s *Storage) Close() (err error) {
+	s.stopColdTier()
 	if !s.readOnly {
 		err = s.db.Flush()
 	}
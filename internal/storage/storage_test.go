@@ -1,8 +1,10 @@
 package storage
 
 import (
+	"bytes"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/pebble"
 	"github.com/stretchr/testify/assert"
@@ -124,8 +126,8 @@ func TestStorage_CommitContext(t *testing.T) {
 func TestStorage_WriteBatch(t *testing.T) {
 	testStorage(t, func(t testing.TB, stg *Storage) {
 		wb := stg.NewWriteBatch()
-		assert.NoError(t, wb.Set(1, []byte("1")))
-		assert.NoError(t, wb.Set(2, []byte("2")))
+		assert.NoError(t, wb.Set(1, []byte("1"), varlogpb.Checksum([]byte("1"))))
+		assert.NoError(t, wb.Set(2, []byte("2"), varlogpb.Checksum([]byte("2"))))
 		assert.NoError(t, wb.Apply())
 		assert.NoError(t, wb.Close())
 
@@ -143,7 +145,8 @@ func TestStorage_WriteBatch(t *testing.T) {
 			LogEntryMeta: varlogpb.LogEntryMeta{
 				LLSN: 1,
 			},
-			Data: []byte("1"),
+			Data:     []byte("1"),
+			Checksum: varlogpb.Checksum([]byte("1")),
 		}, le)
 		assert.True(t, scanner.Next())
 		le, err = scanner.Value()
@@ -152,7 +155,8 @@ func TestStorage_WriteBatch(t *testing.T) {
 			LogEntryMeta: varlogpb.LogEntryMeta{
 				LLSN: 2,
 			},
-			Data: []byte("2"),
+			Data:     []byte("2"),
+			Checksum: varlogpb.Checksum([]byte("2")),
 		}, le)
 		assert.False(t, scanner.Next())
 		_, err = scanner.Value()
@@ -195,7 +199,7 @@ func TestStorage_AppendBatch(t *testing.T) {
 			name: "LogEntry",
 			testf: func(t testing.TB, stg *Storage) {
 				batch := stg.NewAppendBatch()
-				require.NoError(t, batch.SetLogEntry(1, 1, []byte("one")))
+				require.NoError(t, batch.SetLogEntry(1, 1, []byte("one"), varlogpb.Checksum([]byte("one"))))
 				require.NoError(t, batch.Apply())
 				require.NoError(t, batch.Close())
 
@@ -223,7 +227,7 @@ func TestStorage_AppendBatch(t *testing.T) {
 			name: "Combined",
 			testf: func(t testing.TB, stg *Storage) {
 				batch := stg.NewAppendBatch()
-				require.NoError(t, batch.SetLogEntry(1, 1, []byte("one")))
+				require.NoError(t, batch.SetLogEntry(1, 1, []byte("one"), varlogpb.Checksum([]byte("one"))))
 				require.NoError(t, batch.SetCommitContext(cc))
 				require.NoError(t, batch.Apply())
 				require.NoError(t, batch.Close())
@@ -274,11 +278,76 @@ func TestStorage_InconsistentWriteCommit(t *testing.T) {
 	})
 }
 
+func TestStorage_Checksum(t *testing.T) {
+	testStorage(t, func(t testing.TB, stg *Storage) {
+		wb := stg.NewWriteBatch()
+		assert.NoError(t, wb.Set(1, []byte("1"), varlogpb.Checksum([]byte("1"))))
+		assert.NoError(t, wb.Set(2, []byte("2"), varlogpb.Checksum([]byte("2"))))
+		// The checksum is wrong from the beginning.
+		assert.NoError(t, wb.Set(3, []byte("3"), varlogpb.Checksum([]byte("three"))))
+		assert.NoError(t, wb.Apply())
+		assert.NoError(t, wb.Close())
+
+		cb, err := stg.NewCommitBatch(CommitContext{
+			Version:            1,
+			HighWatermark:      13,
+			CommittedGLSNBegin: 11,
+			CommittedGLSNEnd:   14,
+			CommittedLLSNBegin: 1,
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, cb.Set(1, 11))
+		assert.NoError(t, cb.Set(2, 12))
+		assert.NoError(t, cb.Set(3, 13))
+		assert.NoError(t, cb.Apply())
+		assert.NoError(t, cb.Close())
+
+		TestCorruptLogEntry(t, stg, 2)
+
+		le, err := stg.Read(AtGLSN(11))
+		assert.NoError(t, err)
+		assert.Equal(t, varlogpb.Checksum([]byte("1")), le.Checksum)
+
+		for _, llsn := range []types.LLSN{2, 3} {
+			_, err = stg.Read(AtLLSN(llsn))
+			assert.ErrorIs(t, err, ErrChecksumMismatch)
+			_, err = stg.Read(AtGLSN(types.GLSN(llsn) + 10))
+			assert.ErrorIs(t, err, ErrChecksumMismatch)
+		}
+
+		scanner := stg.NewScanner(WithLLSN(2, 3))
+		assert.True(t, scanner.Valid())
+		_, err = scanner.Value()
+		assert.ErrorIs(t, err, ErrChecksumMismatch)
+		assert.NoError(t, scanner.Close())
+
+		// A log entry written without a checksum is read without
+		// verification.
+		assert.NoError(t, stg.db.Set(encodeDataKeyInternal(4, make([]byte, dataKeyLength)), []byte("4"), pebble.Sync))
+		cb, err = stg.NewCommitBatch(CommitContext{
+			Version:            2,
+			HighWatermark:      14,
+			CommittedGLSNBegin: 14,
+			CommittedGLSNEnd:   15,
+			CommittedLLSNBegin: 4,
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, cb.Set(4, 14))
+		assert.NoError(t, cb.Apply())
+		assert.NoError(t, cb.Close())
+
+		le, err = stg.Read(AtGLSN(14))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("4"), le.Data)
+		assert.Zero(t, le.Checksum)
+	})
+}
+
 func TestStorage_WriteCommit(t *testing.T) {
 	testStorage(t, func(t testing.TB, stg *Storage) {
 		wb := stg.NewWriteBatch()
-		assert.NoError(t, wb.Set(1, []byte("1")))
-		assert.NoError(t, wb.Set(2, []byte("2")))
+		assert.NoError(t, wb.Set(1, []byte("1"), varlogpb.Checksum([]byte("1"))))
+		assert.NoError(t, wb.Set(2, []byte("2"), varlogpb.Checksum([]byte("2"))))
 		assert.NoError(t, wb.Apply())
 		assert.NoError(t, wb.Close())
 
@@ -303,7 +372,8 @@ func TestStorage_WriteCommit(t *testing.T) {
 				LLSN: 1,
 				GLSN: 11,
 			},
-			Data: []byte("1"),
+			Data:     []byte("1"),
+			Checksum: varlogpb.Checksum([]byte("1")),
 		}, le)
 		le, err = stg.Read(AtLLSN(2))
 		assert.NoError(t, err)
@@ -312,7 +382,8 @@ func TestStorage_WriteCommit(t *testing.T) {
 				LLSN: 2,
 				GLSN: 12,
 			},
-			Data: []byte("2"),
+			Data:     []byte("2"),
+			Checksum: varlogpb.Checksum([]byte("2")),
 		}, le)
 
 		// read by GLSN
@@ -323,7 +394,8 @@ func TestStorage_WriteCommit(t *testing.T) {
 				LLSN: 1,
 				GLSN: 11,
 			},
-			Data: []byte("1"),
+			Data:     []byte("1"),
+			Checksum: varlogpb.Checksum([]byte("1")),
 		}, le)
 		le, err = stg.Read(AtGLSN(12))
 		assert.NoError(t, err)
@@ -332,7 +404,8 @@ func TestStorage_WriteCommit(t *testing.T) {
 				LLSN: 2,
 				GLSN: 12,
 			},
-			Data: []byte("2"),
+			Data:     []byte("2"),
+			Checksum: varlogpb.Checksum([]byte("2")),
 		}, le)
 
 		// scan
@@ -345,7 +418,8 @@ func TestStorage_WriteCommit(t *testing.T) {
 				LLSN: 1,
 				GLSN: 11,
 			},
-			Data: []byte("1"),
+			Data:     []byte("1"),
+			Checksum: varlogpb.Checksum([]byte("1")),
 		}, le)
 		assert.True(t, scanner.Next())
 		assert.True(t, scanner.Valid())
@@ -356,7 +430,8 @@ func TestStorage_WriteCommit(t *testing.T) {
 				LLSN: 2,
 				GLSN: 12,
 			},
-			Data: []byte("2"),
+			Data:     []byte("2"),
+			Checksum: varlogpb.Checksum([]byte("2")),
 		}, le)
 
 		assert.False(t, scanner.Next())
@@ -371,6 +446,399 @@ func TestStorage_WriteCommit(t *testing.T) {
 	})
 }
 
+func TestStorage_ScannerSeekGLSN(t *testing.T) {
+	testStorage(t, func(t testing.TB, stg *Storage) {
+		wb := stg.NewWriteBatch()
+		assert.NoError(t, wb.Set(1, []byte("1"), varlogpb.Checksum([]byte("1"))))
+		assert.NoError(t, wb.Set(2, []byte("2"), varlogpb.Checksum([]byte("2"))))
+		assert.NoError(t, wb.Set(3, []byte("3"), varlogpb.Checksum([]byte("3"))))
+		assert.NoError(t, wb.Apply())
+		assert.NoError(t, wb.Close())
+
+		cb, err := stg.NewCommitBatch(CommitContext{
+			Version:            1,
+			HighWatermark:      15,
+			CommittedGLSNBegin: 11,
+			CommittedGLSNEnd:   16,
+			CommittedLLSNBegin: 1,
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, cb.Set(1, 11))
+		assert.NoError(t, cb.Set(2, 13))
+		assert.NoError(t, cb.Set(3, 15))
+		assert.NoError(t, cb.Apply())
+		assert.NoError(t, cb.Close())
+
+		scanner := stg.NewScanner(WithGLSN(11, 16))
+		defer func() {
+			assert.NoError(t, scanner.Close())
+		}()
+
+		assert.True(t, scanner.SeekGLSN(13))
+		le, err := scanner.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, varlogpb.LogEntry{
+			LogEntryMeta: varlogpb.LogEntryMeta{
+				LLSN: 2,
+				GLSN: 13,
+			},
+			Data:     []byte("2"),
+			Checksum: varlogpb.Checksum([]byte("2")),
+		}, le)
+
+		// positioned at the next log entry
+		assert.False(t, scanner.SeekGLSN(14))
+		assert.True(t, scanner.Valid())
+		le, err = scanner.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, types.GLSN(15), le.GLSN)
+
+		// backward
+		assert.True(t, scanner.SeekGLSN(11))
+		le, err = scanner.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, types.LLSN(1), le.LLSN)
+
+		// out of range
+		assert.False(t, scanner.SeekGLSN(16))
+		assert.False(t, scanner.Valid())
+	})
+}
+
+func TestStorage_Headers(t *testing.T) {
+	headers := map[string][]byte{"foo": []byte("1"), "bar": []byte("2")}
+
+	tcs := []struct {
+		name  string
+		testf func(t testing.TB, stg *Storage)
+	}{
+		{
+			name: "WriteBatch",
+			testf: func(t testing.TB, stg *Storage) {
+				wb := stg.NewWriteBatch()
+				require.NoError(t, wb.Set(1, []byte("1"), varlogpb.Checksum([]byte("1"))))
+				require.NoError(t, wb.SetHeaders(1, headers))
+				require.NoError(t, wb.Set(2, []byte("2"), varlogpb.Checksum([]byte("2"))))
+				require.NoError(t, wb.SetHeaders(2, nil))
+				require.NoError(t, wb.Apply())
+				require.NoError(t, wb.Close())
+
+				cb, err := stg.NewCommitBatch(CommitContext{
+					Version:            1,
+					HighWatermark:      2,
+					CommittedGLSNBegin: 1,
+					CommittedGLSNEnd:   3,
+					CommittedLLSNBegin: 1,
+				})
+				require.NoError(t, err)
+				require.NoError(t, cb.Set(1, 1))
+				require.NoError(t, cb.Set(2, 2))
+				require.NoError(t, cb.Apply())
+				require.NoError(t, cb.Close())
+
+				le, err := stg.Read(AtGLSN(1))
+				require.NoError(t, err)
+				require.Equal(t, headers, le.Headers)
+
+				le, err = stg.Read(AtLLSN(1))
+				require.NoError(t, err)
+				require.Equal(t, headers, le.Headers)
+
+				le, err = stg.Read(AtGLSN(2))
+				require.NoError(t, err)
+				require.Nil(t, le.Headers)
+
+				scanner := stg.NewScanner(WithLLSN(1, 3))
+				le, err = scanner.Value()
+				require.NoError(t, err)
+				require.Equal(t, headers, le.Headers)
+				require.NoError(t, scanner.Close())
+
+				// trim
+				require.NoError(t, stg.Trim(1))
+				hs, err := stg.readHeaders(stg.db, 1, make([]byte, headerKeyLength))
+				require.NoError(t, err)
+				require.Nil(t, hs)
+			},
+		},
+		{
+			name: "AppendBatch",
+			testf: func(t testing.TB, stg *Storage) {
+				batch := stg.NewAppendBatch()
+				require.NoError(t, batch.SetLogEntry(1, 1, []byte("one"), varlogpb.Checksum([]byte("one"))))
+				require.NoError(t, batch.SetHeaders(1, headers))
+				require.NoError(t, batch.Apply())
+				require.NoError(t, batch.Close())
+
+				le, err := stg.Read(AtGLSN(1))
+				require.NoError(t, err)
+				require.Equal(t, headers, le.Headers)
+
+				// Overwriting the log entry clears stale headers.
+				batch = stg.NewAppendBatch()
+				require.NoError(t, batch.SetLogEntry(1, 1, []byte("one"), varlogpb.Checksum([]byte("one"))))
+				require.NoError(t, batch.Apply())
+				require.NoError(t, batch.Close())
+
+				le, err = stg.Read(AtGLSN(1))
+				require.NoError(t, err)
+				require.Nil(t, le.Headers)
+			},
+		},
+		{
+			name: "DeleteHeaders",
+			testf: func(t testing.TB, stg *Storage) {
+				wb := stg.NewWriteBatch()
+				for llsn := types.LLSN(1); llsn <= 3; llsn++ {
+					require.NoError(t, wb.Set(llsn, nil, varlogpb.Checksum(nil)))
+					require.NoError(t, wb.SetHeaders(llsn, headers))
+				}
+				require.NoError(t, wb.Apply())
+				require.NoError(t, wb.Close())
+
+				require.NoError(t, stg.DeleteHeaders(2))
+
+				hk := make([]byte, headerKeyLength)
+				hs, err := stg.readHeaders(stg.db, 1, hk)
+				require.NoError(t, err)
+				require.Equal(t, headers, hs)
+				for llsn := types.LLSN(2); llsn <= 3; llsn++ {
+					hs, err := stg.readHeaders(stg.db, llsn, hk)
+					require.NoError(t, err)
+					require.Nil(t, hs)
+				}
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			testStorage(t, func(t testing.TB, stg *Storage) {
+				tc.testf(t, stg)
+			})
+		})
+	}
+}
+
+func TestStorage_ProducerStates(t *testing.T) {
+	testStorage(t, func(t testing.TB, stg *Storage) {
+		states, err := stg.ReadProducerStates()
+		require.NoError(t, err)
+		require.Empty(t, states)
+
+		foo := varlogpb.ProducerState{
+			ProducerID:        "foo",
+			LastBatchSequence: 10,
+			LastBatch: []varlogpb.LogEntryMeta{
+				{LLSN: 1, GLSN: 1},
+				{LLSN: 2, GLSN: 2},
+			},
+		}
+		cb, err := stg.NewCommitBatch(CommitContext{
+			Version:            1,
+			HighWatermark:      2,
+			CommittedGLSNBegin: 1,
+			CommittedGLSNEnd:   3,
+			CommittedLLSNBegin: 1,
+		})
+		require.NoError(t, err)
+		require.NoError(t, cb.Set(1, 1))
+		require.NoError(t, cb.Set(2, 2))
+		require.NoError(t, cb.SetProducerState(foo))
+		require.NoError(t, cb.Apply())
+		require.NoError(t, cb.Close())
+
+		bar := varlogpb.ProducerState{
+			ProducerID:        "bar",
+			LastBatchSequence: 1,
+			LastBatch:         []varlogpb.LogEntryMeta{{LLSN: 3, GLSN: 3}},
+		}
+		batch := stg.NewAppendBatch()
+		require.NoError(t, batch.SetLogEntry(3, 3, nil, varlogpb.Checksum(nil)))
+		require.NoError(t, batch.SetProducerState(bar))
+		require.NoError(t, batch.Apply())
+		require.NoError(t, batch.Close())
+
+		states, err = stg.ReadProducerStates()
+		require.NoError(t, err)
+		require.Equal(t, []varlogpb.ProducerState{bar, foo}, states)
+
+		// The newer state overwrites the older one.
+		foo.LastBatchSequence = 12
+		foo.LastBatch = []varlogpb.LogEntryMeta{{LLSN: 4, GLSN: 4}}
+		batch = stg.NewAppendBatch()
+		require.NoError(t, batch.SetProducerState(foo))
+		require.NoError(t, batch.Apply())
+		require.NoError(t, batch.Close())
+
+		states, err = stg.ReadProducerStates()
+		require.NoError(t, err)
+		require.Equal(t, []varlogpb.ProducerState{bar, foo}, states)
+
+		// The commit batch deletes the state of the expired producer.
+		cb, err = stg.NewCommitBatch(CommitContext{
+			Version:            2,
+			HighWatermark:      4,
+			CommittedGLSNBegin: 4,
+			CommittedGLSNEnd:   5,
+			CommittedLLSNBegin: 4,
+		})
+		require.NoError(t, err)
+		require.NoError(t, cb.Set(4, 4))
+		require.NoError(t, cb.DeleteProducerState(bar.ProducerID))
+		require.NoError(t, cb.Apply())
+		require.NoError(t, cb.Close())
+
+		states, err = stg.ReadProducerStates()
+		require.NoError(t, err)
+		require.Equal(t, []varlogpb.ProducerState{foo}, states)
+	})
+}
+
+func TestStorage_CommitTime(t *testing.T) {
+	path := t.TempDir()
+	stg := TestNewStorage(t, WithPath(path))
+
+	_, err := stg.SeekTime(time.Unix(0, 0))
+	require.ErrorIs(t, err, ErrNoLogEntry)
+
+	base := time.Now()
+	commit := func(glsn types.GLSN, commitTime time.Time) {
+		cb, err := stg.NewCommitBatch(CommitContext{
+			Version:            types.Version(glsn),
+			HighWatermark:      glsn,
+			CommittedGLSNBegin: glsn,
+			CommittedGLSNEnd:   glsn + 1,
+			CommittedLLSNBegin: types.LLSN(glsn),
+		})
+		require.NoError(t, err)
+		require.NoError(t, cb.Set(types.LLSN(glsn), glsn))
+		require.NoError(t, cb.SetCommitTime(commitTime))
+		require.NoError(t, cb.Apply())
+		require.NoError(t, cb.Close())
+	}
+	commit(1, base)
+	commit(2, base.Add(time.Second))
+	// The clock goes backward, but the commit time in the index increases.
+	commit(3, base.Add(time.Millisecond))
+
+	tcs := []struct {
+		t        time.Time
+		expected types.GLSN
+	}{
+		{t: time.Time{}, expected: 1},
+		{t: base.Add(-time.Second), expected: 1},
+		{t: base, expected: 1},
+		{t: base.Add(time.Nanosecond), expected: 2},
+		{t: base.Add(time.Second), expected: 2},
+		{t: base.Add(time.Second + time.Nanosecond), expected: 3},
+	}
+	for _, tc := range tcs {
+		glsn, err := stg.SeekTime(tc.t)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, glsn)
+	}
+	_, err = stg.SeekTime(base.Add(2 * time.Second))
+	require.ErrorIs(t, err, ErrNoLogEntry)
+
+	// The last commit time is recovered after restarting.
+	require.NoError(t, stg.Close())
+	stg = TestNewStorage(t, WithPath(path))
+	defer func() {
+		require.NoError(t, stg.Close())
+	}()
+	commit(4, base)
+	glsn, err := stg.SeekTime(base.Add(time.Second + 2*time.Nanosecond))
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(4), glsn)
+
+	// Trim keeps the entry of the time index for the commit that has log
+	// entries not trimmed.
+	require.NoError(t, stg.Trim(1))
+	glsn, err = stg.SeekTime(base.Add(-time.Second))
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(2), glsn)
+
+	require.NoError(t, stg.Trim(3))
+	glsn, err = stg.SeekTime(base.Add(-time.Second))
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(4), glsn)
+}
+
+func TestStorage_ReadCommitTimes(t *testing.T) {
+	stg := TestNewStorage(t)
+	defer func() {
+		require.NoError(t, stg.Close())
+	}()
+
+	cts, err := stg.ReadCommitTimes(1, types.MaxGLSN)
+	require.NoError(t, err)
+	require.Empty(t, cts)
+
+	// Each commit has two log entries at irregular intervals.
+	const numCommits = 100
+	base := time.Now()
+	var expected []CommitTime
+	for i := 0; i < numCommits; i++ {
+		glsn := types.GLSN(2*i + 1)
+		cb, err := stg.NewCommitBatch(CommitContext{
+			Version:            types.Version(i + 1),
+			HighWatermark:      glsn + 1,
+			CommittedGLSNBegin: glsn,
+			CommittedGLSNEnd:   glsn + 2,
+			CommittedLLSNBegin: types.LLSN(glsn),
+		})
+		require.NoError(t, err)
+		require.NoError(t, cb.Set(types.LLSN(glsn), glsn))
+		require.NoError(t, cb.Set(types.LLSN(glsn+1), glsn+1))
+		commitTime := base.Add(time.Duration(i*i) * time.Millisecond)
+		require.NoError(t, cb.SetCommitTime(commitTime))
+		require.NoError(t, cb.Apply())
+		require.NoError(t, cb.Close())
+		expected = append(expected, CommitTime{
+			UnixNano:  commitTime.UnixNano(),
+			GLSNBegin: glsn,
+			GLSNEnd:   glsn + 2,
+		})
+	}
+
+	cts, err = stg.ReadCommitTimes(1, types.MaxGLSN)
+	require.NoError(t, err)
+	require.Equal(t, expected, cts)
+
+	cts, err = stg.ReadCommitTimes(4, 6)
+	require.NoError(t, err)
+	require.Equal(t, expected[1:3], cts)
+
+	// Trim seeks the entry of the time index for the first commit that has
+	// log entries not trimmed.
+	for _, trimGLSN := range []types.GLSN{1, 2, 51, 100, 199} {
+		require.NoError(t, stg.Trim(trimGLSN))
+		cts, err = stg.ReadCommitTimes(1, types.MaxGLSN)
+		require.NoError(t, err)
+		require.Equal(t, expected[trimGLSN/2:], cts)
+	}
+	require.NoError(t, stg.Trim(types.MaxGLSN))
+	cts, err = stg.ReadCommitTimes(1, types.MaxGLSN)
+	require.NoError(t, err)
+	require.Empty(t, cts)
+
+	// The commit time copied from another replica is later than the latest
+	// one in the index.
+	ab := stg.NewAppendBatch()
+	require.NoError(t, ab.SetLogEntry(201, 201, nil, 0))
+	require.NoError(t, ab.SetCommitTime(CommitTime{UnixNano: base.UnixNano(), GLSNBegin: 201, GLSNEnd: 202}))
+	require.NoError(t, ab.Apply())
+	require.NoError(t, ab.Close())
+	cts, err = stg.ReadCommitTimes(201, 202)
+	require.NoError(t, err)
+	require.Equal(t, []CommitTime{{
+		UnixNano:  expected[numCommits-1].UnixNano + 1,
+		GLSNBegin: 201,
+		GLSNEnd:   202,
+	}}, cts)
+}
+
 func TestStorageRead(t *testing.T) {
 	testStorage(t, func(t testing.TB, stg *Storage) {
 		// no logs
@@ -401,7 +869,7 @@ func TestStorageRead(t *testing.T) {
 		assert.Error(t, err)
 
 		wb := stg.NewWriteBatch()
-		assert.NoError(t, wb.Set(11, nil))
+		assert.NoError(t, wb.Set(11, nil, varlogpb.Checksum(nil)))
 		assert.NoError(t, wb.Apply())
 		assert.NoError(t, wb.Close())
 
@@ -428,7 +896,7 @@ func TestStorageRead(t *testing.T) {
 		assert.NoError(t, cb.Apply())
 
 		wb = stg.NewWriteBatch()
-		assert.NoError(t, wb.Set(12, []byte("foo")))
+		assert.NoError(t, wb.Set(12, []byte("foo"), varlogpb.Checksum([]byte("foo"))))
 		assert.NoError(t, wb.Apply())
 		assert.NoError(t, wb.Close())
 
@@ -439,7 +907,8 @@ func TestStorageRead(t *testing.T) {
 			LogEntryMeta: varlogpb.LogEntryMeta{
 				GLSN: 12, LLSN: 12,
 			},
-			Data: []byte("foo"),
+			Data:     []byte("foo"),
+			Checksum: varlogpb.Checksum([]byte("foo")),
 		}, le)
 	})
 }
@@ -558,7 +1027,7 @@ func TestStorageReadLogEntryBoundaries(t *testing.T) {
 		assert.Error(t, err)
 
 		wb := stg.NewWriteBatch()
-		assert.NoError(t, wb.Set(1, nil))
+		assert.NoError(t, wb.Set(1, nil, varlogpb.Checksum(nil)))
 		assert.NoError(t, wb.Apply())
 		assert.NoError(t, wb.Close())
 
@@ -585,7 +1054,7 @@ func TestStorageReadLogEntryBoundaries(t *testing.T) {
 		assert.Error(t, err)
 
 		wb = stg.NewWriteBatch()
-		assert.NoError(t, wb.Set(2, nil))
+		assert.NoError(t, wb.Set(2, nil, varlogpb.Checksum(nil)))
 		assert.NoError(t, wb.Apply())
 		assert.NoError(t, wb.Close())
 
@@ -647,7 +1116,7 @@ func TestStorageReadRecoveryPoints(t *testing.T) {
 		assert.Error(t, err)
 
 		wb := stg.NewWriteBatch()
-		assert.NoError(t, wb.Set(1, nil))
+		assert.NoError(t, wb.Set(1, nil, varlogpb.Checksum(nil)))
 		assert.NoError(t, wb.Apply())
 		assert.NoError(t, wb.Close())
 
@@ -737,6 +1206,34 @@ func TestStorage_TrimWhenNoLogEntry(t *testing.T) {
 	}
 }
 
+func TestStorage_LiveDiskUsage(t *testing.T) {
+	stg := TestNewStorage(t)
+	defer func() {
+		assert.NoError(t, stg.Close())
+	}()
+
+	// Each half of log entries is flushed to its own file.
+	data := bytes.Repeat([]byte("x"), 1024)
+	for llsn := types.MinLLSN; llsn <= 200; llsn++ {
+		TestAppendLogEntryWithoutCommitContext(t, stg, llsn, types.GLSN(llsn), data)
+		if llsn%100 == 0 {
+			require.NoError(t, stg.db.Flush())
+		}
+	}
+
+	all, err := stg.LiveDiskUsage(types.MinLLSN)
+	require.NoError(t, err)
+	half, err := stg.LiveDiskUsage(101)
+	require.NoError(t, err)
+	require.Positive(t, half)
+	require.Less(t, half, all)
+
+	// Only the tail of the last data block overlaps the range.
+	none, err := stg.LiveDiskUsage(201)
+	require.NoError(t, err)
+	require.Less(t, none, half)
+}
+
 func TestStorage_Trim(t *testing.T) {
 	expectedCC := CommitContext{
 		Version:            1,
@@ -841,9 +1338,9 @@ func TestStorage_Trim(t *testing.T) {
 				// LLSN: 1 2 3
 				// GLSN: 1 2 3
 				wb := stg.NewWriteBatch()
-				assert.NoError(t, wb.Set(1, nil))
-				assert.NoError(t, wb.Set(2, nil))
-				assert.NoError(t, wb.Set(3, nil))
+				assert.NoError(t, wb.Set(1, nil, varlogpb.Checksum(nil)))
+				assert.NoError(t, wb.Set(2, nil, varlogpb.Checksum(nil)))
+				assert.NoError(t, wb.Set(3, nil, varlogpb.Checksum(nil)))
 				assert.NoError(t, wb.Apply())
 				assert.NoError(t, wb.Close())
 
@@ -33,11 +33,23 @@ func TestGetUnderlyingDB(tb testing.TB, stg *Storage) *pebble.DB {
 // context.
 func TestAppendLogEntryWithoutCommitContext(tb testing.TB, stg *Storage, llsn types.LLSN, glsn types.GLSN, data []byte) {
 	batch := stg.NewAppendBatch()
-	require.NoError(tb, batch.SetLogEntry(llsn, glsn, data))
+	require.NoError(tb, batch.SetLogEntry(llsn, glsn, data, varlogpb.Checksum(data)))
 	require.NoError(tb, batch.Apply())
 	require.NoError(tb, batch.Close())
 }
 
+// TestCorruptLogEntry flips a bit of the stored value of the log entry at the
+// llsn to simulate silent corruption of the disk.
+func TestCorruptLogEntry(tb testing.TB, stg *Storage, llsn types.LLSN) {
+	dk := encodeDataKeyInternal(llsn, make([]byte, dataKeyLength))
+	value, closer, err := stg.db.Get(dk)
+	require.NoError(tb, err)
+	value = append([]byte(nil), value...)
+	require.NoError(tb, closer.Close())
+	value[0] ^= 0x1
+	require.NoError(tb, stg.db.Set(dk, value, pebble.Sync))
+}
+
 // TestSetCommitContext stores only commit context.
 func TestSetCommitContext(tb testing.TB, stg *Storage, cc CommitContext) {
 	batch := stg.NewAppendBatch()
@@ -62,6 +74,10 @@ func TestDeleteLogEntry(tb testing.TB, stg *Storage, lsn varlogpb.LogSequenceNum
 	err := batch.Delete(encodeDataKeyInternal(lsn.LLSN, dk), nil)
 	require.NoError(tb, err)
 
+	sk := make([]byte, checksumKeyLength)
+	err = batch.Delete(encodeChecksumKeyInternal(lsn.LLSN, sk), nil)
+	require.NoError(tb, err)
+
 	ck := make([]byte, commitKeyLength)
 	err = batch.Delete(encodeCommitKeyInternal(lsn.GLSN, ck), nil)
 	require.NoError(tb, err)
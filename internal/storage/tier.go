@@ -0,0 +1,534 @@
+package storage
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+var ErrInvalidSegment = errors.New("storage: invalid segment")
+
+const (
+	segmentMagic     = "VLSG"
+	segmentVersion   = byte(1)
+	segmentCacheSize = 4
+)
+
+// segmentMeta describes a segment, an immutable object in the cold tier that
+// has committed log entries from first to last. After trimming, first can be
+// greater than the first log entry in the object.
+type segmentMeta struct {
+	first     varlogpb.LogEntryMeta
+	last      varlogpb.LogEntryMeta
+	objectKey string
+}
+
+// reader is a read-only view of the database, either the database itself or
+// its snapshot.
+type reader interface {
+	Get(key []byte) ([]byte, io.Closer, error)
+	NewIter(o *pebble.IterOptions) *pebble.Iterator
+}
+
+var (
+	_ reader = (*pebble.DB)(nil)
+	_ reader = (*pebble.Snapshot)(nil)
+)
+
+func nopCloseView() {}
+
+// newView returns a reader and the catalog of segments consistent with each
+// other. Moving log entries to the cold tier and trimming them change both
+// the database and the catalog; thus, readers should see both of them at the
+// same point. The caller must call the returned function to release the
+// view.
+//
+// If the cold tier is disabled, it returns the database itself.
+func (s *Storage) newView() (reader, []segmentMeta, func()) {
+	if s.coldTier.store == nil {
+		return s.db, nil, nopCloseView
+	}
+	s.tier.mu.RLock()
+	defer s.tier.mu.RUnlock()
+	snap := s.db.NewSnapshot()
+	return snap, s.tier.catalog, func() {
+		_ = snap.Close()
+	}
+}
+
+// openColdTier loads the catalog of segments and deletes objects in the cold
+// tier not referenced by the catalog. These objects are left by moving log
+// entries to the cold tier that failed in the middle.
+func (s *Storage) openColdTier() error {
+	it := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{segmentKeyPrefix},
+		UpperBound: []byte{segmentKeySentinelPrefix},
+	})
+	defer func() {
+		_ = it.Close()
+	}()
+	var catalog []segmentMeta
+	for it.First(); it.Valid(); it.Next() {
+		catalog = append(catalog, decodeSegmentMeta(it.Key(), it.Value()))
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if len(catalog) > 0 && s.coldTier.store == nil {
+		return errors.New("storage: log entries in cold tier, but no cold tier")
+	}
+	s.tier.catalog = catalog
+
+	if s.coldTier.store == nil || s.readOnly {
+		return nil
+	}
+	// The storage deletes unreferenced objects only under the prefix it
+	// owns. Referring to segments elsewhere, for instance, ones of a
+	// checkpoint, means that the prefix may be shared with another storage.
+	for _, seg := range catalog {
+		if !strings.HasPrefix(seg.objectKey, s.coldTier.prefix+"/") {
+			return fmt.Errorf("storage: cold tier: segment %s not under prefix %s", seg.objectKey, s.coldTier.prefix)
+		}
+	}
+	ctx := context.Background()
+	keys, err := s.coldTier.store.List(ctx, s.coldTier.prefix+"/")
+	if err != nil {
+		return fmt.Errorf("storage: cold tier: %w", err)
+	}
+	referenced := make(map[string]bool, len(catalog))
+	for _, seg := range catalog {
+		referenced[seg.objectKey] = true
+	}
+	for _, key := range keys {
+		if referenced[key] {
+			continue
+		}
+		if err := s.coldTier.store.Delete(ctx, key); err != nil {
+			s.logger.Warn("storage: cold tier: delete unreferenced segment", zap.String("key", key), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (s *Storage) startColdTier() {
+	if s.coldTier.store == nil || s.readOnly || s.coldTier.noOffload {
+		return
+	}
+	s.tier.stop = make(chan struct{})
+	s.tier.ticker = time.NewTicker(s.coldTier.checkInterval)
+	s.tier.wg.Add(1)
+	go func() {
+		defer s.tier.wg.Done()
+		for {
+			select {
+			case <-s.tier.ticker.C:
+				if err := s.offload(time.Now().Add(-s.coldTier.age)); err != nil {
+					s.logger.Warn("storage: cold tier: offload", zap.Error(err))
+				}
+			case <-s.tier.stop:
+				return
+			}
+		}
+	}()
+}
+
+func (s *Storage) stopColdTier() {
+	if s.coldTier.store == nil || s.readOnly || s.coldTier.noOffload {
+		return
+	}
+	s.tier.ticker.Stop()
+	close(s.tier.stop)
+	s.tier.wg.Wait()
+}
+
+// offload moves log entries committed before the argument before from the
+// local disk to the cold tier. It packs the oldest log entries into segments
+// of the configured size; the last segment can be smaller so that no log
+// entry stays on the local disk longer than the configured age regardless of
+// the write rate.
+func (s *Storage) offload(before time.Time) error {
+	s.tier.opMu.Lock()
+	defer s.tier.opMu.Unlock()
+
+	upper, err := s.offloadUpperBound(before)
+	if err != nil || upper.Invalid() {
+		return err
+	}
+
+	ck := make([]byte, commitKeyLength)
+	it := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{commitKeyPrefix},
+		UpperBound: encodeCommitKeyInternal(upper, ck),
+	})
+	defer func() {
+		_ = it.Close()
+	}()
+
+	var les []varlogpb.LogEntry
+	size := 0
+	hk := make([]byte, headerKeyLength)
+	sk := make([]byte, checksumKeyLength)
+	for it.First(); it.Valid(); it.Next() {
+		le, err := s.readCommitted(s.db, it.Key(), it.Value(), hk, sk)
+		if err != nil {
+			return err
+		}
+		les = append(les, le)
+		size += len(le.Data)
+		for key, value := range le.Headers {
+			size += len(key) + len(value)
+		}
+		if size < s.coldTier.segmentSize {
+			continue
+		}
+		if err := s.offloadSegment(les); err != nil {
+			return err
+		}
+		les, size = nil, 0
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if len(les) == 0 {
+		return nil
+	}
+	return s.offloadSegment(les)
+}
+
+// offloadUpperBound returns the exclusive upper bound of GLSNs of log entries
+// committed before the argument before. It returns InvalidGLSN if there is no
+// such log entry or the storage has no time index.
+func (s *Storage) offloadUpperBound(before time.Time) (types.GLSN, error) {
+	lastCommitTime := s.lastCommitTime.Load()
+	if lastCommitTime == 0 {
+		return types.InvalidGLSN, nil
+	}
+	glsn, err := s.SeekTime(before)
+	if err == nil {
+		return glsn, nil
+	}
+	if !errors.Is(err, ErrNoLogEntry) {
+		return types.InvalidGLSN, err
+	}
+	// All commits in the time index are before the argument before.
+	if lastCommitTime < before.UnixNano() {
+		return types.MaxGLSN, nil
+	}
+	return types.InvalidGLSN, nil
+}
+
+// offloadSegment writes the log entries to the cold tier as a segment, and
+// then deletes them from the local disk. If it fails after writing the
+// segment, the segment is deleted when the storage is opened next time.
+func (s *Storage) offloadSegment(les []varlogpb.LogEntry) error {
+	first, last := les[0].LogEntryMeta, les[len(les)-1].LogEntryMeta
+	seg := segmentMeta{
+		first:     varlogpb.LogEntryMeta{GLSN: first.GLSN, LLSN: first.LLSN},
+		last:      varlogpb.LogEntryMeta{GLSN: last.GLSN, LLSN: last.LLSN},
+		objectKey: path.Join(s.coldTier.prefix, fmt.Sprintf("%020d-%020d.seg", first.GLSN, last.GLSN)),
+	}
+	if err := s.coldTier.store.Put(context.Background(), seg.objectKey, encodeSegmentObject(les)); err != nil {
+		return fmt.Errorf("storage: cold tier: %w", err)
+	}
+
+	batch := s.db.NewBatch()
+	defer func() {
+		_ = batch.Close()
+	}()
+	_ = batch.Set(encodeSegmentKey(seg.last.GLSN), encodeSegmentValue(seg), nil)
+
+	ckBegin := make([]byte, commitKeyLength)
+	ckEnd := make([]byte, commitKeyLength)
+	_ = batch.DeleteRange(encodeCommitKeyInternal(seg.first.GLSN, ckBegin), encodeCommitKeyInternal(seg.last.GLSN+1, ckEnd), nil)
+
+	dkBegin := make([]byte, dataKeyLength)
+	dkEnd := make([]byte, dataKeyLength)
+	_ = batch.DeleteRange(encodeDataKeyInternal(seg.first.LLSN, dkBegin), encodeDataKeyInternal(seg.last.LLSN+1, dkEnd), nil)
+
+	skBegin := make([]byte, checksumKeyLength)
+	skEnd := make([]byte, checksumKeyLength)
+	_ = batch.DeleteRange(encodeChecksumKeyInternal(seg.first.LLSN, skBegin), encodeChecksumKeyInternal(seg.last.LLSN+1, skEnd), nil)
+
+	hkBegin := make([]byte, headerKeyLength)
+	hkEnd := make([]byte, headerKeyLength)
+	_ = batch.DeleteRange(encodeHeaderKeyInternal(seg.first.LLSN, hkBegin), encodeHeaderKeyInternal(seg.last.LLSN+1, hkEnd), nil)
+
+	s.tier.mu.Lock()
+	defer s.tier.mu.Unlock()
+	if err := batch.Commit(s.writeOpts); err != nil {
+		return err
+	}
+	s.tier.catalog = append(s.tier.catalog, seg)
+	s.tier.cache.put(seg.objectKey, les)
+	return nil
+}
+
+// trimColdTier adds changes of the catalog for trimming log entries up to
+// the argument lem to the batch. It returns segments to be deleted and the
+// catalog after trimming. The caller must hold opMu.
+func (s *Storage) trimColdTier(batch *pebble.Batch, lem varlogpb.LogEntryMeta) (removed, catalog []segmentMeta, err error) {
+	old := s.tier.catalog
+	idx := sort.Search(len(old), func(i int) bool {
+		return old[i].last.GLSN > lem.GLSN
+	})
+	removed = old[:idx]
+	catalog = make([]segmentMeta, len(old)-idx)
+	copy(catalog, old[idx:])
+
+	if len(removed) > 0 {
+		_ = batch.DeleteRange([]byte{segmentKeyPrefix}, encodeSegmentKey(removed[len(removed)-1].last.GLSN+1), nil)
+	}
+	if len(catalog) == 0 || catalog[0].first.GLSN > lem.GLSN {
+		return removed, catalog, nil
+	}
+
+	// The first segment is trimmed partially.
+	les, err := s.loadSegment(catalog[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	pos := sort.Search(len(les), func(i int) bool {
+		return les[i].GLSN > lem.GLSN
+	})
+	if pos == len(les) {
+		return nil, nil, fmt.Errorf("storage: cold tier: %s: %w", catalog[0].objectKey, ErrInvalidSegment)
+	}
+	catalog[0].first = varlogpb.LogEntryMeta{GLSN: les[pos].GLSN, LLSN: les[pos].LLSN}
+	_ = batch.Set(encodeSegmentKey(catalog[0].last.GLSN), encodeSegmentValue(catalog[0]), nil)
+	return removed, catalog, nil
+}
+
+// deleteSegments deletes the segments from the cold tier. Failing to delete
+// them is not fatal since the storage deletes unreferenced segments when
+// opened.
+func (s *Storage) deleteSegments(segs []segmentMeta) {
+	for _, seg := range segs {
+		s.tier.cache.remove(seg.objectKey)
+		if err := s.coldTier.store.Delete(context.Background(), seg.objectKey); err != nil {
+			s.logger.Warn("storage: cold tier: delete segment", zap.String("key", seg.objectKey), zap.Error(err))
+		}
+	}
+}
+
+// loadSegment returns all log entries in the segment, including ones trimmed
+// already. The caller must not modify the returned log entries.
+func (s *Storage) loadSegment(seg segmentMeta) ([]varlogpb.LogEntry, error) {
+	if les, ok := s.tier.cache.get(seg.objectKey); ok {
+		return les, nil
+	}
+	buf, err := s.coldTier.store.Get(context.Background(), seg.objectKey)
+	if err != nil {
+		return nil, fmt.Errorf("storage: cold tier: %w", err)
+	}
+	les, err := decodeSegmentObject(buf)
+	if err != nil {
+		return nil, fmt.Errorf("storage: cold tier: %s: %w", seg.objectKey, err)
+	}
+	s.tier.cache.put(seg.objectKey, les)
+	return les, nil
+}
+
+// segmentCache keeps recently read segments to avoid fetching a segment from
+// the cold tier for each log entry.
+type segmentCache struct {
+	mu       sync.Mutex
+	keys     []string // from the least recently used
+	segments map[string][]varlogpb.LogEntry
+}
+
+func (c *segmentCache) get(key string) ([]varlogpb.LogEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	les, ok := c.segments[key]
+	if ok {
+		c.touch(key)
+	}
+	return les, ok
+}
+
+func (c *segmentCache) put(key string, les []varlogpb.LogEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.segments == nil {
+		c.segments = make(map[string][]varlogpb.LogEntry, segmentCacheSize)
+	}
+	if _, ok := c.segments[key]; ok {
+		c.segments[key] = les
+		c.touch(key)
+		return
+	}
+	if len(c.keys) == segmentCacheSize {
+		delete(c.segments, c.keys[0])
+		c.keys = c.keys[1:]
+	}
+	c.segments[key] = les
+	c.keys = append(c.keys, key)
+}
+
+func (c *segmentCache) remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.segments[key]; !ok {
+		return
+	}
+	delete(c.segments, key)
+	for i := range c.keys {
+		if c.keys[i] == key {
+			c.keys = append(c.keys[:i], c.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// touch moves the key to the most recently used position. The caller must
+// hold mu.
+func (c *segmentCache) touch(key string) {
+	for i := range c.keys {
+		if c.keys[i] == key {
+			c.keys = append(append(c.keys[:i:i], c.keys[i+1:]...), key)
+			return
+		}
+	}
+}
+
+// cloneLogEntry returns a copy of the log entry loaded from a segment so that
+// callers can modify it freely.
+func cloneLogEntry(src varlogpb.LogEntry) (le varlogpb.LogEntry) {
+	le.LogEntryMeta = src.LogEntryMeta
+	le.Checksum = src.Checksum
+	if len(src.Data) > 0 {
+		le.Data = make([]byte, len(src.Data))
+		copy(le.Data, src.Data)
+	}
+	if src.Headers != nil {
+		le.Headers = make(map[string][]byte, len(src.Headers))
+		for key, value := range src.Headers {
+			le.Headers[key] = append([]byte(nil), value...)
+		}
+	}
+	return le
+}
+
+// encodeSegmentObject serializes log entries into a segment. A segment has
+// the magic, version, number of log entries, log entries, and CRC32C checksum
+// of all preceding bytes:
+//
+//	magic | version | count | entry | ... | checksum
+//
+// Each log entry consists of GLSN, LLSN, and length-prefixed data and
+// headers. Headers are encoded by encodeHeaders, and the log entry having no
+// headers has empty headers.
+//
+//	GLSN | LLSN | len(data) | data | len(headers) | headers
+func encodeSegmentObject(les []varlogpb.LogEntry) []byte {
+	size := len(segmentMagic) + 1 + binary.MaxVarintLen64 + crc32.Size
+	headers := make([][]byte, len(les))
+	for i := range les {
+		if len(les[i].Headers) > 0 {
+			headers[i] = encodeHeaders(les[i].Headers)
+		}
+		size += types.GLSNLen + types.LLSNLen + 2*binary.MaxVarintLen64 + len(les[i].Data) + len(headers[i])
+	}
+
+	buf := make([]byte, size)
+	offset := copy(buf, segmentMagic)
+	buf[offset] = segmentVersion
+	offset++
+	offset += binary.PutUvarint(buf[offset:], uint64(len(les)))
+	for i := range les {
+		binary.BigEndian.PutUint64(buf[offset:], uint64(les[i].GLSN))
+		offset += types.GLSNLen
+		binary.BigEndian.PutUint64(buf[offset:], uint64(les[i].LLSN))
+		offset += types.LLSNLen
+		offset += binary.PutUvarint(buf[offset:], uint64(len(les[i].Data)))
+		offset += copy(buf[offset:], les[i].Data)
+		offset += binary.PutUvarint(buf[offset:], uint64(len(headers[i])))
+		offset += copy(buf[offset:], headers[i])
+	}
+	binary.BigEndian.PutUint32(buf[offset:], varlogpb.Checksum(buf[:offset]))
+	offset += crc32.Size
+	return buf[:offset]
+}
+
+// decodeSegmentObject deserializes log entries from a segment encoded by
+// encodeSegmentObject.
+func decodeSegmentObject(buf []byte) ([]varlogpb.LogEntry, error) {
+	if len(buf) < len(segmentMagic)+1+crc32.Size || string(buf[:len(segmentMagic)]) != segmentMagic {
+		return nil, ErrInvalidSegment
+	}
+	body := buf[:len(buf)-crc32.Size]
+	if binary.BigEndian.Uint32(buf[len(body):]) != varlogpb.Checksum(body) {
+		return nil, fmt.Errorf("%w: checksum mismatch", ErrInvalidSegment)
+	}
+	if body[len(segmentMagic)] != segmentVersion {
+		return nil, fmt.Errorf("%w: unknown version %d", ErrInvalidSegment, body[len(segmentMagic)])
+	}
+	body = body[len(segmentMagic)+1:]
+
+	readBytes := func() ([]byte, error) {
+		n, sz := binary.Uvarint(body)
+		if sz <= 0 || uint64(len(body)-sz) < n {
+			return nil, ErrInvalidSegment
+		}
+		ret := body[sz : sz+int(n)]
+		body = body[sz+int(n):]
+		return ret, nil
+	}
+
+	count, sz := binary.Uvarint(body)
+	if sz <= 0 {
+		return nil, ErrInvalidSegment
+	}
+	body = body[sz:]
+	// Each log entry needs at least 18 bytes: GLSN, LLSN, and two lengths.
+	if count == 0 || count > uint64(len(body)/(types.GLSNLen+types.LLSNLen+2)) {
+		return nil, ErrInvalidSegment
+	}
+	les := make([]varlogpb.LogEntry, count)
+	for i := range les {
+		if len(body) < types.GLSNLen+types.LLSNLen {
+			return nil, ErrInvalidSegment
+		}
+		les[i].GLSN = types.GLSN(binary.BigEndian.Uint64(body))
+		les[i].LLSN = types.LLSN(binary.BigEndian.Uint64(body[types.GLSNLen:]))
+		body = body[types.GLSNLen+types.LLSNLen:]
+
+		data, err := readBytes()
+		if err != nil {
+			return nil, err
+		}
+		if len(data) > 0 {
+			les[i].Data = data
+		}
+		// The checksum of the segment protects the data already.
+		les[i].Checksum = varlogpb.Checksum(data)
+		headers, err := readBytes()
+		if err != nil {
+			return nil, err
+		}
+		if len(headers) > 0 {
+			les[i].Headers, err = decodeHeaders(headers)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(body) != 0 {
+		return nil, ErrInvalidSegment
+	}
+	return les, nil
+}
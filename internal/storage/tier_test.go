@@ -0,0 +1,401 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/internal/storage/objstore"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func TestSegmentObject(t *testing.T) {
+	les := []varlogpb.LogEntry{
+		{LogEntryMeta: varlogpb.LogEntryMeta{GLSN: 2, LLSN: 1}, Data: []byte("one")},
+		{LogEntryMeta: varlogpb.LogEntryMeta{GLSN: 4, LLSN: 2}},
+		{LogEntryMeta: varlogpb.LogEntryMeta{GLSN: 6, LLSN: 3}, Data: []byte("three"), Headers: map[string][]byte{"k": []byte("v")}},
+	}
+	for i := range les {
+		les[i].Checksum = varlogpb.Checksum(les[i].Data)
+	}
+	buf := encodeSegmentObject(les)
+	got, err := decodeSegmentObject(buf)
+	require.NoError(t, err)
+	require.Equal(t, les, got)
+
+	_, err = decodeSegmentObject(nil)
+	require.ErrorIs(t, err, ErrInvalidSegment)
+
+	_, err = decodeSegmentObject(buf[:len(buf)-1])
+	require.ErrorIs(t, err, ErrInvalidSegment)
+
+	corrupted := append([]byte(nil), buf...)
+	corrupted[len(segmentMagic)+3] ^= 0xff
+	_, err = decodeSegmentObject(corrupted)
+	require.ErrorIs(t, err, ErrInvalidSegment)
+}
+
+func TestStorage_ColdTierInvalidConfig(t *testing.T) {
+	store, err := objstore.NewFileSystem(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = New(WithPath(t.TempDir()), WithColdTier(store))
+	require.Error(t, err)
+
+	_, err = New(WithPath(t.TempDir()), WithColdTier(store), WithColdTierPrefix("ls"), WithColdTierAge(0))
+	require.Error(t, err)
+
+	_, err = New(WithPath(t.TempDir()), WithColdTier(store), WithColdTierPrefix("ls"), WithColdTierCheckInterval(0))
+	require.Error(t, err)
+
+	_, err = New(WithPath(t.TempDir()), WithColdTier(store), WithColdTierPrefix("ls"), WithColdTierSegmentSize(0))
+	require.Error(t, err)
+}
+
+// testColdTierCommit writes and commits log entries from LLSN begin to end.
+// The log entry with LLSN i has GLSN 2*i, data "data-i", and headers only if
+// i is even. It was committed at base+i seconds.
+func testColdTierCommit(tb testing.TB, stg *Storage, base time.Time, begin, end types.LLSN) {
+	wb := stg.NewWriteBatch()
+	for llsn := begin; llsn < end; llsn++ {
+		require.NoError(tb, wb.Set(llsn, testColdTierData(llsn), varlogpb.Checksum(testColdTierData(llsn))))
+		if llsn%2 == 0 {
+			require.NoError(tb, wb.SetHeaders(llsn, testColdTierHeaders(llsn)))
+		}
+	}
+	require.NoError(tb, wb.Apply())
+	require.NoError(tb, wb.Close())
+
+	for llsn := begin; llsn < end; llsn++ {
+		glsn := types.GLSN(2 * llsn)
+		cb, err := stg.NewCommitBatch(CommitContext{
+			Version:            types.Version(llsn),
+			HighWatermark:      glsn,
+			CommittedGLSNBegin: glsn,
+			CommittedGLSNEnd:   glsn + 1,
+			CommittedLLSNBegin: llsn,
+		})
+		require.NoError(tb, err)
+		require.NoError(tb, cb.Set(llsn, glsn))
+		require.NoError(tb, cb.SetCommitTime(base.Add(time.Duration(llsn)*time.Second)))
+		require.NoError(tb, cb.Apply())
+		require.NoError(tb, cb.Close())
+	}
+}
+
+func testColdTierData(llsn types.LLSN) []byte {
+	return []byte(fmt.Sprintf("data-%d", llsn))
+}
+
+func testColdTierHeaders(llsn types.LLSN) map[string][]byte {
+	if llsn%2 != 0 {
+		return nil
+	}
+	return map[string][]byte{"llsn": []byte(fmt.Sprint(uint64(llsn)))}
+}
+
+func testColdTierLogEntry(llsn types.LLSN, withGLSN bool) varlogpb.LogEntry {
+	le := varlogpb.LogEntry{
+		LogEntryMeta: varlogpb.LogEntryMeta{LLSN: llsn},
+		Data:         testColdTierData(llsn),
+		Headers:      testColdTierHeaders(llsn),
+		Checksum:     varlogpb.Checksum(testColdTierData(llsn)),
+	}
+	if withGLSN {
+		le.GLSN = types.GLSN(2 * llsn)
+	}
+	return le
+}
+
+func testColdTierScanGLSN(tb testing.TB, stg *Storage, begin, end types.GLSN) []varlogpb.LogEntry {
+	scanner := stg.NewScanner(WithGLSN(begin, end))
+	defer func() {
+		require.NoError(tb, scanner.Close())
+	}()
+	var les []varlogpb.LogEntry
+	for scanner.Valid() {
+		le, err := scanner.Value()
+		require.NoError(tb, err)
+		les = append(les, le)
+		scanner.Next()
+	}
+	return les
+}
+
+func testColdTierScanLLSN(tb testing.TB, stg *Storage, begin, end types.LLSN) []varlogpb.LogEntry {
+	scanner := stg.NewScanner(WithLLSN(begin, end))
+	defer func() {
+		require.NoError(tb, scanner.Close())
+	}()
+	var les []varlogpb.LogEntry
+	for scanner.Valid() {
+		le, err := scanner.Value()
+		require.NoError(tb, err)
+		les = append(les, le)
+		scanner.Next()
+	}
+	return les
+}
+
+func testColdTierHotGLSNs(tb testing.TB, stg *Storage) []types.GLSN {
+	it := stg.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{commitKeyPrefix},
+		UpperBound: []byte{commitKeySentinelPrefix},
+	})
+	defer func() {
+		require.NoError(tb, it.Close())
+	}()
+	var glsns []types.GLSN
+	for it.First(); it.Valid(); it.Next() {
+		glsns = append(glsns, decodeCommitKey(it.Key()))
+	}
+	return glsns
+}
+
+func TestStorage_ColdTier(t *testing.T) {
+	ctx := context.Background()
+	path := t.TempDir()
+	store, err := objstore.NewFileSystem(t.TempDir())
+	require.NoError(t, err)
+	opts := []Option{
+		WithPath(path),
+		WithColdTier(store),
+		WithColdTierPrefix("ls"),
+		// Each segment has two log entries since each data is six bytes.
+		WithColdTierSegmentSize(12),
+		// Moving log entries to the cold tier is triggered manually.
+		WithColdTierCheckInterval(time.Hour),
+	}
+	stg := TestNewStorage(t, opts...)
+
+	base := time.Now()
+	testColdTierCommit(t, stg, base, 1, 10)
+
+	// Nothing is moved if all log entries are young.
+	require.NoError(t, stg.offload(base))
+	keys, err := store.List(ctx, "")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	// LLSN 1 to 5 are committed before base+5.5s.
+	require.NoError(t, stg.offload(base.Add(5500*time.Millisecond)))
+	keys, err = store.List(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"ls/00000000000000000002-00000000000000000004.seg",
+		"ls/00000000000000000006-00000000000000000008.seg",
+		"ls/00000000000000000010-00000000000000000010.seg",
+	}, keys)
+	require.Equal(t, []types.GLSN{12, 14, 16, 18}, testColdTierHotGLSNs(t, stg))
+
+	var expected []varlogpb.LogEntry
+	for llsn := types.LLSN(1); llsn < 10; llsn++ {
+		expected = append(expected, testColdTierLogEntry(llsn, true))
+	}
+	require.Equal(t, expected, testColdTierScanGLSN(t, stg, types.MinGLSN, types.MaxGLSN))
+	require.Equal(t, expected[2:7], testColdTierScanGLSN(t, stg, 5, 15))
+
+	expected = nil
+	for llsn := types.LLSN(1); llsn < 10; llsn++ {
+		expected = append(expected, testColdTierLogEntry(llsn, false))
+	}
+	require.Equal(t, expected, testColdTierScanLLSN(t, stg, types.MinLLSN, types.MaxLLSN))
+	require.Equal(t, expected[3:7], testColdTierScanLLSN(t, stg, 4, 8))
+
+	// SeekGLSN across tiers
+	scanner := stg.NewScanner(WithGLSN(types.MinGLSN, types.MaxGLSN))
+	require.True(t, scanner.SeekGLSN(14))
+	le, err := scanner.Value()
+	require.NoError(t, err)
+	require.Equal(t, testColdTierLogEntry(7, true), le)
+	require.True(t, scanner.SeekGLSN(4))
+	le, err = scanner.Value()
+	require.NoError(t, err)
+	require.Equal(t, testColdTierLogEntry(2, true), le)
+	require.False(t, scanner.SeekGLSN(5))
+	le, err = scanner.Value()
+	require.NoError(t, err)
+	require.Equal(t, testColdTierLogEntry(3, true), le)
+	require.False(t, scanner.SeekGLSN(11))
+	le, err = scanner.Value()
+	require.NoError(t, err)
+	require.Equal(t, testColdTierLogEntry(6, true), le)
+	require.True(t, scanner.Next())
+	le, err = scanner.Value()
+	require.NoError(t, err)
+	require.Equal(t, testColdTierLogEntry(7, true), le)
+	require.False(t, scanner.SeekGLSN(19))
+	require.False(t, scanner.Valid())
+	_, err = scanner.Value()
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, scanner.Close())
+
+	// Read
+	le, err = stg.Read(AtGLSN(6))
+	require.NoError(t, err)
+	require.Equal(t, testColdTierLogEntry(3, true), le)
+	le, err = stg.Read(AtLLSN(4))
+	require.NoError(t, err)
+	require.Equal(t, testColdTierLogEntry(4, true), le)
+	le, err = stg.Read(AtLLSN(8))
+	require.NoError(t, err)
+	require.Equal(t, testColdTierLogEntry(8, true), le)
+	_, err = stg.Read(AtGLSN(7))
+	require.ErrorIs(t, err, ErrNoLogEntry)
+
+	// Time index is kept for log entries in the cold tier.
+	glsn, err := stg.SeekTime(base)
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(2), glsn)
+
+	rp, err := stg.ReadRecoveryPoints()
+	require.NoError(t, err)
+	require.Equal(t, &varlogpb.LogEntryMeta{LLSN: 1, GLSN: 2}, rp.CommittedLogEntry.First)
+	require.Equal(t, &varlogpb.LogEntryMeta{LLSN: 9, GLSN: 18}, rp.CommittedLogEntry.Last)
+
+	// Trimming deletes the first segment entirely.
+	require.NoError(t, stg.Trim(5))
+	keys, err = store.List(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"ls/00000000000000000006-00000000000000000008.seg",
+		"ls/00000000000000000010-00000000000000000010.seg",
+	}, keys)
+
+	// Trimming the second segment partially.
+	require.NoError(t, stg.Trim(6))
+	keys, err = store.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	_, err = stg.Read(AtLLSN(3))
+	require.ErrorIs(t, err, ErrNoLogEntry)
+	_, err = stg.Read(AtGLSN(6))
+	require.ErrorIs(t, err, ErrNoLogEntry)
+	require.Equal(t, expected[3:], testColdTierScanLLSN(t, stg, types.MinLLSN, types.MaxLLSN))
+
+	rp, err = stg.ReadRecoveryPoints()
+	require.NoError(t, err)
+	require.Equal(t, &varlogpb.LogEntryMeta{LLSN: 4, GLSN: 8}, rp.CommittedLogEntry.First)
+	require.Equal(t, &varlogpb.LogEntryMeta{LLSN: 9, GLSN: 18}, rp.CommittedLogEntry.Last)
+
+	// An unreferenced segment left by a failure is deleted when the storage
+	// is opened.
+	require.NoError(t, store.Put(ctx, "ls/orphan.seg", []byte("orphan")))
+	require.NoError(t, store.Put(ctx, "ls2/other.seg", []byte("other")))
+	require.NoError(t, stg.Close())
+
+	// The catalog of the cold tier is necessary to open the storage.
+	_, err = New(WithPath(path))
+	require.Error(t, err)
+
+	stg = TestNewStorage(t, opts...)
+	defer func() {
+		require.NoError(t, stg.Close())
+	}()
+	keys, err = store.List(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"ls/00000000000000000006-00000000000000000008.seg",
+		"ls/00000000000000000010-00000000000000000010.seg",
+		"ls2/other.seg",
+	}, keys)
+
+	rp, err = stg.ReadRecoveryPoints()
+	require.NoError(t, err)
+	require.Equal(t, &varlogpb.LogEntryMeta{LLSN: 4, GLSN: 8}, rp.CommittedLogEntry.First)
+	require.Equal(t, &varlogpb.LogEntryMeta{LLSN: 9, GLSN: 18}, rp.CommittedLogEntry.Last)
+
+	// All log entries are old.
+	require.NoError(t, stg.offload(base.Add(time.Hour)))
+	require.Empty(t, testColdTierHotGLSNs(t, stg))
+	require.Equal(t, expected[3:], testColdTierScanLLSN(t, stg, types.MinLLSN, types.MaxLLSN))
+
+	rp, err = stg.ReadRecoveryPoints()
+	require.NoError(t, err)
+	require.Equal(t, &varlogpb.LogEntryMeta{LLSN: 4, GLSN: 8}, rp.CommittedLogEntry.First)
+	require.Equal(t, &varlogpb.LogEntryMeta{LLSN: 9, GLSN: 18}, rp.CommittedLogEntry.Last)
+
+	// New log entries are appended to the local disk.
+	testColdTierCommit(t, stg, base, 10, 11)
+	require.Equal(t, []types.GLSN{20}, testColdTierHotGLSNs(t, stg))
+	require.Equal(t, expected[3:], testColdTierScanLLSN(t, stg, types.MinLLSN, 10))
+	le, err = stg.Read(AtLLSN(10))
+	require.NoError(t, err)
+	require.Equal(t, testColdTierLogEntry(10, true), le)
+
+	// Trimming all log entries.
+	require.NoError(t, stg.Trim(types.MaxGLSN))
+	keys, err = store.List(ctx, "ls/")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+	rp, err = stg.ReadRecoveryPoints()
+	require.NoError(t, err)
+	require.Nil(t, rp.CommittedLogEntry.First)
+	require.Nil(t, rp.CommittedLogEntry.Last)
+	require.Empty(t, testColdTierScanGLSN(t, stg, types.MinGLSN, types.MaxGLSN))
+}
+
+func TestStorage_ColdTierMissingSegment(t *testing.T) {
+	ctx := context.Background()
+	store, err := objstore.NewFileSystem(t.TempDir())
+	require.NoError(t, err)
+	stg := TestNewStorage(t,
+		WithColdTier(store),
+		WithColdTierPrefix("ls"),
+		WithColdTierCheckInterval(time.Hour),
+	)
+	defer func() {
+		require.NoError(t, stg.Close())
+	}()
+
+	base := time.Now()
+	testColdTierCommit(t, stg, base, 1, 3)
+	require.NoError(t, stg.offload(base.Add(time.Hour)))
+
+	keys, err := store.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.NoError(t, store.Delete(ctx, keys[0]))
+	stg.tier.cache.remove(keys[0])
+
+	scanner := stg.NewScanner(WithGLSN(types.MinGLSN, types.MaxGLSN))
+	require.True(t, scanner.Valid())
+	_, err = scanner.Value()
+	require.ErrorIs(t, err, objstore.ErrNotExist)
+	require.False(t, scanner.Next())
+	require.False(t, scanner.Valid())
+	require.NoError(t, scanner.Close())
+
+	_, err = stg.Read(AtLLSN(1))
+	require.ErrorIs(t, err, objstore.ErrNotExist)
+}
+
+func TestStorage_ColdTierBackground(t *testing.T) {
+	store, err := objstore.NewFileSystem(t.TempDir())
+	require.NoError(t, err)
+	stg := TestNewStorage(t,
+		WithColdTier(store),
+		WithColdTierPrefix("ls"),
+		WithColdTierAge(time.Nanosecond),
+		WithColdTierCheckInterval(10*time.Millisecond),
+	)
+	defer func() {
+		assert.NoError(t, stg.Close())
+	}()
+
+	testColdTierCommit(t, stg, time.Now().Add(-time.Minute), 1, 4)
+	require.Eventually(t, func() bool {
+		return len(testColdTierHotGLSNs(t, stg)) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	le, err := stg.Read(AtGLSN(4))
+	require.NoError(t, err)
+	require.Equal(t, testColdTierLogEntry(2, true), le)
+}
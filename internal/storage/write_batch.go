@@ -12,6 +12,9 @@ var writeBatchPool = sync.Pool{
 	New: func() interface{} {
 		return &WriteBatch{
 			dk: make([]byte, dataKeyLength),
+			hk: make([]byte, headerKeyLength),
+			sk: make([]byte, checksumKeyLength),
+			sv: make([]byte, checksumValueLength),
 		}
 	},
 }
@@ -21,6 +24,9 @@ type WriteBatch struct {
 	batch     *pebble.Batch
 	writeOpts *pebble.WriteOptions
 	dk        []byte
+	hk        []byte
+	sk        []byte
+	sv        []byte
 }
 
 func newWriteBatch(batch *pebble.Batch, writeOpts *pebble.WriteOptions) *WriteBatch {
@@ -56,9 +62,23 @@ func (wb *WriteBatch) Deferred(poolIdx int) *DeferredWriteBatch {
 //	return nil
 //}
 
-// Set writes the given LLSN and data to the batch.
-func (wb *WriteBatch) Set(llsn types.LLSN, data []byte) error {
-	return wb.batch.Set(encodeDataKeyInternal(llsn, wb.dk), data, nil)
+// Set writes the given LLSN and data to the batch. The argument checksum is
+// the CRC32C checksum of the data, which is verified whenever the data is
+// read.
+func (wb *WriteBatch) Set(llsn types.LLSN, data []byte, checksum uint32) error {
+	if err := wb.batch.Set(encodeDataKeyInternal(llsn, wb.dk), data, nil); err != nil {
+		return err
+	}
+	return wb.batch.Set(encodeChecksumKeyInternal(llsn, wb.sk), encodeChecksumValue(checksum, wb.sv), nil)
+}
+
+// SetHeaders writes the headers of the log entry at the given LLSN to the
+// batch. It does nothing if the headers are empty.
+func (wb *WriteBatch) SetHeaders(llsn types.LLSN, headers map[string][]byte) error {
+	if len(headers) == 0 {
+		return nil
+	}
+	return wb.batch.Set(encodeHeaderKeyInternal(llsn, wb.hk), encodeHeaders(headers), nil)
 }
 
 // SetDeferred writes the given LLSN and data to the batch.
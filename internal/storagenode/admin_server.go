@@ -1,13 +1,20 @@
 package storagenode
 
 import (
+	"bufio"
 	"context"
+	"errors"
+	"io"
+	"path/filepath"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/kakao/varlog/internal/storage"
 	snerrors "github.com/kakao/varlog/internal/storagenode/errors"
+	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
@@ -143,3 +150,139 @@ func (as *adminServer) Trim(ctx context.Context, req *snpb.TrimRequest) (*snpb.T
 	results := as.sn.trim(ctx, req.TopicID, req.LastGLSN)
 	return &snpb.TrimResponse{Results: results}, nil
 }
+
+func (as *adminServer) Checkpoint(ctx context.Context, req *snpb.CheckpointRequest) (*snpb.CheckpointResponse, error) {
+	path, err := as.sn.checkpoint(ctx, req.TopicID, req.LogStreamID, req.Path, req.ColdTierPrefix, req.CommitResult)
+	if err != nil {
+		var code codes.Code
+		switch {
+		case errors.Is(err, snerrors.ErrClosed), errors.Is(err, verrors.ErrClosed):
+			code = codes.Unavailable
+		case errors.Is(err, snerrors.ErrNotExist):
+			code = codes.NotFound
+		case errors.Is(err, snerrors.ErrExist):
+			code = codes.AlreadyExists
+		case errors.Is(err, verrors.ErrSealed):
+			code = codes.FailedPrecondition
+		default:
+			code = status.FromContextError(err).Code()
+		}
+		return nil, status.Error(code, err.Error())
+	}
+	return &snpb.CheckpointResponse{Path: path}, nil
+}
+
+func (as *adminServer) CheckBackupPath(_ context.Context, req *snpb.CheckBackupPathRequest) (*snpb.CheckBackupPathResponse, error) {
+	if !filepath.IsAbs(req.Path) {
+		return nil, status.Errorf(codes.InvalidArgument, "backup path %s not absolute", req.Path)
+	}
+	if err := as.sn.checkBackupPath(req.Path, req.Token); err != nil {
+		code := codes.FailedPrecondition
+		if errors.Is(err, snerrors.ErrClosed) {
+			code = codes.Unavailable
+		}
+		return nil, status.Error(code, err.Error())
+	}
+	return &snpb.CheckBackupPathResponse{}, nil
+}
+
+// exportChunkSize is the maximum size of data in a response of Export.
+const exportChunkSize = 1 << 20
+
+func (as *adminServer) Export(req *snpb.ExportRequest, stream snpb.Management_ExportServer) error {
+	w := bufio.NewWriterSize(exportWriter{stream: stream}, exportChunkSize)
+	err := as.sn.export(req.TopicID, req.LogStreamID, w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		var code codes.Code
+		switch {
+		case errors.Is(err, snerrors.ErrClosed):
+			code = codes.Unavailable
+		case errors.Is(err, snerrors.ErrNotExist):
+			code = codes.NotFound
+		case errors.Is(err, storage.ErrNoCommitContext):
+			code = codes.FailedPrecondition
+		default:
+			code = status.FromContextError(err).Code()
+		}
+		return status.Error(code, err.Error())
+	}
+	return nil
+}
+
+// exportWriter sends data written to it as responses of Export.
+type exportWriter struct {
+	stream snpb.Management_ExportServer
+}
+
+func (w exportWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&snpb.ExportResponse{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (as *adminServer) Import(stream snpb.Management_ImportServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	snPath := req.StorageNodePath
+	if snPath == "" {
+		snPath = as.sn.snPaths[0]
+	}
+	if !slices.Contains(as.sn.snPaths, snPath) {
+		return status.Errorf(codes.InvalidArgument, "no storage node path %s", snPath)
+	}
+
+	r := &importReader{stream: stream, data: req.Data}
+	lsrmd, err := as.sn.importLogStreamReplica(stream.Context(), req.TopicID, req.LogStreamID, snPath, r)
+	if err != nil {
+		var code codes.Code
+		switch {
+		case errors.Is(err, snerrors.ErrClosed):
+			code = codes.Unavailable
+		case errors.Is(err, snerrors.ErrExist):
+			code = codes.AlreadyExists
+		case errors.Is(err, snerrors.ErrTooManyReplicas):
+			code = codes.ResourceExhausted
+		case errors.Is(err, storage.ErrInvalidArchive), errors.Is(err, io.ErrUnexpectedEOF):
+			code = codes.InvalidArgument
+		default:
+			code = status.FromContextError(err).Code()
+		}
+		if r.err != nil {
+			code = status.Code(r.err)
+		}
+		return status.Error(code, err.Error())
+	}
+	return stream.SendAndClose(&snpb.ImportResponse{LogStreamReplica: lsrmd})
+}
+
+// importReader reads data in requests of Import.
+type importReader struct {
+	stream snpb.Management_ImportServer
+	data   []byte
+	err    error
+}
+
+func (r *importReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		req, err := r.stream.Recv()
+		if err != nil {
+			if err != io.EOF {
+				r.err = err
+			}
+			return 0, err
+		}
+		r.data = req.Data
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/kakao/varlog/pkg/rpc"
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/util/telemetry"
 	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
@@ -14,7 +17,13 @@ import (
 
 type SubscribeResult struct {
 	varlogpb.LogEntry
-	Error error
+	// Filtered is true if the result reports log entries that do not match
+	// the filter of Subscribe. FilteredRanges are ranges of their GLSNs in
+	// ascending order, and only the GLSN, which is the beginning of the
+	// first range, is valid in that case.
+	Filtered       bool
+	FilteredRanges []snpb.SubscribeResponse_GLSNRange
+	Error          error
 }
 
 var InvalidSubscribeResult = SubscribeResult{
@@ -22,6 +31,13 @@ var InvalidSubscribeResult = SubscribeResult{
 	Error:    errors.New("invalid subscribe result"),
 }
 
+// ReadBatchResult is a log entry read by ReadBatch. If Error is not nil, only
+// the GLSN of the log entry is valid.
+type ReadBatchResult struct {
+	varlogpb.LogEntry
+	Error error
+}
+
 type LogClient struct {
 	rpcClient snpb.LogIOClient
 	target    varlogpb.StorageNode
@@ -39,15 +55,40 @@ func (c *LogClient) reset(rpcConn *rpc.Conn, _ types.ClusterID, target varlogpb.
 }
 
 // Append stores data to the log stream specified with the topicID and the logStreamID.
+// The headers are headers of each log entry in the data; they can be nil if no
+// log entry has headers. Otherwise, their length should be the same as that of
+// the data.
 // The backup indicates the storage nodes that have backup replicas of that log stream.
 // It returns valid GLSN if the append completes successfully.
-func (c *LogClient) Append(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data [][]byte, backups ...varlogpb.StorageNode) ([]snpb.AppendResult, error) {
-	req := &snpb.AppendRequest{
+func (c *LogClient) Append(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data [][]byte, headers []varlogpb.LogEntryHeaders, backups ...varlogpb.StorageNode) ([]snpb.AppendResult, error) {
+	return c.append(ctx, &snpb.AppendRequest{
 		TopicID:     tpid,
 		LogStreamID: lsid,
 		Payload:     data,
 		Backups:     backups,
-	}
+		Headers:     headers,
+	})
+}
+
+// AppendWithProducer is similar to Append except that it appends data on
+// behalf of the idempotent producer. The sequence is the sequence number of
+// the first log entry in the data. If the log stream has already appended
+// them, it returns their original metadata rather than appending them again.
+func (c *LogClient) AppendWithProducer(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, producerID string, sequence uint64, data [][]byte, headers []varlogpb.LogEntryHeaders, backups ...varlogpb.StorageNode) ([]snpb.AppendResult, error) {
+	return c.append(ctx, &snpb.AppendRequest{
+		TopicID:     tpid,
+		LogStreamID: lsid,
+		Payload:     data,
+		Backups:     backups,
+		Headers:     headers,
+		ProducerID:  producerID,
+		Sequence:    sequence,
+	})
+}
+
+func (c *LogClient) append(ctx context.Context, req *snpb.AppendRequest) ([]snpb.AppendResult, error) {
+	req.TraceContext = telemetry.InjectTraceContext(ctx)
+	req.Checksums = checksums(req.Payload)
 	rsp, err := c.rpcClient.Append(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
@@ -55,9 +96,158 @@ func (c *LogClient) Append(ctx context.Context, tpid types.TopicID, lsid types.L
 	return rsp.Results, nil
 }
 
+// checksums returns checksums of the data so that storage nodes can detect
+// data corrupted on the wire.
+func checksums(data [][]byte) []uint32 {
+	ret := make([]uint32, len(data))
+	for i := range data {
+		ret[i] = varlogpb.Checksum(data[i])
+	}
+	return ret
+}
+
+// AppendStream opens a stream to append log entries to the log stream
+// specified with the topicID and the logStreamID. Unlike Append, callers can
+// send data through the stream without waiting for the results of previous
+// ones. The stream is closed when the ctx is canceled.
+func (c *LogClient) AppendStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, backups ...varlogpb.StorageNode) (*AppendStream, error) {
+	stream, err := c.rpcClient.AppendStream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
+	}
+	return &AppendStream{
+		stream: stream,
+		req: snpb.AppendRequest{
+			TopicID:     tpid,
+			LogStreamID: lsid,
+			Backups:     backups,
+		},
+	}, nil
+}
+
+// AppendStream is a stream opened by LogClient.AppendStream. A goroutine can
+// call Send while another goroutine calls Recv.
+type AppendStream struct {
+	stream snpb.LogIO_AppendStreamClient
+	req    snpb.AppendRequest
+	rsp    snpb.AppendResponse
+}
+
+// Send sends data and its headers to the log stream. The headers can be nil if
+// no log entry has headers.
+func (s *AppendStream) Send(data [][]byte, headers []varlogpb.LogEntryHeaders) error {
+	s.req.Payload = data
+	s.req.Headers = headers
+	s.req.Checksums = checksums(data)
+	err := s.stream.SendMsg(&s.req)
+	s.req.Payload = nil
+	s.req.Headers = nil
+	s.req.Checksums = nil
+	if err != nil {
+		return fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
+	}
+	return nil
+}
+
+// Recv receives results of data in the order of Send. Each result has an error
+// message if the log entry failed to be appended.
+func (s *AppendStream) Recv() ([]snpb.AppendResult, error) {
+	s.rsp.Reset()
+	if err := s.stream.RecvMsg(&s.rsp); err != nil {
+		return nil, fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
+	}
+	return s.rsp.Results, nil
+}
+
+// CloseSend tells the storage node that no more data will be sent. Callers
+// can still receive results of data sent already.
+func (s *AppendStream) CloseSend() error {
+	return s.stream.CloseSend()
+}
+
+// ReadWithGLSN reads the committed log entry at the GLSN from the log stream
+// replica specified with the topicID and the logStreamID.
+// It returns an error wrapping verrors.ErrNoEntry if the log stream replica
+// does not have the log entry, and verrors.ErrTrimmed if the log entry was
+// already trimmed.
+func (c *LogClient) ReadWithGLSN(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, glsn types.GLSN) (varlogpb.LogEntry, error) {
+	return c.read(ctx, &snpb.ReadRequest{
+		TopicID:     tpid,
+		LogStreamID: lsid,
+		GLSN:        glsn,
+	})
+}
+
+// ReadWithLLSN is similar to ReadWithGLSN except that it specifies the
+// position of the log entry with LLSN.
+func (c *LogClient) ReadWithLLSN(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, llsn types.LLSN) (varlogpb.LogEntry, error) {
+	return c.read(ctx, &snpb.ReadRequest{
+		TopicID:     tpid,
+		LogStreamID: lsid,
+		LLSN:        llsn,
+	})
+}
+
+func (c *LogClient) read(ctx context.Context, req *snpb.ReadRequest) (varlogpb.LogEntry, error) {
+	rsp, err := c.rpcClient.Read(ctx, req)
+	if err != nil {
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
+	}
+	return varlogpb.LogEntry{
+		LogEntryMeta: varlogpb.LogEntryMeta{
+			TopicID:     req.TopicID,
+			LogStreamID: req.LogStreamID,
+			GLSN:        rsp.GLSN,
+			LLSN:        rsp.LLSN,
+		},
+		Data:    rsp.Payload,
+		Headers: rsp.Headers,
+	}, nil
+}
+
+// ReadBatch reads the committed log entries at the GLSNs from the log stream
+// replica specified with the topicID and the logStreamID. It returns results
+// in ascending order of GLSN only for the log entries that the log stream
+// replica has. The result for an already trimmed log entry has an error
+// wrapping verrors.ErrTrimmed.
+func (c *LogClient) ReadBatch(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, glsns []types.GLSN) ([]ReadBatchResult, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.rpcClient.ReadBatch(ctx, &snpb.ReadBatchRequest{
+		TopicID:     tpid,
+		LogStreamID: lsid,
+		GLSNs:       glsns,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
+	}
+
+	var results []ReadBatchResult
+	for {
+		rsp, err := stream.Recv()
+		if err == io.EOF {
+			return results, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
+		}
+		result := ReadBatchResult{LogEntry: rsp.LogEntry}
+		if rsp.Trimmed {
+			result.Error = fmt.Errorf("logclient: glsn %d: %w", rsp.LogEntry.GLSN, verrors.ErrTrimmed)
+		}
+		result.TopicID = tpid
+		result.LogStreamID = lsid
+		results = append(results, result)
+	}
+}
+
 // Subscribe gets log entries continuously from the storage node. It guarantees that LLSNs of log
 // entries taken are sequential.
-func (c *LogClient) Subscribe(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, begin, end types.GLSN) (<-chan SubscribeResult, error) {
+// If the argument filter is not nil, log entries not matched by the filter
+// are received as results whose Filtered fields are true. A result can report
+// many of them.
+func (c *LogClient) Subscribe(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, begin, end types.GLSN, filter *snpb.SubscribeFilter) (<-chan SubscribeResult, error) {
 	if begin >= end {
 		return nil, errors.New("logclient: invalid argument")
 	}
@@ -67,6 +257,7 @@ func (c *LogClient) Subscribe(ctx context.Context, tpid types.TopicID, lsid type
 		LogStreamID: lsid,
 		GLSNBegin:   begin,
 		GLSNEnd:     end,
+		Filter:      filter,
 	}
 	stream, err := c.rpcClient.Subscribe(ctx, req)
 	if err != nil {
@@ -88,8 +279,11 @@ func (c *LogClient) Subscribe(ctx context.Context, tpid types.TopicID, lsid type
 						GLSN: rsp.GetGLSN(),
 						LLSN: rsp.GetLLSN(),
 					},
-					Data: rsp.GetPayload(),
+					Data:    rsp.GetPayload(),
+					Headers: rsp.GetHeaders(),
 				}
+				result.Filtered = rsp.GetFiltered()
+				result.FilteredRanges = rsp.GetFilteredRanges()
 			}
 			select {
 			case out <- result:
@@ -104,7 +298,10 @@ func (c *LogClient) Subscribe(ctx context.Context, tpid types.TopicID, lsid type
 	return out, nil
 }
 
-func (c *LogClient) SubscribeTo(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, begin, end types.LLSN) (<-chan SubscribeResult, error) {
+// SubscribeTo gets log entries of the log stream in the range of LLSNs
+// continuously from the storage node. If the argument filter is not nil, log
+// entries not matched by the filter are not received.
+func (c *LogClient) SubscribeTo(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, begin, end types.LLSN, filter *snpb.SubscribeFilter) (<-chan SubscribeResult, error) {
 	if begin >= end {
 		return nil, errors.New("logclient: invalid argument")
 	}
@@ -114,6 +311,7 @@ func (c *LogClient) SubscribeTo(ctx context.Context, tpid types.TopicID, lsid ty
 		LogStreamID: lsid,
 		LLSNBegin:   begin,
 		LLSNEnd:     end,
+		Filter:      filter,
 	}
 	stream, err := c.rpcClient.SubscribeTo(ctx, req)
 	if err != nil {
@@ -168,6 +366,23 @@ func (c *LogClient) LogStreamReplicaMetadata(ctx context.Context, tpid types.Top
 	return rsp.LogStreamReplica, nil
 }
 
+// SeekTime returns the GLSN of the first log entry committed at or after the
+// argument t in the log stream replica. The second return value is false if
+// the log stream replica has no such log entry; in that case, the returned
+// GLSN is the one following the last committed log entry known to the
+// replica.
+func (c *LogClient) SeekTime(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, t time.Time) (types.GLSN, bool, error) {
+	rsp, err := c.rpcClient.SeekTime(ctx, &snpb.SeekTimeRequest{
+		TopicID:     tpid,
+		LogStreamID: lsid,
+		Time:        t,
+	})
+	if err != nil {
+		return types.InvalidGLSN, false, fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
+	}
+	return rsp.GLSN, rsp.Found, nil
+}
+
 // Target returns connected storage node.
 func (c *LogClient) Target() varlogpb.StorageNode {
 	return c.target
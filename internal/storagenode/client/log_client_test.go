@@ -90,6 +90,41 @@ func newMockStorageNodeServiceClient(ctrl *gomock.Controller, sn *storageNode, t
 		}, nil
 	}).AnyTimes()
 
+	// ReadBatch
+	mockClient.EXPECT().ReadBatch(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *snpb.ReadBatchRequest, opts ...grpc.CallOption) (snpb.LogIO_ReadBatchClient, error) {
+		glsns := req.GetGLSNs()
+		stream := mock.NewMockLogIO_ReadBatchClient(ctrl)
+		stream.EXPECT().Recv().DoAndReturn(
+			func() (*snpb.ReadBatchResponse, error) {
+				sn.mu.Lock()
+				defer sn.mu.Unlock()
+				for len(glsns) > 0 {
+					glsn := glsns[0]
+					glsns = glsns[1:]
+					data, ok := sn.logEntries[glsn]
+					if !ok {
+						continue
+					}
+					return &snpb.ReadBatchResponse{
+						LogEntry: varlogpb.LogEntry{
+							LogEntryMeta: varlogpb.LogEntryMeta{
+								GLSN: glsn,
+								LLSN: sn.glsnToLLSN[glsn],
+							},
+							Data: data,
+						},
+					}, nil
+				}
+				return nil, io.EOF
+			},
+		).AnyTimes()
+		return stream, nil
+	}).AnyTimes()
+
 	// Subscribe
 	mockClient.EXPECT().Subscribe(
 		gomock.Any(),
@@ -166,18 +201,33 @@ func TestBasicOperations(t *testing.T) {
 		var msg string
 
 		msg = "msg-1"
-		res, err = client.Append(context.TODO(), topicID, logStreamID, [][]byte{[]byte(msg)})
+		res, err = client.Append(context.TODO(), topicID, logStreamID, [][]byte{[]byte(msg)}, nil)
 		currGLSN = res[0].Meta.GLSN
 		So(err, ShouldBeNil)
 		prevGLSN = currGLSN
 
 		msg = "msg-2"
-		res, err = client.Append(context.TODO(), topicID, logStreamID, [][]byte{[]byte(msg)})
+		res, err = client.Append(context.TODO(), topicID, logStreamID, [][]byte{[]byte(msg)}, nil)
 		currGLSN = res[0].Meta.GLSN
 		So(err, ShouldBeNil)
 		So(currGLSN, ShouldBeGreaterThan, prevGLSN)
 
-		ch, err := client.Subscribe(context.TODO(), topicID, logStreamID, types.GLSN(0), types.GLSN(10))
+		logEntry, err := client.ReadWithGLSN(context.TODO(), topicID, logStreamID, currGLSN)
+		So(err, ShouldBeNil)
+		So(logEntry.GLSN, ShouldEqual, currGLSN)
+		So(string(logEntry.Data), ShouldEqual, "msg-2")
+
+		results, err := client.ReadBatch(context.TODO(), topicID, logStreamID, []types.GLSN{prevGLSN, currGLSN + 1, currGLSN})
+		So(err, ShouldBeNil)
+		So(results, ShouldHaveLength, 2)
+		So(results[0].Error, ShouldBeNil)
+		So(results[0].GLSN, ShouldEqual, prevGLSN)
+		So(string(results[0].Data), ShouldEqual, "msg-1")
+		So(results[1].Error, ShouldBeNil)
+		So(results[1].GLSN, ShouldEqual, currGLSN)
+		So(string(results[1].Data), ShouldEqual, "msg-2")
+
+		ch, err := client.Subscribe(context.TODO(), topicID, logStreamID, types.GLSN(0), types.GLSN(10), nil)
 		So(err, ShouldBeNil)
 		subRes := <-ch
 		So(subRes.Error, ShouldBeNil)
@@ -4,6 +4,7 @@ package client
 
 import (
 	"context"
+	"io"
 
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -26,6 +27,10 @@ type StorageNodeManagementClient interface {
 	Unseal(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, replicas []varlogpb.LogStreamReplica) error
 	Sync(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, backupStorageNodeID types.StorageNodeID, backupAddress string, lastGLSN types.GLSN) (*snpb.SyncStatus, error)
 	Trim(ctx context.Context, topicID types.TopicID, lastGLSN types.GLSN) (map[types.LogStreamID]error, error)
+	Checkpoint(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, path, coldTierPrefix string, commitResult snpb.LogStreamCommitResult) (string, error)
+	CheckBackupPath(ctx context.Context, path, token string) error
+	Export(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, w io.Writer) error
+	Import(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, snPath string, r io.Reader) (snpb.LogStreamReplicaMetadataDescriptor, error)
 	Close() error
 }
 
@@ -165,6 +170,111 @@ func (c *ManagementClient) Trim(ctx context.Context, topicID types.TopicID, last
 	return ret, errors.WithStack(verrors.FromStatusError(err))
 }
 
+// Checkpoint copies the log stream replica into the directory path at the
+// commit result. Segments in the cold tier are copied under the prefix
+// coldTierPrefix. It returns the absolute path of the copy.
+func (c *ManagementClient) Checkpoint(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, path, coldTierPrefix string, commitResult snpb.LogStreamCommitResult) (string, error) {
+	rsp, err := c.rpcClient.Checkpoint(ctx, &snpb.CheckpointRequest{
+		ClusterID:      c.cid,
+		StorageNodeID:  c.target.StorageNodeID,
+		TopicID:        topicID,
+		LogStreamID:    logStreamID,
+		Path:           path,
+		CommitResult:   commitResult,
+		ColdTierPrefix: coldTierPrefix,
+	})
+	if err != nil {
+		return "", errors.WithStack(verrors.FromStatusError(err))
+	}
+	return rsp.Path, nil
+}
+
+// CheckBackupPath checks whether the storage node can access the backup
+// directory path having the probe file with the token.
+func (c *ManagementClient) CheckBackupPath(ctx context.Context, path, token string) error {
+	_, err := c.rpcClient.CheckBackupPath(ctx, &snpb.CheckBackupPathRequest{
+		ClusterID:     c.cid,
+		StorageNodeID: c.target.StorageNodeID,
+		Path:          path,
+		Token:         token,
+	})
+	return errors.WithStack(verrors.FromStatusError(err))
+}
+
+// Export writes the archive of the log stream replica into the writer w. See
+// storage.Storage.Export for the archive.
+func (c *ManagementClient) Export(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, w io.Writer) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.rpcClient.Export(ctx, &snpb.ExportRequest{
+		ClusterID:     c.cid,
+		StorageNodeID: c.target.StorageNodeID,
+		TopicID:       topicID,
+		LogStreamID:   logStreamID,
+	})
+	if err != nil {
+		return errors.WithStack(verrors.FromStatusError(err))
+	}
+	for {
+		rsp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.WithStack(verrors.FromStatusError(err))
+		}
+		if _, err := w.Write(rsp.Data); err != nil {
+			return err
+		}
+	}
+}
+
+// importChunkSize is the maximum size of data in a request of Import.
+const importChunkSize = 1 << 20
+
+// Import creates the log stream replica in the storage node path snPath from
+// the archive read from r and adds it to the storage node. If the argument
+// snPath is empty, the storage node uses its first storage node path.
+func (c *ManagementClient) Import(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, snPath string, r io.Reader) (snpb.LogStreamReplicaMetadataDescriptor, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.rpcClient.Import(ctx)
+	if err != nil {
+		return snpb.LogStreamReplicaMetadataDescriptor{}, errors.WithStack(verrors.FromStatusError(err))
+	}
+	req := &snpb.ImportRequest{
+		ClusterID:       c.cid,
+		StorageNodeID:   c.target.StorageNodeID,
+		TopicID:         topicID,
+		LogStreamID:     logStreamID,
+		StorageNodePath: snPath,
+	}
+	buf := make([]byte, importChunkSize)
+	for {
+		n, rerr := io.ReadFull(r, buf)
+		if rerr != nil && rerr != io.EOF && rerr != io.ErrUnexpectedEOF {
+			return snpb.LogStreamReplicaMetadataDescriptor{}, rerr
+		}
+		req.Data = buf[:n]
+		// Send returns io.EOF if the storage node has already failed the
+		// stream; CloseAndRecv then returns the error.
+		if err := stream.Send(req); err != nil {
+			break
+		}
+		if rerr != nil {
+			break
+		}
+		req = &snpb.ImportRequest{}
+	}
+	rsp, err := stream.CloseAndRecv()
+	if err != nil {
+		return snpb.LogStreamReplicaMetadataDescriptor{}, errors.WithStack(verrors.FromStatusError(err))
+	}
+	return rsp.LogStreamReplica, nil
+}
+
 // Close closes connection to the storage node.
 // Deprecated: Use `Manager[*ManagementClient]`.
 func (c *ManagementClient) Close() error {
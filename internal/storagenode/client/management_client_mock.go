@@ -6,6 +6,7 @@ package client
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -53,6 +54,35 @@ func (mr *MockStorageNodeManagementClientMockRecorder) AddLogStreamReplica(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLogStreamReplica", reflect.TypeOf((*MockStorageNodeManagementClient)(nil).AddLogStreamReplica), arg0, arg1, arg2, arg3)
 }
 
+// CheckBackupPath mocks base method.
+func (m *MockStorageNodeManagementClient) CheckBackupPath(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckBackupPath", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckBackupPath indicates an expected call of CheckBackupPath.
+func (mr *MockStorageNodeManagementClientMockRecorder) CheckBackupPath(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBackupPath", reflect.TypeOf((*MockStorageNodeManagementClient)(nil).CheckBackupPath), arg0, arg1, arg2)
+}
+
+// Checkpoint mocks base method.
+func (m *MockStorageNodeManagementClient) Checkpoint(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3, arg4 string, arg5 snpb.LogStreamCommitResult) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkpoint", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkpoint indicates an expected call of Checkpoint.
+func (mr *MockStorageNodeManagementClientMockRecorder) Checkpoint(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkpoint", reflect.TypeOf((*MockStorageNodeManagementClient)(nil).Checkpoint), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // Close mocks base method.
 func (m *MockStorageNodeManagementClient) Close() error {
 	m.ctrl.T.Helper()
@@ -67,6 +97,20 @@ func (mr *MockStorageNodeManagementClientMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStorageNodeManagementClient)(nil).Close))
 }
 
+// Export mocks base method.
+func (m *MockStorageNodeManagementClient) Export(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockStorageNodeManagementClientMockRecorder) Export(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockStorageNodeManagementClient)(nil).Export), arg0, arg1, arg2, arg3)
+}
+
 // GetMetadata mocks base method.
 func (m *MockStorageNodeManagementClient) GetMetadata(arg0 context.Context) (*snpb.StorageNodeMetadataDescriptor, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +126,21 @@ func (mr *MockStorageNodeManagementClientMockRecorder) GetMetadata(arg0 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockStorageNodeManagementClient)(nil).GetMetadata), arg0)
 }
 
+// Import mocks base method.
+func (m *MockStorageNodeManagementClient) Import(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3 string, arg4 io.Reader) (snpb.LogStreamReplicaMetadataDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(snpb.LogStreamReplicaMetadataDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockStorageNodeManagementClientMockRecorder) Import(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockStorageNodeManagementClient)(nil).Import), arg0, arg1, arg2, arg3, arg4)
+}
+
 // RemoveLogStream mocks base method.
 func (m *MockStorageNodeManagementClient) RemoveLogStream(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID) error {
 	m.ctrl.T.Helper()
@@ -29,6 +29,7 @@ type config struct {
 	snid                            types.StorageNodeID
 	listen                          string
 	advertise                       string
+	tags                            map[string]string
 	ballastSize                     int64
 	grpcServerReadBufferSize        int64
 	grpcServerWriteBufferSize       int64
@@ -40,6 +41,7 @@ type config struct {
 	defaultLogStreamExecutorOptions []logstream.ExecutorOption
 	pprofOpts                       []pprof.Option
 	defaultStorageOptions           []storage.Option
+	restoreFrom                     string
 	logger                          *zap.Logger
 }
 
@@ -144,6 +146,14 @@ func WithAdvertiseAddress(advertise string) Option {
 	})
 }
 
+// WithTags sets the tags of the storage node, for instance, the zone where the
+// storage node runs. Clients can choose log streams by the tags.
+func WithTags(tags map[string]string) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.tags = tags
+	})
+}
+
 func WithBallastSize(ballastSize int64) Option {
 	return newFuncOption(func(cfg *config) {
 		cfg.ballastSize = ballastSize
@@ -210,6 +220,15 @@ func WithDefaultStorageOptions(defaultStorageOpts ...storage.Option) Option {
 	})
 }
 
+// WithRestoreFrom sets the backup directory, from which the storage node
+// restores its log stream replicas that do not exist in its volumes before
+// loading them.
+func WithRestoreFrom(dir string) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.restoreFrom = dir
+	})
+}
+
 func WithLogger(logger *zap.Logger) Option {
 	return newFuncOption(func(cfg *config) {
 		cfg.logger = logger
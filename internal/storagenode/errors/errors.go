@@ -7,4 +7,5 @@ var (
 	ErrClosed          = stderrors.New("closed")
 	ErrTooManyReplicas = stderrors.New("too many log stream replicas")
 	ErrNotExist        = stderrors.New("not exist")
+	ErrExist           = stderrors.New("already exist")
 )
@@ -3,17 +3,25 @@ package storagenode
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	snerrors "github.com/kakao/varlog/internal/storagenode/errors"
 	"github.com/kakao/varlog/internal/storagenode/logstream"
+	"github.com/kakao/varlog/internal/storagenode/telemetry"
 	"github.com/kakao/varlog/pkg/types"
+	vtelemetry "github.com/kakao/varlog/pkg/util/telemetry"
 	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 type logServer struct {
@@ -30,18 +38,54 @@ func (ls logServer) Append(ctx context.Context, req *snpb.AppendRequest) (*snpb.
 
 	payload := req.GetPayload()
 	req.Payload = nil
+	if len(req.Headers) > 0 && len(req.Headers) != len(payload) {
+		return nil, status.Error(codes.InvalidArgument, "the number of headers does not match that of payload")
+	}
+	if len(req.Checksums) > 0 {
+		if _, err := logstream.VerifyChecksums(payload, req.Checksums); err != nil {
+			return nil, verrors.ToStatusErrorWithCode(err, checksumErrorCode(err))
+		}
+	}
 	lse, loaded := ls.sn.executors.Load(req.TopicID, req.LogStreamID)
 	if !loaded {
 		return nil, status.Error(codes.NotFound, "no such log stream")
 	}
 
-	res, err := lse.Append(ctx, payload)
+	ctx = vtelemetry.ExtractTraceContext(ctx, req.TraceContext)
+	ctx, span := telemetry.Tracer().Start(ctx, "snpb.LogIO/Append",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.Int("tpid", int(req.TopicID)),
+			attribute.Int("lsid", int(req.LogStreamID)),
+			attribute.Int("batch", len(payload)),
+		),
+	)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	var res []snpb.AppendResult
+	if req.ProducerID != "" {
+		res, err = lse.AppendWithProducer(ctx, req.ProducerID, req.Sequence, payload, req.Headers)
+	} else {
+		res, err = lse.Append(ctx, payload, req.Headers)
+	}
 	if err != nil {
 		var code codes.Code
-		switch err {
-		case verrors.ErrSealed:
+		switch {
+		case errors.Is(err, verrors.ErrDuplicateSequence):
+			return nil, verrors.ToStatusErrorWithCode(err, codes.AlreadyExists)
+		case errors.Is(err, verrors.ErrTxnAborted):
+			return nil, verrors.ToStatusErrorWithCode(err, codes.Aborted)
+		case errors.Is(err, verrors.ErrInvalid):
+			code = codes.InvalidArgument
+		case err == verrors.ErrSealed:
 			code = codes.FailedPrecondition
-		case snerrors.ErrNotPrimary:
+		case err == snerrors.ErrNotPrimary:
 			code = codes.Unavailable
 		default:
 			code = status.FromContextError(err).Code()
@@ -51,10 +95,211 @@ func (ls logServer) Append(ctx context.Context, req *snpb.AppendRequest) (*snpb.
 	return &snpb.AppendResponse{Results: res}, nil
 }
 
-func (ls logServer) Read(context.Context, *snpb.ReadRequest) (*snpb.ReadResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "deprecated")
+// appendStreamPipelineSize is the maximum number of AppendRequests in a stream
+// whose responses are not sent yet.
+const appendStreamPipelineSize = 64
+
+type appendStreamTask struct {
+	at         *logstream.AppendTask
+	payloadLen int
+	err        error
+}
+
+func (ls logServer) AppendStream(stream snpb.LogIO_AppendStreamServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	taskC := make(chan appendStreamTask, appendStreamPipelineSize)
+	errC := make(chan error, 1)
+	go func() {
+		errC <- ls.appendStreamSend(ctx, cancel, stream, taskC)
+	}()
+
+	var err error
+	req := &snpb.AppendRequest{}
+	for {
+		req.Reset()
+		err = stream.RecvMsg(req)
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+			break
+		}
+		task := ls.appendStreamRecv(ctx, req)
+		select {
+		case taskC <- task:
+			continue
+		case <-ctx.Done():
+			if task.at != nil {
+				_, _ = task.at.WaitForCompletion(ctx)
+			}
+		}
+		break
+	}
+	close(taskC)
+	return multierr.Append(err, <-errC)
+}
+
+func (ls logServer) appendStreamRecv(ctx context.Context, req *snpb.AppendRequest) appendStreamTask {
+	task := appendStreamTask{payloadLen: len(req.Payload)}
+	if task.err = snpb.ValidateTopicLogStream(req); task.err != nil {
+		return task
+	}
+	if len(req.ProducerID) > 0 {
+		task.err = fmt.Errorf("append stream: idempotent producer not allowed: %w", verrors.ErrInvalid)
+		return task
+	}
+	if len(req.Headers) > 0 && len(req.Headers) != len(req.Payload) {
+		task.err = fmt.Errorf("append stream: the number of headers does not match that of payload: %w", verrors.ErrInvalid)
+		return task
+	}
+	if len(req.Checksums) > 0 {
+		if _, task.err = logstream.VerifyChecksums(req.Payload, req.Checksums); task.err != nil {
+			task.err = fmt.Errorf("append stream: %w", task.err)
+			return task
+		}
+	}
+	lse, loaded := ls.sn.executors.Load(req.TopicID, req.LogStreamID)
+	if !loaded {
+		task.err = fmt.Errorf("append stream: no such log stream %d", req.LogStreamID)
+		return task
+	}
+	task.at, task.err = lse.AppendAsync(ctx, req.Payload, req.Headers)
+	return task
+}
+
+// appendStreamSend sends responses in the order of tasks. If it fails to send
+// a response, it cancels the stream and waits for the remaining tasks.
+func (ls logServer) appendStreamSend(ctx context.Context, cancel context.CancelFunc, stream snpb.LogIO_AppendStreamServer, taskC <-chan appendStreamTask) error {
+	var err error
+	rsp := &snpb.AppendResponse{}
+	for task := range taskC {
+		var res []snpb.AppendResult
+		werr := task.err
+		if task.at != nil {
+			res, werr = task.at.WaitForCompletion(ctx)
+		}
+		if err != nil {
+			continue
+		}
+		if werr != nil {
+			if len(res) != task.payloadLen {
+				res = make([]snpb.AppendResult, task.payloadLen)
+			}
+			for i := range res {
+				if res[i].Meta.GLSN.Invalid() {
+					res[i].Error = werr.Error()
+				}
+			}
+		}
+		rsp.Results = res
+		if err = stream.SendMsg(rsp); err != nil {
+			cancel()
+		}
+	}
+	return err
+}
+
+func (ls logServer) Read(_ context.Context, req *snpb.ReadRequest) (*snpb.ReadResponse, error) {
+	if err := snpb.ValidateTopicLogStream(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.GLSN.Invalid() == req.LLSN.Invalid() {
+		return nil, status.Error(codes.InvalidArgument, "either glsn or llsn should be set")
+	}
+
+	lse, loaded := ls.sn.executors.Load(req.TopicID, req.LogStreamID)
+	if !loaded {
+		return nil, status.Error(codes.NotFound, "no such log stream")
+	}
+
+	var (
+		le  varlogpb.LogEntry
+		err error
+	)
+	if !req.GLSN.Invalid() {
+		le, err = lse.ReadWithGLSN(req.GLSN)
+	} else {
+		le, err = lse.ReadWithLLSN(req.LLSN)
+	}
+	if err != nil {
+		var code codes.Code
+		if errors.Is(err, verrors.ErrClosed) {
+			code = codes.Unavailable
+		} else if errors.Is(err, verrors.ErrInvalid) {
+			code = codes.InvalidArgument
+		} else if errors.Is(err, verrors.ErrTrimmed) {
+			code = codes.OutOfRange
+		} else if errors.Is(err, verrors.ErrNoEntry) {
+			code = codes.NotFound
+		} else if errors.Is(err, verrors.ErrChecksumMismatch) {
+			code = codes.DataLoss
+		} else {
+			code = status.FromContextError(err).Code()
+		}
+		return nil, verrors.ToStatusErrorWithCode(err, code)
+	}
+	return &snpb.ReadResponse{
+		GLSN:    le.GLSN,
+		LLSN:    le.LLSN,
+		Payload: le.Data,
+		Headers: le.Headers,
+	}, nil
+}
+
+func (ls logServer) ReadBatch(req *snpb.ReadBatchRequest, stream snpb.LogIO_ReadBatchServer) error {
+	if err := snpb.ValidateTopicLogStream(req); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lse, loaded := ls.sn.executors.Load(req.TopicID, req.LogStreamID)
+	if !loaded {
+		return status.Error(codes.NotFound, "no such log stream")
+	}
+
+	ctx := stream.Context()
+	rsp := &snpb.ReadBatchResponse{}
+	err := lse.ReadBatchWithGLSN(req.GLSNs, func(glsn types.GLSN, le varlogpb.LogEntry, err error) error {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		rsp.LogEntry = le
+		rsp.Trimmed = errors.Is(err, verrors.ErrTrimmed)
+		if rsp.Trimmed {
+			rsp.LogEntry = varlogpb.LogEntry{
+				LogEntryMeta: varlogpb.LogEntryMeta{GLSN: glsn},
+			}
+		}
+		return stream.SendMsg(rsp)
+	})
+	if err != nil {
+		var code codes.Code
+		if errors.Is(err, verrors.ErrClosed) {
+			code = codes.Unavailable
+		} else if errors.Is(err, verrors.ErrInvalid) {
+			code = codes.InvalidArgument
+		} else if errors.Is(err, verrors.ErrChecksumMismatch) {
+			code = codes.DataLoss
+		} else {
+			code = status.FromContextError(err).Code()
+		}
+		return verrors.ToStatusErrorWithCode(err, code)
+	}
+	return nil
 }
 
+const (
+	// maxSubscribeFilteredEntries is the maximum number of log entries not
+	// matched by the filter of a subscription that are reported by a
+	// response. It bounds how long the client waits for them to be reported
+	// while a long run of them is scanned.
+	maxSubscribeFilteredEntries = 4096
+	// maxSubscribeFilteredRanges is the maximum number of ranges of GLSNs
+	// in a response that reports log entries not matched by the filter.
+	maxSubscribeFilteredRanges = 256
+)
+
 func (ls logServer) Subscribe(req *snpb.SubscribeRequest, stream snpb.LogIO_SubscribeServer) error {
 	if err := snpb.ValidateTopicLogStream(req); err != nil {
 		return status.Error(codes.InvalidArgument, err.Error())
@@ -66,7 +311,7 @@ func (ls logServer) Subscribe(req *snpb.SubscribeRequest, stream snpb.LogIO_Subs
 	}
 
 	ctx := stream.Context()
-	sr, err := lse.SubscribeWithGLSN(req.GLSNBegin, req.GLSNEnd)
+	sr, err := lse.SubscribeWithGLSN(req.GLSNBegin, req.GLSNEnd, logstream.WithSubscribeFilter(req.Filter))
 	if err != nil {
 		var code codes.Code
 		if errors.Is(err, verrors.ErrClosed) {
@@ -82,24 +327,75 @@ func (ls logServer) Subscribe(req *snpb.SubscribeRequest, stream snpb.LogIO_Subs
 	}
 
 	rsp := &snpb.SubscribeResponse{}
+	// filtered coalesces log entries not matched by the filter until a log
+	// entry matched arrives, no log entry is ready, or it gets too large.
+	filtered := &snpb.SubscribeResponse{Filtered: true}
+	numFiltered := 0
+	flush := func() error {
+		if len(filtered.FilteredRanges) == 0 {
+			return nil
+		}
+		filtered.GLSN = filtered.FilteredRanges[0].Begin
+		err := stream.SendMsg(filtered)
+		filtered.FilteredRanges = filtered.FilteredRanges[:0]
+		numFiltered = 0
+		return err
+	}
 Loop:
 	for {
+		var (
+			le varlogpb.LogEntry
+			ok bool
+		)
 		select {
 		case <-ctx.Done():
 			err = ctx.Err()
 			break Loop
-		case le, ok := <-sr.Result():
-			if !ok {
+		case le, ok = <-sr.Result():
+		default:
+			if err = flush(); err != nil {
 				break Loop
 			}
-			rsp.GLSN = le.GLSN
-			rsp.LLSN = le.LLSN
-			rsp.Payload = le.Data
-			err = stream.SendMsg(rsp)
-			if err != nil {
+			select {
+			case <-ctx.Done():
+				err = ctx.Err()
 				break Loop
+			case le, ok = <-sr.Result():
 			}
 		}
+		if !ok {
+			err = flush()
+			break Loop
+		}
+
+		// NOTE: The log stream executor sends a log entry without LLSN if
+		// the filter does not match it.
+		if le.LLSN.Invalid() {
+			ranges := filtered.FilteredRanges
+			if n := len(ranges); n > 0 && ranges[n-1].End == le.GLSN {
+				ranges[n-1].End++
+			} else {
+				filtered.FilteredRanges = append(ranges, snpb.SubscribeResponse_GLSNRange{Begin: le.GLSN, End: le.GLSN + 1})
+			}
+			numFiltered++
+			if numFiltered < maxSubscribeFilteredEntries && len(filtered.FilteredRanges) < maxSubscribeFilteredRanges {
+				continue
+			}
+		}
+		if err = flush(); err != nil {
+			break Loop
+		}
+		if le.LLSN.Invalid() {
+			continue
+		}
+		rsp.GLSN = le.GLSN
+		rsp.LLSN = le.LLSN
+		rsp.Payload = le.Data
+		rsp.Headers = le.Headers
+		err = stream.SendMsg(rsp)
+		if err != nil {
+			break Loop
+		}
 	}
 	sr.Stop()
 	// FIXME: error propagation via gRPC is awkward.
@@ -109,6 +405,9 @@ Loop:
 	if err != nil {
 		return status.Error(status.FromContextError(err).Code(), multierr.Append(err, sr.Err()).Error())
 	}
+	if errors.Is(sr.Err(), verrors.ErrChecksumMismatch) {
+		return verrors.ToStatusErrorWithCode(sr.Err(), codes.DataLoss)
+	}
 	return status.Error(status.FromContextError(sr.Err()).Code(), sr.Err().Error())
 }
 
@@ -124,7 +423,7 @@ func (ls logServer) SubscribeTo(req *snpb.SubscribeToRequest, stream snpb.LogIO_
 	}
 
 	ctx := stream.Context()
-	sr, err := lse.SubscribeWithLLSN(req.LLSNBegin, req.LLSNEnd)
+	sr, err := lse.SubscribeWithLLSN(req.LLSNBegin, req.LLSNEnd, logstream.WithSubscribeFilter(req.Filter))
 	if err != nil {
 		var code codes.Code
 		if errors.Is(err, verrors.ErrClosed) {
@@ -158,6 +457,9 @@ Loop:
 		}
 	}
 	sr.Stop()
+	if err == nil && errors.Is(sr.Err(), verrors.ErrChecksumMismatch) {
+		return verrors.ToStatusErrorWithCode(sr.Err(), codes.DataLoss)
+	}
 	return multierr.Append(err, sr.Err())
 }
 
@@ -172,6 +474,25 @@ func (ls logServer) TrimDeprecated(ctx context.Context, req *snpb.TrimDeprecated
 	return &pbtypes.Empty{}, nil
 }
 
+func (ls logServer) SeekTime(_ context.Context, req *snpb.SeekTimeRequest) (*snpb.SeekTimeResponse, error) {
+	if err := snpb.ValidateTopicLogStream(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	lse, loaded := ls.sn.executors.Load(req.TopicID, req.LogStreamID)
+	if !loaded {
+		return nil, status.Error(codes.NotFound, "no such log stream")
+	}
+
+	glsn, found, err := lse.SeekTime(req.Time)
+	if err != nil {
+		if errors.Is(err, verrors.ErrClosed) {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		return nil, status.Error(status.FromContextError(err).Code(), err.Error())
+	}
+	return &snpb.SeekTimeResponse{GLSN: glsn, Found: found}, nil
+}
+
 func (ls logServer) LogStreamReplicaMetadata(_ context.Context, req *snpb.LogStreamReplicaMetadataRequest) (*snpb.LogStreamReplicaMetadataResponse, error) {
 	if err := snpb.ValidateTopicLogStream(req); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -190,3 +511,12 @@ func (ls logServer) LogStreamReplicaMetadata(_ context.Context, req *snpb.LogStr
 	}
 	return &snpb.LogStreamReplicaMetadataResponse{LogStreamReplica: lsrmd}, nil
 }
+
+// checksumErrorCode returns the code of the error returned by
+// logstream.VerifyChecksums.
+func checksumErrorCode(err error) codes.Code {
+	if errors.Is(err, verrors.ErrInvalid) {
+		return codes.InvalidArgument
+	}
+	return codes.DataLoss
+}
@@ -2,13 +2,18 @@ package logstream
 
 import (
 	"context"
+	"fmt"
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/kakao/varlog/internal/batchlet"
 	snerrors "github.com/kakao/varlog/internal/storagenode/errors"
+	"github.com/kakao/varlog/internal/storagenode/telemetry"
 	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 type appendContext struct {
@@ -18,60 +23,210 @@ type appendContext struct {
 	totalBytes int64
 }
 
-// Append appends a batch of logs to the log stream.
-func (lse *Executor) Append(ctx context.Context, dataBatch [][]byte) ([]snpb.AppendResult, error) {
+// Append appends a batch of logs to the log stream. The argument headersBatch
+// has headers of each log entry in the dataBatch; it can be nil if none of the
+// log entries has headers.
+func (lse *Executor) Append(ctx context.Context, dataBatch [][]byte, headersBatch []varlogpb.LogEntryHeaders) ([]snpb.AppendResult, error) {
+	return lse.append(ctx, dataBatch, headersBatch, "", 0)
+}
+
+// AppendWithProducer appends a batch of logs on behalf of the idempotent
+// producer. The argument sequence is the sequence number of the first log
+// entry in the dataBatch, and the following log entries have consecutive
+// sequence numbers. Sequence numbers of a producer should increase, but they
+// need not be contiguous across batches.
+//
+// If the log stream has already appended some of the log entries, it does not
+// append them again but returns their original metadata. It can answer only
+// log entries in the last batch of the producer; for older ones, it returns
+// an error wrapping verrors.ErrDuplicateSequence.
+//
+// Appends by the same producer run one by one. Unlike Append, it waits for
+// the log entries to be committed or discarded even if the ctx is canceled;
+// otherwise, a retried batch could be appended twice.
+func (lse *Executor) AppendWithProducer(ctx context.Context, producerID string, sequence uint64, dataBatch [][]byte, headersBatch []varlogpb.LogEntryHeaders) ([]snpb.AppendResult, error) {
+	if producerID == "" {
+		return nil, fmt.Errorf("log stream: append: empty producer id: %w", verrors.ErrInvalid)
+	}
+	// A batch of an idempotent producer is processed as a single batchlet so
+	// that backup replicas can tell the boundary of the batch.
+	if maxBatchletLength := batchlet.LengthClasses[len(batchlet.LengthClasses)-1]; len(dataBatch) > maxBatchletLength {
+		return nil, fmt.Errorf("log stream: append: too many log entries %d for producer, limit %d: %w", len(dataBatch), maxBatchletLength, verrors.ErrInvalid)
+	}
+	if len(headersBatch) > 0 && len(headersBatch) != len(dataBatch) {
+		return nil, fmt.Errorf("log stream: append: unmatched headers: %w", verrors.ErrInvalid)
+	}
+
+	unlock, err := lse.producers.lock(ctx, producerID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	var dups []snpb.AppendResult
+	if ps, ok := lse.producers.get(producerID); ok && sequence <= ps.LastSequence() {
+		if sequence < ps.LastBatchSequence {
+			return nil, fmt.Errorf("log stream: append: producer %s, sequence %d: %w", producerID, sequence, verrors.ErrDuplicateSequence)
+		}
+		for seq := sequence; seq <= ps.LastSequence() && len(dups) < len(dataBatch); seq++ {
+			dups = append(dups, snpb.AppendResult{Meta: ps.LastBatch[seq-ps.LastBatchSequence]})
+		}
+		if len(dups) == len(dataBatch) {
+			return dups, nil
+		}
+		dataBatch = dataBatch[len(dups):]
+		if len(headersBatch) > 0 {
+			headersBatch = headersBatch[len(dups):]
+		}
+		sequence += uint64(len(dups))
+	}
+
+	res, err := lse.append(ctx, dataBatch, headersBatch, producerID, sequence)
+	if len(dups) > 0 {
+		res = append(dups, res...)
+	}
+	return res, err
+}
+
+func (lse *Executor) append(ctx context.Context, dataBatch [][]byte, headersBatch []varlogpb.LogEntryHeaders, producerID string, sequence uint64) ([]snpb.AppendResult, error) {
+	at, err := lse.appendAsync(ctx, dataBatch, headersBatch, producerID, sequence)
+	if err != nil {
+		return nil, err
+	}
+	if producerID != "" {
+		// Keep the span to trace the rest of the append.
+		ctx = trace.ContextWithSpan(context.Background(), trace.SpanFromContext(ctx))
+	}
+	return at.WaitForCompletion(ctx)
+}
+
+// AppendTask is an append in progress started by AppendAsync.
+type AppendTask struct {
+	lse                 *Executor
+	apc                 appendContext
+	dataBatchLen        int
+	startTime           time.Time
+	preparationDuration time.Duration
+}
+
+// AppendAsync starts appending a batch of logs to the log stream and returns
+// without waiting for the logs to be committed. Appends started by
+// AppendAsync in a goroutine are sequenced in the order of calls; hence,
+// callers can pipeline appends without losing their order.
+//
+// Callers must call WaitForCompletion of the returned AppendTask exactly once,
+// even if they are no longer interested in the result.
+func (lse *Executor) AppendAsync(ctx context.Context, dataBatch [][]byte, headersBatch []varlogpb.LogEntryHeaders) (*AppendTask, error) {
+	return lse.appendAsync(ctx, dataBatch, headersBatch, "", 0)
+}
+
+func (lse *Executor) appendAsync(ctx context.Context, dataBatch [][]byte, headersBatch []varlogpb.LogEntryHeaders, producerID string, sequence uint64) (*AppendTask, error) {
 	atomic.AddInt64(&lse.inflight, 1)
 	atomic.AddInt64(&lse.inflightAppend, 1)
 
-	defer func() {
+	err := lse.checkAppendable(dataBatch, headersBatch)
+	if err != nil {
 		atomic.AddInt64(&lse.inflightAppend, -1)
 		atomic.AddInt64(&lse.inflight, -1)
-	}()
+		return nil, err
+	}
+
+	dataBatchLen := len(dataBatch)
+	at := &AppendTask{
+		lse: lse,
+		apc: appendContext{
+			sts:  make([]*sequenceTask, 0, dataBatchLen/batchlet.LengthClasses[0]),
+			wwgs: make([]*writeWaitGroup, 0, dataBatchLen/batchlet.LengthClasses[0]),
+			awgs: make([]*appendWaitGroup, 0, dataBatchLen),
+		},
+		dataBatchLen: dataBatchLen,
+		startTime:    time.Now(),
+	}
+	lse.prepareAppendContext(dataBatch, headersBatch, producerID, sequence, &at.apc)
+	at.preparationDuration = time.Since(at.startTime)
+	lse.sendSequenceTasks(ctx, at.apc.sts)
+	return at, nil
+}
 
+func (lse *Executor) checkAppendable(dataBatch [][]byte, headersBatch []varlogpb.LogEntryHeaders) error {
 	switch lse.esm.load() {
 	case executorStateSealing, executorStateSealed, executorStateLearning:
-		return nil, verrors.ErrSealed
+		return verrors.ErrSealed
 	case executorStateClosed:
-		return nil, verrors.ErrClosed
+		return verrors.ErrClosed
 	}
 
 	if !lse.isPrimary() {
-		return nil, snerrors.ErrNotPrimary
+		return snerrors.ErrNotPrimary
 	}
 
-	startTime := time.Now()
-	var preparationDuration time.Duration
-	dataBatchLen := len(dataBatch)
-	apc := appendContext{
-		sts:  make([]*sequenceTask, 0, dataBatchLen/batchlet.LengthClasses[0]),
-		wwgs: make([]*writeWaitGroup, 0, dataBatchLen/batchlet.LengthClasses[0]),
-		awgs: make([]*appendWaitGroup, 0, dataBatchLen),
+	if len(headersBatch) > 0 && len(headersBatch) != len(dataBatch) {
+		return fmt.Errorf("log stream: append: unmatched headers: %w", verrors.ErrInvalid)
 	}
+	return lse.checkTxnHeaders(headersBatch)
+}
 
+// VerifyChecksums verifies that the argument checksums are CRC32C checksums of
+// log entries in the dataBatch. If checksums is empty, it computes and returns
+// them. It returns an error wrapping verrors.ErrChecksumMismatch if any of the
+// checksums does not match.
+func VerifyChecksums(dataBatch [][]byte, checksums []uint32) ([]uint32, error) {
+	if len(checksums) == 0 {
+		checksums = make([]uint32, len(dataBatch))
+		for i := range dataBatch {
+			checksums[i] = varlogpb.Checksum(dataBatch[i])
+		}
+		return checksums, nil
+	}
+	if len(checksums) != len(dataBatch) {
+		return nil, fmt.Errorf("unmatched checksums: %w", verrors.ErrInvalid)
+	}
+	for i := range dataBatch {
+		if actual := varlogpb.Checksum(dataBatch[i]); actual != checksums[i] {
+			return nil, fmt.Errorf("log entry %d, expected checksum %08x, actual %08x: %w", i, checksums[i], actual, verrors.ErrChecksumMismatch)
+		}
+	}
+	return checksums, nil
+}
+
+// WaitForCompletion waits for the logs appended by the AppendTask to be
+// committed and returns their results. If it returns an error, results of the
+// logs that failed have invalid metadata.
+func (at *AppendTask) WaitForCompletion(ctx context.Context) ([]snpb.AppendResult, error) {
+	lse := at.lse
 	defer func() {
+		atomic.AddInt64(&lse.inflightAppend, -1)
+		atomic.AddInt64(&lse.inflight, -1)
 		if lse.lsm == nil {
 			return
 		}
-		atomic.AddInt64(&lse.lsm.AppendLogs, int64(dataBatchLen))
-		atomic.AddInt64(&lse.lsm.AppendBytes, apc.totalBytes)
-		atomic.AddInt64(&lse.lsm.AppendDuration, time.Since(startTime).Microseconds())
+		atomic.AddInt64(&lse.lsm.AppendLogs, int64(at.dataBatchLen))
+		atomic.AddInt64(&lse.lsm.AppendBytes, at.apc.totalBytes)
+		atomic.AddInt64(&lse.lsm.AppendDuration, time.Since(at.startTime).Microseconds())
 		atomic.AddInt64(&lse.lsm.AppendOperations, 1)
-		atomic.AddInt64(&lse.lsm.AppendPreparationMicro, preparationDuration.Microseconds())
+		atomic.AddInt64(&lse.lsm.AppendPreparationMicro, at.preparationDuration.Microseconds())
 	}()
 
-	lse.prepareAppendContext(dataBatch, &apc)
-	preparationDuration = time.Since(startTime)
-	lse.sendSequenceTasks(ctx, apc.sts)
-	res, err := lse.waitForCompletionOfAppends(ctx, dataBatchLen, apc.awgs)
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		// Waiting for writes first tells how long the logs wait to be
+		// committed by the metadata repository after they are written.
+		for i := range at.apc.wwgs {
+			_ = at.apc.wwgs[i].wait(ctx)
+		}
+		_, span := telemetry.StartChildSpan(ctx, sc, "logstream.commit")
+		defer span.End()
+	}
+
+	res, err := lse.waitForCompletionOfAppends(ctx, at.dataBatchLen, at.apc.awgs)
 	if err == nil {
-		for i := range apc.wwgs {
-			apc.wwgs[i].release()
+		for i := range at.apc.wwgs {
+			at.apc.wwgs[i].release()
 		}
 	}
 	return res, err
 }
 
-func (lse *Executor) prepareAppendContext(dataBatch [][]byte, apc *appendContext) {
+func (lse *Executor) prepareAppendContext(dataBatch [][]byte, headersBatch []varlogpb.LogEntryHeaders, producerID string, sequence uint64, apc *appendContext) {
 	begin, end := 0, len(dataBatch)
 	for begin < end {
 		batchletClassIdx, batchletLen := batchlet.SelectLengthClass(end - begin)
@@ -80,20 +235,29 @@ func (lse *Executor) prepareAppendContext(dataBatch [][]byte, apc *appendContext
 			batchletEndIdx = end
 		}
 
-		lse.prepareAppendContextInternal(dataBatch, begin, batchletEndIdx, batchletClassIdx, apc)
+		lse.prepareAppendContextInternal(dataBatch, headersBatch, producerID, sequence, begin, batchletEndIdx, batchletClassIdx, apc)
 		begin = batchletEndIdx
 	}
 }
 
-func (lse *Executor) prepareAppendContextInternal(dataBatch [][]byte, begin, end, batchletClassIdx int, apc *appendContext) {
+func (lse *Executor) prepareAppendContextInternal(dataBatch [][]byte, headersBatch []varlogpb.LogEntryHeaders, producerID string, sequence uint64, begin, end, batchletClassIdx int, apc *appendContext) {
 	numBackups := len(lse.primaryBackups) - 1
 	batchletData := dataBatch[begin:end]
+	// Checksums are computed once by the primary replica and carried to the
+	// storage and backup replicas.
+	batchletChecksums, _ := VerifyChecksums(batchletData, nil)
+	var batchletHeaders []varlogpb.LogEntryHeaders
+	if len(headersBatch) > 0 {
+		batchletHeaders = headersBatch[begin:end]
+	}
 
 	st := newSequenceTask()
 	apc.sts = append(apc.sts, st)
 
 	// data batch
 	st.dataBatch = batchletData
+	st.checksums = batchletChecksums
+	st.headersBatch = batchletHeaders
 
 	// replicate tasks
 	st.rts = newReplicateTaskSlice()
@@ -102,6 +266,12 @@ func (lse *Executor) prepareAppendContextInternal(dataBatch [][]byte, begin, end
 		rt.tpid = lse.tpid
 		rt.lsid = lse.lsid
 		rt.dataList = batchletData
+		rt.checksumList = batchletChecksums
+		rt.headersList = batchletHeaders
+		if producerID != "" {
+			rt.producerID = producerID
+			rt.sequence = sequence + uint64(begin)
+		}
 		st.rts = append(st.rts, rt)
 	}
 
@@ -116,13 +286,27 @@ func (lse *Executor) prepareAppendContextInternal(dataBatch [][]byte, begin, end
 		// st.dwb.PutData(batchletData[i])
 		apc.totalBytes += int64(len(batchletData[i]))
 		awg := newAppendWaitGroup(st.wwg)
-		st.cwts.PushFront(newCommitWaitTask(awg))
+		cwt := newCommitWaitTask(awg)
+		if producerID != "" {
+			cwt.producerID = producerID
+			cwt.sequence = sequence + uint64(begin+i)
+			cwt.batchSequence = sequence
+		}
+		st.cwts.PushFront(cwt)
 		apc.awgs = append(apc.awgs, awg)
 	}
 	st.awgs = apc.awgs[begin:end]
 }
 
 func (lse *Executor) sendSequenceTasks(ctx context.Context, sts []*sequenceTask) {
+	sc := trace.SpanContextFromContext(ctx)
+	for _, st := range sts {
+		st.spanContext = sc
+		for _, rt := range st.rts {
+			rt.spanContext = sc
+		}
+	}
+
 	var err error
 	sendIdx := 0
 	for sendIdx < len(sts) {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/kakao/varlog/internal/storage"
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 func TestBackupWriter_InvalidConfig(t *testing.T) {
@@ -130,7 +131,7 @@ func TestBackupWriter_UnexpectedLLSN(t *testing.T) {
 
 	assert.Panics(t, func() {
 		wb := stg.NewWriteBatch()
-		err := wb.Set(uncommittedLLSNEnd+1, nil)
+		err := wb.Set(uncommittedLLSNEnd+1, nil, varlogpb.Checksum(nil))
 		assert.NoError(t, err)
 		bwt := newBackupWriteTask(wb, uncommittedLLSNEnd+1, uncommittedLLSNEnd+2)
 		bw.writeLoopInternal(context.Background(), bwt)
@@ -0,0 +1,129 @@
+package logstream
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/multierr"
+
+	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
+)
+
+// checkpointPollInterval is the interval to check whether the replica has
+// committed log entries to be copied by Checkpoint.
+const checkpointPollInterval = 10 * time.Millisecond
+
+// Checkpoint copies the replica into the directory dir, which must not exist.
+// The copy has log entries committed until the argument commitResult, and its
+// commit context is the one for commitResult; log entries committed after it
+// and ones not committed yet are not in the copy. Hence, copies of all log
+// streams taken with the commit results of the same version are a consistent
+// cut of the cluster.
+//
+// If the cold tier is enabled, segments of the replica are copied into the
+// cold tier under the prefix coldTierPrefix, which must be owned by the copy.
+//
+// It waits for the replica to commit all log entries in commitResult until the
+// context is done. It returns verrors.ErrSealed without waiting further if the
+// replica is sealed or learning before committing them, since it cannot catch
+// up until it is unsealed.
+func (lse *Executor) Checkpoint(ctx context.Context, dir, coldTierPrefix string, commitResult snpb.LogStreamCommitResult) (err error) {
+	atomic.AddInt64(&lse.inflight, 1)
+	defer atomic.AddInt64(&lse.inflight, -1)
+
+	cc := storage.CommitContext{
+		Version:            commitResult.Version,
+		HighWatermark:      commitResult.HighWatermark,
+		CommittedGLSNBegin: commitResult.CommittedGLSNOffset,
+		CommittedGLSNEnd:   commitResult.CommittedGLSNOffset + types.GLSN(commitResult.CommittedGLSNLength),
+		CommittedLLSNBegin: commitResult.CommittedLLSNOffset,
+	}
+	llsnEnd := cc.CommittedLLSNBegin + types.LLSN(commitResult.CommittedGLSNLength)
+
+	ticker := time.NewTicker(checkpointPollInterval)
+	defer ticker.Stop()
+	for {
+		state := lse.esm.load()
+		if state == executorStateClosed {
+			return verrors.ErrClosed
+		}
+		_, _, uncommittedBegin, _ := lse.lsc.reportCommitBase()
+		if uncommittedBegin.LLSN >= llsnEnd {
+			break
+		}
+		if state == executorStateSealed || state == executorStateLearning {
+			return fmt.Errorf("log stream: checkpoint: wait for llsn %d: %w", llsnEnd-1, verrors.ErrSealed)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("log stream: checkpoint: wait for llsn %d: %w", llsnEnd-1, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dir), os.ModePerm); err != nil {
+		return err
+	}
+	lse.muStorage.RLock()
+	if lse.esm.load() == executorStateClosed {
+		lse.muStorage.RUnlock()
+		return verrors.ErrClosed
+	}
+	stg, err := lse.stg.Checkpoint(dir, coldTierPrefix)
+	lse.muStorage.RUnlock()
+	if err != nil {
+		return fmt.Errorf("log stream: checkpoint: %w", err)
+	}
+	defer func() {
+		err = multierr.Append(err, stg.Close())
+		if err != nil {
+			_ = os.RemoveAll(dir)
+		}
+	}()
+	if err := stg.Truncate(cc); err != nil {
+		return fmt.Errorf("log stream: checkpoint: %w", err)
+	}
+	return nil
+}
+
+// Export writes the archive of log entries committed in the replica into the
+// writer w, and returns the commit context of the archive. Unlike copying the
+// data directory, it reads log entries in the cold tier as well. See
+// storage.Storage.Export for the archive.
+func (lse *Executor) Export(w io.Writer) (storage.CommitContext, error) {
+	atomic.AddInt64(&lse.inflight, 1)
+	defer atomic.AddInt64(&lse.inflight, -1)
+
+	lse.muStorage.RLock()
+	defer lse.muStorage.RUnlock()
+	if lse.esm.load() == executorStateClosed {
+		return storage.CommitContext{}, verrors.ErrClosed
+	}
+	cc, err := lse.stg.Export(exportWriter{lse: lse, w: w})
+	if err != nil {
+		return cc, fmt.Errorf("log stream: export: %w", err)
+	}
+	return cc, nil
+}
+
+// exportWriter stops Export once the executor is closed so that closing the
+// executor does not wait for the whole archive to be written.
+type exportWriter struct {
+	lse *Executor
+	w   io.Writer
+}
+
+func (ew exportWriter) Write(p []byte) (int, error) {
+	if ew.lse.esm.load() == executorStateClosed {
+		return 0, verrors.ErrClosed
+	}
+	return ew.w.Write(p)
+}
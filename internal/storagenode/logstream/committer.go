@@ -14,6 +14,7 @@ import (
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/runner"
 	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
 
@@ -208,10 +209,13 @@ func (cm *committer) commit(_ context.Context, ct *commitTask) error {
 		CommittedLLSNBegin: uncommittedLLSNBegin,
 	}
 
-	return cm.commitInternal(commitContext, true)
+	return cm.commitInternal(commitContext, ct.abortedTxnSpans, true)
 }
 
-func (cm *committer) commitInternal(cc storage.CommitContext, requireCommitWaitTasks bool) (err error) {
+// commitInternal commits log entries in the commit context. Log entries in the
+// argument abortedTxnSpans are committed with the TxnAbortedHeader replacing
+// their headers, and their appends fail with verrors.ErrTxnAborted.
+func (cm *committer) commitInternal(cc storage.CommitContext, abortedTxnSpans []snpb.TxnSpan, requireCommitWaitTasks bool) (err error) {
 	_, _, uncommittedBegin, _ := cm.lse.lsc.reportCommitBase()
 	uncommttedLLSNBegin := uncommittedBegin.LLSN
 
@@ -249,6 +253,9 @@ func (cm *committer) commitInternal(cc storage.CommitContext, requireCommitWaitT
 		atomic.AddInt64(&cm.lse.lsm.CommitterLogs, int64(numCommits))
 	}()
 
+	// producerStates has states of producers updated by this commit.
+	var producerStates map[string]*varlogpb.ProducerState
+
 	iter := cm.commitWaitQ.peekIterator()
 	for i := 0; i < numCommits; i++ {
 		llsn := cc.CommittedLLSNBegin + types.LLSN(i)
@@ -266,19 +273,60 @@ func (cm *committer) commitInternal(cc storage.CommitContext, requireCommitWaitT
 		cwt := iter.task()
 		if cwt != nil {
 			cwt.awg.setGLSN(glsn)
+			if cwt.producerID != "" {
+				if producerStates == nil {
+					producerStates = make(map[string]*varlogpb.ProducerState)
+				}
+				cm.updateProducerState(producerStates, cwt, llsn, glsn)
+			}
 		}
 
 		err = cb.Set(llsn, glsn)
 		if err != nil {
 			return err
 		}
+		if span, ok := findTxnSpan(abortedTxnSpans, llsn); ok {
+			err = cb.SetHeaders(llsn, map[string][]byte{
+				snpb.TxnAbortedHeader: []byte(span.Txn.ID),
+			})
+			if err != nil {
+				return err
+			}
+		}
 
 		iter.next()
 	}
+	for _, ps := range producerStates {
+		err = cb.SetProducerState(*ps)
+		if err != nil {
+			return err
+		}
+	}
+	// Producers updated by this commit are in use even if they were idle
+	// for a long time.
+	var expiredProducers []string
+	for _, producerID := range cm.lse.producers.expired(startTime) {
+		if _, ok := producerStates[producerID]; ok {
+			continue
+		}
+		err = cb.DeleteProducerState(producerID)
+		if err != nil {
+			return err
+		}
+		expiredProducers = append(expiredProducers, producerID)
+	}
+	err = cb.SetCommitTime(startTime)
+	if err != nil {
+		return err
+	}
 	err = cb.Apply()
 	if err != nil {
 		return err
 	}
+	for _, ps := range producerStates {
+		cm.lse.producers.put(*ps, startTime)
+	}
+	cm.lse.producers.remove(expiredProducers)
 
 	committedTasks := make([]*commitWaitTask, 0, numCommits)
 	for i := 0; i < numCommits; i++ {
@@ -308,8 +356,14 @@ func (cm *committer) commitInternal(cc storage.CommitContext, requireCommitWaitT
 		cm.lse.lsc.storeReportCommitBase(cc.Version, cc.HighWatermark, uncommittedBegin, false /*invalid*/)
 	})
 
-	for _, cwt := range committedTasks {
-		cwt.awg.commitDone(nil)
+	cm.lse.txns.release(uncommittedBegin.LLSN)
+
+	for i, cwt := range committedTasks {
+		var cerr error
+		if _, ok := findTxnSpan(abortedTxnSpans, cc.CommittedLLSNBegin+types.LLSN(i)); ok {
+			cerr = verrors.ErrTxnAborted
+		}
+		cwt.awg.commitDone(cerr)
 		cwt.release()
 	}
 
@@ -321,6 +375,33 @@ func (cm *committer) commitInternal(cc storage.CommitContext, requireCommitWaitT
 	return nil
 }
 
+// updateProducerState adds the log entry committed at the llsn and glsn to the
+// state of the producer in the argument states. A new batch of the producer
+// replaces the last batch of the state.
+func (cm *committer) updateProducerState(states map[string]*varlogpb.ProducerState, cwt *commitWaitTask, llsn types.LLSN, glsn types.GLSN) {
+	ps, ok := states[cwt.producerID]
+	if !ok {
+		// Copy the last batch since the producer table shares it.
+		prev, _ := cm.lse.producers.get(cwt.producerID)
+		ps = &varlogpb.ProducerState{
+			ProducerID:        cwt.producerID,
+			LastBatchSequence: prev.LastBatchSequence,
+			LastBatch:         append([]varlogpb.LogEntryMeta(nil), prev.LastBatch...),
+		}
+		states[cwt.producerID] = ps
+	}
+	if cwt.sequence == cwt.batchSequence {
+		ps.LastBatchSequence = cwt.sequence
+		ps.LastBatch = ps.LastBatch[:0]
+	}
+	ps.LastBatch = append(ps.LastBatch, varlogpb.LogEntryMeta{
+		TopicID:     cm.lse.tpid,
+		LogStreamID: cm.lse.lsid,
+		GLSN:        glsn,
+		LLSN:        llsn,
+	})
+}
+
 // drainCommitWaitQ drains the commit wait tasks in commitWaitQ.
 func (cm *committer) drainCommitWaitQ(cause error) {
 	cm.logger.Debug("draining commit wait tasks",
@@ -398,6 +479,13 @@ var commitWaitTaskPool = sync.Pool{
 
 type commitWaitTask struct {
 	awg *appendWaitGroup
+
+	// producerID is set only if the log entry is appended by an idempotent
+	// producer. The sequence is the sequence number of the log entry, and the
+	// batchSequence is that of the first log entry in its batch.
+	producerID    string
+	sequence      uint64
+	batchSequence uint64
 }
 
 func newCommitWaitTask(awg *appendWaitGroup) *commitWaitTask {
@@ -409,6 +497,9 @@ func newCommitWaitTask(awg *appendWaitGroup) *commitWaitTask {
 
 func (cwt *commitWaitTask) release() {
 	cwt.awg = nil
+	cwt.producerID = ""
+	cwt.sequence = 0
+	cwt.batchSequence = 0
 	commitWaitTaskPool.Put(cwt)
 }
 
@@ -432,6 +523,9 @@ type commitTask struct {
 	committedGLSNBegin types.GLSN
 	committedGLSNEnd   types.GLSN
 	committedLLSNBegin types.LLSN
+	// abortedTxnSpans are spans of log entries of aborted transactions in
+	// the commit.
+	abortedTxnSpans []snpb.TxnSpan
 }
 
 func newCommitTask() *commitTask {
@@ -444,6 +538,7 @@ func (ct *commitTask) release() {
 	ct.committedGLSNBegin = types.InvalidGLSN
 	ct.committedGLSNEnd = types.InvalidGLSN
 	ct.committedLLSNBegin = types.InvalidLLSN
+	ct.abortedTxnSpans = nil
 	commitTaskPool.Put(ct)
 }
 
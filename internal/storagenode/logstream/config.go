@@ -9,6 +9,7 @@ import (
 	"github.com/kakao/varlog/internal/storage"
 	"github.com/kakao/varlog/internal/storagenode/telemetry"
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/snpb"
 )
 
 const (
@@ -17,6 +18,7 @@ const (
 	DefaultCommitQueueCapacity          = 1024
 	DefaultReplicateClientQueueCapacity = 1024
 	DefaultSyncTimeout                  = 10 * time.Second
+	DefaultProducerStateTTL             = 24 * time.Hour
 )
 
 type executorConfig struct {
@@ -34,6 +36,7 @@ type executorConfig struct {
 	logger                       *zap.Logger
 	lsm                          *telemetry.LogStreamMetrics
 	syncTimeout                  time.Duration
+	producerStateTTL             time.Duration
 }
 
 func newExecutorConfig(opts []ExecutorOption) (executorConfig, error) {
@@ -44,6 +47,7 @@ func newExecutorConfig(opts []ExecutorOption) (executorConfig, error) {
 		replicateClientQueueCapacity: DefaultReplicateClientQueueCapacity,
 		logger:                       zap.NewNop(),
 		syncTimeout:                  DefaultSyncTimeout,
+		producerStateTTL:             DefaultProducerStateTTL,
 	}
 	for _, opt := range opts {
 		opt.applyExecutor(&cfg)
@@ -182,3 +186,52 @@ func WithSyncTimeout(syncTimeout time.Duration) ExecutorOption {
 		cfg.syncTimeout = syncTimeout
 	})
 }
+
+// WithProducerStateTTL sets how long the log stream replica keeps the state
+// of an idempotent producer that appends nothing. After the ttl, the replica
+// forgets the producer, and retries of its old batches are no longer
+// detected as duplicates. If the ttl is not positive, states never expire.
+func WithProducerStateTTL(ttl time.Duration) ExecutorOption {
+	return newFuncExecutorOption(func(cfg *executorConfig) {
+		cfg.producerStateTTL = ttl
+	})
+}
+
+type subscribeConfig struct {
+	filter *snpb.SubscribeFilter
+}
+
+func newSubscribeConfig(opts []SubscribeOption) subscribeConfig {
+	cfg := subscribeConfig{}
+	for _, opt := range opts {
+		opt.applySubscribe(&cfg)
+	}
+	return cfg
+}
+
+type SubscribeOption interface {
+	applySubscribe(cfg *subscribeConfig)
+}
+
+type funcSubscribeOption struct {
+	f func(*subscribeConfig)
+}
+
+func newFuncSubscribeOption(f func(*subscribeConfig)) *funcSubscribeOption {
+	return &funcSubscribeOption{f: f}
+}
+
+func (fso *funcSubscribeOption) applySubscribe(cfg *subscribeConfig) {
+	fso.f(cfg)
+}
+
+// WithSubscribeFilter sets the filter of a subscription. Log entries not
+// matched by the filter are skipped in SubscribeWithLLSN. In
+// SubscribeWithGLSN, they are sent with only their TopicID, LogStreamID and
+// GLSN, that is, their LLSNs are invalid, so that the caller can tell which
+// GLSNs belong to the log stream.
+func WithSubscribeFilter(filter *snpb.SubscribeFilter) SubscribeOption {
+	return newFuncSubscribeOption(func(cfg *subscribeConfig) {
+		cfg.filter = filter
+	})
+}
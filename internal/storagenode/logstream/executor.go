@@ -40,9 +40,19 @@ type Executor struct {
 	cm      *committer
 	bw      *backupWriter
 
+	// producers has states of idempotent producers.
+	producers *producerTable
+	// txns has spans of uncommitted log entries of transactions.
+	txns txnTracker
+
 	inflight       int64
 	inflightAppend int64
 
+	// muStorage keeps Close from closing the storage while Checkpoint or
+	// Export, which can take long, reads it. They hold the read lock and
+	// stop once the executor is closed, and Close holds the write lock.
+	muStorage sync.RWMutex
+
 	// FIXME: move to lsc
 	globalLowWatermark struct {
 		mu   sync.Mutex
@@ -106,6 +116,12 @@ func NewExecutor(opts ...ExecutorOption) (lse *Executor, err error) {
 	}
 	lse.lsc = lse.restoreLogStreamContext(rp)
 
+	producerStates, err := lse.stg.ReadProducerStates()
+	if err != nil {
+		return nil, err
+	}
+	lse.producers = newProducerTable(producerStates, lse.producerStateTTL, time.Now())
+
 	lse.decider = newDecidableCondition(lse.lsc)
 	defer func() {
 		if err == nil {
@@ -152,7 +168,14 @@ func NewExecutor(opts ...ExecutorOption) (lse *Executor, err error) {
 	return lse, err
 }
 
-func (lse *Executor) Replicate(ctx context.Context, llsnList []types.LLSN, dataList [][]byte) error {
+// Replicate writes a batch of log entries replicated from the primary replica.
+// The argument checksumList has checksums of the data computed by the primary
+// replica; Replicate verifies them before writing the log entries, or computes
+// them if checksumList is nil. The argument headersList can be nil if none of
+// the log entries has headers. The argument producerID is not empty only if
+// the log entries are a batch appended by an idempotent producer, and the
+// sequence is the sequence number of the first log entry.
+func (lse *Executor) Replicate(ctx context.Context, llsnList []types.LLSN, dataList [][]byte, checksumList []uint32, headersList []varlogpb.LogEntryHeaders, producerID string, sequence uint64) error {
 	atomic.AddInt64(&lse.inflight, 1)
 	defer atomic.AddInt64(&lse.inflight, -1)
 
@@ -167,6 +190,14 @@ func (lse *Executor) Replicate(ctx context.Context, llsnList []types.LLSN, dataL
 		return errors.New("log stream: not backup")
 	}
 
+	if len(headersList) > 0 && len(headersList) != len(dataList) {
+		return fmt.Errorf("log stream: replicate: unmatched headers: %w", verrors.ErrInvalid)
+	}
+	checksumList, err := VerifyChecksums(dataList, checksumList)
+	if err != nil {
+		return fmt.Errorf("log stream: replicate: %w", err)
+	}
+
 	var preparationDuration time.Duration
 	startTime := time.Now()
 	dataBytes := int64(0)
@@ -186,9 +217,19 @@ func (lse *Executor) Replicate(ctx context.Context, llsnList []types.LLSN, dataL
 	wb := lse.stg.NewWriteBatch()
 	cwts := newListQueue()
 	for i := 0; i < len(llsnList); i++ {
-		_ = wb.Set(llsnList[i], dataList[i])
+		_ = wb.Set(llsnList[i], dataList[i], checksumList[i])
+		if len(headersList) > 0 {
+			_ = wb.SetHeaders(llsnList[i], headersList[i].Values)
+			lse.txns.add(llsnList[i], headersList[i].Values, startTime)
+		}
 		dataBytes += int64(len(dataList[i]))
-		cwts.PushFront(newCommitWaitTask(nil))
+		cwt := newCommitWaitTask(nil)
+		if producerID != "" {
+			cwt.producerID = producerID
+			cwt.sequence = sequence + uint64(i)
+			cwt.batchSequence = sequence
+		}
+		cwts.PushFront(cwt)
 	}
 	bwt := newBackupWriteTask(wb, oldLLSN, newLLSN)
 
@@ -368,6 +409,14 @@ func (lse *Executor) resetInternalState(lastCommittedLLSN types.LLSN, discardCom
 	}
 
 	// TODO: delete storage? really?
+	// Headers are written only for log entries having them. Since LLSNs of
+	// uncommitted log entries will be reused, their headers should be
+	// deleted not to be attached to new log entries.
+	if err := lse.stg.DeleteHeaders(lastCommittedLLSN + 1); err != nil {
+		lse.logger.Warn("could not delete headers of uncommitted log entries", zap.Error(err))
+	}
+
+	lse.txns.truncate(lastCommittedLLSN + 1)
 
 	// reset llsn
 	lse.sq.llsn = lastCommittedLLSN
@@ -403,6 +452,7 @@ func (lse *Executor) Report(_ context.Context) (report snpb.LogStreamUncommitRep
 		HighWatermark:         highWatermark,
 		UncommittedLLSNOffset: uncommittedLLSNBegin,
 		UncommittedLLSNLength: uint64(uncommittedLLSNEnd - uncommittedLLSNBegin),
+		TxnSpans:              lse.txns.report(uncommittedLLSNBegin),
 	}
 	prevUncommittedLLSNEnd := lse.prevUncommittedLLSNEnd.Load()
 	if prevUncommittedLLSNEnd != uncommittedLLSNEnd {
@@ -443,6 +493,7 @@ func (lse *Executor) Commit(ctx context.Context, commitResult snpb.LogStreamComm
 	ct.committedGLSNBegin = commitResult.CommittedGLSNOffset
 	ct.committedGLSNEnd = commitResult.CommittedGLSNOffset + types.GLSN(commitResult.CommittedGLSNLength)
 	ct.committedLLSNBegin = commitResult.CommittedLLSNOffset
+	ct.abortedTxnSpans = commitResult.AbortedTxnSpans
 	if err := lse.cm.sendCommitTask(ctx, ct); err != nil {
 		ct.release()
 		return err
@@ -480,6 +531,19 @@ func (lse *Executor) metadataDescriptor(state executorState) snpb.LogStreamRepli
 
 	localLowWatermark, localHighWatermark, _ := lse.lsc.localWatermarks()
 	version, globalHighWatermark, _, _ := lse.lsc.reportCommitBase()
+	var liveStorageSizeBytes uint64
+	// The storage of a closed executor cannot be read.
+	if state != executorStateClosed {
+		liveBegin := localLowWatermark.LLSN
+		if liveBegin.Invalid() {
+			liveBegin = types.MinLLSN
+		}
+		var err error
+		liveStorageSizeBytes, err = lse.stg.LiveDiskUsage(liveBegin)
+		if err != nil {
+			lse.logger.Warn("could not estimate live storage size", zap.Error(err))
+		}
+	}
 	return snpb.LogStreamReplicaMetadataDescriptor{
 		LogStreamReplica: varlogpb.LogStreamReplica{
 			StorageNode: varlogpb.StorageNode{
@@ -500,10 +564,11 @@ func (lse *Executor) metadataDescriptor(state executorState) snpb.LogStreamRepli
 			LLSN: localHighWatermark.LLSN,
 			GLSN: localHighWatermark.GLSN,
 		},
-		Status:           status,
-		Path:             lse.stg.Path(),
-		StorageSizeBytes: lse.stg.DiskUsage(),
-		CreatedTime:      lse.createdTime,
+		Status:               status,
+		Path:                 lse.stg.Path(),
+		StorageSizeBytes:     lse.stg.DiskUsage(),
+		LiveStorageSizeBytes: liveStorageSizeBytes,
+		CreatedTime:          lse.createdTime,
 	}
 }
 
@@ -597,7 +662,9 @@ func (lse *Executor) Close() (err error) {
 		lse.bw.stop()
 	}
 	if lse.stg != nil {
+		lse.muStorage.Lock()
 		err = lse.stg.Close()
+		lse.muStorage.Unlock()
 	}
 	lse.decider.destroy()
 	lse.waitForDrainage()
@@ -84,10 +84,10 @@ func TestExecutor_Closed(t *testing.T) {
 	assert.NoError(t, lse.Close())
 	assert.Equal(t, executorStateClosed, lse.esm.load())
 
-	_, err := lse.Append(context.Background(), TestNewBatchData(t, 1, 0))
+	_, err := lse.Append(context.Background(), TestNewBatchData(t, 1, 0), nil)
 	assert.ErrorIs(t, err, verrors.ErrClosed)
 
-	err = lse.Replicate(context.Background(), []types.LLSN{1}, TestNewBatchData(t, 1, 0))
+	err = lse.Replicate(context.Background(), []types.LLSN{1}, TestNewBatchData(t, 1, 0), nil, nil, "", 0)
 	assert.ErrorIs(t, err, verrors.ErrClosed)
 
 	_, _, err = lse.Seal(context.Background(), types.MinGLSN)
@@ -150,6 +150,17 @@ func TestExecutor_Closed(t *testing.T) {
 
 	err = lse.Trim(context.Background(), 1)
 	assert.ErrorIs(t, err, verrors.ErrClosed)
+
+	_, err = lse.ReadWithGLSN(types.MinGLSN)
+	assert.ErrorIs(t, err, verrors.ErrClosed)
+
+	_, err = lse.ReadWithLLSN(types.MinLLSN)
+	assert.ErrorIs(t, err, verrors.ErrClosed)
+
+	err = lse.ReadBatchWithGLSN([]types.GLSN{types.MinGLSN}, func(types.GLSN, varlogpb.LogEntry, error) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, verrors.ErrClosed)
 }
 
 func TestExecutor_Sealing(t *testing.T) {
@@ -172,7 +183,7 @@ func TestExecutor_Sealing(t *testing.T) {
 				assert.Equal(t, varlogpb.LogStreamStatusSealing, st)
 				assert.Equal(t, executorStateSealing, lse.esm.load())
 
-				_, err = lse.Append(context.Background(), TestNewBatchData(t, 1, 0))
+				_, err = lse.Append(context.Background(), TestNewBatchData(t, 1, 0), nil)
 				assert.ErrorIs(t, err, verrors.ErrSealed)
 			},
 		},
@@ -185,7 +196,7 @@ func TestExecutor_Sealing(t *testing.T) {
 				assert.Equal(t, varlogpb.LogStreamStatusSealing, st)
 				assert.Equal(t, executorStateSealing, lse.esm.load())
 
-				err = lse.Replicate(context.Background(), []types.LLSN{1}, TestNewBatchData(t, 1, 0))
+				err = lse.Replicate(context.Background(), []types.LLSN{1}, TestNewBatchData(t, 1, 0), nil, nil, "", 0)
 				assert.ErrorIs(t, err, verrors.ErrSealed)
 			},
 		},
@@ -286,10 +297,10 @@ func TestExecutor_Sealed(t *testing.T) {
 	assert.Equal(t, varlogpb.LogStreamStatusSealed, st)
 	assert.Equal(t, executorStateSealed, lse.esm.load())
 
-	_, err = lse.Append(context.Background(), TestNewBatchData(t, 1, 0))
+	_, err = lse.Append(context.Background(), TestNewBatchData(t, 1, 0), nil)
 	assert.ErrorIs(t, err, verrors.ErrSealed)
 
-	err = lse.Replicate(context.Background(), []types.LLSN{1}, TestNewBatchData(t, 1, 0))
+	err = lse.Replicate(context.Background(), []types.LLSN{1}, TestNewBatchData(t, 1, 0), nil, nil, "", 0)
 	assert.ErrorIs(t, err, verrors.ErrSealed)
 }
 
@@ -298,7 +309,7 @@ func testUnsealInitialExecutor(t *testing.T, lse *Executor, replicas []varlogpb.
 	assert.NoError(t, err)
 	assert.Equal(t, varlogpb.LogStreamStatusSealing, lsmd.Status)
 
-	_, err = lse.Append(context.Background(), [][]byte{[]byte("hello")})
+	_, err = lse.Append(context.Background(), [][]byte{[]byte("hello")}, nil)
 	assert.Error(t, err)
 
 	st, localHWM, err := lse.Seal(context.Background(), lastGLSN)
@@ -485,7 +496,7 @@ func TestExecutor_Append(t *testing.T) {
 
 			// backup
 			if tc.isErr {
-				_, err := lse.Append(context.Background(), [][]byte{nil})
+				_, err := lse.Append(context.Background(), [][]byte{nil}, nil)
 				assert.Error(t, err)
 				return
 			}
@@ -506,7 +517,7 @@ func TestExecutor_Append(t *testing.T) {
 				go func() {
 					defer wg.Done()
 					batch := TestNewBatchData(t, batchLen, 0)
-					_, err := lse.Append(context.Background(), batch)
+					_, err := lse.Append(context.Background(), batch, nil)
 					assert.NoError(t, err)
 				}()
 			}
@@ -588,6 +599,558 @@ func TestExecutor_Append(t *testing.T) {
 	}
 }
 
+func TestExecutor_AppendWithHeaders(t *testing.T) {
+	lse := testNewPrimaryExecutor(t)
+	defer func() {
+		assert.NoError(t, lse.Close())
+	}()
+
+	// unmatched headers
+	_, err := lse.Append(context.Background(), [][]byte{[]byte("foo"), []byte("bar")}, []varlogpb.LogEntryHeaders{{}})
+	assert.ErrorIs(t, err, verrors.ErrInvalid)
+
+	headers := map[string][]byte{"key": []byte("value")}
+	commit := func(llsn types.LLSN, glsn types.GLSN, version types.Version) {
+		assert.Eventually(t, func() bool {
+			_ = lse.Commit(context.Background(), snpb.LogStreamCommitResult{
+				TopicID:             lse.tpid,
+				LogStreamID:         lse.lsid,
+				CommittedLLSNOffset: llsn,
+				CommittedGLSNOffset: glsn,
+				CommittedGLSNLength: 1,
+				Version:             version,
+				HighWatermark:       glsn,
+			})
+			rpt, err := lse.Report(context.Background())
+			assert.NoError(t, err)
+			return rpt.Version == version
+		}, time.Second, 10*time.Millisecond)
+	}
+
+	// LLSN: 1 2
+	// GLSN: 1 2
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := lse.Append(context.Background(), [][]byte{[]byte("foo"), []byte("bar")}, []varlogpb.LogEntryHeaders{
+			{Values: headers},
+			{},
+		})
+		assert.NoError(t, err)
+	}()
+	assert.Eventually(t, func() bool {
+		rpt, err := lse.Report(context.Background())
+		assert.NoError(t, err)
+		return rpt.UncommittedLLSNLength == 2
+	}, time.Second, 10*time.Millisecond)
+	commit(1, 1, 1)
+	commit(2, 2, 2)
+	wg.Wait()
+
+	le, err := lse.ReadWithGLSN(1)
+	assert.NoError(t, err)
+	assert.Equal(t, headers, le.Headers)
+	le, err = lse.ReadWithLLSN(2)
+	assert.NoError(t, err)
+	assert.Empty(t, le.Headers)
+
+	sr, err := lse.SubscribeWithGLSN(1, 3)
+	assert.NoError(t, err)
+	le = <-sr.Result()
+	assert.Equal(t, headers, le.Headers)
+	le = <-sr.Result()
+	assert.Empty(t, le.Headers)
+	sr.Stop()
+	assert.NoError(t, sr.Err())
+
+	// The uncommitted log entry at LLSN 3 is discarded by sealing.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := lse.Append(context.Background(), [][]byte{[]byte("discarded")}, []varlogpb.LogEntryHeaders{{Values: headers}})
+		assert.Error(t, err)
+	}()
+	assert.Eventually(t, func() bool {
+		rpt, err := lse.Report(context.Background())
+		assert.NoError(t, err)
+		return rpt.UncommittedLLSNLength == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		st, _, err := lse.Seal(context.Background(), 2)
+		assert.NoError(t, err)
+		return st == varlogpb.LogStreamStatusSealed
+	}, time.Second, 10*time.Millisecond)
+	wg.Wait()
+	assert.NoError(t, lse.Unseal(context.Background(), lse.primaryBackups))
+
+	// A new log entry at LLSN 3 should not have headers of the discarded one.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := lse.Append(context.Background(), [][]byte{[]byte("baz")}, nil)
+		assert.NoError(t, err)
+	}()
+	assert.Eventually(t, func() bool {
+		rpt, err := lse.Report(context.Background())
+		assert.NoError(t, err)
+		return rpt.UncommittedLLSNLength == 1
+	}, time.Second, 10*time.Millisecond)
+	commit(3, 3, 3)
+	wg.Wait()
+
+	le, err = lse.ReadWithGLSN(3)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("baz"), le.Data)
+	assert.Empty(t, le.Headers)
+}
+
+func TestExecutor_AppendTxn(t *testing.T) {
+	lse := testNewPrimaryExecutor(t)
+	defer func() {
+		assert.NoError(t, lse.Close())
+	}()
+
+	txnHeaders := func(id string, participants ...snpb.TxnParticipant) varlogpb.LogEntryHeaders {
+		txn := snpb.Txn{ID: id, Participants: participants, Timeout: time.Minute}
+		value, err := txn.Marshal()
+		require.NoError(t, err)
+		return varlogpb.LogEntryHeaders{Values: map[string][]byte{snpb.TxnHeader: value}}
+	}
+	self := snpb.TxnParticipant{TopicID: lse.tpid, LogStreamID: lse.lsid, Length: 2}
+	other := snpb.TxnParticipant{TopicID: lse.tpid, LogStreamID: lse.lsid + 1, Length: 1}
+
+	// invalid transaction headers
+	for _, headers := range []varlogpb.LogEntryHeaders{
+		{Values: map[string][]byte{snpb.TxnHeader: []byte("malformed")}},
+		{Values: map[string][]byte{snpb.TxnAbortedHeader: []byte("x")}},
+		txnHeaders("x", other),
+	} {
+		_, err := lse.Append(context.Background(), [][]byte{[]byte("foo")}, []varlogpb.LogEntryHeaders{headers})
+		assert.ErrorIs(t, err, verrors.ErrInvalid)
+	}
+
+	commit := func(llsn types.LLSN, glsn types.GLSN, length uint64, version types.Version, aborted []snpb.TxnSpan) {
+		assert.Eventually(t, func() bool {
+			_ = lse.Commit(context.Background(), snpb.LogStreamCommitResult{
+				TopicID:             lse.tpid,
+				LogStreamID:         lse.lsid,
+				CommittedLLSNOffset: llsn,
+				CommittedGLSNOffset: glsn,
+				CommittedGLSNLength: length,
+				Version:             version,
+				HighWatermark:       glsn + types.GLSN(length) - 1,
+				AbortedTxnSpans:     aborted,
+			})
+			rpt, err := lse.Report(context.Background())
+			assert.NoError(t, err)
+			return rpt.Version == version
+		}, time.Second, 10*time.Millisecond)
+	}
+	appendTxn := func(headers varlogpb.LogEntryHeaders, expectedErr error) *sync.WaitGroup {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := lse.Append(context.Background(), [][]byte{[]byte("foo"), []byte("bar")}, []varlogpb.LogEntryHeaders{headers, headers})
+			if expectedErr != nil {
+				assert.ErrorIs(t, err, expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		}()
+		return &wg
+	}
+	reportTxnSpans := func(length uint64) []snpb.TxnSpan {
+		var rpt snpb.LogStreamUncommitReport
+		assert.Eventually(t, func() bool {
+			var err error
+			rpt, err = lse.Report(context.Background())
+			assert.NoError(t, err)
+			return rpt.UncommittedLLSNLength == length
+		}, time.Second, 10*time.Millisecond)
+		return rpt.TxnSpans
+	}
+
+	// LLSN: 1 2
+	// GLSN: 1 2 (aborted)
+	wg := appendTxn(txnHeaders("x", self, other), verrors.ErrTxnAborted)
+	spans := reportTxnSpans(2)
+	require.Len(t, spans, 1)
+	assert.Equal(t, "x", spans[0].Txn.ID)
+	assert.Equal(t, types.LLSN(1), spans[0].LLSNBegin)
+	assert.Equal(t, types.LLSN(3), spans[0].LLSNEnd)
+	assert.True(t, spans[0].Deadline.After(time.Now()))
+	commit(1, 1, 2, 1, spans)
+	wg.Wait()
+	assert.Empty(t, reportTxnSpans(0))
+
+	// LLSN: 3 4
+	// GLSN: 3 4
+	wg = appendTxn(txnHeaders("y", self), nil)
+	spans = reportTxnSpans(2)
+	require.Len(t, spans, 1)
+	assert.Equal(t, "y", spans[0].Txn.ID)
+	commit(3, 3, 2, 2, nil)
+	wg.Wait()
+
+	// Log entries of the aborted transaction are invisible.
+	_, err := lse.ReadWithGLSN(1)
+	assert.ErrorIs(t, err, verrors.ErrNoEntry)
+	_, err = lse.ReadWithLLSN(2)
+	assert.ErrorIs(t, err, verrors.ErrNoEntry)
+	le, err := lse.ReadWithGLSN(3)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("foo"), le.Data)
+
+	var glsns []types.GLSN
+	err = lse.ReadBatchWithGLSN([]types.GLSN{1, 2, 3, 4}, func(glsn types.GLSN, _ varlogpb.LogEntry, err error) error {
+		glsns = append(glsns, glsn)
+		return err
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []types.GLSN{3, 4}, glsns)
+
+	sr, err := lse.SubscribeWithGLSN(1, 5)
+	assert.NoError(t, err)
+	for glsn := types.GLSN(1); glsn < 5; glsn++ {
+		le := <-sr.Result()
+		assert.Equal(t, glsn, le.GLSN)
+		assert.Equal(t, glsn >= 3, le.LLSN == types.LLSN(glsn))
+	}
+	sr.Stop()
+	assert.NoError(t, sr.Err())
+
+	sr, err = lse.SubscribeWithLLSN(1, 5)
+	assert.NoError(t, err)
+	for llsn := types.LLSN(3); llsn < 5; llsn++ {
+		le := <-sr.Result()
+		assert.Equal(t, llsn, le.LLSN)
+	}
+	sr.Stop()
+	assert.NoError(t, sr.Err())
+}
+
+func TestExecutor_SubscribeWithFilter(t *testing.T) {
+	lse := testNewPrimaryExecutor(t)
+	defer func() {
+		assert.NoError(t, lse.Close())
+	}()
+
+	// LLSN: 1 2 3
+	// GLSN: 1 2 3
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := lse.Append(context.Background(), [][]byte{[]byte("foo"), []byte("bar"), []byte("baz")}, []varlogpb.LogEntryHeaders{
+			{Values: map[string][]byte{"type": []byte("a")}},
+			{Values: map[string][]byte{"type": []byte("b")}},
+			{Values: map[string][]byte{"type": []byte("a")}},
+		})
+		assert.NoError(t, err)
+	}()
+	assert.Eventually(t, func() bool {
+		rpt, err := lse.Report(context.Background())
+		assert.NoError(t, err)
+		return rpt.UncommittedLLSNLength == 3
+	}, time.Second, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		_ = lse.Commit(context.Background(), snpb.LogStreamCommitResult{
+			TopicID:             lse.tpid,
+			LogStreamID:         lse.lsid,
+			CommittedLLSNOffset: 1,
+			CommittedGLSNOffset: 1,
+			CommittedGLSNLength: 3,
+			Version:             1,
+			HighWatermark:       3,
+		})
+		rpt, err := lse.Report(context.Background())
+		assert.NoError(t, err)
+		return rpt.Version == 1
+	}, time.Second, 10*time.Millisecond)
+	wg.Wait()
+
+	filter := &snpb.SubscribeFilter{
+		Headers:     map[string][]byte{"type": []byte("a")},
+		ExcludeData: true,
+	}
+
+	// Log entries not matched are sent without LLSNs.
+	sr, err := lse.SubscribeWithGLSN(1, 4, WithSubscribeFilter(filter))
+	require.NoError(t, err)
+	var les []varlogpb.LogEntry
+	for le := range sr.Result() {
+		les = append(les, le)
+	}
+	sr.Stop()
+	require.NoError(t, sr.Err())
+	require.Equal(t, []varlogpb.LogEntry{
+		{
+			LogEntryMeta: varlogpb.LogEntryMeta{TopicID: lse.tpid, LogStreamID: lse.lsid, GLSN: 1, LLSN: 1},
+			Headers:      map[string][]byte{"type": []byte("a")},
+		},
+		{
+			LogEntryMeta: varlogpb.LogEntryMeta{TopicID: lse.tpid, LogStreamID: lse.lsid, GLSN: 2},
+		},
+		{
+			LogEntryMeta: varlogpb.LogEntryMeta{TopicID: lse.tpid, LogStreamID: lse.lsid, GLSN: 3, LLSN: 3},
+			Headers:      map[string][]byte{"type": []byte("a")},
+		},
+	}, les)
+
+	// Log entries not matched are skipped.
+	sr, err = lse.SubscribeWithLLSN(1, 4, WithSubscribeFilter(filter))
+	require.NoError(t, err)
+	les = les[:0]
+	for le := range sr.Result() {
+		les = append(les, le)
+	}
+	sr.Stop()
+	require.NoError(t, sr.Err())
+	require.Len(t, les, 2)
+	require.Equal(t, types.LLSN(1), les[0].LLSN)
+	require.Equal(t, types.LLSN(3), les[1].LLSN)
+	require.Nil(t, les[0].Data)
+	require.Nil(t, les[1].Data)
+}
+
+func TestExecutor_AppendAsync(t *testing.T) {
+	const numTasks = 3
+
+	lse := testNewPrimaryExecutor(t)
+	defer func() {
+		assert.NoError(t, lse.Close())
+	}()
+
+	_, err := lse.AppendAsync(context.Background(), [][]byte{[]byte("foo")}, []varlogpb.LogEntryHeaders{{}, {}})
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	// Appends are sequenced in the order of AppendAsync calls.
+	tasks := make([]*AppendTask, 0, numTasks)
+	for i := 0; i < numTasks; i++ {
+		at, err := lse.AppendAsync(context.Background(), [][]byte{[]byte(strconv.Itoa(i)), []byte(strconv.Itoa(i))}, nil)
+		require.NoError(t, err)
+		tasks = append(tasks, at)
+	}
+	assert.Eventually(t, func() bool {
+		rpt, err := lse.Report(context.Background())
+		assert.NoError(t, err)
+		return rpt.UncommittedLLSNLength == numTasks*2
+	}, time.Second, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		_ = lse.Commit(context.Background(), snpb.LogStreamCommitResult{
+			TopicID:             lse.tpid,
+			LogStreamID:         lse.lsid,
+			CommittedLLSNOffset: 1,
+			CommittedGLSNOffset: 1,
+			CommittedGLSNLength: numTasks * 2,
+			Version:             1,
+			HighWatermark:       numTasks * 2,
+		})
+		rpt, err := lse.Report(context.Background())
+		assert.NoError(t, err)
+		return rpt.Version == 1
+	}, time.Second, 10*time.Millisecond)
+
+	for i, at := range tasks {
+		res, err := at.WaitForCompletion(context.Background())
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		for j := range res {
+			llsn := types.LLSN(i*2 + j + 1)
+			assert.Equal(t, llsn, res[j].Meta.LLSN)
+
+			le, err := lse.ReadWithLLSN(llsn)
+			require.NoError(t, err)
+			assert.Equal(t, []byte(strconv.Itoa(i)), le.Data)
+		}
+	}
+
+	// sealed
+	assert.Eventually(t, func() bool {
+		st, _, err := lse.Seal(context.Background(), numTasks*2)
+		assert.NoError(t, err)
+		return st == varlogpb.LogStreamStatusSealed
+	}, time.Second, 10*time.Millisecond)
+	_, err = lse.AppendAsync(context.Background(), [][]byte{[]byte("foo")}, nil)
+	require.ErrorIs(t, err, verrors.ErrSealed)
+}
+
+func TestExecutor_AppendWithProducer(t *testing.T) {
+	const producerID = "producer"
+
+	lse := testNewPrimaryExecutor(t)
+	path := lse.stg.Path()
+
+	// invalid producer
+	_, err := lse.AppendWithProducer(context.Background(), "", 1, [][]byte{[]byte("foo")}, nil)
+	assert.ErrorIs(t, err, verrors.ErrInvalid)
+
+	var version types.Version
+	commit := func(llsn types.LLSN, glsn types.GLSN, length uint64) {
+		version++
+		assert.Eventually(t, func() bool {
+			_ = lse.Commit(context.Background(), snpb.LogStreamCommitResult{
+				TopicID:             lse.tpid,
+				LogStreamID:         lse.lsid,
+				CommittedLLSNOffset: llsn,
+				CommittedGLSNOffset: glsn,
+				CommittedGLSNLength: length,
+				Version:             version,
+				HighWatermark:       glsn + types.GLSN(length) - 1,
+			})
+			rpt, err := lse.Report(context.Background())
+			assert.NoError(t, err)
+			return rpt.Version == version
+		}, time.Second, 10*time.Millisecond)
+	}
+	appendAndCommit := func(sequence uint64, dataBatch [][]byte, llsn types.LLSN, glsn types.GLSN, length uint64) []snpb.AppendResult {
+		var (
+			wg  sync.WaitGroup
+			res []snpb.AppendResult
+		)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var err error
+			res, err = lse.AppendWithProducer(context.Background(), producerID, sequence, dataBatch, nil)
+			assert.NoError(t, err)
+		}()
+		assert.Eventually(t, func() bool {
+			rpt, err := lse.Report(context.Background())
+			assert.NoError(t, err)
+			return rpt.UncommittedLLSNLength == length
+		}, time.Second, 10*time.Millisecond)
+		commit(llsn, glsn, length)
+		wg.Wait()
+		return res
+	}
+	metas := func(res []snpb.AppendResult) []varlogpb.LogEntryMeta {
+		ret := make([]varlogpb.LogEntryMeta, 0, len(res))
+		for _, r := range res {
+			assert.Empty(t, r.Error)
+			ret = append(ret, r.Meta)
+		}
+		return ret
+	}
+
+	// LLSN: 1 2
+	// GLSN: 1 2
+	// SEQ : 10 11
+	res := appendAndCommit(10, [][]byte{[]byte("a"), []byte("b")}, 1, 1, 2)
+	want := metas(res)
+	require.Len(t, want, 2)
+	assert.Equal(t, types.LLSN(1), want[0].LLSN)
+	assert.Equal(t, types.LLSN(2), want[1].LLSN)
+
+	// The retried batch is not appended again.
+	res, err = lse.AppendWithProducer(context.Background(), producerID, 10, [][]byte{[]byte("a"), []byte("b")}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, want, metas(res))
+	res, err = lse.AppendWithProducer(context.Background(), producerID, 11, [][]byte{[]byte("b")}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, want[1:], metas(res))
+
+	// Only the log entry with sequence 12 is appended.
+	// LLSN: 1 2 3
+	// GLSN: 1 2 3
+	// SEQ : 10 11 12
+	res = appendAndCommit(11, [][]byte{[]byte("b"), []byte("c")}, 3, 3, 1)
+	got := metas(res)
+	require.Len(t, got, 2)
+	assert.Equal(t, want[1], got[0])
+	assert.Equal(t, types.LLSN(3), got[1].LLSN)
+	assert.Equal(t, types.GLSN(3), got[1].GLSN)
+	want = got[1:]
+
+	le, err := lse.ReadWithGLSN(3)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("c"), le.Data)
+
+	// The log stream no longer remembers the result of sequence 10.
+	_, err = lse.AppendWithProducer(context.Background(), producerID, 10, [][]byte{[]byte("a")}, nil)
+	assert.ErrorIs(t, err, verrors.ErrDuplicateSequence)
+
+	rpt, err := lse.Report(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, types.LLSN(4), rpt.UncommittedLLSNOffset)
+	assert.Zero(t, rpt.UncommittedLLSNLength)
+
+	// The state of the producer survives the restart.
+	require.NoError(t, lse.Close())
+	lse = testRespawnExecutor(t, lse, path, 3)
+	defer func() {
+		assert.NoError(t, lse.Close())
+	}()
+	res, err = lse.AppendWithProducer(context.Background(), producerID, 12, [][]byte{[]byte("c")}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, want, metas(res))
+}
+
+func TestExecutor_ProducerStateExpiry(t *testing.T) {
+	const (
+		producerID = "producer"
+		ttl        = 10 * time.Millisecond
+	)
+
+	lse := testNewPrimaryExecutor(t, WithProducerStateTTL(ttl))
+	defer func() {
+		assert.NoError(t, lse.Close())
+	}()
+
+	var version types.Version
+	appendAndCommit := func(appendf func() error, glsn types.GLSN) {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, appendf())
+		}()
+		assert.Eventually(t, func() bool {
+			rpt, err := lse.Report(context.Background())
+			assert.NoError(t, err)
+			return rpt.UncommittedLLSNLength == 1
+		}, time.Second, 10*time.Millisecond)
+		version++
+		assert.Eventually(t, func() bool {
+			_ = lse.Commit(context.Background(), snpb.LogStreamCommitResult{
+				TopicID:             lse.tpid,
+				LogStreamID:         lse.lsid,
+				CommittedLLSNOffset: types.LLSN(glsn),
+				CommittedGLSNOffset: glsn,
+				CommittedGLSNLength: 1,
+				Version:             version,
+				HighWatermark:       glsn,
+			})
+			rpt, err := lse.Report(context.Background())
+			assert.NoError(t, err)
+			return rpt.Version == version
+		}, time.Second, 10*time.Millisecond)
+		wg.Wait()
+	}
+
+	appendAndCommit(func() error {
+		_, err := lse.AppendWithProducer(context.Background(), producerID, 1, [][]byte{[]byte("foo")}, nil)
+		return err
+	}, 1)
+	states, err := lse.stg.ReadProducerStates()
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+
+	// The next commit after the ttl deletes the state of the idle producer.
+	time.Sleep(2 * ttl)
+	appendAndCommit(func() error {
+		_, err := lse.Append(context.Background(), TestNewBatchData(t, 1, 0), nil)
+		return err
+	}, 2)
+	_, ok := lse.producers.get(producerID)
+	require.False(t, ok)
+	states, err = lse.stg.ReadProducerStates()
+	require.NoError(t, err)
+	require.Empty(t, states)
+}
+
 func TestExecutor_Replicate(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -619,7 +1182,7 @@ func TestExecutor_Replicate(t *testing.T) {
 
 			// primary
 			if tc.isErr {
-				err := lse.Replicate(context.Background(), []types.LLSN{1}, [][]byte{nil})
+				err := lse.Replicate(context.Background(), []types.LLSN{1}, [][]byte{nil}, nil, nil, "", 0)
 				assert.Error(t, err)
 				return
 			}
@@ -633,7 +1196,7 @@ func TestExecutor_Replicate(t *testing.T) {
 					llsn++
 					llsnList[i] = llsn
 				}
-				err := lse.Replicate(context.Background(), llsnList, dataList)
+				err := lse.Replicate(context.Background(), llsnList, dataList, nil, nil, "", 0)
 				assert.NoError(t, err)
 			}
 
@@ -711,6 +1274,57 @@ func TestExecutor_Replicate(t *testing.T) {
 	}
 }
 
+func TestExecutor_Checksum(t *testing.T) {
+	lse := testNewBackupExecutor(t)
+	defer func() {
+		assert.NoError(t, lse.Close())
+	}()
+
+	dataList := [][]byte{[]byte("one"), []byte("two")}
+	checksumList := []uint32{varlogpb.Checksum(dataList[0]), varlogpb.Checksum(dataList[1])}
+
+	err := lse.Replicate(context.Background(), []types.LLSN{1, 2}, dataList, checksumList[:1], nil, "", 0)
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+	err = lse.Replicate(context.Background(), []types.LLSN{1, 2}, dataList, []uint32{checksumList[1], checksumList[0]}, nil, "", 0)
+	require.ErrorIs(t, err, verrors.ErrChecksumMismatch)
+	err = lse.Replicate(context.Background(), []types.LLSN{1, 2}, dataList, checksumList, nil, "", 0)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		_ = lse.Commit(context.Background(), snpb.LogStreamCommitResult{
+			TopicID:             lse.tpid,
+			LogStreamID:         lse.lsid,
+			CommittedLLSNOffset: 1,
+			CommittedGLSNOffset: 1,
+			CommittedGLSNLength: 2,
+			Version:             1,
+			HighWatermark:       2,
+		})
+		_, localHWM, _ := lse.lsc.localWatermarks()
+		return localHWM.GLSN == 2
+	}, time.Second, 10*time.Millisecond)
+
+	le, err := lse.ReadWithGLSN(1)
+	require.NoError(t, err)
+	require.Equal(t, checksumList[0], le.Checksum)
+
+	// Silent corruption of the disk is detected when the log entry is read.
+	storage.TestCorruptLogEntry(t, lse.stg, 2)
+	_, err = lse.ReadWithGLSN(2)
+	require.ErrorIs(t, err, verrors.ErrChecksumMismatch)
+	_, err = lse.ReadWithLLSN(2)
+	require.ErrorIs(t, err, verrors.ErrChecksumMismatch)
+
+	sr, err := lse.SubscribeWithGLSN(1, 3)
+	require.NoError(t, err)
+	le = <-sr.Result()
+	require.Equal(t, types.GLSN(1), le.GLSN)
+	_, ok := <-sr.Result()
+	require.False(t, ok)
+	sr.Stop()
+	require.ErrorIs(t, sr.Err(), verrors.ErrChecksumMismatch)
+}
+
 func TestExecutor_AppendSeal(t *testing.T) {
 	const (
 		numClients = 20
@@ -729,7 +1343,7 @@ func TestExecutor_AppendSeal(t *testing.T) {
 		go func() {
 			defer wg.Done()
 			for {
-				_, err := lse.Append(context.Background(), [][]byte{[]byte("hello")})
+				_, err := lse.Append(context.Background(), [][]byte{[]byte("hello")}, nil)
 				if err != nil {
 					break
 				}
@@ -801,7 +1415,7 @@ func TestExecutor_AppendSeal(t *testing.T) {
 		go func() {
 			defer wg.Done()
 			for {
-				_, err := lse.Append(context.Background(), [][]byte{[]byte("hello")})
+				_, err := lse.Append(context.Background(), [][]byte{[]byte("hello")}, nil)
 				if err != nil {
 					break
 				}
@@ -878,7 +1492,7 @@ func TestExecutor_ReplicateSeal(t *testing.T) {
 	go func() {
 		defer wg.Done()
 		for llsn := lastLLSN + 1; llsn < types.MaxLLSN; llsn++ {
-			err := lse.Replicate(context.Background(), []types.LLSN{llsn}, [][]byte{nil})
+			err := lse.Replicate(context.Background(), []types.LLSN{llsn}, [][]byte{nil}, nil, nil, "", 0)
 			if err != nil {
 				break
 			}
@@ -938,7 +1552,7 @@ func TestExecutor_ReplicateSeal(t *testing.T) {
 	go func() {
 		defer wg.Done()
 		for llsn := lastLLSN + 1; llsn < types.MaxLLSN; llsn++ {
-			err := lse.Replicate(context.Background(), []types.LLSN{llsn}, [][]byte{nil})
+			err := lse.Replicate(context.Background(), []types.LLSN{llsn}, [][]byte{nil}, nil, nil, "", 0)
 			if err != nil {
 				break
 			}
@@ -1091,7 +1705,8 @@ func TestExecutor_SubscribeWithGLSN(t *testing.T) {
 							LLSN:        llsn,
 							GLSN:        glsn,
 						},
-						Data: msg,
+						Data:     msg,
+						Checksum: varlogpb.Checksum(msg),
 					}, <-sr.Result())
 				}
 				_, ok := <-sr.Result()
@@ -1122,7 +1737,8 @@ func TestExecutor_SubscribeWithGLSN(t *testing.T) {
 							LLSN:        llsn,
 							GLSN:        glsn,
 						},
-						Data: msg,
+						Data:     msg,
+						Checksum: varlogpb.Checksum(msg),
 					}, <-sr.Result())
 				}
 				_, ok := <-sr.Result()
@@ -1153,7 +1769,8 @@ func TestExecutor_SubscribeWithGLSN(t *testing.T) {
 							LLSN:        llsn,
 							GLSN:        glsn,
 						},
-						Data: msg,
+						Data:     msg,
+						Checksum: varlogpb.Checksum(msg),
 					}, <-sr.Result())
 				}
 				_, ok := <-sr.Result()
@@ -1184,7 +1801,8 @@ func TestExecutor_SubscribeWithGLSN(t *testing.T) {
 							LLSN:        llsn,
 							GLSN:        glsn,
 						},
-						Data: msg,
+						Data:     msg,
+						Checksum: varlogpb.Checksum(msg),
 					}, <-sr.Result())
 				}
 				var wg sync.WaitGroup
@@ -1223,7 +1841,8 @@ func TestExecutor_SubscribeWithGLSN(t *testing.T) {
 							LLSN:        llsn,
 							GLSN:        glsn,
 						},
-						Data: msg,
+						Data:     msg,
+						Checksum: varlogpb.Checksum(msg),
 					}, <-sr.Result())
 				}
 				var wg sync.WaitGroup
@@ -1301,7 +1920,7 @@ func TestExecutor_SubscribeWithGLSN(t *testing.T) {
 				wg.Add(1)
 				go func() {
 					defer wg.Done()
-					_, err := lse.Append(context.Background(), [][]byte{msg})
+					_, err := lse.Append(context.Background(), [][]byte{msg}, nil)
 					assert.NoError(t, err)
 				}()
 			}
@@ -1349,7 +1968,7 @@ func TestExecutor_Subscribe(t *testing.T) {
 		defer wg.Done()
 		for i := 0; i < numLogs; i++ {
 			data := []byte(strconv.Itoa(int(expectedGLSN)))
-			res, err := lse.Append(context.Background(), [][]byte{data})
+			res, err := lse.Append(context.Background(), [][]byte{data}, nil)
 			assert.NoError(t, err)
 			assert.Equal(t, []snpb.AppendResult{{
 				Meta: varlogpb.LogEntryMeta{
@@ -1406,7 +2025,8 @@ func TestExecutor_Subscribe(t *testing.T) {
 				GLSN:        types.GLSN(i + 1),
 				LLSN:        types.LLSN(i + 1),
 			},
-			Data: []byte(strconv.Itoa(i + 1)),
+			Data:     []byte(strconv.Itoa(i + 1)),
+			Checksum: varlogpb.Checksum([]byte(strconv.Itoa(i + 1))),
 		}, <-sr.Result())
 	}
 	_, ok := <-sr.Result()
@@ -1425,7 +2045,8 @@ func TestExecutor_Subscribe(t *testing.T) {
 				LogStreamID: lse.lsid,
 				LLSN:        types.LLSN(i + 1),
 			},
-			Data: []byte(strconv.Itoa(i + 1)),
+			Data:     []byte(strconv.Itoa(i + 1)),
+			Checksum: varlogpb.Checksum([]byte(strconv.Itoa(i + 1))),
 		}, <-sr.Result())
 	}
 	_, ok = <-sr.Result()
@@ -1445,7 +2066,8 @@ func TestExecutor_Subscribe(t *testing.T) {
 				GLSN:        types.GLSN(i + 1),
 				LLSN:        types.LLSN(i + 1),
 			},
-			Data: []byte(strconv.Itoa(i + 1)),
+			Data:     []byte(strconv.Itoa(i + 1)),
+			Checksum: varlogpb.Checksum([]byte(strconv.Itoa(i + 1))),
 		}, <-sr.Result())
 	}
 	wg.Add(1)
@@ -1469,7 +2091,8 @@ func TestExecutor_Subscribe(t *testing.T) {
 				LogStreamID: lse.lsid,
 				LLSN:        types.LLSN(i + 1),
 			},
-			Data: []byte(strconv.Itoa(i + 1)),
+			Data:     []byte(strconv.Itoa(i + 1)),
+			Checksum: varlogpb.Checksum([]byte(strconv.Itoa(i + 1))),
 		}, <-sr.Result())
 	}
 	wg.Add(1)
@@ -1488,7 +2111,7 @@ func TestExecutor_Subscribe(t *testing.T) {
 	go func() {
 		defer appendWg.Done()
 		data := []byte(strconv.Itoa(int(expectedGLSN)))
-		res, err := lse.Append(context.Background(), [][]byte{data})
+		res, err := lse.Append(context.Background(), [][]byte{data}, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, []snpb.AppendResult{{
 			Meta: varlogpb.LogEntryMeta{
@@ -1518,7 +2141,8 @@ func TestExecutor_Subscribe(t *testing.T) {
 				GLSN:        types.GLSN(i + 1),
 				LLSN:        types.LLSN(i + 1),
 			},
-			Data: []byte(strconv.Itoa(i + 1)),
+			Data:     []byte(strconv.Itoa(i + 1)),
+			Checksum: varlogpb.Checksum([]byte(strconv.Itoa(i + 1))),
 		}, <-sr.Result())
 	}
 	wg.Add(1)
@@ -1542,7 +2166,8 @@ func TestExecutor_Subscribe(t *testing.T) {
 				LogStreamID: lse.lsid,
 				LLSN:        types.LLSN(i + 1),
 			},
-			Data: []byte(strconv.Itoa(i + 1)),
+			Data:     []byte(strconv.Itoa(i + 1)),
+			Checksum: varlogpb.Checksum([]byte(strconv.Itoa(i + 1))),
 		}, <-sr.Result())
 	}
 	wg.Add(1)
@@ -1570,7 +2195,8 @@ func TestExecutor_Subscribe(t *testing.T) {
 					GLSN:        types.GLSN(i + 1),
 					LLSN:        types.LLSN(i + 1),
 				},
-				Data: []byte(strconv.Itoa(i + 1)),
+				Data:     []byte(strconv.Itoa(i + 1)),
+				Checksum: varlogpb.Checksum([]byte(strconv.Itoa(i + 1))),
 			}, <-sr.Result())
 		}
 		_, ok := <-sr.Result()
@@ -1593,7 +2219,8 @@ func TestExecutor_Subscribe(t *testing.T) {
 					LogStreamID: lse.lsid,
 					LLSN:        types.LLSN(i + 1),
 				},
-				Data: []byte(strconv.Itoa(i + 1)),
+				Data:     []byte(strconv.Itoa(i + 1)),
+				Checksum: varlogpb.Checksum([]byte(strconv.Itoa(i + 1))),
 			}, <-sr.Result())
 		}
 		_, ok := <-sr.Result()
@@ -1649,7 +2276,7 @@ func TestExecutor_Recover(t *testing.T) {
 				wg.Done()
 			}()
 			for {
-				_, err := lse.Append(context.Background(), [][]byte{[]byte("hello")})
+				_, err := lse.Append(context.Background(), [][]byte{[]byte("hello")}, nil)
 				if err == nil {
 					continue
 				}
@@ -2011,12 +2638,12 @@ func TestExecutor_SealAfterRestart(t *testing.T) {
 				wg.Add(2)
 				go func() {
 					defer wg.Done()
-					_, err := lse.Append(context.Background(), [][]byte{[]byte("foo")})
+					_, err := lse.Append(context.Background(), [][]byte{[]byte("foo")}, nil)
 					assert.NoError(t, err)
 				}()
 				go func() {
 					defer wg.Done()
-					_, err := lse.Append(context.Background(), [][]byte{[]byte("foo")})
+					_, err := lse.Append(context.Background(), [][]byte{[]byte("foo")}, nil)
 					assert.NoError(t, err)
 				}()
 				require.Eventually(t, func() bool {
@@ -2091,12 +2718,12 @@ func TestExecutor_SealAfterRestart(t *testing.T) {
 				wg.Add(2)
 				go func() {
 					defer wg.Done()
-					_, err := lse.Append(context.Background(), [][]byte{[]byte("foo")})
+					_, err := lse.Append(context.Background(), [][]byte{[]byte("foo")}, nil)
 					assert.NoError(t, err)
 				}()
 				go func() {
 					defer wg.Done()
-					_, err := lse.Append(context.Background(), [][]byte{[]byte("foo")})
+					_, err := lse.Append(context.Background(), [][]byte{[]byte("foo")}, nil)
 					assert.NoError(t, err)
 				}()
 				require.Eventually(t, func() bool {
@@ -2161,12 +2788,12 @@ func TestExecutor_SealAfterRestart(t *testing.T) {
 				wg.Add(2)
 				go func() {
 					defer wg.Done()
-					_, err := lse.Append(context.Background(), [][]byte{[]byte("foo")})
+					_, err := lse.Append(context.Background(), [][]byte{[]byte("foo")}, nil)
 					assert.NoError(t, err)
 				}()
 				go func() {
 					defer wg.Done()
-					_, err := lse.Append(context.Background(), [][]byte{[]byte("foo")})
+					_, err := lse.Append(context.Background(), [][]byte{[]byte("foo")}, nil)
 					assert.NoError(t, err)
 				}()
 				require.Eventually(t, func() bool {
@@ -2985,7 +3612,7 @@ func TestExecutorSyncInit(t *testing.T) {
 					wg.Add(1)
 					go func() {
 						defer wg.Done()
-						_, err := dst.Append(context.Background(), [][]byte{[]byte("foo")})
+						_, err := dst.Append(context.Background(), [][]byte{[]byte("foo")}, nil)
 						assert.NoError(t, err)
 					}()
 				}
@@ -3166,6 +3793,62 @@ func TestExecutorSyncReplicate(t *testing.T) {
 				assert.Equal(t, executorStateSealing, dst.esm.load())
 			},
 		},
+		{
+			name: "ChecksumMismatch",
+			testf: func(t *testing.T, dst *Executor, src varlogpb.LogStreamReplica) {
+				const lastCommittedLSN = numLogs + 10
+				makeLearningState(t, dst, src, lastCommittedLSN, snpb.SyncRange{
+					FirstLLSN: 1,
+					LastLLSN:  lastCommittedLSN,
+				}, snpb.SyncRange{
+					FirstLLSN: numLogs + 1,
+					LastLLSN:  lastCommittedLSN,
+				})
+				err := dst.SyncReplicate(context.Background(), src, snpb.SyncPayload{
+					LogEntry: &varlogpb.LogEntry{
+						LogEntryMeta: varlogpb.LogEntryMeta{
+							TopicID:     dst.tpid,
+							LogStreamID: dst.lsid,
+							LLSN:        numLogs + 1,
+							GLSN:        numLogs + 1,
+						},
+						Data:     []byte("corrupted"),
+						Checksum: varlogpb.Checksum([]byte("original")),
+					},
+				})
+				require.ErrorIs(t, err, verrors.ErrChecksumMismatch)
+
+				assert.Equal(t, executorStateSealing, dst.esm.load())
+			},
+		},
+		{
+			name: "UnknownChecksum",
+			testf: func(t *testing.T, dst *Executor, src varlogpb.LogStreamReplica) {
+				const lastCommittedLSN = numLogs + 10
+				makeLearningState(t, dst, src, lastCommittedLSN, snpb.SyncRange{
+					FirstLLSN: 1,
+					LastLLSN:  lastCommittedLSN,
+				}, snpb.SyncRange{
+					FirstLLSN: numLogs + 1,
+					LastLLSN:  lastCommittedLSN,
+				})
+				// A source that does not know checksums sends none.
+				err := dst.SyncReplicate(context.Background(), src, snpb.SyncPayload{
+					LogEntry: &varlogpb.LogEntry{
+						LogEntryMeta: varlogpb.LogEntryMeta{
+							TopicID:     dst.tpid,
+							LogStreamID: dst.lsid,
+							LLSN:        numLogs + 1,
+							GLSN:        numLogs + 1,
+						},
+						Data: []byte("legacy"),
+					},
+				})
+				require.NoError(t, err)
+
+				assert.Equal(t, executorStateLearning, dst.esm.load())
+			},
+		},
 		{
 			name: "NotSequentialLogEntries",
 			testf: func(t *testing.T, dst *Executor, src varlogpb.LogStreamReplica) {
@@ -3380,6 +4063,101 @@ func TestExecutorSyncReplicate(t *testing.T) {
 				require.Equal(t, types.GLSN(lastCommittedLSN), localHWM)
 			},
 		},
+		{
+			name: "SucceedWithProducerState",
+			testf: func(t *testing.T, dst *Executor, src varlogpb.LogStreamReplica) {
+				const lastCommittedLSN = numLogs + 1
+				makeLearningState(t, dst, src, lastCommittedLSN, snpb.SyncRange{
+					FirstLLSN: 1,
+					LastLLSN:  lastCommittedLSN,
+				}, snpb.SyncRange{
+					FirstLLSN: numLogs + 1,
+					LastLLSN:  lastCommittedLSN,
+				})
+				lem := varlogpb.LogEntryMeta{
+					TopicID:     dst.tpid,
+					LogStreamID: dst.lsid,
+					LLSN:        lastCommittedLSN,
+					GLSN:        lastCommittedLSN,
+				}
+				ps := varlogpb.ProducerState{
+					ProducerID:        "producer",
+					LastBatchSequence: 1,
+					LastBatch:         []varlogpb.LogEntryMeta{lem},
+				}
+				err := dst.SyncReplicate(context.Background(), src, snpb.SyncPayload{
+					LogEntry: &varlogpb.LogEntry{LogEntryMeta: lem},
+				})
+				require.NoError(t, err)
+				err = dst.SyncReplicate(context.Background(), src, snpb.SyncPayload{
+					ProducerState: &ps,
+				})
+				require.NoError(t, err)
+				err = dst.SyncReplicate(context.Background(), src, snpb.SyncPayload{
+					CommitContext: &varlogpb.CommitContext{
+						Version:            types.Version(2),
+						HighWatermark:      lastCommittedLSN,
+						CommittedGLSNBegin: lastCommittedLSN,
+						CommittedGLSNEnd:   lastCommittedLSN + 1,
+						CommittedLLSNBegin: lastCommittedLSN,
+					},
+				})
+				require.NoError(t, err)
+
+				actual, ok := dst.producers.get(ps.ProducerID)
+				require.True(t, ok)
+				require.Equal(t, ps, actual)
+
+				states, err := dst.stg.ReadProducerStates()
+				require.NoError(t, err)
+				require.Equal(t, []varlogpb.ProducerState{ps}, states)
+			},
+		},
+		{
+			name: "SucceedWithCommitTime",
+			testf: func(t *testing.T, dst *Executor, src varlogpb.LogStreamReplica) {
+				const lastCommittedLSN = numLogs + 1
+				makeLearningState(t, dst, src, lastCommittedLSN, snpb.SyncRange{
+					FirstLLSN: 1,
+					LastLLSN:  lastCommittedLSN,
+				}, snpb.SyncRange{
+					FirstLLSN: numLogs + 1,
+					LastLLSN:  lastCommittedLSN,
+				})
+				err := dst.SyncReplicate(context.Background(), src, snpb.SyncPayload{
+					LogEntry: &varlogpb.LogEntry{LogEntryMeta: varlogpb.LogEntryMeta{
+						TopicID:     dst.tpid,
+						LogStreamID: dst.lsid,
+						LLSN:        lastCommittedLSN,
+						GLSN:        lastCommittedLSN,
+					}},
+				})
+				require.NoError(t, err)
+				commitTime := time.Now()
+				err = dst.SyncReplicate(context.Background(), src, snpb.SyncPayload{
+					CommitTime: &snpb.SyncCommitTime{
+						UnixNano:  commitTime.UnixNano(),
+						GLSNBegin: lastCommittedLSN,
+						GLSNEnd:   lastCommittedLSN + 1,
+					},
+				})
+				require.NoError(t, err)
+				err = dst.SyncReplicate(context.Background(), src, snpb.SyncPayload{
+					CommitContext: &varlogpb.CommitContext{
+						Version:            types.Version(2),
+						HighWatermark:      lastCommittedLSN,
+						CommittedGLSNBegin: lastCommittedLSN,
+						CommittedGLSNEnd:   lastCommittedLSN + 1,
+						CommittedLLSNBegin: lastCommittedLSN,
+					},
+				})
+				require.NoError(t, err)
+
+				glsn, err := dst.stg.SeekTime(commitTime)
+				require.NoError(t, err)
+				require.Equal(t, types.GLSN(lastCommittedLSN), glsn)
+			},
+		},
 	}
 
 	for _, tc := range tcs {
@@ -3394,7 +4172,7 @@ func TestExecutorSyncReplicate(t *testing.T) {
 				wg.Add(1)
 				go func() {
 					defer wg.Done()
-					_, err := dst.Append(context.Background(), [][]byte{[]byte("foo")})
+					_, err := dst.Append(context.Background(), [][]byte{[]byte("foo")}, nil)
 					assert.NoError(t, err)
 				}()
 			}
@@ -3478,7 +4256,7 @@ func TestExecutor_Trim(t *testing.T) {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			_, err := lse.Append(context.Background(), [][]byte{[]byte("hello")})
+			_, err := lse.Append(context.Background(), [][]byte{[]byte("hello")}, nil)
 			assert.NoError(t, err)
 		}()
 	}
@@ -3518,12 +4296,58 @@ func TestExecutor_Trim(t *testing.T) {
 	assert.Equal(t, varlogpb.LogSequenceNumber{LLSN: 1, GLSN: 1}, lsrmd.LocalLowWatermark)
 	assert.Equal(t, varlogpb.LogSequenceNumber{LLSN: 10, GLSN: 10}, lsrmd.LocalHighWatermark)
 
+	le, err := lse.ReadWithGLSN(types.GLSN(numLogs))
+	assert.NoError(t, err)
+	assert.Equal(t, varlogpb.LogEntryMeta{
+		TopicID:     lse.tpid,
+		LogStreamID: lse.lsid,
+		GLSN:        types.GLSN(numLogs),
+		LLSN:        types.LLSN(numLogs),
+	}, le.LogEntryMeta)
+	assert.Equal(t, []byte("hello"), le.Data)
+	_, err = lse.ReadWithGLSN(types.GLSN(numLogs + 1))
+	assert.ErrorIs(t, err, verrors.ErrNoEntry)
+	_, err = lse.ReadWithGLSN(types.InvalidGLSN)
+	assert.ErrorIs(t, err, verrors.ErrInvalid)
+	_, err = lse.ReadWithLLSN(types.LLSN(numLogs + 1))
+	assert.ErrorIs(t, err, verrors.ErrNoEntry)
+
 	// CC:   +-- 1 --+ +--  2 --+
 	// LLSN: _ _ _ _ 5 6 7 8 9 10
 	// GLSN: _ _ _ _ 5 6 7 8 9 10
 	err = lse.Trim(context.Background(), 4)
 	assert.NoError(t, err)
 	// already trimmed
+	_, err = lse.ReadWithGLSN(4)
+	assert.ErrorIs(t, err, verrors.ErrTrimmed)
+	_, err = lse.ReadWithLLSN(4)
+	assert.ErrorIs(t, err, verrors.ErrTrimmed)
+	le, err = lse.ReadWithLLSN(5)
+	assert.NoError(t, err)
+	assert.Equal(t, types.GLSN(5), le.GLSN)
+	var (
+		readGLSNs    []types.GLSN
+		trimmedGLSNs []types.GLSN
+	)
+	err = lse.ReadBatchWithGLSN([]types.GLSN{10, 3, 5, 11, 5}, func(glsn types.GLSN, le varlogpb.LogEntry, err error) error {
+		if err != nil {
+			assert.ErrorIs(t, err, verrors.ErrTrimmed)
+			trimmedGLSNs = append(trimmedGLSNs, glsn)
+			return nil
+		}
+		assert.Equal(t, glsn, le.GLSN)
+		assert.Equal(t, types.LLSN(glsn), le.LLSN)
+		assert.Equal(t, []byte("hello"), le.Data)
+		readGLSNs = append(readGLSNs, glsn)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []types.GLSN{3}, trimmedGLSNs)
+	assert.Equal(t, []types.GLSN{5, 10}, readGLSNs)
+	err = lse.ReadBatchWithGLSN([]types.GLSN{types.InvalidGLSN}, func(types.GLSN, varlogpb.LogEntry, error) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, verrors.ErrInvalid)
 	_, err = lse.SubscribeWithGLSN(4, types.MaxGLSN)
 	assert.ErrorIs(t, err, verrors.ErrTrimmed)
 	_, err = lse.SubscribeWithLLSN(4, types.MaxLLSN)
@@ -0,0 +1,162 @@
+package logstream
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// producerTable keeps states of idempotent producers that have appended log
+// entries to the log stream replica. The committer updates the state of a
+// producer when it commits log entries appended by the producer, and the
+// storage persists the state together with the commit.
+//
+// A producer that appends nothing for the ttl expires. The committer asks
+// the table for expired producers when it commits, deletes their states from
+// the storage together with the commit, and then removes them from the
+// table. States loaded from the storage are regarded as used at the creation
+// of the table. If the ttl is not positive, no producer expires.
+//
+// States returned by producerTable share their LastBatch with the table;
+// hence, callers must not modify them.
+type producerTable struct {
+	mu         sync.Mutex
+	states     map[string]varlogpb.ProducerState
+	lastUsed   map[string]time.Time
+	locks      map[string]*producerLock
+	ttl        time.Duration
+	lastPruned time.Time
+}
+
+// producerLock serializes appends by a producer. It is removed from the
+// table when nobody holds or waits for it.
+type producerLock struct {
+	ch   chan struct{}
+	refs int
+}
+
+func newProducerTable(states []varlogpb.ProducerState, ttl time.Duration, now time.Time) *producerTable {
+	pt := &producerTable{
+		states:     make(map[string]varlogpb.ProducerState, len(states)),
+		lastUsed:   make(map[string]time.Time, len(states)),
+		locks:      make(map[string]*producerLock),
+		ttl:        ttl,
+		lastPruned: now,
+	}
+	for _, ps := range states {
+		pt.states[ps.ProducerID] = ps
+		pt.lastUsed[ps.ProducerID] = now
+	}
+	return pt
+}
+
+// get returns the state of the producer.
+func (pt *producerTable) get(producerID string) (varlogpb.ProducerState, bool) {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	ps, ok := pt.states[producerID]
+	return ps, ok
+}
+
+// put replaces the state of the producer, which is used at now.
+func (pt *producerTable) put(ps varlogpb.ProducerState, now time.Time) {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	pt.states[ps.ProducerID] = ps
+	pt.lastUsed[ps.ProducerID] = now
+}
+
+// list returns states of all producers sorted by their IDs.
+func (pt *producerTable) list() []varlogpb.ProducerState {
+	pt.mu.Lock()
+	states := make([]varlogpb.ProducerState, 0, len(pt.states))
+	for _, ps := range pt.states {
+		states = append(states, ps)
+	}
+	pt.mu.Unlock()
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].ProducerID < states[j].ProducerID
+	})
+	return states
+}
+
+// expired returns IDs of producers that have not been used for the ttl by
+// now. Producers holding or waiting for their locks are not expired. To
+// avoid scanning the table on every commit, it scans at most once per the
+// smaller of the ttl and a minute, and returns nil otherwise.
+//
+// The returned producers stay in the table until remove is called.
+func (pt *producerTable) expired(now time.Time) []string {
+	if pt.ttl <= 0 {
+		return nil
+	}
+	interval := pt.ttl
+	if interval > time.Minute {
+		interval = time.Minute
+	}
+
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	if now.Sub(pt.lastPruned) < interval {
+		return nil
+	}
+	pt.lastPruned = now
+
+	var ids []string
+	for id, lastUsed := range pt.lastUsed {
+		if now.Sub(lastUsed) < pt.ttl {
+			continue
+		}
+		if _, ok := pt.locks[id]; ok {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// remove deletes states of the producers from the table.
+func (pt *producerTable) remove(producerIDs []string) {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	for _, id := range producerIDs {
+		delete(pt.states, id)
+		delete(pt.lastUsed, id)
+	}
+}
+
+// lock acquires the lock of the producer so that appends by the same
+// producer run one by one. It returns a function to release the lock.
+func (pt *producerTable) lock(ctx context.Context, producerID string) (unlock func(), err error) {
+	pt.mu.Lock()
+	pl, ok := pt.locks[producerID]
+	if !ok {
+		pl = &producerLock{ch: make(chan struct{}, 1)}
+		pt.locks[producerID] = pl
+	}
+	pl.refs++
+	pt.mu.Unlock()
+
+	select {
+	case pl.ch <- struct{}{}:
+		return func() {
+			<-pl.ch
+			pt.release(producerID, pl)
+		}, nil
+	case <-ctx.Done():
+		pt.release(producerID, pl)
+		return nil, ctx.Err()
+	}
+}
+
+func (pt *producerTable) release(producerID string, pl *producerLock) {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	pl.refs--
+	if pl.refs == 0 {
+		delete(pt.locks, producerID)
+	}
+}
@@ -0,0 +1,72 @@
+package logstream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func TestProducerTable_Expired(t *testing.T) {
+	const ttl = time.Hour
+
+	base := time.Now()
+	pt := newProducerTable([]varlogpb.ProducerState{
+		{ProducerID: "foo", LastBatchSequence: 1},
+	}, ttl, base)
+	pt.put(varlogpb.ProducerState{ProducerID: "bar", LastBatchSequence: 1}, base.Add(30*time.Minute))
+
+	// Nobody is idle for the ttl.
+	require.Empty(t, pt.expired(base.Add(ttl-time.Second)))
+
+	// The table is not scanned again within a minute.
+	require.Empty(t, pt.expired(base.Add(ttl+time.Second)))
+
+	// A producer holding its lock does not expire.
+	unlock, err := pt.lock(context.Background(), "foo")
+	require.NoError(t, err)
+	require.Empty(t, pt.expired(base.Add(ttl+time.Minute)))
+	unlock()
+	require.Empty(t, pt.locks)
+
+	now := base.Add(ttl + 2*time.Minute)
+	expired := pt.expired(now)
+	require.Equal(t, []string{"foo"}, expired)
+	pt.remove(expired)
+	_, ok := pt.get("foo")
+	require.False(t, ok)
+	_, ok = pt.get("bar")
+	require.True(t, ok)
+
+	// Putting the state again refreshes the last use of the producer.
+	pt.put(varlogpb.ProducerState{ProducerID: "bar", LastBatchSequence: 2}, now)
+	require.Empty(t, pt.expired(now.Add(ttl-time.Second)))
+	require.Equal(t, []string{"bar"}, pt.expired(now.Add(ttl+time.Minute)))
+}
+
+func TestProducerTable_ExpiryDisabled(t *testing.T) {
+	base := time.Now()
+	pt := newProducerTable([]varlogpb.ProducerState{
+		{ProducerID: "foo", LastBatchSequence: 1},
+	}, 0, base)
+	require.Empty(t, pt.expired(base.Add(365*24*time.Hour)))
+}
+
+func TestProducerTable_LockCanceled(t *testing.T) {
+	pt := newProducerTable(nil, DefaultProducerStateTTL, time.Now())
+
+	unlock, err := pt.lock(context.Background(), "foo")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = pt.lock(ctx, "foo")
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, pt.locks, 1)
+
+	unlock()
+	require.Empty(t, pt.locks)
+}
@@ -0,0 +1,212 @@
+package logstream
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// ReadWithGLSN reads the committed log entry whose GLSN is the argument glsn.
+// It returns verrors.ErrTrimmed if the log entry was already trimmed, and
+// verrors.ErrNoEntry if the log stream replica does not have the log entry.
+// Note that the log entry may belong to another log stream in the same topic
+// or to an aborted transaction when it returns verrors.ErrNoEntry.
+func (lse *Executor) ReadWithGLSN(glsn types.GLSN) (varlogpb.LogEntry, error) {
+	atomic.AddInt64(&lse.inflight, 1)
+	defer atomic.AddInt64(&lse.inflight, -1)
+
+	if lse.esm.load() == executorStateClosed {
+		return varlogpb.InvalidLogEntry(), verrors.ErrClosed
+	}
+
+	if glsn.Invalid() {
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("log stream: read: invalid glsn: %w", verrors.ErrInvalid)
+	}
+
+	lse.globalLowWatermark.mu.Lock()
+	if glsn < lse.globalLowWatermark.glsn {
+		lse.globalLowWatermark.mu.Unlock()
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("log stream: read: %w", verrors.ErrTrimmed)
+	}
+	lse.globalLowWatermark.mu.Unlock()
+
+	_, localHWM, _ := lse.lsc.localWatermarks()
+	if localHWM.GLSN < glsn {
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("log stream: read: glsn %d: %w", glsn, verrors.ErrNoEntry)
+	}
+
+	return lse.read(storage.AtGLSN(glsn))
+}
+
+// ReadWithLLSN reads the committed log entry whose LLSN is the argument llsn.
+// It returns verrors.ErrTrimmed if the log entry was already trimmed, and
+// verrors.ErrNoEntry if the log entry has not been committed yet or belongs to
+// an aborted transaction.
+func (lse *Executor) ReadWithLLSN(llsn types.LLSN) (varlogpb.LogEntry, error) {
+	atomic.AddInt64(&lse.inflight, 1)
+	defer atomic.AddInt64(&lse.inflight, -1)
+
+	if lse.esm.load() == executorStateClosed {
+		return varlogpb.InvalidLogEntry(), verrors.ErrClosed
+	}
+
+	if llsn.Invalid() {
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("log stream: read: invalid llsn: %w", verrors.ErrInvalid)
+	}
+
+	localLWM, localHWM, _ := lse.lsc.localWatermarks()
+	if llsn < localLWM.LLSN {
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("log stream: read: %w", verrors.ErrTrimmed)
+	}
+	if localHWM.LLSN < llsn {
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("log stream: read: llsn %d: %w", llsn, verrors.ErrNoEntry)
+	}
+
+	return lse.read(storage.AtLLSN(llsn))
+}
+
+// ReadBatchWithGLSN reads the committed log entries whose GLSNs are in the
+// argument glsns and calls the argument f for each of them in ascending order
+// of GLSN. It calls f with verrors.ErrTrimmed for the GLSN of a log entry that
+// was already trimmed, and skips GLSNs that the log stream replica does not
+// have or that belong to aborted transactions. It stops reading if f returns an error and returns the error.
+func (lse *Executor) ReadBatchWithGLSN(glsns []types.GLSN, f func(glsn types.GLSN, le varlogpb.LogEntry, err error) error) error {
+	atomic.AddInt64(&lse.inflight, 1)
+	defer atomic.AddInt64(&lse.inflight, -1)
+
+	if lse.esm.load() == executorStateClosed {
+		return verrors.ErrClosed
+	}
+
+	sorted := make([]types.GLSN, 0, len(glsns))
+	for _, glsn := range glsns {
+		if glsn.Invalid() {
+			return fmt.Errorf("log stream: read batch: invalid glsn: %w", verrors.ErrInvalid)
+		}
+		sorted = append(sorted, glsn)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	lse.globalLowWatermark.mu.Lock()
+	globalLWM := lse.globalLowWatermark.glsn
+	lse.globalLowWatermark.mu.Unlock()
+	_, localHWM, _ := lse.lsc.localWatermarks()
+
+	var prev types.GLSN
+	idx := 0
+	for ; idx < len(sorted) && sorted[idx] < globalLWM; idx++ {
+		if sorted[idx] == prev {
+			continue
+		}
+		prev = sorted[idx]
+		if err := f(prev, varlogpb.InvalidLogEntry(), fmt.Errorf("log stream: read batch: %w", verrors.ErrTrimmed)); err != nil {
+			return err
+		}
+	}
+	sorted = sorted[idx:]
+	for len(sorted) > 0 && sorted[len(sorted)-1] > localHWM.GLSN {
+		sorted = sorted[:len(sorted)-1]
+	}
+	if len(sorted) == 0 {
+		return nil
+	}
+
+	scanner := lse.stg.NewScanner(storage.WithGLSN(sorted[0], sorted[len(sorted)-1]+1))
+	defer func() {
+		_ = scanner.Close()
+	}()
+	for _, glsn := range sorted {
+		if glsn == prev {
+			continue
+		}
+		prev = glsn
+		if !scanner.SeekGLSN(glsn) {
+			continue
+		}
+		le, err := scanner.Value()
+		if err != nil {
+			return fmt.Errorf("log stream: read batch: %w", lse.checkCorruption(err))
+		}
+		if snpb.IsTxnAborted(le.Headers) {
+			continue
+		}
+		le.TopicID = lse.tpid
+		le.LogStreamID = lse.lsid
+		if err := f(glsn, le, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (lse *Executor) read(opt storage.ReadOption) (varlogpb.LogEntry, error) {
+	le, err := lse.stg.Read(opt)
+	if err != nil {
+		if errors.Is(err, storage.ErrNoLogEntry) {
+			err = verrors.ErrNoEntry
+		}
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("log stream: read: %w", lse.checkCorruption(err))
+	}
+	if snpb.IsTxnAborted(le.Headers) {
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("log stream: read: aborted transaction: %w", verrors.ErrNoEntry)
+	}
+	le.TopicID = lse.tpid
+	le.LogStreamID = lse.lsid
+	return le, nil
+}
+
+// SeekTime returns the first GLSN of log entries committed at or after the
+// argument t in the log stream replica. If no log entry is committed at or
+// after t, it returns the GLSN next to the global high watermark and false.
+//
+// Commit times are recorded by the replica when it commits log entries, or
+// when it receives them by synchronization; hence, they can differ slightly
+// across replicas.
+func (lse *Executor) SeekTime(t time.Time) (glsn types.GLSN, found bool, err error) {
+	atomic.AddInt64(&lse.inflight, 1)
+	defer atomic.AddInt64(&lse.inflight, -1)
+
+	if lse.esm.load() == executorStateClosed {
+		return types.InvalidGLSN, false, verrors.ErrClosed
+	}
+
+	// NOTE: The global high watermark is read before seeking since the
+	// storage applies a commit before the log stream context is updated.
+	_, globalHWM, _, _ := lse.lsc.reportCommitBase()
+	glsn, err = lse.stg.SeekTime(t)
+	if errors.Is(err, storage.ErrNoLogEntry) {
+		return globalHWM + 1, false, nil
+	}
+	if err != nil {
+		return types.InvalidGLSN, false, err
+	}
+
+	// The first log entries committed at the time can be trimmed.
+	localLWM, _, _ := lse.lsc.localWatermarks()
+	if glsn < localLWM.GLSN {
+		glsn = localLWM.GLSN
+	}
+	return glsn, true, nil
+}
+
+// checkCorruption converts storage.ErrChecksumMismatch into
+// verrors.ErrChecksumMismatch, which can be delivered to clients. Since it
+// means that the log stream replica has a corrupted log entry, it logs the
+// error with details.
+func (lse *Executor) checkCorruption(err error) error {
+	if !errors.Is(err, storage.ErrChecksumMismatch) {
+		return err
+	}
+	lse.logger.Error("log stream: corrupted log entry", zap.Error(err))
+	return verrors.ErrChecksumMismatch
+}
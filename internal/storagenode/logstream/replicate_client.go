@@ -6,12 +6,17 @@ import (
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/kakao/varlog/internal/batchlet"
+	"github.com/kakao/varlog/internal/storagenode/telemetry"
 	"github.com/kakao/varlog/pkg/rpc"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/runner"
+	vtelemetry "github.com/kakao/varlog/pkg/util/telemetry"
 	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
@@ -130,16 +135,30 @@ func (rc *replicateClient) sendLoop(ctx context.Context) {
 }
 
 // sendLoopInternal sends a replicate task to the backup replica.
-func (rc *replicateClient) sendLoopInternal(_ context.Context, rt *replicateTask, req *snpb.ReplicateRequest) error {
+func (rc *replicateClient) sendLoopInternal(ctx context.Context, rt *replicateTask, req *snpb.ReplicateRequest) error {
 	// Remove maxAppendSubBatchSize, since rt already has batched data.
 	startTime := time.Now()
+	ctx, span := telemetry.StartChildSpan(ctx, rt.spanContext, "logstream.replicate",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.Int("snid", int(rc.replica.StorageNodeID))),
+	)
+	defer span.End()
+	req.TraceContext = vtelemetry.InjectTraceContext(ctx)
 	// NOTE: We need to copy the LLSN array, since the array is reused.
 	req.LLSN = req.LLSN[0:len(rt.llsnList)]
 	copy(req.LLSN, rt.llsnList)
 	//req.LLSN = rt.llsnList
 	req.Data = rt.dataList
+	req.Checksums = rt.checksumList
+	req.Headers = rt.headersList
+	req.ProducerID = rt.producerID
+	req.Sequence = rt.sequence
 	rt.release()
 	err := rc.streamClient.Send(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
 	inflight := atomic.AddInt64(&rc.inflight, -1)
 	if rc.lse.lsm != nil {
 		atomic.StoreInt64(&rc.lse.lsm.ReplicateClientInflightOperations, inflight)
@@ -3,16 +3,26 @@ package logstream
 import (
 	"sync"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/kakao/varlog/internal/batchlet"
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 // replicateTask is a task struct including a list of LLSNs and bytes of data.
 type replicateTask struct {
-	tpid     types.TopicID
-	lsid     types.LogStreamID
-	llsnList []types.LLSN
-	dataList [][]byte
+	tpid         types.TopicID
+	lsid         types.LogStreamID
+	llsnList     []types.LLSN
+	dataList     [][]byte
+	checksumList []uint32
+	headersList  []varlogpb.LogEntryHeaders
+	producerID   string
+	sequence     uint64
+	// spanContext is the span context of the append. It is invalid if the
+	// append is not traced.
+	spanContext trace.SpanContext
 
 	poolIdx int
 }
@@ -30,6 +40,11 @@ func (rt *replicateTask) release() {
 	rt.lsid = 0
 	rt.llsnList = rt.llsnList[0:0]
 	rt.dataList = nil
+	rt.checksumList = nil
+	rt.headersList = nil
+	rt.producerID = ""
+	rt.sequence = 0
+	rt.spanContext = trace.SpanContext{}
 	replicateTaskPools[rt.poolIdx].Put(rt)
 }
 
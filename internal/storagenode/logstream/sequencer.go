@@ -7,12 +7,16 @@ import (
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/internal/storagenode/telemetry"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/runner"
 	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 type sequencer struct {
@@ -99,6 +103,10 @@ func (sq *sequencer) sequenceLoopInternal(ctx context.Context, st *sequenceTask)
 	}()
 
 	startTime = time.Now()
+	_, span := telemetry.StartChildSpan(ctx, st.spanContext, "logstream.sequence",
+		trace.WithAttributes(attribute.Int("batch", len(st.awgs))),
+	)
+	defer span.End()
 
 	for dataIdx := 0; dataIdx < len(st.awgs); dataIdx++ {
 		sq.llsn++
@@ -109,9 +117,16 @@ func (sq *sequencer) sequenceLoopInternal(ctx context.Context, st *sequenceTask)
 			st.rts[replicaIdx].llsnList = append(st.rts[replicaIdx].llsnList, sq.llsn)
 		}
 		//nolint:staticcheck
-		if err := st.wb.Set(sq.llsn, st.dataBatch[dataIdx]); err != nil {
+		if err := st.wb.Set(sq.llsn, st.dataBatch[dataIdx], st.checksums[dataIdx]); err != nil {
 			// TODO: handle error
 		}
+		if len(st.headersBatch) > 0 {
+			//nolint:staticcheck
+			if err := st.wb.SetHeaders(sq.llsn, st.headersBatch[dataIdx].Values); err != nil {
+				// TODO: handle error
+			}
+			sq.lse.txns.add(sq.llsn, st.headersBatch[dataIdx].Values, startTime)
+		}
 		// st.dwb.SetLLSN(dataIdx, sq.llsn)
 	}
 
@@ -245,10 +260,15 @@ type sequenceTask struct {
 	wwg  *writeWaitGroup
 	awgs []*appendWaitGroup
 	// dwb  *storage.DeferredWriteBatch
-	wb        *storage.WriteBatch
-	dataBatch [][]byte
-	cwts      *listQueue
-	rts       []*replicateTask
+	wb           *storage.WriteBatch
+	dataBatch    [][]byte
+	checksums    []uint32
+	headersBatch []varlogpb.LogEntryHeaders
+	cwts         *listQueue
+	rts          []*replicateTask
+	// spanContext is the span context of the append. It is invalid if the
+	// append is not traced.
+	spanContext trace.SpanContext
 }
 
 func newSequenceTask() *sequenceTask {
@@ -272,7 +292,10 @@ func (st *sequenceTask) release() {
 	// st.dwb = nil
 	st.wb = nil
 	st.dataBatch = nil
+	st.checksums = nil
+	st.headersBatch = nil
 	st.cwts = nil
 	st.rts = nil
+	st.spanContext = trace.SpanContext{}
 	sequenceTaskPool.Put(st)
 }
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 func TestSequencer_InvalidConfig(t *testing.T) {
@@ -76,6 +77,7 @@ func testSequenceTask(stg *storage.Storage) *sequenceTask {
 
 	st.wb = stg.NewWriteBatch() // .Deferred(0)
 	st.dataBatch = [][]byte{nil}
+	st.checksums = []uint32{varlogpb.Checksum(nil)}
 	// st.dwb.PutData(nil)
 
 	st.cwts = newListQueue()
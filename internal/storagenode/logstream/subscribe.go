@@ -9,6 +9,7 @@ import (
 	"github.com/kakao/varlog/internal/storage"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
 
@@ -64,7 +65,7 @@ func (lse *Executor) newEmptySubscribeResult() (*SubscribeResult, context.Contex
 
 // SubscribeWithGLSN subscribes to the log stream with the given range of GLSNs.
 // TODO: The first argument ctx may not be necessary, since the subscription can be stopped by the `internal/varlogsn/logstream.(*SubscribeResult).Stop()`.
-func (lse *Executor) SubscribeWithGLSN(begin, end types.GLSN) (*SubscribeResult, error) {
+func (lse *Executor) SubscribeWithGLSN(begin, end types.GLSN, opts ...SubscribeOption) (*SubscribeResult, error) {
 	atomic.AddInt64(&lse.inflight, 1)
 	defer atomic.AddInt64(&lse.inflight, -1)
 
@@ -105,14 +106,14 @@ func (lse *Executor) SubscribeWithGLSN(begin, end types.GLSN) (*SubscribeResult,
 	sr.wg.Add(1)
 	go func() {
 		defer sr.wg.Done()
-		sr.err = lse.scanWithGLSN(ctx, begin, end, sr)
+		sr.err = lse.scanWithGLSN(ctx, begin, end, newSubscribeConfig(opts).filter, sr)
 	}()
 	return sr, nil
 }
 
 // SubscribeWithLLSN subscribes to the log stream with the given range of LLSNs.
 // TODO: The first argument ctx may not be necessary, since the subscription can be stopped by the `internal/varlogsn/logstream.(*SubscribeResult).Stop()`.
-func (lse *Executor) SubscribeWithLLSN(begin, end types.LLSN) (*SubscribeResult, error) {
+func (lse *Executor) SubscribeWithLLSN(begin, end types.LLSN, opts ...SubscribeOption) (*SubscribeResult, error) {
 	atomic.AddInt64(&lse.inflight, 1)
 	defer atomic.AddInt64(&lse.inflight, -1)
 
@@ -139,12 +140,12 @@ func (lse *Executor) SubscribeWithLLSN(begin, end types.LLSN) (*SubscribeResult,
 	sr.wg.Add(1)
 	go func() {
 		defer sr.wg.Done()
-		sr.err = lse.scanWithLLSN(ctx, begin, end, sr)
+		sr.err = lse.scanWithLLSN(ctx, begin, end, newSubscribeConfig(opts).filter, sr)
 	}()
 	return sr, nil
 }
 
-func (lse *Executor) scanWithGLSN(ctx context.Context, begin, end types.GLSN, sr *SubscribeResult) error {
+func (lse *Executor) scanWithGLSN(ctx context.Context, begin, end types.GLSN, filter *snpb.SubscribeFilter, sr *SubscribeResult) error {
 	defer close(sr.c)
 	scanBegin := begin
 	scanEnd := end
@@ -167,11 +168,24 @@ func (lse *Executor) scanWithGLSN(ctx context.Context, begin, end types.GLSN, sr
 			le, err := scanner.Value()
 			if err != nil {
 				_ = scanner.Close()
-				return err
+				return lse.checkCorruption(err)
 			}
 			le.TopicID = lse.tpid
 			le.LogStreamID = lse.lsid
 			lastGLSN = le.GLSN
+			// Log entries of aborted transactions are sent as
+			// placeholders like unmatched ones.
+			if !snpb.IsTxnAborted(le.Headers) && filter.Match(le) {
+				filter.Project(&le)
+			} else {
+				le = varlogpb.LogEntry{
+					LogEntryMeta: varlogpb.LogEntryMeta{
+						TopicID:     le.TopicID,
+						LogStreamID: le.LogStreamID,
+						GLSN:        le.GLSN,
+					},
+				}
+			}
 			select {
 			case sr.c <- le:
 			case <-ctx.Done():
@@ -194,7 +208,7 @@ func (lse *Executor) scanWithGLSN(ctx context.Context, begin, end types.GLSN, sr
 	}
 }
 
-func (lse *Executor) scanWithLLSN(ctx context.Context, begin, end types.LLSN, sr *SubscribeResult) error {
+func (lse *Executor) scanWithLLSN(ctx context.Context, begin, end types.LLSN, filter *snpb.SubscribeFilter, sr *SubscribeResult) error {
 	defer close(sr.c)
 	scanBegin := begin
 	for {
@@ -217,11 +231,16 @@ func (lse *Executor) scanWithLLSN(ctx context.Context, begin, end types.LLSN, sr
 			le, err := scanner.Value()
 			if err != nil {
 				_ = scanner.Close()
-				return err
+				return lse.checkCorruption(err)
 			}
 			le.TopicID = lse.tpid
 			le.LogStreamID = lse.lsid
 			lastLLSN = le.LLSN
+			if snpb.IsTxnAborted(le.Headers) || !filter.Match(le) {
+				_ = scanner.Next()
+				continue
+			}
+			filter.Project(&le)
 			select {
 			case sr.c <- le:
 			case <-ctx.Done():
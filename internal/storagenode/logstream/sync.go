@@ -223,6 +223,37 @@ func (lse *Executor) syncLoop(_ context.Context, sc *syncClient, st *syncTracker
 			err = fmt.Errorf("scan: %w", err)
 			return
 		}
+
+		// Commit times of the copied log entries follow them so that the
+		// destination can find them by their commit times. They are clipped
+		// to the copied range since the destination has its own commit times
+		// of log entries before the range.
+		var cts []storage.CommitTime
+		cts, err = lse.stg.ReadCommitTimes(st.syncRange.first.GLSN, st.syncRange.last.GLSN+1)
+		if err != nil {
+			err = fmt.Errorf("commit times: %w", err)
+			return
+		}
+		req.Payload.LogEntry = nil
+		for _, ct := range cts {
+			if ct.GLSNBegin < st.syncRange.first.GLSN {
+				ct.GLSNBegin = st.syncRange.first.GLSN
+			}
+			if ct.GLSNEnd > st.syncRange.last.GLSN+1 {
+				ct.GLSNEnd = st.syncRange.last.GLSN + 1
+			}
+			req.Payload.CommitTime = &snpb.SyncCommitTime{
+				UnixNano:  ct.UnixNano,
+				GLSNBegin: ct.GLSNBegin,
+				GLSNEnd:   ct.GLSNEnd,
+			}
+			err = stream.SendMsg(req)
+			if err != nil {
+				err = fmt.Errorf("sync replicate: commit time %+v: %w", ct, err)
+				return
+			}
+		}
+		req.Payload.CommitTime = nil
 	}
 
 	cc, err = lse.stg.ReadCommitContext()
@@ -240,6 +271,16 @@ func (lse *Executor) syncLoop(_ context.Context, sc *syncClient, st *syncTracker
 		return
 	}
 	req.Payload.LogEntry = nil
+	for _, ps := range lse.producers.list() {
+		ps := ps
+		req.Payload.ProducerState = &ps
+		err = stream.SendMsg(req)
+		if err != nil {
+			err = fmt.Errorf("sync replicate: producer state %s: %w", ps.ProducerID, err)
+			return
+		}
+	}
+	req.Payload.ProducerState = nil
 	req.Payload.CommitContext = &varlogpb.CommitContext{
 		Version:            cc.Version,
 		HighWatermark:      cc.HighWatermark,
@@ -475,7 +516,7 @@ func (lse *Executor) SyncReplicate(_ context.Context, srcReplica varlogpb.LogStr
 		return fmt.Errorf("log stream: sync replicate: incorrect source replica: %s", srcReplica.String())
 	}
 
-	if payload.LogEntry == nil && payload.CommitContext == nil {
+	if payload.LogEntry == nil && payload.ProducerState == nil && payload.CommitTime == nil && payload.CommitContext == nil {
 		lse.esm.store(executorStateSealing)
 		return fmt.Errorf("log stream: sync replicate: empty payload")
 	}
@@ -500,7 +541,20 @@ func (lse *Executor) SyncReplicate(_ context.Context, srcReplica varlogpb.LogStr
 			return err
 		}
 
-		err = batch.SetLogEntry(entry.LLSN, entry.GLSN, entry.Data)
+		// A zero checksum is unknown since sources that do not support
+		// checksums, and log entries written before checksums were
+		// introduced, have none. Such a log entry is stored with the
+		// checksum computed here.
+		checksum := varlogpb.Checksum(entry.Data)
+		if entry.Checksum != 0 && checksum != entry.Checksum {
+			err = fmt.Errorf("log stream: sync replicate: llsn %v, expected checksum %08x, actual %08x: %w", entry.LLSN, entry.Checksum, checksum, verrors.ErrChecksumMismatch)
+			return err
+		}
+		err = batch.SetLogEntry(entry.LLSN, entry.GLSN, entry.Data, checksum)
+		if err != nil {
+			return err
+		}
+		err = batch.SetHeaders(entry.LLSN, entry.Headers)
 		if err != nil {
 			return err
 		}
@@ -514,6 +568,23 @@ func (lse *Executor) SyncReplicate(_ context.Context, srcReplica varlogpb.LogStr
 			GLSN:        entry.GLSN,
 		}
 	}
+	if ps := payload.ProducerState; ps != nil {
+		err = batch.SetProducerState(*ps)
+		if err != nil {
+			return err
+		}
+		lse.logger.Info("log stream: sync replicate: copy", zap.String("producer state", ps.String()))
+	}
+	if ct := payload.CommitTime; ct != nil {
+		err = batch.SetCommitTime(storage.CommitTime{
+			UnixNano:  ct.UnixNano,
+			GLSNBegin: ct.GLSNBegin,
+			GLSNEnd:   ct.GLSNEnd,
+		})
+		if err != nil {
+			return err
+		}
+	}
 	if cc := payload.CommitContext; cc != nil {
 		lastLLSN := cc.CommittedLLSNBegin + types.LLSN(cc.CommittedGLSNEnd-cc.CommittedGLSNBegin) - 1
 		if lastLLSN != uncommittedLLSNBegin-1 {
@@ -543,6 +614,9 @@ func (lse *Executor) SyncReplicate(_ context.Context, srcReplica varlogpb.LogStr
 		return err
 	}
 
+	if ps := payload.ProducerState; ps != nil {
+		lse.producers.put(*ps, time.Now())
+	}
 	if lem != nil {
 		lse.lsc.localLWM.CompareAndSwap(varlogpb.LogSequenceNumber{}, varlogpb.LogSequenceNumber{
 			LLSN: lem.LLSN,
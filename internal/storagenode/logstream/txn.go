@@ -0,0 +1,139 @@
+package logstream
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// txnTracker keeps spans of uncommitted log entries of transactions written
+// to the log stream replica. The replica reports them to the metadata
+// repository, which holds back log entries of a transaction until all of
+// them are written to every participant or the transaction times out.
+//
+// Uncommitted log entries are not recovered after restart, thus the tracker
+// does not need to be persisted.
+type txnTracker struct {
+	mu    sync.Mutex
+	spans []snpb.TxnSpan
+	// last is the raw value of the transaction header of the last span. A log
+	// entry having the same value right after the last span extends it.
+	last []byte
+}
+
+// add registers the log entry at the llsn if it has the transaction header.
+// The deadline of a new span is the argument now plus the timeout of the
+// transaction.
+func (tt *txnTracker) add(llsn types.LLSN, headers map[string][]byte, now time.Time) {
+	value, ok := headers[snpb.TxnHeader]
+	if !ok {
+		return
+	}
+
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	if n := len(tt.spans); n > 0 && tt.spans[n-1].LLSNEnd == llsn && bytes.Equal(tt.last, value) {
+		tt.spans[n-1].LLSNEnd = llsn + 1
+		return
+	}
+	txn, err := snpb.ParseTxn(value)
+	if err != nil {
+		// The primary replica rejects malformed transaction headers.
+		return
+	}
+	tt.spans = append(tt.spans, snpb.TxnSpan{
+		Txn:       txn,
+		LLSNBegin: llsn,
+		LLSNEnd:   llsn + 1,
+		Deadline:  now.Add(txn.Timeout),
+	})
+	tt.last = append(tt.last[:0], value...)
+}
+
+// report returns spans having log entries at or after the argument begin.
+func (tt *txnTracker) report(begin types.LLSN) []snpb.TxnSpan {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	idx := sort.Search(len(tt.spans), func(i int) bool {
+		return tt.spans[i].LLSNEnd > begin
+	})
+	if idx == len(tt.spans) {
+		return nil
+	}
+	return append([]snpb.TxnSpan(nil), tt.spans[idx:]...)
+}
+
+// release drops spans committed or aborted, that is, spans that end at or
+// before the argument end.
+func (tt *txnTracker) release(end types.LLSN) {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	idx := sort.Search(len(tt.spans), func(i int) bool {
+		return tt.spans[i].LLSNEnd > end
+	})
+	tt.spans = append(tt.spans[:0], tt.spans[idx:]...)
+	if len(tt.spans) == 0 {
+		tt.last = tt.last[:0]
+	}
+}
+
+// truncate drops log entries at or after the argument begin, which are
+// discarded by the log stream replica.
+func (tt *txnTracker) truncate(begin types.LLSN) {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+
+	idx := sort.Search(len(tt.spans), func(i int) bool {
+		return tt.spans[i].LLSNEnd > begin
+	})
+	if idx < len(tt.spans) && tt.spans[idx].LLSNBegin < begin {
+		tt.spans[idx].LLSNEnd = begin
+		idx++
+	}
+	tt.spans = tt.spans[:idx]
+	tt.last = tt.last[:0]
+}
+
+// findTxnSpan returns the span that has the log entry at the llsn. The
+// argument spans should be sorted by LLSN.
+func findTxnSpan(spans []snpb.TxnSpan, llsn types.LLSN) (*snpb.TxnSpan, bool) {
+	idx := sort.Search(len(spans), func(i int) bool {
+		return spans[i].LLSNEnd > llsn
+	})
+	if idx < len(spans) && spans[idx].LLSNBegin <= llsn {
+		return &spans[idx], true
+	}
+	return nil, false
+}
+
+// checkTxnHeaders checks that the transaction headers of the log entries are
+// valid and that the transaction includes the log stream as a participant.
+func (lse *Executor) checkTxnHeaders(headersBatch []varlogpb.LogEntryHeaders) error {
+	for i := range headersBatch {
+		if snpb.IsTxnAborted(headersBatch[i].Values) {
+			return fmt.Errorf("log stream: append: reserved header %s: %w", snpb.TxnAbortedHeader, verrors.ErrInvalid)
+		}
+		value, ok := headersBatch[i].Values[snpb.TxnHeader]
+		if !ok {
+			continue
+		}
+		txn, err := snpb.ParseTxn(value)
+		if err != nil {
+			return fmt.Errorf("log stream: append: %v: %w", err, verrors.ErrInvalid)
+		}
+		if _, ok := txn.Participant(lse.tpid, lse.lsid); !ok {
+			return fmt.Errorf("log stream: append: not a participant of transaction %s: %w", txn.ID, verrors.ErrInvalid)
+		}
+	}
+	return nil
+}
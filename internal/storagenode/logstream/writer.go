@@ -6,8 +6,10 @@ import (
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/codes"
 	"go.uber.org/zap"
 
+	"github.com/kakao/varlog/internal/storagenode/telemetry"
 	"github.com/kakao/varlog/pkg/util/runner"
 	"github.com/kakao/varlog/pkg/verrors"
 )
@@ -80,11 +82,17 @@ func (w *writer) writeLoop(ctx context.Context) {
 }
 
 // writeLoopInternal stores a batch of writes to the storage and modifies uncommittedLLSNEnd of the log stream which presents the next expected LLSN to be written.
-func (w *writer) writeLoopInternal(_ context.Context, st *sequenceTask) {
+func (w *writer) writeLoopInternal(ctx context.Context, st *sequenceTask) {
 	startTime := time.Now()
+	_, span := telemetry.StartChildSpan(ctx, st.spanContext, "logstream.write")
 	var err error
 	cnt := len(st.awgs)
 	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
 		st.wwg.done(err)
 		// _ = st.dwb.Close()
 		_ = st.wb.Close()
@@ -7,10 +7,15 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/kakao/varlog/internal/storagenode/logstream"
+	"github.com/kakao/varlog/internal/storagenode/telemetry"
+	vtelemetry "github.com/kakao/varlog/pkg/util/telemetry"
 	"github.com/kakao/varlog/proto/snpb"
 )
 
@@ -167,7 +172,7 @@ func (rs *replicationServer) replicate(ctx context.Context, requestC <-chan *rep
 
 			atomic.AddInt64(&lse.Metrics().ReplicateServerOperations, 1)
 
-			err = lse.Replicate(ctx, rst.req.LLSN, rst.req.Data)
+			err = rs.replicateOne(ctx, lse, &rst.req)
 			if err != nil {
 				rst.release()
 				return
@@ -177,3 +182,26 @@ func (rs *replicationServer) replicate(ctx context.Context, requestC <-chan *rep
 	}()
 	return errC
 }
+
+// replicateOne replicates a batch of the request to the log stream. If the
+// append being replicated is traced, it continues the trace; it does not start
+// a new trace otherwise.
+func (rs *replicationServer) replicateOne(ctx context.Context, lse *logstream.Executor, req *snpb.ReplicateRequest) (err error) {
+	parent := trace.SpanContextFromContext(vtelemetry.ExtractTraceContext(ctx, req.TraceContext))
+	ctx, span := telemetry.StartChildSpan(ctx, parent, "snpb.Replicator/Replicate",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.Int("tpid", int(req.TopicID)),
+			attribute.Int("lsid", int(req.LogStreamID)),
+			attribute.Int("batch", len(req.LLSN)),
+		),
+	)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+	return lse.Replicate(ctx, req.LLSN, req.Data, req.Checksums, req.Headers, req.ProducerID, req.Sequence)
+}
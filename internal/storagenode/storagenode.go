@@ -3,10 +3,13 @@ package storagenode
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -25,6 +28,7 @@ import (
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 
+	"github.com/kakao/varlog/internal/backup"
 	"github.com/kakao/varlog/internal/storage"
 	snerrors "github.com/kakao/varlog/internal/storagenode/errors"
 	"github.com/kakao/varlog/internal/storagenode/executorsmap"
@@ -32,9 +36,11 @@ import (
 	"github.com/kakao/varlog/internal/storagenode/pprof"
 	"github.com/kakao/varlog/internal/storagenode/telemetry"
 	"github.com/kakao/varlog/internal/storagenode/volume"
+	"github.com/kakao/varlog/pkg/rpc"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/fputil"
 	"github.com/kakao/varlog/pkg/util/netutil"
+	"github.com/kakao/varlog/proto/admpb"
 	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
@@ -54,6 +60,7 @@ type StorageNode struct {
 	mu           sync.RWMutex
 	lis          net.Listener
 	server       *grpc.Server
+	handlers     *rpc.HandlerGroup
 	healthServer *health.Server
 	closed       bool
 	closedC      chan struct{}
@@ -92,6 +99,11 @@ func NewStorageNode(opts ...Option) (*StorageNode, error) {
 	if len(snPaths) == 0 {
 		return nil, errors.New("storage node: no valid storage node path")
 	}
+	if len(cfg.restoreFrom) > 0 {
+		if err := restoreLogStreamReplicas(cfg, snPaths); err != nil {
+			return nil, err
+		}
+	}
 
 	dataDirs, err := volume.ReadVolumes(cfg.volumes)
 	if err != nil {
@@ -99,11 +111,13 @@ func NewStorageNode(opts ...Option) (*StorageNode, error) {
 	}
 	dataDirs = filterValidDataDirectories(dataDirs, cfg.cid, cfg.snid, cfg.logger)
 
+	handlers := &rpc.HandlerGroup{}
 	grpcServer := grpc.NewServer(
 		grpc.ReadBufferSize(int(cfg.grpcServerReadBufferSize)),
 		grpc.WriteBufferSize(int(cfg.grpcServerWriteBufferSize)),
 		grpc.MaxRecvMsgSize(int(cfg.grpcServerMaxRecvMsgSize)),
 		grpcmiddleware.WithUnaryServerChain(
+			handlers.UnaryServerInterceptor(),
 			grpcctxtags.UnaryServerInterceptor(),
 			grpczap.UnaryServerInterceptor(cfg.logger, grpczap.WithDecider(
 				func(fullMethodName string, err error) bool {
@@ -114,12 +128,14 @@ func NewStorageNode(opts ...Option) (*StorageNode, error) {
 				return grpcPayloadLogAllowList[fullMethodName]
 			}),
 		),
+		grpc.StreamInterceptor(handlers.StreamServerInterceptor()),
 	)
 
 	sn := &StorageNode{
 		config:       cfg,
 		executors:    executorsmap.New(hintNumExecutors),
 		server:       grpcServer,
+		handlers:     handlers,
 		healthServer: health.NewServer(),
 		closedC:      make(chan struct{}),
 		snPaths:      snPaths,
@@ -137,6 +153,36 @@ func NewStorageNode(opts ...Option) (*StorageNode, error) {
 	return sn, nil
 }
 
+// restoreLogStreamReplicas copies the log stream replicas of the storage node
+// from the backup directory into the storage node paths.
+func restoreLogStreamReplicas(cfg config, snPaths []string) error {
+	manifest, err := backup.ReadManifest(cfg.restoreFrom)
+	if err != nil {
+		return fmt.Errorf("storage node: restore: %w", err)
+	}
+	if manifest.ClusterID != cfg.cid {
+		return fmt.Errorf("storage node: restore: cluster id %d, expected %d", manifest.ClusterID, cfg.cid)
+	}
+	// Segments of the copies belong to the backup; thus, each restored
+	// replica copies them under its own prefix before being loaded.
+	prepare := func(replica admpb.BackupReplica, dir string) error {
+		stgOpts := make([]storage.Option, len(cfg.defaultStorageOptions))
+		copy(stgOpts, cfg.defaultStorageOptions)
+		stgOpts = append(stgOpts, storage.WithColdTierPrefix(coldTierPrefix(cfg.cid, cfg.snid, replica.TopicID, replica.LogStreamID)))
+		return storage.CopyColdTier(dir, stgOpts...)
+	}
+	restored, err := backup.RestoreReplicas(cfg.restoreFrom, manifest, cfg.snid, snPaths, prepare)
+	if err != nil {
+		return fmt.Errorf("storage node: restore: %w", err)
+	}
+	cfg.logger.Info("restore log stream replicas",
+		zap.String("backup", cfg.restoreFrom),
+		zap.Uint64("version", uint64(manifest.Version)),
+		zap.Int("restored", restored),
+	)
+	return nil
+}
+
 func filterValidDataDirectories(dataDirs []volume.DataDir, cid types.ClusterID, snid types.StorageNodeID, logger *zap.Logger) []volume.DataDir {
 	ret := make([]volume.DataDir, 0, len(dataDirs))
 	for _, dd := range dataDirs {
@@ -227,21 +273,30 @@ func (sn *StorageNode) Serve() error {
 
 func (sn *StorageNode) Close() (err error) {
 	sn.mu.Lock()
-	defer sn.mu.Unlock()
 	if sn.closed {
+		sn.mu.Unlock()
 		return nil
 	}
 	sn.closed = true
 	close(sn.closedC)
+	sn.mu.Unlock()
 
 	sn.mux.Close()
 	sn.pprofServer.Close(context.Background())
 	sn.healthServer.Shutdown()
+	// Stopping the server cancels running RPCs, but some of their handlers,
+	// for instance, Append, return only after the log stream replicas are
+	// closed. Hence, it closes the replicas and then waits for the handlers
+	// so that none of them outlives the storage node.
+	sn.server.Stop()
+
+	sn.mu.Lock()
 	sn.executors.Range(func(_ types.LogStreamID, _ types.TopicID, lse *logstream.Executor) bool {
 		err = multierr.Append(err, lse.Close())
 		return true
 	})
-	sn.server.Stop() // TODO: sn.server.GracefulStop() -> need not to use mutex
+	sn.mu.Unlock()
+	sn.handlers.Wait()
 	sn.logger.Info("closed")
 	return err
 }
@@ -262,6 +317,7 @@ func (sn *StorageNode) getMetadata(_ context.Context) (*snpb.StorageNodeMetadata
 			},
 			Status:    varlogpb.StorageNodeStatusRunning, // TODO (jun), Ready, Running, Stopping,
 			StartTime: sn.startTime,
+			Tags:      sn.tags,
 		}
 
 		for _, path := range sn.snPaths {
@@ -316,13 +372,7 @@ func (sn *StorageNode) runLogStreamReplica(_ context.Context, tpid types.TopicID
 		return nil, err
 	}
 
-	stgOpts := make([]storage.Option, len(sn.defaultStorageOptions))
-	copy(stgOpts, sn.defaultStorageOptions)
-	stgOpts = append(stgOpts,
-		storage.WithPath(lsPath),
-		storage.WithLogger(sn.logger.Named("storage").With(zap.String("path", lsPath))),
-	)
-	stg, err := storage.New(stgOpts...)
+	stg, err := storage.New(sn.storageOptions(tpid, lsid, lsPath)...)
 	if err != nil {
 		return nil, err
 	}
@@ -354,6 +404,25 @@ func (sn *StorageNode) runLogStreamReplica(_ context.Context, tpid types.TopicID
 	return lse, nil
 }
 
+// storageOptions returns options of the storage for the log stream replica in
+// the directory lsPath.
+func (sn *StorageNode) storageOptions(tpid types.TopicID, lsid types.LogStreamID, lsPath string) []storage.Option {
+	stgOpts := make([]storage.Option, len(sn.defaultStorageOptions))
+	copy(stgOpts, sn.defaultStorageOptions)
+	return append(stgOpts,
+		storage.WithPath(lsPath),
+		storage.WithLogger(sn.logger.Named("storage").With(zap.String("path", lsPath))),
+		storage.WithColdTierPrefix(coldTierPrefix(sn.cid, sn.snid, tpid, lsid)),
+	)
+}
+
+// coldTierPrefix returns the prefix in the cold tier for segments of the log
+// stream replica. Replicas sharing the cold tier are distinguished by the
+// storage node and log stream.
+func coldTierPrefix(cid types.ClusterID, snid types.StorageNodeID, tpid types.TopicID, lsid types.LogStreamID) string {
+	return path.Join(volume.StorageNodeDirName(cid, snid), volume.LogStreamDirName(tpid, lsid))
+}
+
 func (sn *StorageNode) removeLogStreamReplica(_ context.Context, tpid types.TopicID, lsid types.LogStreamID) error {
 	sn.mu.RLock()
 	defer sn.mu.RUnlock()
@@ -421,6 +490,103 @@ func (sn *StorageNode) sync(ctx context.Context, tpid types.TopicID, lsid types.
 	return lse.Sync(ctx, dst)
 }
 
+// checkpoint copies the log stream replica into the directory dir at the
+// commit result, and its segments in the cold tier under the prefix
+// coldTierPrefix. It returns the absolute path of the copy.
+func (sn *StorageNode) checkpoint(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, dir, coldTierPrefix string, commitResult snpb.LogStreamCommitResult) (string, error) {
+	// It does not hold the mutex while waiting for the replica to commit, so
+	// as not to block closing the storage node. The replica stops the
+	// checkpoint once it is closed.
+	lse, err := sn.loadExecutor(tpid, lsid)
+	if err != nil {
+		return "", err
+	}
+
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(dir); err == nil {
+		return "", snerrors.ErrExist
+	}
+	if err := lse.Checkpoint(ctx, dir, coldTierPrefix, commitResult); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// checkBackupPath checks whether the storage node can access the backup
+// directory dir having the probe file with the token.
+func (sn *StorageNode) checkBackupPath(dir, token string) error {
+	sn.mu.RLock()
+	defer sn.mu.RUnlock()
+	if sn.closed {
+		return snerrors.ErrClosed
+	}
+	return backup.CheckProbe(dir, token)
+}
+
+// export writes the archive of the log stream replica into the writer w.
+func (sn *StorageNode) export(tpid types.TopicID, lsid types.LogStreamID, w io.Writer) error {
+	// Like checkpoint, it does not hold the mutex while streaming the
+	// archive to a possibly slow reader.
+	lse, err := sn.loadExecutor(tpid, lsid)
+	if err != nil {
+		return err
+	}
+	_, err = lse.Export(w)
+	return err
+}
+
+// importLogStreamReplica restores the archive read from r into a new log
+// stream replica in the storage node path snPath and adds the replica to the
+// storage node. The archive is restored with the same storage options as
+// other replicas of the storage node.
+func (sn *StorageNode) importLogStreamReplica(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, snPath string, r io.Reader) (snpb.LogStreamReplicaMetadataDescriptor, error) {
+	// Like export, it does not hold the mutex while reading the archive from
+	// a possibly slow writer.
+	if _, err := sn.loadExecutor(tpid, lsid); !errors.Is(err, snerrors.ErrNotExist) {
+		if err == nil {
+			err = snerrors.ErrExist
+		}
+		return snpb.LogStreamReplicaMetadataDescriptor{}, err
+	}
+
+	lsPath := path.Join(snPath, volume.LogStreamDirName(tpid, lsid))
+	if _, err := os.Stat(lsPath); !errors.Is(err, os.ErrNotExist) {
+		if err == nil {
+			err = fmt.Errorf("storage node: import: %s: %w", lsPath, snerrors.ErrExist)
+		}
+		return snpb.LogStreamReplicaMetadataDescriptor{}, err
+	}
+	stg, err := storage.New(sn.storageOptions(tpid, lsid, lsPath)...)
+	if err != nil {
+		return snpb.LogStreamReplicaMetadataDescriptor{}, err
+	}
+	_, err = stg.Import(r)
+	if err = multierr.Append(err, stg.Close()); err != nil {
+		_ = os.RemoveAll(lsPath)
+		return snpb.LogStreamReplicaMetadataDescriptor{}, fmt.Errorf("storage node: import: %w", err)
+	}
+	return sn.addLogStreamReplica(ctx, tpid, lsid, snPath)
+}
+
+// loadExecutor returns the executor of the log stream replica. The caller uses
+// the executor without holding the mutex; hence, it must handle the executor
+// closed meanwhile.
+func (sn *StorageNode) loadExecutor(tpid types.TopicID, lsid types.LogStreamID) (*logstream.Executor, error) {
+	sn.mu.RLock()
+	defer sn.mu.RUnlock()
+	if sn.closed {
+		return nil, snerrors.ErrClosed
+	}
+	lse, loaded := sn.executors.Load(tpid, lsid)
+	if !loaded {
+		return nil, snerrors.ErrNotExist
+	}
+	return lse, nil
+}
+
 func (sn *StorageNode) trim(ctx context.Context, topicID types.TopicID, lastGLSN types.GLSN) map[types.LogStreamID]string {
 	ret := make(map[types.LogStreamID]string)
 	sn.executors.Range(func(lsid types.LogStreamID, tpid types.TopicID, lse *logstream.Executor) bool {
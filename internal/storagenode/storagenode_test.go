@@ -1,6 +1,7 @@
 package storagenode
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"io/fs"
@@ -508,6 +509,33 @@ func TestStorageNode_Append(t *testing.T) {
 				require.Equal(t, codes.NotFound, status.Code(err))
 			},
 		},
+		{
+			name: "UnmatchedChecksums",
+			testf: func(t *testing.T, _ string, lc snpb.LogIOClient) {
+				_, err := lc.Append(context.Background(), &snpb.AppendRequest{
+					TopicID:     tpid,
+					LogStreamID: lsid,
+					Payload:     payload,
+					Checksums:   []uint32{0, 0},
+				})
+				require.Error(t, err)
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "ChecksumMismatch",
+			testf: func(t *testing.T, _ string, lc snpb.LogIOClient) {
+				_, err := lc.Append(context.Background(), &snpb.AppendRequest{
+					TopicID:     tpid,
+					LogStreamID: lsid,
+					Payload:     [][]byte{[]byte("corrupted")},
+					Checksums:   []uint32{varlogpb.Checksum([]byte("original"))},
+				})
+				require.Error(t, err)
+				require.Equal(t, codes.DataLoss, status.Code(err))
+				require.ErrorIs(t, verrors.FromStatusError(err), verrors.ErrChecksumMismatch)
+			},
+		},
 		{
 			name: "NotPrimary",
 			testf: func(t *testing.T, addr string, lc snpb.LogIOClient) {
@@ -2502,13 +2530,13 @@ func TestStorageNode_Trim(t *testing.T) {
 				defer closer()
 
 				// Subscribe: AlreadyTrimmed
-				res, err := c.Subscribe(context.Background(), tpid, lsid1, 1, 7)
+				res, err := c.Subscribe(context.Background(), tpid, lsid1, 1, 7, nil)
 				require.NoError(t, err)
 				sr := <-res
 				require.ErrorIs(t, sr.Error, verrors.ErrTrimmed) // TODO: Use gRPC status code.
 
 				// SubscribeTo: AlreadyTrimmed
-				res, err = c.SubscribeTo(context.Background(), tpid, lsid1, 1, 4)
+				res, err = c.SubscribeTo(context.Background(), tpid, lsid1, 1, 4, nil)
 				require.NoError(t, err)
 				sr = <-res
 				require.ErrorIs(t, sr.Error, verrors.ErrTrimmed) // TODO: Use gRPC status code.
@@ -2538,7 +2566,7 @@ func TestStorageNode_Trim(t *testing.T) {
 				defer closer()
 
 				// Subscribe: AlreadyTrimmed
-				res, err := c.Subscribe(context.Background(), tpid, lsid1, 1, 7)
+				res, err := c.Subscribe(context.Background(), tpid, lsid1, 1, 7, nil)
 				require.NoError(t, err)
 				sr := <-res
 				// Because the log stream executor cannot know the global low watermark after a restart, it returns an io.EOF error instead of ErrTrimmed. Note that the log stream executor keeps the global low watermark in memory.
@@ -2546,20 +2574,20 @@ func TestStorageNode_Trim(t *testing.T) {
 				require.ErrorIs(t, io.EOF, sr.Error)
 
 				// SubscribeTo: AlreadyTrimmed
-				res, err = c.SubscribeTo(context.Background(), tpid, lsid1, 1, 4)
+				res, err = c.SubscribeTo(context.Background(), tpid, lsid1, 1, 4, nil)
 				require.NoError(t, err)
 				sr = <-res
 				// Unlike SubscribeWithGLSN, the SubscribeWithLLSN can decide if the prefix log entries were trimmed after a restart.
 				require.ErrorIs(t, sr.Error, verrors.ErrTrimmed) // TODO: Use gRPC status code.
 
 				// Subscribe: No logs
-				res, err = c.Subscribe(context.Background(), tpid, lsid1, 10, 21)
+				res, err = c.Subscribe(context.Background(), tpid, lsid1, 10, 21, nil)
 				require.NoError(t, err)
 				sr = <-res
 				require.ErrorIs(t, io.EOF, sr.Error)
 
 				// Subscribe: 7, 9
-				res, err = c.Subscribe(context.Background(), tpid, lsid1, 7, 10)
+				res, err = c.Subscribe(context.Background(), tpid, lsid1, 7, 10, nil)
 				require.NoError(t, err)
 				for llsn := 4; llsn <= 5; llsn++ {
 					glsn := llsn*2 - 1
@@ -2571,7 +2599,7 @@ func TestStorageNode_Trim(t *testing.T) {
 				require.ErrorIs(t, io.EOF, sr.Error)
 
 				// SubscribeTo: 4, 5
-				res, err = c.SubscribeTo(context.Background(), tpid, lsid1, 4, 6)
+				res, err = c.SubscribeTo(context.Background(), tpid, lsid1, 4, 6, nil)
 				require.NoError(t, err)
 				for llsn := 4; llsn <= 5; llsn++ {
 					sr = <-res
@@ -2581,25 +2609,25 @@ func TestStorageNode_Trim(t *testing.T) {
 				require.ErrorIs(t, io.EOF, sr.Error)
 
 				// Subscribe: AlreadyTrimmed
-				res, err = c.Subscribe(context.Background(), tpid, lsid2, 1, 6)
+				res, err = c.Subscribe(context.Background(), tpid, lsid2, 1, 6, nil)
 				require.NoError(t, err)
 				sr = <-res
 				require.ErrorIs(t, io.EOF, sr.Error)
 
 				// SubscribeTo: AlreadyTrimmed
-				res, err = c.SubscribeTo(context.Background(), tpid, lsid2, 1, 3)
+				res, err = c.SubscribeTo(context.Background(), tpid, lsid2, 1, 3, nil)
 				require.NoError(t, err)
 				sr = <-res
 				require.ErrorIs(t, sr.Error, verrors.ErrTrimmed) // TODO: Use gRPC status code.
 
 				// Subscribe: No logs
-				res, err = c.Subscribe(context.Background(), tpid, lsid2, 11, 21)
+				res, err = c.Subscribe(context.Background(), tpid, lsid2, 11, 21, nil)
 				require.NoError(t, err)
 				sr = <-res
 				require.ErrorIs(t, io.EOF, sr.Error)
 
 				// Subscribe: 6, 8, 10
-				res, err = c.Subscribe(context.Background(), tpid, lsid2, 6, 11)
+				res, err = c.Subscribe(context.Background(), tpid, lsid2, 6, 11, nil)
 				require.NoError(t, err)
 				for llsn := 3; llsn <= 5; llsn++ {
 					glsn := llsn * 2
@@ -2611,7 +2639,7 @@ func TestStorageNode_Trim(t *testing.T) {
 				require.ErrorIs(t, io.EOF, sr.Error)
 
 				// SubscribeTo: 3, 4, 5
-				res, err = c.SubscribeTo(context.Background(), tpid, lsid2, 3, 6)
+				res, err = c.SubscribeTo(context.Background(), tpid, lsid2, 3, 6, nil)
 				require.NoError(t, err)
 				for llsn := 3; llsn <= 5; llsn++ {
 					sr = <-res
@@ -2665,7 +2693,7 @@ func TestStorageNode_Trim(t *testing.T) {
 				defer closer()
 
 				// Subscribe: AlreadyTrimmed
-				res, err := c.Subscribe(context.Background(), tpid, lsid1, 1, 10)
+				res, err := c.Subscribe(context.Background(), tpid, lsid1, 1, 10, nil)
 				require.NoError(t, err)
 				sr := <-res
 				// Because the log stream executor cannot know the global low watermark after a restart, it returns an io.EOF error instead of ErrTrimmed. Note that the log stream executor keeps the global low watermark in memory.
@@ -2673,32 +2701,32 @@ func TestStorageNode_Trim(t *testing.T) {
 				require.ErrorIs(t, io.EOF, sr.Error)
 
 				// SubscribeTo: AlreadyTrimmed
-				res, err = c.SubscribeTo(context.Background(), tpid, lsid1, 1, 6)
+				res, err = c.SubscribeTo(context.Background(), tpid, lsid1, 1, 6, nil)
 				require.NoError(t, err)
 				sr = <-res
 				// Unlike SubscribeWithGLSN, the SubscribeWithLLSN can decide if the prefix log entries were trimmed after a restart.
 				require.ErrorIs(t, sr.Error, verrors.ErrTrimmed) // TODO: Use gRPC status code.
 
 				// Subscribe: No Logs
-				res, err = c.Subscribe(context.Background(), tpid, lsid1, 10, 21)
+				res, err = c.Subscribe(context.Background(), tpid, lsid1, 10, 21, nil)
 				require.NoError(t, err)
 				sr = <-res
 				require.ErrorIs(t, io.EOF, sr.Error)
 
 				// Subscribe: AlreadyTrimmed
-				res, err = c.Subscribe(context.Background(), tpid, lsid2, 2, 11)
+				res, err = c.Subscribe(context.Background(), tpid, lsid2, 2, 11, nil)
 				require.NoError(t, err)
 				sr = <-res
 				require.ErrorIs(t, io.EOF, sr.Error)
 
 				// SubscribeTo: AlreadyTrimmed
-				res, err = c.SubscribeTo(context.Background(), tpid, lsid2, 1, 6)
+				res, err = c.SubscribeTo(context.Background(), tpid, lsid2, 1, 6, nil)
 				require.NoError(t, err)
 				sr = <-res
 				require.ErrorIs(t, sr.Error, verrors.ErrTrimmed) // TODO: Use gRPC status code.
 
 				// Subscribe: No Logs
-				res, err = c.Subscribe(context.Background(), tpid, lsid2, 11, 21)
+				res, err = c.Subscribe(context.Background(), tpid, lsid2, 11, 21, nil)
 				require.NoError(t, err)
 				sr = <-res
 				require.ErrorIs(t, io.EOF, sr.Error)
@@ -2889,3 +2917,104 @@ func TestStorageNode_Trim(t *testing.T) {
 		})
 	}
 }
+
+func TestStorageNode_Export(t *testing.T) {
+	const (
+		cid     = types.ClusterID(1)
+		snid    = types.StorageNodeID(2)
+		tpid    = types.TopicID(3)
+		lsid    = types.LogStreamID(4)
+		numLogs = 3
+	)
+
+	sn := TestNewSimpleStorageNode(t, WithClusterID(cid), WithStorageNodeID(snid))
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = sn.Serve()
+	}()
+	defer func() {
+		require.NoError(t, sn.Close())
+		wg.Wait()
+	}()
+	TestWaitForStartingOfServe(t, sn)
+
+	addr := sn.advertise
+	replicas := []varlogpb.LogStreamReplica{{
+		StorageNode:    varlogpb.StorageNode{StorageNodeID: snid, Address: addr},
+		TopicLogStream: varlogpb.TopicLogStream{TopicID: tpid, LogStreamID: lsid},
+	}}
+	TestAddLogStreamReplica(t, cid, snid, tpid, lsid, sn.snPaths[0], addr)
+
+	mc, mcClose := TestNewManagementClient(t, cid, snid, addr)
+	defer mcClose()
+
+	// no such log stream
+	err := mc.Export(context.Background(), tpid, lsid+1, io.Discard)
+	require.Error(t, err)
+
+	TestSealLogStreamReplica(t, cid, snid, tpid, lsid, types.InvalidGLSN, addr)
+	TestUnsealLogStreamReplica(t, cid, snid, tpid, lsid, replicas, addr)
+
+	dataBatch := make([][]byte, numLogs)
+	for i := range dataBatch {
+		dataBatch[i] = []byte("foo")
+	}
+	var appendWg sync.WaitGroup
+	appendWg.Add(1)
+	go func() {
+		defer appendWg.Done()
+		TestAppend(t, tpid, lsid, dataBatch, replicas)
+	}()
+	require.Eventually(t, func() bool {
+		reportcommitter.TestCommit(t, addr, snpb.CommitRequest{
+			StorageNodeID: snid,
+			CommitResult: snpb.LogStreamCommitResult{
+				TopicID:             tpid,
+				LogStreamID:         lsid,
+				CommittedLLSNOffset: types.MinLLSN,
+				CommittedGLSNOffset: types.MinGLSN,
+				CommittedGLSNLength: numLogs,
+				Version:             1,
+				HighWatermark:       numLogs,
+			},
+		})
+		reports := reportcommitter.TestGetReport(t, addr)
+		return len(reports) == 1 && reports[0].Version == 1
+	}, time.Second, 10*time.Millisecond)
+	appendWg.Wait()
+
+	var buf bytes.Buffer
+	require.NoError(t, mc.Export(context.Background(), tpid, lsid, &buf))
+	cc, err := storage.VerifyArchive(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(numLogs+1), cc.CommittedGLSNEnd)
+
+	stg := storage.TestNewStorage(t)
+	defer func() {
+		require.NoError(t, stg.Close())
+	}()
+	_, err = stg.Import(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	for glsn := types.MinGLSN; glsn <= numLogs; glsn++ {
+		le, err := stg.Read(storage.AtGLSN(glsn))
+		require.NoError(t, err)
+		require.Equal(t, []byte("foo"), le.Data)
+	}
+
+	// import through the storage node
+	_, err = mc.Import(context.Background(), tpid, lsid+1, "/no/such/path", bytes.NewReader(buf.Bytes()))
+	require.ErrorContains(t, err, "no storage node path")
+	_, err = mc.Import(context.Background(), tpid, lsid, "", bytes.NewReader(buf.Bytes()))
+	require.ErrorContains(t, err, "already exist")
+	_, err = mc.Import(context.Background(), tpid, lsid+1, "", bytes.NewReader(buf.Bytes()[:buf.Len()/2]))
+	require.Error(t, err)
+
+	lsrmd, err := mc.Import(context.Background(), tpid, lsid+1, "", bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, lsid+1, lsrmd.LogStreamID)
+	require.Equal(t, types.LLSN(numLogs), lsrmd.LocalHighWatermark.LLSN)
+	require.Equal(t, types.GLSN(numLogs), lsrmd.LocalHighWatermark.GLSN)
+	require.Equal(t, sn.snPaths[0], filepath.Dir(lsrmd.Path))
+}
@@ -0,0 +1,29 @@
+package telemetry
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "varlogsn"
+
+// Tracer returns the tracer of storage nodes. It is looked up from the global
+// tracer provider every time; thus, it follows the provider set after storage
+// nodes start.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// StartChildSpan starts a span as a child of the argument parent, which is the
+// span context of an append handed over between goroutines, for instance,
+// stages of a log stream executor. Unlike Tracer().Start, it does not start a
+// root span: if the parent is invalid, that is, the append is not traced, it
+// returns a non-recording span.
+func StartChildSpan(ctx context.Context, parent trace.SpanContext, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if !parent.IsValid() {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	return Tracer().Start(trace.ContextWithSpanContext(ctx, parent), name, opts...)
+}
@@ -139,7 +139,7 @@ func TestAppend(t *testing.T, tpid types.TopicID, lsid types.LogStreamID, dataBa
 			Address:       replica.Address,
 		})
 	}
-	res, err := lc.Append(context.Background(), tpid, lsid, dataBatch, backups...)
+	res, err := lc.Append(context.Background(), tpid, lsid, dataBatch, nil, backups...)
 	assert.NoError(t, err)
 	return res
 }
@@ -148,7 +148,7 @@ func TestSubscribe(t *testing.T, tpid types.TopicID, lsid types.LogStreamID, beg
 	lc, closer := TestNewLogIOClient(t, snid, addr)
 	defer closer()
 
-	ch, err := lc.Subscribe(context.Background(), tpid, lsid, begin, end)
+	ch, err := lc.Subscribe(context.Background(), tpid, lsid, begin, end, nil)
 	assert.NoError(t, err)
 
 	var les []varlogpb.LogEntry
@@ -167,7 +167,7 @@ func TestSubscribeTo(t *testing.T, tpid types.TopicID, lsid types.LogStreamID, b
 	lc, closer := TestNewLogIOClient(t, snid, addr)
 	defer closer()
 
-	ch, err := lc.SubscribeTo(context.Background(), tpid, lsid, begin, end)
+	ch, err := lc.SubscribeTo(context.Background(), tpid, lsid, begin, end, nil)
 	assert.NoError(t, err)
 
 	var les []varlogpb.LogEntry
@@ -4,7 +4,6 @@ import (
 	"context"
 	"io"
 	"log"
-	"time"
 
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -14,13 +13,9 @@ import (
 	"github.com/kakao/varlog/proto/varlogpb"
 )
 
-const (
-	subscribeTimeout = 5 * time.Second
-)
-
-func Subscribe(mrAddrs []string, clusterID types.ClusterID, topicID types.TopicID) error {
-	const size = 10
-
+// Subscribe follows the topic from the position from, and prints log entries
+// until the subscription fails.
+func Subscribe(mrAddrs []string, clusterID types.ClusterID, topicID types.TopicID, from varlog.Position) error {
 	vlog, err := open(mrAddrs, clusterID)
 	if err != nil {
 		return err
@@ -29,38 +24,23 @@ func Subscribe(mrAddrs []string, clusterID types.ClusterID, topicID types.TopicI
 		_ = vlog.Close()
 	}()
 
-	for begin := types.MinGLSN; begin < types.MaxGLSN; begin += size {
-		if err := subscribe(vlog, topicID, begin, begin+size); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func subscribe(vlog varlog.Log, topicID types.TopicID, begin, end types.GLSN) error {
-	errC := make(chan error)
+	errC := make(chan error, 1)
 	onNext := func(logEntry varlogpb.LogEntry, err error) {
 		if err != nil {
-			errC <- err
-			close(errC)
+			select {
+			case errC <- err:
+			default:
+			}
 			return
 		}
 		log.Printf("Subscribe: %s (%+v)", string(logEntry.Data), logEntry)
 	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), subscribeTimeout)
-	defer cancel()
-	closer, err := vlog.Subscribe(ctx, topicID, begin, end, onNext)
+	closer, err := vlog.Follow(context.Background(), topicID, from, onNext)
 	if err != nil {
 		return errors.WithMessage(err, "could not subscribe")
 	}
 	defer closer()
-	select {
-	case err = <-errC:
-	case <-ctx.Done():
-		return ctx.Err()
-	}
-	if err == nil || errors.Is(err, io.EOF) {
+	if err = <-errC; err == nil || errors.Is(err, io.EOF) {
 		return nil
 	}
 	return errors.WithMessage(err, "could not subscribe")
@@ -0,0 +1,15 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/kakao/varlog/internal/varlogctl"
+	"github.com/kakao/varlog/pkg/varlog"
+)
+
+// Backup takes a point-in-time backup of the cluster into the directory path.
+func Backup(path string) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		return adm.Backup(ctx, path)
+	}
+}
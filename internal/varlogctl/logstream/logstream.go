@@ -2,7 +2,14 @@ package logstream
 
 import (
 	"context"
+	"fmt"
+	"os"
 
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/internal/storagenode/client"
 	"github.com/kakao/varlog/internal/varlogctl"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/varlog"
@@ -40,3 +47,106 @@ func Sync(tpid types.TopicID, lsid types.LogStreamID, src, dst types.StorageNode
 		return adm.Sync(ctx, tpid, lsid, src, dst)
 	}
 }
+
+// ExportResult is the result of Export and Import.
+type ExportResult struct {
+	Path          string                `json:"path"`
+	CommitContext storage.CommitContext `json:"commitContext"`
+}
+
+// Export writes committed log entries of the log stream into the file output
+// as an archive. The storage node snid streams the archive from its replica of
+// the log stream, so log entries in the cold tier are exported as well. If the
+// argument snid is zero, the first replica of the log stream is used. The
+// archive is verified after it is written.
+func Export(tpid types.TopicID, lsid types.LogStreamID, snid types.StorageNodeID, output string) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		if snid == 0 {
+			lsd, err := adm.GetLogStream(ctx, tpid, lsid)
+			if err != nil {
+				return nil, err
+			}
+			if len(lsd.Replicas) == 0 {
+				return nil, fmt.Errorf("export: log stream %d has no replica", lsid)
+			}
+			snid = lsd.Replicas[0].StorageNodeID
+		}
+		snm, err := adm.GetStorageNode(ctx, snid)
+		if err != nil {
+			return nil, err
+		}
+		mc, err := client.NewManagementClient(ctx, snm.ClusterID, snm.Address, zap.NewNop())
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = mc.Close()
+		}()
+
+		f, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		err = mc.Export(ctx, tpid, lsid, f)
+		if err = multierr.Combine(err, f.Sync(), f.Close()); err != nil {
+			_ = os.Remove(output)
+			return nil, err
+		}
+		cc, err := verifyArchive(output)
+		if err != nil {
+			_ = os.Remove(output)
+			return nil, err
+		}
+		return ExportResult{Path: output, CommitContext: cc}, nil
+	}
+}
+
+func verifyArchive(path string) (storage.CommitContext, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return storage.CommitContext{}, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return storage.VerifyArchive(f)
+}
+
+// Import restores the archive created by Export into the storage node path
+// snPath of the storage node snid as a replica of the log stream. The archive
+// is verified and then streamed to the storage node, which restores it with
+// its own storage options and adds the replica. If the argument snPath is
+// empty, the first storage node path is used.
+func Import(tpid types.TopicID, lsid types.LogStreamID, snid types.StorageNodeID, snPath, input string) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		cc, err := verifyArchive(input)
+		if err != nil {
+			return nil, err
+		}
+
+		snm, err := adm.GetStorageNode(ctx, snid)
+		if err != nil {
+			return nil, err
+		}
+		mc, err := client.NewManagementClient(ctx, snm.ClusterID, snm.Address, zap.NewNop())
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = mc.Close()
+		}()
+
+		f, err := os.Open(input)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		lsrmd, err := mc.Import(ctx, tpid, lsid, snPath, f)
+		if err != nil {
+			return nil, err
+		}
+		return ExportResult{Path: lsrmd.Path, CommitContext: cc}, nil
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/kakao/varlog/internal/varlogctl"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/varlog"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 // Add returns a function to add a new topic.
@@ -36,3 +37,11 @@ func Describe(id ...types.TopicID) varlogctl.ExecuteFunc {
 		return adm.ListTopics(ctx)
 	}
 }
+
+// SetRetentionPolicy returns a function to replace the retention policy of
+// the topic identified with id. An empty policy removes the retention policy.
+func SetRetentionPolicy(id types.TopicID, policy varlogpb.RetentionPolicy) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		return adm.SetRetentionPolicy(ctx, id, &policy)
+	}
+}
@@ -27,8 +27,24 @@ type MetadataRepositoryClient interface {
 	UnregisterLogStream(context.Context, types.LogStreamID) error
 	UpdateLogStream(context.Context, *varlogpb.LogStreamDescriptor) error
 	GetMetadata(context.Context) (*varlogpb.MetadataDescriptor, error)
+	// WatchMetadata calls the argument fn with the metadata whenever its
+	// applied index becomes greater than the argument appliedIndex and the
+	// one received last. It blocks until the context is canceled or the
+	// stream fails.
+	WatchMetadata(ctx context.Context, appliedIndex uint64, fn func(*varlogpb.MetadataDescriptor)) error
 	Seal(context.Context, types.LogStreamID) (types.GLSN, error)
 	Unseal(context.Context, types.LogStreamID) error
+	SetRetentionPolicy(ctx context.Context, topicID types.TopicID, policy *varlogpb.RetentionPolicy) error
+	CommitOffset(ctx context.Context, group string, offset mrpb.ConsumerGroupOffset) error
+	FetchOffset(ctx context.Context, group string, topicID types.TopicID, logStreamID types.LogStreamID) (mrpb.ConsumerGroupOffset, error)
+	DeleteConsumerGroup(ctx context.Context, group string) error
+	// GetHighWatermark returns the GLSN of the last log entry committed to
+	// the topic. It returns types.InvalidGLSN if no log entry has been
+	// committed yet.
+	GetHighWatermark(ctx context.Context, topicID types.TopicID) (types.GLSN, error)
+	// Backup returns a copy of the state machine that is a consistent cut of
+	// the cluster.
+	Backup(ctx context.Context) (*mrpb.BackupResponse, error)
 	Close() error
 }
 
@@ -168,6 +184,20 @@ func (c *metadataRepositoryClient) GetMetadata(ctx context.Context) (*varlogpb.M
 	return rsp.GetMetadata(), nil
 }
 
+func (c *metadataRepositoryClient) WatchMetadata(ctx context.Context, appliedIndex uint64, fn func(*varlogpb.MetadataDescriptor)) error {
+	stream, err := c.client.WatchMetadata(ctx, &mrpb.WatchMetadataRequest{AppliedIndex: appliedIndex})
+	if err != nil {
+		return verrors.FromStatusError(errors.WithStack(err))
+	}
+	for {
+		rsp, err := stream.Recv()
+		if err != nil {
+			return verrors.FromStatusError(errors.WithStack(err))
+		}
+		fn(rsp.GetMetadata())
+	}
+}
+
 func (c *metadataRepositoryClient) Seal(ctx context.Context, lsID types.LogStreamID) (types.GLSN, error) {
 	rsp, err := c.client.Seal(ctx, &mrpb.SealRequest{LogStreamID: lsID})
 	if err != nil {
@@ -184,3 +214,78 @@ func (c *metadataRepositoryClient) Unseal(ctx context.Context, lsID types.LogStr
 	}
 	return nil
 }
+
+func (c *metadataRepositoryClient) SetRetentionPolicy(ctx context.Context, topicID types.TopicID, policy *varlogpb.RetentionPolicy) error {
+	if topicID.Invalid() {
+		return errors.WithStack(verrors.ErrInvalid)
+	}
+
+	req := &mrpb.SetRetentionPolicyRequest{
+		TopicID:         topicID,
+		RetentionPolicy: policy,
+	}
+	_, err := c.client.SetRetentionPolicy(ctx, req)
+	return errors.WithStack(verrors.FromStatusError(err))
+}
+
+func (c *metadataRepositoryClient) CommitOffset(ctx context.Context, group string, offset mrpb.ConsumerGroupOffset) error {
+	if len(group) == 0 || offset.TopicID.Invalid() {
+		return errors.WithStack(verrors.ErrInvalid)
+	}
+
+	req := &mrpb.CommitOffsetRequest{
+		Group:  group,
+		Offset: offset,
+	}
+	_, err := c.client.CommitOffset(ctx, req)
+	return errors.WithStack(verrors.FromStatusError(err))
+}
+
+func (c *metadataRepositoryClient) FetchOffset(ctx context.Context, group string, topicID types.TopicID, logStreamID types.LogStreamID) (mrpb.ConsumerGroupOffset, error) {
+	if len(group) == 0 || topicID.Invalid() {
+		return mrpb.ConsumerGroupOffset{}, errors.WithStack(verrors.ErrInvalid)
+	}
+
+	req := &mrpb.FetchOffsetRequest{
+		Group:       group,
+		TopicID:     topicID,
+		LogStreamID: logStreamID,
+	}
+	rsp, err := c.client.FetchOffset(ctx, req)
+	if err != nil {
+		return mrpb.ConsumerGroupOffset{}, errors.WithStack(verrors.FromStatusError(err))
+	}
+	return rsp.Offset, nil
+}
+
+func (c *metadataRepositoryClient) DeleteConsumerGroup(ctx context.Context, group string) error {
+	if len(group) == 0 {
+		return errors.WithStack(verrors.ErrInvalid)
+	}
+
+	req := &mrpb.DeleteConsumerGroupRequest{
+		Group: group,
+	}
+	_, err := c.client.DeleteConsumerGroup(ctx, req)
+	return errors.WithStack(verrors.FromStatusError(err))
+}
+
+func (c *metadataRepositoryClient) GetHighWatermark(ctx context.Context, topicID types.TopicID) (types.GLSN, error) {
+	if topicID.Invalid() {
+		return types.InvalidGLSN, errors.WithStack(verrors.ErrInvalid)
+	}
+
+	rsp, err := c.client.GetHighWatermark(ctx, &mrpb.GetHighWatermarkRequest{TopicID: topicID})
+	if err != nil {
+		return types.InvalidGLSN, errors.WithStack(verrors.FromStatusError(err))
+	}
+	return rsp.HighWatermark, nil
+}
+
+func (c *metadataRepositoryClient) Backup(ctx context.Context) (*mrpb.BackupResponse, error) {
+	rsp, err := c.client.Backup(ctx, &mrpb.BackupRequest{})
+	if err != nil {
+		return nil, errors.WithStack(verrors.FromStatusError(err))
+	}
+	return rsp, nil
+}
@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 
 	types "github.com/kakao/varlog/pkg/types"
+	mrpb "github.com/kakao/varlog/proto/mrpb"
 	varlogpb "github.com/kakao/varlog/proto/varlogpb"
 )
 
@@ -37,6 +38,21 @@ func (m *MockMetadataRepositoryClient) EXPECT() *MockMetadataRepositoryClientMoc
 	return m.recorder
 }
 
+// Backup mocks base method.
+func (m *MockMetadataRepositoryClient) Backup(arg0 context.Context) (*mrpb.BackupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup", arg0)
+	ret0, _ := ret[0].(*mrpb.BackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockMetadataRepositoryClientMockRecorder) Backup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).Backup), arg0)
+}
+
 // Close mocks base method.
 func (m *MockMetadataRepositoryClient) Close() error {
 	m.ctrl.T.Helper()
@@ -51,6 +67,64 @@ func (mr *MockMetadataRepositoryClientMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).Close))
 }
 
+// CommitOffset mocks base method.
+func (m *MockMetadataRepositoryClient) CommitOffset(arg0 context.Context, arg1 string, arg2 mrpb.ConsumerGroupOffset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitOffset", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitOffset indicates an expected call of CommitOffset.
+func (mr *MockMetadataRepositoryClientMockRecorder) CommitOffset(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitOffset", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).CommitOffset), arg0, arg1, arg2)
+}
+
+// DeleteConsumerGroup mocks base method.
+func (m *MockMetadataRepositoryClient) DeleteConsumerGroup(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConsumerGroup indicates an expected call of DeleteConsumerGroup.
+func (mr *MockMetadataRepositoryClientMockRecorder) DeleteConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConsumerGroup", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).DeleteConsumerGroup), arg0, arg1)
+}
+
+// FetchOffset mocks base method.
+func (m *MockMetadataRepositoryClient) FetchOffset(arg0 context.Context, arg1 string, arg2 types.TopicID, arg3 types.LogStreamID) (mrpb.ConsumerGroupOffset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchOffset", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(mrpb.ConsumerGroupOffset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchOffset indicates an expected call of FetchOffset.
+func (mr *MockMetadataRepositoryClientMockRecorder) FetchOffset(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchOffset", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).FetchOffset), arg0, arg1, arg2, arg3)
+}
+
+// GetHighWatermark mocks base method.
+func (m *MockMetadataRepositoryClient) GetHighWatermark(arg0 context.Context, arg1 types.TopicID) (types.GLSN, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHighWatermark", arg0, arg1)
+	ret0, _ := ret[0].(types.GLSN)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHighWatermark indicates an expected call of GetHighWatermark.
+func (mr *MockMetadataRepositoryClientMockRecorder) GetHighWatermark(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHighWatermark", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).GetHighWatermark), arg0, arg1)
+}
+
 // GetMetadata mocks base method.
 func (m *MockMetadataRepositoryClient) GetMetadata(arg0 context.Context) (*varlogpb.MetadataDescriptor, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +197,20 @@ func (mr *MockMetadataRepositoryClientMockRecorder) Seal(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).Seal), arg0, arg1)
 }
 
+// SetRetentionPolicy mocks base method.
+func (m *MockMetadataRepositoryClient) SetRetentionPolicy(arg0 context.Context, arg1 types.TopicID, arg2 *varlogpb.RetentionPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRetentionPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRetentionPolicy indicates an expected call of SetRetentionPolicy.
+func (mr *MockMetadataRepositoryClientMockRecorder) SetRetentionPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetentionPolicy", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).SetRetentionPolicy), arg0, arg1, arg2)
+}
+
 // UnregisterLogStream mocks base method.
 func (m *MockMetadataRepositoryClient) UnregisterLogStream(arg0 context.Context, arg1 types.LogStreamID) error {
 	m.ctrl.T.Helper()
@@ -192,3 +280,17 @@ func (mr *MockMetadataRepositoryClientMockRecorder) UpdateLogStream(arg0, arg1 i
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogStream", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).UpdateLogStream), arg0, arg1)
 }
+
+// WatchMetadata mocks base method.
+func (m *MockMetadataRepositoryClient) WatchMetadata(arg0 context.Context, arg1 uint64, arg2 func(*varlogpb.MetadataDescriptor)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchMetadata", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchMetadata indicates an expected call of WatchMetadata.
+func (mr *MockMetadataRepositoryClientMockRecorder) WatchMetadata(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchMetadata", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).WatchMetadata), arg0, arg1, arg2)
+}
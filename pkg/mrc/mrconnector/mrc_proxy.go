@@ -172,6 +172,84 @@ func (m *mrProxy) Unseal(ctx context.Context, id types.LogStreamID) error {
 	return m.cl.Unseal(ctx, id)
 }
 
+func (m *mrProxy) SetRetentionPolicy(ctx context.Context, topicID types.TopicID, policy *varlogpb.RetentionPolicy) error {
+	m.mu.RLock()
+	defer func() {
+		atomic.AddInt64(&m.inflight, -1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	atomic.AddInt64(&m.inflight, 1)
+
+	return m.cl.SetRetentionPolicy(ctx, topicID, policy)
+}
+
+func (m *mrProxy) CommitOffset(ctx context.Context, group string, offset mrpb.ConsumerGroupOffset) error {
+	m.mu.RLock()
+	defer func() {
+		atomic.AddInt64(&m.inflight, -1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	atomic.AddInt64(&m.inflight, 1)
+
+	return m.cl.CommitOffset(ctx, group, offset)
+}
+
+func (m *mrProxy) FetchOffset(ctx context.Context, group string, topicID types.TopicID, logStreamID types.LogStreamID) (mrpb.ConsumerGroupOffset, error) {
+	m.mu.RLock()
+	defer func() {
+		atomic.AddInt64(&m.inflight, -1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	atomic.AddInt64(&m.inflight, 1)
+
+	return m.cl.FetchOffset(ctx, group, topicID, logStreamID)
+}
+
+func (m *mrProxy) DeleteConsumerGroup(ctx context.Context, group string) error {
+	m.mu.RLock()
+	defer func() {
+		atomic.AddInt64(&m.inflight, -1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	atomic.AddInt64(&m.inflight, 1)
+
+	return m.cl.DeleteConsumerGroup(ctx, group)
+}
+
+func (m *mrProxy) GetHighWatermark(ctx context.Context, topicID types.TopicID) (types.GLSN, error) {
+	m.mu.RLock()
+	defer func() {
+		atomic.AddInt64(&m.inflight, -1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	atomic.AddInt64(&m.inflight, 1)
+
+	return m.cl.GetHighWatermark(ctx, topicID)
+}
+
+func (m *mrProxy) Backup(ctx context.Context) (*mrpb.BackupResponse, error) {
+	m.mu.RLock()
+	defer func() {
+		atomic.AddInt64(&m.inflight, -1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	atomic.AddInt64(&m.inflight, 1)
+
+	return m.cl.Backup(ctx)
+}
+
+// WatchMetadata is not counted as an inflight call since it lasts long.
+// Instead, closing the proxy stops it.
+func (m *mrProxy) WatchMetadata(ctx context.Context, appliedIndex uint64, fn func(*varlogpb.MetadataDescriptor)) error {
+	return m.cl.WatchMetadata(ctx, appliedIndex, fn)
+}
+
 func (m *mrProxy) AddPeer(ctx context.Context, clusterID types.ClusterID, nodeID types.NodeID, url string) error {
 	m.mu.RLock()
 	defer func() {
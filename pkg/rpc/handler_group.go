@@ -0,0 +1,64 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HandlerGroup tracks running handlers of a gRPC server. Although
+// grpc.Server.Stop cancels running RPCs, it does not wait for their handlers
+// to return; hence, a server should wait for them by using Wait after
+// stopping the gRPC server before releasing resources the handlers use.
+//
+// Interceptors returned by UnaryServerInterceptor and StreamServerInterceptor
+// must be installed to the gRPC server.
+type HandlerGroup struct {
+	mu     sync.Mutex
+	closed bool
+	wg     sync.WaitGroup
+}
+
+func (g *HandlerGroup) add() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.closed {
+		return false
+	}
+	g.wg.Add(1)
+	return true
+}
+
+// Wait rejects new handlers with the Unavailable error and waits for running
+// handlers to return.
+func (g *HandlerGroup) Wait() {
+	g.mu.Lock()
+	g.closed = true
+	g.mu.Unlock()
+	g.wg.Wait()
+}
+
+// UnaryServerInterceptor returns an interceptor that tracks unary handlers.
+func (g *HandlerGroup) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !g.add() {
+			return nil, status.Error(codes.Unavailable, "rpc: server closed")
+		}
+		defer g.wg.Done()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor that tracks stream handlers.
+func (g *HandlerGroup) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !g.add() {
+			return status.Error(codes.Unavailable, "rpc: server closed")
+		}
+		defer g.wg.Done()
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,51 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHandlerGroup(t *testing.T) {
+	var g HandlerGroup
+	interceptor := g.UnaryServerInterceptor()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	returned := make(chan struct{})
+	go func() {
+		defer close(returned)
+		_, err := interceptor(context.Background(), nil, nil, func(context.Context, any) (any, error) {
+			close(started)
+			<-release
+			return nil, nil
+		})
+		require.NoError(t, err)
+	}()
+	<-started
+
+	waited := make(chan struct{})
+	go func() {
+		defer close(waited)
+		g.Wait()
+	}()
+	select {
+	case <-waited:
+		require.Fail(t, "wait returned before the running handler")
+	case <-time.After(10 * time.Millisecond):
+	}
+	close(release)
+	<-returned
+	<-waited
+
+	// New handlers are rejected after Wait.
+	_, err := interceptor(context.Background(), nil, nil, func(context.Context, any) (any, error) {
+		require.Fail(t, "unexpected handler")
+		return nil, nil
+	})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,490 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/kakao/varlog/pkg/verrors"
+)
+
+// validate checks whether the definition is well-formed.
+func validate(typ Type, definition []byte) error {
+	var err error
+	switch typ {
+	case TypeAvro:
+		_, err = parseAvro(definition)
+	case TypeProtobuf:
+		_, err = parseProtobuf(definition)
+	case TypeJSON:
+		_, err = parseJSONSchema(definition)
+	default:
+		return fmt.Errorf("schema: %s: %w", typ, verrors.ErrInvalid)
+	}
+	if err != nil {
+		return fmt.Errorf("schema: %s: %v: %w", typ, err, verrors.ErrInvalid)
+	}
+	return nil
+}
+
+// checkCompatibility checks whether the definition next satisfies the rule
+// compat against the definition latest. Both of them should be valid.
+func checkCompatibility(typ Type, compat Compatibility, latest, next []byte) error {
+	var err error
+	switch compat {
+	case CompatibilityBackward:
+		err = checkReadable(typ, next, latest)
+	case CompatibilityForward:
+		err = checkReadable(typ, latest, next)
+	case CompatibilityFull:
+		err = checkReadable(typ, next, latest)
+		if err == nil {
+			err = checkReadable(typ, latest, next)
+		}
+	case CompatibilityNone:
+	default:
+		return fmt.Errorf("schema: %s: %w", compat, verrors.ErrInvalid)
+	}
+	if err != nil {
+		return fmt.Errorf("schema: %s: %v: %w", compat, err, ErrIncompatible)
+	}
+	return nil
+}
+
+// checkReadable checks whether data written with the definition writer can be
+// read with the definition reader.
+func checkReadable(typ Type, reader, writer []byte) error {
+	switch typ {
+	case TypeAvro:
+		r, err := parseAvro(reader)
+		if err != nil {
+			return err
+		}
+		w, err := parseAvro(writer)
+		if err != nil {
+			return err
+		}
+		return r.readable(w, "")
+	case TypeProtobuf:
+		r, err := parseProtobuf(reader)
+		if err != nil {
+			return err
+		}
+		w, err := parseProtobuf(writer)
+		if err != nil {
+			return err
+		}
+		return protobufReadable(r, w)
+	case TypeJSON:
+		r, err := parseJSONSchema(reader)
+		if err != nil {
+			return err
+		}
+		w, err := parseJSONSchema(writer)
+		if err != nil {
+			return err
+		}
+		return r.readable(w, "$")
+	default:
+		return fmt.Errorf("unknown type %s", typ)
+	}
+}
+
+// avroSchema is a parsed Avro schema. Named types are resolved when they are
+// parsed; hence, references to them share the same avroSchema.
+type avroSchema struct {
+	typ     string
+	name    string
+	fields  []avroField
+	symbols []string
+	hasDef  bool
+	items   *avroSchema
+	values  *avroSchema
+	size    int
+	// branches are the types of a union.
+	branches []*avroSchema
+}
+
+type avroField struct {
+	name       string
+	typ        *avroSchema
+	hasDefault bool
+}
+
+var avroPrimitives = map[string]bool{
+	"null": true, "boolean": true, "int": true, "long": true,
+	"float": true, "double": true, "bytes": true, "string": true,
+}
+
+// avroPromotions are the writer types that each reader type can read, by the
+// schema resolution rules of the Avro specification.
+var avroPromotions = map[string][]string{
+	"long":   {"int"},
+	"float":  {"int", "long"},
+	"double": {"int", "long", "float"},
+	"string": {"bytes"},
+	"bytes":  {"string"},
+}
+
+func parseAvro(definition []byte) (*avroSchema, error) {
+	var v any
+	if err := json.Unmarshal(definition, &v); err != nil {
+		return nil, err
+	}
+	return parseAvroValue(v, "", make(map[string]*avroSchema))
+}
+
+func parseAvroValue(v any, namespace string, names map[string]*avroSchema) (*avroSchema, error) {
+	switch v := v.(type) {
+	case string:
+		if avroPrimitives[v] {
+			return &avroSchema{typ: v}, nil
+		}
+		if s, ok := names[avroFullName(v, namespace)]; ok {
+			return s, nil
+		}
+		if s, ok := names[v]; ok {
+			return s, nil
+		}
+		return nil, fmt.Errorf("unknown avro type %q", v)
+	case []any:
+		s := &avroSchema{typ: "union"}
+		for _, b := range v {
+			branch, err := parseAvroValue(b, namespace, names)
+			if err != nil {
+				return nil, err
+			}
+			if branch.typ == "union" {
+				return nil, fmt.Errorf("nested avro union")
+			}
+			s.branches = append(s.branches, branch)
+		}
+		return s, nil
+	case map[string]any:
+		return parseAvroObject(v, namespace, names)
+	default:
+		return nil, fmt.Errorf("invalid avro schema %v", v)
+	}
+}
+
+func parseAvroObject(v map[string]any, namespace string, names map[string]*avroSchema) (*avroSchema, error) {
+	typ, ok := v["type"].(string)
+	if !ok {
+		if t, ok := v["type"]; ok {
+			// {"type": {...}} or {"type": [...]}
+			return parseAvroValue(t, namespace, names)
+		}
+		return nil, fmt.Errorf("avro schema without type")
+	}
+	if avroPrimitives[typ] {
+		return &avroSchema{typ: typ}, nil
+	}
+
+	s := &avroSchema{typ: typ}
+	if typ == "error" {
+		s.typ = "record"
+	}
+	switch s.typ {
+	case "record", "enum", "fixed":
+		name, _ := v["name"].(string)
+		if len(name) == 0 {
+			return nil, fmt.Errorf("avro %s without name", s.typ)
+		}
+		if ns, ok := v["namespace"].(string); ok {
+			namespace = ns
+		}
+		s.name = avroFullName(name, namespace)
+		names[s.name] = s
+	}
+
+	switch s.typ {
+	case "record":
+		fields, ok := v["fields"].([]any)
+		if !ok {
+			return nil, fmt.Errorf("avro record %s without fields", s.name)
+		}
+		for _, f := range fields {
+			field, ok := f.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("invalid field of avro record %s", s.name)
+			}
+			name, _ := field["name"].(string)
+			if len(name) == 0 {
+				return nil, fmt.Errorf("avro record %s: field without name", s.name)
+			}
+			ft, err := parseAvroValue(field["type"], namespace, names)
+			if err != nil {
+				return nil, fmt.Errorf("avro record %s: field %s: %w", s.name, name, err)
+			}
+			_, hasDefault := field["default"]
+			s.fields = append(s.fields, avroField{name: name, typ: ft, hasDefault: hasDefault})
+		}
+	case "enum":
+		symbols, ok := v["symbols"].([]any)
+		if !ok {
+			return nil, fmt.Errorf("avro enum %s without symbols", s.name)
+		}
+		for _, sym := range symbols {
+			str, ok := sym.(string)
+			if !ok {
+				return nil, fmt.Errorf("avro enum %s: invalid symbol %v", s.name, sym)
+			}
+			s.symbols = append(s.symbols, str)
+		}
+		_, s.hasDef = v["default"]
+	case "fixed":
+		size, ok := v["size"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("avro fixed %s without size", s.name)
+		}
+		s.size = int(size)
+	case "array":
+		items, err := parseAvroValue(v["items"], namespace, names)
+		if err != nil {
+			return nil, fmt.Errorf("avro array: %w", err)
+		}
+		s.items = items
+	case "map":
+		values, err := parseAvroValue(v["values"], namespace, names)
+		if err != nil {
+			return nil, fmt.Errorf("avro map: %w", err)
+		}
+		s.values = values
+	default:
+		return nil, fmt.Errorf("unknown avro type %q", typ)
+	}
+	return s, nil
+}
+
+func avroFullName(name, namespace string) string {
+	if strings.Contains(name, ".") || len(namespace) == 0 {
+		return name
+	}
+	return namespace + "." + name
+}
+
+// readable checks whether data written with the schema w can be read with the
+// schema s by the schema resolution rules of the Avro specification.
+func (s *avroSchema) readable(w *avroSchema, path string) error {
+	return s.readableVisited(w, path, make(map[[2]*avroSchema]bool))
+}
+
+func (s *avroSchema) readableVisited(w *avroSchema, path string, visited map[[2]*avroSchema]bool) error {
+	// Recursive types are compared once.
+	key := [2]*avroSchema{s, w}
+	if visited[key] {
+		return nil
+	}
+	visited[key] = true
+
+	if w.typ == "union" {
+		for _, branch := range w.branches {
+			if err := s.readableVisited(branch, path, visited); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if s.typ == "union" {
+		for _, branch := range s.branches {
+			if branch.readableVisited(w, path, visited) == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: no branch of union reads %s", avroPath(path), w.typ)
+	}
+
+	if s.typ != w.typ {
+		for _, promoted := range avroPromotions[s.typ] {
+			if promoted == w.typ {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: %s cannot read %s", avroPath(path), s.typ, w.typ)
+	}
+
+	switch s.typ {
+	case "record":
+		if s.name != w.name {
+			return fmt.Errorf("%s: record %s cannot read %s", avroPath(path), s.name, w.name)
+		}
+		writerFields := make(map[string]*avroSchema, len(w.fields))
+		for _, f := range w.fields {
+			writerFields[f.name] = f.typ
+		}
+		for _, f := range s.fields {
+			wt, ok := writerFields[f.name]
+			if !ok {
+				if !f.hasDefault {
+					return fmt.Errorf("%s: field %s without default", avroPath(path+"."+f.name), f.name)
+				}
+				continue
+			}
+			if err := f.typ.readableVisited(wt, path+"."+f.name, visited); err != nil {
+				return err
+			}
+		}
+	case "enum":
+		if s.name != w.name {
+			return fmt.Errorf("%s: enum %s cannot read %s", avroPath(path), s.name, w.name)
+		}
+		if s.hasDef {
+			return nil
+		}
+		symbols := make(map[string]bool, len(s.symbols))
+		for _, sym := range s.symbols {
+			symbols[sym] = true
+		}
+		for _, sym := range w.symbols {
+			if !symbols[sym] {
+				return fmt.Errorf("%s: enum %s without symbol %s", avroPath(path), s.name, sym)
+			}
+		}
+	case "fixed":
+		if s.name != w.name || s.size != w.size {
+			return fmt.Errorf("%s: fixed %s(%d) cannot read %s(%d)", avroPath(path), s.name, s.size, w.name, w.size)
+		}
+	case "array":
+		return s.items.readableVisited(w.items, path+"[]", visited)
+	case "map":
+		return s.values.readableVisited(w.values, path+"{}", visited)
+	}
+	return nil
+}
+
+func avroPath(path string) string {
+	if len(path) == 0 {
+		return "."
+	}
+	return path
+}
+
+// protobufMessages are messages defined in a FileDescriptorSet, including
+// nested ones, by their full names.
+type protobufMessages map[protoreflect.FullName]protoreflect.MessageDescriptor
+
+func parseProtobuf(definition []byte) (protobufMessages, error) {
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(definition, &set); err != nil {
+		return nil, err
+	}
+	if len(set.File) == 0 {
+		return nil, fmt.Errorf("no file in protobuf descriptor set")
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		return nil, err
+	}
+	messages := make(protobufMessages)
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		collectMessages(fd.Messages(), messages)
+		return true
+	})
+	return messages, nil
+}
+
+func collectMessages(mds protoreflect.MessageDescriptors, messages protobufMessages) {
+	for i := 0; i < mds.Len(); i++ {
+		md := mds.Get(i)
+		messages[md.FullName()] = md
+		collectMessages(md.Messages(), messages)
+	}
+}
+
+// protobufReadable checks whether messages written with the writer can be
+// read with the reader. Fields are matched by their numbers since names are
+// not in the wire format. Protocol Buffers allow adding and removing fields,
+// but a field number in both should have the same type and cardinality.
+func protobufReadable(reader, writer protobufMessages) error {
+	for name, rmd := range reader {
+		wmd, ok := writer[name]
+		if !ok {
+			continue
+		}
+		rfields := rmd.Fields()
+		for i := 0; i < rfields.Len(); i++ {
+			rfd := rfields.Get(i)
+			wfd := wmd.Fields().ByNumber(rfd.Number())
+			if wfd == nil {
+				continue
+			}
+			if rfd.Kind() != wfd.Kind() || rfd.Cardinality() != wfd.Cardinality() || rfd.IsMap() != wfd.IsMap() {
+				return fmt.Errorf("%s: field %d: %s %s cannot read %s %s", name, rfd.Number(), rfd.Cardinality(), rfd.Kind(), wfd.Cardinality(), wfd.Kind())
+			}
+			switch rfd.Kind() {
+			case protoreflect.MessageKind, protoreflect.GroupKind:
+				if rfd.Message().FullName() != wfd.Message().FullName() {
+					return fmt.Errorf("%s: field %d: %s cannot read %s", name, rfd.Number(), rfd.Message().FullName(), wfd.Message().FullName())
+				}
+			case protoreflect.EnumKind:
+				if rfd.Enum().FullName() != wfd.Enum().FullName() {
+					return fmt.Errorf("%s: field %d: %s cannot read %s", name, rfd.Number(), rfd.Enum().FullName(), wfd.Enum().FullName())
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// jsonSchema is a subset of JSON Schema considered in compatibility checks.
+type jsonSchema struct {
+	Type                 any                    `json:"type,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	AdditionalProperties any                    `json:"additionalProperties,omitempty"`
+}
+
+func parseJSONSchema(definition []byte) (*jsonSchema, error) {
+	var s jsonSchema
+	if err := json.Unmarshal(definition, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// readable checks whether every document valid against the schema w is also
+// valid against the schema s, within the subset of JSON Schema.
+func (s *jsonSchema) readable(w *jsonSchema, path string) error {
+	if s.Type != nil && !reflect.DeepEqual(s.Type, w.Type) {
+		return fmt.Errorf("%s: type %v cannot read %v", path, s.Type, w.Type)
+	}
+
+	required := make(map[string]bool, len(w.Required))
+	for _, name := range w.Required {
+		required[name] = true
+	}
+	for _, name := range s.Required {
+		if !required[name] {
+			return fmt.Errorf("%s: property %s is required but optional in the writer", path, name)
+		}
+	}
+
+	for name, sp := range s.Properties {
+		wp, ok := w.Properties[name]
+		if !ok {
+			continue
+		}
+		if err := sp.readable(wp, path+"."+name); err != nil {
+			return err
+		}
+	}
+	if closed, ok := s.AdditionalProperties.(bool); ok && !closed {
+		for name := range w.Properties {
+			if _, ok := s.Properties[name]; !ok {
+				return fmt.Errorf("%s: additional property %s is not allowed", path, name)
+			}
+		}
+	}
+
+	if s.Items != nil && w.Items != nil {
+		return s.Items.readable(w.Items, path+"[]")
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/kakao/varlog/pkg/verrors"
+)
+
+func TestValidate(t *testing.T) {
+	tcs := []struct {
+		name       string
+		typ        Type
+		definition string
+		ok         bool
+	}{
+		{name: "AvroRecord", typ: TypeAvro, definition: `{"type":"record","name":"User","fields":[{"name":"id","type":"long"}]}`, ok: true},
+		{name: "AvroPrimitive", typ: TypeAvro, definition: `"string"`, ok: true},
+		{name: "AvroRecursive", typ: TypeAvro, definition: `{"type":"record","name":"Node","fields":[{"name":"next","type":["null","Node"]}]}`, ok: true},
+		{name: "AvroUnknownType", typ: TypeAvro, definition: `{"type":"record","name":"User","fields":[{"name":"id","type":"Unknown"}]}`},
+		{name: "AvroNoName", typ: TypeAvro, definition: `{"type":"record","fields":[]}`},
+		{name: "AvroMalformed", typ: TypeAvro, definition: `{`},
+		{name: "JSON", typ: TypeJSON, definition: `{"type":"object","properties":{"id":{"type":"integer"}}}`, ok: true},
+		{name: "JSONMalformed", typ: TypeJSON, definition: `[`},
+		{name: "ProtobufMalformed", typ: TypeProtobuf, definition: "malformed"},
+		{name: "ProtobufEmpty", typ: TypeProtobuf, definition: ""},
+		{name: "UnknownType", typ: Type(0), definition: `{}`},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validate(tc.typ, []byte(tc.definition))
+			if tc.ok {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, verrors.ErrInvalid)
+		})
+	}
+}
+
+func TestCheckCompatibility_Avro(t *testing.T) {
+	const (
+		v1 = `{"type":"record","name":"User","namespace":"com.example","fields":[
+			{"name":"id","type":"int"},
+			{"name":"name","type":"string"}
+		]}`
+		// v2 adds a field with a default and promotes int to long.
+		v2 = `{"type":"record","name":"User","namespace":"com.example","fields":[
+			{"name":"id","type":"long"},
+			{"name":"name","type":"string"},
+			{"name":"email","type":["null","string"],"default":null}
+		]}`
+		// v3 adds a field without a default.
+		v3 = `{"type":"record","name":"User","namespace":"com.example","fields":[
+			{"name":"id","type":"int"},
+			{"name":"name","type":"string"},
+			{"name":"age","type":"int"}
+		]}`
+		// v4 changes the type of a field.
+		v4 = `{"type":"record","name":"User","namespace":"com.example","fields":[
+			{"name":"id","type":"int"},
+			{"name":"name","type":"boolean"}
+		]}`
+		// v5 renames the record.
+		v5 = `{"type":"record","name":"Account","namespace":"com.example","fields":[
+			{"name":"id","type":"int"},
+			{"name":"name","type":"string"}
+		]}`
+		e1 = `{"type":"enum","name":"Color","symbols":["RED","GREEN"]}`
+		e2 = `{"type":"enum","name":"Color","symbols":["RED","GREEN","BLUE"]}`
+	)
+
+	tcs := []struct {
+		name   string
+		compat Compatibility
+		latest string
+		next   string
+		ok     bool
+	}{
+		{name: "BackwardAddFieldWithDefault", compat: CompatibilityBackward, latest: v1, next: v2, ok: true},
+		{name: "ForwardAddFieldWithDefault", compat: CompatibilityForward, latest: v1, next: v2},
+		{name: "BackwardAddFieldWithoutDefault", compat: CompatibilityBackward, latest: v1, next: v3},
+		{name: "ForwardAddFieldWithoutDefault", compat: CompatibilityForward, latest: v1, next: v3, ok: true},
+		{name: "FullAddFieldWithoutDefault", compat: CompatibilityFull, latest: v1, next: v3},
+		{name: "BackwardChangeType", compat: CompatibilityBackward, latest: v1, next: v4},
+		{name: "NoneChangeType", compat: CompatibilityNone, latest: v1, next: v4, ok: true},
+		{name: "BackwardRename", compat: CompatibilityBackward, latest: v1, next: v5},
+		{name: "BackwardAddSymbol", compat: CompatibilityBackward, latest: e1, next: e2, ok: true},
+		{name: "ForwardAddSymbol", compat: CompatibilityForward, latest: e1, next: e2},
+		{name: "BackwardArray", compat: CompatibilityBackward, latest: `{"type":"array","items":"int"}`, next: `{"type":"array","items":"double"}`, ok: true},
+		{name: "BackwardMap", compat: CompatibilityBackward, latest: `{"type":"map","values":"string"}`, next: `{"type":"map","values":"int"}`},
+		{name: "BackwardToUnion", compat: CompatibilityBackward, latest: `"string"`, next: `["null","string"]`, ok: true},
+		{name: "BackwardFromUnion", compat: CompatibilityBackward, latest: `["null","string"]`, next: `"string"`},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkCompatibility(TypeAvro, tc.compat, []byte(tc.latest), []byte(tc.next))
+			if tc.ok {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrIncompatible)
+		})
+	}
+}
+
+func TestCheckCompatibility_JSON(t *testing.T) {
+	const (
+		v1 = `{"type":"object","properties":{"id":{"type":"integer"},"name":{"type":"string"}},"required":["id"]}`
+		// v2 adds an optional property.
+		v2 = `{"type":"object","properties":{"id":{"type":"integer"},"name":{"type":"string"},"email":{"type":"string"}},"required":["id"]}`
+		// v3 requires a property optional in v1.
+		v3 = `{"type":"object","properties":{"id":{"type":"integer"},"name":{"type":"string"}},"required":["id","name"]}`
+		// v4 changes the type of a property.
+		v4 = `{"type":"object","properties":{"id":{"type":"string"},"name":{"type":"string"}},"required":["id"]}`
+		// v5 disallows additional properties.
+		v5 = `{"type":"object","properties":{"id":{"type":"integer"}},"required":["id"],"additionalProperties":false}`
+	)
+
+	tcs := []struct {
+		name   string
+		compat Compatibility
+		latest string
+		next   string
+		ok     bool
+	}{
+		{name: "BackwardAddOptional", compat: CompatibilityBackward, latest: v1, next: v2, ok: true},
+		{name: "FullAddOptional", compat: CompatibilityFull, latest: v1, next: v2, ok: true},
+		{name: "BackwardRequire", compat: CompatibilityBackward, latest: v1, next: v3},
+		{name: "ForwardRequire", compat: CompatibilityForward, latest: v1, next: v3, ok: true},
+		{name: "BackwardChangeType", compat: CompatibilityBackward, latest: v1, next: v4},
+		{name: "BackwardCloseProperties", compat: CompatibilityBackward, latest: v1, next: v5},
+		{name: "ForwardCloseProperties", compat: CompatibilityForward, latest: v1, next: v5, ok: true},
+		{name: "BackwardItems", compat: CompatibilityBackward, latest: `{"type":"array","items":{"type":"integer"}}`, next: `{"type":"array","items":{"type":"string"}}`},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkCompatibility(TypeJSON, tc.compat, []byte(tc.latest), []byte(tc.next))
+			if tc.ok {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrIncompatible)
+		})
+	}
+}
+
+func TestCheckCompatibility_Protobuf(t *testing.T) {
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			Number:   proto.Int32(number),
+			Type:     typ.Enum(),
+			Label:    label.Enum(),
+			JsonName: proto.String(name),
+		}
+	}
+	definition := func(fields ...*descriptorpb.FieldDescriptorProto) []byte {
+		set := &descriptorpb.FileDescriptorSet{
+			File: []*descriptorpb.FileDescriptorProto{{
+				Name:    proto.String("user.proto"),
+				Package: proto.String("example"),
+				Syntax:  proto.String("proto3"),
+				MessageType: []*descriptorpb.DescriptorProto{{
+					Name:  proto.String("User"),
+					Field: fields,
+				}},
+			}},
+		}
+		data, err := proto.Marshal(set)
+		require.NoError(t, err)
+		return data
+	}
+
+	const (
+		optional = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+		repeated = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+		int64Typ = descriptorpb.FieldDescriptorProto_TYPE_INT64
+		strTyp   = descriptorpb.FieldDescriptorProto_TYPE_STRING
+	)
+	v1 := definition(field("id", 1, int64Typ, optional), field("name", 2, strTyp, optional))
+	require.NoError(t, validate(TypeProtobuf, v1))
+
+	// Adding, removing, and renaming fields are compatible.
+	v2 := definition(field("id", 1, int64Typ, optional), field("email", 3, strTyp, optional))
+	require.NoError(t, checkCompatibility(TypeProtobuf, CompatibilityFull, v1, v2))
+	v3 := definition(field("user_id", 1, int64Typ, optional), field("name", 2, strTyp, optional))
+	require.NoError(t, checkCompatibility(TypeProtobuf, CompatibilityFull, v1, v3))
+
+	// Changing the type or the cardinality of a field is incompatible.
+	v4 := definition(field("id", 1, strTyp, optional), field("name", 2, strTyp, optional))
+	require.ErrorIs(t, checkCompatibility(TypeProtobuf, CompatibilityBackward, v1, v4), ErrIncompatible)
+	v5 := definition(field("id", 1, int64Typ, optional), field("name", 2, strTyp, repeated))
+	require.ErrorIs(t, checkCompatibility(TypeProtobuf, CompatibilityForward, v1, v5), ErrIncompatible)
+}
@@ -0,0 +1,310 @@
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/varlog"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// Registry is a schema registry stored in a topic reserved for it, which is
+// called the registry topic. Each log entry of the registry topic is a request
+// to register a schema, and every Registry replays them in the order of GLSN.
+// Hence, all Registries sharing the registry topic agree on which requests are
+// accepted and on the versions of subjects, even if the requests race.
+//
+// A request is accepted if it is valid and satisfies the compatibility rule
+// of the request against the latest version of the subject. A request whose
+// definition is the same as the latest version resolves to it instead of
+// adding a new version. The type of a subject cannot change.
+//
+// The registry topic should be created by the admin in advance and must not
+// be trimmed.
+type Registry struct {
+	vlg  varlog.Log
+	tpid types.TopicID
+
+	mu sync.Mutex
+	// next is the GLSN of the next log entry to replay.
+	next     types.GLSN
+	schemas  map[ID]Schema
+	subjects map[types.TopicID][]ID
+	// duplicates maps accepted requests that do not add new versions to the
+	// versions they resolve to.
+	duplicates map[ID]ID
+	// rejected has errors of rejected requests.
+	rejected map[ID]error
+}
+
+// registration is a request to register a schema, which is the data of a log
+// entry in the registry topic.
+type registration struct {
+	TopicID       types.TopicID `json:"topicID"`
+	Type          Type          `json:"type"`
+	Compatibility Compatibility `json:"compatibility"`
+	Definition    []byte        `json:"definition"`
+}
+
+// NewRegistry creates a Registry stored in the registry topic tpid. It reads
+// the registry topic through the argument vlg lazily.
+func NewRegistry(vlg varlog.Log, tpid types.TopicID) *Registry {
+	return &Registry{
+		vlg:        vlg,
+		tpid:       tpid,
+		next:       types.MinGLSN,
+		schemas:    make(map[ID]Schema),
+		subjects:   make(map[types.TopicID][]ID),
+		duplicates: make(map[ID]ID),
+		rejected:   make(map[ID]error),
+	}
+}
+
+// Register registers the definition as a new version of the subject of the
+// topic identified by the argument topicID. It returns an error wrapping
+// ErrIncompatible if the definition violates the compatibility rule, which
+// is CompatibilityBackward unless WithCompatibility overrides it, and
+// verrors.ErrInvalid if the definition is malformed. Registering the same
+// definition as the latest version returns the latest version.
+func (r *Registry) Register(ctx context.Context, topicID types.TopicID, typ Type, definition []byte, opts ...RegisterOption) (Schema, error) {
+	registerOpts := defaultRegisterOptions()
+	for _, opt := range opts {
+		opt.apply(&registerOpts)
+	}
+	req := registration{
+		TopicID:       topicID,
+		Type:          typ,
+		Compatibility: registerOpts.compatibility,
+		Definition:    definition,
+	}
+
+	// Check the request in advance not to append requests that are
+	// rejected obviously.
+	r.mu.Lock()
+	err := r.sync(ctx)
+	if err == nil {
+		err = r.check(req)
+	}
+	if errors.Is(err, errDuplicate) {
+		s := r.latest(topicID)
+		r.mu.Unlock()
+		return s, nil
+	}
+	r.mu.Unlock()
+	if err != nil {
+		return Schema{}, err
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return Schema{}, fmt.Errorf("schema registry: %w", err)
+	}
+	res := r.vlg.Append(ctx, r.tpid, [][]byte{data})
+	if res.Err != nil {
+		return Schema{}, fmt.Errorf("schema registry: %w", res.Err)
+	}
+	id := ID(res.Metadata[0].GLSN)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.sync(ctx); err != nil {
+		return Schema{}, err
+	}
+	if err, ok := r.rejected[id]; ok {
+		return Schema{}, err
+	}
+	if dup, ok := r.duplicates[id]; ok {
+		id = dup
+	}
+	s, ok := r.schemas[id]
+	if !ok {
+		return Schema{}, fmt.Errorf("schema registry: id %d: not replayed", id)
+	}
+	return s, nil
+}
+
+// Schema returns the schema identified by the argument id. It returns an error
+// wrapping verrors.ErrNotExist if there is no such schema.
+func (r *Registry) Schema(ctx context.Context, id ID) (Schema, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if s, ok := r.lookup(id); ok {
+		return s, nil
+	}
+	if err := r.sync(ctx); err != nil {
+		return Schema{}, err
+	}
+	if s, ok := r.lookup(id); ok {
+		return s, nil
+	}
+	return Schema{}, fmt.Errorf("schema registry: id %d: %w", id, verrors.ErrNotExist)
+}
+
+// Latest returns the latest version of the subject of the topic identified by
+// the argument topicID. It returns an error wrapping verrors.ErrNotExist if the
+// subject has no version.
+func (r *Registry) Latest(ctx context.Context, topicID types.TopicID) (Schema, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.sync(ctx); err != nil {
+		return Schema{}, err
+	}
+	if len(r.subjects[topicID]) == 0 {
+		return Schema{}, fmt.Errorf("schema registry: topic %d: %w", topicID, verrors.ErrNotExist)
+	}
+	return r.latest(topicID), nil
+}
+
+// Versions returns all versions of the subject of the topic identified by the
+// argument topicID in ascending order.
+func (r *Registry) Versions(ctx context.Context, topicID types.TopicID) ([]Schema, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.sync(ctx); err != nil {
+		return nil, err
+	}
+	ids := r.subjects[topicID]
+	versions := make([]Schema, 0, len(ids))
+	for _, id := range ids {
+		versions = append(versions, r.schemas[id])
+	}
+	return versions, nil
+}
+
+func (r *Registry) lookup(id ID) (Schema, bool) {
+	if dup, ok := r.duplicates[id]; ok {
+		id = dup
+	}
+	s, ok := r.schemas[id]
+	return s, ok
+}
+
+func (r *Registry) latest(topicID types.TopicID) Schema {
+	ids := r.subjects[topicID]
+	return r.schemas[ids[len(ids)-1]]
+}
+
+// sync replays log entries of the registry topic committed so far. It should
+// be called with r.mu held.
+func (r *Registry) sync(ctx context.Context) error {
+	end, err := r.vlg.ResolvePosition(ctx, r.tpid, varlog.Now)
+	if err != nil {
+		return fmt.Errorf("schema registry: %w", err)
+	}
+	if end <= r.next {
+		return nil
+	}
+
+	sub := r.vlg.SubscribeIter(ctx, r.tpid, r.next, end)
+	defer func() {
+		_ = sub.Close()
+	}()
+	for {
+		logEntry, err := sub.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("schema registry: %w", err)
+		}
+		r.apply(logEntry)
+		r.next = logEntry.GLSN + 1
+	}
+	r.next = end
+	return nil
+}
+
+var errDuplicate = errors.New("duplicate")
+
+// check returns nil if the request adds a new version, errDuplicate if it
+// resolves to the latest version, or an error if it is rejected.
+func (r *Registry) check(req registration) error {
+	if err := validate(req.Type, req.Definition); err != nil {
+		return err
+	}
+	if _, ok := compatibilityNames[req.Compatibility]; !ok {
+		return fmt.Errorf("schema: %s: %w", req.Compatibility, verrors.ErrInvalid)
+	}
+	if len(r.subjects[req.TopicID]) == 0 {
+		return nil
+	}
+	latest := r.latest(req.TopicID)
+	if latest.Type != req.Type {
+		return fmt.Errorf("schema: type %s cannot change to %s: %w", latest.Type, req.Type, ErrIncompatible)
+	}
+	if bytes.Equal(latest.Definition, req.Definition) {
+		return errDuplicate
+	}
+	return checkCompatibility(req.Type, req.Compatibility, latest.Definition, req.Definition)
+}
+
+func (r *Registry) apply(logEntry varlogpb.LogEntry) {
+	id := ID(logEntry.GLSN)
+	var req registration
+	if err := json.Unmarshal(logEntry.Data, &req); err != nil {
+		r.rejected[id] = fmt.Errorf("schema registry: id %d: %v: %w", id, err, verrors.ErrInvalid)
+		return
+	}
+
+	err := r.check(req)
+	switch {
+	case err == nil:
+		r.subjects[req.TopicID] = append(r.subjects[req.TopicID], id)
+		r.schemas[id] = Schema{
+			ID:         id,
+			TopicID:    req.TopicID,
+			Version:    len(r.subjects[req.TopicID]),
+			Type:       req.Type,
+			Definition: req.Definition,
+		}
+	case errors.Is(err, errDuplicate):
+		r.duplicates[id] = r.latest(req.TopicID).ID
+	default:
+		r.rejected[id] = err
+	}
+}
+
+type registerOptions struct {
+	compatibility Compatibility
+}
+
+func defaultRegisterOptions() registerOptions {
+	return registerOptions{
+		compatibility: CompatibilityBackward,
+	}
+}
+
+// RegisterOption configures Register.
+type RegisterOption interface {
+	apply(*registerOptions)
+}
+
+type registerOption struct {
+	f func(*registerOptions)
+}
+
+func (opt *registerOption) apply(opts *registerOptions) {
+	opt.f(opts)
+}
+
+func newRegisterOption(f func(*registerOptions)) *registerOption {
+	return &registerOption{f: f}
+}
+
+// WithCompatibility sets the compatibility rule that the new version should
+// satisfy against the latest version of the subject.
+func WithCompatibility(compat Compatibility) RegisterOption {
+	return newRegisterOption(func(opts *registerOptions) {
+		opts.compatibility = compat
+	})
+}
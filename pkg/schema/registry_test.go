@@ -0,0 +1,112 @@
+package schema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/schema"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/varlogtest"
+	"github.com/kakao/varlog/pkg/verrors"
+)
+
+type user struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+func TestRegistry(t *testing.T) {
+	const (
+		v1 = `{"type":"object","properties":{"id":{"type":"integer"},"name":{"type":"string"}},"required":["id"]}`
+		v2 = `{"type":"object","properties":{"id":{"type":"integer"},"name":{"type":"string"},"email":{"type":"string"}},"required":["id"]}`
+		v3 = `{"type":"object","properties":{"id":{"type":"integer"},"name":{"type":"string"}},"required":["id","name"]}`
+	)
+
+	vt := varlogtest.New(types.ClusterID(1), 1)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	_, err := adm.AddStorageNode(context.Background(), types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+	addTopic := func() types.TopicID {
+		td, err := adm.AddTopic(context.Background())
+		require.NoError(t, err)
+		_, err = adm.AddLogStream(context.Background(), td.TopicID, nil)
+		require.NoError(t, err)
+		return td.TopicID
+	}
+	registryTopicID := addTopic()
+	topicID := addTopic()
+	otherTopicID := addTopic()
+
+	reg := schema.NewRegistry(vlg, registryTopicID)
+
+	_, err = reg.Latest(context.Background(), topicID)
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+	_, err = schema.NewTypedAppender[user](context.Background(), vlg, reg, topicID, schema.JSONCodec[user]{})
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+
+	_, err = reg.Register(context.Background(), topicID, schema.TypeJSON, []byte("{"))
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	s1, err := reg.Register(context.Background(), topicID, schema.TypeJSON, []byte(v1))
+	require.NoError(t, err)
+	require.Equal(t, topicID, s1.TopicID)
+	require.Equal(t, 1, s1.Version)
+
+	// The same definition resolves to the latest version.
+	dup, err := reg.Register(context.Background(), topicID, schema.TypeJSON, []byte(v1))
+	require.NoError(t, err)
+	require.Equal(t, s1, dup)
+
+	// The type of the subject cannot change.
+	_, err = reg.Register(context.Background(), topicID, schema.TypeAvro, []byte(`"string"`))
+	require.ErrorIs(t, err, schema.ErrIncompatible)
+
+	appender1, err := schema.NewTypedAppender[user](context.Background(), vlg, reg, topicID, schema.JSONCodec[user]{})
+	require.NoError(t, err)
+	require.Equal(t, s1, appender1.Schema())
+
+	// v3 makes a property required, thus, it breaks consumers reading log
+	// entries written with v1.
+	_, err = reg.Register(context.Background(), topicID, schema.TypeJSON, []byte(v3))
+	require.ErrorIs(t, err, schema.ErrIncompatible)
+	s3, err := reg.Register(context.Background(), topicID, schema.TypeJSON, []byte(v3), schema.WithCompatibility(schema.CompatibilityForward))
+	require.NoError(t, err)
+	require.Equal(t, 2, s3.Version)
+
+	s4, err := reg.Register(context.Background(), topicID, schema.TypeJSON, []byte(v2), schema.WithCompatibility(schema.CompatibilityNone))
+	require.NoError(t, err)
+	require.Equal(t, 3, s4.Version)
+
+	appender2, err := schema.NewTypedAppender[user](context.Background(), vlg, reg, topicID, schema.JSONCodec[user]{})
+	require.NoError(t, err)
+	require.Equal(t, s4, appender2.Schema())
+
+	// Subjects of topics are independent.
+	other, err := reg.Register(context.Background(), otherTopicID, schema.TypeJSON, []byte(v1))
+	require.NoError(t, err)
+	require.Equal(t, 1, other.Version)
+	require.NotEqual(t, s1.ID, other.ID)
+
+	// Another registry sharing the registry topic agrees on the subjects.
+	replica := schema.NewRegistry(vlg, registryTopicID)
+	versions, err := replica.Versions(context.Background(), topicID)
+	require.NoError(t, err)
+	require.Equal(t, []schema.Schema{s1, s3, s4}, versions)
+	latest, err := replica.Latest(context.Background(), otherTopicID)
+	require.NoError(t, err)
+	require.Equal(t, other, latest)
+	got, err := replica.Schema(context.Background(), s3.ID)
+	require.NoError(t, err)
+	require.Equal(t, s3, got)
+	_, err = replica.Schema(context.Background(), schema.ID(1<<32))
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+}
@@ -0,0 +1,109 @@
+// Package schema provides a schema registry for topics and typed producers
+// and consumers built on it.
+//
+// Each topic has a subject, which is a list of versions of the schema of its
+// payloads. Registering a new version is rejected unless it is compatible with
+// the latest one; hence, producers cannot change the payload format of a
+// shared topic in a way that breaks consumers. TypedAppender records the ID of
+// the schema in each log entry, and TypedSubscriber checks it before decoding
+// the log entry.
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+)
+
+// ErrIncompatible is returned when a schema is not compatible with the latest
+// version of its subject.
+var ErrIncompatible = errors.New("incompatible schema")
+
+// Type is the format of schema definitions.
+type Type int
+
+const (
+	// TypeAvro is Apache Avro. A definition is an Avro schema in JSON.
+	TypeAvro Type = iota + 1
+	// TypeProtobuf is Protocol Buffers. A definition is a
+	// FileDescriptorSet in the binary format, which should include all
+	// imported files, for instance, generated by `protoc --include_imports
+	// --descriptor_set_out`.
+	TypeProtobuf
+	// TypeJSON is JSON Schema. A definition is a JSON Schema document, of
+	// which the keywords "type", "properties", "required", "items", and
+	// "additionalProperties" are considered in compatibility checks.
+	TypeJSON
+)
+
+var typeNames = map[Type]string{
+	TypeAvro:     "avro",
+	TypeProtobuf: "protobuf",
+	TypeJSON:     "json",
+}
+
+func (t Type) String() string {
+	if name, ok := typeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("Type(%d)", int(t))
+}
+
+// ParseType parses the name of a type, which is one of "avro", "protobuf",
+// and "json". It is case-insensitive.
+func ParseType(s string) (Type, error) {
+	for t, name := range typeNames {
+		if strings.EqualFold(s, name) {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("schema: unknown type %q: %w", s, verrors.ErrInvalid)
+}
+
+// Compatibility is the rule that a new version of a subject should satisfy
+// against the latest one.
+type Compatibility int
+
+const (
+	// CompatibilityBackward means that consumers using the new version can
+	// read log entries written with the latest one.
+	CompatibilityBackward Compatibility = iota
+	// CompatibilityForward means that consumers using the latest version
+	// can read log entries written with the new one.
+	CompatibilityForward
+	// CompatibilityFull means both CompatibilityBackward and
+	// CompatibilityForward.
+	CompatibilityFull
+	// CompatibilityNone does not check compatibility.
+	CompatibilityNone
+)
+
+var compatibilityNames = map[Compatibility]string{
+	CompatibilityBackward: "backward",
+	CompatibilityForward:  "forward",
+	CompatibilityFull:     "full",
+	CompatibilityNone:     "none",
+}
+
+func (c Compatibility) String() string {
+	if name, ok := compatibilityNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("Compatibility(%d)", int(c))
+}
+
+// ID identifies a schema in a registry. It is the GLSN of the log entry that
+// registered the schema in the registry topic.
+type ID uint64
+
+// Schema is a version of the subject of a topic.
+type Schema struct {
+	ID         ID
+	TopicID    types.TopicID
+	Version    int
+	Type       Type
+	Definition []byte
+}
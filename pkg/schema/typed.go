@@ -0,0 +1,166 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/varlog"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// SchemaIDHeader is the key of the header that records the ID of the schema of
+// a log entry appended by TypedAppender.
+const SchemaIDHeader = "varlog-schema-id"
+
+// Codec encodes and decodes values of type T. It should agree with the schema
+// used by TypedAppender and TypedSubscriber; for instance, it is generated by
+// Avro or Protocol Buffers compilers from the schema.
+type Codec[T any] interface {
+	Marshal(v T) ([]byte, error)
+	Unmarshal(data []byte) (T, error)
+}
+
+// JSONCodec is a Codec encoding values in JSON, which is used with schemas of
+// TypeJSON.
+type JSONCodec[T any] struct{}
+
+var _ Codec[struct{}] = JSONCodec[struct{}]{}
+
+func (JSONCodec[T]) Marshal(v T) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (JSONCodec[T]) Unmarshal(data []byte) (T, error) {
+	var v T
+	err := json.Unmarshal(data, &v)
+	return v, err
+}
+
+// TypedAppender appends values of type T to a topic with the latest version of
+// the subject of the topic at the time it is created.
+type TypedAppender[T any] struct {
+	vlg      varlog.Log
+	schema   Schema
+	codec    Codec[T]
+	schemaID []byte
+}
+
+// NewTypedAppender creates a TypedAppender for the topic identified by the
+// argument topicID. It returns an error wrapping verrors.ErrNotExist if the
+// subject of the topic has no version in the registry.
+func NewTypedAppender[T any](ctx context.Context, vlg varlog.Log, reg *Registry, topicID types.TopicID, codec Codec[T]) (*TypedAppender[T], error) {
+	s, err := reg.Latest(ctx, topicID)
+	if err != nil {
+		return nil, err
+	}
+	return &TypedAppender[T]{
+		vlg:      vlg,
+		schema:   s,
+		codec:    codec,
+		schemaID: []byte(strconv.FormatUint(uint64(s.ID), 10)),
+	}, nil
+}
+
+// Schema returns the schema with which the TypedAppender appends values.
+func (a *TypedAppender[T]) Schema() Schema {
+	return a.schema
+}
+
+// Append encodes the values and appends them to the topic in a batch. The ID of
+// the schema is recorded in SchemaIDHeader of each log entry. Since the
+// TypedAppender sets headers of log entries, varlog.WithHeaders in the
+// argument opts is ignored.
+func (a *TypedAppender[T]) Append(ctx context.Context, values []T, opts ...varlog.AppendOption) varlog.AppendResult {
+	data := make([][]byte, len(values))
+	headers := make([]map[string][]byte, len(values))
+	for i := range values {
+		var err error
+		data[i], err = a.codec.Marshal(values[i])
+		if err != nil {
+			return varlog.AppendResult{Err: fmt.Errorf("typed appender: %w", err)}
+		}
+		headers[i] = map[string][]byte{SchemaIDHeader: a.schemaID}
+	}
+	opts = append(opts, varlog.WithHeaders(headers...))
+	return a.vlg.Append(ctx, a.schema.TopicID, data, opts...)
+}
+
+// TypedLogEntry is a log entry decoded by TypedSubscriber.
+type TypedLogEntry[T any] struct {
+	varlogpb.LogEntryMeta
+	// Schema is the schema with which the log entry was appended.
+	Schema Schema
+	Value  T
+}
+
+// TypedSubscriber decodes log entries of a topic into values of type T.
+type TypedSubscriber[T any] struct {
+	ctx     context.Context
+	sub     varlog.Subscriber
+	reg     *Registry
+	topicID types.TopicID
+	codec   Codec[T]
+}
+
+// NewTypedSubscriber subscribes to the topic identified by the argument topicID
+// from the GLSN begin to the GLSN end exclusively through varlog.Log's
+// SubscribeIter. The caller should call Close of the returned TypedSubscriber.
+func NewTypedSubscriber[T any](ctx context.Context, vlg varlog.Log, reg *Registry, topicID types.TopicID, begin, end types.GLSN, codec Codec[T], opts ...varlog.SubscribeOption) *TypedSubscriber[T] {
+	return &TypedSubscriber[T]{
+		ctx:     ctx,
+		sub:     vlg.SubscribeIter(ctx, topicID, begin, end, opts...),
+		reg:     reg,
+		topicID: topicID,
+		codec:   codec,
+	}
+}
+
+// Next returns the next log entry decoded. It returns io.EOF after the last log
+// entry in the range.
+//
+// If the log entry has no schema ID, its schema is not a version of the
+// subject of the topic, or it cannot be decoded, Next returns an error
+// wrapping verrors.ErrInvalid with the metadata of the log entry. In that case,
+// the caller can skip the log entry by calling Next again.
+func (s *TypedSubscriber[T]) Next() (TypedLogEntry[T], error) {
+	var typed TypedLogEntry[T]
+	logEntry, err := s.sub.Next()
+	if err != nil {
+		return typed, err
+	}
+	typed.LogEntryMeta = logEntry.LogEntryMeta
+
+	value, ok := logEntry.Headers[SchemaIDHeader]
+	if !ok {
+		return typed, fmt.Errorf("typed subscriber: glsn %d: no schema id: %w", logEntry.GLSN, verrors.ErrInvalid)
+	}
+	id, err := strconv.ParseUint(string(value), 10, 64)
+	if err != nil {
+		return typed, fmt.Errorf("typed subscriber: glsn %d: schema id %q: %w", logEntry.GLSN, value, verrors.ErrInvalid)
+	}
+	typed.Schema, err = s.reg.Schema(s.ctx, ID(id))
+	if errors.Is(err, verrors.ErrNotExist) {
+		return typed, fmt.Errorf("typed subscriber: glsn %d: %v: %w", logEntry.GLSN, err, verrors.ErrInvalid)
+	}
+	if err != nil {
+		return typed, fmt.Errorf("typed subscriber: glsn %d: %w", logEntry.GLSN, err)
+	}
+	if typed.Schema.TopicID != s.topicID {
+		return typed, fmt.Errorf("typed subscriber: glsn %d: schema %d of topic %d: %w", logEntry.GLSN, id, typed.Schema.TopicID, verrors.ErrInvalid)
+	}
+	typed.Value, err = s.codec.Unmarshal(logEntry.Data)
+	if err != nil {
+		return typed, fmt.Errorf("typed subscriber: glsn %d: %v: %w", logEntry.GLSN, err, verrors.ErrInvalid)
+	}
+	return typed, nil
+}
+
+// Close closes the subscription.
+func (s *TypedSubscriber[T]) Close() error {
+	return s.sub.Close()
+}
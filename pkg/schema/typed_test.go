@@ -0,0 +1,158 @@
+package schema_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/schema"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/varlog"
+	"github.com/kakao/varlog/pkg/varlogtest"
+	"github.com/kakao/varlog/pkg/verrors"
+)
+
+type failingCodec struct {
+	schema.JSONCodec[user]
+}
+
+func (failingCodec) Marshal(user) ([]byte, error) {
+	return nil, errors.New("marshal")
+}
+
+func TestJSONCodec(t *testing.T) {
+	codec := schema.JSONCodec[user]{}
+
+	data, err := codec.Marshal(user{ID: 1, Name: "foo"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":1,"name":"foo"}`, string(data))
+
+	u, err := codec.Unmarshal(data)
+	require.NoError(t, err)
+	require.Equal(t, user{ID: 1, Name: "foo"}, u)
+
+	_, err = codec.Unmarshal([]byte("{"))
+	require.Error(t, err)
+}
+
+func TestTyped(t *testing.T) {
+	const (
+		v1 = `{"type":"object","properties":{"id":{"type":"integer"},"name":{"type":"string"}},"required":["id"]}`
+		v2 = `{"type":"object","properties":{"id":{"type":"integer"},"name":{"type":"string"},"email":{"type":"string"}},"required":["id"]}`
+	)
+	ctx := context.Background()
+
+	vt := varlogtest.New(types.ClusterID(1), 1)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	_, err := adm.AddStorageNode(ctx, types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+	addTopic := func() types.TopicID {
+		td, err := adm.AddTopic(ctx)
+		require.NoError(t, err)
+		_, err = adm.AddLogStream(ctx, td.TopicID, nil)
+		require.NoError(t, err)
+		return td.TopicID
+	}
+	registryTopicID := addTopic()
+	topicID := addTopic()
+	otherTopicID := addTopic()
+
+	reg := schema.NewRegistry(vlg, registryTopicID)
+	s1, err := reg.Register(ctx, topicID, schema.TypeJSON, []byte(v1))
+	require.NoError(t, err)
+	other, err := reg.Register(ctx, otherTopicID, schema.TypeJSON, []byte(v1))
+	require.NoError(t, err)
+
+	appender1, err := schema.NewTypedAppender[user](ctx, vlg, reg, topicID, schema.JSONCodec[user]{})
+	require.NoError(t, err)
+	s2, err := reg.Register(ctx, topicID, schema.TypeJSON, []byte(v2))
+	require.NoError(t, err)
+	appender2, err := schema.NewTypedAppender[user](ctx, vlg, reg, topicID, schema.JSONCodec[user]{})
+	require.NoError(t, err)
+	require.Equal(t, s2, appender2.Schema())
+
+	// Each log entry records the schema of the appender that appended it,
+	// and headers given by the caller are ignored.
+	res := appender1.Append(ctx, []user{{ID: 1, Name: "foo"}, {ID: 2, Name: "bar"}},
+		varlog.WithHeaders(map[string][]byte{"key": []byte("value")}, nil),
+	)
+	require.NoError(t, res.Err)
+	require.Len(t, res.Metadata, 2)
+	res = appender2.Append(ctx, []user{{ID: 3, Name: "baz", Email: "baz@example.com"}})
+	require.NoError(t, res.Err)
+
+	logEntry, err := vlg.Read(ctx, topicID, res.Metadata[0].GLSN)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{
+		schema.SchemaIDHeader: []byte(strconv.FormatUint(uint64(s2.ID), 10)),
+	}, logEntry.Headers)
+
+	// The codec fails to encode a value.
+	failing, err := schema.NewTypedAppender[user](ctx, vlg, reg, topicID, failingCodec{})
+	require.NoError(t, err)
+	res = failing.Append(ctx, []user{{ID: 4}})
+	require.Error(t, res.Err)
+
+	// Invalid log entries are appended without TypedAppender.
+	appendRaw := func(data string, headers map[string][]byte) {
+		res := vlg.Append(ctx, topicID, [][]byte{[]byte(data)}, varlog.WithHeaders(headers))
+		require.NoError(t, res.Err)
+	}
+	schemaID := func(s schema.Schema) []byte {
+		return []byte(strconv.FormatUint(uint64(s.ID), 10))
+	}
+	appendRaw(`{"id":5}`, nil)
+	appendRaw(`{"id":6}`, map[string][]byte{schema.SchemaIDHeader: []byte("not-a-number")})
+	appendRaw(`{"id":7}`, map[string][]byte{schema.SchemaIDHeader: []byte("4294967296")})
+	appendRaw(`{"id":8}`, map[string][]byte{schema.SchemaIDHeader: schemaID(other)})
+	appendRaw(`{`, map[string][]byte{schema.SchemaIDHeader: schemaID(s2)})
+	res = appender2.Append(ctx, []user{{ID: 9}})
+	require.NoError(t, res.Err)
+	end := res.Metadata[0].GLSN + 1
+
+	sub := schema.NewTypedSubscriber[user](ctx, vlg, reg, topicID, types.MinGLSN, end, schema.JSONCodec[user]{})
+	defer func() {
+		require.NoError(t, sub.Close())
+	}()
+
+	expected := []struct {
+		schema schema.Schema
+		value  user
+	}{
+		{schema: s1, value: user{ID: 1, Name: "foo"}},
+		{schema: s1, value: user{ID: 2, Name: "bar"}},
+		{schema: s2, value: user{ID: 3, Name: "baz", Email: "baz@example.com"}},
+	}
+	for i, exp := range expected {
+		typed, err := sub.Next()
+		require.NoError(t, err)
+		require.Equal(t, types.GLSN(i+1), typed.GLSN)
+		require.Equal(t, exp.schema, typed.Schema)
+		require.Equal(t, exp.value, typed.Value)
+	}
+
+	// The subscriber skips invalid log entries by calling Next again.
+	for glsn := types.GLSN(4); glsn < end-1; glsn++ {
+		typed, err := sub.Next()
+		require.ErrorIs(t, err, verrors.ErrInvalid)
+		require.Equal(t, glsn, typed.GLSN)
+	}
+
+	typed, err := sub.Next()
+	require.NoError(t, err)
+	require.Equal(t, s2, typed.Schema)
+	require.Equal(t, user{ID: 9}, typed.Value)
+
+	_, err = sub.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
@@ -0,0 +1,43 @@
+package telemetry
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracePropagator is the propagator of trace contexts between clients and
+// storage nodes. It is fixed to the W3C Trace Context rather than the global
+// propagator since all components of a cluster should agree on it.
+var tracePropagator = propagation.TraceContext{}
+
+// InjectTraceContext returns the trace context of the span in the argument ctx
+// to carry it in requests. It returns nil if the ctx has no valid span
+// context; hence, untraced requests pay nothing.
+func InjectTraceContext(ctx context.Context) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+	carrier := propagation.MapCarrier{}
+	tracePropagator.Inject(ctx, carrier)
+	return carrier
+}
+
+// ExtractTraceContext returns a copy of the argument ctx with the remote span
+// context carried by the argument traceContext, which is created by
+// InjectTraceContext. It returns the ctx as it is if the traceContext is
+// empty.
+func ExtractTraceContext(ctx context.Context, traceContext map[string]string) context.Context {
+	if len(traceContext) == 0 {
+		return ctx
+	}
+	return tracePropagator.Extract(ctx, propagation.MapCarrier(traceContext))
+}
+
+// SetGlobalTracerProvider sets the global tracer provider, which storage nodes
+// use to emit spans of appends and replications.
+func SetGlobalTracerProvider(tp trace.TracerProvider) {
+	otel.SetTracerProvider(tp)
+}
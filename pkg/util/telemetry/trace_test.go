@@ -0,0 +1,29 @@
+package telemetry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceContext(t *testing.T) {
+	ctx := context.Background()
+	require.Nil(t, InjectTraceContext(ctx))
+	require.Equal(t, ctx, ExtractTraceContext(ctx, nil))
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	traceContext := InjectTraceContext(trace.ContextWithSpanContext(ctx, sc))
+	require.NotEmpty(t, traceContext)
+
+	extracted := trace.SpanContextFromContext(ExtractTraceContext(ctx, traceContext))
+	require.True(t, extracted.IsRemote())
+	require.Equal(t, sc.TraceID(), extracted.TraceID())
+	require.Equal(t, sc.SpanID(), extracted.SpanID())
+	require.True(t, extracted.IsSampled())
+}
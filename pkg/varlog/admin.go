@@ -87,6 +87,11 @@ type Admin interface {
 	// If the admin could not fetch cluster metadata, it returns an error,
 	// and users can retry this RPC.
 	UnregisterTopic(ctx context.Context, tpid types.TopicID, opts ...AdminCallOption) error
+	// SetRetentionPolicy replaces the retention policy of the topic
+	// specified by the argument tpid and returns the updated topic.
+	// Passing a nil or empty policy removes the retention policy.
+	// It returns the ErrNotExist error if the topic does not exist.
+	SetRetentionPolicy(ctx context.Context, tpid types.TopicID, policy *varlogpb.RetentionPolicy, opts ...AdminCallOption) (*varlogpb.TopicDescriptor, error)
 
 	// GetLogStream returns metadata of log stream specified by the argument tpid and lsid.
 	// It returns an error if there is no topic or log stream.
@@ -145,6 +150,14 @@ type Admin interface {
 	// lastGLSN.
 	// Note that the return type of this method can be changed soon.
 	Trim(ctx context.Context, tpid types.TopicID, lastGLSN types.GLSN, opts ...AdminCallOption) (map[types.LogStreamID]map[types.StorageNodeID]error, error)
+	// Backup takes a point-in-time backup of the cluster into the directory
+	// path, which must not exist and should be accessible to the admin server
+	// and all storage nodes. It returns the manifest of the backup.
+	//
+	// The backup can be restored by starting a new cluster, whose
+	// metadata repositories and storage nodes are given the backup directory
+	// by their flag "--restore-from".
+	Backup(ctx context.Context, path string, opts ...AdminCallOption) (*admpb.BackupManifest, error)
 
 	GetMetadataRepositoryNode(ctx context.Context, nid types.NodeID, opts ...AdminCallOption) (*varlogpb.MetadataRepositoryNode, error)
 	ListMetadataRepositoryNodes(ctx context.Context, opts ...AdminCallOption) ([]varlogpb.MetadataRepositoryNode, error)
@@ -157,6 +170,30 @@ type Admin interface {
 	// RemoveMRPeer unregisters the metadata repository from the cluster.
 	RemoveMRPeer(ctx context.Context, raftURL string, opts ...AdminCallOption) error
 
+	// ConsumerGroupHeartbeat renews the session of the member identified by
+	// the argument memberID in the consumer group of the topic tpid, and
+	// returns log streams assigned to the member. The argument generation is
+	// the generation of the last assignment the member received, or zero if
+	// the member joins the group. The argument owned is log streams the
+	// member consumes now.
+	// It returns an error wrapping verrors.ErrNotMember if the session of the
+	// member has expired.
+	// Users usually do not call it directly; GroupConsumer calls it
+	// periodically.
+	ConsumerGroupHeartbeat(ctx context.Context, group string, tpid types.TopicID, memberID string, generation uint64, owned []types.LogStreamID, opts ...AdminCallOption) (*admpb.ConsumerGroupHeartbeatResponse, error)
+	// LeaveConsumerGroup removes the member from the consumer group of the
+	// topic tpid so that the group rebalances without waiting for the
+	// session of the member to expire.
+	LeaveConsumerGroup(ctx context.Context, group string, tpid types.TopicID, memberID string, opts ...AdminCallOption) error
+	// CommitConsumerGroupOffset commits the LLSN of the last log entry in
+	// the log stream processed by the member of the consumer group. It
+	// returns an error wrapping verrors.ErrNotMember if the member is fenced,
+	// that is, its session has expired, the argument generation is stale, or
+	// it does not own the log stream.
+	// Users usually do not call it directly; GroupConsumer calls it
+	// periodically.
+	CommitConsumerGroupOffset(ctx context.Context, group string, tpid types.TopicID, memberID string, generation uint64, lsid types.LogStreamID, llsn types.LLSN, opts ...AdminCallOption) error
+
 	// Close closes a connection to the admin server.
 	// Once this method is called, the Client can't be used anymore.
 	Close() error
@@ -321,6 +358,24 @@ func (c *admin) UnregisterTopic(ctx context.Context, topicID types.TopicID, opts
 	return err
 }
 
+func (c *admin) SetRetentionPolicy(ctx context.Context, tpid types.TopicID, policy *varlogpb.RetentionPolicy, opts ...AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.SetRetentionPolicy(ctx, &admpb.SetRetentionPolicyRequest{
+		TopicID:         tpid,
+		RetentionPolicy: policy,
+	})
+	if err != nil {
+		if st := status.Convert(err); st.Code() == codes.NotFound {
+			err = verrors.ErrNotExist
+		}
+		return nil, errors.WithMessage(err, "admin: set retention policy")
+	}
+	return rsp.GetTopic(), nil
+}
+
 func (c *admin) GetLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, opts ...AdminCallOption) (*varlogpb.LogStreamDescriptor, error) {
 	cfg := newAdminCallConfig(c.adminCallOptions, opts)
 	ctx, cancel := cfg.withTimeoutContext(ctx)
@@ -486,6 +541,18 @@ func (c *admin) Trim(ctx context.Context, topicID types.TopicID, lastGLSN types.
 	return ret, nil
 }
 
+func (c *admin) Backup(ctx context.Context, path string, opts ...AdminCallOption) (*admpb.BackupManifest, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.Backup(ctx, &admpb.BackupRequest{Path: path})
+	if err != nil {
+		return nil, err
+	}
+	return &rsp.Manifest, nil
+}
+
 func (c *admin) GetMetadataRepositoryNode(ctx context.Context, nid types.NodeID, opts ...AdminCallOption) (*varlogpb.MetadataRepositoryNode, error) {
 	cfg := newAdminCallConfig(c.adminCallOptions, opts)
 	ctx, cancel := cfg.withTimeoutContext(ctx)
@@ -562,3 +629,73 @@ func (c *admin) RemoveMRPeer(ctx context.Context, raftURL string, opts ...AdminC
 	_, err := c.rpcClient.RemoveMRPeer(ctx, &admpb.RemoveMRPeerRequest{RaftURL: raftURL})
 	return err
 }
+
+func (c *admin) ConsumerGroupHeartbeat(ctx context.Context, group string, tpid types.TopicID, memberID string, generation uint64, owned []types.LogStreamID, opts ...AdminCallOption) (*admpb.ConsumerGroupHeartbeatResponse, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.ConsumerGroupHeartbeat(ctx, &admpb.ConsumerGroupHeartbeatRequest{
+		Group:             group,
+		TopicID:           tpid,
+		MemberID:          memberID,
+		OwnedLogStreamIDs: owned,
+		Generation:        generation,
+	})
+	if err != nil {
+		switch status.Convert(err).Code() {
+		case codes.NotFound:
+			err = verrors.ErrNotExist
+		case codes.FailedPrecondition:
+			err = verrors.ErrNotMember
+		case codes.Unavailable:
+			err = verrors.ErrUnavailable
+		case codes.InvalidArgument:
+			err = verrors.ErrInvalid
+		}
+		return nil, errors.WithMessage(err, "admin: consumer group heartbeat")
+	}
+	return rsp, nil
+}
+
+func (c *admin) LeaveConsumerGroup(ctx context.Context, group string, tpid types.TopicID, memberID string, opts ...AdminCallOption) error {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	_, err := c.rpcClient.LeaveConsumerGroup(ctx, &admpb.LeaveConsumerGroupRequest{
+		Group:    group,
+		TopicID:  tpid,
+		MemberID: memberID,
+	})
+	return err
+}
+
+func (c *admin) CommitConsumerGroupOffset(ctx context.Context, group string, tpid types.TopicID, memberID string, generation uint64, lsid types.LogStreamID, llsn types.LLSN, opts ...AdminCallOption) error {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	_, err := c.rpcClient.CommitConsumerGroupOffset(ctx, &admpb.CommitConsumerGroupOffsetRequest{
+		Group:       group,
+		TopicID:     tpid,
+		MemberID:    memberID,
+		Generation:  generation,
+		LogStreamID: lsid,
+		LLSN:        llsn,
+	})
+	if err != nil {
+		switch status.Convert(err).Code() {
+		case codes.NotFound:
+			err = verrors.ErrNotExist
+		case codes.FailedPrecondition:
+			err = verrors.ErrNotMember
+		case codes.Unavailable:
+			err = verrors.ErrUnavailable
+		case codes.InvalidArgument:
+			err = verrors.ErrInvalid
+		}
+		return errors.WithMessage(err, "admin: commit consumer group offset")
+	}
+	return nil
+}
@@ -139,6 +139,26 @@ func (mr *MockAdminMockRecorder) AddTopic(arg0 interface{}, arg1 ...interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTopic", reflect.TypeOf((*MockAdmin)(nil).AddTopic), varargs...)
 }
 
+// Backup mocks base method.
+func (m *MockAdmin) Backup(arg0 context.Context, arg1 string, arg2 ...AdminCallOption) (*admpb.BackupManifest, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Backup", varargs...)
+	ret0, _ := ret[0].(*admpb.BackupManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockAdminMockRecorder) Backup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockAdmin)(nil).Backup), varargs...)
+}
+
 // Close mocks base method.
 func (m *MockAdmin) Close() error {
 	m.ctrl.T.Helper()
@@ -153,6 +173,45 @@ func (mr *MockAdminMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAdmin)(nil).Close))
 }
 
+// CommitConsumerGroupOffset mocks base method.
+func (m *MockAdmin) CommitConsumerGroupOffset(arg0 context.Context, arg1 string, arg2 types.TopicID, arg3 string, arg4 uint64, arg5 types.LogStreamID, arg6 types.LLSN, arg7 ...AdminCallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3, arg4, arg5, arg6}
+	for _, a := range arg7 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitConsumerGroupOffset", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitConsumerGroupOffset indicates an expected call of CommitConsumerGroupOffset.
+func (mr *MockAdminMockRecorder) CommitConsumerGroupOffset(arg0, arg1, arg2, arg3, arg4, arg5, arg6 interface{}, arg7 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3, arg4, arg5, arg6}, arg7...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConsumerGroupOffset", reflect.TypeOf((*MockAdmin)(nil).CommitConsumerGroupOffset), varargs...)
+}
+
+// ConsumerGroupHeartbeat mocks base method.
+func (m *MockAdmin) ConsumerGroupHeartbeat(arg0 context.Context, arg1 string, arg2 types.TopicID, arg3 string, arg4 uint64, arg5 []types.LogStreamID, arg6 ...AdminCallOption) (*admpb.ConsumerGroupHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3, arg4, arg5}
+	for _, a := range arg6 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConsumerGroupHeartbeat", varargs...)
+	ret0, _ := ret[0].(*admpb.ConsumerGroupHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumerGroupHeartbeat indicates an expected call of ConsumerGroupHeartbeat.
+func (mr *MockAdminMockRecorder) ConsumerGroupHeartbeat(arg0, arg1, arg2, arg3, arg4, arg5 interface{}, arg6 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3, arg4, arg5}, arg6...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumerGroupHeartbeat", reflect.TypeOf((*MockAdmin)(nil).ConsumerGroupHeartbeat), varargs...)
+}
+
 // DeleteMetadataRepositoryNode mocks base method.
 func (m *MockAdmin) DeleteMetadataRepositoryNode(arg0 context.Context, arg1 types.NodeID, arg2 ...AdminCallOption) error {
 	m.ctrl.T.Helper()
@@ -312,6 +371,25 @@ func (mr *MockAdminMockRecorder) GetTopic(arg0, arg1 interface{}, arg2 ...interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopic", reflect.TypeOf((*MockAdmin)(nil).GetTopic), varargs...)
 }
 
+// LeaveConsumerGroup mocks base method.
+func (m *MockAdmin) LeaveConsumerGroup(arg0 context.Context, arg1 string, arg2 types.TopicID, arg3 string, arg4 ...AdminCallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LeaveConsumerGroup", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveConsumerGroup indicates an expected call of LeaveConsumerGroup.
+func (mr *MockAdminMockRecorder) LeaveConsumerGroup(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveConsumerGroup", reflect.TypeOf((*MockAdmin)(nil).LeaveConsumerGroup), varargs...)
+}
+
 // ListLogStreams mocks base method.
 func (m *MockAdmin) ListLogStreams(arg0 context.Context, arg1 types.TopicID, arg2 ...AdminCallOption) ([]varlogpb.LogStreamDescriptor, error) {
 	m.ctrl.T.Helper()
@@ -450,6 +528,26 @@ func (mr *MockAdminMockRecorder) Seal(arg0, arg1, arg2 interface{}, arg3 ...inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockAdmin)(nil).Seal), varargs...)
 }
 
+// SetRetentionPolicy mocks base method.
+func (m *MockAdmin) SetRetentionPolicy(arg0 context.Context, arg1 types.TopicID, arg2 *varlogpb.RetentionPolicy, arg3 ...AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetRetentionPolicy", varargs...)
+	ret0, _ := ret[0].(*varlogpb.TopicDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRetentionPolicy indicates an expected call of SetRetentionPolicy.
+func (mr *MockAdminMockRecorder) SetRetentionPolicy(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetentionPolicy", reflect.TypeOf((*MockAdmin)(nil).SetRetentionPolicy), varargs...)
+}
+
 // Sync mocks base method.
 func (m *MockAdmin) Sync(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3, arg4 types.StorageNodeID, arg5 ...AdminCallOption) (*snpb.SyncStatus, error) {
 	m.ctrl.T.Helper()
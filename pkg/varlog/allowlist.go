@@ -46,7 +46,10 @@ type transientAllowlist struct {
 	expireInterval time.Duration
 	runner         *runner.Runner
 	cancel         context.CancelFunc
-	logger         *zap.Logger
+	// metrics counts denials. It is nil if the allowlist is not created by
+	// the client.
+	metrics *clientMetrics
+	logger  *zap.Logger
 }
 
 var _ RenewableAllowlist = (*transientAllowlist)(nil)
@@ -193,6 +196,7 @@ func (adl *transientAllowlist) Deny(topicID types.TopicID, logStreamID types.Log
 		lsMap := lsMapIf.(*sync.Map)
 		lsMap.Store(logStreamID, item)
 	}
+	adl.metrics.addDeny(topicID, logStreamID)
 
 	adl.warmup()
 }
@@ -0,0 +1,418 @@
+package varlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kakao/varlog/internal/storagenode/client"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// AppendCallback is called with the metadata of the log entry appended by
+// Batcher.AppendAsync. If the append fails, err is not nil and the metadata is
+// invalid.
+type AppendCallback func(meta varlogpb.LogEntryMeta, err error)
+
+// Batcher appends log entries to a topic asynchronously. It accumulates log
+// entries into a batch and sends the batch when the batch is full or the
+// linger time passes. It also sends batches without waiting for the results
+// of previous ones, up to the pipeline size, to a log stream.
+//
+// Log entries appended by AppendAsync are sent in the order of calls, and
+// callbacks are invoked in the same order as long as they are sent to the same
+// log stream. The Batcher sends batches to another log stream only when the
+// current one fails; it does not retry failed log entries.
+type Batcher interface {
+	// AppendAsync adds the data with its headers, which can be nil, to the
+	// current batch. The callback is called after the log entry is
+	// committed or failed. Callbacks run in a goroutine of the Batcher one
+	// at a time; hence, they should return quickly and must not call
+	// AppendAsync, Flush, or Close of the same Batcher.
+	//
+	// It blocks if the pipeline is full. It returns an error wrapping
+	// verrors.ErrClosed if the Batcher is already closed.
+	AppendAsync(data []byte, headers map[string][]byte, callback AppendCallback) error
+
+	// Flush sends the current batch immediately and waits for callbacks of
+	// all log entries appended so far to be called.
+	Flush(ctx context.Context) error
+
+	// Close flushes the Batcher and releases its resources.
+	Close() error
+}
+
+type appendBatch struct {
+	data      [][]byte
+	headers   []varlogpb.LogEntryHeaders
+	callbacks []AppendCallback
+	bytes     int
+	// sentAt is when the batch is sent to a log stream.
+	sentAt time.Time
+}
+
+func (ab *appendBatch) add(data []byte, headers map[string][]byte, callback AppendCallback) {
+	if len(headers) > 0 && ab.headers == nil {
+		ab.headers = make([]varlogpb.LogEntryHeaders, len(ab.data), cap(ab.data))
+	}
+	if ab.headers != nil {
+		ab.headers = append(ab.headers, varlogpb.LogEntryHeaders{Values: headers})
+	}
+	ab.data = append(ab.data, data)
+	ab.callbacks = append(ab.callbacks, callback)
+	ab.bytes += len(data)
+}
+
+// encode compresses and encrypts data of the batch before it is sent.
+func (ab *appendBatch) encode(ctx context.Context, codec Compression, kp KeyProvider) (err error) {
+	ab.data, ab.headers, err = encodeBatch(ctx, codec, kp, ab.data, ab.headers)
+	return err
+}
+
+type batcher struct {
+	v    *logImpl
+	tpid types.TopicID
+	opts batcherOptions
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	// batchC delivers full batches to the sendLoop in order.
+	batchC chan *appendBatch
+
+	mu     sync.Mutex
+	cur    *appendBatch
+	linger *time.Timer
+	closed bool
+
+	pendingMu sync.Mutex
+	pending   int
+	drained   chan struct{}
+
+	// callbackMu prevents callbacks from being called concurrently.
+	callbackMu sync.Mutex
+}
+
+var _ Batcher = (*batcher)(nil)
+
+func newBatcher(v *logImpl, tpid types.TopicID, opts ...BatcherOption) (*batcher, error) {
+	batcherOpts := defaultBatcherOptions()
+	for _, opt := range opts {
+		opt.apply(&batcherOpts)
+	}
+	if batcherOpts.maxRecords < 1 {
+		return nil, fmt.Errorf("batcher: invalid max records %d: %w", batcherOpts.maxRecords, verrors.ErrInvalid)
+	}
+	if batcherOpts.maxBytes < 1 {
+		return nil, fmt.Errorf("batcher: invalid max bytes %d: %w", batcherOpts.maxBytes, verrors.ErrInvalid)
+	}
+	if batcherOpts.pipelineSize < 1 {
+		return nil, fmt.Errorf("batcher: invalid pipeline size %d: %w", batcherOpts.pipelineSize, verrors.ErrInvalid)
+	}
+
+	b := &batcher{
+		v:      v,
+		tpid:   tpid,
+		opts:   batcherOpts,
+		batchC: make(chan *appendBatch, batcherOpts.pipelineSize),
+	}
+	b.ctx, b.cancel = context.WithCancel(context.Background())
+	b.wg.Add(1)
+	go b.sendLoop()
+	return b, nil
+}
+
+func (b *batcher) AppendAsync(data []byte, headers map[string][]byte, callback AppendCallback) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return fmt.Errorf("batcher: %w", verrors.ErrClosed)
+	}
+
+	b.addPending(1)
+	if b.cur == nil {
+		b.cur = &appendBatch{
+			data:      make([][]byte, 0, b.opts.maxRecords),
+			callbacks: make([]AppendCallback, 0, b.opts.maxRecords),
+		}
+		if b.opts.linger > 0 {
+			cur := b.cur
+			b.linger = time.AfterFunc(b.opts.linger, func() {
+				b.mu.Lock()
+				defer b.mu.Unlock()
+				if b.cur == cur {
+					b.flushLocked()
+				}
+			})
+		}
+	}
+	b.cur.add(data, headers, callback)
+	if b.opts.linger <= 0 || len(b.cur.data) >= b.opts.maxRecords || b.cur.bytes >= b.opts.maxBytes {
+		b.flushLocked()
+	}
+	return nil
+}
+
+// flushLocked hands over the current batch to the sendLoop. It holds the mu
+// while it blocks on the batchC so that batches are sent in order.
+func (b *batcher) flushLocked() {
+	if b.cur == nil {
+		return
+	}
+	if b.linger != nil {
+		b.linger.Stop()
+		b.linger = nil
+	}
+	b.batchC <- b.cur
+	b.cur = nil
+}
+
+func (b *batcher) Flush(ctx context.Context) error {
+	b.mu.Lock()
+	b.flushLocked()
+	b.mu.Unlock()
+
+	b.pendingMu.Lock()
+	if b.pending == 0 {
+		b.pendingMu.Unlock()
+		return nil
+	}
+	drained := b.drained
+	b.pendingMu.Unlock()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *batcher) Close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	b.flushLocked()
+	close(b.batchC)
+	b.mu.Unlock()
+
+	b.wg.Wait()
+	b.cancel()
+	return nil
+}
+
+func (b *batcher) addPending(delta int) {
+	b.pendingMu.Lock()
+	defer b.pendingMu.Unlock()
+	if b.pending == 0 {
+		b.drained = make(chan struct{})
+	}
+	b.pending += delta
+	if b.pending == 0 {
+		close(b.drained)
+	}
+}
+
+// complete calls callbacks of the batch with the results or the error.
+func (b *batcher) complete(ab *appendBatch, res []snpb.AppendResult, err error) {
+	b.callbackMu.Lock()
+	defer b.callbackMu.Unlock()
+	for i, callback := range ab.callbacks {
+		var meta varlogpb.LogEntryMeta
+		cerr := err
+		if cerr == nil {
+			switch {
+			case i >= len(res):
+				cerr = errors.New("batcher: no result")
+			case len(res[i].Error) > 0:
+				cerr = appendResultError(res[i].Error)
+			default:
+				meta = res[i].Meta
+			}
+		}
+		if callback != nil {
+			callback(meta, cerr)
+		}
+	}
+	b.addPending(-len(ab.callbacks))
+}
+
+func appendResultError(msg string) error {
+	if strings.Contains(msg, "sealed") {
+		return fmt.Errorf("batcher: %s: %w", msg, verrors.ErrSealed)
+	}
+	return fmt.Errorf("batcher: %s", msg)
+}
+
+func (b *batcher) sendLoop() {
+	defer b.wg.Done()
+
+	var sess *appendSession
+	for ab := range b.batchC {
+		if err := ab.encode(b.ctx, b.v.opts.compression, b.v.opts.keyProvider); err != nil {
+			b.complete(ab, nil, fmt.Errorf("batcher: %w", err))
+			continue
+		}
+		if sess != nil && sess.isBroken() {
+			sess.close()
+			sess = nil
+		}
+		if sess == nil {
+			var err error
+			sess, err = b.openSession()
+			if err != nil {
+				b.complete(ab, nil, err)
+				continue
+			}
+		}
+		sess.send(ab)
+	}
+	if sess != nil {
+		sess.close()
+	}
+}
+
+func (b *batcher) openSession() (*appendSession, error) {
+	lsid := b.opts.logStreamID
+	if lsid.Invalid() {
+		var ok bool
+		if lsid, ok = b.v.lsSelector.Select(b.tpid); !ok {
+			return nil, fmt.Errorf("batcher: no usable log stream in topic %d", b.tpid)
+		}
+	}
+
+	replicas, ok := b.v.replicasRetriever.Retrieve(b.tpid, lsid)
+	if !ok {
+		return nil, fmt.Errorf("batcher: log stream %d of topic %d does not exist", lsid, b.tpid)
+	}
+	cl, err := b.v.logCLManager.GetOrConnect(b.ctx, replicas[0].StorageNodeID, replicas[0].Address)
+	if err != nil {
+		b.v.allowlist.Deny(b.tpid, lsid)
+		return nil, fmt.Errorf("batcher: %w", err)
+	}
+	backups := make([]varlogpb.StorageNode, len(replicas)-1)
+	for i := 0; i < len(replicas)-1; i++ {
+		backups[i].StorageNodeID = replicas[i+1].StorageNodeID
+		backups[i].Address = replicas[i+1].Address
+	}
+
+	ctx, cancel := context.WithCancel(b.ctx)
+	stream, err := cl.AppendStream(ctx, b.tpid, lsid, backups...)
+	if err != nil {
+		cancel()
+		b.v.allowlist.Deny(b.tpid, lsid)
+		return nil, fmt.Errorf("batcher: %w", err)
+	}
+
+	sess := &appendSession{
+		b:         b,
+		lsid:      lsid,
+		stream:    stream,
+		cancel:    cancel,
+		inflightC: make(chan *appendBatch, b.opts.pipelineSize),
+		brokenC:   make(chan struct{}),
+	}
+	b.wg.Add(1)
+	go sess.recvLoop()
+	return sess, nil
+}
+
+// appendSession is an append stream to a log stream. Batches sent through the
+// session wait in the inflightC for their results.
+type appendSession struct {
+	b      *batcher
+	lsid   types.LogStreamID
+	stream *client.AppendStream
+	cancel context.CancelFunc
+
+	inflightC  chan *appendBatch
+	brokenC    chan struct{}
+	brokenOnce sync.Once
+}
+
+func (s *appendSession) send(ab *appendBatch) {
+	ab.sentAt = time.Now()
+	s.inflightC <- ab
+	s.b.v.metrics.addBytesOut(s.b.tpid, s.lsid, ab.data)
+	if err := s.stream.Send(ab.data, ab.headers); err != nil {
+		// The recvLoop fails the batch since the stream is broken.
+		s.setBroken()
+	}
+}
+
+func (s *appendSession) recvLoop() {
+	defer func() {
+		s.cancel()
+		s.b.wg.Done()
+	}()
+
+	var err error
+	for ab := range s.inflightC {
+		var res []snpb.AppendResult
+		if err == nil {
+			res, err = s.stream.Recv()
+			if err != nil {
+				err = fmt.Errorf("batcher: %w", err)
+				s.setBroken()
+			}
+			s.observe(ab, res, err)
+		}
+		s.b.complete(ab, res, err)
+		if err == nil && hasAppendResultError(res) {
+			// The log stream may be sealed; thus, the following
+			// batches are sent to another log stream.
+			s.setBroken()
+		}
+	}
+}
+
+// observe records the result of the batch and lets the log stream selector
+// know it.
+func (s *appendSession) observe(ab *appendBatch, res []snpb.AppendResult, err error) {
+	if err == nil && hasAppendResultError(res) {
+		err = errors.New("batcher: append failed")
+	}
+	s.b.v.observeAppend(s.b.tpid, s.lsid, time.Since(ab.sentAt), err)
+}
+
+func hasAppendResultError(res []snpb.AppendResult) bool {
+	for i := range res {
+		if len(res[i].Error) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *appendSession) setBroken() {
+	s.brokenOnce.Do(func() {
+		s.b.v.allowlist.Deny(s.b.tpid, s.lsid)
+		close(s.brokenC)
+	})
+}
+
+func (s *appendSession) isBroken() bool {
+	select {
+	case <-s.brokenC:
+		return true
+	default:
+		return false
+	}
+}
+
+// close stops sending batches through the session. The recvLoop still
+// receives results of the batches already sent.
+func (s *appendSession) close() {
+	_ = s.stream.CloseSend()
+	close(s.inflightC)
+}
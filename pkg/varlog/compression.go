@@ -0,0 +1,216 @@
+package varlog
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// Compression is a codec that compresses data of log entries in clients.
+// Storage nodes store and replicate compressed data as it is, and clients
+// decompress it when they read or subscribe to log entries.
+//
+// The codec is chosen per batch of log entries appended at once, but each log
+// entry is compressed alone; hence, a log entry can be read by itself even if
+// the others in its batch are trimmed.
+type Compression int
+
+const (
+	// CompressionNone does not compress data.
+	CompressionNone Compression = iota
+	// CompressionGzip compresses data by gzip.
+	CompressionGzip
+	// CompressionZstd compresses data by Zstandard.
+	CompressionZstd
+	// CompressionSnappy compresses data by Snappy.
+	CompressionSnappy
+	// CompressionLZ4 compresses data by LZ4.
+	CompressionLZ4
+)
+
+// CompressionHeader is the key of the header that records the codec of
+// compressed data of a log entry. Clients remove it after decompressing the
+// data; hence, applications do not see it.
+const CompressionHeader = "varlog-compression"
+
+var compressionNames = map[Compression]string{
+	CompressionNone:   "none",
+	CompressionGzip:   "gzip",
+	CompressionZstd:   "zstd",
+	CompressionSnappy: "snappy",
+	CompressionLZ4:    "lz4",
+}
+
+func (c Compression) String() string {
+	if name, ok := compressionNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("Compression(%d)", int(c))
+}
+
+// ParseCompression parses the name of a codec, which is one of "none",
+// "gzip", "zstd", "snappy", and "lz4". It is case-insensitive.
+func ParseCompression(s string) (Compression, error) {
+	for c, name := range compressionNames {
+		if strings.EqualFold(s, name) {
+			return c, nil
+		}
+	}
+	return CompressionNone, fmt.Errorf("compression: unknown codec %q: %w", s, verrors.ErrInvalid)
+}
+
+func (c Compression) valid() bool {
+	_, ok := compressionNames[c]
+	return ok
+}
+
+// Encoders and decoders of zstd are safe for concurrent use of EncodeAll and
+// DecodeAll, and expensive to create.
+var (
+	zstdEncoderOnce sync.Once
+	zstdEncoder     *zstd.Encoder
+	zstdDecoderOnce sync.Once
+	zstdDecoder     *zstd.Decoder
+)
+
+func getZstdEncoder() *zstd.Encoder {
+	zstdEncoderOnce.Do(func() {
+		zstdEncoder, _ = zstd.NewWriter(nil)
+	})
+	return zstdEncoder
+}
+
+func getZstdDecoder() *zstd.Decoder {
+	zstdDecoderOnce.Do(func() {
+		zstdDecoder, _ = zstd.NewReader(nil)
+	})
+	return zstdDecoder
+}
+
+func (c Compression) compress(data []byte) ([]byte, error) {
+	switch c {
+	case CompressionGzip:
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(data); err != nil {
+			return nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case CompressionZstd:
+		return getZstdEncoder().EncodeAll(data, nil), nil
+	case CompressionSnappy:
+		return snappy.Encode(nil, data), nil
+	case CompressionLZ4:
+		var buf bytes.Buffer
+		zw := lz4.NewWriter(&buf)
+		if _, err := zw.Write(data); err != nil {
+			return nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return data, nil
+	}
+}
+
+func (c Compression) decompress(data []byte) ([]byte, error) {
+	switch c {
+	case CompressionGzip:
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = zr.Close()
+		}()
+		return io.ReadAll(zr)
+	case CompressionZstd:
+		return getZstdDecoder().DecodeAll(data, nil)
+	case CompressionSnappy:
+		return snappy.Decode(nil, data)
+	case CompressionLZ4:
+		return io.ReadAll(lz4.NewReader(bytes.NewReader(data)))
+	default:
+		return data, nil
+	}
+}
+
+// compressBatch compresses data of each log entry in the batch by the codec,
+// and records the codec in its headers. It neither modifies the arguments nor
+// compresses them if the codec is CompressionNone.
+func compressBatch(codec Compression, data [][]byte, headers []varlogpb.LogEntryHeaders) ([][]byte, []varlogpb.LogEntryHeaders, error) {
+	if !codec.valid() {
+		return nil, nil, fmt.Errorf("compression: %s: %w", codec, verrors.ErrInvalid)
+	}
+	if codec == CompressionNone || len(data) == 0 {
+		return data, headers, nil
+	}
+
+	compressedData := make([][]byte, len(data))
+	compressedHeaders := make([]varlogpb.LogEntryHeaders, len(data))
+	for i := range data {
+		var values map[string][]byte
+		if i < len(headers) {
+			values = headers[i].Values
+		}
+		if _, ok := values[CompressionHeader]; ok {
+			return nil, nil, fmt.Errorf("compression: reserved header %s: %w", CompressionHeader, verrors.ErrInvalid)
+		}
+		compressedHeaders[i].Values = make(map[string][]byte, len(values)+1)
+		for k, v := range values {
+			compressedHeaders[i].Values[k] = v
+		}
+		compressedHeaders[i].Values[CompressionHeader] = []byte(codec.String())
+
+		compressed, err := codec.compress(data[i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("compression: %s: %w", codec, err)
+		}
+		compressedData[i] = compressed
+	}
+	return compressedData, compressedHeaders, nil
+}
+
+// decompressLogEntry decompresses data of the log entry if its headers have
+// CompressionHeader, and removes the header. It does not modify the headers of
+// the argument logEntry in place since they can be shared.
+//
+// Data of the log entry can be excluded by a subscribe filter, in which case
+// only the header is removed.
+func decompressLogEntry(logEntry *varlogpb.LogEntry) error {
+	name, ok := logEntry.Headers[CompressionHeader]
+	if !ok {
+		return nil
+	}
+	codec, err := ParseCompression(string(name))
+	if err != nil {
+		return fmt.Errorf("decompression: glsn %d: %w", logEntry.GLSN, err)
+	}
+
+	logEntry.Headers = removeHeaders(logEntry.Headers, CompressionHeader)
+
+	if len(logEntry.Data) == 0 {
+		return nil
+	}
+	data, err := codec.decompress(logEntry.Data)
+	if err != nil {
+		return fmt.Errorf("decompression: glsn %d: %s: %w", logEntry.GLSN, codec, err)
+	}
+	logEntry.Data = data
+	return nil
+}
@@ -0,0 +1,101 @@
+package varlog
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func TestCompression(t *testing.T) {
+	data := [][]byte{
+		bytes.Repeat([]byte(`{"level":"info","msg":"hello"}`), 100),
+		nil,
+		[]byte("foo"),
+	}
+	headers := []varlogpb.LogEntryHeaders{
+		{Values: map[string][]byte{"key": []byte("value")}},
+	}
+
+	for _, codec := range []Compression{CompressionGzip, CompressionZstd, CompressionSnappy, CompressionLZ4} {
+		t.Run(codec.String(), func(t *testing.T) {
+			parsed, err := ParseCompression(codec.String())
+			require.NoError(t, err)
+			require.Equal(t, codec, parsed)
+
+			compressed, compressedHeaders, err := compressBatch(codec, data, headers)
+			require.NoError(t, err)
+			require.Len(t, compressed, len(data))
+			require.Len(t, compressedHeaders, len(data))
+			require.Less(t, len(compressed[0]), len(data[0]))
+			// The arguments are not modified.
+			require.Len(t, headers[0].Values, 1)
+
+			// Each log entry is decompressed alone, for instance, after
+			// the others in the batch are trimmed.
+			decoder := &logEntryDecoder{}
+			for _, i := range []int{2, 1, 0} {
+				logEntry := varlogpb.LogEntry{
+					LogEntryMeta: varlogpb.LogEntryMeta{LLSN: types.LLSN(i + 1)},
+					Data:         compressed[i],
+					Headers:      compressedHeaders[i].Values,
+				}
+				require.Equal(t, []byte(codec.String()), logEntry.Headers[CompressionHeader])
+				require.NoError(t, decoder.decode(context.Background(), &logEntry))
+				require.Equal(t, string(data[i]), string(logEntry.Data))
+				if i < len(headers) {
+					require.Equal(t, headers[i].Values, logEntry.Headers)
+				} else {
+					require.Nil(t, logEntry.Headers)
+				}
+			}
+
+			// The data is excluded by the subscribe filter.
+			logEntry := varlogpb.LogEntry{
+				LogEntryMeta: varlogpb.LogEntryMeta{LLSN: 1},
+				Headers:      compressedHeaders[0].Values,
+			}
+			require.NoError(t, decoder.decode(context.Background(), &logEntry))
+			require.Empty(t, logEntry.Data)
+			require.Equal(t, headers[0].Values, logEntry.Headers)
+
+			// corrupted data
+			logEntry = varlogpb.LogEntry{
+				LogEntryMeta: varlogpb.LogEntryMeta{LLSN: 1},
+				Data:         []byte("corrupted"),
+				Headers:      compressedHeaders[0].Values,
+			}
+			require.Error(t, decoder.decode(context.Background(), &logEntry))
+		})
+	}
+
+	// none
+	compressed, compressedHeaders, err := compressBatch(CompressionNone, data, nil)
+	require.NoError(t, err)
+	require.Equal(t, data, compressed)
+	require.Nil(t, compressedHeaders)
+
+	// invalid codec
+	_, _, err = compressBatch(Compression(-1), data, nil)
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+	_, err = ParseCompression("lzma")
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	// reserved header
+	_, _, err = compressBatch(CompressionGzip, data[:1], []varlogpb.LogEntryHeaders{
+		{Values: map[string][]byte{CompressionHeader: []byte("none")}},
+	})
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	// unknown codec in the header
+	logEntry := varlogpb.LogEntry{
+		Data:    []byte("foo"),
+		Headers: map[string][]byte{CompressionHeader: []byte("lzma")},
+	}
+	require.ErrorIs(t, decompressLogEntry(&logEntry), verrors.ErrInvalid)
+}
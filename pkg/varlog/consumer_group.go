@@ -0,0 +1,66 @@
+package varlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/mrpb"
+)
+
+func (v *logImpl) commitOffset(ctx context.Context, group string, offset mrpb.ConsumerGroupOffset) error {
+	if len(group) == 0 {
+		return fmt.Errorf("commit offset: no group: %w", verrors.ErrInvalid)
+	}
+	cl, err := v.connector.Client(ctx)
+	if err != nil {
+		return fmt.Errorf("commit offset: %w", err)
+	}
+	if err := cl.CommitOffset(ctx, group, offset); err != nil {
+		return fmt.Errorf("commit offset: group %s: %w", group, err)
+	}
+	return nil
+}
+
+func (v *logImpl) fetchOffset(ctx context.Context, group string, tpid types.TopicID, lsid types.LogStreamID) (mrpb.ConsumerGroupOffset, error) {
+	if len(group) == 0 {
+		return mrpb.ConsumerGroupOffset{}, fmt.Errorf("fetch offset: no group: %w", verrors.ErrInvalid)
+	}
+	cl, err := v.connector.Client(ctx)
+	if err != nil {
+		return mrpb.ConsumerGroupOffset{}, fmt.Errorf("fetch offset: %w", err)
+	}
+	offset, err := cl.FetchOffset(ctx, group, tpid, lsid)
+	if err != nil {
+		return mrpb.ConsumerGroupOffset{}, fmt.Errorf("fetch offset: group %s: %w", group, err)
+	}
+	return offset, nil
+}
+
+func (v *logImpl) deleteConsumerGroup(ctx context.Context, group string) error {
+	if len(group) == 0 {
+		return fmt.Errorf("delete consumer group: no group: %w", verrors.ErrInvalid)
+	}
+	cl, err := v.connector.Client(ctx)
+	if err != nil {
+		return fmt.Errorf("delete consumer group: %w", err)
+	}
+	if err := cl.DeleteConsumerGroup(ctx, group); err != nil {
+		return fmt.Errorf("delete consumer group: group %s: %w", group, err)
+	}
+	return nil
+}
+
+func (v *logImpl) subscribeGroup(ctx context.Context, group string, tpid types.TopicID, end types.GLSN, onNextFunc OnNext, opts ...SubscribeOption) (SubscribeCloser, error) {
+	begin := types.MinGLSN
+	offset, err := v.fetchOffset(ctx, group, tpid, 0)
+	switch {
+	case err == nil:
+		begin = offset.GLSN + 1
+	case !errors.Is(err, verrors.ErrNotExist):
+		return nil, fmt.Errorf("subscribe group: %w", err)
+	}
+	return v.subscribe(ctx, tpid, begin, end, onNextFunc, opts...)
+}
@@ -0,0 +1,54 @@
+package varlog
+
+import (
+	"context"
+
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// logEntryDecoder reverses encodeBatch: it decrypts and then decompresses data
+// of log entries read from log streams. It is safe for concurrent use.
+type logEntryDecoder struct {
+	keyProvider KeyProvider
+	// requireEncryption makes the decoder reject log entries that are not
+	// encrypted.
+	requireEncryption bool
+}
+
+func (v *logImpl) newLogEntryDecoder() *logEntryDecoder {
+	return &logEntryDecoder{
+		keyProvider:       v.opts.keyProvider,
+		requireEncryption: v.opts.requireEncryption,
+	}
+}
+
+// decode decodes the log entry read from a log stream.
+func (d *logEntryDecoder) decode(ctx context.Context, logEntry *varlogpb.LogEntry) error {
+	if err := decryptLogEntry(ctx, d.keyProvider, d.requireEncryption, logEntry); err != nil {
+		return err
+	}
+	return decompressLogEntry(logEntry)
+}
+
+// removeHeaders returns a copy of the headers without the keys. It returns
+// nil if no header remains.
+func removeHeaders(headers map[string][]byte, keys ...string) map[string][]byte {
+	var ret map[string][]byte
+	for k, v := range headers {
+		removed := false
+		for _, key := range keys {
+			if k == key {
+				removed = true
+				break
+			}
+		}
+		if removed {
+			continue
+		}
+		if ret == nil {
+			ret = make(map[string][]byte, len(headers))
+		}
+		ret[k] = v
+	}
+	return ret
+}
@@ -0,0 +1,226 @@
+package varlog
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// EncryptionKeyHeader is the key of the header that records the ID of the key
+// encrypting data of a log entry. Clients remove it after decrypting the data;
+// hence, applications do not see it.
+const EncryptionKeyHeader = "varlog-encryption-key"
+
+// KeyProvider provides keys of AES-GCM to encrypt and decrypt data of log
+// entries in clients. Storage nodes store and replicate encrypted data; hence,
+// operators of storage nodes cannot read the data without the keys.
+//
+// Since the ID of the key is recorded in each log entry, a KeyProvider can
+// rotate keys by changing the current key while keeping old keys to decrypt
+// log entries encrypted by them. Implementations must be safe for concurrent
+// use, and should cache keys since they are looked up for each log entry.
+//
+// Each log entry is encrypted with a random 96-bit nonce. To keep the
+// probability of a nonce collision negligible, a key must not encrypt more
+// than 2^32 log entries; hence, implementations should rotate the current key
+// before it does.
+type KeyProvider interface {
+	// CurrentKey returns the ID and the key to encrypt log entries being
+	// appended. The length of the key should be 16, 24, or 32 bytes to
+	// select AES-128, AES-192, or AES-256.
+	CurrentKey(ctx context.Context) (keyID string, key []byte, err error)
+
+	// Key returns the key identified by the argument keyID to decrypt log
+	// entries.
+	Key(ctx context.Context, keyID string) ([]byte, error)
+}
+
+// KeyRing is an in-memory KeyProvider. It keeps all keys added to it, thus,
+// log entries encrypted by rotated keys are still readable.
+type KeyRing struct {
+	mu      sync.RWMutex
+	current string
+	keys    map[string][]byte
+}
+
+var _ KeyProvider = (*KeyRing)(nil)
+
+// NewKeyRing creates a KeyRing whose current key is the argument key.
+func NewKeyRing(keyID string, key []byte) (*KeyRing, error) {
+	kr := &KeyRing{keys: make(map[string][]byte)}
+	if err := kr.Rotate(keyID, key); err != nil {
+		return nil, err
+	}
+	return kr, nil
+}
+
+// Rotate adds the key to the KeyRing and makes it the current key. It returns
+// an error if the key ID is empty, the key is not a valid AES key, or the key
+// ID already exists with a different key.
+func (kr *KeyRing) Rotate(keyID string, key []byte) error {
+	if len(keyID) == 0 {
+		return fmt.Errorf("key ring: no key id: %w", verrors.ErrInvalid)
+	}
+	if _, err := aes.NewCipher(key); err != nil {
+		return fmt.Errorf("key ring: key %s: %v: %w", keyID, err, verrors.ErrInvalid)
+	}
+
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+	if old, ok := kr.keys[keyID]; ok && string(old) != string(key) {
+		return fmt.Errorf("key ring: key %s: %w", keyID, verrors.ErrExist)
+	}
+	kr.keys[keyID] = append([]byte(nil), key...)
+	kr.current = keyID
+	return nil
+}
+
+func (kr *KeyRing) CurrentKey(context.Context) (string, []byte, error) {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+	return kr.current, kr.keys[kr.current], nil
+}
+
+func (kr *KeyRing) Key(_ context.Context, keyID string) ([]byte, error) {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+	key, ok := kr.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key ring: key %s: %w", keyID, verrors.ErrNotExist)
+	}
+	return key, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptionAAD returns the additional authenticated data of a log entry. It
+// binds the ID of the key and the header of the compression codec to the
+// encrypted data; hence, the decryption fails if they are tampered with.
+func encryptionAAD(keyID []byte, headers map[string][]byte) []byte {
+	fields := [][]byte{keyID, headers[CompressionHeader]}
+	size := 0
+	for _, field := range fields {
+		size += binary.MaxVarintLen64 + len(field)
+	}
+	aad := make([]byte, 0, size)
+	for _, field := range fields {
+		aad = binary.AppendUvarint(aad, uint64(len(field)))
+		aad = append(aad, field...)
+	}
+	return aad
+}
+
+// encryptBatch encrypts each data in the batch by the current key of the
+// KeyProvider, and records the ID of the key in the headers of each log entry.
+// The encrypted data is the nonce followed by the sealed data; see
+// encryptionAAD for its additional authenticated data. It neither modifies
+// the arguments nor encrypts them if the KeyProvider is nil.
+func encryptBatch(ctx context.Context, kp KeyProvider, data [][]byte, headers []varlogpb.LogEntryHeaders) ([][]byte, []varlogpb.LogEntryHeaders, error) {
+	if kp == nil {
+		return data, headers, nil
+	}
+
+	keyID, key, err := kp.CurrentKey(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encryption: %w", err)
+	}
+	if len(keyID) == 0 {
+		return nil, nil, fmt.Errorf("encryption: no key id: %w", verrors.ErrInvalid)
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encryption: key %s: %w", keyID, err)
+	}
+
+	encrypted := make([][]byte, len(data))
+	encryptedHeaders := make([]varlogpb.LogEntryHeaders, len(data))
+	for i := range data {
+		var values map[string][]byte
+		if i < len(headers) {
+			values = headers[i].Values
+		}
+		if _, ok := values[EncryptionKeyHeader]; ok {
+			return nil, nil, fmt.Errorf("encryption: reserved header %s: %w", EncryptionKeyHeader, verrors.ErrInvalid)
+		}
+
+		nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(data[i])+gcm.Overhead())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return nil, nil, fmt.Errorf("encryption: nonce: %w", err)
+		}
+		encrypted[i] = gcm.Seal(nonce, nonce, data[i], encryptionAAD([]byte(keyID), values))
+		encryptedHeaders[i].Values = make(map[string][]byte, len(values)+1)
+		for k, v := range values {
+			encryptedHeaders[i].Values[k] = v
+		}
+		encryptedHeaders[i].Values[EncryptionKeyHeader] = []byte(keyID)
+	}
+	return encrypted, encryptedHeaders, nil
+}
+
+// decryptLogEntry decrypts data of the log entry if its headers have
+// EncryptionKeyHeader, and removes the header. If the argument required is
+// true, it returns an error for the log entry without the header. Like
+// decompressLogEntry, it does not modify the headers of the argument logEntry
+// in place, and only removes the header if the data is excluded by a
+// subscribe filter.
+func decryptLogEntry(ctx context.Context, kp KeyProvider, required bool, logEntry *varlogpb.LogEntry) error {
+	keyID, ok := logEntry.Headers[EncryptionKeyHeader]
+	if !ok {
+		if required {
+			return fmt.Errorf("decryption: glsn %d: not encrypted: %w", logEntry.GLSN, verrors.ErrInvalid)
+		}
+		return nil
+	}
+	if kp == nil {
+		return fmt.Errorf("decryption: glsn %d: no key provider: %w", logEntry.GLSN, verrors.ErrInvalid)
+	}
+
+	aad := encryptionAAD(keyID, logEntry.Headers)
+	logEntry.Headers = removeHeaders(logEntry.Headers, EncryptionKeyHeader)
+
+	if len(logEntry.Data) == 0 {
+		return nil
+	}
+	key, err := kp.Key(ctx, string(keyID))
+	if err != nil {
+		return fmt.Errorf("decryption: glsn %d: %w", logEntry.GLSN, err)
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return fmt.Errorf("decryption: glsn %d: key %s: %w", logEntry.GLSN, keyID, err)
+	}
+	if len(logEntry.Data) < gcm.NonceSize() {
+		return fmt.Errorf("decryption: glsn %d: too short data: %w", logEntry.GLSN, verrors.ErrInvalid)
+	}
+	nonce, sealed := logEntry.Data[:gcm.NonceSize()], logEntry.Data[gcm.NonceSize():]
+	data, err := gcm.Open(nil, nonce, sealed, aad)
+	if err != nil {
+		return fmt.Errorf("decryption: glsn %d: key %s: %w", logEntry.GLSN, keyID, err)
+	}
+	logEntry.Data = data
+	return nil
+}
+
+// encodeBatch compresses and then encrypts data of the batch since encrypted
+// data is hardly compressible.
+func encodeBatch(ctx context.Context, codec Compression, kp KeyProvider, data [][]byte, headers []varlogpb.LogEntryHeaders) ([][]byte, []varlogpb.LogEntryHeaders, error) {
+	data, headers, err := compressBatch(codec, data, headers)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encryptBatch(ctx, kp, data, headers)
+}
@@ -0,0 +1,162 @@
+package varlog
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func TestKeyRing(t *testing.T) {
+	_, err := NewKeyRing("", bytes.Repeat([]byte{1}, 32))
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+	_, err = NewKeyRing("k1", []byte("short"))
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	kr, err := NewKeyRing("k1", bytes.Repeat([]byte{1}, 16))
+	require.NoError(t, err)
+	keyID, key, err := kr.CurrentKey(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "k1", keyID)
+	require.Equal(t, bytes.Repeat([]byte{1}, 16), key)
+
+	require.NoError(t, kr.Rotate("k2", bytes.Repeat([]byte{2}, 32)))
+	keyID, _, err = kr.CurrentKey(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "k2", keyID)
+
+	// The rotated key is still available.
+	key, err = kr.Key(context.Background(), "k1")
+	require.NoError(t, err)
+	require.Equal(t, bytes.Repeat([]byte{1}, 16), key)
+
+	_, err = kr.Key(context.Background(), "k3")
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+	require.ErrorIs(t, kr.Rotate("k1", bytes.Repeat([]byte{3}, 16)), verrors.ErrExist)
+}
+
+func TestEncryption(t *testing.T) {
+	ctx := context.Background()
+	data := [][]byte{
+		[]byte("personally identifiable information"),
+		nil,
+	}
+	headers := []varlogpb.LogEntryHeaders{
+		{Values: map[string][]byte{"key": []byte("value")}},
+	}
+
+	kr, err := NewKeyRing("k1", bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, err)
+
+	encrypted, encryptedHeaders, err := encodeBatch(ctx, CompressionGzip, kr, data, headers)
+	require.NoError(t, err)
+
+	decode := func(kp KeyProvider, llsn types.LLSN, data []byte, headers varlogpb.LogEntryHeaders) (varlogpb.LogEntry, error) {
+		decoder := &logEntryDecoder{keyProvider: kp}
+		logEntry := varlogpb.LogEntry{
+			LogEntryMeta: varlogpb.LogEntryMeta{LLSN: llsn},
+			Data:         data,
+			Headers:      headers.Values,
+		}
+		err := decoder.decode(ctx, &logEntry)
+		return logEntry, err
+	}
+	require.Len(t, encrypted, len(data))
+	require.NotContains(t, string(encrypted[0]), string(data[0]))
+	require.Equal(t, []byte("k1"), encryptedHeaders[0].Values[EncryptionKeyHeader])
+	require.Equal(t, []byte("gzip"), encryptedHeaders[0].Values[CompressionHeader])
+	// The arguments are not modified.
+	require.Len(t, headers[0].Values, 1)
+
+	for i := range encrypted {
+		logEntry, err := decode(kr, types.LLSN(i+1), encrypted[i], encryptedHeaders[i])
+		require.NoError(t, err)
+		require.Equal(t, string(data[i]), string(logEntry.Data))
+		if i < len(headers) {
+			require.Equal(t, headers[i].Values, logEntry.Headers)
+		} else {
+			require.Nil(t, logEntry.Headers)
+		}
+	}
+
+	// Log entries encrypted by the rotated key are still readable.
+	require.NoError(t, kr.Rotate("k2", bytes.Repeat([]byte{2}, 16)))
+	rotated, rotatedHeaders, err := encryptBatch(ctx, kr, data[:1], nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("k2"), rotatedHeaders[0].Values[EncryptionKeyHeader])
+	logEntry, err := decode(kr, 1, rotated[0], rotatedHeaders[0])
+	require.NoError(t, err)
+	require.Equal(t, data[0], logEntry.Data)
+	logEntry, err = decode(kr, 1, encrypted[0], encryptedHeaders[0])
+	require.NoError(t, err)
+	require.Equal(t, data[0], logEntry.Data)
+
+	// The data is excluded by the subscribe filter.
+	logEntry, err = decode(kr, 1, nil, encryptedHeaders[0])
+	require.NoError(t, err)
+	require.Empty(t, logEntry.Data)
+	require.Equal(t, headers[0].Values, logEntry.Headers)
+
+	// no key provider
+	_, err = decode(nil, 1, encrypted[0], encryptedHeaders[0])
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	// unknown key
+	other, err := NewKeyRing("k3", bytes.Repeat([]byte{3}, 32))
+	require.NoError(t, err)
+	_, err = decode(other, 1, encrypted[0], encryptedHeaders[0])
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+
+	// tampered data
+	tampered := append([]byte(nil), rotated[0]...)
+	tampered[len(tampered)-1] ^= 0xff
+	_, err = decode(kr, 1, tampered, rotatedHeaders[0])
+	require.Error(t, err)
+	_, err = decode(kr, 1, rotated[0][:4], rotatedHeaders[0])
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	// The key ID and the codec are authenticated with the data.
+	tamperedHeaders := func(key, value string) varlogpb.LogEntryHeaders {
+		values := make(map[string][]byte, len(encryptedHeaders[0].Values))
+		for k, v := range encryptedHeaders[0].Values {
+			values[k] = v
+		}
+		values[key] = []byte(value)
+		return varlogpb.LogEntryHeaders{Values: values}
+	}
+	require.NoError(t, kr.Rotate("k1-copy", bytes.Repeat([]byte{1}, 32)))
+	_, err = decode(kr, 1, encrypted[0], tamperedHeaders(EncryptionKeyHeader, "k1-copy"))
+	require.Error(t, err)
+	_, err = decode(kr, 1, encrypted[0], tamperedHeaders(CompressionHeader, "zstd"))
+	require.Error(t, err)
+
+	// The header of the key is removed.
+	stripped := varlogpb.LogEntryHeaders{Values: map[string][]byte{"key": []byte("value")}}
+	logEntry, err = decode(kr, 1, rotated[0], stripped)
+	require.NoError(t, err)
+	require.Equal(t, rotated[0], logEntry.Data)
+	logEntry = varlogpb.LogEntry{
+		LogEntryMeta: varlogpb.LogEntryMeta{LLSN: 1},
+		Data:         rotated[0],
+		Headers:      stripped.Values,
+	}
+	err = (&logEntryDecoder{keyProvider: kr, requireEncryption: true}).decode(ctx, &logEntry)
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	// reserved header
+	_, _, err = encryptBatch(ctx, kr, data[:1], []varlogpb.LogEntryHeaders{
+		{Values: map[string][]byte{EncryptionKeyHeader: []byte("k1")}},
+	})
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	// no encryption
+	plain, plainHeaders, err := encryptBatch(ctx, nil, data, headers)
+	require.NoError(t, err)
+	require.Equal(t, data, plain)
+	require.Equal(t, headers, plainHeaders)
+}
@@ -0,0 +1,124 @@
+package varlog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+)
+
+// Position is a symbolic position in a topic. It is resolved to a GLSN when
+// it is used, thus, callers need not know the GLSNs of the topic.
+type Position int
+
+const (
+	// Earliest is the position of the first log entry that is not trimmed
+	// yet.
+	Earliest Position = iota + 1
+	// Latest is the position of the last committed log entry. If no log
+	// entry has been committed yet, it is the same as Now.
+	Latest
+	// Now is the position right after the last committed log entry, that
+	// is, the position of the log entry that will be committed next.
+	Now
+)
+
+var positionNames = map[Position]string{
+	Earliest: "earliest",
+	Latest:   "latest",
+	Now:      "now",
+}
+
+func (pos Position) String() string {
+	if name, ok := positionNames[pos]; ok {
+		return name
+	}
+	return fmt.Sprintf("Position(%d)", int(pos))
+}
+
+// ParsePosition parses the name of a position, which is one of "earliest",
+// "latest", and "now". It is case-insensitive.
+func ParsePosition(s string) (Position, error) {
+	for pos, name := range positionNames {
+		if strings.EqualFold(s, name) {
+			return pos, nil
+		}
+	}
+	return 0, fmt.Errorf("position: unknown position %q: %w", s, verrors.ErrInvalid)
+}
+
+func (v *logImpl) resolvePosition(ctx context.Context, tpid types.TopicID, pos Position) (types.GLSN, error) {
+	switch pos {
+	case Earliest:
+		return v.earliest(ctx, tpid)
+	case Latest, Now:
+		cl, err := v.connector.Client(ctx)
+		if err != nil {
+			return types.InvalidGLSN, fmt.Errorf("resolve position: %w", err)
+		}
+		hwm, err := cl.GetHighWatermark(ctx, tpid)
+		if err != nil {
+			return types.InvalidGLSN, fmt.Errorf("resolve position: %s: %w", pos, err)
+		}
+		if pos == Latest && !hwm.Invalid() {
+			return hwm, nil
+		}
+		return hwm + 1, nil
+	default:
+		return types.InvalidGLSN, fmt.Errorf("resolve position: %s: %w", pos, verrors.ErrInvalid)
+	}
+}
+
+// earliest returns the smallest GLSN among the first log entries of log
+// streams in the topic. It returns types.MinGLSN if none of them has log
+// entries.
+func (v *logImpl) earliest(ctx context.Context, tpid types.TopicID) (types.GLSN, error) {
+	replicasMap := v.replicasRetriever.All(tpid)
+	if len(replicasMap) == 0 {
+		// The topic may be created after the last refresh.
+		v.refresher.Refresh(ctx)
+		replicasMap = v.replicasRetriever.All(tpid)
+	}
+	if len(replicasMap) == 0 {
+		return types.InvalidGLSN, fmt.Errorf("resolve position: %s: no log stream in topic %d", Earliest, tpid)
+	}
+
+	earliest := types.InvalidGLSN
+	for lsid := range replicasMap {
+		first, _, err := v.peekLogStream(ctx, tpid, lsid)
+		if err != nil {
+			return types.InvalidGLSN, fmt.Errorf("resolve position: %s: lsid %d: %w", Earliest, lsid, err)
+		}
+		if first.GLSN.Invalid() {
+			continue
+		}
+		if earliest.Invalid() || first.GLSN < earliest {
+			earliest = first.GLSN
+		}
+	}
+	if earliest.Invalid() {
+		return types.MinGLSN, nil
+	}
+	return earliest, nil
+}
+
+func (v *logImpl) follow(ctx context.Context, tpid types.TopicID, from Position, onNext OnNext, opts ...SubscribeOption) (SubscribeCloser, error) {
+	// The subscription has no end, thus, its buffer should be bounded.
+	subscribeOpts := defaultSubscribeOptions()
+	subscribeOpts.bufferSize = defaultSubscribeIterBufferSize
+	for _, opt := range opts {
+		opt.apply(&subscribeOpts)
+	}
+	if subscribeOpts.bufferSize < 1 {
+		return nil, fmt.Errorf("follow: invalid buffer size %d: %w", subscribeOpts.bufferSize, verrors.ErrInvalid)
+	}
+
+	begin, err := v.resolvePosition(ctx, tpid, from)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, WithSubscribeBufferSize(subscribeOpts.bufferSize))
+	return v.subscribe(ctx, tpid, begin, types.MaxGLSN, onNext, opts...)
+}
@@ -0,0 +1,35 @@
+package varlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/verrors"
+)
+
+func TestParsePosition(t *testing.T) {
+	tcs := []struct {
+		in       string
+		expected Position
+	}{
+		{in: "earliest", expected: Earliest},
+		{in: "Latest", expected: Latest},
+		{in: "NOW", expected: Now},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.in, func(t *testing.T) {
+			pos, err := ParsePosition(tc.in)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, pos)
+
+			pos, err = ParsePosition(pos.String())
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, pos)
+		})
+	}
+
+	_, err := ParsePosition("oldest")
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+	require.Equal(t, "Position(0)", Position(0).String())
+}
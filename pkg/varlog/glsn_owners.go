@@ -0,0 +1,93 @@
+package varlog
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/kakao/varlog/pkg/types"
+)
+
+// maxGLSNOwnerRanges is the maximum number of ranges of GLSNs that
+// glsnOwners remembers for each topic.
+const maxGLSNOwnerRanges = 4096
+
+// glsnOwners remembers which log streams own committed log entries in each
+// topic. Since a GLSN does not tell which log stream has the log entry, point
+// reads resolve the owner here first to ask only the replicas of that log
+// stream. It learns owners from the results of appends and reads. Ownership
+// of a committed log entry never changes; hence, entries need no
+// invalidation. If a topic has too many ranges, the ranges of the smallest
+// GLSNs are forgotten first since recent log entries are read more often.
+type glsnOwners struct {
+	mu     sync.Mutex
+	topics map[types.TopicID][]glsnOwnerRange
+}
+
+// glsnOwnerRange is a range of GLSNs, [begin, end), owned by a log stream.
+type glsnOwnerRange struct {
+	begin types.GLSN
+	end   types.GLSN
+	lsid  types.LogStreamID
+}
+
+func newGLSNOwners() *glsnOwners {
+	return &glsnOwners{
+		topics: make(map[types.TopicID][]glsnOwnerRange),
+	}
+}
+
+// add records that the log stream lsid in the topic tpid has the log entry
+// at the glsn.
+func (o *glsnOwners) add(tpid types.TopicID, lsid types.LogStreamID, glsn types.GLSN) {
+	if glsn.Invalid() {
+		return
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	ranges := o.topics[tpid]
+	// idx is the index of the first range beginning after the glsn.
+	idx := sort.Search(len(ranges), func(i int) bool {
+		return ranges[i].begin > glsn
+	})
+	if idx > 0 && glsn < ranges[idx-1].end {
+		return
+	}
+
+	extendPrev := idx > 0 && ranges[idx-1].lsid == lsid && ranges[idx-1].end == glsn
+	extendNext := idx < len(ranges) && ranges[idx].lsid == lsid && ranges[idx].begin == glsn+1
+	switch {
+	case extendPrev && extendNext:
+		ranges[idx-1].end = ranges[idx].end
+		ranges = append(ranges[:idx], ranges[idx+1:]...)
+	case extendPrev:
+		ranges[idx-1].end = glsn + 1
+	case extendNext:
+		ranges[idx].begin = glsn
+	default:
+		ranges = append(ranges, glsnOwnerRange{})
+		copy(ranges[idx+1:], ranges[idx:])
+		ranges[idx] = glsnOwnerRange{begin: glsn, end: glsn + 1, lsid: lsid}
+		if len(ranges) > maxGLSNOwnerRanges {
+			ranges = append(ranges[:0], ranges[len(ranges)-maxGLSNOwnerRanges:]...)
+		}
+	}
+	o.topics[tpid] = ranges
+}
+
+// lookup returns the log stream that has the log entry at the glsn in the
+// topic tpid. It returns false if the owner is unknown.
+func (o *glsnOwners) lookup(tpid types.TopicID, glsn types.GLSN) (types.LogStreamID, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	ranges := o.topics[tpid]
+	idx := sort.Search(len(ranges), func(i int) bool {
+		return ranges[i].begin > glsn
+	})
+	if idx == 0 || glsn >= ranges[idx-1].end {
+		return 0, false
+	}
+	return ranges[idx-1].lsid, true
+}
@@ -0,0 +1,67 @@
+package varlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/types"
+)
+
+func TestGLSNOwners(t *testing.T) {
+	const (
+		tpid  = types.TopicID(1)
+		lsid1 = types.LogStreamID(1)
+		lsid2 = types.LogStreamID(2)
+	)
+
+	owners := newGLSNOwners()
+	_, ok := owners.lookup(tpid, 1)
+	require.False(t, ok)
+
+	// [1, 4) -> lsid1, [4, 5) -> lsid2, [6, 8) -> lsid1
+	for _, glsn := range []types.GLSN{1, 3, 2, 7, 6} {
+		owners.add(tpid, lsid1, glsn)
+	}
+	owners.add(tpid, lsid2, 4)
+	owners.add(tpid, lsid2, types.InvalidGLSN)
+	// Ownership does not change.
+	owners.add(tpid, lsid2, 1)
+	require.Equal(t, []glsnOwnerRange{
+		{begin: 1, end: 4, lsid: lsid1},
+		{begin: 4, end: 5, lsid: lsid2},
+		{begin: 6, end: 8, lsid: lsid1},
+	}, owners.topics[tpid])
+
+	for glsn, expected := range map[types.GLSN]types.LogStreamID{1: lsid1, 3: lsid1, 4: lsid2, 6: lsid1, 7: lsid1} {
+		lsid, ok := owners.lookup(tpid, glsn)
+		require.True(t, ok)
+		require.Equal(t, expected, lsid)
+	}
+	for _, glsn := range []types.GLSN{5, 8} {
+		_, ok := owners.lookup(tpid, glsn)
+		require.False(t, ok)
+	}
+	_, ok = owners.lookup(tpid+1, 1)
+	require.False(t, ok)
+
+	// Filling the gap merges adjacent ranges of the same log stream.
+	owners.add(tpid, lsid2, 5)
+	require.Equal(t, []glsnOwnerRange{
+		{begin: 1, end: 4, lsid: lsid1},
+		{begin: 4, end: 6, lsid: lsid2},
+		{begin: 6, end: 8, lsid: lsid1},
+	}, owners.topics[tpid])
+
+	// Ranges of the smallest GLSNs are forgotten first.
+	owners = newGLSNOwners()
+	for i := 0; i <= maxGLSNOwnerRanges; i++ {
+		owners.add(tpid, lsid1, types.GLSN(2*i+1))
+	}
+	require.Len(t, owners.topics[tpid], maxGLSNOwnerRanges)
+	_, ok = owners.lookup(tpid, 1)
+	require.False(t, ok)
+	lsid, ok := owners.lookup(tpid, types.GLSN(2*maxGLSNOwnerRanges+1))
+	require.True(t, ok)
+	require.Equal(t, lsid1, lsid)
+}
@@ -0,0 +1,420 @@
+package varlog
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// GroupOnNext is called with each log entry of log streams assigned to a
+// member of a consumer group. Calls for the same log stream are sequential and
+// in the order of LLSN, but calls for different log streams can run
+// concurrently.
+type GroupOnNext func(logEntry varlogpb.LogEntry)
+
+// GroupConsumer is a member of a consumer group that divides log streams of a
+// topic among its members. Each member consumes only log streams assigned to
+// it by the admin server, thus, consumers can scale out horizontally without
+// the global order of the topic.
+//
+// The group rebalances when members join or leave, or new log streams are
+// added to the topic. A log stream moves to another member only after the
+// previous owner stops consuming it and commits its offset. A member that is
+// assigned a log stream resumes consuming it from the committed offset; hence,
+// log entries are delivered at least once.
+//
+// Each heartbeat grants the member a lease that lasts for the session
+// timeout from when the heartbeat is sent. The member stops delivering log
+// entries once its lease lapses, and offsets are committed through the admin
+// server, which rejects commits from members whose sessions have expired or
+// whose generations are stale. Thus, a member cut off from the admin server
+// neither consumes nor commits log streams that may have been assigned to
+// other members.
+type GroupConsumer interface {
+	// MemberID returns the ID of the member in the group.
+	MemberID() string
+
+	// Assignment returns log streams that the member consumes now in
+	// ascending order.
+	Assignment() []types.LogStreamID
+
+	// Close stops consuming, commits offsets of the log streams consumed,
+	// and leaves the group.
+	Close() error
+}
+
+type groupConsumer struct {
+	vlg    Log
+	adm    Admin
+	group  string
+	tpid   types.TopicID
+	onNext GroupOnNext
+	opts   groupConsumerOptions
+	logger *zap.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	// generation is the generation of the last assignment, or zero if the
+	// member has not joined the group yet. It is accessed only by the run
+	// loop, or by Close after the run loop stops.
+	generation uint64
+	// leaseExpiry is the time, in Unix nanoseconds, until which the member
+	// may consume log streams assigned to it.
+	leaseExpiry atomic.Int64
+
+	mu         sync.Mutex
+	partitions map[types.LogStreamID]*groupPartition
+	closed     bool
+}
+
+// groupPartition is a log stream consumed by the member.
+type groupPartition struct {
+	lsid   types.LogStreamID
+	cancel context.CancelFunc
+	done   chan struct{}
+	// processed is the LLSN of the last log entry passed to GroupOnNext.
+	processed atomic.Uint64
+	// committed is the LLSN committed last. It is accessed only by the
+	// run loop, or by Close after the run loop stops.
+	committed types.LLSN
+}
+
+var _ GroupConsumer = (*groupConsumer)(nil)
+
+// errGroupLeaseExpired stops delivering log entries when the lease of the
+// member lapses.
+var errGroupLeaseExpired = errors.New("group consumer: lease expired")
+
+// NewGroupConsumer creates a member of the consumer group, which consumes log
+// streams of the topic tpid. It sends heartbeats to the admin server through
+// the argument adm to get log streams assigned to it, reads them through the
+// argument vlg, and commits their offsets through the argument adm
+// periodically.
+func NewGroupConsumer(vlg Log, adm Admin, group string, tpid types.TopicID, onNext GroupOnNext, opts ...GroupConsumerOption) (GroupConsumer, error) {
+	gcOpts := defaultGroupConsumerOptions()
+	for _, opt := range opts {
+		opt.apply(&gcOpts)
+	}
+	if vlg == nil || adm == nil {
+		return nil, fmt.Errorf("group consumer: no client: %w", verrors.ErrInvalid)
+	}
+	if len(group) == 0 {
+		return nil, fmt.Errorf("group consumer: no group: %w", verrors.ErrInvalid)
+	}
+	if onNext == nil {
+		return nil, fmt.Errorf("group consumer: no callback: %w", verrors.ErrInvalid)
+	}
+	if gcOpts.heartbeatInterval <= 0 {
+		return nil, fmt.Errorf("group consumer: invalid heartbeat interval %v: %w", gcOpts.heartbeatInterval, verrors.ErrInvalid)
+	}
+	if gcOpts.commitInterval <= 0 {
+		return nil, fmt.Errorf("group consumer: invalid commit interval %v: %w", gcOpts.commitInterval, verrors.ErrInvalid)
+	}
+	if gcOpts.sessionTimeout <= gcOpts.heartbeatInterval {
+		return nil, fmt.Errorf("group consumer: session timeout %v should be greater than heartbeat interval %v: %w", gcOpts.sessionTimeout, gcOpts.heartbeatInterval, verrors.ErrInvalid)
+	}
+	if len(gcOpts.memberID) == 0 {
+		var buf [8]byte
+		if _, err := rand.Read(buf[:]); err != nil {
+			return nil, fmt.Errorf("group consumer: member id: %w", err)
+		}
+		gcOpts.memberID = hex.EncodeToString(buf[:])
+	}
+
+	gc := &groupConsumer{
+		vlg:        vlg,
+		adm:        adm,
+		group:      group,
+		tpid:       tpid,
+		onNext:     onNext,
+		opts:       gcOpts,
+		partitions: make(map[types.LogStreamID]*groupPartition),
+	}
+	gc.logger = gcOpts.logger.Named("groupconsumer").With(
+		zap.String("group", group),
+		zap.Int32("tpid", int32(tpid)),
+		zap.String("member", gcOpts.memberID),
+	)
+	gc.ctx, gc.cancel = context.WithCancel(context.Background())
+	gc.wg.Add(1)
+	go gc.run()
+	return gc, nil
+}
+
+func (gc *groupConsumer) MemberID() string {
+	return gc.opts.memberID
+}
+
+func (gc *groupConsumer) Assignment() []types.LogStreamID {
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+	lsids := make([]types.LogStreamID, 0, len(gc.partitions))
+	for lsid := range gc.partitions {
+		lsids = append(lsids, lsid)
+	}
+	sort.Slice(lsids, func(i, j int) bool { return lsids[i] < lsids[j] })
+	return lsids
+}
+
+func (gc *groupConsumer) Close() error {
+	gc.mu.Lock()
+	if gc.closed {
+		gc.mu.Unlock()
+		return nil
+	}
+	gc.closed = true
+	gc.mu.Unlock()
+
+	gc.cancel()
+	gc.wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), gc.opts.sessionTimeout)
+	defer cancel()
+	var err error
+	for _, lsid := range gc.Assignment() {
+		err = multierr.Append(err, gc.revoke(ctx, lsid))
+	}
+	if lerr := gc.adm.LeaveConsumerGroup(ctx, gc.group, gc.tpid, gc.opts.memberID); lerr != nil {
+		err = multierr.Append(err, fmt.Errorf("group consumer: leave: %w", lerr))
+	}
+	return err
+}
+
+func (gc *groupConsumer) run() {
+	defer gc.wg.Done()
+
+	heartbeatTicker := time.NewTicker(gc.opts.heartbeatInterval)
+	defer heartbeatTicker.Stop()
+	commitTicker := time.NewTicker(gc.opts.commitInterval)
+	defer commitTicker.Stop()
+
+	gc.heartbeat()
+	for {
+		select {
+		case <-gc.ctx.Done():
+			return
+		case <-heartbeatTicker.C:
+			gc.heartbeat()
+		case <-commitTicker.C:
+			for _, lsid := range gc.Assignment() {
+				if err := gc.commit(gc.ctx, gc.partition(lsid)); err != nil {
+					gc.logger.Warn("could not commit offset", zap.Int32("lsid", int32(lsid)), zap.Error(err))
+				}
+			}
+		}
+	}
+}
+
+// heartbeat sends a heartbeat to the admin server, extends the lease, and
+// reconciles log streams consumed by the member with the assignment. The lease
+// lasts for the smaller of the session timeouts of the member and the admin
+// server from when the heartbeat is sent. If heartbeats keep failing until
+// the lease lapses, or the admin server fences the member, the member drops
+// all log streams without committing their offsets since the admin server
+// may have assigned them to other members. A fenced member joins the group
+// again by the next heartbeat.
+func (gc *groupConsumer) heartbeat() {
+	owned := gc.Assignment()
+	sent := time.Now()
+	ctx, cancel := context.WithTimeout(gc.ctx, gc.opts.sessionTimeout)
+	defer cancel()
+	rsp, err := gc.adm.ConsumerGroupHeartbeat(ctx, gc.group, gc.tpid, gc.opts.memberID, gc.generation, owned)
+	if err != nil {
+		if gc.ctx.Err() != nil {
+			return
+		}
+		gc.logger.Warn("could not send heartbeat", zap.Error(err))
+		if errors.Is(err, verrors.ErrNotMember) {
+			gc.leaseExpiry.Store(0)
+			gc.generation = 0
+		}
+		if !gc.leased() {
+			for _, lsid := range owned {
+				gc.lose(lsid)
+			}
+		}
+		return
+	}
+	sessionTimeout := gc.opts.sessionTimeout
+	if rsp.SessionTimeout > 0 && rsp.SessionTimeout < sessionTimeout {
+		sessionTimeout = rsp.SessionTimeout
+	}
+	gc.generation = rsp.Generation
+	gc.leaseExpiry.Store(sent.Add(sessionTimeout).UnixNano())
+
+	assigned := make(map[types.LogStreamID]bool, len(rsp.LogStreamIDs))
+	for _, lsid := range rsp.LogStreamIDs {
+		assigned[lsid] = true
+	}
+	for _, lsid := range owned {
+		if !assigned[lsid] {
+			gc.release(lsid)
+		}
+	}
+	for _, lsid := range rsp.LogStreamIDs {
+		if gc.partition(lsid) == nil {
+			gc.assign(lsid)
+		}
+	}
+}
+
+func (gc *groupConsumer) partition(lsid types.LogStreamID) *groupPartition {
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+	return gc.partitions[lsid]
+}
+
+func (gc *groupConsumer) assign(lsid types.LogStreamID) {
+	ctx, cancel := context.WithCancel(gc.ctx)
+	p := &groupPartition{
+		lsid:   lsid,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	gc.mu.Lock()
+	gc.partitions[lsid] = p
+	gc.mu.Unlock()
+	gc.logger.Info("assigned log stream", zap.Int32("lsid", int32(lsid)))
+	go gc.consume(ctx, p)
+}
+
+func (gc *groupConsumer) release(lsid types.LogStreamID) {
+	ctx, cancel := context.WithTimeout(gc.ctx, gc.opts.sessionTimeout)
+	defer cancel()
+	if err := gc.revoke(ctx, lsid); err != nil {
+		gc.logger.Warn("could not commit offset of revoked log stream", zap.Int32("lsid", int32(lsid)), zap.Error(err))
+	}
+	gc.logger.Info("revoked log stream", zap.Int32("lsid", int32(lsid)))
+}
+
+// lose stops consuming the log stream without committing its offset since
+// the member may not own it anymore.
+func (gc *groupConsumer) lose(lsid types.LogStreamID) {
+	gc.mu.Lock()
+	p, ok := gc.partitions[lsid]
+	delete(gc.partitions, lsid)
+	gc.mu.Unlock()
+	if !ok {
+		return
+	}
+	p.cancel()
+	<-p.done
+	gc.logger.Info("lost log stream", zap.Int32("lsid", int32(lsid)))
+}
+
+// leased returns true if the lease granted by the last heartbeat has not
+// lapsed.
+func (gc *groupConsumer) leased() bool {
+	return time.Now().UnixNano() < gc.leaseExpiry.Load()
+}
+
+// revoke stops consuming the log stream and commits its offset.
+func (gc *groupConsumer) revoke(ctx context.Context, lsid types.LogStreamID) error {
+	gc.mu.Lock()
+	p, ok := gc.partitions[lsid]
+	delete(gc.partitions, lsid)
+	gc.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	p.cancel()
+	<-p.done
+	return gc.commit(ctx, p)
+}
+
+func (gc *groupConsumer) commit(ctx context.Context, p *groupPartition) error {
+	if p == nil {
+		return nil
+	}
+	processed := types.LLSN(p.processed.Load())
+	if processed <= p.committed {
+		return nil
+	}
+	if err := gc.adm.CommitConsumerGroupOffset(ctx, gc.group, gc.tpid, gc.opts.memberID, gc.generation, p.lsid, processed); err != nil {
+		return err
+	}
+	p.committed = processed
+	return nil
+}
+
+// consume reads log entries of the log stream from the committed offset until
+// the context is canceled. It subscribes to the log stream again if the
+// subscription fails or the lease lapses; log entries are not delivered
+// until the lease is extended.
+func (gc *groupConsumer) consume(ctx context.Context, p *groupPartition) {
+	defer close(p.done)
+
+	begin := types.MinLLSN
+	for {
+		llsn, err := gc.vlg.FetchLogStreamOffset(ctx, gc.group, gc.tpid, p.lsid)
+		if err == nil {
+			begin = llsn + 1
+			break
+		}
+		if errors.Is(err, verrors.ErrNotExist) {
+			break
+		}
+		gc.logger.Warn("could not fetch offset", zap.Int32("lsid", int32(p.lsid)), zap.Error(err))
+		if !gc.wait(ctx) {
+			return
+		}
+	}
+	p.processed.Store(uint64(begin - 1))
+
+	for {
+		sub := gc.vlg.SubscribeTo(ctx, gc.tpid, p.lsid, begin, types.MaxLLSN)
+		var err error
+		for {
+			var logEntry varlogpb.LogEntry
+			logEntry, err = sub.Next()
+			if err != nil {
+				break
+			}
+			if !gc.leased() {
+				err = errGroupLeaseExpired
+				break
+			}
+			gc.onNext(logEntry)
+			p.processed.Store(uint64(logEntry.LLSN))
+			begin = logEntry.LLSN + 1
+		}
+		_ = sub.Close()
+		if ctx.Err() != nil {
+			return
+		}
+		if !errors.Is(err, errGroupLeaseExpired) {
+			gc.logger.Warn("could not subscribe", zap.Int32("lsid", int32(p.lsid)), zap.Error(err))
+		}
+		if !gc.wait(ctx) {
+			return
+		}
+	}
+}
+
+// wait waits for the heartbeat interval, and returns false if the context is
+// canceled.
+func (gc *groupConsumer) wait(ctx context.Context) bool {
+	timer := time.NewTimer(gc.opts.heartbeatInterval)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
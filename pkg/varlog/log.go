@@ -4,15 +4,20 @@ package varlog
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"sync/atomic"
+	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/kakao/varlog/internal/storagenode/client"
 	"github.com/kakao/varlog/pkg/mrc/mrconnector"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/runner"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/mrpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
 
@@ -30,10 +35,106 @@ type Log interface {
 	// metadata for failed operations is not included in the metadata list.
 	AppendTo(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, data [][]byte, opts ...AppendOption) AppendResult
 
+	// AppendTxn appends the batches to their topics or log streams
+	// atomically: either all or none of the log entries in the batches are
+	// committed. The opts are applied to every batch, except WithHeaders,
+	// which is replaced by the Headers of each batch.
+	//
+	// Log entries of a transaction carry the TxnHeader, which lists the log
+	// streams participating in the transaction. The metadata repository
+	// commits them only after all of them are written to every participant;
+	// until then, they hold back the following log entries in the same log
+	// streams. If not all of them are written within the time limit set by
+	// WithTxnTimeout, the log streams abort the transaction and never
+	// deliver its log entries to readers. The time limit is measured by the
+	// clocks of storage nodes and the metadata repository, which should be
+	// loosely synchronized.
+	//
+	// It returns a TxnResult whose Err wraps ErrTxnAborted if the
+	// transaction is aborted. If it returns another error, for instance, a
+	// timeout of the ctx, the transaction may be committed or aborted.
+	AppendTxn(ctx context.Context, batches []TxnBatch, opts ...AppendOption) TxnResult
+
+	// NewBatcher returns a Batcher that appends log entries to the topic
+	// identified by the topicID argument asynchronously. The caller should
+	// close the Batcher after use.
+	NewBatcher(topicID types.TopicID, opts ...BatcherOption) (Batcher, error)
+
+	// Read reads the committed log entry whose GLSN is the argument glsn in
+	// the topic identified by the topicID argument.
+	// Since a GLSN does not tell which log stream has the log entry, this
+	// method asks only the replicas of the owner if the client has learned
+	// it from earlier appends and reads, and every log stream in the topic
+	// otherwise. It returns an error wrapping verrors.ErrNoEntry if none of
+	// them has the log entry, and verrors.ErrTrimmed if the log entry was
+	// already trimmed.
+	Read(ctx context.Context, topicID types.TopicID, glsn types.GLSN) (varlogpb.LogEntry, error)
+
+	// ReadFrom reads the committed log entry whose LLSN is the argument llsn
+	// in the log stream identified by the topicID and logStreamID arguments.
+	// It returns an error wrapping verrors.ErrNoEntry if the log entry has not
+	// been committed yet, and verrors.ErrTrimmed if it was already trimmed.
+	ReadFrom(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, llsn types.LLSN) (varlogpb.LogEntry, error)
+
+	// ReadBatch reads the committed log entries whose GLSNs are the argument
+	// glsns in the topic identified by the topicID argument.
+	// It returns a list of results in the same order as the glsns. Each
+	// result has either the log entry or an error, for instance, wrapping
+	// verrors.ErrNoEntry or verrors.ErrTrimmed. The returned error is not nil
+	// only if the whole request fails.
+	ReadBatch(ctx context.Context, topicID types.TopicID, glsns []types.GLSN) ([]ReadBatchResult, error)
+
 	Subscribe(ctx context.Context, topicID types.TopicID, begin types.GLSN, end types.GLSN, onNextFunc OnNext, opts ...SubscribeOption) (SubscribeCloser, error)
 
 	SubscribeTo(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, begin, end types.LLSN, opts ...SubscribeOption) Subscriber
 
+	// SubscribeIter subscribes to the topic identified by the topicID
+	// argument from the GLSN begin to the GLSN end exclusively. Unlike
+	// Subscribe, the caller pulls log entries in the order of GLSN by calling
+	// Next of the returned Subscriber. Next returns io.EOF after the last
+	// log entry in the range.
+	//
+	// The subscription buffers a bounded number of log entries, which can
+	// be set by WithSubscribeBufferSize. When the buffer is full, it stops
+	// receiving log entries from storage nodes until the caller calls Next.
+	// The caller should call Close of the returned Subscriber to release
+	// resources.
+	SubscribeIter(ctx context.Context, topicID types.TopicID, begin, end types.GLSN, opts ...SubscribeOption) Subscriber
+
+	// SubscribeGroup subscribes to the topic identified by the topicID
+	// argument on behalf of the consumer group named group. It resumes from
+	// the log entry next to the GLSN committed by CommitOffset, or from the
+	// first log entry if the group has not committed any offset to the
+	// topic. It does not commit offsets by itself; the caller should call
+	// CommitOffset after processing log entries.
+	SubscribeGroup(ctx context.Context, group string, topicID types.TopicID, end types.GLSN, onNextFunc OnNext, opts ...SubscribeOption) (SubscribeCloser, error)
+
+	// CommitOffset stores the GLSN of the last log entry in the topic
+	// identified by the topicID argument consumed by the consumer group named
+	// group. The offset is replicated by the metadata repository; hence, it
+	// outlives the client. It returns an error if the topic does not exist.
+	CommitOffset(ctx context.Context, group string, topicID types.TopicID, glsn types.GLSN) error
+
+	// FetchOffset returns the GLSN committed by CommitOffset. It returns an
+	// error wrapping verrors.ErrNotExist if the group has not committed any
+	// offset to the topic.
+	FetchOffset(ctx context.Context, group string, topicID types.TopicID) (types.GLSN, error)
+
+	// CommitLogStreamOffset stores the LLSN of the last log entry in the log
+	// stream identified by the topicID and logStreamID arguments consumed by
+	// the consumer group named group.
+	CommitLogStreamOffset(ctx context.Context, group string, topicID types.TopicID, logStreamID types.LogStreamID, llsn types.LLSN) error
+
+	// FetchLogStreamOffset returns the LLSN committed by
+	// CommitLogStreamOffset. It returns an error wrapping verrors.ErrNotExist
+	// if the group has not committed any offset to the log stream.
+	FetchLogStreamOffset(ctx context.Context, group string, topicID types.TopicID, logStreamID types.LogStreamID) (types.LLSN, error)
+
+	// DeleteConsumerGroup deletes all offsets committed by the consumer group
+	// named group. It returns an error wrapping verrors.ErrNotExist if the
+	// group has not committed any offset.
+	DeleteConsumerGroup(ctx context.Context, group string) error
+
 	Trim(ctx context.Context, topicID types.TopicID, until types.GLSN, opts TrimOption) error
 
 	// PeekLogStream returns the log sequence numbers at the first and the
@@ -42,6 +143,31 @@ type Log interface {
 	// replica. If none of the replicas' statuses is either appendable or
 	// sealed, it returns an error.
 	PeekLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID) (first varlogpb.LogSequenceNumber, last varlogpb.LogSequenceNumber, err error)
+
+	// SeekTime returns the GLSN of the first log entry committed to the topic
+	// identified by the topicID at or after the time t. The result can be
+	// used as the begin of Subscribe. If no log entry has been committed
+	// since the time t, it returns the GLSN that the next log entry will
+	// have or less.
+	//
+	// Commit times are recorded by storage nodes; hence, the result is as
+	// accurate as their clocks.
+	SeekTime(ctx context.Context, topicID types.TopicID, t time.Time) (types.GLSN, error)
+
+	// ResolvePosition returns the GLSN at the symbolic position pos in the
+	// topic identified by the topicID. Latest and Now are resolved against
+	// the global high watermark of the topic in the commit results of the
+	// metadata repository, and Earliest is resolved by peeking the log
+	// streams of the topic.
+	ResolvePosition(ctx context.Context, topicID types.TopicID, pos Position) (types.GLSN, error)
+
+	// Follow subscribes to the topic identified by the topicID from the
+	// position from without an end; that is, it keeps delivering log
+	// entries as they are committed until the caller calls the returned
+	// SubscribeCloser. It is similar to calling Subscribe with the GLSN
+	// resolved by ResolvePosition and types.MaxGLSN, but its buffer is
+	// bounded as WithSubscribeBufferSize describes.
+	Follow(ctx context.Context, topicID types.TopicID, from Position, onNextFunc OnNext, opts ...SubscribeOption) (SubscribeCloser, error)
 }
 
 type AppendResult struct {
@@ -49,14 +175,25 @@ type AppendResult struct {
 	Err      error
 }
 
+// ReadBatchResult is a result of each GLSN requested by ReadBatch. If Err is
+// not nil, LogEntry is invalid.
+type ReadBatchResult struct {
+	varlogpb.LogEntry
+	Err error
+}
+
 type OnNext func(logEntry varlogpb.LogEntry, err error)
 
 type logImpl struct {
 	clusterID         types.ClusterID
+	connector         mrconnector.Connector
 	refresher         MetadataRefresher
 	lsSelector        LogStreamSelector
 	replicasRetriever ReplicasRetriever
 	allowlist         Allowlist
+	metrics           *clientMetrics
+	tracer            trace.Tracer
+	owners            *glsnOwners
 
 	logCLManager *client.Manager[*client.LogClient]
 	logger       *zap.Logger
@@ -75,12 +212,38 @@ func Open(ctx context.Context, clusterID types.ClusterID, mrAddrs []string, opts
 	for _, opt := range opts {
 		opt.apply(&logOpts)
 	}
+	if logOpts.lsSelectorFactory == nil {
+		return nil, fmt.Errorf("varlog: no log stream selector: %w", verrors.ErrInvalid)
+	}
+	if !logOpts.compression.valid() {
+		return nil, fmt.Errorf("varlog: invalid compression %s: %w", logOpts.compression, verrors.ErrInvalid)
+	}
+	if logOpts.requireEncryption && logOpts.keyProvider == nil {
+		return nil, fmt.Errorf("varlog: encryption required without key provider: %w", verrors.ErrInvalid)
+	}
+	if logOpts.meterProvider == nil {
+		return nil, fmt.Errorf("varlog: no meter provider: %w", verrors.ErrInvalid)
+	}
+	if logOpts.tracerProvider == nil {
+		return nil, fmt.Errorf("varlog: no tracer provider: %w", verrors.ErrInvalid)
+	}
+	if logOpts.txnTimeout <= 0 {
+		return nil, fmt.Errorf("varlog: invalid transaction timeout %s: %w", logOpts.txnTimeout, verrors.ErrInvalid)
+	}
 	logOpts.logger = logOpts.logger.Named("varlog").With(zap.Any("cid", clusterID))
 
+	metrics, err := newClientMetrics(logOpts.meterProvider)
+	if err != nil {
+		return nil, fmt.Errorf("varlog: metrics: %w", err)
+	}
+
 	v := &logImpl{
 		clusterID: clusterID,
 		logger:    logOpts.logger,
 		opts:      &logOpts,
+		metrics:   metrics,
+		tracer:    logOpts.tracerProvider.Tracer(instrumentationName),
+		owners:    newGLSNOwners(),
 		runner:    runner.New("varlog", logOpts.logger),
 	}
 
@@ -98,17 +261,16 @@ func Open(ctx context.Context, clusterID types.ClusterID, mrAddrs []string, opts
 	if err != nil {
 		return nil, err
 	}
+	v.connector = connector
 
 	// allowlist
 	allowlist, err := newTransientAllowlist(v.opts.denyTTL, v.opts.expireDenyInterval, v.logger)
 	if err != nil {
 		return nil, err
 	}
+	allowlist.metrics = metrics
 	v.allowlist = allowlist
 
-	// log stream selector
-	v.lsSelector = newAppendableLogStreamSelector(allowlist)
-
 	// replicas retriever
 	replicasRetriever := &renewableReplicasRetriever{}
 	v.replicasRetriever = replicasRetriever
@@ -122,6 +284,8 @@ func Open(ctx context.Context, clusterID types.ClusterID, mrAddrs []string, opts
 		replicasRetriever,
 		v.opts.metadataRefreshInterval,
 		v.opts.metadataRefreshTimeout,
+		!v.opts.disableMetadataWatch,
+		metrics,
 		v.logger,
 	)
 	if err != nil {
@@ -129,6 +293,9 @@ func Open(ctx context.Context, clusterID types.ClusterID, mrAddrs []string, opts
 	}
 	v.refresher = refresher
 
+	// log stream selector
+	v.lsSelector = v.opts.lsSelectorFactory(allowlist, refresher.Metadata)
+
 	// logcl manager
 	// TODO (jun): metadataRefresher should implement ClusterMetadataView
 	metadata := refresher.Metadata()
@@ -161,6 +328,30 @@ func (v *logImpl) AppendTo(ctx context.Context, topicID types.TopicID, logStream
 	return v.append(ctx, topicID, logStreamID, data, opts...)
 }
 
+func (v *logImpl) AppendTxn(ctx context.Context, batches []TxnBatch, opts ...AppendOption) TxnResult {
+	return v.appendTxn(ctx, batches, opts...)
+}
+
+func (v *logImpl) NewBatcher(topicID types.TopicID, opts ...BatcherOption) (Batcher, error) {
+	b, err := newBatcher(v, topicID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (v *logImpl) Read(ctx context.Context, topicID types.TopicID, glsn types.GLSN) (varlogpb.LogEntry, error) {
+	return v.read(ctx, topicID, glsn)
+}
+
+func (v *logImpl) ReadFrom(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, llsn types.LLSN) (varlogpb.LogEntry, error) {
+	return v.readFrom(ctx, topicID, logStreamID, llsn)
+}
+
+func (v *logImpl) ReadBatch(ctx context.Context, topicID types.TopicID, glsns []types.GLSN) ([]ReadBatchResult, error) {
+	return v.readBatch(ctx, topicID, glsns)
+}
+
 func (v *logImpl) Subscribe(ctx context.Context, topicID types.TopicID, begin types.GLSN, end types.GLSN, onNextFunc OnNext, opts ...SubscribeOption) (SubscribeCloser, error) {
 	return v.subscribe(ctx, topicID, begin, end, onNextFunc, opts...)
 }
@@ -169,6 +360,36 @@ func (v *logImpl) SubscribeTo(ctx context.Context, topicID types.TopicID, logStr
 	return v.subscribeTo(ctx, topicID, logStreamID, begin, end, opts...)
 }
 
+func (v *logImpl) SubscribeIter(ctx context.Context, topicID types.TopicID, begin, end types.GLSN, opts ...SubscribeOption) Subscriber {
+	return v.subscribeIter(ctx, topicID, begin, end, opts...)
+}
+
+func (v *logImpl) SubscribeGroup(ctx context.Context, group string, topicID types.TopicID, end types.GLSN, onNextFunc OnNext, opts ...SubscribeOption) (SubscribeCloser, error) {
+	return v.subscribeGroup(ctx, group, topicID, end, onNextFunc, opts...)
+}
+
+func (v *logImpl) CommitOffset(ctx context.Context, group string, topicID types.TopicID, glsn types.GLSN) error {
+	return v.commitOffset(ctx, group, mrpb.ConsumerGroupOffset{TopicID: topicID, GLSN: glsn})
+}
+
+func (v *logImpl) FetchOffset(ctx context.Context, group string, topicID types.TopicID) (types.GLSN, error) {
+	offset, err := v.fetchOffset(ctx, group, topicID, 0)
+	return offset.GLSN, err
+}
+
+func (v *logImpl) CommitLogStreamOffset(ctx context.Context, group string, topicID types.TopicID, logStreamID types.LogStreamID, llsn types.LLSN) error {
+	return v.commitOffset(ctx, group, mrpb.ConsumerGroupOffset{TopicID: topicID, LogStreamID: logStreamID, LLSN: llsn})
+}
+
+func (v *logImpl) FetchLogStreamOffset(ctx context.Context, group string, topicID types.TopicID, logStreamID types.LogStreamID) (types.LLSN, error) {
+	offset, err := v.fetchOffset(ctx, group, topicID, logStreamID)
+	return offset.LLSN, err
+}
+
+func (v *logImpl) DeleteConsumerGroup(ctx context.Context, group string) error {
+	return v.deleteConsumerGroup(ctx, group)
+}
+
 func (v *logImpl) Trim(ctx context.Context, topicID types.TopicID, until types.GLSN, opts TrimOption) error {
 	return v.trim(ctx, topicID, until, opts)
 }
@@ -177,6 +398,18 @@ func (v *logImpl) PeekLogStream(ctx context.Context, tpid types.TopicID, lsid ty
 	return v.peekLogStream(ctx, tpid, lsid)
 }
 
+func (v *logImpl) SeekTime(ctx context.Context, topicID types.TopicID, t time.Time) (types.GLSN, error) {
+	return v.seekTime(ctx, topicID, t)
+}
+
+func (v *logImpl) ResolvePosition(ctx context.Context, topicID types.TopicID, pos Position) (types.GLSN, error) {
+	return v.resolvePosition(ctx, topicID, pos)
+}
+
+func (v *logImpl) Follow(ctx context.Context, topicID types.TopicID, from Position, onNextFunc OnNext, opts ...SubscribeOption) (SubscribeCloser, error) {
+	return v.follow(ctx, topicID, from, onNextFunc, opts...)
+}
+
 func (v *logImpl) Close() (err error) {
 	if v.closed.Load() {
 		return
@@ -7,6 +7,7 @@ package varlog
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 
@@ -75,6 +76,25 @@ func (mr *MockLogMockRecorder) AppendTo(arg0, arg1, arg2, arg3 interface{}, arg4
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendTo", reflect.TypeOf((*MockLog)(nil).AppendTo), varargs...)
 }
 
+// AppendTxn mocks base method.
+func (m *MockLog) AppendTxn(arg0 context.Context, arg1 []TxnBatch, arg2 ...AppendOption) TxnResult {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AppendTxn", varargs...)
+	ret0, _ := ret[0].(TxnResult)
+	return ret0
+}
+
+// AppendTxn indicates an expected call of AppendTxn.
+func (mr *MockLogMockRecorder) AppendTxn(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendTxn", reflect.TypeOf((*MockLog)(nil).AppendTxn), varargs...)
+}
+
 // Close mocks base method.
 func (m *MockLog) Close() error {
 	m.ctrl.T.Helper()
@@ -89,6 +109,118 @@ func (mr *MockLogMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockLog)(nil).Close))
 }
 
+// CommitLogStreamOffset mocks base method.
+func (m *MockLog) CommitLogStreamOffset(arg0 context.Context, arg1 string, arg2 types.TopicID, arg3 types.LogStreamID, arg4 types.LLSN) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitLogStreamOffset", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitLogStreamOffset indicates an expected call of CommitLogStreamOffset.
+func (mr *MockLogMockRecorder) CommitLogStreamOffset(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitLogStreamOffset", reflect.TypeOf((*MockLog)(nil).CommitLogStreamOffset), arg0, arg1, arg2, arg3, arg4)
+}
+
+// CommitOffset mocks base method.
+func (m *MockLog) CommitOffset(arg0 context.Context, arg1 string, arg2 types.TopicID, arg3 types.GLSN) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitOffset", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitOffset indicates an expected call of CommitOffset.
+func (mr *MockLogMockRecorder) CommitOffset(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitOffset", reflect.TypeOf((*MockLog)(nil).CommitOffset), arg0, arg1, arg2, arg3)
+}
+
+// DeleteConsumerGroup mocks base method.
+func (m *MockLog) DeleteConsumerGroup(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConsumerGroup indicates an expected call of DeleteConsumerGroup.
+func (mr *MockLogMockRecorder) DeleteConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConsumerGroup", reflect.TypeOf((*MockLog)(nil).DeleteConsumerGroup), arg0, arg1)
+}
+
+// FetchLogStreamOffset mocks base method.
+func (m *MockLog) FetchLogStreamOffset(arg0 context.Context, arg1 string, arg2 types.TopicID, arg3 types.LogStreamID) (types.LLSN, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchLogStreamOffset", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(types.LLSN)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchLogStreamOffset indicates an expected call of FetchLogStreamOffset.
+func (mr *MockLogMockRecorder) FetchLogStreamOffset(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchLogStreamOffset", reflect.TypeOf((*MockLog)(nil).FetchLogStreamOffset), arg0, arg1, arg2, arg3)
+}
+
+// FetchOffset mocks base method.
+func (m *MockLog) FetchOffset(arg0 context.Context, arg1 string, arg2 types.TopicID) (types.GLSN, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchOffset", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types.GLSN)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchOffset indicates an expected call of FetchOffset.
+func (mr *MockLogMockRecorder) FetchOffset(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchOffset", reflect.TypeOf((*MockLog)(nil).FetchOffset), arg0, arg1, arg2)
+}
+
+// Follow mocks base method.
+func (m *MockLog) Follow(arg0 context.Context, arg1 types.TopicID, arg2 Position, arg3 OnNext, arg4 ...SubscribeOption) (SubscribeCloser, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Follow", varargs...)
+	ret0, _ := ret[0].(SubscribeCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockLogMockRecorder) Follow(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockLog)(nil).Follow), varargs...)
+}
+
+// NewBatcher mocks base method.
+func (m *MockLog) NewBatcher(arg0 types.TopicID, arg1 ...BatcherOption) (Batcher, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewBatcher", varargs...)
+	ret0, _ := ret[0].(Batcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewBatcher indicates an expected call of NewBatcher.
+func (mr *MockLogMockRecorder) NewBatcher(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewBatcher", reflect.TypeOf((*MockLog)(nil).NewBatcher), varargs...)
+}
+
 // PeekLogStream mocks base method.
 func (m *MockLog) PeekLogStream(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID) (varlogpb.LogSequenceNumber, varlogpb.LogSequenceNumber, error) {
 	m.ctrl.T.Helper()
@@ -105,6 +237,81 @@ func (mr *MockLogMockRecorder) PeekLogStream(arg0, arg1, arg2 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeekLogStream", reflect.TypeOf((*MockLog)(nil).PeekLogStream), arg0, arg1, arg2)
 }
 
+// Read mocks base method.
+func (m *MockLog) Read(arg0 context.Context, arg1 types.TopicID, arg2 types.GLSN) (varlogpb.LogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0, arg1, arg2)
+	ret0, _ := ret[0].(varlogpb.LogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockLogMockRecorder) Read(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockLog)(nil).Read), arg0, arg1, arg2)
+}
+
+// ReadBatch mocks base method.
+func (m *MockLog) ReadBatch(arg0 context.Context, arg1 types.TopicID, arg2 []types.GLSN) ([]ReadBatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadBatch", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]ReadBatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadBatch indicates an expected call of ReadBatch.
+func (mr *MockLogMockRecorder) ReadBatch(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadBatch", reflect.TypeOf((*MockLog)(nil).ReadBatch), arg0, arg1, arg2)
+}
+
+// ReadFrom mocks base method.
+func (m *MockLog) ReadFrom(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3 types.LLSN) (varlogpb.LogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadFrom", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(varlogpb.LogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadFrom indicates an expected call of ReadFrom.
+func (mr *MockLogMockRecorder) ReadFrom(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFrom", reflect.TypeOf((*MockLog)(nil).ReadFrom), arg0, arg1, arg2, arg3)
+}
+
+// ResolvePosition mocks base method.
+func (m *MockLog) ResolvePosition(arg0 context.Context, arg1 types.TopicID, arg2 Position) (types.GLSN, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePosition", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types.GLSN)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePosition indicates an expected call of ResolvePosition.
+func (mr *MockLogMockRecorder) ResolvePosition(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePosition", reflect.TypeOf((*MockLog)(nil).ResolvePosition), arg0, arg1, arg2)
+}
+
+// SeekTime mocks base method.
+func (m *MockLog) SeekTime(arg0 context.Context, arg1 types.TopicID, arg2 time.Time) (types.GLSN, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeekTime", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types.GLSN)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeekTime indicates an expected call of SeekTime.
+func (mr *MockLogMockRecorder) SeekTime(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeekTime", reflect.TypeOf((*MockLog)(nil).SeekTime), arg0, arg1, arg2)
+}
+
 // Subscribe mocks base method.
 func (m *MockLog) Subscribe(arg0 context.Context, arg1 types.TopicID, arg2, arg3 types.GLSN, arg4 OnNext, arg5 ...SubscribeOption) (SubscribeCloser, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +332,45 @@ func (mr *MockLogMockRecorder) Subscribe(arg0, arg1, arg2, arg3, arg4 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockLog)(nil).Subscribe), varargs...)
 }
 
+// SubscribeGroup mocks base method.
+func (m *MockLog) SubscribeGroup(arg0 context.Context, arg1 string, arg2 types.TopicID, arg3 types.GLSN, arg4 OnNext, arg5 ...SubscribeOption) (SubscribeCloser, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3, arg4}
+	for _, a := range arg5 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeGroup", varargs...)
+	ret0, _ := ret[0].(SubscribeCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeGroup indicates an expected call of SubscribeGroup.
+func (mr *MockLogMockRecorder) SubscribeGroup(arg0, arg1, arg2, arg3, arg4 interface{}, arg5 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3, arg4}, arg5...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeGroup", reflect.TypeOf((*MockLog)(nil).SubscribeGroup), varargs...)
+}
+
+// SubscribeIter mocks base method.
+func (m *MockLog) SubscribeIter(arg0 context.Context, arg1 types.TopicID, arg2, arg3 types.GLSN, arg4 ...SubscribeOption) Subscriber {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeIter", varargs...)
+	ret0, _ := ret[0].(Subscriber)
+	return ret0
+}
+
+// SubscribeIter indicates an expected call of SubscribeIter.
+func (mr *MockLogMockRecorder) SubscribeIter(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeIter", reflect.TypeOf((*MockLog)(nil).SubscribeIter), varargs...)
+}
+
 // SubscribeTo mocks base method.
 func (m *MockLog) SubscribeTo(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3, arg4 types.LLSN, arg5 ...SubscribeOption) Subscriber {
 	m.ctrl.T.Helper()
@@ -1,9 +1,16 @@
 package varlog
 
 import (
+	"encoding/binary"
 	"errors"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 var (
@@ -19,6 +26,86 @@ type LogStreamSelector interface {
 	GetAll(topicID types.TopicID) []types.LogStreamID
 }
 
+// AppendObserver is implemented by a LogStreamSelector that learns from the
+// results of appends. The client calls ObserveAppend after each append request
+// to a log stream with its latency and error.
+type AppendObserver interface {
+	ObserveAppend(topicID types.TopicID, logStreamID types.LogStreamID, latency time.Duration, err error)
+}
+
+// LogStreamSelectorFactory creates a LogStreamSelector when a client is
+// opened. The allowlist has log streams that are not denied by the client, and
+// the metadata returns the latest metadata of the cluster known to the client.
+type LogStreamSelectorFactory func(allowlist Allowlist, metadata func() *varlogpb.MetadataDescriptor) LogStreamSelector
+
+// RandomLogStreamSelector returns a LogStreamSelectorFactory that selects a log
+// stream at random. It is the default policy.
+func RandomLogStreamSelector() LogStreamSelectorFactory {
+	return func(allowlist Allowlist, _ func() *varlogpb.MetadataDescriptor) LogStreamSelector {
+		return newAppendableLogStreamSelector(allowlist)
+	}
+}
+
+// RoundRobinLogStreamSelector returns a LogStreamSelectorFactory that selects
+// log streams of a topic in turn.
+func RoundRobinLogStreamSelector() LogStreamSelectorFactory {
+	return func(allowlist Allowlist, _ func() *varlogpb.MetadataDescriptor) LogStreamSelector {
+		return &rrSelector{
+			allowlist: allowlist,
+			next:      make(map[types.TopicID]uint64),
+		}
+	}
+}
+
+// LeastRecentlyFailedLogStreamSelector returns a LogStreamSelectorFactory that
+// selects the log stream whose last failed append is the oldest. Log streams
+// that have never failed are preferred and chosen at random.
+func LeastRecentlyFailedLogStreamSelector() LogStreamSelectorFactory {
+	return func(allowlist Allowlist, _ func() *varlogpb.MetadataDescriptor) LogStreamSelector {
+		return &lrfSelector{
+			allowlist: allowlist,
+			failures:  make(map[topicLogStream]time.Time),
+		}
+	}
+}
+
+// LatencyWeightedLogStreamSelector returns a LogStreamSelectorFactory that
+// selects a log stream at random with a probability inversely proportional to
+// the moving average of its append latency. Log streams that have not been
+// observed yet are weighted as the fastest one so that they are tried soon.
+func LatencyWeightedLogStreamSelector() LogStreamSelectorFactory {
+	return func(allowlist Allowlist, _ func() *varlogpb.MetadataDescriptor) LogStreamSelector {
+		return &lwSelector{
+			allowlist: allowlist,
+			latencies: make(map[topicLogStream]time.Duration),
+		}
+	}
+}
+
+// ZoneTagKey is the key of the storage node tag that locality-aware selection
+// uses to find the zone of a storage node.
+const ZoneTagKey = "zone"
+
+// LocalityLogStreamSelector returns a LogStreamSelectorFactory that prefers log
+// streams whose primary replicas are on storage nodes tagged with the given
+// zone, that is, storage nodes whose tag ZoneTagKey equals the zone. It
+// selects one of them at random, and it selects one of the other log streams
+// at random if there is no such log stream.
+func LocalityLogStreamSelector(zone string) LogStreamSelectorFactory {
+	return func(allowlist Allowlist, metadata func() *varlogpb.MetadataDescriptor) LogStreamSelector {
+		return &localitySelector{
+			allowlist: allowlist,
+			metadata:  metadata,
+			zone:      zone,
+		}
+	}
+}
+
+type topicLogStream struct {
+	topicID     types.TopicID
+	logStreamID types.LogStreamID
+}
+
 // alsSelector implements LogStreamSelector. It uses allowlist to select an appendable log stream.
 type alsSelector struct {
 	allowlist Allowlist
@@ -39,3 +126,286 @@ func (als *alsSelector) Select(topicID types.TopicID) (types.LogStreamID, bool)
 func (als *alsSelector) GetAll(topicID types.TopicID) []types.LogStreamID {
 	return als.allowlist.GetAll(topicID)
 }
+
+// rrSelector implements LogStreamSelector. It selects log streams in the
+// order of their IDs in turn.
+type rrSelector struct {
+	allowlist Allowlist
+
+	mu   sync.Mutex
+	next map[types.TopicID]uint64
+}
+
+var _ LogStreamSelector = (*rrSelector)(nil)
+
+// Select implements (LogStreamSelector).Select method.
+func (rr *rrSelector) Select(topicID types.TopicID) (types.LogStreamID, bool) {
+	lsids := rr.allowlist.GetAll(topicID)
+	if len(lsids) == 0 {
+		return 0, false
+	}
+	sort.Slice(lsids, func(i, j int) bool { return lsids[i] < lsids[j] })
+
+	rr.mu.Lock()
+	next := rr.next[topicID]
+	rr.next[topicID] = next + 1
+	rr.mu.Unlock()
+	return lsids[next%uint64(len(lsids))], true
+}
+
+// GetAll implements (LogStreamSelector).GetAll method.
+func (rr *rrSelector) GetAll(topicID types.TopicID) []types.LogStreamID {
+	return rr.allowlist.GetAll(topicID)
+}
+
+// lrfSelector implements LogStreamSelector and AppendObserver. It remembers
+// when appends to each log stream failed last.
+type lrfSelector struct {
+	allowlist Allowlist
+
+	mu       sync.Mutex
+	failures map[topicLogStream]time.Time
+}
+
+var (
+	_ LogStreamSelector = (*lrfSelector)(nil)
+	_ AppendObserver    = (*lrfSelector)(nil)
+)
+
+// Select implements (LogStreamSelector).Select method.
+func (lrf *lrfSelector) Select(topicID types.TopicID) (types.LogStreamID, bool) {
+	lsids := lrf.allowlist.GetAll(topicID)
+	if len(lsids) == 0 {
+		return 0, false
+	}
+
+	lrf.mu.Lock()
+	defer lrf.mu.Unlock()
+	var oldest time.Time
+	candidates := lsids[:0]
+	for i, lsid := range lsids {
+		failed := lrf.failures[topicLogStream{topicID: topicID, logStreamID: lsid}]
+		switch {
+		case i == 0 || failed.Before(oldest):
+			oldest = failed
+			candidates = append(candidates[:0], lsid)
+		case failed.Equal(oldest):
+			candidates = append(candidates, lsid)
+		}
+	}
+	return candidates[rand.Intn(len(candidates))], true
+}
+
+// GetAll implements (LogStreamSelector).GetAll method.
+func (lrf *lrfSelector) GetAll(topicID types.TopicID) []types.LogStreamID {
+	return lrf.allowlist.GetAll(topicID)
+}
+
+// ObserveAppend implements (AppendObserver).ObserveAppend method.
+func (lrf *lrfSelector) ObserveAppend(topicID types.TopicID, logStreamID types.LogStreamID, _ time.Duration, err error) {
+	if err == nil {
+		return
+	}
+	lrf.mu.Lock()
+	defer lrf.mu.Unlock()
+	lrf.failures[topicLogStream{topicID: topicID, logStreamID: logStreamID}] = time.Now()
+}
+
+// latencyEWMAWeight is the weight of a new sample in the moving average of
+// append latencies.
+const latencyEWMAWeight = 0.2
+
+// lwSelector implements LogStreamSelector and AppendObserver. It keeps the
+// exponentially weighted moving average of append latencies of each log
+// stream.
+type lwSelector struct {
+	allowlist Allowlist
+
+	mu        sync.Mutex
+	latencies map[topicLogStream]time.Duration
+}
+
+var (
+	_ LogStreamSelector = (*lwSelector)(nil)
+	_ AppendObserver    = (*lwSelector)(nil)
+)
+
+// Select implements (LogStreamSelector).Select method.
+func (lw *lwSelector) Select(topicID types.TopicID) (types.LogStreamID, bool) {
+	lsids := lw.allowlist.GetAll(topicID)
+	if len(lsids) == 0 {
+		return 0, false
+	}
+
+	latencies := make([]time.Duration, len(lsids))
+	fastest := time.Duration(0)
+	lw.mu.Lock()
+	for i, lsid := range lsids {
+		latencies[i] = lw.latencies[topicLogStream{topicID: topicID, logStreamID: lsid}]
+		if latencies[i] > 0 && (fastest == 0 || latencies[i] < fastest) {
+			fastest = latencies[i]
+		}
+	}
+	lw.mu.Unlock()
+	if fastest == 0 {
+		return lsids[rand.Intn(len(lsids))], true
+	}
+
+	weights := make([]float64, len(lsids))
+	sum := 0.0
+	for i, latency := range latencies {
+		if latency == 0 {
+			latency = fastest
+		}
+		weights[i] = 1 / float64(latency)
+		sum += weights[i]
+	}
+	r := rand.Float64() * sum
+	for i, weight := range weights {
+		if r < weight {
+			return lsids[i], true
+		}
+		r -= weight
+	}
+	return lsids[len(lsids)-1], true
+}
+
+// GetAll implements (LogStreamSelector).GetAll method.
+func (lw *lwSelector) GetAll(topicID types.TopicID) []types.LogStreamID {
+	return lw.allowlist.GetAll(topicID)
+}
+
+// ObserveAppend implements (AppendObserver).ObserveAppend method. It ignores
+// failed appends since the client denies the log stream.
+func (lw *lwSelector) ObserveAppend(topicID types.TopicID, logStreamID types.LogStreamID, latency time.Duration, err error) {
+	if err != nil || latency <= 0 {
+		return
+	}
+	key := topicLogStream{topicID: topicID, logStreamID: logStreamID}
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+	if avg, ok := lw.latencies[key]; ok {
+		latency = time.Duration(latencyEWMAWeight*float64(latency) + (1-latencyEWMAWeight)*float64(avg))
+	}
+	lw.latencies[key] = latency
+}
+
+// localitySelector implements LogStreamSelector. It finds the zones of
+// primary replicas from the cluster metadata.
+type localitySelector struct {
+	allowlist Allowlist
+	metadata  func() *varlogpb.MetadataDescriptor
+	zone      string
+
+	mu sync.Mutex
+	// md is the metadata from which the local is built.
+	md *varlogpb.MetadataDescriptor
+	// local is the set of log streams whose primary replicas are in the zone.
+	local map[types.LogStreamID]bool
+}
+
+var _ LogStreamSelector = (*localitySelector)(nil)
+
+// Select implements (LogStreamSelector).Select method.
+func (ls *localitySelector) Select(topicID types.TopicID) (types.LogStreamID, bool) {
+	lsids := ls.allowlist.GetAll(topicID)
+	if len(lsids) == 0 {
+		return 0, false
+	}
+
+	local := ls.localLogStreams()
+	candidates := make([]types.LogStreamID, 0, len(lsids))
+	for _, lsid := range lsids {
+		if local[lsid] {
+			candidates = append(candidates, lsid)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = lsids
+	}
+	return candidates[rand.Intn(len(candidates))], true
+}
+
+// GetAll implements (LogStreamSelector).GetAll method.
+func (ls *localitySelector) GetAll(topicID types.TopicID) []types.LogStreamID {
+	return ls.allowlist.GetAll(topicID)
+}
+
+// localLogStreams returns the set of log streams whose primary replicas are
+// in the zone. It rebuilds the set only if the metadata changes.
+func (ls *localitySelector) localLogStreams() map[types.LogStreamID]bool {
+	md := ls.metadata()
+
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	if md == ls.md && ls.local != nil {
+		return ls.local
+	}
+
+	local := make(map[types.LogStreamID]bool)
+	for _, lsd := range md.GetLogStreams() {
+		if len(lsd.GetReplicas()) == 0 || lsd.Replicas[0] == nil {
+			continue
+		}
+		snd := md.GetStorageNode(lsd.Replicas[0].StorageNodeID)
+		if snd != nil && snd.Tags[ZoneTagKey] == ls.zone {
+			local[lsd.LogStreamID] = true
+		}
+	}
+	ls.md = md
+	ls.local = local
+	return local
+}
+
+// observeAppend records the latency of an append, and lets the log stream
+// selector know the result of the append if it is an AppendObserver.
+func (v *logImpl) observeAppend(tpid types.TopicID, lsid types.LogStreamID, latency time.Duration, err error) {
+	v.metrics.recordAppend(tpid, lsid, latency, err)
+	if observer, ok := v.lsSelector.(AppendObserver); ok {
+		observer.ObserveAppend(tpid, lsid, latency, err)
+	}
+}
+
+// selectLogStreamByKey selects a log stream for the partition key among the
+// logStreamIDs. It uses rendezvous hashing, that is, it picks the log stream
+// that has the highest score for the pair of the key and the log stream.
+// Hence, the result does not depend on the order of the logStreamIDs, and
+// adding a log stream moves only the keys for which the new log stream has
+// the highest score.
+func selectLogStreamByKey(key []byte, logStreamIDs []types.LogStreamID) (types.LogStreamID, bool) {
+	if len(logStreamIDs) == 0 {
+		return 0, false
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write(key)
+	keyHash := h.Sum64()
+
+	var buf [8]byte
+	var selected types.LogStreamID
+	var maxScore uint64
+	for i, lsid := range logStreamIDs {
+		binary.BigEndian.PutUint64(buf[:], keyHash)
+		h.Reset()
+		_, _ = h.Write(buf[:])
+		binary.BigEndian.PutUint32(buf[:4], uint32(lsid))
+		_, _ = h.Write(buf[:4])
+		score := mix64(h.Sum64())
+		if i == 0 || score > maxScore || (score == maxScore && lsid < selected) {
+			selected = lsid
+			maxScore = score
+		}
+	}
+	return selected, true
+}
+
+// mix64 is the finalizer of SplitMix64. It spreads the bits of FNV hashes,
+// which are poorly distributed for short inputs.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
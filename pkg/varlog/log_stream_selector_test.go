@@ -0,0 +1,210 @@
+package varlog
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func TestSelectLogStreamByKey(t *testing.T) {
+	const numKeys = 10000
+
+	_, ok := selectLogStreamByKey([]byte("key"), nil)
+	require.False(t, ok)
+
+	lsids := []types.LogStreamID{1, 2, 3, 4}
+	reversed := []types.LogStreamID{4, 3, 2, 1}
+	added := []types.LogStreamID{1, 2, 3, 4, 5}
+
+	counts := make(map[types.LogStreamID]int, len(lsids))
+	moved := 0
+	for i := 0; i < numKeys; i++ {
+		key := []byte(strconv.Itoa(i))
+
+		lsid, ok := selectLogStreamByKey(key, lsids)
+		require.True(t, ok)
+		counts[lsid]++
+
+		// The result does not depend on the order of log streams.
+		other, ok := selectLogStreamByKey(key, reversed)
+		require.True(t, ok)
+		require.Equal(t, lsid, other)
+
+		// Adding a log stream moves keys only to the new one.
+		other, ok = selectLogStreamByKey(key, added)
+		require.True(t, ok)
+		if other != lsid {
+			require.Equal(t, types.LogStreamID(5), other)
+			moved++
+		}
+	}
+
+	// Keys are spread over log streams evenly.
+	for _, lsid := range lsids {
+		require.InDelta(t, numKeys/len(lsids), counts[lsid], numKeys*0.05)
+	}
+	require.InDelta(t, numKeys/len(added), moved, numKeys*0.05)
+}
+
+func newTestSelectorMetadata() *varlogpb.MetadataDescriptor {
+	// Log stream N has its primary replica on storage node N, and storage
+	// nodes 1 and 2 are in zone "a".
+	md := &varlogpb.MetadataDescriptor{
+		Topics: []*varlogpb.TopicDescriptor{
+			{TopicID: 1, LogStreams: []types.LogStreamID{1, 2, 3, 4}},
+		},
+	}
+	for i := 1; i <= 4; i++ {
+		zone := "b"
+		if i <= 2 {
+			zone = "a"
+		}
+		md.StorageNodes = append(md.StorageNodes, &varlogpb.StorageNodeDescriptor{
+			StorageNode: varlogpb.StorageNode{StorageNodeID: types.StorageNodeID(i)},
+			Tags:        map[string]string{ZoneTagKey: zone},
+		})
+		md.LogStreams = append(md.LogStreams, &varlogpb.LogStreamDescriptor{
+			TopicID:     1,
+			LogStreamID: types.LogStreamID(i),
+			Replicas: []*varlogpb.ReplicaDescriptor{
+				{StorageNodeID: types.StorageNodeID(i)},
+			},
+		})
+	}
+	return md
+}
+
+func newTestSelector(t *testing.T, factory LogStreamSelectorFactory) (LogStreamSelector, Allowlist) {
+	allowlist, err := newTransientAllowlist(time.Minute, time.Minute, zap.NewNop())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, allowlist.Close())
+	})
+
+	md := newTestSelectorMetadata()
+	allowlist.Renew(md)
+	return factory(allowlist, func() *varlogpb.MetadataDescriptor { return md }), allowlist
+}
+
+func TestLogStreamSelector(t *testing.T) {
+	const (
+		tpid  = types.TopicID(1)
+		tries = 1000
+	)
+
+	tcs := []struct {
+		name    string
+		factory LogStreamSelectorFactory
+		testf   func(t *testing.T, sel LogStreamSelector, allowlist Allowlist)
+	}{
+		{
+			name:    "Random",
+			factory: RandomLogStreamSelector(),
+			testf: func(t *testing.T, sel LogStreamSelector, allowlist Allowlist) {
+				allowlist.Deny(tpid, 1)
+				for i := 0; i < tries; i++ {
+					lsid, ok := sel.Select(tpid)
+					require.True(t, ok)
+					require.NotEqual(t, types.LogStreamID(1), lsid)
+				}
+			},
+		},
+		{
+			name:    "RoundRobin",
+			factory: RoundRobinLogStreamSelector(),
+			testf: func(t *testing.T, sel LogStreamSelector, allowlist Allowlist) {
+				for i := 0; i < 8; i++ {
+					lsid, ok := sel.Select(tpid)
+					require.True(t, ok)
+					require.Equal(t, types.LogStreamID(i%4+1), lsid)
+				}
+
+				allowlist.Deny(tpid, 2)
+				seen := make(map[types.LogStreamID]int)
+				for i := 0; i < 3; i++ {
+					lsid, ok := sel.Select(tpid)
+					require.True(t, ok)
+					seen[lsid]++
+				}
+				require.Equal(t, map[types.LogStreamID]int{1: 1, 3: 1, 4: 1}, seen)
+			},
+		},
+		{
+			name:    "LeastRecentlyFailed",
+			factory: LeastRecentlyFailedLogStreamSelector(),
+			testf: func(t *testing.T, sel LogStreamSelector, _ Allowlist) {
+				observer := sel.(AppendObserver)
+				observer.ObserveAppend(tpid, 1, time.Millisecond, errors.New("failed"))
+				observer.ObserveAppend(tpid, 2, time.Millisecond, errors.New("failed"))
+				observer.ObserveAppend(tpid, 3, time.Millisecond, nil)
+				for i := 0; i < tries; i++ {
+					lsid, ok := sel.Select(tpid)
+					require.True(t, ok)
+					require.Contains(t, []types.LogStreamID{3, 4}, lsid)
+				}
+
+				observer.ObserveAppend(tpid, 3, time.Millisecond, errors.New("failed"))
+				observer.ObserveAppend(tpid, 4, time.Millisecond, errors.New("failed"))
+				lsid, ok := sel.Select(tpid)
+				require.True(t, ok)
+				require.Equal(t, types.LogStreamID(1), lsid)
+			},
+		},
+		{
+			name:    "LatencyWeighted",
+			factory: LatencyWeightedLogStreamSelector(),
+			testf: func(t *testing.T, sel LogStreamSelector, allowlist Allowlist) {
+				allowlist.Deny(tpid, 3)
+				allowlist.Deny(tpid, 4)
+
+				observer := sel.(AppendObserver)
+				observer.ObserveAppend(tpid, 1, time.Millisecond, nil)
+				observer.ObserveAppend(tpid, 2, 9*time.Millisecond, nil)
+				counts := make(map[types.LogStreamID]int)
+				for i := 0; i < tries; i++ {
+					lsid, ok := sel.Select(tpid)
+					require.True(t, ok)
+					counts[lsid]++
+				}
+				require.InDelta(t, tries*0.9, counts[1], tries*0.05)
+				require.InDelta(t, tries*0.1, counts[2], tries*0.05)
+			},
+		},
+		{
+			name:    "Locality",
+			factory: LocalityLogStreamSelector("a"),
+			testf: func(t *testing.T, sel LogStreamSelector, allowlist Allowlist) {
+				for i := 0; i < tries; i++ {
+					lsid, ok := sel.Select(tpid)
+					require.True(t, ok)
+					require.Contains(t, []types.LogStreamID{1, 2}, lsid)
+				}
+
+				// It falls back to log streams in other zones.
+				allowlist.Deny(tpid, 1)
+				allowlist.Deny(tpid, 2)
+				lsid, ok := sel.Select(tpid)
+				require.True(t, ok)
+				require.Contains(t, []types.LogStreamID{3, 4}, lsid)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			sel, allowlist := newTestSelector(t, tc.factory)
+			tc.testf(t, sel, allowlist)
+
+			_, ok := sel.Select(2)
+			require.False(t, ok)
+		})
+	}
+}
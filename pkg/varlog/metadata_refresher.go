@@ -4,6 +4,7 @@ package varlog
 
 import (
 	"context"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -17,6 +18,10 @@ import (
 	"github.com/kakao/varlog/proto/varlogpb"
 )
 
+// metadataWatchRetryInterval is the interval to restart watching metadata after
+// the watch stops. Meanwhile, the metadata refresher falls back to polling.
+const metadataWatchRetryInterval = time.Second
+
 type Renewable interface {
 	Renew(metadata *varlogpb.MetadataDescriptor)
 }
@@ -29,16 +34,20 @@ type MetadataRefresher interface {
 
 // metadataRefresher fetches metadata from the metadata repository nodes via mrconnector. It also
 // updates internal fields to provide metadata to its callers.
+// It watches metadata changes pushed by the metadata repository, and polls the
+// metadata periodically in case the watch misses changes or stops.
 // It can provide stale metadata to callers.
 type metadataRefresher struct {
 	connector         mrconnector.Connector
 	metadata          atomic.Value // *varlogpb.MetadataDescriptor
+	renewMu           sync.Mutex
 	allowlist         RenewableAllowlist
 	replicasRetriever RenewableReplicasRetriever
 	refreshInterval   time.Duration
 	group             singleflight.Group
 	runner            *runner.Runner
 	cancel            context.CancelFunc
+	metrics           *clientMetrics
 	logger            *zap.Logger
 }
 
@@ -49,6 +58,8 @@ func newMetadataRefresher(
 	replicasRetriever RenewableReplicasRetriever,
 	refreshInterval,
 	refreshTimeout time.Duration,
+	watch bool,
+	metrics *clientMetrics,
 	logger *zap.Logger) (*metadataRefresher, error) {
 	if logger == nil {
 		logger = zap.NewNop()
@@ -60,6 +71,7 @@ func newMetadataRefresher(
 		refreshInterval:   refreshInterval,
 		allowlist:         allowlist,
 		replicasRetriever: replicasRetriever,
+		metrics:           metrics,
 		logger:            logger,
 		runner:            runner.New("metarefresher", logger),
 	}
@@ -73,6 +85,13 @@ func newMetadataRefresher(
 		mr.runner.Stop()
 		return nil, err
 	}
+	if watch {
+		if err := mr.runner.RunC(mctx, mr.watcher); err != nil {
+			cancel()
+			mr.runner.Stop()
+			return nil, err
+		}
+	}
 	mr.cancel = cancel
 	return mr, nil
 }
@@ -114,26 +133,62 @@ func (mr *metadataRefresher) refresh(ctx context.Context) error {
 			return nil, err
 		}
 		// TODO (jun): check if it needs retry? am I torching mr?
+		start := time.Now()
 		clusmeta, err := client.GetMetadata(ctx)
+		mr.metrics.recordMetadataRefresh(time.Since(start), err)
 		if err != nil {
 			return nil, multierr.Append(err, client.Close())
 		}
 
-		if clusmeta.GetAppliedIndex() == mr.getAppliedIndex() {
-			return nil, nil
+		mr.renew(clusmeta)
+		return nil, nil
+	})
+	return err
+}
+
+// watcher receives metadata pushed by the metadata repository. If the watch
+// stops, for instance, due to the failure of the metadata repository node, it
+// closes the client to connect to another node and restarts the watch.
+func (mr *metadataRefresher) watcher(ctx context.Context) {
+	for {
+		client, err := mr.connector.Client(ctx)
+		if err == nil {
+			err = client.WatchMetadata(ctx, mr.getAppliedIndex(), mr.renew)
+			if ctx.Err() == nil {
+				err = multierr.Append(err, client.Close())
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		mr.logger.Debug("metadata watch stopped", zap.Error(err))
+
+		select {
+		case <-time.After(metadataWatchRetryInterval):
+		case <-ctx.Done():
+			return
 		}
+	}
+}
 
-		// update metadata
-		mr.metadata.Store(clusmeta)
+// renew updates the metadata, allowlist, and replicas retriever if the
+// argument clusmeta is newer than the current metadata.
+func (mr *metadataRefresher) renew(clusmeta *varlogpb.MetadataDescriptor) {
+	mr.renewMu.Lock()
+	defer mr.renewMu.Unlock()
 
-		// update allowlist
-		mr.allowlist.Renew(clusmeta)
+	if clusmeta == nil || clusmeta.GetAppliedIndex() <= mr.getAppliedIndex() {
+		return
+	}
 
-		// update replicasRetriever
-		mr.replicasRetriever.Renew(clusmeta)
-		return nil, nil
-	})
-	return err
+	// update metadata
+	mr.metadata.Store(clusmeta)
+
+	// update allowlist
+	mr.allowlist.Renew(clusmeta)
+
+	// update replicasRetriever
+	mr.replicasRetriever.Renew(clusmeta)
 }
 
 func (mr *metadataRefresher) getAppliedIndex() uint64 {
@@ -0,0 +1,155 @@
+package varlog
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/unit"
+	"go.uber.org/multierr"
+
+	"github.com/kakao/varlog/pkg/types"
+)
+
+// instrumentationName is the name of the meter and the tracer of the client.
+const instrumentationName = "varlog.client"
+
+// clientMetrics has instruments measuring the client. Metrics are recorded with
+// the attributes "tpid" and "lsid" if applicable. All methods are safe to call
+// with a nil receiver; thus, components created without the client, for
+// instance, in tests, need not care about them.
+type clientMetrics struct {
+	appendDuration          metric.Float64Histogram
+	appendRetries           metric.Int64Counter
+	denies                  metric.Int64Counter
+	bytesOut                metric.Int64Counter
+	bytesIn                 metric.Int64Counter
+	subscribeReorderDelay   metric.Float64Histogram
+	metadataRefreshDuration metric.Float64Histogram
+}
+
+func newClientMetrics(mp metric.MeterProvider) (*clientMetrics, error) {
+	mt := mp.Meter(instrumentationName)
+	m := &clientMetrics{}
+	var err, e error
+
+	m.appendDuration, e = mt.NewFloat64Histogram(
+		"varlog.client.append.duration",
+		metric.WithDescription("time spent by a request to append a batch to a log stream, including the network round trip"),
+		metric.WithUnit(unit.Milliseconds),
+	)
+	err = multierr.Append(err, e)
+
+	m.appendRetries, e = mt.NewInt64Counter(
+		"varlog.client.append.retries",
+		metric.WithDescription("number of retries of appends after failures"),
+		metric.WithUnit(unit.Dimensionless),
+	)
+	err = multierr.Append(err, e)
+
+	m.denies, e = mt.NewInt64Counter(
+		"varlog.client.allowlist.denies",
+		metric.WithDescription("number of log streams put into the denylist"),
+		metric.WithUnit(unit.Dimensionless),
+	)
+	err = multierr.Append(err, e)
+
+	m.bytesOut, e = mt.NewInt64Counter(
+		"varlog.client.bytes.out",
+		metric.WithDescription("bytes of data of log entries sent to storage nodes"),
+		metric.WithUnit(unit.Bytes),
+	)
+	err = multierr.Append(err, e)
+
+	m.bytesIn, e = mt.NewInt64Counter(
+		"varlog.client.bytes.in",
+		metric.WithDescription("bytes of data of log entries received from storage nodes"),
+		metric.WithUnit(unit.Bytes),
+	)
+	err = multierr.Append(err, e)
+
+	m.subscribeReorderDelay, e = mt.NewFloat64Histogram(
+		"varlog.client.subscribe.reorder_delay",
+		metric.WithDescription("time a log entry received from a storage node waits in the subscriber to be delivered in the order of GLSN"),
+		metric.WithUnit(unit.Milliseconds),
+	)
+	err = multierr.Append(err, e)
+
+	m.metadataRefreshDuration, e = mt.NewFloat64Histogram(
+		"varlog.client.metadata.refresh.duration",
+		metric.WithDescription("time spent fetching metadata from the metadata repository"),
+		metric.WithUnit(unit.Milliseconds),
+	)
+	err = multierr.Append(err, e)
+
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func logStreamAttributes(tpid types.TopicID, lsid types.LogStreamID) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.Int("tpid", int(tpid)),
+		attribute.Int("lsid", int(lsid)),
+	}
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func (m *clientMetrics) recordAppend(tpid types.TopicID, lsid types.LogStreamID, latency time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	attrs := append(logStreamAttributes(tpid, lsid), attribute.Bool("error", err != nil))
+	m.appendDuration.Record(context.Background(), milliseconds(latency), attrs...)
+}
+
+func (m *clientMetrics) addAppendRetry(tpid types.TopicID) {
+	if m == nil {
+		return
+	}
+	m.appendRetries.Add(context.Background(), 1, attribute.Int("tpid", int(tpid)))
+}
+
+func (m *clientMetrics) addDeny(tpid types.TopicID, lsid types.LogStreamID) {
+	if m == nil {
+		return
+	}
+	m.denies.Add(context.Background(), 1, logStreamAttributes(tpid, lsid)...)
+}
+
+func (m *clientMetrics) addBytesOut(tpid types.TopicID, lsid types.LogStreamID, data [][]byte) {
+	if m == nil {
+		return
+	}
+	var n int64
+	for i := range data {
+		n += int64(len(data[i]))
+	}
+	m.bytesOut.Add(context.Background(), n, logStreamAttributes(tpid, lsid)...)
+}
+
+func (m *clientMetrics) addBytesIn(tpid types.TopicID, lsid types.LogStreamID, data []byte) {
+	if m == nil {
+		return
+	}
+	m.bytesIn.Add(context.Background(), int64(len(data)), logStreamAttributes(tpid, lsid)...)
+}
+
+func (m *clientMetrics) recordSubscribeReorderDelay(tpid types.TopicID, lsid types.LogStreamID, delay time.Duration) {
+	if m == nil {
+		return
+	}
+	m.subscribeReorderDelay.Record(context.Background(), milliseconds(delay), logStreamAttributes(tpid, lsid)...)
+}
+
+func (m *clientMetrics) recordMetadataRefresh(latency time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	m.metadataRefreshDuration.Record(context.Background(), milliseconds(latency), attribute.Bool("error", err != nil))
+}
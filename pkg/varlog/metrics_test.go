@@ -0,0 +1,90 @@
+package varlog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+)
+
+func TestClientMetrics(t *testing.T) {
+	// Methods are no-op with a nil receiver.
+	var nilMetrics *clientMetrics
+	require.NotPanics(t, func() {
+		nilMetrics.recordAppend(1, 1, time.Millisecond, nil)
+		nilMetrics.addAppendRetry(1)
+		nilMetrics.addDeny(1, 1)
+		nilMetrics.addBytesOut(1, 1, [][]byte{[]byte("foo")})
+		nilMetrics.addBytesIn(1, 1, []byte("foo"))
+		nilMetrics.recordSubscribeReorderDelay(1, 1, time.Millisecond)
+		nilMetrics.recordMetadataRefresh(time.Millisecond, nil)
+	})
+
+	ctrl := controller.New(
+		processor.NewFactory(
+			simple.NewWithHistogramDistribution(),
+			aggregation.CumulativeTemporalitySelector(),
+		),
+		controller.WithCollectPeriod(0),
+	)
+	m, err := newClientMetrics(ctrl)
+	require.NoError(t, err)
+
+	m.recordAppend(1, 2, 3*time.Millisecond, nil)
+	m.recordAppend(1, 2, 5*time.Millisecond, errors.New("append failed"))
+	m.addAppendRetry(1)
+	m.addDeny(1, 2)
+	m.addBytesOut(1, 2, [][]byte{[]byte("foo"), []byte("bar")})
+	m.addBytesIn(1, 2, []byte("foo"))
+	m.addBytesIn(1, 3, []byte("bar"))
+	m.recordSubscribeReorderDelay(1, 2, time.Millisecond)
+	m.recordMetadataRefresh(time.Millisecond, nil)
+
+	require.NoError(t, ctrl.Collect(context.Background()))
+	counts := make(map[string]uint64)
+	sums := make(map[string]int64)
+	err = ctrl.ForEach(func(_ instrumentation.Library, reader metric.Reader) error {
+		return reader.ForEach(aggregation.CumulativeTemporalitySelector(), func(rec metric.Record) error {
+			name := rec.Descriptor().Name()
+			tpid, ok := rec.Labels().Value(attribute.Key("tpid"))
+			require.True(t, ok || name == "varlog.client.metadata.refresh.duration", name)
+			if ok {
+				require.EqualValues(t, 1, tpid.AsInt64(), name)
+			}
+
+			agg := rec.Aggregation()
+			if h, ok := agg.(aggregation.Histogram); ok {
+				count, err := h.Count()
+				require.NoError(t, err)
+				counts[name] += count
+				return nil
+			}
+			sum, err := agg.(aggregation.Sum).Sum()
+			require.NoError(t, err)
+			sums[name] += sum.AsInt64()
+			return nil
+		})
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]uint64{
+		"varlog.client.append.duration":           2,
+		"varlog.client.subscribe.reorder_delay":   1,
+		"varlog.client.metadata.refresh.duration": 1,
+	}, counts)
+	require.Equal(t, map[string]int64{
+		"varlog.client.append.retries":   1,
+		"varlog.client.allowlist.denies": 1,
+		"varlog.client.bytes.out":        6,
+		"varlog.client.bytes.in":         6,
+	}, sums)
+}
@@ -2,11 +2,16 @@ package varlog
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"strings"
 	"sync"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 
 	"github.com/kakao/varlog/pkg/types"
@@ -18,10 +23,50 @@ import (
 // TODO: use ops-accumulator?
 func (v *logImpl) append(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data [][]byte, opts ...AppendOption) (result AppendResult) {
 	appendOpts := defaultAppendOptions()
+	appendOpts.compression = v.opts.compression
 	for _, opt := range opts {
 		opt.apply(&appendOpts)
 	}
 
+	ctx, span := v.tracer.Start(ctx, "varlog.Append", trace.WithAttributes(
+		attribute.Int("tpid", int(tpid)),
+		attribute.Int("batch", len(data)),
+	))
+	defer func() {
+		endSpan(span, result.Err)
+	}()
+
+	var headers []varlogpb.LogEntryHeaders
+	if len(appendOpts.headers) > 0 {
+		if len(appendOpts.headers) != len(data) {
+			result.Err = fmt.Errorf("append: the number of headers does not match that of data: %w", verrors.ErrInvalid)
+			return result
+		}
+		headers = make([]varlogpb.LogEntryHeaders, len(appendOpts.headers))
+		for i := range appendOpts.headers {
+			headers[i].Values = appendOpts.headers[i]
+		}
+	}
+
+	// Data are compressed and encrypted once for all retries.
+	data, headers, err := encodeBatch(ctx, appendOpts.compression, v.opts.keyProvider, data, headers)
+	if err != nil {
+		result.Err = fmt.Errorf("append: %w", err)
+		return result
+	}
+
+	if len(appendOpts.partitionKey) > 0 && appendOpts.selectLogStream {
+		var ok bool
+		lsid, ok = v.selectLogStreamByKey(tpid, appendOpts.partitionKey, appendOpts.allowedLogStreams)
+		if !ok {
+			result.Err = fmt.Errorf("append: no log stream in topic %d", tpid)
+			return result
+		}
+		// Retries must go to the same log stream to keep the order of
+		// log entries with the key.
+		appendOpts.selectLogStream = false
+	}
+
 	lsidx := 0
 	var lsids []types.LogStreamID
 
@@ -65,9 +110,24 @@ RETRY:
 			}
 		}
 
-		res, err := v.appendTo(ctx, tpid, lsid, data)
+		if len(appendOpts.producerID) > 0 {
+			// Sequence numbers of the producer are tracked by the log
+			// stream; thus, retries must go to the same log stream.
+			appendOpts.selectLogStream = false
+		}
+
+		if i > 0 {
+			v.metrics.addAppendRetry(tpid)
+		}
+		start := time.Now()
+		res, err := v.appendTo(ctx, tpid, lsid, data, headers, appendOpts.producerID, appendOpts.sequence)
+		latency := time.Since(start)
 		if err != nil {
+			v.observeAppend(tpid, lsid, latency, err)
 			result.Err = err
+			if errors.Is(err, verrors.ErrDuplicateSequence) || errors.Is(err, verrors.ErrTxnAborted) {
+				break
+			}
 			continue
 		}
 		result.Err = nil
@@ -82,13 +142,42 @@ RETRY:
 				break
 			}
 			result.Metadata = append(result.Metadata, res[idx].Meta)
+			v.owners.add(tpid, lsid, res[idx].Meta.GLSN)
 		}
+		v.observeAppend(tpid, lsid, latency, result.Err)
 		break
 	}
 	return result
 }
 
-func (v *logImpl) appendTo(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data [][]byte) ([]snpb.AppendResult, error) {
+// selectLogStreamByKey selects the log stream for the partition key among all
+// log streams in the topic regardless of whether they are denied, so that the
+// key does not move to another log stream due to transient failures.
+func (v *logImpl) selectLogStreamByKey(tpid types.TopicID, key []byte, allowedLogStreams map[types.LogStreamID]struct{}) (types.LogStreamID, bool) {
+	all := v.replicasRetriever.All(tpid)
+	lsids := make([]types.LogStreamID, 0, len(all))
+	for lsid := range all {
+		if allowedLogStreams != nil {
+			if _, ok := allowedLogStreams[lsid]; !ok {
+				continue
+			}
+		}
+		lsids = append(lsids, lsid)
+	}
+	return selectLogStreamByKey(key, lsids)
+}
+
+func (v *logImpl) appendTo(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data [][]byte, headers []varlogpb.LogEntryHeaders, producerID string, sequence uint64) (_ []snpb.AppendResult, err error) {
+	// Each attempt of an append has its own span, whose trace context is
+	// propagated to the storage node.
+	ctx, span := v.tracer.Start(ctx, "snpb.LogIO/Append",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(logStreamAttributes(tpid, lsid)...),
+	)
+	defer func() {
+		endSpan(span, err)
+	}()
+
 	replicas, ok := v.replicasRetriever.Retrieve(tpid, lsid)
 	if !ok {
 		return nil, fmt.Errorf("append: log stream %d of topic %d does not exist", lsid, tpid)
@@ -108,7 +197,13 @@ func (v *logImpl) appendTo(ctx context.Context, tpid types.TopicID, lsid types.L
 		backup[i].Address = replicas[i+1].Address
 	}
 
-	res, err := cl.Append(ctx, tpid, lsid, data, backup...)
+	var res []snpb.AppendResult
+	if len(producerID) > 0 {
+		res, err = cl.AppendWithProducer(ctx, tpid, lsid, producerID, sequence, data, headers, backup...)
+	} else {
+		res, err = cl.Append(ctx, tpid, lsid, data, headers, backup...)
+	}
+	v.metrics.addBytesOut(tpid, lsid, data)
 	if err != nil {
 		if strings.Contains(err.Error(), "sealed") {
 			err = fmt.Errorf("append: %s: %w", err.Error(), verrors.ErrSealed)
@@ -117,6 +212,12 @@ func (v *logImpl) appendTo(ctx context.Context, tpid types.TopicID, lsid types.L
 		// FIXME: Do not close clients. Let gRPC manages the connection.
 		// _ = cl.Close()
 
+		// The log stream is healthy, but the producer sent a stale
+		// sequence, or the transaction was aborted.
+		if errors.Is(err, verrors.ErrDuplicateSequence) || errors.Is(err, verrors.ErrTxnAborted) {
+			return nil, err
+		}
+
 		// add deny list
 		v.allowlist.Deny(tpid, lsid)
 
@@ -126,6 +227,15 @@ func (v *logImpl) appendTo(ctx context.Context, tpid types.TopicID, lsid types.L
 	return res, nil
 }
 
+// endSpan ends the span after recording the error if any.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
 func (v *logImpl) peekLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID) (first varlogpb.LogSequenceNumber, last varlogpb.LogSequenceNumber, err error) {
 	replicas, ok := v.replicasRetriever.Retrieve(tpid, lsid)
 	if !ok {
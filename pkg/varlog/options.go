@@ -3,11 +3,16 @@ package varlog
 import (
 	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/snpb"
 )
 
 const (
@@ -19,10 +24,22 @@ const (
 	defaultMetadataRefreshInterval = 1 * time.Minute
 	defaultMetadataRefreshTimeout  = 1 * time.Second
 
-	defaultSubscribeTimeout = 10 * time.Millisecond
+	defaultSubscribeTimeout        = 10 * time.Millisecond
+	defaultSubscribeIterBufferSize = 1024
 
 	defaultDenyTTL            = 10 * time.Minute
 	defaultExpireDenyInterval = 1 * time.Second
+
+	defaultBatcherMaxRecords   = 128
+	defaultBatcherMaxBytes     = 1 << 20
+	defaultBatcherLinger       = 5 * time.Millisecond
+	defaultBatcherPipelineSize = 4
+
+	defaultGroupHeartbeatInterval = 1 * time.Second
+	defaultGroupCommitInterval    = 1 * time.Second
+	defaultGroupSessionTimeout    = 10 * time.Second
+
+	defaultTxnTimeout = 10 * time.Second
 )
 
 func defaultOptions() options {
@@ -37,6 +54,10 @@ func defaultOptions() options {
 
 		denyTTL:            defaultDenyTTL,
 		expireDenyInterval: defaultExpireDenyInterval,
+		lsSelectorFactory:  RandomLogStreamSelector(),
+		meterProvider:      global.GetMeterProvider(),
+		tracerProvider:     otel.GetTracerProvider(),
+		txnTimeout:         defaultTxnTimeout,
 		logger:             zap.NewNop(),
 		grpcDialOptions: []grpc.DialOption{
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -58,15 +79,37 @@ type options struct {
 	// metadataRefreshInterval is the period to refresh metadata.
 	metadataRefreshInterval time.Duration
 	metadataRefreshTimeout  time.Duration
+	// disableMetadataWatch makes the client poll metadata only.
+	disableMetadataWatch bool
 
 	// denyTTL is duration until the log stream in denylist is expired and goes back to
 	// allowlist.
 	denyTTL            time.Duration
 	expireDenyInterval time.Duration
 
+	// lsSelectorFactory creates the policy to select log streams to append.
+	lsSelectorFactory LogStreamSelectorFactory
+
 	// grpcOptions
 	grpcDialOptions []grpc.DialOption
 
+	// compression is the default codec to compress data of log entries.
+	compression Compression
+	// keyProvider provides keys to encrypt and decrypt data of log
+	// entries. It is nil if the encryption is disabled.
+	keyProvider KeyProvider
+	// requireEncryption makes the client reject log entries that are not
+	// encrypted when it reads them.
+	requireEncryption bool
+
+	// meterProvider provides the meter to record metrics of the client.
+	meterProvider metric.MeterProvider
+	// tracerProvider provides the tracer to trace appends.
+	tracerProvider trace.TracerProvider
+
+	// txnTimeout is the time limit of transactions appended by AppendTxn.
+	txnTimeout time.Duration
+
 	logger *zap.Logger
 }
 
@@ -86,6 +129,44 @@ func (opt *option) apply(opts *options) {
 	opt.f(opts)
 }
 
+// WithDefaultCompression sets the codec to compress data of log entries
+// appended by the client unless WithCompression overrides it. Log entries are
+// not compressed by default.
+func WithDefaultCompression(codec Compression) Option {
+	return newOption(func(opts *options) {
+		opts.compression = codec
+	})
+}
+
+// WithEncryption encrypts data of log entries appended by the client with
+// AES-GCM using the current key of the argument kp, and decrypts data of log
+// entries read or subscribed by the client using the key recorded in each of
+// them. Data is compressed before it is encrypted. Note that filters of
+// subscriptions see encrypted data, and headers are not encrypted.
+//
+// Since each log entry is encrypted with a random nonce, the current key of
+// the KeyProvider should be rotated before it encrypts 2^32 log entries.
+//
+// Log entries without the EncryptionKeyHeader are read as they are; use
+// WithRequireEncryption to reject them.
+func WithEncryption(kp KeyProvider) Option {
+	return newOption(func(opts *options) {
+		opts.keyProvider = kp
+	})
+}
+
+// WithRequireEncryption makes the client reject log entries that are not
+// encrypted when it reads or subscribes to them; it returns an error wrapping
+// verrors.ErrInvalid for such log entries. Without it, a log entry whose
+// EncryptionKeyHeader is removed, for instance, by someone who can modify
+// storage nodes, is returned as it is, that is, the ciphertext is returned as
+// the data. It requires WithEncryption.
+func WithRequireEncryption() Option {
+	return newOption(func(opts *options) {
+		opts.requireEncryption = true
+	})
+}
+
 func WithOpenTimeout(timeout time.Duration) Option {
 	return newOption(func(opts *options) {
 		opts.openTimeout = timeout
@@ -116,6 +197,17 @@ func WithMetadataRefreshTimeout(timeout time.Duration) Option {
 	})
 }
 
+// WithoutMetadataWatch disables watching metadata changes, so the client
+// fetches metadata only periodically by the interval set by
+// WithMetadataRefreshInterval, or when operations fail.
+// By default, the client watches the metadata repository to learn about new
+// or sealed log streams as soon as they change.
+func WithoutMetadataWatch() Option {
+	return newOption(func(opts *options) {
+		opts.disableMetadataWatch = true
+	})
+}
+
 func WithDenyTTL(denyTTL time.Duration) Option {
 	return newOption(func(opts *options) {
 		opts.denyTTL = denyTTL
@@ -128,6 +220,53 @@ func WithExpireDenyInterval(interval time.Duration) Option {
 	})
 }
 
+// WithLogStreamSelector sets the policy to select log streams to which Append
+// and Batcher send log entries. Built-in policies are RandomLogStreamSelector,
+// RoundRobinLogStreamSelector, LeastRecentlyFailedLogStreamSelector,
+// LatencyWeightedLogStreamSelector, and LocalityLogStreamSelector. The default
+// is RandomLogStreamSelector.
+func WithLogStreamSelector(factory LogStreamSelectorFactory) Option {
+	return newOption(func(opts *options) {
+		opts.lsSelectorFactory = factory
+	})
+}
+
+// WithMeterProvider sets the OpenTelemetry meter provider to which the client
+// records metrics, for instance, latencies of appends per log stream, retries,
+// denylist events, reorder delays of subscriptions, bytes sent and received,
+// and latencies of metadata refreshes. By default, the client uses the global
+// meter provider, which is a no-op unless the application sets it, for
+// instance, by telemetry.SetGlobalMeterProvider in the package
+// pkg/util/telemetry.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return newOption(func(opts *options) {
+		opts.meterProvider = mp
+	})
+}
+
+// WithTracerProvider sets the OpenTelemetry tracer provider with which the
+// client traces appends. The client propagates the trace context to storage
+// nodes, which emit spans of sequencing, writing, replication, and waiting for
+// the commit of the appended log entries under the span of the append. By
+// default, the client uses the global tracer provider.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return newOption(func(opts *options) {
+		opts.tracerProvider = tp
+	})
+}
+
+// WithTxnTimeout sets the time limit of transactions appended by AppendTxn.
+// Log streams abort a transaction if not all of its log entries are written
+// within the time limit; hence, the time limit should be long enough to append
+// all batches of a transaction. Since log entries of a transaction hold back
+// the following log entries in the same log streams until the transaction is
+// committed or aborted, it should not be too long. The default is 10 seconds.
+func WithTxnTimeout(timeout time.Duration) Option {
+	return newOption(func(opts *options) {
+		opts.txnTimeout = timeout
+	})
+}
+
 func WithLogger(logger *zap.Logger) Option {
 	return newOption(func(opts *options) {
 		opts.logger = logger
@@ -155,6 +294,11 @@ type appendOptions struct {
 	retryCount        int
 	selectLogStream   bool
 	allowedLogStreams map[types.LogStreamID]struct{}
+	headers           []map[string][]byte
+	producerID        string
+	sequence          uint64
+	partitionKey      []byte
+	compression       Compression
 }
 
 type AppendOption interface {
@@ -191,6 +335,227 @@ func WithAllowedLogStreams(logStreams map[types.LogStreamID]struct{}) AppendOpti
 	})
 }
 
+// WithHeaders sets headers of log entries to be appended. The i-th headers
+// belong to the i-th log entry in the batch; thus, the number of headers
+// should be the same as that of the log entries. A nil map means that the log
+// entry has no headers.
+func WithHeaders(headers ...map[string][]byte) AppendOption {
+	return newAppendOption(func(opts *appendOptions) {
+		opts.headers = headers
+	})
+}
+
+// AppendOptionHeaders returns the headers set by WithHeaders in the argument
+// opts. It is for implementations of Log other than the client, for instance,
+// varlogtest.
+func AppendOptionHeaders(opts ...AppendOption) []map[string][]byte {
+	appendOpts := defaultAppendOptions()
+	for _, opt := range opts {
+		opt.apply(&appendOpts)
+	}
+	return appendOpts.headers
+}
+
+// WithCompression compresses data of each log entry in the batch by the
+// codec. The codec is recorded in the CompressionHeader of the log entries,
+// thus, clients decompress them transparently when they read or subscribe to
+// them. Note that filters of subscriptions see compressed data.
+func WithCompression(codec Compression) AppendOption {
+	return newAppendOption(func(opts *appendOptions) {
+		opts.compression = codec
+	})
+}
+
+// WithProducer makes the append idempotent. The producerID identifies the
+// producer, and the sequence is the sequence number of the first log entry in
+// the batch; the following log entries have consecutive sequence numbers. The
+// producer should increase sequence numbers monotonically, though they need
+// not be contiguous across batches.
+//
+// The log stream appends log entries of the producer only once for each
+// sequence number. If it has already appended them, for instance, when the
+// client retries the append after losing the response, it returns their
+// original metadata. Since each log stream keeps track of sequence numbers of
+// producers separately, retries within an append are sent to the log stream
+// selected first. Producers that retry on their own should use AppendTo to
+// send the batch to the same log stream.
+func WithProducer(producerID string, sequence uint64) AppendOption {
+	return newAppendOption(func(opts *appendOptions) {
+		opts.producerID = producerID
+		opts.sequence = sequence
+	})
+}
+
+// WithPartitionKey makes Append send log entries to the log stream that the
+// key maps to. Log entries with the same key go to the same log stream; hence,
+// they keep their order. The mapping considers all log streams in the topic,
+// or those allowed by WithAllowedLogStreams, and it changes only for some keys
+// when log streams are added to the topic.
+//
+// If the log stream for the key is unavailable, for instance, sealed, Append
+// fails rather than sending the log entries to another log stream, which
+// could break their order.
+//
+// It has no effect on AppendTo.
+func WithPartitionKey(key []byte) AppendOption {
+	return newAppendOption(func(opts *appendOptions) {
+		opts.partitionKey = key
+	})
+}
+
+func defaultBatcherOptions() batcherOptions {
+	return batcherOptions{
+		maxRecords:   defaultBatcherMaxRecords,
+		maxBytes:     defaultBatcherMaxBytes,
+		linger:       defaultBatcherLinger,
+		pipelineSize: defaultBatcherPipelineSize,
+	}
+}
+
+type batcherOptions struct {
+	maxRecords   int
+	maxBytes     int
+	linger       time.Duration
+	pipelineSize int
+	logStreamID  types.LogStreamID
+}
+
+type BatcherOption interface {
+	apply(*batcherOptions)
+}
+
+type batcherOption struct {
+	f func(*batcherOptions)
+}
+
+func (opt *batcherOption) apply(opts *batcherOptions) {
+	opt.f(opts)
+}
+
+func newBatcherOption(f func(*batcherOptions)) *batcherOption {
+	return &batcherOption{f: f}
+}
+
+// WithBatcherMaxRecords sets the maximum number of log entries in a batch. The
+// Batcher sends the batch as soon as it has that many log entries.
+func WithBatcherMaxRecords(maxRecords int) BatcherOption {
+	return newBatcherOption(func(opts *batcherOptions) {
+		opts.maxRecords = maxRecords
+	})
+}
+
+// WithBatcherMaxBytes sets the size of a batch in bytes. The Batcher sends the
+// batch as soon as the total size of data in the batch reaches it.
+func WithBatcherMaxBytes(maxBytes int) BatcherOption {
+	return newBatcherOption(func(opts *batcherOptions) {
+		opts.maxBytes = maxBytes
+	})
+}
+
+// WithBatcherLinger sets how long the Batcher waits for more log entries before
+// sending a batch that is not full. If it is zero, the Batcher sends each log
+// entry without waiting.
+func WithBatcherLinger(linger time.Duration) BatcherOption {
+	return newBatcherOption(func(opts *batcherOptions) {
+		opts.linger = linger
+	})
+}
+
+// WithBatcherPipelineSize sets the maximum number of batches that are sent but
+// whose results have not arrived yet. AppendAsync blocks if the pipeline is
+// full.
+func WithBatcherPipelineSize(pipelineSize int) BatcherOption {
+	return newBatcherOption(func(opts *batcherOptions) {
+		opts.pipelineSize = pipelineSize
+	})
+}
+
+// WithBatcherLogStream makes the Batcher send batches only to the log stream
+// rather than selecting one in the topic.
+func WithBatcherLogStream(logStreamID types.LogStreamID) BatcherOption {
+	return newBatcherOption(func(opts *batcherOptions) {
+		opts.logStreamID = logStreamID
+	})
+}
+
+func defaultGroupConsumerOptions() groupConsumerOptions {
+	return groupConsumerOptions{
+		heartbeatInterval: defaultGroupHeartbeatInterval,
+		commitInterval:    defaultGroupCommitInterval,
+		sessionTimeout:    defaultGroupSessionTimeout,
+		logger:            zap.NewNop(),
+	}
+}
+
+type groupConsumerOptions struct {
+	memberID          string
+	heartbeatInterval time.Duration
+	commitInterval    time.Duration
+	sessionTimeout    time.Duration
+	logger            *zap.Logger
+}
+
+type GroupConsumerOption interface {
+	apply(*groupConsumerOptions)
+}
+
+type groupConsumerOption struct {
+	f func(*groupConsumerOptions)
+}
+
+func (opt *groupConsumerOption) apply(opts *groupConsumerOptions) {
+	opt.f(opts)
+}
+
+func newGroupConsumerOption(f func(*groupConsumerOptions)) *groupConsumerOption {
+	return &groupConsumerOption{f: f}
+}
+
+// WithGroupMemberID sets the ID of the member in the consumer group. Members
+// in the same group should have different IDs. If it is not set, a random ID
+// is used.
+func WithGroupMemberID(memberID string) GroupConsumerOption {
+	return newGroupConsumerOption(func(opts *groupConsumerOptions) {
+		opts.memberID = memberID
+	})
+}
+
+// WithGroupHeartbeatInterval sets how often the GroupConsumer sends heartbeats
+// to the admin server. Since the assignment changes only by heartbeats, it
+// also bounds how fast the group rebalances.
+func WithGroupHeartbeatInterval(interval time.Duration) GroupConsumerOption {
+	return newGroupConsumerOption(func(opts *groupConsumerOptions) {
+		opts.heartbeatInterval = interval
+	})
+}
+
+// WithGroupCommitInterval sets how often the GroupConsumer commits offsets of
+// log streams it consumes. Offsets are also committed when log streams are
+// revoked or the GroupConsumer is closed.
+func WithGroupCommitInterval(interval time.Duration) GroupConsumerOption {
+	return newGroupConsumerOption(func(opts *groupConsumerOptions) {
+		opts.commitInterval = interval
+	})
+}
+
+// WithGroupSessionTimeout sets how long the GroupConsumer keeps consuming
+// log streams after sending a heartbeat while its heartbeats fail. The
+// GroupConsumer uses the smaller of it and the session timeout returned by
+// the admin server so that it stops consuming the log streams before the
+// admin server assigns them to other members.
+func WithGroupSessionTimeout(sessionTimeout time.Duration) GroupConsumerOption {
+	return newGroupConsumerOption(func(opts *groupConsumerOptions) {
+		opts.sessionTimeout = sessionTimeout
+	})
+}
+
+// WithGroupConsumerLogger sets the logger of the GroupConsumer.
+func WithGroupConsumerLogger(logger *zap.Logger) GroupConsumerOption {
+	return newGroupConsumerOption(func(opts *groupConsumerOptions) {
+		opts.logger = logger
+	})
+}
+
 func defaultSubscribeOptions() subscribeOptions {
 	return subscribeOptions{
 		timeout: defaultSubscribeTimeout,
@@ -198,7 +563,9 @@ func defaultSubscribeOptions() subscribeOptions {
 }
 
 type subscribeOptions struct {
-	timeout time.Duration
+	timeout    time.Duration
+	bufferSize int
+	filter     *snpb.SubscribeFilter
 }
 
 type SubscribeOption interface {
@@ -222,3 +589,30 @@ func WithSubscribeTimeout(timeout time.Duration) SubscribeOption {
 		opts.timeout = timeout
 	})
 }
+
+// WithSubscribeFilter makes storage nodes send only log entries matched by the
+// argument filter, and only fields of them not excluded by the filter. Log
+// entries not matched are skipped before crossing the network, thus, a
+// consumer interested in a few log entries of a topic saves the network
+// bandwidth.
+// Since storage nodes evaluate the filter on the stored data, subscriptions
+// fail with verrors.ErrInvalid if the filter has conditions on the data while
+// the client compresses or encrypts it. Reserved headers, such as
+// CompressionHeader, are sent even if the filter excludes headers.
+func WithSubscribeFilter(filter *snpb.SubscribeFilter) SubscribeOption {
+	return newSubscribeOption(func(opts *subscribeOptions) {
+		opts.filter = filter
+	})
+}
+
+// WithSubscribeBufferSize bounds the number of log entries buffered by a
+// subscription of a topic. The subscription stops receiving log entries from
+// storage nodes while its buffer is full, thus, a slow consumer does not make
+// the buffer grow. Subscribe buffers all log entries in the range unless this
+// option is set, and SubscribeIter and Follow buffer 1024 log entries by
+// default.
+func WithSubscribeBufferSize(size int) SubscribeOption {
+	return newSubscribeOption(func(opts *subscribeOptions) {
+		opts.bufferSize = size
+	})
+}
@@ -0,0 +1,315 @@
+package varlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/multierr"
+
+	"github.com/kakao/varlog/internal/storagenode/client"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+type readResult struct {
+	logEntry varlogpb.LogEntry
+	err      error
+}
+
+func (v *logImpl) read(ctx context.Context, tpid types.TopicID, glsn types.GLSN) (varlogpb.LogEntry, error) {
+	if glsn.Invalid() {
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("read: invalid glsn: %w", verrors.ErrInvalid)
+	}
+
+	replicasMap := v.replicasRetriever.All(tpid)
+	if len(replicasMap) == 0 {
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("read: no log stream in topic %d", tpid)
+	}
+
+	// If the owner of the GLSN is known, only its replicas are asked. It
+	// falls back to asking every log stream if the owner does not have the
+	// log entry, which should not happen unless the log stream has gone.
+	if lsid, ok := v.owners.lookup(tpid, glsn); ok {
+		if replicas, ok := replicasMap[lsid]; ok {
+			le, err := v.readGLSNFromLogStream(ctx, tpid, lsid, replicas, glsn)
+			if err == nil {
+				return le, nil
+			}
+			if !errors.Is(err, verrors.ErrNoEntry) {
+				return varlogpb.InvalidLogEntry(), fmt.Errorf("read: glsn %d: %w", glsn, err)
+			}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Only one log stream in the topic has the log entry at the GLSN. Since
+	// the owner is unknown, it asks all of them at once and takes the first
+	// successful result.
+	resultC := make(chan readResult, len(replicasMap))
+	for lsid, replicas := range replicasMap {
+		go func(lsid types.LogStreamID, replicas []varlogpb.LogStreamReplica) {
+			le, err := v.readGLSNFromLogStream(ctx, tpid, lsid, replicas, glsn)
+			resultC <- readResult{logEntry: le, err: err}
+		}(lsid, replicas)
+	}
+
+	var err error
+	for i := 0; i < len(replicasMap); i++ {
+		res := <-resultC
+		switch {
+		case res.err == nil:
+			return res.logEntry, nil
+		case errors.Is(res.err, verrors.ErrNoEntry):
+		case errors.Is(res.err, verrors.ErrTrimmed):
+			return varlogpb.InvalidLogEntry(), fmt.Errorf("read: glsn %d: %w", glsn, res.err)
+		default:
+			err = multierr.Append(err, res.err)
+		}
+	}
+	if err == nil {
+		err = verrors.ErrNoEntry
+	}
+	return varlogpb.InvalidLogEntry(), fmt.Errorf("read: glsn %d: %w", glsn, err)
+}
+
+// readGLSNFromLogStream reads the log entry at the glsn from the replicas of
+// the log stream lsid. It remembers the log stream as the owner of the glsn
+// if it has the log entry.
+func (v *logImpl) readGLSNFromLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, replicas []varlogpb.LogStreamReplica, glsn types.GLSN) (le varlogpb.LogEntry, err error) {
+	err = v.readFromReplicas(ctx, replicas, func(ctx context.Context, cl *client.LogClient) (err error) {
+		le, err = cl.ReadWithGLSN(ctx, tpid, lsid, glsn)
+		return err
+	})
+	if err != nil {
+		return le, err
+	}
+	v.owners.add(tpid, lsid, glsn)
+	v.metrics.addBytesIn(tpid, lsid, le.Data)
+	err = v.newLogEntryDecoder().decode(ctx, &le)
+	return le, err
+}
+
+func (v *logImpl) readFrom(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, llsn types.LLSN) (varlogpb.LogEntry, error) {
+	if llsn.Invalid() {
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("read: invalid llsn: %w", verrors.ErrInvalid)
+	}
+
+	replicas, ok := v.replicasRetriever.Retrieve(tpid, lsid)
+	if !ok {
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("read: %w", errNoLogStream)
+	}
+
+	var le varlogpb.LogEntry
+	err := v.readFromReplicas(ctx, replicas, func(ctx context.Context, cl *client.LogClient) (err error) {
+		le, err = cl.ReadWithLLSN(ctx, tpid, lsid, llsn)
+		return err
+	})
+	if err == nil {
+		v.owners.add(tpid, lsid, le.GLSN)
+		v.metrics.addBytesIn(tpid, lsid, le.Data)
+		err = v.newLogEntryDecoder().decode(ctx, &le)
+	}
+	if err != nil {
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("read: llsn %d: %w", llsn, err)
+	}
+	return le, nil
+}
+
+func (v *logImpl) readBatch(ctx context.Context, tpid types.TopicID, glsns []types.GLSN) ([]ReadBatchResult, error) {
+	results := make([]ReadBatchResult, len(glsns))
+	if len(glsns) == 0 {
+		return results, nil
+	}
+
+	replicasMap := v.replicasRetriever.All(tpid)
+	if len(replicasMap) == 0 {
+		return nil, fmt.Errorf("read batch: no log stream in topic %d", tpid)
+	}
+
+	// GLSNs whose owners are known are sent only to the owners, and the
+	// others are sent to every log stream in the topic. Each log stream
+	// sends only the log entries it has.
+	requests := make(map[types.LogStreamID][]types.GLSN, len(replicasMap))
+	var unknown []types.GLSN
+	for _, glsn := range glsns {
+		if glsn.Invalid() {
+			continue
+		}
+		if lsid, ok := v.owners.lookup(tpid, glsn); ok {
+			if _, ok := replicasMap[lsid]; ok {
+				requests[lsid] = append(requests[lsid], glsn)
+				continue
+			}
+		}
+		unknown = append(unknown, glsn)
+	}
+	if len(unknown) > 0 {
+		for lsid := range replicasMap {
+			requests[lsid] = append(requests[lsid], unknown...)
+		}
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		found = make(map[types.GLSN]client.ReadBatchResult, len(glsns))
+		lsErr error
+	)
+	for lsid, lsGLSNs := range requests {
+		wg.Add(1)
+		go func(lsid types.LogStreamID, replicas []varlogpb.LogStreamReplica, lsGLSNs []types.GLSN) {
+			defer wg.Done()
+			var rs []client.ReadBatchResult
+			err := v.readFromReplicas(ctx, replicas, func(ctx context.Context, cl *client.LogClient) (err error) {
+				rs, err = cl.ReadBatch(ctx, tpid, lsid, lsGLSNs)
+				return err
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				lsErr = multierr.Append(lsErr, fmt.Errorf("logstream %d: %w", lsid, err))
+				return
+			}
+			for _, r := range rs {
+				if r.Error == nil {
+					v.owners.add(tpid, lsid, r.GLSN)
+					v.metrics.addBytesIn(tpid, lsid, r.LogEntry.Data)
+				}
+				if prev, ok := found[r.GLSN]; ok && prev.Error == nil {
+					continue
+				}
+				found[r.GLSN] = r
+			}
+		}(lsid, replicasMap[lsid], lsGLSNs)
+	}
+	wg.Wait()
+
+	decoder := v.newLogEntryDecoder()
+	for i, glsn := range glsns {
+		results[i].LogEntry = varlogpb.InvalidLogEntry()
+		if glsn.Invalid() {
+			results[i].Err = fmt.Errorf("read batch: invalid glsn: %w", verrors.ErrInvalid)
+			continue
+		}
+		r, ok := found[glsn]
+		switch {
+		case ok && r.Error == nil:
+			le := r.LogEntry
+			if err := decoder.decode(ctx, &le); err != nil {
+				results[i].Err = fmt.Errorf("read batch: %w", err)
+				continue
+			}
+			results[i].LogEntry = le
+		case ok:
+			results[i].Err = fmt.Errorf("read batch: %w", r.Error)
+		case lsErr != nil:
+			// The log entry might be in the log stream that failed.
+			results[i].Err = fmt.Errorf("read batch: glsn %d: %w", glsn, lsErr)
+		default:
+			results[i].Err = fmt.Errorf("read batch: glsn %d: %w", glsn, verrors.ErrNoEntry)
+		}
+	}
+	return results, nil
+}
+
+// readFromReplicas calls the argument readFunc with the replicas in order
+// until one of them answers. The primary replica comes first, so a reader can
+// see log entries that have just been appended. Errors that are decided by
+// the log stream rather than the replica, for instance, verrors.ErrTrimmed and
+// verrors.ErrInvalid, are returned immediately. Since a lagging replica
+// returns verrors.ErrNoEntry for log entries that it has not caught up yet,
+// the other replicas are still asked. It returns verrors.ErrNoEntry only if
+// every replica answers so.
+func (v *logImpl) readFromReplicas(ctx context.Context, replicas []varlogpb.LogStreamReplica, readFunc func(context.Context, *client.LogClient) error) error {
+	var err, noEntryErr error
+	for _, replica := range replicas {
+		cl, cerr := v.logCLManager.GetOrConnect(ctx, replica.StorageNodeID, replica.Address)
+		if cerr != nil {
+			err = multierr.Append(err, cerr)
+			continue
+		}
+
+		rerr := readFunc(ctx, cl)
+		if rerr == nil {
+			return nil
+		}
+		if errors.Is(rerr, verrors.ErrTrimmed) || errors.Is(rerr, verrors.ErrInvalid) {
+			return rerr
+		}
+		if errors.Is(rerr, verrors.ErrNoEntry) {
+			noEntryErr = rerr
+		} else {
+			err = multierr.Append(err, rerr)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	if err == nil {
+		return noEntryErr
+	}
+	return err
+}
+
+type seekTimeResult struct {
+	glsn types.GLSN
+	err  error
+}
+
+func (v *logImpl) seekTime(ctx context.Context, tpid types.TopicID, t time.Time) (types.GLSN, error) {
+	replicasMap := v.replicasRetriever.All(tpid)
+	if len(replicasMap) == 0 {
+		// The topic may be created after the last refresh.
+		v.refresher.Refresh(ctx)
+		replicasMap = v.replicasRetriever.All(tpid)
+	}
+	if len(replicasMap) == 0 {
+		return types.InvalidGLSN, fmt.Errorf("seek time: no log stream in topic %d", tpid)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	resultC := make(chan seekTimeResult, len(replicasMap))
+	for lsid, replicas := range replicasMap {
+		go func(lsid types.LogStreamID, replicas []varlogpb.LogStreamReplica) {
+			var res seekTimeResult
+			res.err = v.readFromReplicas(ctx, replicas, func(ctx context.Context, cl *client.LogClient) (err error) {
+				res.glsn, _, err = cl.SeekTime(ctx, tpid, lsid, t)
+				return err
+			})
+			resultC <- res
+		}(lsid, replicas)
+	}
+
+	// Every log stream has to answer since the first log entry committed at
+	// or after the time can be in any of them. A log stream that has no
+	// such log entry returns the GLSN following the last one it knows, thus,
+	// the smallest GLSN among the results never skips any log entry even
+	// though some replicas lag behind.
+	var (
+		err   error
+		begin = types.MaxGLSN
+	)
+	for i := 0; i < len(replicasMap); i++ {
+		res := <-resultC
+		if res.err != nil {
+			err = multierr.Append(err, res.err)
+			continue
+		}
+		if res.glsn < begin {
+			begin = res.glsn
+		}
+	}
+	if err != nil {
+		return types.InvalidGLSN, fmt.Errorf("seek time: %w", err)
+	}
+	return begin, nil
+}
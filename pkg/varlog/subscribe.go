@@ -4,6 +4,7 @@ import (
 	"container/heap"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"sync"
 	"sync/atomic"
@@ -16,6 +17,7 @@ import (
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/runner"
 	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
 
@@ -31,6 +33,73 @@ func (v *logImpl) subscribe(ctx context.Context, topicID types.TopicID, begin, e
 		opt.apply(&subscribeOpts)
 	}
 
+	sub, err := v.newSubscription(topicID, begin, end, subscribeOpts)
+	if err != nil {
+		return nil, err
+	}
+	closer = sub.close
+
+	dis := &dispatcher{
+		onNextFunc: onNext,
+		sleq:       sub.sleq,
+		logger:     v.logger,
+	}
+	if err = sub.runner.RunC(sub.ctx, dis.dispatch); err != nil {
+		closer()
+		return nil, err
+	}
+
+	return closer, nil
+}
+
+func (v *logImpl) subscribeIter(_ context.Context, topicID types.TopicID, begin, end types.GLSN, opts ...SubscribeOption) Subscriber {
+	if begin >= end {
+		return invalidSubscriber{err: verrors.ErrInvalid}
+	}
+
+	subscribeOpts := defaultSubscribeOptions()
+	subscribeOpts.bufferSize = defaultSubscribeIterBufferSize
+	for _, opt := range opts {
+		opt.apply(&subscribeOpts)
+	}
+	if subscribeOpts.bufferSize < 1 {
+		return invalidSubscriber{err: verrors.ErrInvalid}
+	}
+
+	sub, err := v.newSubscription(topicID, begin, end, subscribeOpts)
+	if err != nil {
+		return invalidSubscriber{err: err}
+	}
+	return &iterSubscriber{sub: sub}
+}
+
+// subscription transmits log entries of a topic to its subscribedLogEntriesQueue
+// in the order of GLSN.
+type subscription struct {
+	sleq   *subscribedLogEntriesQueue
+	runner *runner.Runner
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// checkSubscribeFilter returns an error if the filter has conditions on the
+// data of log entries while the client compresses or encrypts it, since
+// storage nodes evaluate the conditions on the stored data.
+func (v *logImpl) checkSubscribeFilter(filter *snpb.SubscribeFilter) error {
+	if !filter.HasDataPredicates() {
+		return nil
+	}
+	if v.opts.compression != CompressionNone || v.opts.keyProvider != nil {
+		return fmt.Errorf("subscribe: filter on data of compressed or encrypted log entries: %w", verrors.ErrInvalid)
+	}
+	return nil
+}
+
+func (v *logImpl) newSubscription(topicID types.TopicID, begin, end types.GLSN, subscribeOpts subscribeOptions) (*subscription, error) {
+	if err := v.checkSubscribeFilter(subscribeOpts.filter); err != nil {
+		return nil, err
+	}
+
 	subscribeRunner := runner.New("subscribe", v.logger.Named("subscribe").With(
 		zap.Int32("tpid", int32(topicID)),
 		zap.Uint64("begin", uint64(begin)),
@@ -40,12 +109,19 @@ func (v *logImpl) subscribe(ctx context.Context, topicID types.TopicID, begin, e
 	transmitCV := make(chan struct{}, 1)
 
 	mctx, cancel := subscribeRunner.WithManagedCancel(context.Background())
-	closer = func() {
-		cancel()
-		subscribeRunner.Stop()
+	sub := &subscription{
+		runner: subscribeRunner,
+		ctx:    mctx,
+		cancel: cancel,
 	}
 
-	sleq := newSubscribedLogEntiresQueue(begin, end, closer, v.logger)
+	// NOTE: The queue is unbounded unless the buffer size is set, that is,
+	// it can hold all log entries in the range.
+	queueSize := uint64(end - begin)
+	if subscribeOpts.bufferSize > 0 && uint64(subscribeOpts.bufferSize) < queueSize {
+		queueSize = uint64(subscribeOpts.bufferSize)
+	}
+	sub.sleq = newSubscribedLogEntiresQueue(begin, end, int(queueSize), sub.close, v.logger)
 
 	tlogger := v.logger.Named("transmitter")
 	tsm := &transmitter{
@@ -54,33 +130,81 @@ func (v *logImpl) subscribe(ctx context.Context, topicID types.TopicID, begin, e
 		refresher:         v.refresher,
 		replicasRetriever: v.replicasRetriever,
 		logCLManager:      v.logCLManager,
-		sleq:              sleq,
+		sleq:              sub.sleq,
 		wanted:            begin,
 		end:               end,
 		transmitQ:         &transmitQueue{pq: &PriorityQueue{}},
 		transmitCV:        transmitCV,
+		maxPending:        subscribeOpts.bufferSize,
+		filter:            subscribeOpts.filter,
+		decoder:           v.newLogEntryDecoder(),
+		metrics:           v.metrics,
 		timeout:           subscribeOpts.timeout,
 		runner:            runner.New("transmitter", tlogger),
 		logger:            tlogger,
 	}
-
-	dis := &dispatcher{
-		onNextFunc: onNext,
-		sleq:       sleq,
-		logger:     v.logger,
+	if err := subscribeRunner.RunC(mctx, tsm.transmit); err != nil {
+		sub.close()
+		return nil, err
 	}
-	if err = subscribeRunner.RunC(mctx, tsm.transmit); err != nil {
-		goto errOut
+	return sub, nil
+}
+
+func (s *subscription) close() {
+	s.cancel()
+	s.runner.Stop()
+}
+
+// iterSubscriber is a Subscriber that pulls log entries of a topic in the
+// order of GLSN. Since its queue is bounded, the transmitter stops receiving
+// log entries from storage nodes if the caller does not call Next.
+type iterSubscriber struct {
+	sub *subscription
+
+	// mu serializes calls of Next.
+	mu     sync.Mutex
+	err    error
+	closed atomic.Bool
+}
+
+var _ Subscriber = (*iterSubscriber)(nil)
+
+func (s *iterSubscriber) Next() (varlogpb.LogEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return varlogpb.InvalidLogEntry(), s.err
 	}
-	if err = subscribeRunner.RunC(mctx, dis.dispatch); err != nil {
-		goto errOut
+
+	res, ok := <-s.sub.sleq.recvC()
+	switch {
+	case s.closed.Load():
+		s.err = verrors.ErrClosed
+	case !ok:
+		s.err = io.EOF
+	case res.Error != nil:
+		s.err = res.Error
+	default:
+		return res.LogEntry, nil
 	}
+	return varlogpb.InvalidLogEntry(), s.err
+}
 
-	return closer, nil
+func (s *iterSubscriber) Close() error {
+	if !s.closed.CompareAndSwap(false, true) {
+		return nil
+	}
 
-errOut:
-	closer()
-	return nil, err
+	s.sub.cancel()
+	// The transmitter can be blocked by the full queue; hence, it drains the
+	// queue until the transmitter stops.
+	go func() {
+		for range s.sub.sleq.recvC() {
+		}
+	}()
+	s.sub.runner.Stop()
+	return nil
 }
 
 type PriorityQueueItem interface {
@@ -116,6 +240,10 @@ type transmitResult struct {
 	logStreamID   types.LogStreamID
 	storageNodeID types.StorageNodeID
 	result        client.SubscribeResult
+	// sub is the subscriber that pushed the result.
+	sub *subscriber
+	// receivedAt is when the subscriber received the result.
+	receivedAt time.Time
 }
 
 func (t transmitResult) Priority() uint64 {
@@ -144,7 +272,11 @@ func (tq *transmitQueue) Pop() (transmitResult, bool) {
 		}, false
 	}
 
-	return heap.Pop(tq.pq).(transmitResult), true
+	r := heap.Pop(tq.pq).(transmitResult)
+	if r.sub != nil {
+		r.sub.release()
+	}
+	return r, true
 }
 
 func (tq *transmitQueue) Front() (transmitResult, bool) {
@@ -170,6 +302,12 @@ type subscriber struct {
 
 	transmitQ  *transmitQueue
 	transmitCV chan struct{}
+	// pending limits the number of results pushed to the transmitQ but not
+	// popped yet. It is nil if the number is unlimited.
+	pending chan struct{}
+	// decoder decrypts and decompresses data of log entries.
+	decoder *logEntryDecoder
+	metrics *clientMetrics
 
 	done     chan struct{}
 	closed   atomic.Bool
@@ -180,9 +318,9 @@ type subscriber struct {
 	logger *zap.Logger
 }
 
-func newSubscriber(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, storageNodeID types.StorageNodeID, logCL *client.LogClient, begin, end types.GLSN, transmitQ *transmitQueue, transmitCV chan struct{}, logger *zap.Logger) (*subscriber, error) {
+func newSubscriber(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, storageNodeID types.StorageNodeID, logCL *client.LogClient, begin, end types.GLSN, transmitQ *transmitQueue, transmitCV chan struct{}, maxPending int, filter *snpb.SubscribeFilter, decoder *logEntryDecoder, metrics *clientMetrics, logger *zap.Logger) (*subscriber, error) {
 	ctx, cancel := context.WithCancel(ctx)
-	resultC, err := logCL.Subscribe(ctx, topicID, logStreamID, begin, end)
+	resultC, err := logCL.Subscribe(ctx, topicID, logStreamID, begin, end, filter)
 	if err != nil {
 		cancel()
 		return nil, err
@@ -196,9 +334,14 @@ func newSubscriber(ctx context.Context, topicID types.TopicID, logStreamID types
 		cancelSubscribe: cancel,
 		transmitQ:       transmitQ,
 		transmitCV:      transmitCV,
+		decoder:         decoder,
+		metrics:         metrics,
 		done:            make(chan struct{}),
 		logger:          logger.Named("subscriber").With(zap.Int32("lsid", int32(logStreamID))),
 	}
+	if maxPending > 0 {
+		s.pending = make(chan struct{}, maxPending)
+	}
 	s.lastSubscribeAt.Store(time.Now())
 	s.closed.Store(false)
 	s.complete.Store(false)
@@ -211,6 +354,34 @@ func (s *subscriber) stop() {
 	s.closed.Store(true)
 }
 
+// acquire waits until the subscriber can push a result to the transmitQ. Since
+// log entries of a log stream are in the order of GLSN, the log entry that the
+// transmitter wants is always at the head of results pushed by a subscriber;
+// thus, limiting results per subscriber never blocks the transmitter.
+func (s *subscriber) acquire(ctx context.Context) bool {
+	if s.pending == nil {
+		return true
+	}
+	select {
+	case s.pending <- struct{}{}:
+		return true
+	case <-s.done:
+		return false
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (s *subscriber) release() {
+	if s.pending == nil {
+		return
+	}
+	select {
+	case <-s.pending:
+	default:
+	}
+}
+
 func (s *subscriber) subscribe(ctx context.Context) {
 	defer func() {
 		// s.logCL.Close()
@@ -226,16 +397,29 @@ func (s *subscriber) subscribe(ctx context.Context) {
 			r := transmitResult{
 				storageNodeID: s.storageNodeID,
 				logStreamID:   s.logStreamID,
+				sub:           s,
+				receivedAt:    time.Now(),
 			}
 
 			if ok {
 				r.result = res
+				if res.Error == nil && !res.Filtered {
+					s.metrics.addBytesIn(s.topicID, s.logStreamID, res.Data)
+					r.result.Error = s.decoder.decode(ctx, &r.result.LogEntry)
+				}
 			} else {
 				r.result = client.InvalidSubscribeResult
 			}
 
 			needExit := r.result.Error != nil
 
+			// NOTE: Waiting for the transmitter to pop results is not a
+			// delay of the storage node; hence, it updates the
+			// lastSubscribeAt after acquiring.
+			if !s.acquire(ctx) {
+				return
+			}
+
 			if res.GLSN != types.InvalidGLSN {
 				s.lastSubscribeAt.Store(time.Now())
 			} else if res.Error == io.EOF || errors.Is(res.Error, verrors.ErrTrimmed) {
@@ -270,6 +454,12 @@ type transmitter struct {
 
 	transmitQ  *transmitQueue
 	transmitCV chan struct{}
+	// maxPending is the maximum number of results in the transmitQ per
+	// subscriber. Zero means unlimited.
+	maxPending int
+	filter     *snpb.SubscribeFilter
+	decoder    *logEntryDecoder
+	metrics    *clientMetrics
 
 	timeout time.Duration
 	timer   *time.Timer
@@ -339,7 +529,7 @@ func (p *transmitter) refreshSubscriber(ctx context.Context) error {
 				continue CONNECT
 			}
 
-			s, err = newSubscriber(ctx, p.topicID, logStreamID, snid, logCL, p.wanted, p.end, p.transmitQ, p.transmitCV, p.logger)
+			s, err = newSubscriber(ctx, p.topicID, logStreamID, snid, logCL, p.wanted, p.end, p.transmitQ, p.transmitCV, p.maxPending, p.filter, p.decoder, p.metrics, p.logger)
 			if err != nil {
 				// logCL.Close()
 				continue CONNECT
@@ -402,8 +592,11 @@ func (p *transmitter) handleResult(r transmitResult) error {
 		if errors.Is(err, verrors.ErrTrimmed) {
 			p.sleq.pushBack(r.result)
 		}
+	} else if r.result.Filtered {
+		p.skipFiltered(r)
 	} else if p.wanted == r.result.GLSN {
 		p.sleq.pushBack(r.result)
+		p.metrics.recordSubscribeReorderDelay(p.topicID, r.logStreamID, time.Since(r.receivedAt))
 		p.wanted++
 		p.timer.Reset(p.timeout)
 	}
@@ -411,6 +604,39 @@ func (p *transmitter) handleResult(r transmitResult) error {
 	return err
 }
 
+// skipFiltered moves on past the range of GLSNs of log entries not matched by
+// the filter if the range has the wanted GLSN, and pushes the result back to
+// the transmitQ with the rest of the ranges, which follow log entries of other
+// log streams.
+func (p *transmitter) skipFiltered(r transmitResult) {
+	ranges := r.result.FilteredRanges
+	if len(ranges) == 0 {
+		ranges = []snpb.SubscribeResponse_GLSNRange{{Begin: r.result.GLSN, End: r.result.GLSN + 1}}
+	}
+	// NOTE: Ranges before the wanted GLSN can be reported by subscribers
+	// that have not yet closed.
+	for len(ranges) > 0 && ranges[0].End <= p.wanted {
+		ranges = ranges[1:]
+	}
+	if len(ranges) == 0 {
+		return
+	}
+	if ranges[0].Begin <= p.wanted {
+		p.sleq.skip(ranges[0].End - p.wanted)
+		p.wanted = ranges[0].End
+		p.timer.Reset(p.timeout)
+		ranges = ranges[1:]
+	}
+	if len(ranges) > 0 {
+		r.result.FilteredRanges = ranges
+		r.result.GLSN = ranges[0].Begin
+		// NOTE: The result has already been released from the pending
+		// results of the subscriber.
+		r.sub = nil
+		p.transmitQ.Push(r)
+	}
+}
+
 func (p *transmitter) transmitLoop(ctx context.Context) bool {
 	needRefresh := false
 
@@ -449,9 +675,9 @@ type subscribedLogEntriesQueue struct {
 	logger *zap.Logger
 }
 
-func newSubscribedLogEntiresQueue(begin, end types.GLSN, closer SubscribeCloser, logger *zap.Logger) *subscribedLogEntriesQueue {
+func newSubscribedLogEntiresQueue(begin, end types.GLSN, size int, closer SubscribeCloser, logger *zap.Logger) *subscribedLogEntriesQueue {
 	q := &subscribedLogEntriesQueue{
-		c:      make(chan client.SubscribeResult, end-begin),
+		c:      make(chan client.SubscribeResult, size),
 		wanted: begin,
 		end:    end,
 		closer: closer,
@@ -465,13 +691,23 @@ func (q *subscribedLogEntriesQueue) pushBack(result client.SubscribeResult) {
 		q.logger.Panic("not pushable")
 	}
 	advance := result.Error == nil
-	// NOTE: the sendC is not blocking since its size is enough to receive messages.
+	// NOTE: Only the transmit goroutine calls pushBack, and wanted advances
+	// after the send; thus, log entries reach the receiver in increasing
+	// order of GLSN, and only ones skipped by the filter are missing. The
+	// send blocks while the channel is full, which applies backpressure to
+	// the transmitter.
 	q.sendC() <- result
 	if advance {
 		q.wanted++
 	}
 }
 
+// skip moves on past the argument n GLSNs without pushing log entries, which
+// are not matched by the filter of the subscription.
+func (q *subscribedLogEntriesQueue) skip(n types.GLSN) {
+	q.wanted += n
+}
+
 func (q *subscribedLogEntriesQueue) pushable(result client.SubscribeResult) bool {
 	return result.LogEntry.GLSN == q.wanted || result.Error != nil
 }
@@ -535,8 +771,16 @@ func (v *logImpl) subscribeTo(ctx context.Context, topicID types.TopicID, logStr
 	for _, opt := range opts {
 		opt.apply(&subscribeOpts)
 	}
+	if err := v.checkSubscribeFilter(subscribeOpts.filter); err != nil {
+		return invalidSubscriber{err: err}
+	}
 
 	logStreamReplicas, ok := v.replicasRetriever.Retrieve(topicID, logStreamID)
+	if !ok {
+		// The log stream may be added after the last refresh.
+		v.refresher.Refresh(ctx)
+		logStreamReplicas, ok = v.replicasRetriever.Retrieve(topicID, logStreamID)
+	}
 	if !ok {
 		return invalidSubscriber{err: errors.New("no such log stream")}
 	}
@@ -558,7 +802,7 @@ func (v *logImpl) subscribeTo(ctx context.Context, topicID types.TopicID, logStr
 			continue
 		}
 
-		resultC, cerr = logCL.SubscribeTo(ctx, topicID, logStreamID, begin, end)
+		resultC, cerr = logCL.SubscribeTo(ctx, topicID, logStreamID, begin, end, subscribeOpts.filter)
 		if cerr != nil {
 			err = multierr.Append(err, cerr)
 			// _ = logCL.Close()
@@ -575,12 +819,16 @@ func (v *logImpl) subscribeTo(ctx context.Context, topicID types.TopicID, logStr
 
 	ch := make(chan struct{})
 	return &logStreamSubscriber{
-		ctx:     ctx,
-		cancel:  cancel,
-		closeC:  ch,
-		closer:  func() { close(ch) },
-		logCL:   logCL,
-		resultC: resultC,
+		ctx:         ctx,
+		cancel:      cancel,
+		closeC:      ch,
+		closer:      func() { close(ch) },
+		logCL:       logCL,
+		resultC:     resultC,
+		decoder:     v.newLogEntryDecoder(),
+		metrics:     v.metrics,
+		topicID:     topicID,
+		logStreamID: logStreamID,
 	}
 }
 
@@ -588,9 +836,13 @@ type logStreamSubscriber struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	closeC  <-chan struct{}
-	logCL   *client.LogClient
-	resultC <-chan client.SubscribeResult
+	closeC      <-chan struct{}
+	logCL       *client.LogClient
+	resultC     <-chan client.SubscribeResult
+	decoder     *logEntryDecoder
+	metrics     *clientMetrics
+	topicID     types.TopicID
+	logStreamID types.LogStreamID
 
 	mu      sync.Mutex
 	closer  func()
@@ -614,6 +866,10 @@ func (s *logStreamSubscriber) Next() (logEntry varlogpb.LogEntry, err error) {
 	case sr, ok := <-s.resultC:
 		if ok {
 			logEntry, err = sr.LogEntry, sr.Error
+			if err == nil {
+				s.metrics.addBytesIn(s.topicID, s.logStreamID, logEntry.Data)
+				err = s.decoder.decode(s.ctx, &logEntry)
+			}
 		} else {
 			err = errors.New("already stopped SubscribeTo RPC")
 		}
@@ -0,0 +1,180 @@
+package varlog
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
+)
+
+// TxnHeader is the key of the header that marks a log entry appended by
+// AppendTxn. Its value lists the log streams participating in the
+// transaction.
+const TxnHeader = snpb.TxnHeader
+
+// ErrTxnAborted is returned by AppendTxn if the transaction is aborted since
+// not all of its log entries are written within its time limit.
+var ErrTxnAborted = verrors.ErrTxnAborted
+
+// TxnBatch is a batch of log entries appended by AppendTxn.
+type TxnBatch struct {
+	TopicID types.TopicID
+	// LogStreamID is the log stream to which the batch is appended. If it
+	// is zero, the client selects a log stream of the topic as Append does.
+	LogStreamID types.LogStreamID
+	Data        [][]byte
+	// Headers are headers of the log entries in the batch, as WithHeaders
+	// describes. It can be nil.
+	Headers []map[string][]byte
+}
+
+// TxnResult is the result of AppendTxn. Results have the result of each batch
+// in the same order as the batches.
+type TxnResult struct {
+	TxnID   string
+	Results []AppendResult
+	Err     error
+}
+
+func newTxnID() (string, error) {
+	var buf [16]byte
+	if _, err := io.ReadFull(rand.Reader, buf[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf[:]), nil
+}
+
+// newTxn returns the transaction appending the batches. It selects log
+// streams for batches without them, thus, they should be appended to the
+// selected log streams without retries to other log streams.
+func (v *logImpl) newTxn(id string, batches []TxnBatch, timeout time.Duration) (snpb.Txn, error) {
+	txn := snpb.Txn{ID: id, Timeout: timeout}
+	participants := make(map[types.LogStreamID]int, len(batches))
+	for i := range batches {
+		if batches[i].LogStreamID == 0 {
+			lsid, ok := v.lsSelector.Select(batches[i].TopicID)
+			if !ok {
+				return txn, fmt.Errorf("batch %d: no usable log stream in topic %d", i, batches[i].TopicID)
+			}
+			batches[i].LogStreamID = lsid
+		}
+		idx, ok := participants[batches[i].LogStreamID]
+		if !ok {
+			idx = len(txn.Participants)
+			participants[batches[i].LogStreamID] = idx
+			txn.Participants = append(txn.Participants, snpb.TxnParticipant{
+				TopicID:     batches[i].TopicID,
+				LogStreamID: batches[i].LogStreamID,
+			})
+		}
+		if txn.Participants[idx].TopicID != batches[i].TopicID {
+			return txn, fmt.Errorf("batch %d: log stream %d in several topics: %w", i, batches[i].LogStreamID, verrors.ErrInvalid)
+		}
+		txn.Participants[idx].Length += uint64(len(batches[i].Data))
+	}
+	return txn, nil
+}
+
+// appendTxn appends the batches with the TxnHeader concurrently. Log streams
+// and the metadata repository decide whether the transaction is committed, and
+// the result of each batch tells the decision.
+func (v *logImpl) appendTxn(ctx context.Context, batches []TxnBatch, opts ...AppendOption) (result TxnResult) {
+	if len(batches) == 0 {
+		result.Err = fmt.Errorf("append txn: no batch: %w", verrors.ErrInvalid)
+		return result
+	}
+	for i := range batches {
+		if len(batches[i].Data) == 0 {
+			result.Err = fmt.Errorf("append txn: batch %d: no data: %w", i, verrors.ErrInvalid)
+			return result
+		}
+		if len(batches[i].Headers) > 0 && len(batches[i].Headers) != len(batches[i].Data) {
+			result.Err = fmt.Errorf("append txn: batch %d: the number of headers does not match that of data: %w", i, verrors.ErrInvalid)
+			return result
+		}
+	}
+
+	id, err := newTxnID()
+	if err != nil {
+		result.Err = fmt.Errorf("append txn: %w", err)
+		return result
+	}
+	result.TxnID = id
+
+	timeout := v.opts.txnTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); remaining < timeout {
+			timeout = remaining
+		}
+	}
+	if timeout <= 0 {
+		result.Err = fmt.Errorf("append txn %s: %w", id, context.DeadlineExceeded)
+		return result
+	}
+
+	batches = append([]TxnBatch(nil), batches...)
+	txn, err := v.newTxn(id, batches, timeout)
+	if err != nil {
+		result.Err = fmt.Errorf("append txn %s: %w", id, err)
+		return result
+	}
+	txnHeader, err := txn.Marshal()
+	if err != nil {
+		result.Err = fmt.Errorf("append txn %s: %w", id, err)
+		return result
+	}
+
+	result.Results = make([]AppendResult, len(batches))
+	var wg sync.WaitGroup
+	for i := range batches {
+		headers := make([]map[string][]byte, len(batches[i].Data))
+		for j := range headers {
+			headers[j] = make(map[string][]byte)
+			if len(batches[i].Headers) > 0 {
+				for k, val := range batches[i].Headers[j] {
+					headers[j][k] = val
+				}
+			}
+			headers[j][TxnHeader] = txnHeader
+		}
+		// A retry could write the batch twice, or write it to another
+		// log stream than the participant.
+		batchOpts := append(append([]AppendOption(nil), opts...),
+			WithHeaders(headers...),
+			WithRetryCount(0),
+			withoutSelectLogStream(),
+		)
+
+		wg.Add(1)
+		go func(i int, batchOpts []AppendOption) {
+			defer wg.Done()
+			batch := batches[i]
+			result.Results[i] = v.append(ctx, batch.TopicID, batch.LogStreamID, batch.Data, batchOpts...)
+		}(i, batchOpts)
+	}
+	wg.Wait()
+
+	// An abort is decided for the whole transaction, whereas other errors
+	// leave it undecided.
+	for i := range result.Results {
+		if err := result.Results[i].Err; errors.Is(err, ErrTxnAborted) {
+			result.Err = fmt.Errorf("append txn %s: batch %d: %w", id, i, err)
+			return result
+		}
+	}
+	for i := range result.Results {
+		if err := result.Results[i].Err; err != nil {
+			result.Err = fmt.Errorf("append txn %s: batch %d: %w", id, i, err)
+			return result
+		}
+	}
+	return result
+}
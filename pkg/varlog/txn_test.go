@@ -0,0 +1,49 @@
+package varlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/snpb"
+)
+
+func TestNewTxn(t *testing.T) {
+	id, err := newTxnID()
+	require.NoError(t, err)
+	other, err := newTxnID()
+	require.NoError(t, err)
+	require.NotEqual(t, id, other)
+
+	v := &logImpl{}
+	txn, err := v.newTxn(id, []TxnBatch{
+		{TopicID: 1, LogStreamID: 2, Data: [][]byte{nil, nil}},
+		{TopicID: 3, LogStreamID: 4, Data: [][]byte{nil}},
+		{TopicID: 1, LogStreamID: 2, Data: [][]byte{nil}},
+	}, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, []snpb.TxnParticipant{
+		{TopicID: 1, LogStreamID: 2, Length: 3},
+		{TopicID: 3, LogStreamID: 4, Length: 1},
+	}, txn.Participants)
+
+	value, err := txn.Marshal()
+	require.NoError(t, err)
+	parsed, err := snpb.ParseTxn(value)
+	require.NoError(t, err)
+	require.Equal(t, txn, parsed)
+	p, ok := parsed.Participant(types.TopicID(3), types.LogStreamID(4))
+	require.True(t, ok)
+	require.EqualValues(t, 1, p.Length)
+	_, ok = parsed.Participant(types.TopicID(1), types.LogStreamID(4))
+	require.False(t, ok)
+
+	// A log stream belongs to only one topic.
+	_, err = v.newTxn(id, []TxnBatch{
+		{TopicID: 1, LogStreamID: 2, Data: [][]byte{nil}},
+		{TopicID: 3, LogStreamID: 2, Data: [][]byte{nil}},
+	}, time.Second)
+	require.Error(t, err)
+}
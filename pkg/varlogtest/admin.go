@@ -15,6 +15,7 @@ import (
 	"github.com/kakao/varlog/pkg/varlog"
 	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/admpb"
+	"github.com/kakao/varlog/proto/mrpb"
 	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
@@ -180,6 +181,7 @@ func (c *testAdmin) AddTopic(ctx context.Context, opts ...varlog.AdminCallOption
 
 	invalidLogEntry := varlogpb.InvalidLogEntry()
 	c.vt.globalLogEntries[topicID] = []*varlogpb.LogEntry{&invalidLogEntry}
+	c.vt.commitTimes[topicID] = []time.Time{{}}
 
 	return proto.Clone(&topicDesc).(*varlogpb.TopicDescriptor), nil
 }
@@ -188,6 +190,29 @@ func (c *testAdmin) UnregisterTopic(ctx context.Context, topicID types.TopicID,
 	panic("not implemented")
 }
 
+func (c *testAdmin) SetRetentionPolicy(ctx context.Context, tpid types.TopicID, policy *varlogpb.RetentionPolicy, opts ...varlog.AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+	defer c.unlock()
+
+	if policy != nil && policy.MaxAge < 0 {
+		return nil, errors.Wrap(verrors.ErrInvalid, "negative max age")
+	}
+
+	td, ok := c.vt.topics[tpid]
+	if !ok || td.Status.Deleted() {
+		return nil, errors.Wrapf(verrors.ErrNotExist, "topic %d", tpid)
+	}
+	if policy.Empty() {
+		td.RetentionPolicy = nil
+	} else {
+		td.RetentionPolicy = proto.Clone(policy).(*varlogpb.RetentionPolicy)
+	}
+	c.vt.topics[tpid] = td
+	return proto.Clone(&td).(*varlogpb.TopicDescriptor), nil
+}
+
 func (c *testAdmin) GetLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, opts ...varlog.AdminCallOption) (*varlogpb.LogStreamDescriptor, error) {
 	panic("not implemented")
 }
@@ -465,6 +490,10 @@ func (c *testAdmin) Trim(ctx context.Context, topicID types.TopicID, lastGLSN ty
 	return ret, nil
 }
 
+func (c *testAdmin) Backup(ctx context.Context, path string, opts ...varlog.AdminCallOption) (*admpb.BackupManifest, error) {
+	panic("not implemented")
+}
+
 func (c *testAdmin) GetMetadataRepositoryNode(ctx context.Context, nid types.NodeID, opts ...varlog.AdminCallOption) (*varlogpb.MetadataRepositoryNode, error) {
 	panic("not implemented")
 }
@@ -493,6 +522,63 @@ func (c *testAdmin) RemoveMRPeer(ctx context.Context, raftURL string, opts ...va
 	panic("not implemented")
 }
 
+func (c *testAdmin) ConsumerGroupHeartbeat(ctx context.Context, group string, tpid types.TopicID, memberID string, generation uint64, owned []types.LogStreamID, opts ...varlog.AdminCallOption) (*admpb.ConsumerGroupHeartbeatResponse, error) {
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+	defer c.unlock()
+
+	if len(group) == 0 || len(memberID) == 0 {
+		return nil, errors.WithMessage(verrors.ErrInvalid, "no group or member")
+	}
+	td, ok := c.vt.topics[tpid]
+	if !ok {
+		return nil, errors.WithMessage(verrors.ErrNotExist, "no such topic")
+	}
+	asg, err := c.vt.groups.Heartbeat(group, tpid, memberID, generation, owned, td.LogStreams)
+	if err != nil {
+		return nil, errors.WithMessage(verrors.ErrNotMember, err.Error())
+	}
+	return &admpb.ConsumerGroupHeartbeatResponse{
+		Generation:     asg.Generation,
+		LogStreamIDs:   asg.LogStreamIDs,
+		SessionTimeout: asg.SessionTimeout,
+	}, nil
+}
+
+func (c *testAdmin) LeaveConsumerGroup(ctx context.Context, group string, tpid types.TopicID, memberID string, opts ...varlog.AdminCallOption) error {
+	if err := c.lock(); err != nil {
+		return err
+	}
+	defer c.unlock()
+
+	if len(group) == 0 || len(memberID) == 0 {
+		return errors.WithMessage(verrors.ErrInvalid, "no group or member")
+	}
+	c.vt.groups.Leave(group, tpid, memberID)
+	return nil
+}
+
+func (c *testAdmin) CommitConsumerGroupOffset(ctx context.Context, group string, tpid types.TopicID, memberID string, generation uint64, lsid types.LogStreamID, llsn types.LLSN, opts ...varlog.AdminCallOption) error {
+	if err := c.lock(); err != nil {
+		return err
+	}
+	defer c.unlock()
+
+	if len(group) == 0 || len(memberID) == 0 {
+		return errors.WithMessage(verrors.ErrInvalid, "no group or member")
+	}
+	if _, err := c.vt.logStreamDescriptor(tpid, lsid); err != nil {
+		return err
+	}
+	if err := c.vt.groups.CheckCommit(group, tpid, memberID, generation, lsid); err != nil {
+		return errors.WithMessage(verrors.ErrNotMember, err.Error())
+	}
+	key := consumerGroupKey{group: group, topicID: tpid, logStreamID: lsid}
+	c.vt.offsets[key] = mrpb.ConsumerGroupOffset{TopicID: tpid, LogStreamID: lsid, LLSN: llsn}
+	return nil
+}
+
 func (c *testAdmin) Close() error {
 	c.vt.cond.L.Lock()
 	defer c.vt.cond.L.Unlock()
@@ -0,0 +1,75 @@
+package varlogtest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/varlog"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// testBatcher appends each log entry as soon as AppendAsync is called, and
+// calls the callback before AppendAsync returns.
+type testBatcher struct {
+	c           *testLog
+	topicID     types.TopicID
+	logStreamID types.LogStreamID
+
+	mu     sync.Mutex
+	closed bool
+}
+
+var _ varlog.Batcher = (*testBatcher)(nil)
+
+func (c *testLog) NewBatcher(topicID types.TopicID, opts ...varlog.BatcherOption) (varlog.Batcher, error) {
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+	defer c.unlock()
+
+	topicDesc, err := c.vt.topicDescriptor(topicID)
+	if err != nil {
+		return nil, err
+	}
+	if len(topicDesc.LogStreams) == 0 {
+		return nil, fmt.Errorf("batcher: no log stream in topic %d", topicID)
+	}
+	return &testBatcher{
+		c:           c,
+		topicID:     topicID,
+		logStreamID: topicDesc.LogStreams[c.vt.rng.Intn(len(topicDesc.LogStreams))],
+	}, nil
+}
+
+func (b *testBatcher) AppendAsync(data []byte, _ map[string][]byte, callback varlog.AppendCallback) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return fmt.Errorf("batcher: %w", verrors.ErrClosed)
+	}
+
+	res := b.c.AppendTo(context.Background(), b.topicID, b.logStreamID, [][]byte{data})
+	if callback == nil {
+		return nil
+	}
+	var meta varlogpb.LogEntryMeta
+	if res.Err == nil {
+		meta = res.Metadata[0]
+	}
+	callback(meta, res.Err)
+	return nil
+}
+
+func (b *testBatcher) Flush(context.Context) error {
+	return nil
+}
+
+func (b *testBatcher) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	return nil
+}
@@ -2,14 +2,18 @@ package varlogtest
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/varlog"
 	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/mrpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
 
@@ -53,7 +57,7 @@ func (c *testLog) Append(ctx context.Context, topicID types.TopicID, dataBatch [
 		return res
 	}
 	logStreamID := topicDesc.LogStreams[c.vt.rng.Intn(len(topicDesc.LogStreams))]
-	return c.appendTo(topicID, logStreamID, dataBatch)
+	return c.appendTo(topicID, logStreamID, dataBatch, varlog.AppendOptionHeaders(opts...))
 }
 
 func (c *testLog) AppendTo(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, dataBatch [][]byte, opts ...varlog.AppendOption) (res varlog.AppendResult) {
@@ -63,10 +67,66 @@ func (c *testLog) AppendTo(ctx context.Context, topicID types.TopicID, logStream
 	}
 	defer c.unlock()
 
-	return c.appendTo(topicID, logStreamID, dataBatch)
+	return c.appendTo(topicID, logStreamID, dataBatch, varlog.AppendOptionHeaders(opts...))
 }
 
-func (c *testLog) appendTo(topicID types.TopicID, logStreamID types.LogStreamID, dataBatch [][]byte) (res varlog.AppendResult) {
+// AppendTxn appends all batches at once since the log entries are committed as
+// soon as they are appended; hence, transactions are never aborted.
+func (c *testLog) AppendTxn(ctx context.Context, batches []varlog.TxnBatch, opts ...varlog.AppendOption) (res varlog.TxnResult) {
+	if err := c.lock(); err != nil {
+		res.Err = err
+		return res
+	}
+	defer c.unlock()
+
+	if len(batches) == 0 {
+		res.Err = errors.Wrap(verrors.ErrInvalid, "append txn: no batch")
+		return res
+	}
+
+	// All batches are checked before appending any of them to keep the
+	// transaction atomic.
+	logStreamIDs := make([]types.LogStreamID, len(batches))
+	for i, batch := range batches {
+		if len(batch.Data) == 0 {
+			res.Err = errors.Wrapf(verrors.ErrInvalid, "append txn: batch %d: no data", i)
+			return res
+		}
+		logStreamID := batch.LogStreamID
+		if logStreamID.Invalid() {
+			topicDesc, err := c.vt.topicDescriptor(batch.TopicID)
+			if err != nil {
+				res.Err = err
+				return res
+			}
+			logStreamID = topicDesc.LogStreams[c.vt.rng.Intn(len(topicDesc.LogStreams))]
+		}
+		logStreamDesc, err := c.vt.logStreamDescriptor(batch.TopicID, logStreamID)
+		if err != nil {
+			res.Err = err
+			return res
+		}
+		if logStreamDesc.Status.Sealed() {
+			res.Err = errors.Wrap(verrors.ErrSealed, "could not append")
+			return res
+		}
+		logStreamIDs[i] = logStreamID
+	}
+
+	res.TxnID = fmt.Sprintf("%016x", c.vt.rng.Uint64())
+	res.Results = make([]varlog.AppendResult, len(batches))
+	for i, batch := range batches {
+		res.Results[i] = c.appendTo(batch.TopicID, logStreamIDs[i], batch.Data, batch.Headers)
+	}
+	return res
+}
+
+func (c *testLog) appendTo(topicID types.TopicID, logStreamID types.LogStreamID, dataBatch [][]byte, headers []map[string][]byte) (res varlog.AppendResult) {
+	if len(headers) > 0 && len(headers) != len(dataBatch) {
+		res.Err = errors.Wrap(verrors.ErrInvalid, "the number of headers does not match that of data")
+		return res
+	}
+
 	logStreamDesc, err := c.vt.logStreamDescriptor(topicID, logStreamID)
 	if err != nil {
 		res.Err = err
@@ -87,8 +147,9 @@ func (c *testLog) appendTo(topicID types.TopicID, logStreamID types.LogStreamID,
 	lastGLSN := c.vt.globalLogEntries[topicID][n-1].GLSN
 	_, tail := c.vt.peek(topicID, logStreamID)
 	lastLLSN := tail.LLSN
+	commitTime := time.Now()
 
-	for _, data := range dataBatch {
+	for i, data := range dataBatch {
 		lastGLSN++
 		lastLLSN++
 		logEntry := &varlogpb.LogEntry{
@@ -101,9 +162,13 @@ func (c *testLog) appendTo(topicID types.TopicID, logStreamID types.LogStreamID,
 			Data: make([]byte, len(data)),
 		}
 		copy(logEntry.Data, data)
+		if len(headers) > 0 {
+			logEntry.Headers = copyHeaders(headers[i])
+		}
 
 		c.vt.globalLogEntries[topicID] = append(c.vt.globalLogEntries[topicID], logEntry)
 		c.vt.localLogEntries[logStreamID] = append(c.vt.localLogEntries[logStreamID], logEntry)
+		c.vt.commitTimes[topicID] = append(c.vt.commitTimes[topicID], commitTime)
 		res.Metadata = append(res.Metadata, logEntry.LogEntryMeta)
 	}
 	c.vt.version++
@@ -111,31 +176,139 @@ func (c *testLog) appendTo(topicID types.TopicID, logStreamID types.LogStreamID,
 	return res
 }
 
-func (c *testLog) Read(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, glsn types.GLSN) ([]byte, error) {
+func (c *testLog) Read(ctx context.Context, topicID types.TopicID, glsn types.GLSN) (varlogpb.LogEntry, error) {
 	if err := c.lock(); err != nil {
-		return nil, err
+		return varlogpb.InvalidLogEntry(), err
+	}
+	defer c.unlock()
+
+	if _, err := c.vt.topicDescriptor(topicID); err != nil {
+		return varlogpb.InvalidLogEntry(), err
+	}
+
+	if glsn.Invalid() {
+		return varlogpb.InvalidLogEntry(), errors.WithStack(verrors.ErrInvalid)
+	}
+	if glsn <= c.vt.trimGLSNs[topicID] {
+		return varlogpb.InvalidLogEntry(), errors.WithStack(verrors.ErrTrimmed)
+	}
+
+	logEntries := c.vt.globalLogEntries[topicID]
+	if int(glsn) >= len(logEntries) {
+		return varlogpb.InvalidLogEntry(), errors.WithStack(verrors.ErrNoEntry)
+	}
+	return copyLogEntry(logEntries[glsn]), nil
+}
+
+func (c *testLog) ReadFrom(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, llsn types.LLSN) (varlogpb.LogEntry, error) {
+	if err := c.lock(); err != nil {
+		return varlogpb.InvalidLogEntry(), err
 	}
 	defer c.unlock()
 
 	topicDesc, err := c.vt.topicDescriptor(topicID)
 	if err != nil {
-		return nil, err
+		return varlogpb.InvalidLogEntry(), err
 	}
 	if !topicDesc.HasLogStream(logStreamID) {
-		return nil, errors.New("no such log stream in the topic")
+		return varlogpb.InvalidLogEntry(), errors.New("no such log stream in the topic")
 	}
 
-	n := len(c.vt.globalLogEntries[topicID])
-	if c.vt.globalLogEntries[topicID][n-1].GLSN < glsn {
-		// NOTE: This differs from the real varlog.
-		return nil, errors.New("no such log entry")
+	if llsn.Invalid() {
+		return varlogpb.InvalidLogEntry(), errors.WithStack(verrors.ErrInvalid)
+	}
+
+	logEntries := c.vt.localLogEntries[logStreamID]
+	if int(llsn) >= len(logEntries) {
+		return varlogpb.InvalidLogEntry(), errors.WithStack(verrors.ErrNoEntry)
+	}
+	if logEntries[llsn].GLSN <= c.vt.trimGLSNs[topicID] {
+		return varlogpb.InvalidLogEntry(), errors.WithStack(verrors.ErrTrimmed)
+	}
+	return copyLogEntry(logEntries[llsn]), nil
+}
+
+func (c *testLog) ReadBatch(ctx context.Context, topicID types.TopicID, glsns []types.GLSN) ([]varlog.ReadBatchResult, error) {
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+	defer c.unlock()
+
+	if _, err := c.vt.topicDescriptor(topicID); err != nil {
+		return nil, err
+	}
+
+	logEntries := c.vt.globalLogEntries[topicID]
+	results := make([]varlog.ReadBatchResult, len(glsns))
+	for i, glsn := range glsns {
+		results[i].LogEntry = varlogpb.InvalidLogEntry()
+		switch {
+		case glsn.Invalid():
+			results[i].Err = errors.WithStack(verrors.ErrInvalid)
+		case glsn <= c.vt.trimGLSNs[topicID]:
+			results[i].Err = errors.WithStack(verrors.ErrTrimmed)
+		case int(glsn) >= len(logEntries):
+			results[i].Err = errors.WithStack(verrors.ErrNoEntry)
+		default:
+			results[i].LogEntry = copyLogEntry(logEntries[glsn])
+		}
+	}
+	return results, nil
+}
+
+func copyLogEntry(logEntry *varlogpb.LogEntry) varlogpb.LogEntry {
+	ret := varlogpb.LogEntry{
+		LogEntryMeta: logEntry.LogEntryMeta,
+		Data:         make([]byte, len(logEntry.Data)),
+		Headers:      copyHeaders(logEntry.Headers),
+	}
+	copy(ret.Data, logEntry.Data)
+	return ret
+}
+
+func copyHeaders(headers map[string][]byte) map[string][]byte {
+	if len(headers) == 0 {
+		return nil
+	}
+	ret := make(map[string][]byte, len(headers))
+	for k, v := range headers {
+		ret[k] = append([]byte(nil), v...)
 	}
-	data := make([]byte, len(c.vt.globalLogEntries[topicID][glsn].Data))
-	copy(data, c.vt.globalLogEntries[topicID][glsn].Data)
-	return data, nil
+	return ret
 }
 
 func (c *testLog) Subscribe(ctx context.Context, topicID types.TopicID, begin types.GLSN, end types.GLSN, onNextFunc varlog.OnNext, opts ...varlog.SubscribeOption) (varlog.SubscribeCloser, error) {
+	copiedLogEntries, err := c.globalLogEntries(topicID, begin, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for _, logEntry := range copiedLogEntries {
+			onNextFunc(logEntry, nil)
+		}
+		onNextFunc(varlogpb.InvalidLogEntry(), io.EOF)
+	}()
+
+	return func() {
+		wg.Wait()
+	}, nil
+}
+
+func (c *testLog) SubscribeIter(ctx context.Context, topicID types.TopicID, begin, end types.GLSN, opts ...varlog.SubscribeOption) varlog.Subscriber {
+	copiedLogEntries, err := c.globalLogEntries(topicID, begin, end)
+	if err != nil {
+		return newErrSubscriber(err)
+	}
+	return &iterSubscriber{logEntries: copiedLogEntries}
+}
+
+// globalLogEntries returns copies of log entries of the topic from the GLSN
+// begin to the GLSN end exclusively.
+func (c *testLog) globalLogEntries(topicID types.TopicID, begin, end types.GLSN) ([]varlogpb.LogEntry, error) {
 	if begin >= end {
 		return nil, errors.New("invalid range")
 	}
@@ -170,32 +343,9 @@ func (c *testLog) Subscribe(ctx context.Context, topicID types.TopicID, begin ty
 
 	copiedLogEntries := make([]varlogpb.LogEntry, 0, end-begin)
 	for glsn := begin; glsn < end; glsn++ {
-		logEntry := varlogpb.LogEntry{
-			LogEntryMeta: varlogpb.LogEntryMeta{
-				TopicID:     logEntries[glsn].TopicID,
-				LogStreamID: logEntries[glsn].LogStreamID,
-				GLSN:        glsn,
-				LLSN:        logEntries[glsn].LLSN,
-			},
-			Data: make([]byte, len(logEntries[glsn].Data)),
-		}
-		copy(logEntry.Data, logEntries[glsn].Data)
-		copiedLogEntries = append(copiedLogEntries, logEntry)
+		copiedLogEntries = append(copiedLogEntries, copyLogEntry(logEntries[glsn]))
 	}
-
-	var wg sync.WaitGroup
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		for _, logEntry := range copiedLogEntries {
-			onNextFunc(logEntry, nil)
-		}
-		onNextFunc(varlogpb.InvalidLogEntry(), io.EOF)
-	}()
-
-	return func() {
-		wg.Wait()
-	}, nil
+	return copiedLogEntries, nil
 }
 
 func (c *testLog) SubscribeTo(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, begin, end types.LLSN, opts ...varlog.SubscribeOption) varlog.Subscriber {
@@ -265,6 +415,91 @@ func (c *testLog) SubscribeTo(ctx context.Context, topicID types.TopicID, logStr
 	return s
 }
 
+func (c *testLog) SubscribeGroup(ctx context.Context, group string, topicID types.TopicID, end types.GLSN, onNextFunc varlog.OnNext, opts ...varlog.SubscribeOption) (varlog.SubscribeCloser, error) {
+	begin := types.MinGLSN
+	offset, err := c.fetchOffset(group, topicID, 0)
+	switch {
+	case err == nil:
+		begin = offset.GLSN + 1
+	case !errors.Is(err, verrors.ErrNotExist):
+		return nil, err
+	}
+	return c.Subscribe(ctx, topicID, begin, end, onNextFunc, opts...)
+}
+
+func (c *testLog) CommitOffset(ctx context.Context, group string, topicID types.TopicID, glsn types.GLSN) error {
+	return c.commitOffset(group, mrpb.ConsumerGroupOffset{TopicID: topicID, GLSN: glsn})
+}
+
+func (c *testLog) FetchOffset(ctx context.Context, group string, topicID types.TopicID) (types.GLSN, error) {
+	offset, err := c.fetchOffset(group, topicID, 0)
+	return offset.GLSN, err
+}
+
+func (c *testLog) CommitLogStreamOffset(ctx context.Context, group string, topicID types.TopicID, logStreamID types.LogStreamID, llsn types.LLSN) error {
+	return c.commitOffset(group, mrpb.ConsumerGroupOffset{TopicID: topicID, LogStreamID: logStreamID, LLSN: llsn})
+}
+
+func (c *testLog) FetchLogStreamOffset(ctx context.Context, group string, topicID types.TopicID, logStreamID types.LogStreamID) (types.LLSN, error) {
+	offset, err := c.fetchOffset(group, topicID, logStreamID)
+	return offset.LLSN, err
+}
+
+func (c *testLog) DeleteConsumerGroup(ctx context.Context, group string) error {
+	if err := c.lock(); err != nil {
+		return err
+	}
+	defer c.unlock()
+
+	deleted := false
+	for key := range c.vt.offsets {
+		if key.group == group {
+			delete(c.vt.offsets, key)
+			deleted = true
+		}
+	}
+	if !deleted {
+		return errors.WithStack(verrors.ErrNotExist)
+	}
+	return nil
+}
+
+func (c *testLog) commitOffset(group string, offset mrpb.ConsumerGroupOffset) error {
+	if err := c.lock(); err != nil {
+		return err
+	}
+	defer c.unlock()
+
+	if len(group) == 0 {
+		return errors.WithStack(verrors.ErrInvalid)
+	}
+	if offset.LogStreamID.Invalid() {
+		if _, err := c.vt.topicDescriptor(offset.TopicID); err != nil {
+			return err
+		}
+	} else if _, err := c.vt.logStreamDescriptor(offset.TopicID, offset.LogStreamID); err != nil {
+		return err
+	}
+
+	key := consumerGroupKey{group: group, topicID: offset.TopicID, logStreamID: offset.LogStreamID}
+	c.vt.offsets[key] = offset
+	return nil
+}
+
+func (c *testLog) fetchOffset(group string, topicID types.TopicID, logStreamID types.LogStreamID) (mrpb.ConsumerGroupOffset, error) {
+	if err := c.lock(); err != nil {
+		return mrpb.ConsumerGroupOffset{}, err
+	}
+	defer c.unlock()
+
+	key := consumerGroupKey{group: group, topicID: topicID, logStreamID: logStreamID}
+	offset, ok := c.vt.offsets[key]
+	if !ok {
+		return mrpb.ConsumerGroupOffset{}, errors.WithStack(verrors.ErrNotExist)
+	}
+	return offset, nil
+}
+
 func (c *testLog) Trim(ctx context.Context, topicID types.TopicID, until types.GLSN, opts varlog.TrimOption) error {
 	panic("not implemented")
 }
@@ -297,6 +532,103 @@ func (c *testLog) PeekLogStream(ctx context.Context, tpid types.TopicID, lsid ty
 
 }
 
+func (c *testLog) SeekTime(ctx context.Context, topicID types.TopicID, t time.Time) (types.GLSN, error) {
+	if err := c.lock(); err != nil {
+		return types.InvalidGLSN, err
+	}
+	defer c.unlock()
+
+	if _, err := c.vt.topicDescriptor(topicID); err != nil {
+		return types.InvalidGLSN, err
+	}
+
+	// The first one is a sentinel.
+	commitTimes := c.vt.commitTimes[topicID][1:]
+	idx := sort.Search(len(commitTimes), func(i int) bool {
+		return !commitTimes[i].Before(t)
+	})
+	return types.GLSN(idx + 1), nil
+}
+
+func (c *testLog) ResolvePosition(ctx context.Context, topicID types.TopicID, pos varlog.Position) (types.GLSN, error) {
+	if err := c.lock(); err != nil {
+		return types.InvalidGLSN, err
+	}
+	defer c.unlock()
+
+	if _, err := c.vt.topicDescriptor(topicID); err != nil {
+		return types.InvalidGLSN, err
+	}
+
+	hwm := c.vt.globalHighWatermark(topicID)
+	switch pos {
+	case varlog.Earliest:
+		return c.vt.trimGLSNs[topicID] + 1, nil
+	case varlog.Latest:
+		if hwm.Invalid() {
+			return types.MinGLSN, nil
+		}
+		return hwm, nil
+	case varlog.Now:
+		return hwm + 1, nil
+	default:
+		return types.InvalidGLSN, errors.WithStack(verrors.ErrInvalid)
+	}
+}
+
+func (c *testLog) Follow(ctx context.Context, topicID types.TopicID, from varlog.Position, onNextFunc varlog.OnNext, opts ...varlog.SubscribeOption) (varlog.SubscribeCloser, error) {
+	begin, err := c.ResolvePosition(ctx, topicID, from)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		// It wakes up the follower waiting for new log entries.
+		defer wg.Done()
+		<-ctx.Done()
+		c.vt.cond.L.Lock()
+		c.vt.cond.Broadcast()
+		c.vt.cond.L.Unlock()
+	}()
+	go func() {
+		defer wg.Done()
+		for glsn := begin; ; glsn++ {
+			logEntry, err := c.waitLogEntry(ctx, topicID, glsn)
+			if err != nil {
+				onNextFunc(varlogpb.InvalidLogEntry(), err)
+				return
+			}
+			onNextFunc(logEntry, nil)
+		}
+	}()
+
+	return func() {
+		cancel()
+		wg.Wait()
+	}, nil
+}
+
+// waitLogEntry waits for the log entry at the glsn to be committed to the
+// topic, and returns its copy.
+func (c *testLog) waitLogEntry(ctx context.Context, topicID types.TopicID, glsn types.GLSN) (varlogpb.LogEntry, error) {
+	c.vt.cond.L.Lock()
+	defer c.vt.cond.L.Unlock()
+
+	for len(c.vt.globalLogEntries[topicID]) <= int(glsn) && ctx.Err() == nil && !c.vt.varlogClientClosed {
+		c.vt.cond.Wait()
+	}
+	if c.vt.varlogClientClosed {
+		return varlogpb.InvalidLogEntry(), errors.WithStack(verrors.ErrClosed)
+	}
+	if err := ctx.Err(); err != nil {
+		return varlogpb.InvalidLogEntry(), err
+	}
+	return copyLogEntry(c.vt.globalLogEntries[topicID][glsn]), nil
+}
+
 type errSubscriber struct {
 	err error
 }
@@ -313,6 +645,38 @@ func (s errSubscriber) Close() error {
 	return s.err
 }
 
+// iterSubscriber returns log entries copied when SubscribeIter is called.
+// NOTE: This differs from the real varlog, which waits for log entries
+// appended after SubscribeIter is called.
+type iterSubscriber struct {
+	logEntries []varlogpb.LogEntry
+
+	mu     sync.Mutex
+	closed bool
+}
+
+func (s *iterSubscriber) Next() (varlogpb.LogEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return varlogpb.InvalidLogEntry(), verrors.ErrClosed
+	}
+	if len(s.logEntries) == 0 {
+		return varlogpb.InvalidLogEntry(), io.EOF
+	}
+	logEntry := s.logEntries[0]
+	s.logEntries = s.logEntries[1:]
+	return logEntry, nil
+}
+
+func (s *iterSubscriber) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
 type subscriberImpl struct {
 	end    types.LLSN
 	cursor types.LLSN
@@ -390,16 +754,7 @@ func (s *subscriberImpl) next() (logEntry varlogpb.LogEntry, err error) {
 	}
 
 	logEntries := s.vt.logEntries()
-	logEntry = varlogpb.LogEntry{
-		LogEntryMeta: varlogpb.LogEntryMeta{
-			TopicID:     logEntries[s.cursor].TopicID,
-			LogStreamID: logEntries[s.cursor].LogStreamID,
-			GLSN:        logEntries[s.cursor].GLSN,
-			LLSN:        s.cursor,
-		},
-		Data: make([]byte, len(logEntries[s.cursor].Data)),
-	}
-	copy(logEntry.Data, logEntries[s.cursor].Data)
+	logEntry = copyLogEntry(logEntries[s.cursor])
 	s.cursor++
 	return logEntry, nil
 }
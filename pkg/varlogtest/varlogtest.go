@@ -8,8 +8,10 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/kakao/varlog/internal/admin/consumergroup"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/varlog"
+	"github.com/kakao/varlog/proto/mrpb"
 	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
@@ -30,8 +32,11 @@ type VarlogTest struct {
 	topics           map[types.TopicID]varlogpb.TopicDescriptor
 	globalLogEntries map[types.TopicID][]*varlogpb.LogEntry
 	localLogEntries  map[types.LogStreamID][]*varlogpb.LogEntry
+	commitTimes      map[types.TopicID][]time.Time
 	version          types.Version
 	trimGLSNs        map[types.TopicID]types.GLSN
+	offsets          map[consumerGroupKey]mrpb.ConsumerGroupOffset
+	groups           *consumergroup.Coordinator
 
 	nextTopicID       types.TopicID
 	nextStorageNodeID types.StorageNodeID
@@ -41,6 +46,12 @@ type VarlogTest struct {
 	varlogClientClosed bool
 }
 
+type consumerGroupKey struct {
+	group       string
+	topicID     types.TopicID
+	logStreamID types.LogStreamID
+}
+
 func New(clusterID types.ClusterID, replicationFactor int) *VarlogTest {
 	vt := &VarlogTest{
 		clusterID:         clusterID,
@@ -51,7 +62,10 @@ func New(clusterID types.ClusterID, replicationFactor int) *VarlogTest {
 		topics:            make(map[types.TopicID]varlogpb.TopicDescriptor),
 		globalLogEntries:  make(map[types.TopicID][]*varlogpb.LogEntry),
 		localLogEntries:   make(map[types.LogStreamID][]*varlogpb.LogEntry),
+		commitTimes:       make(map[types.TopicID][]time.Time),
 		trimGLSNs:         make(map[types.TopicID]types.GLSN),
+		offsets:           make(map[consumerGroupKey]mrpb.ConsumerGroupOffset),
+		groups:            consumergroup.New(consumergroup.DefaultSessionTimeout),
 	}
 	vt.cond = sync.NewCond(&vt.mu)
 	vt.admin = &testAdmin{vt: vt}
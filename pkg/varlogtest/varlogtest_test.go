@@ -256,6 +256,24 @@ func TestVarlogTest(t *testing.T) {
 			}
 		}
 	}
+	subscribeIter := func(tpID types.TopicID, begin, end types.GLSN) {
+		subscriber := vlg.SubscribeIter(context.Background(), tpID, begin, end)
+		expectedGLSN := begin
+		for {
+			logEntry, err := subscriber.Next()
+			if err != nil {
+				require.ErrorIs(t, err, io.EOF)
+				break
+			}
+			require.Equal(t, expectedGLSN, logEntry.GLSN)
+			require.Equal(t, []byte(fmt.Sprintf("%d,%d", tpID, expectedGLSN)), logEntry.Data)
+			expectedGLSN++
+		}
+		require.Equal(t, end, expectedGLSN)
+		require.NoError(t, subscriber.Close())
+		_, err := subscriber.Next()
+		require.ErrorIs(t, err, verrors.ErrClosed)
+	}
 	subscribeTo := func(tpID types.TopicID, lsID types.LogStreamID, begin, end types.LLSN) {
 		subscriber := vlg.SubscribeTo(context.Background(), tpID, lsID, begin, end)
 		defer func() {
@@ -333,6 +351,7 @@ func TestVarlogTest(t *testing.T) {
 	for i := 0; i < numTopics; i++ {
 		tpID := topicIDs[i]
 		subscribe(tpID, types.MinGLSN, globalHWMs[tpID]+1)
+		subscribeIter(tpID, types.MinGLSN, globalHWMs[tpID]+1)
 	}
 
 	// Metadata
@@ -545,6 +564,342 @@ func TestVarlogTest_Trim(t *testing.T) {
 		expectedLLSN++
 	}
 	assert.NoError(t, subscriber.Close())
+
+	_, err = vlg.Read(context.Background(), td.TopicID, trimGLSN)
+	assert.ErrorIs(t, err, verrors.ErrTrimmed)
+	_, err = vlg.ReadFrom(context.Background(), td.TopicID, lsds[0].LogStreamID, types.LLSN(2))
+	assert.ErrorIs(t, err, verrors.ErrTrimmed)
+
+	le, err := vlg.Read(context.Background(), td.TopicID, trimGLSN+1)
+	assert.NoError(t, err)
+	assert.Equal(t, lsds[1].LogStreamID, le.LogStreamID)
+	assert.Equal(t, types.LLSN(2), le.LLSN)
+
+	le, err = vlg.ReadFrom(context.Background(), td.TopicID, lsds[0].LogStreamID, types.LLSN(3))
+	assert.NoError(t, err)
+	assert.Equal(t, types.GLSN(5), le.GLSN)
+
+	_, err = vlg.Read(context.Background(), td.TopicID, lastGLSN+1)
+	assert.ErrorIs(t, err, verrors.ErrNoEntry)
+	_, err = vlg.ReadFrom(context.Background(), td.TopicID, lsds[0].LogStreamID, types.LLSN(6))
+	assert.ErrorIs(t, err, verrors.ErrNoEntry)
+
+	results, err := vlg.ReadBatch(context.Background(), td.TopicID, []types.GLSN{lastGLSN + 1, trimGLSN + 1, trimGLSN, types.InvalidGLSN})
+	assert.NoError(t, err)
+	assert.Len(t, results, 4)
+	assert.ErrorIs(t, results[0].Err, verrors.ErrNoEntry)
+	assert.NoError(t, results[1].Err)
+	assert.Equal(t, trimGLSN+1, results[1].GLSN)
+	assert.ErrorIs(t, results[2].Err, verrors.ErrTrimmed)
+	assert.ErrorIs(t, results[3].Err, verrors.ErrInvalid)
+}
+
+func TestVarlogTest_GroupConsumer(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	const (
+		clusterID         = types.ClusterID(1)
+		replicationFactor = 1
+		numLogStreams     = 4
+		numLogs           = 10
+		group             = "group"
+		heartbeatInterval = 10 * time.Millisecond
+	)
+
+	vt := varlogtest.New(clusterID, replicationFactor)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	td, err := adm.AddTopic(context.Background())
+	require.NoError(t, err)
+	_, err = adm.AddStorageNode(context.Background(), types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+
+	var lsids []types.LogStreamID
+	for i := 0; i < numLogStreams; i++ {
+		lsd, err := adm.AddLogStream(context.Background(), td.TopicID, nil)
+		require.NoError(t, err)
+		lsids = append(lsids, lsd.LogStreamID)
+	}
+	appendLogs := func() {
+		for _, lsid := range lsids {
+			for i := 0; i < numLogs; i++ {
+				res := vlg.AppendTo(context.Background(), td.TopicID, lsid, [][]byte{nil})
+				require.NoError(t, res.Err)
+			}
+		}
+	}
+
+	var (
+		mu       sync.Mutex
+		consumed = make(map[types.LogStreamID]types.LLSN)
+	)
+	onNext := func(logEntry varlogpb.LogEntry) {
+		mu.Lock()
+		defer mu.Unlock()
+		consumed[logEntry.LogStreamID] = logEntry.LLSN
+	}
+	consumedAll := func(llsn types.LLSN) func() bool {
+		return func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			for _, lsid := range lsids {
+				if consumed[lsid] != llsn {
+					return false
+				}
+			}
+			return true
+		}
+	}
+	newGroupConsumer := func(memberID string) varlog.GroupConsumer {
+		gc, err := varlog.NewGroupConsumer(vlg, adm, group, td.TopicID, onNext,
+			varlog.WithGroupMemberID(memberID),
+			varlog.WithGroupHeartbeatInterval(heartbeatInterval),
+			varlog.WithGroupCommitInterval(heartbeatInterval),
+		)
+		require.NoError(t, err)
+		return gc
+	}
+
+	_, err = varlog.NewGroupConsumer(vlg, adm, "", td.TopicID, onNext)
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	appendLogs()
+
+	// A single member consumes all log streams.
+	gc1 := newGroupConsumer("member-1")
+	require.Eventually(t, consumedAll(numLogs), 5*time.Second, heartbeatInterval)
+	require.Equal(t, lsids, gc1.Assignment())
+
+	// The second member joins the group, and log streams are divided.
+	gc2 := newGroupConsumer("member-2")
+	require.Eventually(t, func() bool {
+		return len(gc1.Assignment()) == numLogStreams/2 && len(gc2.Assignment()) == numLogStreams/2
+	}, 5*time.Second, heartbeatInterval)
+
+	appendLogs()
+	require.Eventually(t, consumedAll(2*numLogs), 5*time.Second, heartbeatInterval)
+
+	// The first member leaves the group, and the second member takes over
+	// all log streams.
+	require.NoError(t, gc1.Close())
+	require.Eventually(t, func() bool {
+		return len(gc2.Assignment()) == numLogStreams
+	}, 5*time.Second, heartbeatInterval)
+
+	appendLogs()
+	require.Eventually(t, consumedAll(3*numLogs), 5*time.Second, heartbeatInterval)
+	require.NoError(t, gc2.Close())
+
+	// Offsets are committed by closing members.
+	for _, lsid := range lsids {
+		llsn, err := vlg.FetchLogStreamOffset(context.Background(), group, td.TopicID, lsid)
+		require.NoError(t, err)
+		require.Equal(t, types.LLSN(3*numLogs), llsn)
+	}
+
+	// Members that left the group cannot commit offsets.
+	err = adm.CommitConsumerGroupOffset(context.Background(), group, td.TopicID, "member-2", 1, lsids[0], types.LLSN(1))
+	require.ErrorIs(t, err, verrors.ErrNotMember)
+	_, err = adm.ConsumerGroupHeartbeat(context.Background(), group, td.TopicID, "member-2", 1, nil)
+	require.ErrorIs(t, err, verrors.ErrNotMember)
+}
+
+func TestVarlogTest_SeekTime(t *testing.T) {
+	const (
+		clusterID         = types.ClusterID(1)
+		replicationFactor = 1
+		numLogs           = 10
+	)
+
+	vt := varlogtest.New(clusterID, replicationFactor)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	td, err := adm.AddTopic(context.Background())
+	require.NoError(t, err)
+	_, err = adm.AddStorageNode(context.Background(), types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+	_, err = adm.AddLogStream(context.Background(), td.TopicID, nil)
+	require.NoError(t, err)
+
+	appendLogs := func() {
+		for i := 0; i < numLogs; i++ {
+			res := vlg.Append(context.Background(), td.TopicID, [][]byte{nil})
+			require.NoError(t, res.Err)
+		}
+	}
+
+	_, err = vlg.SeekTime(context.Background(), td.TopicID+1, time.Now())
+	require.Error(t, err)
+
+	// No log entry yet.
+	glsn, err := vlg.SeekTime(context.Background(), td.TopicID, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, types.MinGLSN, glsn)
+
+	appendLogs()
+	time.Sleep(time.Millisecond)
+	pivot := time.Now()
+	time.Sleep(time.Millisecond)
+	appendLogs()
+
+	glsn, err = vlg.SeekTime(context.Background(), td.TopicID, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, types.MinGLSN, glsn)
+
+	glsn, err = vlg.SeekTime(context.Background(), td.TopicID, pivot)
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(numLogs+1), glsn)
+
+	glsn, err = vlg.SeekTime(context.Background(), td.TopicID, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(2*numLogs+1), glsn)
+}
+
+func TestVarlogTest_Follow(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	const (
+		clusterID         = types.ClusterID(1)
+		replicationFactor = 1
+		numLogs           = 10
+	)
+
+	vt := varlogtest.New(clusterID, replicationFactor)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	td, err := adm.AddTopic(context.Background())
+	require.NoError(t, err)
+	_, err = adm.AddStorageNode(context.Background(), types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+	_, err = adm.AddLogStream(context.Background(), td.TopicID, nil)
+	require.NoError(t, err)
+
+	appendLogs := func() {
+		for i := 0; i < numLogs; i++ {
+			res := vlg.Append(context.Background(), td.TopicID, [][]byte{nil})
+			require.NoError(t, res.Err)
+		}
+	}
+
+	// No log entry yet.
+	for _, pos := range []varlog.Position{varlog.Earliest, varlog.Latest, varlog.Now} {
+		glsn, err := vlg.ResolvePosition(context.Background(), td.TopicID, pos)
+		require.NoError(t, err)
+		require.Equal(t, types.MinGLSN, glsn)
+	}
+
+	appendLogs()
+	tcs := []struct {
+		pos      varlog.Position
+		expected types.GLSN
+	}{
+		{pos: varlog.Earliest, expected: types.MinGLSN},
+		{pos: varlog.Latest, expected: numLogs},
+		{pos: varlog.Now, expected: numLogs + 1},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.pos.String(), func(t *testing.T) {
+			glsn, err := vlg.ResolvePosition(context.Background(), td.TopicID, tc.pos)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, glsn)
+		})
+	}
+
+	_, err = vlg.ResolvePosition(context.Background(), td.TopicID, varlog.Position(0))
+	require.Error(t, err)
+
+	// Follow waits for log entries appended later.
+	glsnC := make(chan types.GLSN, numLogs)
+	closer, err := vlg.Follow(context.Background(), td.TopicID, varlog.Now, func(le varlogpb.LogEntry, err error) {
+		if err != nil {
+			return
+		}
+		glsnC <- le.GLSN
+	})
+	require.NoError(t, err)
+	appendLogs()
+	for expected := types.GLSN(numLogs + 1); expected <= 2*numLogs; expected++ {
+		require.Equal(t, expected, <-glsnC)
+	}
+	closer()
+}
+
+func TestVarlogTest_AppendTxn(t *testing.T) {
+	const (
+		clusterID         = types.ClusterID(1)
+		replicationFactor = 1
+	)
+
+	vt := varlogtest.New(clusterID, replicationFactor)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	_, err := adm.AddStorageNode(context.Background(), types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+	var tpids []types.TopicID
+	var lsids []types.LogStreamID
+	for i := 0; i < 2; i++ {
+		td, err := adm.AddTopic(context.Background())
+		require.NoError(t, err)
+		lsd, err := adm.AddLogStream(context.Background(), td.TopicID, nil)
+		require.NoError(t, err)
+		tpids = append(tpids, td.TopicID)
+		lsids = append(lsids, lsd.LogStreamID)
+	}
+
+	res := vlg.AppendTxn(context.Background(), nil)
+	require.Error(t, res.Err)
+
+	// None of the batches is appended if any of them is invalid.
+	res = vlg.AppendTxn(context.Background(), []varlog.TxnBatch{
+		{TopicID: tpids[0], Data: [][]byte{[]byte("foo")}},
+		{TopicID: tpids[1], LogStreamID: lsids[0], Data: [][]byte{[]byte("bar")}},
+	})
+	require.Error(t, res.Err)
+	for _, tpid := range tpids {
+		glsn, err := vlg.ResolvePosition(context.Background(), tpid, varlog.Now)
+		require.NoError(t, err)
+		require.Equal(t, types.MinGLSN, glsn)
+	}
+
+	res = vlg.AppendTxn(context.Background(), []varlog.TxnBatch{
+		{TopicID: tpids[0], Data: [][]byte{[]byte("foo")}},
+		{
+			TopicID:     tpids[1],
+			LogStreamID: lsids[1],
+			Data:        [][]byte{[]byte("bar"), []byte("baz")},
+			Headers:     []map[string][]byte{nil, {"key": []byte("value")}},
+		},
+	})
+	require.NoError(t, res.Err)
+	require.NotEmpty(t, res.TxnID)
+	require.Len(t, res.Results, 2)
+	require.Len(t, res.Results[0].Metadata, 1)
+	require.Len(t, res.Results[1].Metadata, 2)
+
+	le, err := vlg.Read(context.Background(), tpids[1], types.GLSN(2))
+	require.NoError(t, err)
+	require.Equal(t, []byte("baz"), le.Data)
+	require.Equal(t, map[string][]byte{"key": []byte("value")}, le.Headers)
 }
 
 func TestMain(m *testing.M) {
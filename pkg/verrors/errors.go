@@ -18,6 +18,10 @@ import (
 var (
 	ErrNoEntry        = errors.New("storage: no entry")
 	ErrCorruptStorage = errors.New("storage: corrupt")
+	// ErrChecksumMismatch means that the data of a log entry does not match
+	// its checksum, that is, the log entry is corrupted in the storage or on
+	// the wire.
+	ErrChecksumMismatch = errors.New("storage: checksum mismatch")
 )
 
 var (
@@ -26,6 +30,14 @@ var (
 	ErrCorruptLogStream = errors.New("logstream: corrupt")
 	ErrSealed           = errors.New("sealed")
 	ErrUnordered        = errors.New("logstream: unordered scanner")
+	// ErrDuplicateSequence means that an idempotent producer tried to append
+	// log entries that were already appended, but the log stream could not
+	// answer with their metadata since they are older than the last batch.
+	ErrDuplicateSequence = errors.New("logstream: duplicate sequence")
+	// ErrTxnAborted means that the metadata repository aborted the
+	// transaction of the log entries since it could not commit all log
+	// entries of the transaction within its timeout.
+	ErrTxnAborted = errors.New("logstream: transaction aborted")
 )
 
 var (
@@ -77,10 +89,11 @@ var errorRegistry = make(map[string]error)
 func init() {
 	initErrorRegistry(
 		// storage
-		ErrNoEntry, ErrCorruptStorage,
+		ErrNoEntry, ErrCorruptStorage, ErrChecksumMismatch,
 
 		// logstream
 		ErrTrimmed, ErrUndecidable, ErrCorruptLogStream, ErrSealed, ErrUnordered,
+		ErrDuplicateSequence, ErrTxnAborted,
 
 		ErrInvalidArgument, ErrAlreadyExists, ErrNotExist,
 
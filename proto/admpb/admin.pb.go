@@ -862,6 +862,108 @@ func (m *UnregisterTopicResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UnregisterTopicResponse proto.InternalMessageInfo
 
+// SetRetentionPolicyRequest represents a request to replace the retention
+// policy of a topic.
+type SetRetentionPolicyRequest struct {
+	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	// RetentionPolicy is the new retention policy of the topic. An empty or
+	// nil policy removes the retention policy.
+	RetentionPolicy *varlogpb.RetentionPolicy `protobuf:"bytes,2,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
+}
+
+func (m *SetRetentionPolicyRequest) Reset()         { *m = SetRetentionPolicyRequest{} }
+func (m *SetRetentionPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRetentionPolicyRequest) ProtoMessage()    {}
+func (*SetRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{19}
+}
+func (m *SetRetentionPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetRetentionPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetRetentionPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetRetentionPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRetentionPolicyRequest.Merge(m, src)
+}
+func (m *SetRetentionPolicyRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SetRetentionPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRetentionPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRetentionPolicyRequest proto.InternalMessageInfo
+
+func (m *SetRetentionPolicyRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *SetRetentionPolicyRequest) GetRetentionPolicy() *varlogpb.RetentionPolicy {
+	if m != nil {
+		return m.RetentionPolicy
+	}
+	return nil
+}
+
+// SetRetentionPolicyResponse represents a response of
+// SetRetentionPolicyRequest.
+type SetRetentionPolicyResponse struct {
+	Topic *varlogpb.TopicDescriptor `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic"`
+}
+
+func (m *SetRetentionPolicyResponse) Reset()         { *m = SetRetentionPolicyResponse{} }
+func (m *SetRetentionPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*SetRetentionPolicyResponse) ProtoMessage()    {}
+func (*SetRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{20}
+}
+func (m *SetRetentionPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetRetentionPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetRetentionPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetRetentionPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRetentionPolicyResponse.Merge(m, src)
+}
+func (m *SetRetentionPolicyResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SetRetentionPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRetentionPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRetentionPolicyResponse proto.InternalMessageInfo
+
+func (m *SetRetentionPolicyResponse) GetTopic() *varlogpb.TopicDescriptor {
+	if m != nil {
+		return m.Topic
+	}
+	return nil
+}
+
 type GetLogStreamRequest struct {
 	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
@@ -871,7 +973,7 @@ func (m *GetLogStreamRequest) Reset()         { *m = GetLogStreamRequest{} }
 func (m *GetLogStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLogStreamRequest) ProtoMessage()    {}
 func (*GetLogStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{19}
+	return fileDescriptor_acd58c06882c23f8, []int{21}
 }
 func (m *GetLogStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,7 +1024,7 @@ func (m *GetLogStreamResponse) Reset()         { *m = GetLogStreamResponse{} }
 func (m *GetLogStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*GetLogStreamResponse) ProtoMessage()    {}
 func (*GetLogStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{20}
+	return fileDescriptor_acd58c06882c23f8, []int{22}
 }
 func (m *GetLogStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -966,7 +1068,7 @@ func (m *ListLogStreamsRequest) Reset()         { *m = ListLogStreamsRequest{} }
 func (m *ListLogStreamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListLogStreamsRequest) ProtoMessage()    {}
 func (*ListLogStreamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{21}
+	return fileDescriptor_acd58c06882c23f8, []int{23}
 }
 func (m *ListLogStreamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1010,7 +1112,7 @@ func (m *ListLogStreamsResponse) Reset()         { *m = ListLogStreamsResponse{}
 func (m *ListLogStreamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListLogStreamsResponse) ProtoMessage()    {}
 func (*ListLogStreamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{22}
+	return fileDescriptor_acd58c06882c23f8, []int{24}
 }
 func (m *ListLogStreamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1056,7 +1158,7 @@ func (m *AddLogStreamRequest) Reset()         { *m = AddLogStreamRequest{} }
 func (m *AddLogStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*AddLogStreamRequest) ProtoMessage()    {}
 func (*AddLogStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{23}
+	return fileDescriptor_acd58c06882c23f8, []int{25}
 }
 func (m *AddLogStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1107,7 +1209,7 @@ func (m *AddLogStreamResponse) Reset()         { *m = AddLogStreamResponse{} }
 func (m *AddLogStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*AddLogStreamResponse) ProtoMessage()    {}
 func (*AddLogStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{24}
+	return fileDescriptor_acd58c06882c23f8, []int{26}
 }
 func (m *AddLogStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1162,7 +1264,7 @@ func (m *UpdateLogStreamRequest) Reset()         { *m = UpdateLogStreamRequest{}
 func (m *UpdateLogStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateLogStreamRequest) ProtoMessage()    {}
 func (*UpdateLogStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{25}
+	return fileDescriptor_acd58c06882c23f8, []int{27}
 }
 func (m *UpdateLogStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1227,7 +1329,7 @@ func (m *UpdateLogStreamResponse) Reset()         { *m = UpdateLogStreamResponse
 func (m *UpdateLogStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateLogStreamResponse) ProtoMessage()    {}
 func (*UpdateLogStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{26}
+	return fileDescriptor_acd58c06882c23f8, []int{28}
 }
 func (m *UpdateLogStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1272,7 +1374,7 @@ func (m *UnregisterLogStreamRequest) Reset()         { *m = UnregisterLogStreamR
 func (m *UnregisterLogStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*UnregisterLogStreamRequest) ProtoMessage()    {}
 func (*UnregisterLogStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{27}
+	return fileDescriptor_acd58c06882c23f8, []int{29}
 }
 func (m *UnregisterLogStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1322,7 +1424,7 @@ func (m *UnregisterLogStreamResponse) Reset()         { *m = UnregisterLogStream
 func (m *UnregisterLogStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*UnregisterLogStreamResponse) ProtoMessage()    {}
 func (*UnregisterLogStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{28}
+	return fileDescriptor_acd58c06882c23f8, []int{30}
 }
 func (m *UnregisterLogStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1361,7 +1463,7 @@ func (m *RemoveLogStreamReplicaRequest) Reset()         { *m = RemoveLogStreamRe
 func (m *RemoveLogStreamReplicaRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveLogStreamReplicaRequest) ProtoMessage()    {}
 func (*RemoveLogStreamReplicaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{29}
+	return fileDescriptor_acd58c06882c23f8, []int{31}
 }
 func (m *RemoveLogStreamReplicaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1418,7 +1520,7 @@ func (m *RemoveLogStreamReplicaResponse) Reset()         { *m = RemoveLogStreamR
 func (m *RemoveLogStreamReplicaResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveLogStreamReplicaResponse) ProtoMessage()    {}
 func (*RemoveLogStreamReplicaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{30}
+	return fileDescriptor_acd58c06882c23f8, []int{32}
 }
 func (m *RemoveLogStreamReplicaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1456,7 +1558,7 @@ func (m *SealRequest) Reset()         { *m = SealRequest{} }
 func (m *SealRequest) String() string { return proto.CompactTextString(m) }
 func (*SealRequest) ProtoMessage()    {}
 func (*SealRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{31}
+	return fileDescriptor_acd58c06882c23f8, []int{33}
 }
 func (m *SealRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1508,7 +1610,7 @@ func (m *SealResponse) Reset()         { *m = SealResponse{} }
 func (m *SealResponse) String() string { return proto.CompactTextString(m) }
 func (*SealResponse) ProtoMessage()    {}
 func (*SealResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{32}
+	return fileDescriptor_acd58c06882c23f8, []int{34}
 }
 func (m *SealResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1560,7 +1662,7 @@ func (m *UnsealRequest) Reset()         { *m = UnsealRequest{} }
 func (m *UnsealRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsealRequest) ProtoMessage()    {}
 func (*UnsealRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{33}
+	return fileDescriptor_acd58c06882c23f8, []int{35}
 }
 func (m *UnsealRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1611,7 +1713,7 @@ func (m *UnsealResponse) Reset()         { *m = UnsealResponse{} }
 func (m *UnsealResponse) String() string { return proto.CompactTextString(m) }
 func (*UnsealResponse) ProtoMessage()    {}
 func (*UnsealResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{34}
+	return fileDescriptor_acd58c06882c23f8, []int{36}
 }
 func (m *UnsealResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1658,7 +1760,7 @@ func (m *SyncRequest) Reset()         { *m = SyncRequest{} }
 func (m *SyncRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncRequest) ProtoMessage()    {}
 func (*SyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{35}
+	return fileDescriptor_acd58c06882c23f8, []int{37}
 }
 func (m *SyncRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1723,7 +1825,7 @@ func (m *SyncResponse) Reset()         { *m = SyncResponse{} }
 func (m *SyncResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncResponse) ProtoMessage()    {}
 func (*SyncResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{36}
+	return fileDescriptor_acd58c06882c23f8, []int{38}
 }
 func (m *SyncResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1768,7 +1870,7 @@ func (m *TrimRequest) Reset()         { *m = TrimRequest{} }
 func (m *TrimRequest) String() string { return proto.CompactTextString(m) }
 func (*TrimRequest) ProtoMessage()    {}
 func (*TrimRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{37}
+	return fileDescriptor_acd58c06882c23f8, []int{39}
 }
 func (m *TrimRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1821,7 +1923,7 @@ func (m *TrimResult) Reset()         { *m = TrimResult{} }
 func (m *TrimResult) String() string { return proto.CompactTextString(m) }
 func (*TrimResult) ProtoMessage()    {}
 func (*TrimResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{38}
+	return fileDescriptor_acd58c06882c23f8, []int{40}
 }
 func (m *TrimResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1879,7 +1981,7 @@ func (m *TrimResponse) Reset()         { *m = TrimResponse{} }
 func (m *TrimResponse) String() string { return proto.CompactTextString(m) }
 func (*TrimResponse) ProtoMessage()    {}
 func (*TrimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{39}
+	return fileDescriptor_acd58c06882c23f8, []int{41}
 }
 func (m *TrimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,22 +2017,33 @@ func (m *TrimResponse) GetResults() []TrimResult {
 	return nil
 }
 
-type GetMetadataRepositoryNodeRequest struct {
-	NodeID github_com_kakao_varlog_pkg_types.NodeID `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.NodeID" json:"node_id,omitempty"`
-}
-
-func (m *GetMetadataRepositoryNodeRequest) Reset()         { *m = GetMetadataRepositoryNodeRequest{} }
-func (m *GetMetadataRepositoryNodeRequest) String() string { return proto.CompactTextString(m) }
-func (*GetMetadataRepositoryNodeRequest) ProtoMessage()    {}
-func (*GetMetadataRepositoryNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{40}
+// BackupReplica is the copy of a log stream replica in a backup.
+type BackupReplica struct {
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storageNodeId"`
+	TopicID       github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId"`
+	LogStreamID   github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,3,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"logStreamId"`
+	// Path is the directory of the copy relative to the backup directory.
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path"`
+	// CommitResult is the last commit result for the log stream in the backup.
+	CommitResult snpb.LogStreamCommitResult `protobuf:"bytes,5,opt,name=commit_result,json=commitResult,proto3" json:"commitResult"`
+	// ColdTierPrefix is the prefix in the cold tier under which segments of the
+	// copy are. The backup owns the segments; thus, they are not deleted by the
+	// log stream replica, and they should be deleted together with the backup.
+	ColdTierPrefix string `protobuf:"bytes,6,opt,name=cold_tier_prefix,json=coldTierPrefix,proto3" json:"coldTierPrefix"`
+}
+
+func (m *BackupReplica) Reset()         { *m = BackupReplica{} }
+func (m *BackupReplica) String() string { return proto.CompactTextString(m) }
+func (*BackupReplica) ProtoMessage()    {}
+func (*BackupReplica) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{42}
 }
-func (m *GetMetadataRepositoryNodeRequest) XXX_Unmarshal(b []byte) error {
+func (m *BackupReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetMetadataRepositoryNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BackupReplica) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetMetadataRepositoryNodeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BackupReplica.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1940,41 +2053,89 @@ func (m *GetMetadataRepositoryNodeRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *GetMetadataRepositoryNodeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMetadataRepositoryNodeRequest.Merge(m, src)
+func (m *BackupReplica) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupReplica.Merge(m, src)
 }
-func (m *GetMetadataRepositoryNodeRequest) XXX_Size() int {
+func (m *BackupReplica) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *GetMetadataRepositoryNodeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMetadataRepositoryNodeRequest.DiscardUnknown(m)
+func (m *BackupReplica) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupReplica.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMetadataRepositoryNodeRequest proto.InternalMessageInfo
+var xxx_messageInfo_BackupReplica proto.InternalMessageInfo
 
-func (m *GetMetadataRepositoryNodeRequest) GetNodeID() github_com_kakao_varlog_pkg_types.NodeID {
+func (m *BackupReplica) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
 	if m != nil {
-		return m.NodeID
+		return m.StorageNodeID
 	}
 	return 0
 }
 
-type GetMetadataRepositoryNodeResponse struct {
-	Node *varlogpb.MetadataRepositoryNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+func (m *BackupReplica) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
 }
 
-func (m *GetMetadataRepositoryNodeResponse) Reset()         { *m = GetMetadataRepositoryNodeResponse{} }
-func (m *GetMetadataRepositoryNodeResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMetadataRepositoryNodeResponse) ProtoMessage()    {}
-func (*GetMetadataRepositoryNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{41}
+func (m *BackupReplica) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
 }
-func (m *GetMetadataRepositoryNodeResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *BackupReplica) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *BackupReplica) GetCommitResult() snpb.LogStreamCommitResult {
+	if m != nil {
+		return m.CommitResult
+	}
+	return snpb.LogStreamCommitResult{}
+}
+
+func (m *BackupReplica) GetColdTierPrefix() string {
+	if m != nil {
+		return m.ColdTierPrefix
+	}
+	return ""
+}
+
+// BackupManifest describes a backup of the cluster. It is stored in the
+// backup directory together with the state machine of the metadata
+// repository and copies of all log stream replicas.
+type BackupManifest struct {
+	ClusterID github_com_kakao_varlog_pkg_types.ClusterID `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"clusterId"`
+	// Version is the version of the last commit in the backup.
+	Version github_com_kakao_varlog_pkg_types.Version `protobuf:"varint,2,opt,name=version,proto3,casttype=github.com/kakao/varlog/pkg/types.Version" json:"version"`
+	// AppliedIndex is the raft index at which the metadata repository took the
+	// backup.
+	AppliedIndex uint64    `protobuf:"varint,3,opt,name=applied_index,json=appliedIndex,proto3" json:"appliedIndex"`
+	CreateTime   time.Time `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3,stdtime" json:"createTime"`
+	// MetadataRepository is the file of the state machine of the metadata
+	// repository relative to the backup directory.
+	MetadataRepository string          `protobuf:"bytes,5,opt,name=metadata_repository,json=metadataRepository,proto3" json:"metadataRepository"`
+	Replicas           []BackupReplica `protobuf:"bytes,6,rep,name=replicas,proto3" json:"replicas"`
+}
+
+func (m *BackupManifest) Reset()         { *m = BackupManifest{} }
+func (m *BackupManifest) String() string { return proto.CompactTextString(m) }
+func (*BackupManifest) ProtoMessage()    {}
+func (*BackupManifest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{43}
+}
+func (m *BackupManifest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetMetadataRepositoryNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BackupManifest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetMetadataRepositoryNodeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BackupManifest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1984,40 +2145,80 @@ func (m *GetMetadataRepositoryNodeResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *GetMetadataRepositoryNodeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMetadataRepositoryNodeResponse.Merge(m, src)
+func (m *BackupManifest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupManifest.Merge(m, src)
 }
-func (m *GetMetadataRepositoryNodeResponse) XXX_Size() int {
+func (m *BackupManifest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *GetMetadataRepositoryNodeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMetadataRepositoryNodeResponse.DiscardUnknown(m)
+func (m *BackupManifest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupManifest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMetadataRepositoryNodeResponse proto.InternalMessageInfo
+var xxx_messageInfo_BackupManifest proto.InternalMessageInfo
 
-func (m *GetMetadataRepositoryNodeResponse) GetNode() *varlogpb.MetadataRepositoryNode {
+func (m *BackupManifest) GetClusterID() github_com_kakao_varlog_pkg_types.ClusterID {
 	if m != nil {
-		return m.Node
+		return m.ClusterID
+	}
+	return 0
+}
+
+func (m *BackupManifest) GetVersion() github_com_kakao_varlog_pkg_types.Version {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *BackupManifest) GetAppliedIndex() uint64 {
+	if m != nil {
+		return m.AppliedIndex
+	}
+	return 0
+}
+
+func (m *BackupManifest) GetCreateTime() time.Time {
+	if m != nil {
+		return m.CreateTime
+	}
+	return time.Time{}
+}
+
+func (m *BackupManifest) GetMetadataRepository() string {
+	if m != nil {
+		return m.MetadataRepository
+	}
+	return ""
+}
+
+func (m *BackupManifest) GetReplicas() []BackupReplica {
+	if m != nil {
+		return m.Replicas
 	}
 	return nil
 }
 
-type ListMetadataRepositoryNodesRequest struct {
+type BackupRequest struct {
+	// Path is the directory to store the backup. It must not exist, and it must
+	// be in a file system shared by the admin server and all storage nodes,
+	// mounted at the same absolute path on every node, since each storage node
+	// writes copies of its log stream replicas into the directory.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path"`
 }
 
-func (m *ListMetadataRepositoryNodesRequest) Reset()         { *m = ListMetadataRepositoryNodesRequest{} }
-func (m *ListMetadataRepositoryNodesRequest) String() string { return proto.CompactTextString(m) }
-func (*ListMetadataRepositoryNodesRequest) ProtoMessage()    {}
-func (*ListMetadataRepositoryNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{42}
+func (m *BackupRequest) Reset()         { *m = BackupRequest{} }
+func (m *BackupRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()    {}
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{44}
 }
-func (m *ListMetadataRepositoryNodesRequest) XXX_Unmarshal(b []byte) error {
+func (m *BackupRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListMetadataRepositoryNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListMetadataRepositoryNodesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BackupRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2027,34 +2228,41 @@ func (m *ListMetadataRepositoryNodesRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *ListMetadataRepositoryNodesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListMetadataRepositoryNodesRequest.Merge(m, src)
+func (m *BackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupRequest.Merge(m, src)
 }
-func (m *ListMetadataRepositoryNodesRequest) XXX_Size() int {
+func (m *BackupRequest) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *ListMetadataRepositoryNodesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListMetadataRepositoryNodesRequest.DiscardUnknown(m)
+func (m *BackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListMetadataRepositoryNodesRequest proto.InternalMessageInfo
+var xxx_messageInfo_BackupRequest proto.InternalMessageInfo
 
-type ListMetadataRepositoryNodesResponse struct {
-	Nodes []varlogpb.MetadataRepositoryNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes"`
+func (m *BackupRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
 }
 
-func (m *ListMetadataRepositoryNodesResponse) Reset()         { *m = ListMetadataRepositoryNodesResponse{} }
-func (m *ListMetadataRepositoryNodesResponse) String() string { return proto.CompactTextString(m) }
-func (*ListMetadataRepositoryNodesResponse) ProtoMessage()    {}
-func (*ListMetadataRepositoryNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{43}
+type BackupResponse struct {
+	Manifest BackupManifest `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest"`
 }
-func (m *ListMetadataRepositoryNodesResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *BackupResponse) Reset()         { *m = BackupResponse{} }
+func (m *BackupResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupResponse) ProtoMessage()    {}
+func (*BackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{45}
+}
+func (m *BackupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListMetadataRepositoryNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListMetadataRepositoryNodesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BackupResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2064,38 +2272,206 @@ func (m *ListMetadataRepositoryNodesResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *ListMetadataRepositoryNodesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListMetadataRepositoryNodesResponse.Merge(m, src)
+func (m *BackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupResponse.Merge(m, src)
 }
-func (m *ListMetadataRepositoryNodesResponse) XXX_Size() int {
+func (m *BackupResponse) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *ListMetadataRepositoryNodesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListMetadataRepositoryNodesResponse.DiscardUnknown(m)
+func (m *BackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListMetadataRepositoryNodesResponse proto.InternalMessageInfo
+var xxx_messageInfo_BackupResponse proto.InternalMessageInfo
 
-func (m *ListMetadataRepositoryNodesResponse) GetNodes() []varlogpb.MetadataRepositoryNode {
+func (m *BackupResponse) GetManifest() BackupManifest {
 	if m != nil {
-		return m.Nodes
+		return m.Manifest
 	}
-	return nil
+	return BackupManifest{}
 }
 
-type GetMRMembersResponse struct {
-	Leader            github_com_kakao_varlog_pkg_types.NodeID            `protobuf:"varint,1,opt,name=leader,proto3,casttype=github.com/kakao/varlog/pkg/types.NodeID" json:"leader,omitempty"`
-	ReplicationFactor int32                                               `protobuf:"varint,2,opt,name=replication_factor,json=replicationFactor,proto3" json:"replicationFactor,omitempty"`
-	Members           map[github_com_kakao_varlog_pkg_types.NodeID]string `protobuf:"bytes,3,rep,name=members,proto3,castkey=github.com/kakao/varlog/pkg/types.NodeID" json:"members,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+type GetMetadataRepositoryNodeRequest struct {
+	NodeID github_com_kakao_varlog_pkg_types.NodeID `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.NodeID" json:"node_id,omitempty"`
 }
 
-func (m *GetMRMembersResponse) Reset()         { *m = GetMRMembersResponse{} }
-func (m *GetMRMembersResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMRMembersResponse) ProtoMessage()    {}
-func (*GetMRMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{44}
+func (m *GetMetadataRepositoryNodeRequest) Reset()         { *m = GetMetadataRepositoryNodeRequest{} }
+func (m *GetMetadataRepositoryNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMetadataRepositoryNodeRequest) ProtoMessage()    {}
+func (*GetMetadataRepositoryNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{46}
 }
-func (m *GetMRMembersResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetMetadataRepositoryNodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMetadataRepositoryNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMetadataRepositoryNodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetMetadataRepositoryNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadataRepositoryNodeRequest.Merge(m, src)
+}
+func (m *GetMetadataRepositoryNodeRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetMetadataRepositoryNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadataRepositoryNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetadataRepositoryNodeRequest proto.InternalMessageInfo
+
+func (m *GetMetadataRepositoryNodeRequest) GetNodeID() github_com_kakao_varlog_pkg_types.NodeID {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+type GetMetadataRepositoryNodeResponse struct {
+	Node *varlogpb.MetadataRepositoryNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (m *GetMetadataRepositoryNodeResponse) Reset()         { *m = GetMetadataRepositoryNodeResponse{} }
+func (m *GetMetadataRepositoryNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMetadataRepositoryNodeResponse) ProtoMessage()    {}
+func (*GetMetadataRepositoryNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{47}
+}
+func (m *GetMetadataRepositoryNodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMetadataRepositoryNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMetadataRepositoryNodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetMetadataRepositoryNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadataRepositoryNodeResponse.Merge(m, src)
+}
+func (m *GetMetadataRepositoryNodeResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetMetadataRepositoryNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadataRepositoryNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetadataRepositoryNodeResponse proto.InternalMessageInfo
+
+func (m *GetMetadataRepositoryNodeResponse) GetNode() *varlogpb.MetadataRepositoryNode {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+type ListMetadataRepositoryNodesRequest struct {
+}
+
+func (m *ListMetadataRepositoryNodesRequest) Reset()         { *m = ListMetadataRepositoryNodesRequest{} }
+func (m *ListMetadataRepositoryNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMetadataRepositoryNodesRequest) ProtoMessage()    {}
+func (*ListMetadataRepositoryNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{48}
+}
+func (m *ListMetadataRepositoryNodesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListMetadataRepositoryNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListMetadataRepositoryNodesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListMetadataRepositoryNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMetadataRepositoryNodesRequest.Merge(m, src)
+}
+func (m *ListMetadataRepositoryNodesRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListMetadataRepositoryNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMetadataRepositoryNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMetadataRepositoryNodesRequest proto.InternalMessageInfo
+
+type ListMetadataRepositoryNodesResponse struct {
+	Nodes []varlogpb.MetadataRepositoryNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes"`
+}
+
+func (m *ListMetadataRepositoryNodesResponse) Reset()         { *m = ListMetadataRepositoryNodesResponse{} }
+func (m *ListMetadataRepositoryNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMetadataRepositoryNodesResponse) ProtoMessage()    {}
+func (*ListMetadataRepositoryNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{49}
+}
+func (m *ListMetadataRepositoryNodesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListMetadataRepositoryNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListMetadataRepositoryNodesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListMetadataRepositoryNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMetadataRepositoryNodesResponse.Merge(m, src)
+}
+func (m *ListMetadataRepositoryNodesResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListMetadataRepositoryNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMetadataRepositoryNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMetadataRepositoryNodesResponse proto.InternalMessageInfo
+
+func (m *ListMetadataRepositoryNodesResponse) GetNodes() []varlogpb.MetadataRepositoryNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type GetMRMembersResponse struct {
+	Leader            github_com_kakao_varlog_pkg_types.NodeID            `protobuf:"varint,1,opt,name=leader,proto3,casttype=github.com/kakao/varlog/pkg/types.NodeID" json:"leader,omitempty"`
+	ReplicationFactor int32                                               `protobuf:"varint,2,opt,name=replication_factor,json=replicationFactor,proto3" json:"replicationFactor,omitempty"`
+	Members           map[github_com_kakao_varlog_pkg_types.NodeID]string `protobuf:"bytes,3,rep,name=members,proto3,castkey=github.com/kakao/varlog/pkg/types.NodeID" json:"members,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *GetMRMembersResponse) Reset()         { *m = GetMRMembersResponse{} }
+func (m *GetMRMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMRMembersResponse) ProtoMessage()    {}
+func (*GetMRMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{50}
+}
+func (m *GetMRMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 func (m *GetMRMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
@@ -2152,7 +2528,7 @@ func (m *AddMetadataRepositoryNodeRequest) Reset()         { *m = AddMetadataRep
 func (m *AddMetadataRepositoryNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*AddMetadataRepositoryNodeRequest) ProtoMessage()    {}
 func (*AddMetadataRepositoryNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{45}
+	return fileDescriptor_acd58c06882c23f8, []int{51}
 }
 func (m *AddMetadataRepositoryNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2203,7 +2579,7 @@ func (m *AddMetadataRepositoryNodeResponse) Reset()         { *m = AddMetadataRe
 func (m *AddMetadataRepositoryNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*AddMetadataRepositoryNodeResponse) ProtoMessage()    {}
 func (*AddMetadataRepositoryNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{46}
+	return fileDescriptor_acd58c06882c23f8, []int{52}
 }
 func (m *AddMetadataRepositoryNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2248,7 +2624,7 @@ func (m *AddMRPeerRequest) Reset()         { *m = AddMRPeerRequest{} }
 func (m *AddMRPeerRequest) String() string { return proto.CompactTextString(m) }
 func (*AddMRPeerRequest) ProtoMessage()    {}
 func (*AddMRPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{47}
+	return fileDescriptor_acd58c06882c23f8, []int{53}
 }
 func (m *AddMRPeerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2299,7 +2675,7 @@ func (m *AddMRPeerResponse) Reset()         { *m = AddMRPeerResponse{} }
 func (m *AddMRPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*AddMRPeerResponse) ProtoMessage()    {}
 func (*AddMRPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{48}
+	return fileDescriptor_acd58c06882c23f8, []int{54}
 }
 func (m *AddMRPeerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2343,7 +2719,7 @@ func (m *DeleteMetadataRepositoryNodeRequest) Reset()         { *m = DeleteMetad
 func (m *DeleteMetadataRepositoryNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteMetadataRepositoryNodeRequest) ProtoMessage()    {}
 func (*DeleteMetadataRepositoryNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{49}
+	return fileDescriptor_acd58c06882c23f8, []int{55}
 }
 func (m *DeleteMetadataRepositoryNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2386,7 +2762,7 @@ func (m *DeleteMetadataRepositoryNodeResponse) Reset()         { *m = DeleteMeta
 func (m *DeleteMetadataRepositoryNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteMetadataRepositoryNodeResponse) ProtoMessage()    {}
 func (*DeleteMetadataRepositoryNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{50}
+	return fileDescriptor_acd58c06882c23f8, []int{56}
 }
 func (m *DeleteMetadataRepositoryNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2423,7 +2799,7 @@ func (m *RemoveMRPeerRequest) Reset()         { *m = RemoveMRPeerRequest{} }
 func (m *RemoveMRPeerRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveMRPeerRequest) ProtoMessage()    {}
 func (*RemoveMRPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{51}
+	return fileDescriptor_acd58c06882c23f8, []int{57}
 }
 func (m *RemoveMRPeerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2466,7 +2842,7 @@ func (m *RemoveMRPeerResponse) Reset()         { *m = RemoveMRPeerResponse{} }
 func (m *RemoveMRPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveMRPeerResponse) ProtoMessage()    {}
 func (*RemoveMRPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{52}
+	return fileDescriptor_acd58c06882c23f8, []int{58}
 }
 func (m *RemoveMRPeerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2495,684 +2871,749 @@ func (m *RemoveMRPeerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RemoveMRPeerResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*StorageNodeMetadata)(nil), "varlog.admpb.StorageNodeMetadata")
-	proto.RegisterType((*GetStorageNodeRequest)(nil), "varlog.admpb.GetStorageNodeRequest")
-	proto.RegisterType((*GetStorageNodeResponse)(nil), "varlog.admpb.GetStorageNodeResponse")
-	proto.RegisterType((*ListStorageNodesRequest)(nil), "varlog.admpb.ListStorageNodesRequest")
-	proto.RegisterType((*ListStorageNodesResponse)(nil), "varlog.admpb.ListStorageNodesResponse")
-	proto.RegisterType((*AddStorageNodeRequest)(nil), "varlog.admpb.AddStorageNodeRequest")
-	proto.RegisterType((*AddStorageNodeResponse)(nil), "varlog.admpb.AddStorageNodeResponse")
-	proto.RegisterType((*UnregisterStorageNodeRequest)(nil), "varlog.admpb.UnregisterStorageNodeRequest")
-	proto.RegisterType((*UnregisterStorageNodeResponse)(nil), "varlog.admpb.UnregisterStorageNodeResponse")
-	proto.RegisterType((*GetTopicRequest)(nil), "varlog.admpb.GetTopicRequest")
-	proto.RegisterType((*GetTopicResponse)(nil), "varlog.admpb.GetTopicResponse")
-	proto.RegisterType((*DescribeTopicRequest)(nil), "varlog.admpb.DescribeTopicRequest")
-	proto.RegisterType((*DescribeTopicResponse)(nil), "varlog.admpb.DescribeTopicResponse")
-	proto.RegisterType((*ListTopicsRequest)(nil), "varlog.admpb.ListTopicsRequest")
-	proto.RegisterType((*ListTopicsResponse)(nil), "varlog.admpb.ListTopicsResponse")
-	proto.RegisterType((*AddTopicRequest)(nil), "varlog.admpb.AddTopicRequest")
-	proto.RegisterType((*AddTopicResponse)(nil), "varlog.admpb.AddTopicResponse")
-	proto.RegisterType((*UnregisterTopicRequest)(nil), "varlog.admpb.UnregisterTopicRequest")
-	proto.RegisterType((*UnregisterTopicResponse)(nil), "varlog.admpb.UnregisterTopicResponse")
-	proto.RegisterType((*GetLogStreamRequest)(nil), "varlog.admpb.GetLogStreamRequest")
-	proto.RegisterType((*GetLogStreamResponse)(nil), "varlog.admpb.GetLogStreamResponse")
-	proto.RegisterType((*ListLogStreamsRequest)(nil), "varlog.admpb.ListLogStreamsRequest")
-	proto.RegisterType((*ListLogStreamsResponse)(nil), "varlog.admpb.ListLogStreamsResponse")
-	proto.RegisterType((*AddLogStreamRequest)(nil), "varlog.admpb.AddLogStreamRequest")
-	proto.RegisterType((*AddLogStreamResponse)(nil), "varlog.admpb.AddLogStreamResponse")
-	proto.RegisterType((*UpdateLogStreamRequest)(nil), "varlog.admpb.UpdateLogStreamRequest")
-	proto.RegisterType((*UpdateLogStreamResponse)(nil), "varlog.admpb.UpdateLogStreamResponse")
-	proto.RegisterType((*UnregisterLogStreamRequest)(nil), "varlog.admpb.UnregisterLogStreamRequest")
-	proto.RegisterType((*UnregisterLogStreamResponse)(nil), "varlog.admpb.UnregisterLogStreamResponse")
-	proto.RegisterType((*RemoveLogStreamReplicaRequest)(nil), "varlog.admpb.RemoveLogStreamReplicaRequest")
-	proto.RegisterType((*RemoveLogStreamReplicaResponse)(nil), "varlog.admpb.RemoveLogStreamReplicaResponse")
-	proto.RegisterType((*SealRequest)(nil), "varlog.admpb.SealRequest")
-	proto.RegisterType((*SealResponse)(nil), "varlog.admpb.SealResponse")
-	proto.RegisterType((*UnsealRequest)(nil), "varlog.admpb.UnsealRequest")
-	proto.RegisterType((*UnsealResponse)(nil), "varlog.admpb.UnsealResponse")
-	proto.RegisterType((*SyncRequest)(nil), "varlog.admpb.SyncRequest")
-	proto.RegisterType((*SyncResponse)(nil), "varlog.admpb.SyncResponse")
-	proto.RegisterType((*TrimRequest)(nil), "varlog.admpb.TrimRequest")
-	proto.RegisterType((*TrimResult)(nil), "varlog.admpb.TrimResult")
-	proto.RegisterType((*TrimResponse)(nil), "varlog.admpb.TrimResponse")
-	proto.RegisterType((*GetMetadataRepositoryNodeRequest)(nil), "varlog.admpb.GetMetadataRepositoryNodeRequest")
-	proto.RegisterType((*GetMetadataRepositoryNodeResponse)(nil), "varlog.admpb.GetMetadataRepositoryNodeResponse")
-	proto.RegisterType((*ListMetadataRepositoryNodesRequest)(nil), "varlog.admpb.ListMetadataRepositoryNodesRequest")
-	proto.RegisterType((*ListMetadataRepositoryNodesResponse)(nil), "varlog.admpb.ListMetadataRepositoryNodesResponse")
-	proto.RegisterType((*GetMRMembersResponse)(nil), "varlog.admpb.GetMRMembersResponse")
-	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.NodeID]string)(nil), "varlog.admpb.GetMRMembersResponse.MembersEntry")
-	proto.RegisterType((*AddMetadataRepositoryNodeRequest)(nil), "varlog.admpb.AddMetadataRepositoryNodeRequest")
-	proto.RegisterType((*AddMetadataRepositoryNodeResponse)(nil), "varlog.admpb.AddMetadataRepositoryNodeResponse")
-	proto.RegisterType((*AddMRPeerRequest)(nil), "varlog.admpb.AddMRPeerRequest")
-	proto.RegisterType((*AddMRPeerResponse)(nil), "varlog.admpb.AddMRPeerResponse")
-	proto.RegisterType((*DeleteMetadataRepositoryNodeRequest)(nil), "varlog.admpb.DeleteMetadataRepositoryNodeRequest")
-	proto.RegisterType((*DeleteMetadataRepositoryNodeResponse)(nil), "varlog.admpb.DeleteMetadataRepositoryNodeResponse")
-	proto.RegisterType((*RemoveMRPeerRequest)(nil), "varlog.admpb.RemoveMRPeerRequest")
-	proto.RegisterType((*RemoveMRPeerResponse)(nil), "varlog.admpb.RemoveMRPeerResponse")
+type ConsumerGroupHeartbeatRequest struct {
+	Group    string                                    `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	TopicID  github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId"`
+	MemberID string                                    `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"memberId"`
+	// OwnedLogStreamIDs are log streams the member consumes now.
+	OwnedLogStreamIDs []github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,4,rep,packed,name=owned_log_stream_ids,json=ownedLogStreamIds,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"ownedLogStreamIds"`
+	// Generation is the generation of the last assignment the member received,
+	// or zero if the member joins the group.
+	Generation uint64 `protobuf:"varint,5,opt,name=generation,proto3" json:"generation,omitempty"`
 }
 
-func init() { proto.RegisterFile("proto/admpb/admin.proto", fileDescriptor_acd58c06882c23f8) }
-
-var fileDescriptor_acd58c06882c23f8 = []byte{
-	// 2161 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4b, 0x73, 0xdb, 0xc6,
-	0x99, 0xa0, 0x64, 0x3d, 0x3e, 0xea, 0xe5, 0xa5, 0x5e, 0x86, 0x2c, 0x41, 0x81, 0x14, 0xd7, 0x4e,
-	0x1d, 0xb2, 0x71, 0x67, 0x3a, 0x1e, 0xb7, 0x99, 0x44, 0xb4, 0x1c, 0xd9, 0x8d, 0x64, 0xa7, 0xa0,
-	0x35, 0x9d, 0x24, 0x8d, 0x19, 0x90, 0x58, 0xd1, 0xac, 0x40, 0x02, 0xc5, 0x82, 0x6e, 0x74, 0x68,
-	0xa7, 0xd3, 0x69, 0xa7, 0x97, 0x1e, 0xf2, 0x13, 0x32, 0xbd, 0xf6, 0xd2, 0x63, 0x0e, 0xfd, 0x01,
-	0x9e, 0x1e, 0x3a, 0xbe, 0xb5, 0x97, 0x22, 0x53, 0xf9, 0xd2, 0xe1, 0xbd, 0x97, 0x9c, 0x3a, 0xd8,
-	0x5d, 0x00, 0x8b, 0x07, 0x49, 0x49, 0xb1, 0x9a, 0x19, 0x5d, 0x44, 0x00, 0xfb, 0xbd, 0x5f, 0xfb,
-	0xed, 0xb7, 0x82, 0x25, 0xdb, 0xb1, 0x5c, 0xab, 0xac, 0x1b, 0x6d, 0xbb, 0xee, 0xff, 0x6d, 0x75,
-	0x4a, 0xf4, 0x0b, 0x9a, 0x7a, 0xa6, 0x3b, 0xa6, 0xd5, 0x2c, 0xd1, 0x15, 0xf9, 0xcd, 0x66, 0xcb,
-	0x7d, 0xda, 0xad, 0x97, 0x1a, 0x56, 0xbb, 0xdc, 0xb4, 0x9a, 0x56, 0x99, 0x02, 0xd5, 0xbb, 0x07,
-	0xf4, 0x8d, 0xd1, 0xf0, 0x9f, 0x18, 0xb2, 0xac, 0x34, 0x2d, 0xab, 0x69, 0xe2, 0x08, 0xca, 0x6d,
-	0xb5, 0x31, 0x71, 0xf5, 0xb6, 0xcd, 0x01, 0x56, 0x92, 0x00, 0xb8, 0x6d, 0xbb, 0x47, 0x7c, 0x71,
-	0x89, 0xb1, 0xb6, 0xeb, 0xe5, 0x36, 0x76, 0x75, 0x43, 0x77, 0x75, 0xbe, 0xb0, 0x40, 0x3a, 0x76,
-	0xbd, 0xec, 0x60, 0xdb, 0x6c, 0x35, 0x74, 0xd7, 0x72, 0xf8, 0xe7, 0x22, 0xfd, 0x1c, 0x87, 0x55,
-	0xbf, 0xcc, 0x43, 0xb1, 0xea, 0x5a, 0x8e, 0xde, 0xc4, 0x0f, 0x2d, 0x03, 0xef, 0xf1, 0x55, 0xf4,
-	0x31, 0x4c, 0x11, 0xf6, 0xb9, 0xd6, 0xb1, 0x0c, 0xbc, 0x2c, 0xad, 0x4b, 0xd7, 0x0b, 0xb7, 0xde,
-	0x28, 0x71, 0x75, 0x7d, 0x52, 0xa5, 0x0c, 0xbc, 0x6d, 0x4c, 0x1a, 0x4e, 0xcb, 0x76, 0x2d, 0xa7,
-	0x32, 0xf5, 0xdc, 0x53, 0x72, 0x2f, 0x3c, 0x45, 0xea, 0x79, 0x4a, 0x4e, 0x2b, 0x90, 0x08, 0x18,
-	0x55, 0xa1, 0xd0, 0x70, 0xb0, 0xee, 0xe2, 0x9a, 0xaf, 0xf0, 0x72, 0x9e, 0xd2, 0x96, 0x4b, 0x4c,
-	0xd9, 0x52, 0xa0, 0x6c, 0xe9, 0x71, 0x60, 0x8d, 0xca, 0xa2, 0x4f, 0xab, 0xe7, 0x29, 0xc0, 0xd0,
-	0xfc, 0x85, 0xcf, 0xbf, 0x52, 0x24, 0x4d, 0x78, 0x47, 0x2d, 0x28, 0x9a, 0x3a, 0x71, 0x6b, 0x4f,
-	0xb1, 0xee, 0xb8, 0x75, 0xac, 0xbb, 0x8c, 0xf8, 0xc8, 0x50, 0xe2, 0xab, 0x9c, 0xf8, 0x65, 0x1f,
-	0xfd, 0x7e, 0x80, 0x1d, 0xf2, 0x48, 0x7f, 0xbe, 0x33, 0xfa, 0x9f, 0x2f, 0x14, 0x49, 0xfd, 0xbd,
-	0x04, 0x0b, 0x3b, 0xd8, 0x15, 0xac, 0xa0, 0xe1, 0x5f, 0x74, 0x31, 0x71, 0x91, 0x09, 0xb3, 0xa2,
-	0xf1, 0x6a, 0x2d, 0x83, 0xda, 0xef, 0x52, 0x65, 0xfb, 0xd8, 0x53, 0xa6, 0x05, 0x84, 0x07, 0xdb,
-	0x5f, 0x7b, 0x4a, 0x59, 0x08, 0x9a, 0x43, 0xfd, 0x50, 0xb7, 0xca, 0xcc, 0xc8, 0x65, 0xfb, 0xb0,
-	0x59, 0x76, 0x8f, 0x6c, 0x4c, 0x4a, 0x31, 0x14, 0x6d, 0x5a, 0xb0, 0xe5, 0x03, 0x43, 0xb5, 0x60,
-	0x31, 0x29, 0x06, 0xb1, 0xad, 0x0e, 0xc1, 0x68, 0x3f, 0xd3, 0x89, 0xaf, 0x95, 0xc4, 0x98, 0xcd,
-	0xf2, 0x62, 0x65, 0xb6, 0xe7, 0x29, 0xa2, 0xc7, 0x62, 0xee, 0x53, 0xaf, 0xc0, 0xd2, 0x6e, 0x8b,
-	0x88, 0x1c, 0x09, 0xd7, 0x5c, 0xfd, 0x0c, 0x96, 0xd3, 0x4b, 0x5c, 0x9a, 0x9f, 0xc1, 0xb4, 0x28,
-	0x0d, 0x59, 0x96, 0xd6, 0x47, 0x4e, 0x26, 0xce, 0x3c, 0xf7, 0xd0, 0x14, 0x11, 0xe9, 0xc6, 0xde,
-	0xd4, 0x27, 0xb0, 0xb0, 0x65, 0x18, 0x19, 0xce, 0xb8, 0x97, 0x69, 0x84, 0xab, 0x01, 0xd7, 0x20,
-	0x89, 0x44, 0xc6, 0x95, 0xd1, 0xe7, 0xc9, 0x98, 0xf5, 0xad, 0x9c, 0xa4, 0x7f, 0xbe, 0x56, 0xfe,
-	0xa3, 0x04, 0x57, 0xf7, 0x3b, 0x0e, 0x6e, 0xb6, 0x88, 0x8b, 0x9d, 0x6f, 0x3d, 0xca, 0x14, 0x58,
-	0xed, 0x23, 0x0d, 0x33, 0x83, 0x7a, 0x00, 0xb3, 0x3b, 0xd8, 0x7d, 0x6c, 0xd9, 0xad, 0x46, 0x20,
-	0x61, 0x15, 0x26, 0x5c, 0xff, 0x3d, 0x12, 0xed, 0xf6, 0xb1, 0xa7, 0x8c, 0x53, 0x18, 0x2a, 0xd4,
-	0x8d, 0xe1, 0x42, 0x71, 0x60, 0x6d, 0x9c, 0x52, 0x7a, 0x60, 0xa8, 0xfb, 0x30, 0x17, 0xf1, 0xe1,
-	0x2e, 0xd8, 0x82, 0x4b, 0x74, 0x99, 0xdb, 0x7e, 0x3d, 0xe5, 0x5c, 0x0a, 0x2e, 0x14, 0xa7, 0xc9,
-	0x9e, 0xa7, 0x30, 0x14, 0x8d, 0xfd, 0xa8, 0x87, 0x30, 0xcf, 0xd6, 0xeb, 0xf8, 0xfc, 0x75, 0xf8,
-	0x93, 0x04, 0x0b, 0x09, 0x6e, 0x5c, 0x93, 0x1f, 0x9d, 0x56, 0x13, 0x16, 0xaa, 0x0c, 0x09, 0xbd,
-	0x0f, 0x05, 0xd3, 0x6a, 0xd6, 0x88, 0xeb, 0x60, 0xbd, 0x4d, 0x96, 0xf3, 0x34, 0xc1, 0x36, 0x53,
-	0x34, 0x76, 0xad, 0x66, 0x95, 0x82, 0xa4, 0xe8, 0x80, 0x19, 0x2c, 0x11, 0xb5, 0x08, 0x97, 0xfd,
-	0x5c, 0xa6, 0x0c, 0xc3, 0x04, 0x7f, 0x02, 0x48, 0xfc, 0xc8, 0xa5, 0xbe, 0x0f, 0x63, 0x54, 0x80,
-	0x20, 0xa7, 0x87, 0x8b, 0x3d, 0xc3, 0x53, 0x9a, 0xe3, 0x69, 0xfc, 0x57, 0xbd, 0x0c, 0xb3, 0x5b,
-	0x86, 0x21, 0x7a, 0xc0, 0x77, 0x78, 0xf4, 0xe9, 0xd5, 0x39, 0xbc, 0x0d, 0x8b, 0x51, 0x40, 0x9f,
-	0xbf, 0xcb, 0xaf, 0xc0, 0x52, 0x8a, 0x1d, 0xcf, 0x9c, 0x17, 0x12, 0x14, 0x77, 0xb0, 0x1b, 0x7a,
-	0xe5, 0x3c, 0xe5, 0x40, 0x06, 0x4c, 0x47, 0x21, 0xe2, 0x53, 0xce, 0x53, 0xca, 0xef, 0x1e, 0x7b,
-	0x4a, 0x21, 0x94, 0x80, 0x52, 0x7f, 0x73, 0x38, 0x75, 0x01, 0x41, 0x2b, 0x84, 0xa1, 0xf3, 0xc0,
-	0x50, 0x7f, 0x0e, 0xf3, 0x71, 0x8d, 0xb8, 0xdf, 0x34, 0x80, 0x88, 0x3b, 0x77, 0xde, 0xc9, 0xe2,
-	0x73, 0xba, 0xe7, 0x29, 0x93, 0x21, 0x0b, 0x2d, 0x7a, 0x54, 0x4d, 0x58, 0xf0, 0x43, 0x32, 0x44,
-	0x22, 0xe7, 0xea, 0x47, 0x02, 0x8b, 0x49, 0x6e, 0x5c, 0xb7, 0x0f, 0xe3, 0xc9, 0x27, 0x9d, 0x22,
-	0xf9, 0x50, 0xd0, 0xdf, 0x44, 0xe9, 0x17, 0x4b, 0xc5, 0xbf, 0x48, 0x50, 0xdc, 0x32, 0x8c, 0xff,
-	0x4f, 0x84, 0x6c, 0xc3, 0x04, 0xef, 0x1d, 0x83, 0x0a, 0xa2, 0xa6, 0x94, 0xd0, 0x18, 0x40, 0xa2,
-	0x7e, 0x48, 0x5a, 0x88, 0xa9, 0x7e, 0x0c, 0xf3, 0x71, 0x89, 0xb9, 0x95, 0xee, 0x9e, 0x35, 0x02,
-	0x44, 0x97, 0xff, 0x37, 0x0f, 0x8b, 0xfb, 0xb6, 0xa1, 0xbb, 0xf8, 0x02, 0x25, 0x0d, 0x7a, 0x04,
-	0x33, 0xb6, 0x65, 0xdb, 0xd8, 0xa8, 0x71, 0x2b, 0xf2, 0xe6, 0xf5, 0xa4, 0xe6, 0xcf, 0x69, 0xd3,
-	0x0c, 0x9f, 0x2f, 0x53, 0x82, 0x5d, 0xf2, 0x54, 0x20, 0x38, 0x7a, 0x6a, 0x82, 0x14, 0x9f, 0x2f,
-	0xab, 0x4f, 0x60, 0x29, 0x65, 0xf6, 0x57, 0xe9, 0xd7, 0x7f, 0x48, 0x20, 0x47, 0x55, 0xf2, 0x22,
-	0x15, 0xc4, 0x55, 0x58, 0xc9, 0x54, 0x8c, 0x6f, 0x01, 0xcf, 0xf3, 0xb0, 0xaa, 0xe1, 0xb6, 0xf5,
-	0x4c, 0xb4, 0x2c, 0xb5, 0xf9, 0xb7, 0xd2, 0xed, 0xc5, 0x2c, 0x9d, 0x3f, 0x37, 0x4b, 0x8f, 0x9c,
-	0x87, 0xa5, 0xd7, 0x61, 0xad, 0x9f, 0x25, 0x03, 0x63, 0x4b, 0x50, 0xa8, 0x62, 0xdd, 0xbc, 0x00,
-	0x61, 0xf5, 0x2f, 0x09, 0xa6, 0x98, 0x2a, 0x3c, 0x0d, 0x8d, 0xac, 0x4d, 0xa8, 0x1c, 0x3b, 0xb6,
-	0x27, 0xed, 0x92, 0x71, 0x76, 0x1f, 0xb2, 0x1f, 0xa1, 0x26, 0x14, 0x08, 0xd6, 0x4d, 0x6c, 0xd4,
-	0x9a, 0x26, 0xe9, 0x50, 0xd5, 0x46, 0x2b, 0xef, 0x1d, 0x7b, 0x0a, 0x54, 0xe9, 0xe7, 0x9d, 0xdd,
-	0xea, 0x43, 0x1f, 0x9d, 0x84, 0x6f, 0x5f, 0x7b, 0xca, 0xb5, 0xe1, 0x7a, 0xfa, 0x90, 0x5a, 0x80,
-	0x65, 0x92, 0x8e, 0xfa, 0x37, 0x09, 0xa6, 0xf7, 0x3b, 0xe4, 0x62, 0x38, 0xcb, 0x80, 0x99, 0x40,
-	0x97, 0x73, 0x6c, 0x87, 0xbe, 0x1c, 0x81, 0x42, 0xf5, 0xa8, 0xd3, 0xb8, 0x00, 0x1b, 0xe2, 0x33,
-	0x28, 0x12, 0xa7, 0x51, 0x4b, 0xd6, 0x3d, 0x56, 0x36, 0x76, 0x8e, 0x3d, 0x65, 0xae, 0xea, 0x34,
-	0xbe, 0x71, 0xe9, 0x9b, 0x23, 0x71, 0x22, 0x94, 0xaf, 0x41, 0xdc, 0x14, 0xdf, 0xd1, 0x88, 0xef,
-	0x76, 0x6c, 0xc6, 0x71, 0x46, 0xbe, 0x46, 0x9c, 0x88, 0xa1, 0xbe, 0x03, 0x53, 0xcc, 0x73, 0x3c,
-	0x3c, 0xca, 0x30, 0x46, 0x5c, 0xdd, 0xed, 0x12, 0x1e, 0x1a, 0x4b, 0xf1, 0xf1, 0xdb, 0x51, 0xa7,
-	0x51, 0xa5, 0xcb, 0x1a, 0x07, 0x53, 0xff, 0x2e, 0x41, 0xe1, 0xb1, 0xd3, 0x0a, 0x37, 0xcc, 0x27,
-	0x29, 0xdf, 0xdf, 0x15, 0x7c, 0xdf, 0xf3, 0x94, 0xc0, 0xa1, 0x67, 0x0c, 0x83, 0x1a, 0x4c, 0xd2,
-	0x99, 0x9b, 0x50, 0x05, 0x2a, 0xc7, 0x9e, 0x32, 0xb1, 0xab, 0x13, 0x97, 0xd7, 0x80, 0x09, 0x93,
-	0x3f, 0x9f, 0xa2, 0x02, 0x30, 0x1c, 0x3f, 0xff, 0xff, 0x9c, 0x07, 0x60, 0x0a, 0x91, 0xae, 0xe9,
-	0xa2, 0x5f, 0xf5, 0xdb, 0x04, 0xf7, 0x53, 0x9b, 0x60, 0xcf, 0x53, 0xe2, 0x7b, 0xda, 0x2b, 0xd8,
-	0x15, 0x49, 0x76, 0xd4, 0x3f, 0x4a, 0x44, 0x7d, 0xcf, 0x53, 0xc4, 0x30, 0xfe, 0x86, 0x49, 0x70,
-	0x03, 0x2e, 0x61, 0xc7, 0xb1, 0x1c, 0x1a, 0xf6, 0x93, 0x95, 0x62, 0xcf, 0x53, 0x66, 0xe9, 0x87,
-	0x9b, 0x56, 0xbb, 0xe5, 0xd2, 0x81, 0xb0, 0xc6, 0x20, 0xd4, 0xfb, 0x30, 0xc5, 0x8d, 0xc5, 0xe2,
-	0xe7, 0x36, 0x8c, 0x3b, 0xd4, 0x70, 0xc1, 0x46, 0xb0, 0x1c, 0x1f, 0x4a, 0x45, 0x96, 0xe5, 0xed,
-	0x5e, 0x00, 0xae, 0x12, 0x58, 0xdf, 0xc1, 0x6e, 0xb0, 0x33, 0x68, 0xd8, 0xb6, 0x48, 0xcb, 0xb5,
-	0x9c, 0x23, 0x71, 0xfe, 0xf4, 0x08, 0xc6, 0x45, 0x27, 0x8c, 0x56, 0x7e, 0x70, 0xec, 0x29, 0x63,
-	0x61, 0x3e, 0x5c, 0x1f, 0xae, 0x33, 0xb7, 0xf2, 0x58, 0x87, 0x85, 0xff, 0xa7, 0xf0, 0xda, 0x00,
-	0xa6, 0x5c, 0xa7, 0x1f, 0xc2, 0xa8, 0x30, 0x65, 0xfb, 0x4e, 0xaa, 0x58, 0xf6, 0x41, 0xa7, 0x48,
-	0xea, 0x26, 0xa8, 0xfe, 0xe1, 0x2d, 0x1b, 0x26, 0x9c, 0x71, 0x10, 0xd8, 0x18, 0x08, 0xc5, 0x25,
-	0xd9, 0x85, 0x4b, 0xe2, 0x1c, 0xf3, 0xa4, 0xa2, 0x54, 0xa6, 0xf9, 0xe6, 0xca, 0xb0, 0x35, 0xf6,
-	0xa3, 0xfe, 0x3b, 0x4f, 0x8f, 0xcc, 0x7b, 0xda, 0x1e, 0x6e, 0xd7, 0xb1, 0x13, 0xb1, 0xd9, 0x86,
-	0x31, 0x13, 0xeb, 0x06, 0x76, 0xb8, 0x95, 0x6f, 0x9e, 0xce, 0xb6, 0x0c, 0x17, 0x3d, 0x04, 0x14,
-	0x5c, 0x08, 0xb4, 0xac, 0x4e, 0xed, 0x40, 0x6f, 0xb8, 0x96, 0xc3, 0xe3, 0x57, 0xe9, 0x79, 0xca,
-	0x8a, 0xb0, 0xfa, 0x1e, 0x5d, 0x14, 0xc2, 0xeb, 0x72, 0x6a, 0x11, 0xfd, 0x12, 0xc6, 0xdb, 0x4c,
-	0xd0, 0xe5, 0x91, 0x78, 0x8f, 0xc1, 0x42, 0x2b, 0x4b, 0x95, 0x12, 0x7f, 0xbf, 0xd7, 0x71, 0x9d,
-	0xa3, 0xca, 0xcd, 0xdf, 0x7e, 0x75, 0x0a, 0x3d, 0x02, 0x6e, 0xf2, 0x1d, 0x98, 0x12, 0xc9, 0xa0,
-	0x39, 0x18, 0x39, 0xc4, 0x47, 0xcc, 0x36, 0x9a, 0xff, 0x88, 0xe6, 0xe1, 0xd2, 0x33, 0xdd, 0xec,
-	0xb2, 0x7b, 0x85, 0x49, 0x8d, 0xbd, 0xdc, 0xc9, 0xdf, 0x96, 0x54, 0x07, 0xd6, 0xb7, 0x0c, 0x63,
-	0x70, 0x54, 0x5f, 0x83, 0x09, 0x47, 0x3f, 0x70, 0x6b, 0x5d, 0xc7, 0xa4, 0x44, 0x27, 0x2b, 0x05,
-	0xbf, 0x64, 0x6a, 0xfa, 0x81, 0xbb, 0xaf, 0xed, 0x6a, 0xe3, 0xfe, 0xe2, 0xbe, 0x63, 0x52, 0x38,
-	0xbb, 0x51, 0xd3, 0x0d, 0x83, 0x99, 0x31, 0x80, 0xfb, 0xe0, 0xee, 0x96, 0x61, 0x38, 0xda, 0xb8,
-	0x63, 0x37, 0xfc, 0x07, 0x3f, 0xa8, 0x07, 0xf0, 0x7c, 0x15, 0x41, 0x5d, 0xa7, 0xf3, 0xb1, 0x3d,
-	0xed, 0x03, 0x8c, 0x9d, 0xf3, 0xd2, 0xe2, 0x33, 0xb8, 0x2c, 0xf0, 0xe0, 0x52, 0x37, 0x92, 0x05,
-	0xe0, 0xc7, 0x51, 0x01, 0xe8, 0x79, 0xca, 0x1c, 0x4b, 0xeb, 0x28, 0x8e, 0xce, 0x54, 0x14, 0x7e,
-	0x23, 0xc1, 0xc6, 0x36, 0x36, 0xb1, 0x8b, 0x07, 0xfb, 0xed, 0xc3, 0xa4, 0x30, 0xef, 0xc6, 0x84,
-	0xe1, 0xe4, 0xce, 0x24, 0xc2, 0x35, 0xd8, 0x1c, 0x2c, 0x01, 0x3f, 0x57, 0xbc, 0x0d, 0x45, 0x76,
-	0xf2, 0x38, 0x93, 0x2f, 0xd4, 0x45, 0x98, 0x8f, 0xa3, 0x33, 0xb2, 0xb7, 0xfe, 0x5a, 0x84, 0x99,
-	0xbb, 0x66, 0xd7, 0x3f, 0x38, 0xee, 0xe9, 0x1d, 0xbd, 0x89, 0x1d, 0xf4, 0x09, 0xcc, 0xc4, 0xaf,
-	0x7c, 0xd0, 0x46, 0x2a, 0xfd, 0xd2, 0x37, 0x06, 0xf2, 0xe6, 0x60, 0x20, 0xae, 0x46, 0x0e, 0x35,
-	0x60, 0x2e, 0x79, 0x8b, 0x83, 0x5e, 0x8f, 0xe3, 0xf6, 0xb9, 0x00, 0x92, 0xaf, 0x0d, 0x03, 0x0b,
-	0x99, 0x7c, 0x02, 0x33, 0xf1, 0x0b, 0x95, 0xa4, 0x0e, 0x99, 0xd7, 0x39, 0x49, 0x1d, 0xb2, 0xef,
-	0x64, 0xd4, 0x1c, 0x72, 0x60, 0x21, 0xf3, 0xbe, 0x02, 0xbd, 0x11, 0x27, 0x30, 0xe8, 0x8a, 0x45,
-	0xfe, 0xee, 0x89, 0x60, 0x43, 0x9e, 0xef, 0xc3, 0x44, 0x70, 0x35, 0x81, 0x56, 0x53, 0xb6, 0x16,
-	0x67, 0xcc, 0xf2, 0x5a, 0xbf, 0xe5, 0x90, 0xd8, 0x47, 0x30, 0x1d, 0xbb, 0x22, 0x40, 0x6a, 0x1c,
-	0x25, 0xeb, 0xb6, 0x42, 0xde, 0x18, 0x08, 0x13, 0xd2, 0xfe, 0x09, 0x40, 0x34, 0xc5, 0x47, 0x4a,
-	0xda, 0x67, 0xb1, 0xa1, 0xbf, 0xbc, 0xde, 0x1f, 0x40, 0xd4, 0x3d, 0x98, 0xd2, 0x27, 0x75, 0x4f,
-	0x0c, 0xf4, 0x93, 0xba, 0x27, 0x87, 0xfb, 0x6a, 0x0e, 0x7d, 0x0a, 0xb3, 0x89, 0x61, 0x39, 0xda,
-	0xec, 0xe7, 0x8a, 0x18, 0xe9, 0xd7, 0x87, 0x40, 0x85, 0x1c, 0x7e, 0x0a, 0x53, 0xe2, 0x80, 0x1a,
-	0xbd, 0x96, 0xf2, 0x47, 0x72, 0xfa, 0x24, 0xab, 0x83, 0x40, 0xc4, 0xb0, 0x8e, 0xcf, 0x87, 0x93,
-	0x61, 0x9d, 0x39, 0xab, 0x4e, 0x86, 0x75, 0xf6, 0x88, 0x99, 0xc9, 0x2d, 0x8e, 0x55, 0x93, 0x72,
-	0x67, 0x0c, 0x89, 0x93, 0x72, 0x67, 0x4d, 0x65, 0xb9, 0xc9, 0xe3, 0xa3, 0xbd, 0x94, 0xc9, 0x33,
-	0x07, 0xae, 0x29, 0x93, 0x67, 0xcf, 0x07, 0xd5, 0x1c, 0x32, 0xa1, 0x98, 0x31, 0x02, 0x43, 0xd7,
-	0xfb, 0xb9, 0x2c, 0xc5, 0xe9, 0xc6, 0x09, 0x20, 0x43, 0x6e, 0x5d, 0x58, 0xcc, 0x1e, 0x03, 0xa1,
-	0x44, 0x52, 0x0f, 0x1c, 0xbb, 0xc9, 0x37, 0x4f, 0x06, 0x1c, 0xb2, 0x7d, 0x07, 0x46, 0xab, 0x58,
-	0x37, 0xd1, 0x95, 0xc4, 0xf5, 0x6f, 0x34, 0xc1, 0x90, 0xe5, 0xac, 0xa5, 0x90, 0xc0, 0x3d, 0x18,
-	0x63, 0x43, 0x02, 0xb4, 0x92, 0x54, 0x57, 0x18, 0x83, 0xc8, 0x57, 0xb3, 0x17, 0x63, 0x72, 0x1c,
-	0x75, 0x1a, 0x29, 0x39, 0xa2, 0xc1, 0x40, 0x4a, 0x0e, 0xe1, 0xe4, 0xc9, 0x08, 0xf8, 0xc7, 0x83,
-	0x24, 0x01, 0xe1, 0x74, 0x99, 0x24, 0x20, 0x1e, 0x3d, 0xd4, 0x1c, 0xfa, 0x35, 0x5c, 0xe9, 0xdb,
-	0xcd, 0xa3, 0x52, 0xba, 0x5b, 0x1c, 0xb4, 0xbb, 0xcb, 0xe5, 0x13, 0xc3, 0x87, 0xfc, 0x7f, 0x27,
-	0xc1, 0xca, 0x80, 0x36, 0x1e, 0x7d, 0x2f, 0x9d, 0x71, 0x83, 0xcf, 0x05, 0xf2, 0x5b, 0xa7, 0xc0,
-	0x08, 0xc5, 0xd8, 0xa5, 0x85, 0x26, 0xec, 0x85, 0xd1, 0x62, 0xea, 0x3f, 0x51, 0xee, 0xf9, 0xbd,
-	0x51, 0x46, 0x75, 0x49, 0xf5, 0xcf, 0xcc, 0xa8, 0x7d, 0xbb, 0xc9, 0xa4, 0x51, 0x87, 0xb5, 0xba,
-	0x49, 0xa3, 0x0e, 0x6d, 0x53, 0xd5, 0x1c, 0x7a, 0x08, 0x93, 0x61, 0x1f, 0x88, 0xd2, 0x75, 0x3c,
-	0xd6, 0xf8, 0xc8, 0x4a, 0xdf, 0xf5, 0x90, 0xde, 0x1f, 0x24, 0xb8, 0x3a, 0xa8, 0xb7, 0x42, 0x6f,
-	0x25, 0x37, 0xb4, 0xa1, 0x9d, 0xa0, 0x7c, 0xeb, 0x34, 0x28, 0x62, 0x61, 0x15, 0xbb, 0xaf, 0x64,
-	0x61, 0xcd, 0x68, 0xec, 0x92, 0x2e, 0xcb, 0x6a, 0xde, 0xd4, 0x5c, 0xe5, 0xed, 0xe7, 0xc7, 0x6b,
-	0xd2, 0x8b, 0xe3, 0x35, 0xe9, 0xf3, 0x97, 0x6b, 0xb9, 0x2f, 0x5e, 0xae, 0x49, 0x2f, 0x5e, 0xae,
-	0xe5, 0xfe, 0xf9, 0x72, 0x2d, 0xf7, 0xd1, 0x46, 0xdf, 0x4e, 0x34, 0xfa, 0x67, 0xb3, 0xfa, 0x18,
-	0x7d, 0xf9, 0xfe, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x72, 0xa8, 0x94, 0x14, 0x82, 0x26, 0x00,
-	0x00,
+func (m *ConsumerGroupHeartbeatRequest) Reset()         { *m = ConsumerGroupHeartbeatRequest{} }
+func (m *ConsumerGroupHeartbeatRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupHeartbeatRequest) ProtoMessage()    {}
+func (*ConsumerGroupHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{59}
 }
-
-func (this *StorageNodeMetadata) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ConsumerGroupHeartbeatRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroupHeartbeatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupHeartbeatRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-
-	that1, ok := that.(*StorageNodeMetadata)
-	if !ok {
-		that2, ok := that.(StorageNodeMetadata)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.StorageNodeMetadataDescriptor.Equal(&that1.StorageNodeMetadataDescriptor) {
-		return false
-	}
-	if !this.CreateTime.Equal(that1.CreateTime) {
-		return false
-	}
-	if !this.LastHeartbeatTime.Equal(that1.LastHeartbeatTime) {
-		return false
-	}
-	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ClusterManagerClient is the client API for ClusterManager service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ClusterManagerClient interface {
-	// GetStorageNode returns the metadata of the storage node requested.
-	// It produces a gRPC NotFound error if the storage node does not exist. If
-	// the metadata repository cannot be reachable, it returns a gRPC Unavailable
-	// error. In this case, clients can retry with proper backoff to fix it.
-	GetStorageNode(ctx context.Context, in *GetStorageNodeRequest, opts ...grpc.CallOption) (*GetStorageNodeResponse, error)
-	// ListStorageNodes returns a list of storage nodes.
-	// If the metadata repository cannot be reachable, it returns a gRPC
-	// Unavailable error. In this case, clients can retry with proper backoff to
-	// fix it. If the metadata fetched from the metadata repository is
-	// inconsistent, it returns a gRPC Internal error.
-	ListStorageNodes(ctx context.Context, in *ListStorageNodesRequest, opts ...grpc.CallOption) (*ListStorageNodesResponse, error)
-	// AddStorageNode adds a new storage node to the cluster. It is idempotent;
-	// adding an already added storage node is okay.
-	// Note that if the admin server cannot refresh the storage node list in the
-	// memory, it returns a gRPC Unavailable error. However, the storage node
-	// could have been added, so users should call this RPC with the same
-	// parameter again.
-	AddStorageNode(ctx context.Context, in *AddStorageNodeRequest, opts ...grpc.CallOption) (*AddStorageNodeResponse, error)
-	// UnregisterStorageNode unregisters the storage node specified by the
-	// argument snid. If users try to unregister an already non-exist node, it
-	// returns okay.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - FailedPrecondition: The storage node still has valid log stream replicas.
-	UnregisterStorageNode(ctx context.Context, in *UnregisterStorageNodeRequest, opts ...grpc.CallOption) (*UnregisterStorageNodeResponse, error)
-	// GetTopic returns the topic specified by the request.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - NotFound: The topic doesn't exist.
-	GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error)
-	// DescribeTopic returns the topic specified by the request.
-	// Deprecated: Use GetTopic.
-	DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error)
-	// ListTopics returns a list of topics in the cluster.
-	ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error)
-	// AddTopic adds a new topic and returns its metadata.
-	// It produces a gRPC Internal error if the metadata rejects the request.
-	AddTopic(ctx context.Context, in *AddTopicRequest, opts ...grpc.CallOption) (*AddTopicResponse, error)
-	// UnregisterTopic unregisters the topic specified by the argument tpid.
-	// It returns a gRPC Unavailable error if the metadata cannot be fetched from
-	// the metadata repository.
-	// TODO: Its behavior is unclear if the topic has already been removed.
-	// FIXME: It overwrites the gRPC errors returned from the metadata repository,
-	// even if some may be important.
-	UnregisterTopic(ctx context.Context, in *UnregisterTopicRequest, opts ...grpc.CallOption) (*UnregisterTopicResponse, error)
-	// GetLogStream returns the metadata of the log stream specified by the
-	// arguments tpid and lsid. The metadata is the type stored in the metadata
-	// repository.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - NotFound: Either the topic or the log stream does not exist.
-	// - Internal: The TopicID in the log stream metadata doesn't match.
-	GetLogStream(ctx context.Context, in *GetLogStreamRequest, opts ...grpc.CallOption) (*GetLogStreamResponse, error)
-	// ListLogStreams returns all log streams belonging to the topic specified by
-	// the argument tpid.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - NotFound: The topic does not exist.
-	// - Internal: The TopicID in the log stream metadata doesn't match.
-	ListLogStreams(ctx context.Context, in *ListLogStreamsRequest, opts ...grpc.CallOption) (*ListLogStreamsResponse, error)
-	// AddLogStream adds a new log stream to the cluster.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - ResourceExhausted: The number of log streams is reached the upper limit.
-	// - FailedPrecondition: Replicas information in the request is invalid; for
-	// example, the number of log stream replicas in the request does not equal
-	// the replication factor, or the storage node for the replica does not exist.
-	//
-	// TODO: Not all errors are codified.
-	AddLogStream(ctx context.Context, in *AddLogStreamRequest, opts ...grpc.CallOption) (*AddLogStreamResponse, error)
-	// UpdateLogStream changes the configuration of replicas in a log stream.
-	//
-	// Its codes are defines as followings:
-	// - InvalidArgument: The client tries to swap the same replica.
-	// - Unavailable: The cluster metadata cannot be fetched from the metadata
-	// repository transiently.
-	// - NotFound: The target log stream does not exist.
-	// - FailedPrecondition: Either the target log stream is not sealed, the
-	// target log stream doesn't have a victim replica, or the target log stream
-	// already has both victim and new replica. Note that clients should not retry
-	// without any action.
-	// - Unknown: Either storage node or metadata repository returns an error.
-	//
-	// TODO: Moving the data directory within the same node is not supported yet.
-	// TODO: We will define codes for errors returned from storage nodes and
-	// metadata repository soon.
-	UpdateLogStream(ctx context.Context, in *UpdateLogStreamRequest, opts ...grpc.CallOption) (*UpdateLogStreamResponse, error)
-	// UnregisterLogStream unregisters the log stream specified by the arguments
-	// tpid and lsid.
-	// TODO: It is not tested.
-	UnregisterLogStream(ctx context.Context, in *UnregisterLogStreamRequest, opts ...grpc.CallOption) (*UnregisterLogStreamResponse, error)
-	// RemoveLogStreamReplica removes the log stream replica specified by the
-	// arguments snid, tpid, and lsid.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - FailedPrecondition: The log stream of the log stream replica can't be
-	// removable, e.g., clients still can append logs to the log stream.
-	RemoveLogStreamReplica(ctx context.Context, in *RemoveLogStreamReplicaRequest, opts ...grpc.CallOption) (*RemoveLogStreamReplicaResponse, error)
-	Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error)
-	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error)
-	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
-	Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error)
-	GetMetadataRepositoryNode(ctx context.Context, in *GetMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*GetMetadataRepositoryNodeResponse, error)
-	ListMetadataRepositoryNodes(ctx context.Context, in *ListMetadataRepositoryNodesRequest, opts ...grpc.CallOption) (*ListMetadataRepositoryNodesResponse, error)
-	GetMRMembers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GetMRMembersResponse, error)
-	AddMetadataRepositoryNode(ctx context.Context, in *AddMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*AddMetadataRepositoryNodeResponse, error)
-	AddMRPeer(ctx context.Context, in *AddMRPeerRequest, opts ...grpc.CallOption) (*AddMRPeerResponse, error)
-	DeleteMetadataRepositoryNode(ctx context.Context, in *DeleteMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*DeleteMetadataRepositoryNodeResponse, error)
-	RemoveMRPeer(ctx context.Context, in *RemoveMRPeerRequest, opts ...grpc.CallOption) (*RemoveMRPeerResponse, error)
+func (m *ConsumerGroupHeartbeatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupHeartbeatRequest.Merge(m, src)
 }
-
-type clusterManagerClient struct {
-	cc *grpc.ClientConn
+func (m *ConsumerGroupHeartbeatRequest) XXX_Size() int {
+	return m.ProtoSize()
 }
-
-func NewClusterManagerClient(cc *grpc.ClientConn) ClusterManagerClient {
-	return &clusterManagerClient{cc}
+func (m *ConsumerGroupHeartbeatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupHeartbeatRequest.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) GetStorageNode(ctx context.Context, in *GetStorageNodeRequest, opts ...grpc.CallOption) (*GetStorageNodeResponse, error) {
-	out := new(GetStorageNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetStorageNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerGroupHeartbeatRequest proto.InternalMessageInfo
+
+func (m *ConsumerGroupHeartbeatRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return out, nil
+	return ""
 }
 
-func (c *clusterManagerClient) ListStorageNodes(ctx context.Context, in *ListStorageNodesRequest, opts ...grpc.CallOption) (*ListStorageNodesResponse, error) {
-	out := new(ListStorageNodesResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListStorageNodes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupHeartbeatRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) AddStorageNode(ctx context.Context, in *AddStorageNodeRequest, opts ...grpc.CallOption) (*AddStorageNodeResponse, error) {
-	out := new(AddStorageNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddStorageNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupHeartbeatRequest) GetMemberID() string {
+	if m != nil {
+		return m.MemberID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *clusterManagerClient) UnregisterStorageNode(ctx context.Context, in *UnregisterStorageNodeRequest, opts ...grpc.CallOption) (*UnregisterStorageNodeResponse, error) {
-	out := new(UnregisterStorageNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UnregisterStorageNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupHeartbeatRequest) GetOwnedLogStreamIDs() []github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.OwnedLogStreamIDs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *clusterManagerClient) GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error) {
-	out := new(GetTopicResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupHeartbeatRequest) GetGeneration() uint64 {
+	if m != nil {
+		return m.Generation
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error) {
-	out := new(DescribeTopicResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/DescribeTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ConsumerGroupHeartbeatResponse struct {
+	// Generation increases whenever the group rebalances.
+	Generation uint64 `protobuf:"varint,1,opt,name=generation,proto3" json:"generation,omitempty"`
+	// LogStreamIDs are log streams assigned to the member.
+	LogStreamIDs []github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,rep,packed,name=log_stream_ids,json=logStreamIds,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"logStreamIds"`
+	// SessionTimeout is how long the member may consume the log streams after
+	// sending the heartbeat. The member should stop consuming them if it cannot
+	// renew its session within the timeout since the group may assign them to
+	// other members.
+	SessionTimeout time.Duration `protobuf:"bytes,3,opt,name=session_timeout,json=sessionTimeout,proto3,stdduration" json:"sessionTimeout"`
 }
 
-func (c *clusterManagerClient) ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error) {
-	out := new(ListTopicsResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListTopics", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupHeartbeatResponse) Reset()         { *m = ConsumerGroupHeartbeatResponse{} }
+func (m *ConsumerGroupHeartbeatResponse) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupHeartbeatResponse) ProtoMessage()    {}
+func (*ConsumerGroupHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{60}
+}
+func (m *ConsumerGroupHeartbeatResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroupHeartbeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupHeartbeatResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ConsumerGroupHeartbeatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupHeartbeatResponse.Merge(m, src)
+}
+func (m *ConsumerGroupHeartbeatResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ConsumerGroupHeartbeatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupHeartbeatResponse.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) AddTopic(ctx context.Context, in *AddTopicRequest, opts ...grpc.CallOption) (*AddTopicResponse, error) {
-	out := new(AddTopicResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerGroupHeartbeatResponse proto.InternalMessageInfo
+
+func (m *ConsumerGroupHeartbeatResponse) GetGeneration() uint64 {
+	if m != nil {
+		return m.Generation
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) UnregisterTopic(ctx context.Context, in *UnregisterTopicRequest, opts ...grpc.CallOption) (*UnregisterTopicResponse, error) {
-	out := new(UnregisterTopicResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UnregisterTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupHeartbeatResponse) GetLogStreamIDs() []github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamIDs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *clusterManagerClient) GetLogStream(ctx context.Context, in *GetLogStreamRequest, opts ...grpc.CallOption) (*GetLogStreamResponse, error) {
-	out := new(GetLogStreamResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupHeartbeatResponse) GetSessionTimeout() time.Duration {
+	if m != nil {
+		return m.SessionTimeout
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) ListLogStreams(ctx context.Context, in *ListLogStreamsRequest, opts ...grpc.CallOption) (*ListLogStreamsResponse, error) {
-	out := new(ListLogStreamsResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListLogStreams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type LeaveConsumerGroupRequest struct {
+	Group    string                                    `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	TopicID  github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId"`
+	MemberID string                                    `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"memberId"`
 }
 
-func (c *clusterManagerClient) AddLogStream(ctx context.Context, in *AddLogStreamRequest, opts ...grpc.CallOption) (*AddLogStreamResponse, error) {
-	out := new(AddLogStreamResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LeaveConsumerGroupRequest) Reset()         { *m = LeaveConsumerGroupRequest{} }
+func (m *LeaveConsumerGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaveConsumerGroupRequest) ProtoMessage()    {}
+func (*LeaveConsumerGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{61}
+}
+func (m *LeaveConsumerGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaveConsumerGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaveConsumerGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *LeaveConsumerGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaveConsumerGroupRequest.Merge(m, src)
+}
+func (m *LeaveConsumerGroupRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LeaveConsumerGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaveConsumerGroupRequest.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) UpdateLogStream(ctx context.Context, in *UpdateLogStreamRequest, opts ...grpc.CallOption) (*UpdateLogStreamResponse, error) {
-	out := new(UpdateLogStreamResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UpdateLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LeaveConsumerGroupRequest proto.InternalMessageInfo
+
+func (m *LeaveConsumerGroupRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return out, nil
+	return ""
 }
 
-func (c *clusterManagerClient) UnregisterLogStream(ctx context.Context, in *UnregisterLogStreamRequest, opts ...grpc.CallOption) (*UnregisterLogStreamResponse, error) {
-	out := new(UnregisterLogStreamResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UnregisterLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LeaveConsumerGroupRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) RemoveLogStreamReplica(ctx context.Context, in *RemoveLogStreamReplicaRequest, opts ...grpc.CallOption) (*RemoveLogStreamReplicaResponse, error) {
-	out := new(RemoveLogStreamReplicaResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/RemoveLogStreamReplica", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LeaveConsumerGroupRequest) GetMemberID() string {
+	if m != nil {
+		return m.MemberID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *clusterManagerClient) Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error) {
-	out := new(SealResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Seal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type LeaveConsumerGroupResponse struct {
 }
 
-func (c *clusterManagerClient) Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error) {
-	out := new(UnsealResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Unseal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LeaveConsumerGroupResponse) Reset()         { *m = LeaveConsumerGroupResponse{} }
+func (m *LeaveConsumerGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*LeaveConsumerGroupResponse) ProtoMessage()    {}
+func (*LeaveConsumerGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{62}
+}
+func (m *LeaveConsumerGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaveConsumerGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaveConsumerGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *LeaveConsumerGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaveConsumerGroupResponse.Merge(m, src)
+}
+func (m *LeaveConsumerGroupResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LeaveConsumerGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaveConsumerGroupResponse.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
-	out := new(SyncResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Sync", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_LeaveConsumerGroupResponse proto.InternalMessageInfo
+
+type CommitConsumerGroupOffsetRequest struct {
+	Group    string                                    `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	TopicID  github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId"`
+	MemberID string                                    `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"memberId"`
+	// Generation is the generation of the last assignment the member received.
+	Generation  uint64                                        `protobuf:"varint,4,opt,name=generation,proto3" json:"generation,omitempty"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,5,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"logStreamId"`
+	LLSN        github_com_kakao_varlog_pkg_types.LLSN        `protobuf:"varint,6,opt,name=llsn,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn"`
 }
 
-func (c *clusterManagerClient) Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error) {
-	out := new(TrimResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Trim", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CommitConsumerGroupOffsetRequest) Reset()         { *m = CommitConsumerGroupOffsetRequest{} }
+func (m *CommitConsumerGroupOffsetRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitConsumerGroupOffsetRequest) ProtoMessage()    {}
+func (*CommitConsumerGroupOffsetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{63}
+}
+func (m *CommitConsumerGroupOffsetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitConsumerGroupOffsetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitConsumerGroupOffsetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *CommitConsumerGroupOffsetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitConsumerGroupOffsetRequest.Merge(m, src)
+}
+func (m *CommitConsumerGroupOffsetRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CommitConsumerGroupOffsetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitConsumerGroupOffsetRequest.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) GetMetadataRepositoryNode(ctx context.Context, in *GetMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*GetMetadataRepositoryNodeResponse, error) {
-	out := new(GetMetadataRepositoryNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetMetadataRepositoryNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CommitConsumerGroupOffsetRequest proto.InternalMessageInfo
+
+func (m *CommitConsumerGroupOffsetRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return out, nil
+	return ""
 }
 
-func (c *clusterManagerClient) ListMetadataRepositoryNodes(ctx context.Context, in *ListMetadataRepositoryNodesRequest, opts ...grpc.CallOption) (*ListMetadataRepositoryNodesResponse, error) {
-	out := new(ListMetadataRepositoryNodesResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListMetadataRepositoryNodes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CommitConsumerGroupOffsetRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) GetMRMembers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GetMRMembersResponse, error) {
-	out := new(GetMRMembersResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetMRMembers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CommitConsumerGroupOffsetRequest) GetMemberID() string {
+	if m != nil {
+		return m.MemberID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *clusterManagerClient) AddMetadataRepositoryNode(ctx context.Context, in *AddMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*AddMetadataRepositoryNodeResponse, error) {
-	out := new(AddMetadataRepositoryNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddMetadataRepositoryNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CommitConsumerGroupOffsetRequest) GetGeneration() uint64 {
+	if m != nil {
+		return m.Generation
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) AddMRPeer(ctx context.Context, in *AddMRPeerRequest, opts ...grpc.CallOption) (*AddMRPeerResponse, error) {
-	out := new(AddMRPeerResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddMRPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CommitConsumerGroupOffsetRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) DeleteMetadataRepositoryNode(ctx context.Context, in *DeleteMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*DeleteMetadataRepositoryNodeResponse, error) {
-	out := new(DeleteMetadataRepositoryNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/DeleteMetadataRepositoryNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CommitConsumerGroupOffsetRequest) GetLLSN() github_com_kakao_varlog_pkg_types.LLSN {
+	if m != nil {
+		return m.LLSN
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) RemoveMRPeer(ctx context.Context, in *RemoveMRPeerRequest, opts ...grpc.CallOption) (*RemoveMRPeerResponse, error) {
-	out := new(RemoveMRPeerResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/RemoveMRPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
+type CommitConsumerGroupOffsetResponse struct {
+}
+
+func (m *CommitConsumerGroupOffsetResponse) Reset()         { *m = CommitConsumerGroupOffsetResponse{} }
+func (m *CommitConsumerGroupOffsetResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitConsumerGroupOffsetResponse) ProtoMessage()    {}
+func (*CommitConsumerGroupOffsetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{64}
+}
+func (m *CommitConsumerGroupOffsetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitConsumerGroupOffsetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitConsumerGroupOffsetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *CommitConsumerGroupOffsetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitConsumerGroupOffsetResponse.Merge(m, src)
+}
+func (m *CommitConsumerGroupOffsetResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CommitConsumerGroupOffsetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitConsumerGroupOffsetResponse.DiscardUnknown(m)
 }
 
-// ClusterManagerServer is the server API for ClusterManager service.
-type ClusterManagerServer interface {
-	// GetStorageNode returns the metadata of the storage node requested.
-	// It produces a gRPC NotFound error if the storage node does not exist. If
-	// the metadata repository cannot be reachable, it returns a gRPC Unavailable
-	// error. In this case, clients can retry with proper backoff to fix it.
-	GetStorageNode(context.Context, *GetStorageNodeRequest) (*GetStorageNodeResponse, error)
-	// ListStorageNodes returns a list of storage nodes.
-	// If the metadata repository cannot be reachable, it returns a gRPC
-	// Unavailable error. In this case, clients can retry with proper backoff to
-	// fix it. If the metadata fetched from the metadata repository is
-	// inconsistent, it returns a gRPC Internal error.
-	ListStorageNodes(context.Context, *ListStorageNodesRequest) (*ListStorageNodesResponse, error)
-	// AddStorageNode adds a new storage node to the cluster. It is idempotent;
-	// adding an already added storage node is okay.
-	// Note that if the admin server cannot refresh the storage node list in the
-	// memory, it returns a gRPC Unavailable error. However, the storage node
-	// could have been added, so users should call this RPC with the same
-	// parameter again.
-	AddStorageNode(context.Context, *AddStorageNodeRequest) (*AddStorageNodeResponse, error)
-	// UnregisterStorageNode unregisters the storage node specified by the
-	// argument snid. If users try to unregister an already non-exist node, it
-	// returns okay.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - FailedPrecondition: The storage node still has valid log stream replicas.
-	UnregisterStorageNode(context.Context, *UnregisterStorageNodeRequest) (*UnregisterStorageNodeResponse, error)
-	// GetTopic returns the topic specified by the request.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - NotFound: The topic doesn't exist.
-	GetTopic(context.Context, *GetTopicRequest) (*GetTopicResponse, error)
-	// DescribeTopic returns the topic specified by the request.
-	// Deprecated: Use GetTopic.
-	DescribeTopic(context.Context, *DescribeTopicRequest) (*DescribeTopicResponse, error)
-	// ListTopics returns a list of topics in the cluster.
-	ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error)
-	// AddTopic adds a new topic and returns its metadata.
-	// It produces a gRPC Internal error if the metadata rejects the request.
-	AddTopic(context.Context, *AddTopicRequest) (*AddTopicResponse, error)
-	// UnregisterTopic unregisters the topic specified by the argument tpid.
-	// It returns a gRPC Unavailable error if the metadata cannot be fetched from
-	// the metadata repository.
-	// TODO: Its behavior is unclear if the topic has already been removed.
-	// FIXME: It overwrites the gRPC errors returned from the metadata repository,
-	// even if some may be important.
-	UnregisterTopic(context.Context, *UnregisterTopicRequest) (*UnregisterTopicResponse, error)
-	// GetLogStream returns the metadata of the log stream specified by the
-	// arguments tpid and lsid. The metadata is the type stored in the metadata
-	// repository.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - NotFound: Either the topic or the log stream does not exist.
-	// - Internal: The TopicID in the log stream metadata doesn't match.
-	GetLogStream(context.Context, *GetLogStreamRequest) (*GetLogStreamResponse, error)
-	// ListLogStreams returns all log streams belonging to the topic specified by
-	// the argument tpid.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - NotFound: The topic does not exist.
-	// - Internal: The TopicID in the log stream metadata doesn't match.
-	ListLogStreams(context.Context, *ListLogStreamsRequest) (*ListLogStreamsResponse, error)
-	// AddLogStream adds a new log stream to the cluster.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - ResourceExhausted: The number of log streams is reached the upper limit.
-	// - FailedPrecondition: Replicas information in the request is invalid; for
-	// example, the number of log stream replicas in the request does not equal
-	// the replication factor, or the storage node for the replica does not exist.
-	//
+var xxx_messageInfo_CommitConsumerGroupOffsetResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*StorageNodeMetadata)(nil), "varlog.admpb.StorageNodeMetadata")
+	proto.RegisterType((*GetStorageNodeRequest)(nil), "varlog.admpb.GetStorageNodeRequest")
+	proto.RegisterType((*GetStorageNodeResponse)(nil), "varlog.admpb.GetStorageNodeResponse")
+	proto.RegisterType((*ListStorageNodesRequest)(nil), "varlog.admpb.ListStorageNodesRequest")
+	proto.RegisterType((*ListStorageNodesResponse)(nil), "varlog.admpb.ListStorageNodesResponse")
+	proto.RegisterType((*AddStorageNodeRequest)(nil), "varlog.admpb.AddStorageNodeRequest")
+	proto.RegisterType((*AddStorageNodeResponse)(nil), "varlog.admpb.AddStorageNodeResponse")
+	proto.RegisterType((*UnregisterStorageNodeRequest)(nil), "varlog.admpb.UnregisterStorageNodeRequest")
+	proto.RegisterType((*UnregisterStorageNodeResponse)(nil), "varlog.admpb.UnregisterStorageNodeResponse")
+	proto.RegisterType((*GetTopicRequest)(nil), "varlog.admpb.GetTopicRequest")
+	proto.RegisterType((*GetTopicResponse)(nil), "varlog.admpb.GetTopicResponse")
+	proto.RegisterType((*DescribeTopicRequest)(nil), "varlog.admpb.DescribeTopicRequest")
+	proto.RegisterType((*DescribeTopicResponse)(nil), "varlog.admpb.DescribeTopicResponse")
+	proto.RegisterType((*ListTopicsRequest)(nil), "varlog.admpb.ListTopicsRequest")
+	proto.RegisterType((*ListTopicsResponse)(nil), "varlog.admpb.ListTopicsResponse")
+	proto.RegisterType((*AddTopicRequest)(nil), "varlog.admpb.AddTopicRequest")
+	proto.RegisterType((*AddTopicResponse)(nil), "varlog.admpb.AddTopicResponse")
+	proto.RegisterType((*UnregisterTopicRequest)(nil), "varlog.admpb.UnregisterTopicRequest")
+	proto.RegisterType((*UnregisterTopicResponse)(nil), "varlog.admpb.UnregisterTopicResponse")
+	proto.RegisterType((*SetRetentionPolicyRequest)(nil), "varlog.admpb.SetRetentionPolicyRequest")
+	proto.RegisterType((*SetRetentionPolicyResponse)(nil), "varlog.admpb.SetRetentionPolicyResponse")
+	proto.RegisterType((*GetLogStreamRequest)(nil), "varlog.admpb.GetLogStreamRequest")
+	proto.RegisterType((*GetLogStreamResponse)(nil), "varlog.admpb.GetLogStreamResponse")
+	proto.RegisterType((*ListLogStreamsRequest)(nil), "varlog.admpb.ListLogStreamsRequest")
+	proto.RegisterType((*ListLogStreamsResponse)(nil), "varlog.admpb.ListLogStreamsResponse")
+	proto.RegisterType((*AddLogStreamRequest)(nil), "varlog.admpb.AddLogStreamRequest")
+	proto.RegisterType((*AddLogStreamResponse)(nil), "varlog.admpb.AddLogStreamResponse")
+	proto.RegisterType((*UpdateLogStreamRequest)(nil), "varlog.admpb.UpdateLogStreamRequest")
+	proto.RegisterType((*UpdateLogStreamResponse)(nil), "varlog.admpb.UpdateLogStreamResponse")
+	proto.RegisterType((*UnregisterLogStreamRequest)(nil), "varlog.admpb.UnregisterLogStreamRequest")
+	proto.RegisterType((*UnregisterLogStreamResponse)(nil), "varlog.admpb.UnregisterLogStreamResponse")
+	proto.RegisterType((*RemoveLogStreamReplicaRequest)(nil), "varlog.admpb.RemoveLogStreamReplicaRequest")
+	proto.RegisterType((*RemoveLogStreamReplicaResponse)(nil), "varlog.admpb.RemoveLogStreamReplicaResponse")
+	proto.RegisterType((*SealRequest)(nil), "varlog.admpb.SealRequest")
+	proto.RegisterType((*SealResponse)(nil), "varlog.admpb.SealResponse")
+	proto.RegisterType((*UnsealRequest)(nil), "varlog.admpb.UnsealRequest")
+	proto.RegisterType((*UnsealResponse)(nil), "varlog.admpb.UnsealResponse")
+	proto.RegisterType((*SyncRequest)(nil), "varlog.admpb.SyncRequest")
+	proto.RegisterType((*SyncResponse)(nil), "varlog.admpb.SyncResponse")
+	proto.RegisterType((*TrimRequest)(nil), "varlog.admpb.TrimRequest")
+	proto.RegisterType((*TrimResult)(nil), "varlog.admpb.TrimResult")
+	proto.RegisterType((*TrimResponse)(nil), "varlog.admpb.TrimResponse")
+	proto.RegisterType((*BackupReplica)(nil), "varlog.admpb.BackupReplica")
+	proto.RegisterType((*BackupManifest)(nil), "varlog.admpb.BackupManifest")
+	proto.RegisterType((*BackupRequest)(nil), "varlog.admpb.BackupRequest")
+	proto.RegisterType((*BackupResponse)(nil), "varlog.admpb.BackupResponse")
+	proto.RegisterType((*GetMetadataRepositoryNodeRequest)(nil), "varlog.admpb.GetMetadataRepositoryNodeRequest")
+	proto.RegisterType((*GetMetadataRepositoryNodeResponse)(nil), "varlog.admpb.GetMetadataRepositoryNodeResponse")
+	proto.RegisterType((*ListMetadataRepositoryNodesRequest)(nil), "varlog.admpb.ListMetadataRepositoryNodesRequest")
+	proto.RegisterType((*ListMetadataRepositoryNodesResponse)(nil), "varlog.admpb.ListMetadataRepositoryNodesResponse")
+	proto.RegisterType((*GetMRMembersResponse)(nil), "varlog.admpb.GetMRMembersResponse")
+	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.NodeID]string)(nil), "varlog.admpb.GetMRMembersResponse.MembersEntry")
+	proto.RegisterType((*AddMetadataRepositoryNodeRequest)(nil), "varlog.admpb.AddMetadataRepositoryNodeRequest")
+	proto.RegisterType((*AddMetadataRepositoryNodeResponse)(nil), "varlog.admpb.AddMetadataRepositoryNodeResponse")
+	proto.RegisterType((*AddMRPeerRequest)(nil), "varlog.admpb.AddMRPeerRequest")
+	proto.RegisterType((*AddMRPeerResponse)(nil), "varlog.admpb.AddMRPeerResponse")
+	proto.RegisterType((*DeleteMetadataRepositoryNodeRequest)(nil), "varlog.admpb.DeleteMetadataRepositoryNodeRequest")
+	proto.RegisterType((*DeleteMetadataRepositoryNodeResponse)(nil), "varlog.admpb.DeleteMetadataRepositoryNodeResponse")
+	proto.RegisterType((*RemoveMRPeerRequest)(nil), "varlog.admpb.RemoveMRPeerRequest")
+	proto.RegisterType((*RemoveMRPeerResponse)(nil), "varlog.admpb.RemoveMRPeerResponse")
+	proto.RegisterType((*ConsumerGroupHeartbeatRequest)(nil), "varlog.admpb.ConsumerGroupHeartbeatRequest")
+	proto.RegisterType((*ConsumerGroupHeartbeatResponse)(nil), "varlog.admpb.ConsumerGroupHeartbeatResponse")
+	proto.RegisterType((*LeaveConsumerGroupRequest)(nil), "varlog.admpb.LeaveConsumerGroupRequest")
+	proto.RegisterType((*LeaveConsumerGroupResponse)(nil), "varlog.admpb.LeaveConsumerGroupResponse")
+	proto.RegisterType((*CommitConsumerGroupOffsetRequest)(nil), "varlog.admpb.CommitConsumerGroupOffsetRequest")
+	proto.RegisterType((*CommitConsumerGroupOffsetResponse)(nil), "varlog.admpb.CommitConsumerGroupOffsetResponse")
+}
+
+func init() { proto.RegisterFile("proto/admpb/admin.proto", fileDescriptor_acd58c06882c23f8) }
+
+var fileDescriptor_acd58c06882c23f8 = []byte{
+	// 2869 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0x3b, 0x70, 0xdc, 0xc6,
+	0xd9, 0xc4, 0xf1, 0xf8, 0xfa, 0xc8, 0x23, 0xa9, 0x25, 0xc5, 0x07, 0x24, 0x1d, 0x28, 0x88, 0x96,
+	0x65, 0x5b, 0xe6, 0xfd, 0xf6, 0x3f, 0xce, 0x68, 0x14, 0x3b, 0xb6, 0x4e, 0x94, 0x69, 0xc6, 0xa4,
+	0x24, 0x83, 0x62, 0x32, 0xb6, 0x63, 0x9d, 0xc1, 0xc3, 0xf2, 0x84, 0x08, 0x77, 0x40, 0xb0, 0x38,
+	0x5a, 0x2c, 0x92, 0xc9, 0x64, 0x92, 0x49, 0xe3, 0xc2, 0x65, 0xd2, 0x64, 0x3c, 0x69, 0xd3, 0xa4,
+	0x48, 0xe1, 0x26, 0xbd, 0x26, 0x45, 0x46, 0x5d, 0xd2, 0x04, 0x9e, 0x9c, 0x9a, 0xcc, 0x15, 0xe9,
+	0xd2, 0x38, 0x4d, 0x06, 0xbb, 0x0b, 0xdc, 0xe2, 0x71, 0x0f, 0x4a, 0xa4, 0x3d, 0x56, 0x23, 0x1e,
+	0xf6, 0x7b, 0x3f, 0xf6, 0xdb, 0xdd, 0x6f, 0x57, 0xb0, 0xe8, 0xb8, 0xb6, 0x67, 0x97, 0x74, 0xa3,
+	0xee, 0xec, 0x05, 0xff, 0x9a, 0x8d, 0x35, 0x3a, 0x82, 0xa6, 0x0e, 0x74, 0xd7, 0xb2, 0x6b, 0x6b,
+	0x14, 0x22, 0xbf, 0x5c, 0x33, 0xbd, 0x7b, 0xcd, 0xbd, 0xb5, 0xaa, 0x5d, 0x2f, 0xd5, 0xec, 0x9a,
+	0x5d, 0xa2, 0x48, 0x7b, 0xcd, 0x7d, 0xfa, 0xc5, 0x78, 0x04, 0xbf, 0x18, 0xb1, 0x5c, 0xac, 0xd9,
+	0x76, 0xcd, 0xc2, 0x1d, 0x2c, 0xa3, 0xe9, 0xea, 0x9e, 0x69, 0x73, 0xe6, 0xb2, 0x92, 0x84, 0x7b,
+	0x66, 0x1d, 0x13, 0x4f, 0xaf, 0x3b, 0x1c, 0xe1, 0x4c, 0x12, 0x01, 0xd7, 0x1d, 0xef, 0x90, 0x03,
+	0x17, 0x99, 0x6a, 0xce, 0x5e, 0xa9, 0x8e, 0x3d, 0xdd, 0xd0, 0x3d, 0x9d, 0x03, 0x4e, 0x93, 0x86,
+	0xb3, 0x57, 0x72, 0xb1, 0x63, 0x99, 0x55, 0xdd, 0xb3, 0x5d, 0x3e, 0x3c, 0x47, 0x1a, 0x69, 0xdc,
+	0x22, 0x1d, 0xb4, 0xec, 0x5a, 0x85, 0x78, 0x2e, 0xd6, 0xeb, 0x15, 0x17, 0x3b, 0xb6, 0xeb, 0x61,
+	0x4e, 0xa4, 0x7e, 0x91, 0x83, 0xb9, 0x1d, 0xcf, 0x76, 0xf5, 0x1a, 0xbe, 0x69, 0x1b, 0x78, 0x9b,
+	0x53, 0xa3, 0x0f, 0x61, 0x8a, 0xb0, 0xe1, 0x4a, 0xc3, 0x36, 0xf0, 0x92, 0xb4, 0x22, 0x5d, 0x9a,
+	0x7c, 0xf5, 0xc5, 0x35, 0xee, 0xae, 0x80, 0xeb, 0x5a, 0x06, 0xdd, 0x3a, 0x26, 0x55, 0xd7, 0x74,
+	0x3c, 0xdb, 0x2d, 0x4f, 0x3d, 0xf4, 0x95, 0xa1, 0x47, 0xbe, 0x22, 0xb5, 0x7d, 0x65, 0x48, 0x9b,
+	0x24, 0x1d, 0x64, 0xb4, 0x03, 0x93, 0x55, 0x17, 0xeb, 0x1e, 0xae, 0x04, 0x0e, 0x59, 0xca, 0x51,
+	0xde, 0xf2, 0x1a, 0x73, 0xc6, 0x5a, 0xe8, 0x8c, 0xb5, 0x3b, 0xa1, 0xb7, 0xca, 0x0b, 0x01, 0xaf,
+	0xb6, 0xaf, 0x00, 0x23, 0x0b, 0x00, 0x9f, 0x7d, 0xa9, 0x48, 0x9a, 0xf0, 0x8d, 0x4c, 0x98, 0xb3,
+	0x74, 0xe2, 0x55, 0xee, 0x61, 0xdd, 0xf5, 0xf6, 0xb0, 0xee, 0x31, 0xe6, 0xc3, 0x7d, 0x99, 0x9f,
+	0xe3, 0xcc, 0x4f, 0x05, 0xe4, 0xef, 0x84, 0xd4, 0x91, 0x8c, 0xf4, 0xf0, 0xd5, 0xfc, 0xbf, 0x3e,
+	0x57, 0x24, 0xf5, 0x57, 0x12, 0x9c, 0xde, 0xc0, 0x9e, 0xe0, 0x05, 0x0d, 0xff, 0xa4, 0x89, 0x89,
+	0x87, 0x2c, 0x98, 0x11, 0x9d, 0x57, 0x31, 0x0d, 0xea, 0xbf, 0x91, 0xf2, 0x7a, 0xcb, 0x57, 0x0a,
+	0x02, 0xc1, 0xe6, 0xfa, 0x57, 0xbe, 0x52, 0x12, 0x92, 0xee, 0xbe, 0x7e, 0x5f, 0xb7, 0x4b, 0xcc,
+	0xc9, 0x25, 0xe7, 0x7e, 0xad, 0xe4, 0x1d, 0x3a, 0x98, 0xac, 0xc5, 0x48, 0xb4, 0x82, 0xe0, 0xcb,
+	0x4d, 0x43, 0xb5, 0x61, 0x21, 0xa9, 0x06, 0x71, 0xec, 0x06, 0xc1, 0x68, 0x37, 0x33, 0x88, 0xe7,
+	0xd7, 0xc4, 0x9c, 0xcf, 0x8a, 0x62, 0x79, 0xa6, 0xed, 0x2b, 0x62, 0xc4, 0x62, 0xe1, 0x53, 0x97,
+	0x61, 0x71, 0xcb, 0x24, 0xa2, 0x44, 0xc2, 0x2d, 0x57, 0x1f, 0xc0, 0x52, 0x1a, 0xc4, 0xb5, 0xf9,
+	0x11, 0x14, 0x44, 0x6d, 0xc8, 0x92, 0xb4, 0x32, 0x3c, 0x98, 0x3a, 0xf3, 0x3c, 0x42, 0x53, 0x44,
+	0xe4, 0x1b, 0xfb, 0x52, 0xef, 0xc2, 0xe9, 0x6b, 0x86, 0x91, 0x11, 0x8c, 0x1b, 0x99, 0x4e, 0x38,
+	0x1b, 0x4a, 0x0d, 0x27, 0x99, 0x28, 0xb8, 0x9c, 0x7f, 0x98, 0xcc, 0xd9, 0xc0, 0xcb, 0x49, 0xfe,
+	0x27, 0xeb, 0xe5, 0x4f, 0x25, 0x38, 0xbb, 0xdb, 0x70, 0x71, 0xcd, 0x24, 0x1e, 0x76, 0xbf, 0xf1,
+	0x2c, 0x53, 0xe0, 0x5c, 0x17, 0x6d, 0x98, 0x1b, 0xd4, 0x7d, 0x98, 0xd9, 0xc0, 0xde, 0x1d, 0xdb,
+	0x31, 0xab, 0xa1, 0x86, 0x3b, 0x30, 0xee, 0x05, 0xdf, 0x1d, 0xd5, 0xae, 0xb4, 0x7c, 0x65, 0x8c,
+	0xe2, 0x50, 0xa5, 0x5e, 0xe8, 0xaf, 0x14, 0x47, 0xd6, 0xc6, 0x28, 0xa7, 0x4d, 0x43, 0xdd, 0x85,
+	0xd9, 0x8e, 0x1c, 0x1e, 0x82, 0x6b, 0x30, 0x42, 0xc1, 0xdc, 0xf7, 0x2b, 0xa9, 0xe0, 0x52, 0x74,
+	0xa1, 0x38, 0x4d, 0xb4, 0x7d, 0x85, 0x91, 0x68, 0xec, 0x8f, 0x7a, 0x1f, 0xe6, 0x19, 0x7c, 0x0f,
+	0x9f, 0xbc, 0x0d, 0xbf, 0x97, 0xe0, 0x74, 0x42, 0x1a, 0xb7, 0xe4, 0xf5, 0xa3, 0x5a, 0xc2, 0x52,
+	0x95, 0x11, 0xa1, 0x77, 0x61, 0xb2, 0x53, 0xea, 0xc9, 0x52, 0x8e, 0x4e, 0xb0, 0xd5, 0x14, 0x8f,
+	0x2d, 0xbb, 0xb6, 0x43, 0x51, 0x52, 0x7c, 0xc0, 0x0a, 0x41, 0x44, 0x9d, 0x83, 0x53, 0xc1, 0x5c,
+	0xa6, 0x02, 0xa3, 0x09, 0x7e, 0x17, 0x90, 0x38, 0xc8, 0xb5, 0x7e, 0x07, 0x46, 0xa9, 0x02, 0xe1,
+	0x9c, 0xee, 0xaf, 0xf6, 0x34, 0x9f, 0xd2, 0x9c, 0x4e, 0xe3, 0x7f, 0xd5, 0x53, 0x30, 0x73, 0xcd,
+	0x30, 0xc4, 0x08, 0x04, 0x01, 0xef, 0x0c, 0x1d, 0x5f, 0xc0, 0xeb, 0xb0, 0xd0, 0x49, 0xe8, 0x93,
+	0x0f, 0xf9, 0x32, 0x2c, 0xa6, 0xc4, 0xf1, 0x99, 0xf3, 0x67, 0x09, 0x96, 0x77, 0xb0, 0xa7, 0x61,
+	0x0f, 0x37, 0x82, 0xdd, 0xc3, 0x6d, 0xdb, 0x32, 0xab, 0x87, 0x27, 0xa9, 0x0d, 0x7a, 0x17, 0x66,
+	0xdd, 0x50, 0x5c, 0xc5, 0xa1, 0xf2, 0x96, 0x72, 0x5d, 0x5c, 0x99, 0xd4, 0x6b, 0xc6, 0x8d, 0x0f,
+	0xa8, 0x15, 0x90, 0xb3, 0xd4, 0x3f, 0xbe, 0x50, 0x3d, 0x92, 0x60, 0x6e, 0x03, 0x7b, 0x51, 0xda,
+	0x9e, 0xa8, 0x6b, 0x0c, 0x28, 0x08, 0xdb, 0x25, 0xd3, 0xa0, 0x7e, 0x19, 0x29, 0xbf, 0xd5, 0xf2,
+	0x95, 0xc9, 0x48, 0x03, 0xca, 0xfd, 0xe5, 0xfe, 0xdc, 0x05, 0x02, 0x6d, 0x32, 0x9a, 0x5b, 0x9b,
+	0x86, 0xfa, 0x63, 0x98, 0x8f, 0x5b, 0xc4, 0xbd, 0xa5, 0x01, 0x74, 0xa4, 0x73, 0x97, 0x0d, 0x36,
+	0x81, 0x0b, 0x6d, 0x5f, 0x99, 0x88, 0x44, 0x68, 0x9d, 0x9f, 0xaa, 0x05, 0xa7, 0x83, 0x39, 0x1b,
+	0x11, 0x91, 0x13, 0x4d, 0x74, 0x02, 0x0b, 0x49, 0x69, 0xdc, 0xb6, 0xf7, 0xe3, 0xd5, 0x49, 0x3a,
+	0x42, 0x75, 0x42, 0xe1, 0x06, 0xb0, 0x53, 0x9f, 0x62, 0xb5, 0xea, 0x8f, 0x12, 0xcc, 0x5d, 0x33,
+	0x8c, 0xaf, 0x27, 0x43, 0xd6, 0x61, 0x9c, 0x6f, 0xbe, 0xc3, 0x12, 0xab, 0x66, 0x4c, 0x1a, 0x8a,
+	0x90, 0x28, 0xb0, 0x92, 0x16, 0x51, 0xaa, 0x1f, 0xc2, 0x7c, 0x5c, 0x63, 0xee, 0xa5, 0xeb, 0x4f,
+	0x9a, 0x01, 0x62, 0xc8, 0xff, 0x93, 0x83, 0x85, 0x5d, 0xc7, 0xd0, 0x3d, 0xfc, 0x0c, 0x4d, 0x1a,
+	0x74, 0x0b, 0xa6, 0x1d, 0xdb, 0x71, 0xb0, 0x51, 0xe1, 0x5e, 0xe4, 0xbb, 0xfb, 0x41, 0xdd, 0x3f,
+	0xa4, 0x15, 0x18, 0x3d, 0x07, 0x53, 0x86, 0x4d, 0x72, 0x4f, 0x60, 0x98, 0x3f, 0x32, 0x43, 0x4a,
+	0xcf, 0xc1, 0xea, 0x5d, 0x58, 0x4c, 0xb9, 0xfd, 0x38, 0xe3, 0xfa, 0x37, 0x09, 0xe4, 0xce, 0x32,
+	0xf2, 0x2c, 0x15, 0xc4, 0x73, 0x70, 0x26, 0xd3, 0x30, 0xbe, 0x46, 0x3e, 0xcc, 0xc1, 0x39, 0x0d,
+	0xd7, 0xed, 0x03, 0xd1, 0xb3, 0xd4, 0xe7, 0xdf, 0xc8, 0x76, 0x38, 0xe6, 0xe9, 0xdc, 0x89, 0x79,
+	0x7a, 0xf8, 0x24, 0x3c, 0xbd, 0x02, 0xc5, 0x6e, 0x9e, 0x0c, 0x9d, 0x2d, 0xc1, 0xe4, 0x0e, 0xd6,
+	0xad, 0x67, 0x20, 0xad, 0xfe, 0x21, 0xc1, 0x14, 0x33, 0x85, 0x4f, 0x43, 0x23, 0x6b, 0x11, 0x2a,
+	0xc5, 0xfa, 0x1a, 0x49, 0xbf, 0x64, 0x34, 0x37, 0xfa, 0xac, 0x47, 0xa8, 0x06, 0x93, 0x04, 0xeb,
+	0x16, 0x36, 0x2a, 0x35, 0x8b, 0x34, 0xa8, 0x69, 0xf9, 0xf2, 0xdb, 0x2d, 0x5f, 0x81, 0x1d, 0x3a,
+	0xbc, 0xb1, 0xb5, 0x73, 0x33, 0x20, 0x27, 0xd1, 0xd7, 0x57, 0xbe, 0x72, 0xb1, 0xbf, 0x9d, 0x01,
+	0xa6, 0x16, 0x52, 0x59, 0xa4, 0xa1, 0xfe, 0x45, 0x82, 0xc2, 0x6e, 0x83, 0x3c, 0x1b, 0xc1, 0x32,
+	0x60, 0x3a, 0xb4, 0xe5, 0x04, 0xb7, 0x43, 0x5f, 0x0c, 0xc3, 0xe4, 0xce, 0x61, 0xa3, 0xfa, 0x0c,
+	0x2c, 0x88, 0x07, 0x30, 0x47, 0xdc, 0x6a, 0x25, 0x59, 0xf7, 0x58, 0xd9, 0xd8, 0x68, 0xf9, 0xca,
+	0xec, 0x8e, 0x5b, 0x7d, 0xea, 0xd2, 0x37, 0x4b, 0xe2, 0x4c, 0xa8, 0x5c, 0x83, 0x78, 0x29, 0xb9,
+	0xf9, 0x8e, 0xdc, 0x75, 0xe2, 0x3d, 0xbd, 0x5c, 0x23, 0xce, 0xc4, 0x50, 0xdf, 0x84, 0x29, 0x16,
+	0x39, 0x9e, 0x1e, 0x25, 0x18, 0x25, 0x9e, 0xee, 0x35, 0x09, 0x4f, 0x8d, 0xc5, 0x78, 0x7f, 0xf2,
+	0xb0, 0x51, 0xdd, 0xa1, 0x60, 0x8d, 0xa3, 0xa9, 0x7f, 0x95, 0x60, 0xf2, 0x8e, 0x6b, 0x46, 0x0b,
+	0xe6, 0xdd, 0x54, 0xec, 0xaf, 0x0b, 0xb1, 0x6f, 0xfb, 0x4a, 0x18, 0xd0, 0x27, 0x4c, 0x83, 0x0a,
+	0x4c, 0xd0, 0xa6, 0xa4, 0x50, 0x05, 0xca, 0x2d, 0x5f, 0x19, 0xdf, 0xd2, 0x89, 0xc7, 0x6b, 0xc0,
+	0xb8, 0xc5, 0x7f, 0x1f, 0xa1, 0x02, 0x30, 0x9a, 0x60, 0xfe, 0xff, 0x21, 0x07, 0xc0, 0x0c, 0x22,
+	0x4d, 0xcb, 0x43, 0x3f, 0xed, 0xb6, 0x08, 0xee, 0xa6, 0x16, 0xc1, 0xb6, 0xaf, 0xc4, 0xd7, 0xb4,
+	0x63, 0x58, 0x15, 0x49, 0x76, 0xd6, 0xdf, 0x4a, 0x64, 0x7d, 0xd0, 0xf7, 0x12, 0xd2, 0xf8, 0x29,
+	0x27, 0xc1, 0x0b, 0x30, 0x82, 0x5d, 0xd7, 0x76, 0x69, 0xda, 0x4f, 0x94, 0xe7, 0xda, 0xbe, 0x32,
+	0x43, 0x07, 0x2e, 0xdb, 0x75, 0xd3, 0xa3, 0x1d, 0x75, 0x8d, 0x61, 0xa8, 0xef, 0xc0, 0x14, 0x77,
+	0x16, 0xcb, 0x9f, 0x2b, 0x30, 0xe6, 0x52, 0xc7, 0x85, 0x0b, 0xc1, 0x52, 0xbc, 0x6b, 0xd7, 0xf1,
+	0x2c, 0xdf, 0xee, 0x85, 0xe8, 0xea, 0xef, 0xf2, 0x50, 0x28, 0xeb, 0xd5, 0xfb, 0x4d, 0x27, 0xdc,
+	0x4b, 0x7e, 0xc3, 0xae, 0xbf, 0x9b, 0xda, 0x90, 0x1c, 0x6f, 0x26, 0x93, 0xec, 0xbd, 0xc9, 0xc9,
+	0x86, 0xf6, 0x2c, 0xe4, 0x1d, 0xdd, 0xbb, 0x47, 0x0b, 0xcb, 0x44, 0x79, 0xbc, 0xed, 0x2b, 0xf4,
+	0x5b, 0xa3, 0xff, 0xa2, 0x8f, 0xa0, 0x50, 0xb5, 0xeb, 0x75, 0xd3, 0xab, 0xb0, 0xa8, 0x2c, 0x8d,
+	0xc4, 0x37, 0xef, 0xf1, 0xc5, 0xfc, 0x3a, 0x45, 0xe5, 0xd1, 0x8c, 0x3a, 0xca, 0x55, 0x61, 0x54,
+	0x8b, 0x7d, 0xa1, 0xd7, 0x61, 0xb6, 0x6a, 0x5b, 0x46, 0xc5, 0x33, 0xb1, 0x5b, 0x71, 0x5c, 0xbc,
+	0x6f, 0x3e, 0x58, 0x1a, 0xa5, 0x8a, 0xa0, 0xb6, 0xaf, 0x4c, 0x07, 0xb0, 0x3b, 0x26, 0x76, 0x6f,
+	0x53, 0x88, 0x96, 0xf8, 0x56, 0xff, 0x3d, 0x0c, 0xd3, 0x2c, 0x41, 0xb6, 0xf5, 0x86, 0xb9, 0x1f,
+	0x14, 0x9b, 0x7b, 0x00, 0x55, 0xab, 0x49, 0x3c, 0xec, 0x86, 0xc9, 0x51, 0x28, 0x6f, 0xb6, 0x7c,
+	0x65, 0xe2, 0x3a, 0x1b, 0xa5, 0xde, 0x9b, 0xe0, 0x28, 0xd4, 0x77, 0x2f, 0xf5, 0xf7, 0x5d, 0x44,
+	0xab, 0x75, 0x28, 0x91, 0x06, 0x63, 0x07, 0xd8, 0x25, 0xa6, 0x1d, 0x16, 0x9d, 0x2b, 0x41, 0x02,
+	0xf0, 0xa1, 0xc1, 0x12, 0xe0, 0x07, 0x0c, 0x59, 0x0b, 0xa9, 0xd0, 0x6b, 0x50, 0xd0, 0x1d, 0xc7,
+	0x32, 0xb1, 0x51, 0x31, 0x1b, 0x06, 0x7e, 0x40, 0x13, 0x20, 0x5f, 0x9e, 0x0d, 0xbc, 0xc8, 0x01,
+	0x9b, 0xc1, 0xb8, 0x16, 0xfb, 0x4a, 0xde, 0xf5, 0xe4, 0x8f, 0xe5, 0xae, 0x67, 0x03, 0xe6, 0xc2,
+	0x7b, 0x2e, 0x7a, 0xa1, 0x45, 0x4c, 0xcf, 0x76, 0x0f, 0x69, 0xfc, 0x27, 0xca, 0x0b, 0x6d, 0x5f,
+	0x41, 0x21, 0x58, 0x8b, 0xa0, 0x5a, 0xc6, 0x18, 0xda, 0x14, 0x8e, 0xf2, 0xa3, 0xb4, 0x02, 0x9c,
+	0x89, 0x57, 0x80, 0xd8, 0x1c, 0x2f, 0xcf, 0x72, 0xdd, 0x22, 0x22, 0xe1, 0x3c, 0xff, 0x72, 0xa7,
+	0x20, 0xb0, 0xb5, 0x25, 0x4c, 0x5e, 0x29, 0x2b, 0x79, 0xd5, 0xdb, 0x61, 0x7a, 0x44, 0xc5, 0xe8,
+	0x7b, 0x30, 0x5e, 0xe7, 0xa9, 0x92, 0xbc, 0xa4, 0x10, 0x75, 0x09, 0xd3, 0x89, 0x57, 0xa4, 0x88,
+	0x46, 0x25, 0xb0, 0xb2, 0x81, 0xbd, 0xed, 0x94, 0x91, 0xe2, 0x9d, 0xc1, 0x2d, 0x18, 0x13, 0x8b,
+	0x53, 0xbe, 0xfc, 0x9d, 0x96, 0xaf, 0x8c, 0x46, 0x4b, 0xf4, 0xa5, 0xfe, 0x79, 0xc1, 0x70, 0xb5,
+	0xd1, 0x06, 0x5b, 0x91, 0x3f, 0x86, 0xf3, 0x3d, 0x84, 0x72, 0xcb, 0xbe, 0x0b, 0x79, 0xe1, 0x66,
+	0xe4, 0xf9, 0xd4, 0xfe, 0xad, 0x0b, 0x39, 0x25, 0x52, 0x57, 0x41, 0x0d, 0xfa, 0x49, 0xd9, 0x38,
+	0x51, 0x5f, 0x9a, 0xc0, 0x85, 0x9e, 0x58, 0x5c, 0x93, 0x2d, 0x18, 0x11, 0xef, 0x9e, 0x06, 0x55,
+	0xa5, 0x5c, 0xe0, 0x81, 0x67, 0xd4, 0x1a, 0xfb, 0xa3, 0xfe, 0x33, 0x47, 0xbb, 0x78, 0xdb, 0xda,
+	0x36, 0xae, 0xef, 0x61, 0xb7, 0x23, 0x66, 0x1d, 0x46, 0x2d, 0xac, 0x1b, 0xd8, 0xe5, 0x5e, 0xbe,
+	0x7c, 0x34, 0xdf, 0x32, 0x5a, 0x74, 0x13, 0x50, 0x78, 0xc9, 0x1b, 0xb4, 0x69, 0xf7, 0xf5, 0xaa,
+	0x67, 0xbb, 0xbc, 0xb8, 0x2b, 0x6d, 0x5f, 0x39, 0x23, 0x40, 0xdf, 0xa6, 0x40, 0x61, 0xc5, 0x3b,
+	0x95, 0x02, 0xa2, 0x4f, 0x60, 0xac, 0xce, 0x14, 0x5d, 0x1a, 0x8e, 0x1f, 0x7b, 0x58, 0x7e, 0x65,
+	0x99, 0xb2, 0xc6, 0xbf, 0x6f, 0x34, 0x3c, 0xf7, 0xb0, 0x7c, 0xf9, 0x17, 0x5f, 0x1e, 0xc1, 0x8e,
+	0x50, 0x9a, 0x7c, 0x15, 0xa6, 0x44, 0x36, 0x68, 0x16, 0x86, 0xef, 0xe3, 0x43, 0xe6, 0x1b, 0x2d,
+	0xf8, 0x89, 0xe6, 0x61, 0xe4, 0x40, 0xb7, 0x9a, 0xec, 0x2e, 0x78, 0x42, 0x63, 0x1f, 0x57, 0x73,
+	0x57, 0x24, 0xd5, 0x85, 0x95, 0x6b, 0x86, 0xd1, 0x3b, 0xab, 0x2f, 0xc2, 0xb8, 0xab, 0xef, 0x7b,
+	0x95, 0xa6, 0x6b, 0xf1, 0xd9, 0x36, 0x19, 0xac, 0x7d, 0x9a, 0xbe, 0xef, 0xed, 0x6a, 0x5b, 0xda,
+	0x58, 0x00, 0xdc, 0x75, 0x2d, 0x8a, 0xe7, 0x54, 0x2b, 0xba, 0x61, 0x30, 0x37, 0x86, 0x78, 0xb7,
+	0xaf, 0x5f, 0x33, 0x0c, 0x57, 0x1b, 0x73, 0x9d, 0x6a, 0xf0, 0x23, 0x48, 0xea, 0x1e, 0x32, 0x8f,
+	0x23, 0xa9, 0xf7, 0xe8, 0x9d, 0xc6, 0xb6, 0x76, 0x1b, 0x63, 0xf7, 0xa4, 0xac, 0x78, 0x00, 0xa7,
+	0x04, 0x19, 0x5c, 0xeb, 0x6a, 0xb2, 0x00, 0x7c, 0xbf, 0x53, 0x00, 0xda, 0xbe, 0x32, 0xcb, 0xa6,
+	0x75, 0x27, 0x8f, 0x9e, 0xa8, 0x28, 0xfc, 0x5c, 0x82, 0x0b, 0xeb, 0xd8, 0xc2, 0x1e, 0xee, 0x1d,
+	0xb7, 0xf7, 0x93, 0xca, 0xbc, 0x15, 0x53, 0x86, 0xb3, 0x7b, 0x22, 0x15, 0x2e, 0xc2, 0x6a, 0x6f,
+	0x0d, 0x78, 0xab, 0xe3, 0x0d, 0x98, 0x63, 0xcd, 0x90, 0x27, 0x8a, 0x85, 0xba, 0x00, 0xf3, 0x71,
+	0x72, 0xce, 0xf6, 0xbf, 0x39, 0x38, 0x77, 0xdd, 0x6e, 0x90, 0x66, 0x1d, 0xbb, 0x1b, 0xae, 0xdd,
+	0x74, 0xa2, 0x07, 0x04, 0xa1, 0x84, 0x79, 0x18, 0xa9, 0x05, 0x00, 0xc6, 0x5e, 0x63, 0x1f, 0x27,
+	0xbe, 0x8b, 0x7b, 0x0d, 0x26, 0xd8, 0xa4, 0x0c, 0x77, 0x70, 0x13, 0xe5, 0xa5, 0xe0, 0x3c, 0xc2,
+	0xa6, 0x27, 0x95, 0x30, 0xce, 0x10, 0x36, 0x0d, 0x2d, 0xfa, 0x85, 0x3e, 0x95, 0x60, 0xde, 0xfe,
+	0xa4, 0x81, 0x8d, 0x4a, 0x6c, 0x0f, 0x48, 0x96, 0xf2, 0x2b, 0xc3, 0x97, 0x46, 0xca, 0x1f, 0xb6,
+	0x7c, 0xe5, 0xd4, 0xad, 0x00, 0x2e, 0xec, 0xe4, 0x48, 0xf0, 0xa4, 0xc2, 0x8e, 0x0f, 0x1a, 0xe4,
+	0xe8, 0x1b, 0xc2, 0x34, 0x0f, 0x54, 0x04, 0xa8, 0xe1, 0x06, 0x66, 0x6f, 0x6d, 0xe8, 0xaa, 0x9f,
+	0xd7, 0x84, 0x11, 0xf5, 0xb7, 0x39, 0x28, 0x76, 0xf3, 0x3e, 0x9f, 0x07, 0x71, 0x16, 0x52, 0x92,
+	0x05, 0xfa, 0x04, 0xa6, 0x13, 0xa6, 0xe6, 0xa8, 0xa9, 0xef, 0xb5, 0x7c, 0x65, 0x2a, 0x61, 0xe5,
+	0x94, 0xf5, 0x54, 0x06, 0xc6, 0xc8, 0xd1, 0x5d, 0x98, 0x21, 0x98, 0x04, 0x3b, 0x2e, 0xba, 0x61,
+	0xb2, 0x9b, 0x1e, 0x6f, 0x72, 0x2f, 0xa7, 0xf6, 0x4c, 0xeb, 0xfc, 0xb5, 0x51, 0x59, 0xe6, 0xab,
+	0xd3, 0x34, 0xa7, 0xbc, 0xc3, 0x08, 0x7f, 0x13, 0x6c, 0x9b, 0x12, 0x63, 0x41, 0x6f, 0x6f, 0x79,
+	0x0b, 0xeb, 0x07, 0x38, 0xe6, 0xa0, 0x6f, 0x63, 0x56, 0xaa, 0x67, 0x41, 0xce, 0xb2, 0x84, 0x4f,
+	0xc1, 0x3f, 0x0d, 0xc3, 0x0a, 0xdb, 0xf3, 0xc7, 0xe0, 0xb7, 0xf6, 0xf7, 0x09, 0xfe, 0x76, 0xce,
+	0xc2, 0x78, 0xce, 0xe6, 0x53, 0x39, 0x9b, 0x3a, 0xa2, 0x8d, 0x7c, 0x0d, 0x47, 0xb4, 0x9b, 0x90,
+	0xb7, 0x82, 0xe6, 0xc6, 0x28, 0x2d, 0xe0, 0x57, 0x5b, 0xbe, 0x92, 0xdf, 0x62, 0x8d, 0x0d, 0x3a,
+	0x3e, 0x58, 0x53, 0x23, 0xa0, 0xd0, 0x28, 0xbe, 0x7a, 0x01, 0xce, 0xf7, 0x88, 0x1a, 0x8b, 0xed,
+	0xab, 0x8f, 0x16, 0x61, 0x9a, 0x1f, 0x7c, 0xb6, 0xf5, 0x86, 0x5e, 0xc3, 0x2e, 0xfa, 0x08, 0xa6,
+	0xe3, 0xaf, 0xa0, 0xd0, 0x85, 0xd4, 0xee, 0x26, 0xfd, 0x88, 0x46, 0x5e, 0xed, 0x8d, 0xc4, 0x73,
+	0x69, 0x08, 0x55, 0x61, 0x36, 0xf9, 0xb0, 0x09, 0x3d, 0x17, 0xa7, 0xed, 0xf2, 0x26, 0x4a, 0xbe,
+	0xd8, 0x0f, 0x2d, 0x12, 0xf2, 0x11, 0x4c, 0xc7, 0xdf, 0x18, 0x25, 0x6d, 0xc8, 0x7c, 0xe1, 0x24,
+	0xaf, 0xf6, 0x46, 0x8a, 0xd8, 0xbb, 0x70, 0x3a, 0xf3, 0x09, 0x0f, 0x7a, 0x31, 0xce, 0xa0, 0xd7,
+	0xab, 0x23, 0xf9, 0xa5, 0x81, 0x70, 0x23, 0x99, 0xef, 0xc2, 0x78, 0xf8, 0x5a, 0x07, 0x9d, 0x4b,
+	0xf9, 0x5a, 0x7c, 0x76, 0x21, 0x17, 0xbb, 0x81, 0x23, 0x66, 0x1f, 0x40, 0x21, 0xf6, 0x6a, 0x06,
+	0xa9, 0x71, 0x92, 0xac, 0x07, 0x3c, 0xf2, 0x85, 0x9e, 0x38, 0x11, 0xef, 0xf7, 0x00, 0x3a, 0x0f,
+	0x5b, 0x90, 0x92, 0x8e, 0x59, 0xec, 0x1d, 0x8c, 0xbc, 0xd2, 0x1d, 0x41, 0xb4, 0x3d, 0x7c, 0xb8,
+	0x92, 0xb4, 0x3d, 0xf1, 0xc6, 0x45, 0x2e, 0x76, 0x03, 0x47, 0xcc, 0x3e, 0x86, 0x99, 0xc4, 0xfb,
+	0x11, 0xb4, 0xda, 0x2d, 0x14, 0x31, 0xd6, 0xcf, 0xf5, 0xc1, 0x8a, 0x24, 0x98, 0x80, 0xd2, 0xcf,
+	0x38, 0xd0, 0xf3, 0x71, 0xf2, 0xae, 0xef, 0x54, 0xe4, 0x4b, 0xfd, 0x11, 0x23, 0x51, 0x3f, 0x84,
+	0x29, 0xf1, 0xf5, 0x03, 0x3a, 0x9f, 0x0a, 0x7d, 0xf2, 0x6a, 0x53, 0x56, 0x7b, 0xa1, 0x88, 0x33,
+	0x28, 0xfe, 0xf8, 0x20, 0x39, 0x83, 0x32, 0x1f, 0x42, 0xc8, 0xab, 0xbd, 0x91, 0x44, 0xbd, 0xc5,
+	0x3b, 0xfb, 0xa4, 0xde, 0x19, 0x2f, 0x10, 0x64, 0xb5, 0x17, 0x4a, 0x2c, 0xba, 0xf1, 0x7b, 0xe3,
+	0x54, 0x74, 0x33, 0x6f, 0xf3, 0xe5, 0xe7, 0xfa, 0x60, 0x45, 0x12, 0x2c, 0x98, 0xcb, 0xb8, 0x5f,
+	0x45, 0x97, 0xba, 0x65, 0x47, 0x4a, 0xd2, 0x0b, 0x03, 0x60, 0x46, 0xd2, 0x9a, 0xb0, 0x90, 0x7d,
+	0xc7, 0x88, 0x12, 0xf5, 0xa3, 0xe7, 0x9d, 0xae, 0x7c, 0x79, 0x30, 0xe4, 0x48, 0xec, 0x9b, 0x90,
+	0x0f, 0xee, 0xd7, 0xd0, 0x72, 0x32, 0x17, 0xa3, 0xeb, 0x31, 0x59, 0xce, 0x02, 0x45, 0x0c, 0x6e,
+	0xc0, 0x28, 0xbb, 0x81, 0x42, 0x67, 0x92, 0xe6, 0x0a, 0x77, 0x6c, 0xf2, 0xd9, 0x6c, 0x60, 0x4c,
+	0x8f, 0xc3, 0x46, 0x35, 0xa5, 0x47, 0xe7, 0xd6, 0x49, 0x96, 0xb3, 0x40, 0x22, 0x83, 0xa0, 0xf7,
+	0x9c, 0x64, 0x20, 0x5c, 0x5d, 0xc8, 0x72, 0x16, 0x48, 0x34, 0x84, 0xb5, 0x8b, 0x50, 0x97, 0x86,
+	0x56, 0xa6, 0x21, 0xf1, 0x8e, 0x94, 0x3a, 0x84, 0x7e, 0x06, 0xcb, 0x5d, 0xdb, 0x3b, 0x68, 0x2d,
+	0xdd, 0x3e, 0xe8, 0x75, 0xdc, 0x93, 0x4b, 0x03, 0xe3, 0x47, 0xf2, 0x7f, 0x29, 0xc1, 0x99, 0x1e,
+	0x7d, 0x1d, 0xf4, 0x7f, 0xe9, 0x89, 0xdb, 0xbb, 0x51, 0x24, 0xbf, 0x72, 0x04, 0x8a, 0x48, 0x8d,
+	0x2d, 0x98, 0x12, 0x9b, 0x23, 0x68, 0x21, 0xb5, 0x17, 0xbf, 0x11, 0x1c, 0x96, 0x33, 0x8a, 0x54,
+	0xaa, 0xa1, 0xc2, 0x9c, 0xda, 0xb5, 0xbd, 0x90, 0x74, 0x6a, 0xbf, 0xde, 0x87, 0x5c, 0x1a, 0x18,
+	0x3f, 0x92, 0x7f, 0x13, 0x26, 0xa2, 0xc6, 0x00, 0x4a, 0xaf, 0x3c, 0xb1, 0x93, 0xb0, 0xac, 0x74,
+	0x85, 0x47, 0xfc, 0x7e, 0x2d, 0xc1, 0xd9, 0x5e, 0x87, 0x6d, 0xf4, 0x4a, 0x72, 0x09, 0xee, 0xdb,
+	0x1a, 0x90, 0x5f, 0x3d, 0x0a, 0x89, 0x58, 0x9f, 0xc5, 0xe3, 0x78, 0xb2, 0x3e, 0x67, 0x9c, 0xf4,
+	0x65, 0xb5, 0x17, 0x8a, 0x58, 0xcf, 0xb2, 0x0f, 0x94, 0xc9, 0x7a, 0xd6, 0xf3, 0xd0, 0x2f, 0x5f,
+	0x1e, 0x0c, 0x59, 0x5c, 0x92, 0xd3, 0x27, 0x9c, 0xe4, 0x92, 0xdc, 0xf5, 0x34, 0x27, 0x5f, 0xea,
+	0x8f, 0x28, 0x26, 0x65, 0xd7, 0x7d, 0x77, 0x32, 0x29, 0xfb, 0x1d, 0xab, 0xe4, 0xd2, 0xc0, 0xf8,
+	0xa1, 0xfc, 0xf2, 0x1b, 0x0f, 0x5b, 0x45, 0xe9, 0x51, 0xab, 0x28, 0x7d, 0xf6, 0xb8, 0x38, 0xf4,
+	0xf9, 0xe3, 0xa2, 0xf4, 0xe8, 0x71, 0x71, 0xe8, 0xef, 0x8f, 0x8b, 0x43, 0x1f, 0x5c, 0xe8, 0x7a,
+	0x7e, 0xe8, 0xfc, 0x9f, 0x9e, 0xbd, 0x51, 0xfa, 0xf1, 0xff, 0xff, 0x1b, 0x00, 0x11, 0x1d, 0x79,
+	0x3d, 0xe9, 0x33, 0x00, 0x00,
+}
+
+func (this *StorageNodeMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StorageNodeMetadata)
+	if !ok {
+		that2, ok := that.(StorageNodeMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.StorageNodeMetadataDescriptor.Equal(&that1.StorageNodeMetadataDescriptor) {
+		return false
+	}
+	if !this.CreateTime.Equal(that1.CreateTime) {
+		return false
+	}
+	if !this.LastHeartbeatTime.Equal(that1.LastHeartbeatTime) {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ClusterManagerClient is the client API for ClusterManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ClusterManagerClient interface {
+	// GetStorageNode returns the metadata of the storage node requested.
+	// It produces a gRPC NotFound error if the storage node does not exist. If
+	// the metadata repository cannot be reachable, it returns a gRPC Unavailable
+	// error. In this case, clients can retry with proper backoff to fix it.
+	GetStorageNode(ctx context.Context, in *GetStorageNodeRequest, opts ...grpc.CallOption) (*GetStorageNodeResponse, error)
+	// ListStorageNodes returns a list of storage nodes.
+	// If the metadata repository cannot be reachable, it returns a gRPC
+	// Unavailable error. In this case, clients can retry with proper backoff to
+	// fix it. If the metadata fetched from the metadata repository is
+	// inconsistent, it returns a gRPC Internal error.
+	ListStorageNodes(ctx context.Context, in *ListStorageNodesRequest, opts ...grpc.CallOption) (*ListStorageNodesResponse, error)
+	// AddStorageNode adds a new storage node to the cluster. It is idempotent;
+	// adding an already added storage node is okay.
+	// Note that if the admin server cannot refresh the storage node list in the
+	// memory, it returns a gRPC Unavailable error. However, the storage node
+	// could have been added, so users should call this RPC with the same
+	// parameter again.
+	AddStorageNode(ctx context.Context, in *AddStorageNodeRequest, opts ...grpc.CallOption) (*AddStorageNodeResponse, error)
+	// UnregisterStorageNode unregisters the storage node specified by the
+	// argument snid. If users try to unregister an already non-exist node, it
+	// returns okay.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - FailedPrecondition: The storage node still has valid log stream replicas.
+	UnregisterStorageNode(ctx context.Context, in *UnregisterStorageNodeRequest, opts ...grpc.CallOption) (*UnregisterStorageNodeResponse, error)
+	// GetTopic returns the topic specified by the request.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - NotFound: The topic doesn't exist.
+	GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error)
+	// DescribeTopic returns the topic specified by the request.
+	// Deprecated: Use GetTopic.
+	DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error)
+	// ListTopics returns a list of topics in the cluster.
+	ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error)
+	// AddTopic adds a new topic and returns its metadata.
+	// It produces a gRPC Internal error if the metadata rejects the request.
+	AddTopic(ctx context.Context, in *AddTopicRequest, opts ...grpc.CallOption) (*AddTopicResponse, error)
+	// UnregisterTopic unregisters the topic specified by the argument tpid.
+	// It returns a gRPC Unavailable error if the metadata cannot be fetched from
+	// the metadata repository.
+	// TODO: Its behavior is unclear if the topic has already been removed.
+	// FIXME: It overwrites the gRPC errors returned from the metadata repository,
+	// even if some may be important.
+	UnregisterTopic(ctx context.Context, in *UnregisterTopicRequest, opts ...grpc.CallOption) (*UnregisterTopicResponse, error)
+	// SetRetentionPolicy replaces the retention policy of the topic specified
+	// by the request and returns the updated topic.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The retention policy is invalid.
+	// - NotFound: The topic doesn't exist.
+	// - Unavailable: The metadata repository is not accessible.
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error)
+	// GetLogStream returns the metadata of the log stream specified by the
+	// arguments tpid and lsid. The metadata is the type stored in the metadata
+	// repository.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - NotFound: Either the topic or the log stream does not exist.
+	// - Internal: The TopicID in the log stream metadata doesn't match.
+	GetLogStream(ctx context.Context, in *GetLogStreamRequest, opts ...grpc.CallOption) (*GetLogStreamResponse, error)
+	// ListLogStreams returns all log streams belonging to the topic specified by
+	// the argument tpid.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - NotFound: The topic does not exist.
+	// - Internal: The TopicID in the log stream metadata doesn't match.
+	ListLogStreams(ctx context.Context, in *ListLogStreamsRequest, opts ...grpc.CallOption) (*ListLogStreamsResponse, error)
+	// AddLogStream adds a new log stream to the cluster.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - ResourceExhausted: The number of log streams is reached the upper limit.
+	// - FailedPrecondition: Replicas information in the request is invalid; for
+	// example, the number of log stream replicas in the request does not equal
+	// the replication factor, or the storage node for the replica does not exist.
+	//
 	// TODO: Not all errors are codified.
-	AddLogStream(context.Context, *AddLogStreamRequest) (*AddLogStreamResponse, error)
+	AddLogStream(ctx context.Context, in *AddLogStreamRequest, opts ...grpc.CallOption) (*AddLogStreamResponse, error)
 	// UpdateLogStream changes the configuration of replicas in a log stream.
 	//
 	// Its codes are defines as followings:
@@ -3189,11 +3630,11 @@ type ClusterManagerServer interface {
 	// TODO: Moving the data directory within the same node is not supported yet.
 	// TODO: We will define codes for errors returned from storage nodes and
 	// metadata repository soon.
-	UpdateLogStream(context.Context, *UpdateLogStreamRequest) (*UpdateLogStreamResponse, error)
+	UpdateLogStream(ctx context.Context, in *UpdateLogStreamRequest, opts ...grpc.CallOption) (*UpdateLogStreamResponse, error)
 	// UnregisterLogStream unregisters the log stream specified by the arguments
 	// tpid and lsid.
 	// TODO: It is not tested.
-	UnregisterLogStream(context.Context, *UnregisterLogStreamRequest) (*UnregisterLogStreamResponse, error)
+	UnregisterLogStream(ctx context.Context, in *UnregisterLogStreamRequest, opts ...grpc.CallOption) (*UnregisterLogStreamResponse, error)
 	// RemoveLogStreamReplica removes the log stream replica specified by the
 	// arguments snid, tpid, and lsid.
 	//
@@ -3201,1106 +3642,1349 @@ type ClusterManagerServer interface {
 	// - Unavailable: The metadata cannot be fetched from the metadata repository.
 	// - FailedPrecondition: The log stream of the log stream replica can't be
 	// removable, e.g., clients still can append logs to the log stream.
-	RemoveLogStreamReplica(context.Context, *RemoveLogStreamReplicaRequest) (*RemoveLogStreamReplicaResponse, error)
-	Seal(context.Context, *SealRequest) (*SealResponse, error)
-	Unseal(context.Context, *UnsealRequest) (*UnsealResponse, error)
-	Sync(context.Context, *SyncRequest) (*SyncResponse, error)
-	Trim(context.Context, *TrimRequest) (*TrimResponse, error)
-	GetMetadataRepositoryNode(context.Context, *GetMetadataRepositoryNodeRequest) (*GetMetadataRepositoryNodeResponse, error)
-	ListMetadataRepositoryNodes(context.Context, *ListMetadataRepositoryNodesRequest) (*ListMetadataRepositoryNodesResponse, error)
-	GetMRMembers(context.Context, *types.Empty) (*GetMRMembersResponse, error)
-	AddMetadataRepositoryNode(context.Context, *AddMetadataRepositoryNodeRequest) (*AddMetadataRepositoryNodeResponse, error)
-	AddMRPeer(context.Context, *AddMRPeerRequest) (*AddMRPeerResponse, error)
-	DeleteMetadataRepositoryNode(context.Context, *DeleteMetadataRepositoryNodeRequest) (*DeleteMetadataRepositoryNodeResponse, error)
-	RemoveMRPeer(context.Context, *RemoveMRPeerRequest) (*RemoveMRPeerResponse, error)
+	RemoveLogStreamReplica(ctx context.Context, in *RemoveLogStreamReplicaRequest, opts ...grpc.CallOption) (*RemoveLogStreamReplicaResponse, error)
+	Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error)
+	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error)
+	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
+	Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error)
+	// Backup takes a point-in-time backup of the cluster into a directory. It
+	// freezes a consistent cut at the last commit of the metadata repository,
+	// and copies all log stream replicas at the cut.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The path is empty.
+	// - AlreadyExists: The path already exists.
+	// - FailedPrecondition: Some storage nodes cannot access the path written
+	// by the admin server, that is, the path is not in a shared file system.
+	// - Unavailable: The metadata repository or storage nodes cannot be
+	// reachable.
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error)
+	GetMetadataRepositoryNode(ctx context.Context, in *GetMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*GetMetadataRepositoryNodeResponse, error)
+	ListMetadataRepositoryNodes(ctx context.Context, in *ListMetadataRepositoryNodesRequest, opts ...grpc.CallOption) (*ListMetadataRepositoryNodesResponse, error)
+	GetMRMembers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GetMRMembersResponse, error)
+	AddMetadataRepositoryNode(ctx context.Context, in *AddMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*AddMetadataRepositoryNodeResponse, error)
+	AddMRPeer(ctx context.Context, in *AddMRPeerRequest, opts ...grpc.CallOption) (*AddMRPeerResponse, error)
+	DeleteMetadataRepositoryNode(ctx context.Context, in *DeleteMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*DeleteMetadataRepositoryNodeResponse, error)
+	RemoveMRPeer(ctx context.Context, in *RemoveMRPeerRequest, opts ...grpc.CallOption) (*RemoveMRPeerResponse, error)
+	// ConsumerGroupHeartbeat renews the session of the member in the consumer
+	// group of the topic, and returns log streams assigned to the member. The
+	// member joins the group by its first heartbeat. Log streams of the topic
+	// are divided among members of the group, and the group rebalances when
+	// members join or leave, or log streams are added to the topic.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The group or member ID is empty.
+	// - NotFound: The topic does not exist.
+	// - FailedPrecondition: The session of the member has expired. The member
+	//   should stop consuming all log streams without committing their offsets
+	//   and join the group again with generation zero.
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	ConsumerGroupHeartbeat(ctx context.Context, in *ConsumerGroupHeartbeatRequest, opts ...grpc.CallOption) (*ConsumerGroupHeartbeatResponse, error)
+	// LeaveConsumerGroup removes the member from the consumer group of the
+	// topic. It is okay to leave the group that the member does not belong to.
+	LeaveConsumerGroup(ctx context.Context, in *LeaveConsumerGroupRequest, opts ...grpc.CallOption) (*LeaveConsumerGroupResponse, error)
+	// CommitConsumerGroupOffset commits the offset of the log stream consumed
+	// by the member in the consumer group of the topic. Unlike committing
+	// offsets to the metadata repository directly, it is fenced: the commit is
+	// accepted only if the session of the member is alive, the generation is
+	// the one returned by its last heartbeat, and the member either consumes
+	// or is granted the log stream.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The group or member ID is empty.
+	// - NotFound: The topic or log stream does not exist.
+	// - FailedPrecondition: The member is fenced.
+	// - Unavailable: The metadata repository is not reachable.
+	CommitConsumerGroupOffset(ctx context.Context, in *CommitConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*CommitConsumerGroupOffsetResponse, error)
 }
 
-// UnimplementedClusterManagerServer can be embedded to have forward compatible implementations.
-type UnimplementedClusterManagerServer struct {
+type clusterManagerClient struct {
+	cc *grpc.ClientConn
 }
 
-func (*UnimplementedClusterManagerServer) GetStorageNode(ctx context.Context, req *GetStorageNodeRequest) (*GetStorageNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStorageNode not implemented")
-}
-func (*UnimplementedClusterManagerServer) ListStorageNodes(ctx context.Context, req *ListStorageNodesRequest) (*ListStorageNodesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListStorageNodes not implemented")
-}
-func (*UnimplementedClusterManagerServer) AddStorageNode(ctx context.Context, req *AddStorageNodeRequest) (*AddStorageNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddStorageNode not implemented")
+func NewClusterManagerClient(cc *grpc.ClientConn) ClusterManagerClient {
+	return &clusterManagerClient{cc}
 }
-func (*UnimplementedClusterManagerServer) UnregisterStorageNode(ctx context.Context, req *UnregisterStorageNodeRequest) (*UnregisterStorageNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterStorageNode not implemented")
+
+func (c *clusterManagerClient) GetStorageNode(ctx context.Context, in *GetStorageNodeRequest, opts ...grpc.CallOption) (*GetStorageNodeResponse, error) {
+	out := new(GetStorageNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetStorageNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedClusterManagerServer) GetTopic(ctx context.Context, req *GetTopicRequest) (*GetTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTopic not implemented")
-}
-func (*UnimplementedClusterManagerServer) DescribeTopic(ctx context.Context, req *DescribeTopicRequest) (*DescribeTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DescribeTopic not implemented")
-}
-func (*UnimplementedClusterManagerServer) ListTopics(ctx context.Context, req *ListTopicsRequest) (*ListTopicsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTopics not implemented")
-}
-func (*UnimplementedClusterManagerServer) AddTopic(ctx context.Context, req *AddTopicRequest) (*AddTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddTopic not implemented")
-}
-func (*UnimplementedClusterManagerServer) UnregisterTopic(ctx context.Context, req *UnregisterTopicRequest) (*UnregisterTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterTopic not implemented")
-}
-func (*UnimplementedClusterManagerServer) GetLogStream(ctx context.Context, req *GetLogStreamRequest) (*GetLogStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLogStream not implemented")
-}
-func (*UnimplementedClusterManagerServer) ListLogStreams(ctx context.Context, req *ListLogStreamsRequest) (*ListLogStreamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListLogStreams not implemented")
-}
-func (*UnimplementedClusterManagerServer) AddLogStream(ctx context.Context, req *AddLogStreamRequest) (*AddLogStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddLogStream not implemented")
-}
-func (*UnimplementedClusterManagerServer) UpdateLogStream(ctx context.Context, req *UpdateLogStreamRequest) (*UpdateLogStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateLogStream not implemented")
-}
-func (*UnimplementedClusterManagerServer) UnregisterLogStream(ctx context.Context, req *UnregisterLogStreamRequest) (*UnregisterLogStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterLogStream not implemented")
-}
-func (*UnimplementedClusterManagerServer) RemoveLogStreamReplica(ctx context.Context, req *RemoveLogStreamReplicaRequest) (*RemoveLogStreamReplicaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveLogStreamReplica not implemented")
-}
-func (*UnimplementedClusterManagerServer) Seal(ctx context.Context, req *SealRequest) (*SealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Seal not implemented")
-}
-func (*UnimplementedClusterManagerServer) Unseal(ctx context.Context, req *UnsealRequest) (*UnsealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
-}
-func (*UnimplementedClusterManagerServer) Sync(ctx context.Context, req *SyncRequest) (*SyncResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
-}
-func (*UnimplementedClusterManagerServer) Trim(ctx context.Context, req *TrimRequest) (*TrimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Trim not implemented")
-}
-func (*UnimplementedClusterManagerServer) GetMetadataRepositoryNode(ctx context.Context, req *GetMetadataRepositoryNodeRequest) (*GetMetadataRepositoryNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataRepositoryNode not implemented")
-}
-func (*UnimplementedClusterManagerServer) ListMetadataRepositoryNodes(ctx context.Context, req *ListMetadataRepositoryNodesRequest) (*ListMetadataRepositoryNodesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMetadataRepositoryNodes not implemented")
-}
-func (*UnimplementedClusterManagerServer) GetMRMembers(ctx context.Context, req *types.Empty) (*GetMRMembersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMRMembers not implemented")
-}
-func (*UnimplementedClusterManagerServer) AddMetadataRepositoryNode(ctx context.Context, req *AddMetadataRepositoryNodeRequest) (*AddMetadataRepositoryNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMetadataRepositoryNode not implemented")
-}
-func (*UnimplementedClusterManagerServer) AddMRPeer(ctx context.Context, req *AddMRPeerRequest) (*AddMRPeerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMRPeer not implemented")
-}
-func (*UnimplementedClusterManagerServer) DeleteMetadataRepositoryNode(ctx context.Context, req *DeleteMetadataRepositoryNodeRequest) (*DeleteMetadataRepositoryNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteMetadataRepositoryNode not implemented")
-}
-func (*UnimplementedClusterManagerServer) RemoveMRPeer(ctx context.Context, req *RemoveMRPeerRequest) (*RemoveMRPeerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMRPeer not implemented")
+
+func (c *clusterManagerClient) ListStorageNodes(ctx context.Context, in *ListStorageNodesRequest, opts ...grpc.CallOption) (*ListStorageNodesResponse, error) {
+	out := new(ListStorageNodesResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListStorageNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterClusterManagerServer(s *grpc.Server, srv ClusterManagerServer) {
-	s.RegisterService(&_ClusterManager_serviceDesc, srv)
+func (c *clusterManagerClient) AddStorageNode(ctx context.Context, in *AddStorageNodeRequest, opts ...grpc.CallOption) (*AddStorageNodeResponse, error) {
+	out := new(AddStorageNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddStorageNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _ClusterManager_GetStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStorageNodeRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) UnregisterStorageNode(ctx context.Context, in *UnregisterStorageNodeRequest, opts ...grpc.CallOption) (*UnregisterStorageNodeResponse, error) {
+	out := new(UnregisterStorageNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UnregisterStorageNode", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).GetStorageNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/GetStorageNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).GetStorageNode(ctx, req.(*GetStorageNodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_ListStorageNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListStorageNodesRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error) {
+	out := new(GetTopicResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetTopic", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).ListStorageNodes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/ListStorageNodes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).ListStorageNodes(ctx, req.(*ListStorageNodesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_AddStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddStorageNodeRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error) {
+	out := new(DescribeTopicResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/DescribeTopic", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).AddStorageNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/AddStorageNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).AddStorageNode(ctx, req.(*AddStorageNodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_UnregisterStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnregisterStorageNodeRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error) {
+	out := new(ListTopicsResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListTopics", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).UnregisterStorageNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/UnregisterStorageNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).UnregisterStorageNode(ctx, req.(*UnregisterStorageNodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTopicRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) AddTopic(ctx context.Context, in *AddTopicRequest, opts ...grpc.CallOption) (*AddTopicResponse, error) {
+	out := new(AddTopicResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddTopic", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).GetTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/GetTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).GetTopic(ctx, req.(*GetTopicRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_DescribeTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeTopicRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) UnregisterTopic(ctx context.Context, in *UnregisterTopicRequest, opts ...grpc.CallOption) (*UnregisterTopicResponse, error) {
+	out := new(UnregisterTopicResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UnregisterTopic", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).DescribeTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/DescribeTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).DescribeTopic(ctx, req.(*DescribeTopicRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTopicsRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error) {
+	out := new(SetRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/SetRetentionPolicy", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).ListTopics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/ListTopics",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).ListTopics(ctx, req.(*ListTopicsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_AddTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddTopicRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) GetLogStream(ctx context.Context, in *GetLogStreamRequest, opts ...grpc.CallOption) (*GetLogStreamResponse, error) {
+	out := new(GetLogStreamResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetLogStream", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).AddTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/AddTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).AddTopic(ctx, req.(*AddTopicRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_UnregisterTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnregisterTopicRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) ListLogStreams(ctx context.Context, in *ListLogStreamsRequest, opts ...grpc.CallOption) (*ListLogStreamsResponse, error) {
+	out := new(ListLogStreamsResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListLogStreams", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).UnregisterTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/UnregisterTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).UnregisterTopic(ctx, req.(*UnregisterTopicRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_GetLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLogStreamRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) AddLogStream(ctx context.Context, in *AddLogStreamRequest, opts ...grpc.CallOption) (*AddLogStreamResponse, error) {
+	out := new(AddLogStreamResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddLogStream", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).GetLogStream(ctx, in)
+	return out, nil
+}
+
+func (c *clusterManagerClient) UpdateLogStream(ctx context.Context, in *UpdateLogStreamRequest, opts ...grpc.CallOption) (*UpdateLogStreamResponse, error) {
+	out := new(UpdateLogStreamResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UpdateLogStream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/GetLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).GetLogStream(ctx, req.(*GetLogStreamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_ListLogStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListLogStreamsRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) UnregisterLogStream(ctx context.Context, in *UnregisterLogStreamRequest, opts ...grpc.CallOption) (*UnregisterLogStreamResponse, error) {
+	out := new(UnregisterLogStreamResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UnregisterLogStream", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).ListLogStreams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/ListLogStreams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).ListLogStreams(ctx, req.(*ListLogStreamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_AddLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddLogStreamRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) RemoveLogStreamReplica(ctx context.Context, in *RemoveLogStreamReplicaRequest, opts ...grpc.CallOption) (*RemoveLogStreamReplicaResponse, error) {
+	out := new(RemoveLogStreamReplicaResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/RemoveLogStreamReplica", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).AddLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/AddLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).AddLogStream(ctx, req.(*AddLogStreamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_UpdateLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateLogStreamRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error) {
+	out := new(SealResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Seal", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).UpdateLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/UpdateLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).UpdateLogStream(ctx, req.(*UpdateLogStreamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_UnregisterLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnregisterLogStreamRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error) {
+	out := new(UnsealResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Unseal", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).UnregisterLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/UnregisterLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).UnregisterLogStream(ctx, req.(*UnregisterLogStreamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_RemoveLogStreamReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveLogStreamReplicaRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
+	out := new(SyncResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Sync", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).RemoveLogStreamReplica(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/RemoveLogStreamReplica",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).RemoveLogStreamReplica(ctx, req.(*RemoveLogStreamReplicaRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_Seal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SealRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error) {
+	out := new(TrimResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Trim", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).Seal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/Seal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).Seal(ctx, req.(*SealRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_Unseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsealRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error) {
+	out := new(BackupResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Backup", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).Unseal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/Unseal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).Unseal(ctx, req.(*UnsealRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SyncRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) GetMetadataRepositoryNode(ctx context.Context, in *GetMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*GetMetadataRepositoryNodeResponse, error) {
+	out := new(GetMetadataRepositoryNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetMetadataRepositoryNode", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).Sync(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/Sync",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).Sync(ctx, req.(*SyncRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_Trim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TrimRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) ListMetadataRepositoryNodes(ctx context.Context, in *ListMetadataRepositoryNodesRequest, opts ...grpc.CallOption) (*ListMetadataRepositoryNodesResponse, error) {
+	out := new(ListMetadataRepositoryNodesResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListMetadataRepositoryNodes", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).Trim(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/Trim",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).Trim(ctx, req.(*TrimRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_GetMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetadataRepositoryNodeRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) GetMRMembers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GetMRMembersResponse, error) {
+	out := new(GetMRMembersResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetMRMembers", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).GetMetadataRepositoryNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/GetMetadataRepositoryNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).GetMetadataRepositoryNode(ctx, req.(*GetMetadataRepositoryNodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_ListMetadataRepositoryNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMetadataRepositoryNodesRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) AddMetadataRepositoryNode(ctx context.Context, in *AddMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*AddMetadataRepositoryNodeResponse, error) {
+	out := new(AddMetadataRepositoryNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddMetadataRepositoryNode", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).ListMetadataRepositoryNodes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/ListMetadataRepositoryNodes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).ListMetadataRepositoryNodes(ctx, req.(*ListMetadataRepositoryNodesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_GetMRMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) AddMRPeer(ctx context.Context, in *AddMRPeerRequest, opts ...grpc.CallOption) (*AddMRPeerResponse, error) {
+	out := new(AddMRPeerResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddMRPeer", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).GetMRMembers(ctx, in)
+	return out, nil
+}
+
+func (c *clusterManagerClient) DeleteMetadataRepositoryNode(ctx context.Context, in *DeleteMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*DeleteMetadataRepositoryNodeResponse, error) {
+	out := new(DeleteMetadataRepositoryNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/DeleteMetadataRepositoryNode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/GetMRMembers",
+	return out, nil
+}
+
+func (c *clusterManagerClient) RemoveMRPeer(ctx context.Context, in *RemoveMRPeerRequest, opts ...grpc.CallOption) (*RemoveMRPeerResponse, error) {
+	out := new(RemoveMRPeerResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/RemoveMRPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).GetMRMembers(ctx, req.(*types.Empty))
+	return out, nil
+}
+
+func (c *clusterManagerClient) ConsumerGroupHeartbeat(ctx context.Context, in *ConsumerGroupHeartbeatRequest, opts ...grpc.CallOption) (*ConsumerGroupHeartbeatResponse, error) {
+	out := new(ConsumerGroupHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ConsumerGroupHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_AddMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddMetadataRepositoryNodeRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) LeaveConsumerGroup(ctx context.Context, in *LeaveConsumerGroupRequest, opts ...grpc.CallOption) (*LeaveConsumerGroupResponse, error) {
+	out := new(LeaveConsumerGroupResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/LeaveConsumerGroup", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).AddMetadataRepositoryNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/AddMetadataRepositoryNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).AddMetadataRepositoryNode(ctx, req.(*AddMetadataRepositoryNodeRequest))
+	return out, nil
+}
+
+func (c *clusterManagerClient) CommitConsumerGroupOffset(ctx context.Context, in *CommitConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*CommitConsumerGroupOffsetResponse, error) {
+	out := new(CommitConsumerGroupOffsetResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/CommitConsumerGroupOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_AddMRPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddMRPeerRequest)
+// ClusterManagerServer is the server API for ClusterManager service.
+type ClusterManagerServer interface {
+	// GetStorageNode returns the metadata of the storage node requested.
+	// It produces a gRPC NotFound error if the storage node does not exist. If
+	// the metadata repository cannot be reachable, it returns a gRPC Unavailable
+	// error. In this case, clients can retry with proper backoff to fix it.
+	GetStorageNode(context.Context, *GetStorageNodeRequest) (*GetStorageNodeResponse, error)
+	// ListStorageNodes returns a list of storage nodes.
+	// If the metadata repository cannot be reachable, it returns a gRPC
+	// Unavailable error. In this case, clients can retry with proper backoff to
+	// fix it. If the metadata fetched from the metadata repository is
+	// inconsistent, it returns a gRPC Internal error.
+	ListStorageNodes(context.Context, *ListStorageNodesRequest) (*ListStorageNodesResponse, error)
+	// AddStorageNode adds a new storage node to the cluster. It is idempotent;
+	// adding an already added storage node is okay.
+	// Note that if the admin server cannot refresh the storage node list in the
+	// memory, it returns a gRPC Unavailable error. However, the storage node
+	// could have been added, so users should call this RPC with the same
+	// parameter again.
+	AddStorageNode(context.Context, *AddStorageNodeRequest) (*AddStorageNodeResponse, error)
+	// UnregisterStorageNode unregisters the storage node specified by the
+	// argument snid. If users try to unregister an already non-exist node, it
+	// returns okay.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - FailedPrecondition: The storage node still has valid log stream replicas.
+	UnregisterStorageNode(context.Context, *UnregisterStorageNodeRequest) (*UnregisterStorageNodeResponse, error)
+	// GetTopic returns the topic specified by the request.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - NotFound: The topic doesn't exist.
+	GetTopic(context.Context, *GetTopicRequest) (*GetTopicResponse, error)
+	// DescribeTopic returns the topic specified by the request.
+	// Deprecated: Use GetTopic.
+	DescribeTopic(context.Context, *DescribeTopicRequest) (*DescribeTopicResponse, error)
+	// ListTopics returns a list of topics in the cluster.
+	ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error)
+	// AddTopic adds a new topic and returns its metadata.
+	// It produces a gRPC Internal error if the metadata rejects the request.
+	AddTopic(context.Context, *AddTopicRequest) (*AddTopicResponse, error)
+	// UnregisterTopic unregisters the topic specified by the argument tpid.
+	// It returns a gRPC Unavailable error if the metadata cannot be fetched from
+	// the metadata repository.
+	// TODO: Its behavior is unclear if the topic has already been removed.
+	// FIXME: It overwrites the gRPC errors returned from the metadata repository,
+	// even if some may be important.
+	UnregisterTopic(context.Context, *UnregisterTopicRequest) (*UnregisterTopicResponse, error)
+	// SetRetentionPolicy replaces the retention policy of the topic specified
+	// by the request and returns the updated topic.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The retention policy is invalid.
+	// - NotFound: The topic doesn't exist.
+	// - Unavailable: The metadata repository is not accessible.
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error)
+	// GetLogStream returns the metadata of the log stream specified by the
+	// arguments tpid and lsid. The metadata is the type stored in the metadata
+	// repository.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - NotFound: Either the topic or the log stream does not exist.
+	// - Internal: The TopicID in the log stream metadata doesn't match.
+	GetLogStream(context.Context, *GetLogStreamRequest) (*GetLogStreamResponse, error)
+	// ListLogStreams returns all log streams belonging to the topic specified by
+	// the argument tpid.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - NotFound: The topic does not exist.
+	// - Internal: The TopicID in the log stream metadata doesn't match.
+	ListLogStreams(context.Context, *ListLogStreamsRequest) (*ListLogStreamsResponse, error)
+	// AddLogStream adds a new log stream to the cluster.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - ResourceExhausted: The number of log streams is reached the upper limit.
+	// - FailedPrecondition: Replicas information in the request is invalid; for
+	// example, the number of log stream replicas in the request does not equal
+	// the replication factor, or the storage node for the replica does not exist.
+	//
+	// TODO: Not all errors are codified.
+	AddLogStream(context.Context, *AddLogStreamRequest) (*AddLogStreamResponse, error)
+	// UpdateLogStream changes the configuration of replicas in a log stream.
+	//
+	// Its codes are defines as followings:
+	// - InvalidArgument: The client tries to swap the same replica.
+	// - Unavailable: The cluster metadata cannot be fetched from the metadata
+	// repository transiently.
+	// - NotFound: The target log stream does not exist.
+	// - FailedPrecondition: Either the target log stream is not sealed, the
+	// target log stream doesn't have a victim replica, or the target log stream
+	// already has both victim and new replica. Note that clients should not retry
+	// without any action.
+	// - Unknown: Either storage node or metadata repository returns an error.
+	//
+	// TODO: Moving the data directory within the same node is not supported yet.
+	// TODO: We will define codes for errors returned from storage nodes and
+	// metadata repository soon.
+	UpdateLogStream(context.Context, *UpdateLogStreamRequest) (*UpdateLogStreamResponse, error)
+	// UnregisterLogStream unregisters the log stream specified by the arguments
+	// tpid and lsid.
+	// TODO: It is not tested.
+	UnregisterLogStream(context.Context, *UnregisterLogStreamRequest) (*UnregisterLogStreamResponse, error)
+	// RemoveLogStreamReplica removes the log stream replica specified by the
+	// arguments snid, tpid, and lsid.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - FailedPrecondition: The log stream of the log stream replica can't be
+	// removable, e.g., clients still can append logs to the log stream.
+	RemoveLogStreamReplica(context.Context, *RemoveLogStreamReplicaRequest) (*RemoveLogStreamReplicaResponse, error)
+	Seal(context.Context, *SealRequest) (*SealResponse, error)
+	Unseal(context.Context, *UnsealRequest) (*UnsealResponse, error)
+	Sync(context.Context, *SyncRequest) (*SyncResponse, error)
+	Trim(context.Context, *TrimRequest) (*TrimResponse, error)
+	// Backup takes a point-in-time backup of the cluster into a directory. It
+	// freezes a consistent cut at the last commit of the metadata repository,
+	// and copies all log stream replicas at the cut.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The path is empty.
+	// - AlreadyExists: The path already exists.
+	// - FailedPrecondition: Some storage nodes cannot access the path written
+	// by the admin server, that is, the path is not in a shared file system.
+	// - Unavailable: The metadata repository or storage nodes cannot be
+	// reachable.
+	Backup(context.Context, *BackupRequest) (*BackupResponse, error)
+	GetMetadataRepositoryNode(context.Context, *GetMetadataRepositoryNodeRequest) (*GetMetadataRepositoryNodeResponse, error)
+	ListMetadataRepositoryNodes(context.Context, *ListMetadataRepositoryNodesRequest) (*ListMetadataRepositoryNodesResponse, error)
+	GetMRMembers(context.Context, *types.Empty) (*GetMRMembersResponse, error)
+	AddMetadataRepositoryNode(context.Context, *AddMetadataRepositoryNodeRequest) (*AddMetadataRepositoryNodeResponse, error)
+	AddMRPeer(context.Context, *AddMRPeerRequest) (*AddMRPeerResponse, error)
+	DeleteMetadataRepositoryNode(context.Context, *DeleteMetadataRepositoryNodeRequest) (*DeleteMetadataRepositoryNodeResponse, error)
+	RemoveMRPeer(context.Context, *RemoveMRPeerRequest) (*RemoveMRPeerResponse, error)
+	// ConsumerGroupHeartbeat renews the session of the member in the consumer
+	// group of the topic, and returns log streams assigned to the member. The
+	// member joins the group by its first heartbeat. Log streams of the topic
+	// are divided among members of the group, and the group rebalances when
+	// members join or leave, or log streams are added to the topic.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The group or member ID is empty.
+	// - NotFound: The topic does not exist.
+	// - FailedPrecondition: The session of the member has expired. The member
+	//   should stop consuming all log streams without committing their offsets
+	//   and join the group again with generation zero.
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	ConsumerGroupHeartbeat(context.Context, *ConsumerGroupHeartbeatRequest) (*ConsumerGroupHeartbeatResponse, error)
+	// LeaveConsumerGroup removes the member from the consumer group of the
+	// topic. It is okay to leave the group that the member does not belong to.
+	LeaveConsumerGroup(context.Context, *LeaveConsumerGroupRequest) (*LeaveConsumerGroupResponse, error)
+	// CommitConsumerGroupOffset commits the offset of the log stream consumed
+	// by the member in the consumer group of the topic. Unlike committing
+	// offsets to the metadata repository directly, it is fenced: the commit is
+	// accepted only if the session of the member is alive, the generation is
+	// the one returned by its last heartbeat, and the member either consumes
+	// or is granted the log stream.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The group or member ID is empty.
+	// - NotFound: The topic or log stream does not exist.
+	// - FailedPrecondition: The member is fenced.
+	// - Unavailable: The metadata repository is not reachable.
+	CommitConsumerGroupOffset(context.Context, *CommitConsumerGroupOffsetRequest) (*CommitConsumerGroupOffsetResponse, error)
+}
+
+// UnimplementedClusterManagerServer can be embedded to have forward compatible implementations.
+type UnimplementedClusterManagerServer struct {
+}
+
+func (*UnimplementedClusterManagerServer) GetStorageNode(ctx context.Context, req *GetStorageNodeRequest) (*GetStorageNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageNode not implemented")
+}
+func (*UnimplementedClusterManagerServer) ListStorageNodes(ctx context.Context, req *ListStorageNodesRequest) (*ListStorageNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStorageNodes not implemented")
+}
+func (*UnimplementedClusterManagerServer) AddStorageNode(ctx context.Context, req *AddStorageNodeRequest) (*AddStorageNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStorageNode not implemented")
+}
+func (*UnimplementedClusterManagerServer) UnregisterStorageNode(ctx context.Context, req *UnregisterStorageNodeRequest) (*UnregisterStorageNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterStorageNode not implemented")
+}
+func (*UnimplementedClusterManagerServer) GetTopic(ctx context.Context, req *GetTopicRequest) (*GetTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopic not implemented")
+}
+func (*UnimplementedClusterManagerServer) DescribeTopic(ctx context.Context, req *DescribeTopicRequest) (*DescribeTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTopic not implemented")
+}
+func (*UnimplementedClusterManagerServer) ListTopics(ctx context.Context, req *ListTopicsRequest) (*ListTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopics not implemented")
+}
+func (*UnimplementedClusterManagerServer) AddTopic(ctx context.Context, req *AddTopicRequest) (*AddTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTopic not implemented")
+}
+func (*UnimplementedClusterManagerServer) UnregisterTopic(ctx context.Context, req *UnregisterTopicRequest) (*UnregisterTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterTopic not implemented")
+}
+func (*UnimplementedClusterManagerServer) SetRetentionPolicy(ctx context.Context, req *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (*UnimplementedClusterManagerServer) GetLogStream(ctx context.Context, req *GetLogStreamRequest) (*GetLogStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogStream not implemented")
+}
+func (*UnimplementedClusterManagerServer) ListLogStreams(ctx context.Context, req *ListLogStreamsRequest) (*ListLogStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLogStreams not implemented")
+}
+func (*UnimplementedClusterManagerServer) AddLogStream(ctx context.Context, req *AddLogStreamRequest) (*AddLogStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLogStream not implemented")
+}
+func (*UnimplementedClusterManagerServer) UpdateLogStream(ctx context.Context, req *UpdateLogStreamRequest) (*UpdateLogStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLogStream not implemented")
+}
+func (*UnimplementedClusterManagerServer) UnregisterLogStream(ctx context.Context, req *UnregisterLogStreamRequest) (*UnregisterLogStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterLogStream not implemented")
+}
+func (*UnimplementedClusterManagerServer) RemoveLogStreamReplica(ctx context.Context, req *RemoveLogStreamReplicaRequest) (*RemoveLogStreamReplicaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLogStreamReplica not implemented")
+}
+func (*UnimplementedClusterManagerServer) Seal(ctx context.Context, req *SealRequest) (*SealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Seal not implemented")
+}
+func (*UnimplementedClusterManagerServer) Unseal(ctx context.Context, req *UnsealRequest) (*UnsealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
+}
+func (*UnimplementedClusterManagerServer) Sync(ctx context.Context, req *SyncRequest) (*SyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
+func (*UnimplementedClusterManagerServer) Trim(ctx context.Context, req *TrimRequest) (*TrimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Trim not implemented")
+}
+func (*UnimplementedClusterManagerServer) Backup(ctx context.Context, req *BackupRequest) (*BackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (*UnimplementedClusterManagerServer) GetMetadataRepositoryNode(ctx context.Context, req *GetMetadataRepositoryNodeRequest) (*GetMetadataRepositoryNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataRepositoryNode not implemented")
+}
+func (*UnimplementedClusterManagerServer) ListMetadataRepositoryNodes(ctx context.Context, req *ListMetadataRepositoryNodesRequest) (*ListMetadataRepositoryNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetadataRepositoryNodes not implemented")
+}
+func (*UnimplementedClusterManagerServer) GetMRMembers(ctx context.Context, req *types.Empty) (*GetMRMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMRMembers not implemented")
+}
+func (*UnimplementedClusterManagerServer) AddMetadataRepositoryNode(ctx context.Context, req *AddMetadataRepositoryNodeRequest) (*AddMetadataRepositoryNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMetadataRepositoryNode not implemented")
+}
+func (*UnimplementedClusterManagerServer) AddMRPeer(ctx context.Context, req *AddMRPeerRequest) (*AddMRPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMRPeer not implemented")
+}
+func (*UnimplementedClusterManagerServer) DeleteMetadataRepositoryNode(ctx context.Context, req *DeleteMetadataRepositoryNodeRequest) (*DeleteMetadataRepositoryNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMetadataRepositoryNode not implemented")
+}
+func (*UnimplementedClusterManagerServer) RemoveMRPeer(ctx context.Context, req *RemoveMRPeerRequest) (*RemoveMRPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMRPeer not implemented")
+}
+func (*UnimplementedClusterManagerServer) ConsumerGroupHeartbeat(ctx context.Context, req *ConsumerGroupHeartbeatRequest) (*ConsumerGroupHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumerGroupHeartbeat not implemented")
+}
+func (*UnimplementedClusterManagerServer) LeaveConsumerGroup(ctx context.Context, req *LeaveConsumerGroupRequest) (*LeaveConsumerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveConsumerGroup not implemented")
+}
+func (*UnimplementedClusterManagerServer) CommitConsumerGroupOffset(ctx context.Context, req *CommitConsumerGroupOffsetRequest) (*CommitConsumerGroupOffsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitConsumerGroupOffset not implemented")
+}
+
+func RegisterClusterManagerServer(s *grpc.Server, srv ClusterManagerServer) {
+	s.RegisterService(&_ClusterManager_serviceDesc, srv)
+}
+
+func _ClusterManager_GetStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageNodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterManagerServer).AddMRPeer(ctx, in)
+		return srv.(ClusterManagerServer).GetStorageNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/AddMRPeer",
+		FullMethod: "/varlog.admpb.ClusterManager/GetStorageNode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).AddMRPeer(ctx, req.(*AddMRPeerRequest))
+		return srv.(ClusterManagerServer).GetStorageNode(ctx, req.(*GetStorageNodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterManager_DeleteMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMetadataRepositoryNodeRequest)
+func _ClusterManager_ListStorageNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStorageNodesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterManagerServer).DeleteMetadataRepositoryNode(ctx, in)
+		return srv.(ClusterManagerServer).ListStorageNodes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/DeleteMetadataRepositoryNode",
+		FullMethod: "/varlog.admpb.ClusterManager/ListStorageNodes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).DeleteMetadataRepositoryNode(ctx, req.(*DeleteMetadataRepositoryNodeRequest))
+		return srv.(ClusterManagerServer).ListStorageNodes(ctx, req.(*ListStorageNodesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterManager_RemoveMRPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveMRPeerRequest)
+func _ClusterManager_AddStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStorageNodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterManagerServer).RemoveMRPeer(ctx, in)
+		return srv.(ClusterManagerServer).AddStorageNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/RemoveMRPeer",
+		FullMethod: "/varlog.admpb.ClusterManager/AddStorageNode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).RemoveMRPeer(ctx, req.(*RemoveMRPeerRequest))
+		return srv.(ClusterManagerServer).AddStorageNode(ctx, req.(*AddStorageNodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _ClusterManager_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "varlog.admpb.ClusterManager",
-	HandlerType: (*ClusterManagerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetStorageNode",
-			Handler:    _ClusterManager_GetStorageNode_Handler,
-		},
-		{
-			MethodName: "ListStorageNodes",
-			Handler:    _ClusterManager_ListStorageNodes_Handler,
-		},
-		{
-			MethodName: "AddStorageNode",
-			Handler:    _ClusterManager_AddStorageNode_Handler,
-		},
-		{
-			MethodName: "UnregisterStorageNode",
-			Handler:    _ClusterManager_UnregisterStorageNode_Handler,
-		},
-		{
-			MethodName: "GetTopic",
-			Handler:    _ClusterManager_GetTopic_Handler,
-		},
-		{
-			MethodName: "DescribeTopic",
-			Handler:    _ClusterManager_DescribeTopic_Handler,
-		},
-		{
-			MethodName: "ListTopics",
-			Handler:    _ClusterManager_ListTopics_Handler,
-		},
-		{
-			MethodName: "AddTopic",
-			Handler:    _ClusterManager_AddTopic_Handler,
-		},
-		{
-			MethodName: "UnregisterTopic",
-			Handler:    _ClusterManager_UnregisterTopic_Handler,
-		},
-		{
-			MethodName: "GetLogStream",
-			Handler:    _ClusterManager_GetLogStream_Handler,
-		},
-		{
-			MethodName: "ListLogStreams",
-			Handler:    _ClusterManager_ListLogStreams_Handler,
-		},
-		{
-			MethodName: "AddLogStream",
-			Handler:    _ClusterManager_AddLogStream_Handler,
-		},
-		{
-			MethodName: "UpdateLogStream",
-			Handler:    _ClusterManager_UpdateLogStream_Handler,
-		},
-		{
-			MethodName: "UnregisterLogStream",
-			Handler:    _ClusterManager_UnregisterLogStream_Handler,
-		},
-		{
-			MethodName: "RemoveLogStreamReplica",
-			Handler:    _ClusterManager_RemoveLogStreamReplica_Handler,
-		},
-		{
-			MethodName: "Seal",
-			Handler:    _ClusterManager_Seal_Handler,
-		},
-		{
-			MethodName: "Unseal",
-			Handler:    _ClusterManager_Unseal_Handler,
-		},
-		{
-			MethodName: "Sync",
-			Handler:    _ClusterManager_Sync_Handler,
-		},
-		{
-			MethodName: "Trim",
-			Handler:    _ClusterManager_Trim_Handler,
-		},
-		{
-			MethodName: "GetMetadataRepositoryNode",
-			Handler:    _ClusterManager_GetMetadataRepositoryNode_Handler,
-		},
-		{
-			MethodName: "ListMetadataRepositoryNodes",
-			Handler:    _ClusterManager_ListMetadataRepositoryNodes_Handler,
-		},
-		{
-			MethodName: "GetMRMembers",
-			Handler:    _ClusterManager_GetMRMembers_Handler,
-		},
-		{
-			MethodName: "AddMetadataRepositoryNode",
-			Handler:    _ClusterManager_AddMetadataRepositoryNode_Handler,
-		},
-		{
-			MethodName: "AddMRPeer",
-			Handler:    _ClusterManager_AddMRPeer_Handler,
-		},
-		{
-			MethodName: "DeleteMetadataRepositoryNode",
-			Handler:    _ClusterManager_DeleteMetadataRepositoryNode_Handler,
-		},
-		{
-			MethodName: "RemoveMRPeer",
-			Handler:    _ClusterManager_RemoveMRPeer_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/admpb/admin.proto",
-}
-
-func (m *StorageNodeMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_UnregisterStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterStorageNodeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *StorageNodeMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *StorageNodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeatTime):])
-	if err1 != nil {
-		return 0, err1
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).UnregisterStorageNode(ctx, in)
 	}
-	i -= n1
-	i = encodeVarintAdmin(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x1a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime):])
-	if err2 != nil {
-		return 0, err2
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/UnregisterStorageNode",
 	}
-	i -= n2
-	i = encodeVarintAdmin(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.StorageNodeMetadataDescriptor.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).UnregisterStorageNode(ctx, req.(*UnregisterStorageNodeRequest))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetStorageNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetTopic(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/GetTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetTopic(ctx, req.(*GetTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetStorageNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_DescribeTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTopicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DescribeTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/DescribeTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DescribeTopic(ctx, req.(*DescribeTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopicsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ListTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/ListTopics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ListTopics(ctx, req.(*ListTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		{
-			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _ClusterManager_AddTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).AddTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/AddTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).AddTopic(ctx, req.(*AddTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListStorageNodesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_UnregisterTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterTopicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListStorageNodesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListStorageNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).UnregisterTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/UnregisterTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).UnregisterTopic(ctx, req.(*UnregisterTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListStorageNodesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListStorageNodesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListStorageNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StorageNodes) > 0 {
-		for iNdEx := len(m.StorageNodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StorageNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).SetRetentionPolicy(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/SetRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddStorageNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_GetLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogStreamRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetLogStream(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/GetLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetLogStream(ctx, req.(*GetLogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddStorageNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_ListLogStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLogStreamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		{
-			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ListLogStreams(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/ListLogStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ListLogStreams(ctx, req.(*ListLogStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnregisterStorageNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_AddLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLogStreamRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UnregisterStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).AddLogStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/AddLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).AddLogStream(ctx, req.(*AddLogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnregisterStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
-		i--
-		dAtA[i] = 0x8
+func _ClusterManager_UpdateLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLogStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).UpdateLogStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/UpdateLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).UpdateLogStream(ctx, req.(*UpdateLogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnregisterStorageNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_UnregisterLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterLogStreamRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).UnregisterLogStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/UnregisterLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).UnregisterLogStream(ctx, req.(*UnregisterLogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnregisterStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_RemoveLogStreamReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLogStreamReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).RemoveLogStreamReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/RemoveLogStreamReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).RemoveLogStreamReplica(ctx, req.(*RemoveLogStreamReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnregisterStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _ClusterManager_Seal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).Seal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/Seal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).Seal(ctx, req.(*SealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_Unseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsealRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).Unseal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/Unseal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).Unseal(ctx, req.(*UnsealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).Sync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/Sync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).Sync(ctx, req.(*SyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+func _ClusterManager_Trim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).Trim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/Trim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).Trim(ctx, req.(*TrimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).Backup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/Backup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).Backup(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_GetMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataRepositoryNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetMetadataRepositoryNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/GetMetadataRepositoryNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetMetadataRepositoryNode(ctx, req.(*GetMetadataRepositoryNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Topic != nil {
-		{
-			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _ClusterManager_ListMetadataRepositoryNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetadataRepositoryNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ListMetadataRepositoryNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/ListMetadataRepositoryNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ListMetadataRepositoryNodes(ctx, req.(*ListMetadataRepositoryNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DescribeTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_GetMRMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetMRMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/GetMRMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetMRMembers(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DescribeTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_AddMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMetadataRepositoryNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).AddMetadataRepositoryNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/AddMetadataRepositoryNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).AddMetadataRepositoryNode(ctx, req.(*AddMetadataRepositoryNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DescribeTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+func _ClusterManager_AddMRPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMRPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).AddMRPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/AddMRPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).AddMRPeer(ctx, req.(*AddMRPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DescribeTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_DeleteMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMetadataRepositoryNodeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DeleteMetadataRepositoryNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/DeleteMetadataRepositoryNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DeleteMetadataRepositoryNode(ctx, req.(*DeleteMetadataRepositoryNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DescribeTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
+func _ClusterManager_RemoveMRPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMRPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).RemoveMRPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/RemoveMRPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).RemoveMRPeer(ctx, req.(*RemoveMRPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_ConsumerGroupHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumerGroupHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ConsumerGroupHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/ConsumerGroupHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ConsumerGroupHeartbeat(ctx, req.(*ConsumerGroupHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_LeaveConsumerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveConsumerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).LeaveConsumerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/LeaveConsumerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).LeaveConsumerGroup(ctx, req.(*LeaveConsumerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterManager_CommitConsumerGroupOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitConsumerGroupOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).CommitConsumerGroupOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/CommitConsumerGroupOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).CommitConsumerGroupOffset(ctx, req.(*CommitConsumerGroupOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ClusterManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "varlog.admpb.ClusterManager",
+	HandlerType: (*ClusterManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStorageNode",
+			Handler:    _ClusterManager_GetStorageNode_Handler,
+		},
+		{
+			MethodName: "ListStorageNodes",
+			Handler:    _ClusterManager_ListStorageNodes_Handler,
+		},
+		{
+			MethodName: "AddStorageNode",
+			Handler:    _ClusterManager_AddStorageNode_Handler,
+		},
+		{
+			MethodName: "UnregisterStorageNode",
+			Handler:    _ClusterManager_UnregisterStorageNode_Handler,
+		},
+		{
+			MethodName: "GetTopic",
+			Handler:    _ClusterManager_GetTopic_Handler,
+		},
+		{
+			MethodName: "DescribeTopic",
+			Handler:    _ClusterManager_DescribeTopic_Handler,
+		},
+		{
+			MethodName: "ListTopics",
+			Handler:    _ClusterManager_ListTopics_Handler,
+		},
+		{
+			MethodName: "AddTopic",
+			Handler:    _ClusterManager_AddTopic_Handler,
+		},
+		{
+			MethodName: "UnregisterTopic",
+			Handler:    _ClusterManager_UnregisterTopic_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _ClusterManager_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "GetLogStream",
+			Handler:    _ClusterManager_GetLogStream_Handler,
+		},
+		{
+			MethodName: "ListLogStreams",
+			Handler:    _ClusterManager_ListLogStreams_Handler,
+		},
+		{
+			MethodName: "AddLogStream",
+			Handler:    _ClusterManager_AddLogStream_Handler,
+		},
+		{
+			MethodName: "UpdateLogStream",
+			Handler:    _ClusterManager_UpdateLogStream_Handler,
+		},
+		{
+			MethodName: "UnregisterLogStream",
+			Handler:    _ClusterManager_UnregisterLogStream_Handler,
+		},
+		{
+			MethodName: "RemoveLogStreamReplica",
+			Handler:    _ClusterManager_RemoveLogStreamReplica_Handler,
+		},
+		{
+			MethodName: "Seal",
+			Handler:    _ClusterManager_Seal_Handler,
+		},
+		{
+			MethodName: "Unseal",
+			Handler:    _ClusterManager_Unseal_Handler,
+		},
+		{
+			MethodName: "Sync",
+			Handler:    _ClusterManager_Sync_Handler,
+		},
+		{
+			MethodName: "Trim",
+			Handler:    _ClusterManager_Trim_Handler,
+		},
+		{
+			MethodName: "Backup",
+			Handler:    _ClusterManager_Backup_Handler,
+		},
+		{
+			MethodName: "GetMetadataRepositoryNode",
+			Handler:    _ClusterManager_GetMetadataRepositoryNode_Handler,
+		},
+		{
+			MethodName: "ListMetadataRepositoryNodes",
+			Handler:    _ClusterManager_ListMetadataRepositoryNodes_Handler,
+		},
+		{
+			MethodName: "GetMRMembers",
+			Handler:    _ClusterManager_GetMRMembers_Handler,
+		},
+		{
+			MethodName: "AddMetadataRepositoryNode",
+			Handler:    _ClusterManager_AddMetadataRepositoryNode_Handler,
+		},
+		{
+			MethodName: "AddMRPeer",
+			Handler:    _ClusterManager_AddMRPeer_Handler,
+		},
+		{
+			MethodName: "DeleteMetadataRepositoryNode",
+			Handler:    _ClusterManager_DeleteMetadataRepositoryNode_Handler,
+		},
+		{
+			MethodName: "RemoveMRPeer",
+			Handler:    _ClusterManager_RemoveMRPeer_Handler,
+		},
+		{
+			MethodName: "ConsumerGroupHeartbeat",
+			Handler:    _ClusterManager_ConsumerGroupHeartbeat_Handler,
+		},
+		{
+			MethodName: "LeaveConsumerGroup",
+			Handler:    _ClusterManager_LeaveConsumerGroup_Handler,
+		},
+		{
+			MethodName: "CommitConsumerGroupOffset",
+			Handler:    _ClusterManager_CommitConsumerGroupOffset_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/admpb/admin.proto",
+}
+
+func (m *StorageNodeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageNodeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StorageNodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LogStreams) > 0 {
-		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeatTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAdmin(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime):])
+	if err2 != nil {
+		return 0, err2
 	}
+	i -= n2
+	i = encodeVarintAdmin(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
 	{
-		size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.StorageNodeMetadataDescriptor.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4312,7 +4996,7 @@ func (m *DescribeTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ListTopicsRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetStorageNodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4322,20 +5006,25 @@ func (m *ListTopicsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListTopicsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListTopicsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListTopicsResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetStorageNodeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4345,34 +5034,92 @@ func (m *ListTopicsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListTopicsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListTopicsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
+	if m.StorageNode != nil {
+		{
+			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListStorageNodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListStorageNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListStorageNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListStorageNodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListStorageNodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListStorageNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageNodes) > 0 {
+		for iNdEx := len(m.StorageNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddTopicRequest) Marshal() (dAtA []byte, err error) {
+func (m *AddStorageNodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4382,20 +5129,30 @@ func (m *AddTopicRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *AddTopicResponse) Marshal() (dAtA []byte, err error) {
+func (m *AddStorageNodeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4405,19 +5162,19 @@ func (m *AddTopicResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Topic != nil {
+	if m.StorageNode != nil {
 		{
-			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4430,7 +5187,7 @@ func (m *AddTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *UnregisterTopicRequest) Marshal() (dAtA []byte, err error) {
+func (m *UnregisterStorageNodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4440,25 +5197,25 @@ func (m *UnregisterTopicRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnregisterTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnregisterStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnregisterTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnregisterStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UnregisterTopicResponse) Marshal() (dAtA []byte, err error) {
+func (m *UnregisterStorageNodeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4468,12 +5225,12 @@ func (m *UnregisterTopicResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnregisterTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnregisterStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnregisterTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnregisterStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4481,7 +5238,7 @@ func (m *UnregisterTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *GetLogStreamRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetTopicRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4491,21 +5248,16 @@ func (m *GetLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetTopicRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
 	if m.TopicID != 0 {
 		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
 		i--
@@ -4514,7 +5266,7 @@ func (m *GetLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetLogStreamResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetTopicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4524,19 +5276,19 @@ func (m *GetLogStreamResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetTopicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStream != nil {
+	if m.Topic != nil {
 		{
-			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4549,7 +5301,7 @@ func (m *GetLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ListLogStreamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *DescribeTopicRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4559,12 +5311,12 @@ func (m *ListLogStreamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListLogStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeTopicRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListLogStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4577,7 +5329,7 @@ func (m *ListLogStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ListLogStreamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *DescribeTopicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4587,12 +5339,12 @@ func (m *ListLogStreamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListLogStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeTopicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListLogStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4608,13 +5360,23 @@ func (m *ListLogStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 				i = encodeVarintAdmin(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *AddLogStreamRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListTopicsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4624,39 +5386,20 @@ func (m *AddLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListTopicsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListTopicsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Replicas) > 0 {
-		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddLogStreamResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListTopicsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4666,32 +5409,34 @@ func (m *AddLogStreamResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListTopicsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListTopicsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStream != nil {
-		{
-			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateLogStreamRequest) Marshal() (dAtA []byte, err error) {
+func (m *AddTopicRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4701,50 +5446,20 @@ func (m *UpdateLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddTopicRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.PushedReplica.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintAdmin(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.PoppedReplica.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintAdmin(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateLogStreamResponse) Marshal() (dAtA []byte, err error) {
+func (m *AddTopicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4754,19 +5469,19 @@ func (m *UpdateLogStreamResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddTopicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStream != nil {
+	if m.Topic != nil {
 		{
-			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4779,7 +5494,7 @@ func (m *UpdateLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *UnregisterLogStreamRequest) Marshal() (dAtA []byte, err error) {
+func (m *UnregisterTopicRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4789,21 +5504,16 @@ func (m *UnregisterLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnregisterLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnregisterTopicRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnregisterLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnregisterTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
 	if m.TopicID != 0 {
 		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
 		i--
@@ -4812,7 +5522,7 @@ func (m *UnregisterLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *UnregisterLogStreamResponse) Marshal() (dAtA []byte, err error) {
+func (m *UnregisterTopicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4822,12 +5532,12 @@ func (m *UnregisterLogStreamResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnregisterLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnregisterTopicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnregisterLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnregisterTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4835,7 +5545,7 @@ func (m *UnregisterLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveLogStreamReplicaRequest) Marshal() (dAtA []byte, err error) {
+func (m *SetRetentionPolicyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4845,35 +5555,37 @@ func (m *RemoveLogStreamReplicaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveLogStreamReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetRetentionPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveLogStreamReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetRetentionPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+	if m.RetentionPolicy != nil {
+		{
+			size, err := m.RetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
 	if m.TopicID != 0 {
 		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.StorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
-		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveLogStreamReplicaResponse) Marshal() (dAtA []byte, err error) {
+func (m *SetRetentionPolicyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4883,20 +5595,32 @@ func (m *RemoveLogStreamReplicaResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveLogStreamReplicaResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetRetentionPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveLogStreamReplicaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetRetentionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Topic != nil {
+		{
+			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SealRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4906,12 +5630,12 @@ func (m *SealRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SealRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4929,7 +5653,7 @@ func (m *SealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SealResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetLogStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4939,21 +5663,79 @@ func (m *SealResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SealResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SealedGLSN != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.SealedGLSN))
+	if m.LogStream != nil {
+		{
+			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListLogStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListLogStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListLogStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListLogStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListLogStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListLogStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.LogStreams) > 0 {
 		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4971,7 +5753,7 @@ func (m *SealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *UnsealRequest) Marshal() (dAtA []byte, err error) {
+func (m *AddLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4981,20 +5763,29 @@ func (m *UnsealRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnsealRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnsealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
+	if len(m.Replicas) > 0 {
+		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if m.TopicID != 0 {
 		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
@@ -5004,7 +5795,7 @@ func (m *UnsealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *UnsealResponse) Marshal() (dAtA []byte, err error) {
+func (m *AddLogStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5014,12 +5805,12 @@ func (m *UnsealResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnsealResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnsealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5039,7 +5830,7 @@ func (m *UnsealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncRequest) Marshal() (dAtA []byte, err error) {
+func (m *UpdateLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5049,26 +5840,36 @@ func (m *SyncRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DstStorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.DstStorageNodeID))
-		i--
-		dAtA[i] = 0x20
+	{
+		size, err := m.PushedReplica.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
 	}
-	if m.SrcStorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.SrcStorageNodeID))
-		i--
-		dAtA[i] = 0x18
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.PoppedReplica.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.LogStreamID != 0 {
 		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
 		i--
@@ -5082,7 +5883,7 @@ func (m *SyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncResponse) Marshal() (dAtA []byte, err error) {
+func (m *UpdateLogStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5092,19 +5893,19 @@ func (m *SyncResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
+	if m.LogStream != nil {
 		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5117,7 +5918,7 @@ func (m *SyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TrimRequest) Marshal() (dAtA []byte, err error) {
+func (m *UnregisterLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5127,18 +5928,18 @@ func (m *TrimRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TrimRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnregisterLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TrimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnregisterLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastGLSN != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LastGLSN))
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -5150,7 +5951,7 @@ func (m *TrimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TrimResult) Marshal() (dAtA []byte, err error) {
+func (m *UnregisterLogStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5160,26 +5961,47 @@ func (m *TrimResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TrimResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnregisterLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TrimResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnregisterLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveLogStreamReplicaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveLogStreamReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveLogStreamReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.LogStreamID != 0 {
 		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
 		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
 		dAtA[i] = 0x10
 	}
 	if m.StorageNodeID != 0 {
@@ -5190,7 +6012,7 @@ func (m *TrimResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TrimResponse) Marshal() (dAtA []byte, err error) {
+func (m *RemoveLogStreamReplicaResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5200,34 +6022,20 @@ func (m *TrimResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TrimResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveLogStreamReplicaResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TrimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveLogStreamReplicaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
+func (m *SealRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5237,25 +6045,30 @@ func (m *GetMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SealRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
+func (m *SealResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5265,32 +6078,39 @@ func (m *GetMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SealResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Node != nil {
-		{
-			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if m.SealedGLSN != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SealedGLSN))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LogStreams) > 0 {
+		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListMetadataRepositoryNodesRequest) Marshal() (dAtA []byte, err error) {
+func (m *UnsealRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5300,20 +6120,30 @@ func (m *ListMetadataRepositoryNodesRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *ListMetadataRepositoryNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnsealRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListMetadataRepositoryNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnsealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListMetadataRepositoryNodesResponse) Marshal() (dAtA []byte, err error) {
+func (m *UnsealResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5323,34 +6153,32 @@ func (m *ListMetadataRepositoryNodesResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *ListMetadataRepositoryNodesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnsealResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListMetadataRepositoryNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnsealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
+	if m.LogStream != nil {
+		{
+			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetMRMembersResponse) Marshal() (dAtA []byte, err error) {
+func (m *SyncRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5360,84 +6188,40 @@ func (m *GetMRMembersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetMRMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetMRMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for k := range m.Members {
-			v := m.Members[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarintAdmin(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.ReplicationFactor != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.ReplicationFactor))
+	if m.DstStorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.DstStorageNodeID))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
-	if m.Leader != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Leader))
+	if m.SrcStorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SrcStorageNodeID))
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AddMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x18
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RPCAddr) > 0 {
-		i -= len(m.RPCAddr)
-		copy(dAtA[i:], m.RPCAddr)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RPCAddr)))
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.RaftURL) > 0 {
-		i -= len(m.RaftURL)
-		copy(dAtA[i:], m.RaftURL)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
+func (m *SyncResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5447,19 +6231,19 @@ func (m *AddMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Node != nil {
+	if m.Status != nil {
 		{
-			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5472,7 +6256,7 @@ func (m *AddMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *AddMRPeerRequest) Marshal() (dAtA []byte, err error) {
+func (m *TrimRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5482,34 +6266,30 @@ func (m *AddMRPeerRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddMRPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TrimRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddMRPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TrimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RPCAddr) > 0 {
-		i -= len(m.RPCAddr)
-		copy(dAtA[i:], m.RPCAddr)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RPCAddr)))
+	if m.LastGLSN != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LastGLSN))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.RaftURL) > 0 {
-		i -= len(m.RaftURL)
-		copy(dAtA[i:], m.RaftURL)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddMRPeerResponse) Marshal() (dAtA []byte, err error) {
+func (m *TrimResult) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5519,25 +6299,37 @@ func (m *AddMRPeerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddMRPeerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TrimResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddMRPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TrimResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
+func (m *TrimResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5547,25 +6339,34 @@ func (m *DeleteMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *DeleteMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TrimResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TrimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
+func (m *BackupReplica) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5575,50 +6376,126 @@ func (m *DeleteMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *DeleteMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BackupReplica) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BackupReplica) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *RemoveMRPeerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.ColdTierPrefix) > 0 {
+		i -= len(m.ColdTierPrefix)
+		copy(dAtA[i:], m.ColdTierPrefix)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ColdTierPrefix)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return dAtA[:n], nil
-}
+	{
+		size, err := m.CommitResult.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *RemoveMRPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *BackupManifest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupManifest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveMRPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BackupManifest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RaftURL) > 0 {
-		i -= len(m.RaftURL)
-		copy(dAtA[i:], m.RaftURL)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
+	if len(m.Replicas) > 0 {
+		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MetadataRepository) > 0 {
+		i -= len(m.MetadataRepository)
+		copy(dAtA[i:], m.MetadataRepository)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.MetadataRepository)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
+	}
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintAdmin(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x22
+	if m.AppliedIndex != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.AppliedIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveMRPeerResponse) Marshal() (dAtA []byte, err error) {
+func (m *BackupRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5628,566 +6505,807 @@ func (m *RemoveMRPeerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveMRPeerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BackupRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveMRPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAdmin(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BackupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StorageNodeMetadata) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.StorageNodeMetadataDescriptor.ProtoSize()
-	n += 1 + l + sovAdmin(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime)
-	n += 1 + l + sovAdmin(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeatTime)
-	n += 1 + l + sovAdmin(uint64(l))
-	return n
+
+func (m *BackupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetStorageNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	{
+		size, err := m.Manifest.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GetStorageNodeResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		l = m.StorageNode.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+func (m *GetMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListStorageNodesRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *GetMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListStorageNodesResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.StorageNodes) > 0 {
-		for _, e := range m.StorageNodes {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
+	if m.NodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddStorageNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *GetMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.StorageNode.ProtoSize()
-	n += 1 + l + sovAdmin(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AddStorageNodeResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		l = m.StorageNode.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	return n
+func (m *GetMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnregisterStorageNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	if m.Node != nil {
+		{
+			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnregisterStorageNodeResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *ListMetadataRepositoryNodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetTopicRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	return n
+func (m *ListMetadataRepositoryNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTopicResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ListMetadataRepositoryNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Topic != nil {
-		l = m.Topic.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeTopicRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+func (m *ListMetadataRepositoryNodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DescribeTopicResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ListMetadataRepositoryNodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListMetadataRepositoryNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Topic.ProtoSize()
-	n += 1 + l + sovAdmin(uint64(l))
-	if len(m.LogStreams) > 0 {
-		for _, e := range m.LogStreams {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListTopicsRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *GetMRMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListTopicsResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetMRMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMRMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Topics) > 0 {
-		for _, e := range m.Topics {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
+	if len(m.Members) > 0 {
+		for k := range m.Members {
+			v := m.Members[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintAdmin(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if m.ReplicationFactor != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ReplicationFactor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Leader != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Leader))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AddTopicRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *AddMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AddTopicResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AddMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Topic != nil {
-		l = m.Topic.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+	if len(m.RPCAddr) > 0 {
+		i -= len(m.RPCAddr)
+		copy(dAtA[i:], m.RPCAddr)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RPCAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.RaftURL) > 0 {
+		i -= len(m.RaftURL)
+		copy(dAtA[i:], m.RaftURL)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UnregisterTopicRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *AddMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+	if m.Node != nil {
+		{
+			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnregisterTopicResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *AddMRPeerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetLogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AddMRPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddMRPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+	if len(m.RPCAddr) > 0 {
+		i -= len(m.RPCAddr)
+		copy(dAtA[i:], m.RPCAddr)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RPCAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	if len(m.RaftURL) > 0 {
+		i -= len(m.RaftURL)
+		copy(dAtA[i:], m.RaftURL)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetLogStreamResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *AddMRPeerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddMRPeerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddMRPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LogStream != nil {
-		l = m.LogStream.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.NodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListLogStreamsRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+func (m *DeleteMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListLogStreamsResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.LogStreams) > 0 {
-		for _, e := range m.LogStreams {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
-	}
-	return n
+func (m *DeleteMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddLogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DeleteMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if len(m.Replicas) > 0 {
-		for _, e := range m.Replicas {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
+	if m.NodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddLogStreamResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LogStream != nil {
-		l = m.LogStream.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateLogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveMRPeerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveMRPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveMRPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	if len(m.RaftURL) > 0 {
+		i -= len(m.RaftURL)
+		copy(dAtA[i:], m.RaftURL)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.PoppedReplica.ProtoSize()
-	n += 1 + l + sovAdmin(uint64(l))
-	l = m.PushedReplica.ProtoSize()
-	n += 1 + l + sovAdmin(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateLogStreamResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveMRPeerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveMRPeerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveMRPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LogStream != nil {
-		l = m.LogStream.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnregisterLogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerGroupHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerGroupHeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Generation != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Generation))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.OwnedLogStreamIDs) > 0 {
+		dAtA25 := make([]byte, len(m.OwnedLogStreamIDs)*10)
+		var j24 int
+		for _, num1 := range m.OwnedLogStreamIDs {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j24++
+			}
+			dAtA25[j24] = uint8(num)
+			j24++
+		}
+		i -= j24
+		copy(dAtA[i:], dAtA25[:j24])
+		i = encodeVarintAdmin(dAtA, i, uint64(j24))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MemberID) > 0 {
+		i -= len(m.MemberID)
+		copy(dAtA[i:], m.MemberID)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.MemberID)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnregisterLogStreamResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerGroupHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RemoveLogStreamReplicaRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConsumerGroupHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerGroupHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	n26, err26 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SessionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SessionTimeout):])
+	if err26 != nil {
+		return 0, err26
 	}
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+	i -= n26
+	i = encodeVarintAdmin(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.LogStreamIDs) > 0 {
+		dAtA28 := make([]byte, len(m.LogStreamIDs)*10)
+		var j27 int
+		for _, num1 := range m.LogStreamIDs {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j27++
+			}
+			dAtA28[j27] = uint8(num)
+			j27++
+		}
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintAdmin(dAtA, i, uint64(j27))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	if m.Generation != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Generation))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveLogStreamReplicaResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *LeaveConsumerGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SealRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LeaveConsumerGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaveConsumerGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.MemberID) > 0 {
+		i -= len(m.MemberID)
+		copy(dAtA[i:], m.MemberID)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.MemberID)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SealResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *LeaveConsumerGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.LogStreams) > 0 {
-		for _, e := range m.LogStreams {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
-	}
-	if m.SealedGLSN != 0 {
-		n += 1 + sovAdmin(uint64(m.SealedGLSN))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UnsealRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *LeaveConsumerGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaveConsumerGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitConsumerGroupOffsetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitConsumerGroupOffsetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitConsumerGroupOffsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+	if m.LLSN != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LLSN))
+		i--
+		dAtA[i] = 0x30
 	}
 	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	if m.Generation != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Generation))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MemberID) > 0 {
+		i -= len(m.MemberID)
+		copy(dAtA[i:], m.MemberID)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.MemberID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UnsealResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *CommitConsumerGroupOffsetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitConsumerGroupOffsetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitConsumerGroupOffsetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LogStream != nil {
-		l = m.LogStream.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SyncRequest) ProtoSize() (n int) {
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StorageNodeMetadata) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
-	}
-	if m.SrcStorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.SrcStorageNodeID))
-	}
-	if m.DstStorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.DstStorageNodeID))
-	}
+	l = m.StorageNodeMetadataDescriptor.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime)
+	n += 1 + l + sovAdmin(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeatTime)
+	n += 1 + l + sovAdmin(uint64(l))
 	return n
 }
 
-func (m *SyncResponse) ProtoSize() (n int) {
+func (m *GetStorageNodeRequest) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Status != nil {
-		l = m.Status.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
 	}
 	return n
 }
 
-func (m *TrimRequest) ProtoSize() (n int) {
+func (m *GetStorageNodeResponse) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if m.LastGLSN != 0 {
-		n += 1 + sovAdmin(uint64(m.LastGLSN))
+	if m.StorageNode != nil {
+		l = m.StorageNode.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
 	}
 	return n
 }
 
-func (m *TrimResult) ProtoSize() (n int) {
+func (m *ListStorageNodesRequest) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.StorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.StorageNodeID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
 	return n
 }
 
-func (m *TrimResponse) ProtoSize() (n int) {
+func (m *ListStorageNodesResponse) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Results) > 0 {
-		for _, e := range m.Results {
+	if len(m.StorageNodes) > 0 {
+		for _, e := range m.StorageNodes {
 			l = e.ProtoSize()
 			n += 1 + l + sovAdmin(uint64(l))
 		}
@@ -6195,150 +7313,130 @@ func (m *TrimResponse) ProtoSize() (n int) {
 	return n
 }
 
-func (m *GetMetadataRepositoryNodeRequest) ProtoSize() (n int) {
+func (m *AddStorageNodeRequest) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.NodeID))
-	}
+	l = m.StorageNode.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
 	return n
 }
 
-func (m *GetMetadataRepositoryNodeResponse) ProtoSize() (n int) {
+func (m *AddStorageNodeResponse) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Node != nil {
-		l = m.Node.ProtoSize()
+	if m.StorageNode != nil {
+		l = m.StorageNode.ProtoSize()
 		n += 1 + l + sovAdmin(uint64(l))
 	}
 	return n
 }
 
-func (m *ListMetadataRepositoryNodesRequest) ProtoSize() (n int) {
+func (m *UnregisterStorageNodeRequest) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
 	return n
 }
 
-func (m *ListMetadataRepositoryNodesResponse) ProtoSize() (n int) {
+func (m *UnregisterStorageNodeResponse) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *GetMRMembersResponse) ProtoSize() (n int) {
+func (m *GetTopicRequest) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Leader != 0 {
-		n += 1 + sovAdmin(uint64(m.Leader))
-	}
-	if m.ReplicationFactor != 0 {
-		n += 1 + sovAdmin(uint64(m.ReplicationFactor))
-	}
-	if len(m.Members) > 0 {
-		for k, v := range m.Members {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovAdmin(uint64(k)) + 1 + len(v) + sovAdmin(uint64(len(v)))
-			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
-		}
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
 	}
 	return n
 }
 
-func (m *AddMetadataRepositoryNodeRequest) ProtoSize() (n int) {
+func (m *GetTopicResponse) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RaftURL)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.RPCAddr)
-	if l > 0 {
+	if m.Topic != nil {
+		l = m.Topic.ProtoSize()
 		n += 1 + l + sovAdmin(uint64(l))
 	}
 	return n
 }
 
-func (m *AddMetadataRepositoryNodeResponse) ProtoSize() (n int) {
+func (m *DescribeTopicRequest) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Node != nil {
-		l = m.Node.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
 	}
 	return n
 }
 
-func (m *AddMRPeerRequest) ProtoSize() (n int) {
+func (m *DescribeTopicResponse) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RaftURL)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.RPCAddr)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	l = m.Topic.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	if len(m.LogStreams) > 0 {
+		for _, e := range m.LogStreams {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *AddMRPeerResponse) ProtoSize() (n int) {
+func (m *ListTopicsRequest) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.NodeID))
-	}
 	return n
 }
 
-func (m *DeleteMetadataRepositoryNodeRequest) ProtoSize() (n int) {
+func (m *ListTopicsResponse) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.NodeID))
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *DeleteMetadataRepositoryNodeResponse) ProtoSize() (n int) {
+func (m *AddTopicRequest) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6347,35 +7445,1880 @@ func (m *DeleteMetadataRepositoryNodeResponse) ProtoSize() (n int) {
 	return n
 }
 
-func (m *RemoveMRPeerRequest) ProtoSize() (n int) {
+func (m *AddTopicResponse) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RaftURL)
-	if l > 0 {
+	if m.Topic != nil {
+		l = m.Topic.ProtoSize()
 		n += 1 + l + sovAdmin(uint64(l))
 	}
 	return n
 }
 
-func (m *RemoveMRPeerResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *UnregisterTopicRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	return n
+}
+
+func (m *UnregisterTopicResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SetRetentionPolicyRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.RetentionPolicy != nil {
+		l = m.RetentionPolicy.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *SetRetentionPolicyResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Topic != nil {
+		l = m.Topic.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *GetLogStreamResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		l = m.LogStream.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ListLogStreamsRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	return n
+}
+
+func (m *ListLogStreamsResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LogStreams) > 0 {
+		for _, e := range m.LogStreams {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddLogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if len(m.Replicas) > 0 {
+		for _, e := range m.Replicas {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddLogStreamResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		l = m.LogStream.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateLogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	l = m.PoppedReplica.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	l = m.PushedReplica.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *UpdateLogStreamResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		l = m.LogStream.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *UnregisterLogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *UnregisterLogStreamResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveLogStreamReplicaRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *RemoveLogStreamReplicaResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SealRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *SealResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LogStreams) > 0 {
+		for _, e := range m.LogStreams {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.SealedGLSN != 0 {
+		n += 1 + sovAdmin(uint64(m.SealedGLSN))
+	}
+	return n
+}
+
+func (m *UnsealRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *UnsealResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		l = m.LogStream.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	if m.SrcStorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.SrcStorageNodeID))
+	}
+	if m.DstStorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.DstStorageNodeID))
+	}
+	return n
+}
+
+func (m *SyncResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *TrimRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LastGLSN != 0 {
+		n += 1 + sovAdmin(uint64(m.LastGLSN))
+	}
+	return n
+}
+
+func (m *TrimResult) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *TrimResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BackupReplica) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = m.CommitResult.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	l = len(m.ColdTierPrefix)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *BackupManifest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovAdmin(uint64(m.ClusterID))
+	}
+	if m.Version != 0 {
+		n += 1 + sovAdmin(uint64(m.Version))
+	}
+	if m.AppliedIndex != 0 {
+		n += 1 + sovAdmin(uint64(m.AppliedIndex))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime)
+	n += 1 + l + sovAdmin(uint64(l))
+	l = len(m.MetadataRepository)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Replicas) > 0 {
+		for _, e := range m.Replicas {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BackupRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *BackupResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Manifest.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *GetMetadataRepositoryNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.NodeID))
+	}
+	return n
+}
+
+func (m *GetMetadataRepositoryNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Node != nil {
+		l = m.Node.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ListMetadataRepositoryNodesRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListMetadataRepositoryNodesResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetMRMembersResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Leader != 0 {
+		n += 1 + sovAdmin(uint64(m.Leader))
+	}
+	if m.ReplicationFactor != 0 {
+		n += 1 + sovAdmin(uint64(m.ReplicationFactor))
+	}
+	if len(m.Members) > 0 {
+		for k, v := range m.Members {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovAdmin(uint64(k)) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *AddMetadataRepositoryNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RaftURL)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.RPCAddr)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AddMetadataRepositoryNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Node != nil {
+		l = m.Node.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AddMRPeerRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RaftURL)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.RPCAddr)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AddMRPeerResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.NodeID))
+	}
+	return n
+}
+
+func (m *DeleteMetadataRepositoryNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.NodeID))
+	}
+	return n
+}
+
+func (m *DeleteMetadataRepositoryNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveMRPeerRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RaftURL)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveMRPeerResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ConsumerGroupHeartbeatRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	l = len(m.MemberID)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.OwnedLogStreamIDs) > 0 {
+		l = 0
+		for _, e := range m.OwnedLogStreamIDs {
+			l += sovAdmin(uint64(e))
+		}
+		n += 1 + sovAdmin(uint64(l)) + l
+	}
+	if m.Generation != 0 {
+		n += 1 + sovAdmin(uint64(m.Generation))
+	}
+	return n
+}
+
+func (m *ConsumerGroupHeartbeatResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Generation != 0 {
+		n += 1 + sovAdmin(uint64(m.Generation))
+	}
+	if len(m.LogStreamIDs) > 0 {
+		l = 0
+		for _, e := range m.LogStreamIDs {
+			l += sovAdmin(uint64(e))
+		}
+		n += 1 + sovAdmin(uint64(l)) + l
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SessionTimeout)
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *LeaveConsumerGroupRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	l = len(m.MemberID)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *LeaveConsumerGroupResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CommitConsumerGroupOffsetRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	l = len(m.MemberID)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Generation != 0 {
+		n += 1 + sovAdmin(uint64(m.Generation))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	if m.LLSN != 0 {
+		n += 1 + sovAdmin(uint64(m.LLSN))
+	}
+	return n
+}
+
+func (m *CommitConsumerGroupOffsetResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageNodeMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageNodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeMetadataDescriptor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageNodeMetadataDescriptor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStorageNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStorageNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStorageNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStorageNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageNode == nil {
+				m.StorageNode = &StorageNodeMetadata{}
+			}
+			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListStorageNodesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListStorageNodesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListStorageNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListStorageNodesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListStorageNodesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListStorageNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageNodes = append(m.StorageNodes, StorageNodeMetadata{})
+			if err := m.StorageNodes[len(m.StorageNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddStorageNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddStorageNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddStorageNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddStorageNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageNode == nil {
+				m.StorageNode = &StorageNodeMetadata{}
+			}
+			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnregisterStorageNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnregisterStorageNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnregisterStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnregisterStorageNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnregisterStorageNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnregisterStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Topic == nil {
+				m.Topic = &varlogpb.TopicDescriptor{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogStreams = append(m.LogStreams, varlogpb.LogStreamDescriptor{})
+			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *ListTopicsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTopicsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTopicsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovAdmin(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAdmin(x uint64) (n int) {
-	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
+func (m *ListTopicsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6398,15 +9341,15 @@ func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageNodeMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTopicsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageNodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTopicsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeMetadataDescriptor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6433,13 +9376,114 @@ func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StorageNodeMetadataDescriptor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Topics = append(m.Topics, varlogpb.TopicDescriptor{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6466,15 +9510,68 @@ func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Topic == nil {
+				m.Topic = &varlogpb.TopicDescriptor{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnregisterTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnregisterTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnregisterTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -6484,25 +9581,11 @@ func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -6524,7 +9607,7 @@ func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetStorageNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *UnregisterTopicResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6547,31 +9630,12 @@ func (m *GetStorageNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetStorageNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnregisterTopicResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnregisterTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
-			}
-			m.StorageNodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -6593,7 +9657,7 @@ func (m *GetStorageNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetStorageNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *SetRetentionPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6616,15 +9680,34 @@ func (m *GetStorageNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetStorageNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetRetentionPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetRetentionPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6651,10 +9734,10 @@ func (m *GetStorageNodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageNode == nil {
-				m.StorageNode = &StorageNodeMetadata{}
+			if m.RetentionPolicy == nil {
+				m.RetentionPolicy = &varlogpb.RetentionPolicy{}
 			}
-			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6679,57 +9762,7 @@ func (m *GetStorageNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListStorageNodesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListStorageNodesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListStorageNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListStorageNodesResponse) Unmarshal(dAtA []byte) error {
+func (m *SetRetentionPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6752,15 +9785,15 @@ func (m *ListStorageNodesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListStorageNodesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetRetentionPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListStorageNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetRetentionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6787,8 +9820,10 @@ func (m *ListStorageNodesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageNodes = append(m.StorageNodes, StorageNodeMetadata{})
-			if err := m.StorageNodes[len(m.StorageNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Topic == nil {
+				m.Topic = &varlogpb.TopicDescriptor{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6813,7 +9848,7 @@ func (m *ListStorageNodesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddStorageNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *GetLogStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6836,17 +9871,17 @@ func (m *AddStorageNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddStorageNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLogStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			var msglen int
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -6856,25 +9891,30 @@ func (m *AddStorageNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
-			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -6896,7 +9936,7 @@ func (m *AddStorageNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddStorageNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *GetLogStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6919,15 +9959,15 @@ func (m *AddStorageNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddStorageNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLogStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6954,10 +9994,10 @@ func (m *AddStorageNodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageNode == nil {
-				m.StorageNode = &StorageNodeMetadata{}
+			if m.LogStream == nil {
+				m.LogStream = &varlogpb.LogStreamDescriptor{}
 			}
-			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6982,7 +10022,7 @@ func (m *AddStorageNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterStorageNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *ListLogStreamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7005,17 +10045,17 @@ func (m *UnregisterStorageNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterStorageNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListLogStreamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListLogStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			m.StorageNodeID = 0
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -7025,7 +10065,7 @@ func (m *UnregisterStorageNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7051,7 +10091,7 @@ func (m *UnregisterStorageNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterStorageNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *ListLogStreamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7074,12 +10114,46 @@ func (m *UnregisterStorageNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterStorageNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListLogStreamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListLogStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogStreams = append(m.LogStreams, varlogpb.LogStreamDescriptor{})
+			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7101,7 +10175,7 @@ func (m *UnregisterStorageNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7124,10 +10198,10 @@ func (m *GetTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddLogStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7149,6 +10223,40 @@ func (m *GetTopicRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, &varlogpb.ReplicaDescriptor{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7170,7 +10278,7 @@ func (m *GetTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *AddLogStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7193,15 +10301,15 @@ func (m *GetTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddLogStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7228,10 +10336,10 @@ func (m *GetTopicResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Topic == nil {
-				m.Topic = &varlogpb.TopicDescriptor{}
+			if m.LogStream == nil {
+				m.LogStream = &varlogpb.LogStreamDescriptor{}
 			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7256,7 +10364,7 @@ func (m *GetTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7279,10 +10387,10 @@ func (m *DescribeTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateLogStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7304,59 +10412,28 @@ func (m *DescribeTopicRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTopicResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoppedReplica", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7383,13 +10460,13 @@ func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoppedReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PushedReplica", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7416,8 +10493,7 @@ func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogStreams = append(m.LogStreams, varlogpb.LogStreamDescriptor{})
-			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PushedReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7442,57 +10518,7 @@ func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTopicsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListTopicsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTopicsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListTopicsResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateLogStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7515,15 +10541,15 @@ func (m *ListTopicsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTopicsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateLogStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTopicsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7550,8 +10576,10 @@ func (m *ListTopicsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, varlogpb.TopicDescriptor{})
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LogStream == nil {
+				m.LogStream = &varlogpb.LogStreamDescriptor{}
+			}
+			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7576,7 +10604,7 @@ func (m *ListTopicsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7599,12 +10627,50 @@ func (m *AddTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnregisterLogStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnregisterLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7626,7 +10692,7 @@ func (m *AddTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *UnregisterLogStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7649,48 +10715,12 @@ func (m *AddTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnregisterLogStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnregisterLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Topic == nil {
-				m.Topic = &varlogpb.TopicDescriptor{}
-			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7712,7 +10742,7 @@ func (m *AddTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7735,13 +10765,32 @@ func (m *UnregisterTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveLogStreamReplicaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveLogStreamReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
@@ -7760,6 +10809,25 @@ func (m *UnregisterTopicRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7781,7 +10849,7 @@ func (m *UnregisterTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7804,10 +10872,10 @@ func (m *UnregisterTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveLogStreamReplicaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveLogStreamReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7831,7 +10899,7 @@ func (m *UnregisterTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *SealRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7854,10 +10922,10 @@ func (m *GetLogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SealRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7919,7 +10987,7 @@ func (m *GetLogStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLogStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *SealResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7942,15 +11010,15 @@ func (m *GetLogStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SealResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7977,13 +11045,30 @@ func (m *GetLogStreamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LogStream == nil {
-				m.LogStream = &varlogpb.LogStreamDescriptor{}
-			}
-			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LogStreams = append(m.LogStreams, snpb.LogStreamReplicaMetadataDescriptor{})
+			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedGLSN", wireType)
+			}
+			m.SealedGLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SealedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8005,7 +11090,7 @@ func (m *GetLogStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListLogStreamsRequest) Unmarshal(dAtA []byte) error {
+func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8028,10 +11113,10 @@ func (m *ListLogStreamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListLogStreamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsealRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListLogStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8053,6 +11138,25 @@ func (m *ListLogStreamsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8074,7 +11178,7 @@ func (m *ListLogStreamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListLogStreamsResponse) Unmarshal(dAtA []byte) error {
+func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8097,15 +11201,15 @@ func (m *ListLogStreamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListLogStreamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsealResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListLogStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8132,8 +11236,10 @@ func (m *ListLogStreamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogStreams = append(m.LogStreams, varlogpb.LogStreamDescriptor{})
-			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LogStream == nil {
+				m.LogStream = &varlogpb.LogStreamDescriptor{}
+			}
+			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8158,7 +11264,7 @@ func (m *ListLogStreamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8181,10 +11287,10 @@ func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddLogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8207,8 +11313,115 @@ func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcStorageNodeID", wireType)
+			}
+			m.SrcStorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstStorageNodeID", wireType)
+			}
+			m.DstStorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8235,8 +11448,10 @@ func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Replicas = append(m.Replicas, &varlogpb.ReplicaDescriptor{})
-			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Status == nil {
+				m.Status = &snpb.SyncStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8261,7 +11476,7 @@ func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddLogStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *TrimRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8284,17 +11499,17 @@ func (m *AddLogStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddLogStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrimRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrimRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			var msglen int
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8304,28 +11519,30 @@ func (m *AddLogStreamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LogStream == nil {
-				m.LogStream = &varlogpb.LogStreamDescriptor{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastGLSN", wireType)
 			}
-			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LastGLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8347,7 +11564,7 @@ func (m *AddLogStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *TrimResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8370,17 +11587,17 @@ func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateLogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrimResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrimResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
 			}
-			m.TopicID = 0
+			m.StorageNodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8390,7 +11607,7 @@ func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8416,42 +11633,9 @@ func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoppedReplica", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PoppedReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PushedReplica", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8461,24 +11645,23 @@ func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PushedReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8501,7 +11684,7 @@ func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateLogStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *TrimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8524,15 +11707,15 @@ func (m *UpdateLogStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateLogStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8559,10 +11742,8 @@ func (m *UpdateLogStreamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LogStream == nil {
-				m.LogStream = &varlogpb.LogStreamDescriptor{}
-			}
-			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, TrimResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8587,7 +11768,7 @@ func (m *UpdateLogStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *BackupReplica) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8610,13 +11791,32 @@ func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterLogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupReplica: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupReplica: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
@@ -8635,7 +11835,7 @@ func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
@@ -8654,56 +11854,103 @@ func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnregisterLogStreamResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitResult", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CommitResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterLogStreamResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColdTierPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColdTierPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8725,7 +11972,7 @@ func (m *UnregisterLogStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
+func (m *BackupManifest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8748,17 +11995,17 @@ func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveLogStreamReplicaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupManifest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveLogStreamReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupManifest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
 			}
-			m.StorageNodeID = 0
+			m.ClusterID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8768,16 +12015,16 @@ func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.TopicID = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8787,16 +12034,16 @@ func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.Version |= github_com_kakao_varlog_pkg_types.Version(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedIndex", wireType)
 			}
-			m.LogStreamID = 0
+			m.AppliedIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8806,11 +12053,110 @@ func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				m.AppliedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataRepository", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataRepository = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, BackupReplica{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8832,7 +12178,7 @@ func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
+func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8855,12 +12201,44 @@ func (m *RemoveLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveLogStreamReplicaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveLogStreamReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8882,7 +12260,7 @@ func (m *RemoveLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SealRequest) Unmarshal(dAtA []byte) error {
+func (m *BackupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8905,17 +12283,17 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SealRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
 			}
-			m.TopicID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8925,30 +12303,25 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			m.LogStreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Manifest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8970,7 +12343,7 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SealResponse) Unmarshal(dAtA []byte) error {
+func (m *GetMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8993,17 +12366,17 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SealResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMetadataRepositoryNodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMetadataRepositoryNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			var msglen int
+			m.NodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9013,31 +12386,66 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogStreams = append(m.LogStreams, snpb.LogStreamReplicaMetadataDescriptor{})
-			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SealedGLSN", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SealedGLSN = 0
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMetadataRepositoryNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMetadataRepositoryNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9047,11 +12455,28 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SealedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Node == nil {
+				m.Node = &varlogpb.MetadataRepositoryNode{}
+			}
+			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9073,7 +12498,7 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
+func (m *ListMetadataRepositoryNodesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9096,50 +12521,12 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsealRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListMetadataRepositoryNodesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListMetadataRepositoryNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
-			}
-			m.TopicID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
-			}
-			m.LogStreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9161,7 +12548,7 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
+func (m *ListMetadataRepositoryNodesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9184,15 +12571,15 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsealResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListMetadataRepositoryNodesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListMetadataRepositoryNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9219,10 +12606,8 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LogStream == nil {
-				m.LogStream = &varlogpb.LogStreamDescriptor{}
-			}
-			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nodes = append(m.Nodes, varlogpb.MetadataRepositoryNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9247,7 +12632,7 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncRequest) Unmarshal(dAtA []byte) error {
+func (m *GetMRMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9270,17 +12655,17 @@ func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMRMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMRMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
-			m.TopicID = 0
+			m.Leader = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9290,16 +12675,16 @@ func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.Leader |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationFactor", wireType)
 			}
-			m.LogStreamID = 0
+			m.ReplicationFactor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9309,16 +12694,16 @@ func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				m.ReplicationFactor |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcStorageNodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			m.SrcStorageNodeID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9328,30 +12713,105 @@ func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SrcStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstStorageNodeID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			m.DstStorageNodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Members == nil {
+				m.Members = make(map[github_com_kakao_varlog_pkg_types.NodeID]string)
+			}
+			var mapkey uint64
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DstStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
+			m.Members[github_com_kakao_varlog_pkg_types.NodeID(mapkey)] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9373,7 +12833,7 @@ func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncResponse) Unmarshal(dAtA []byte) error {
+func (m *AddMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9396,17 +12856,17 @@ func (m *SyncResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddMetadataRepositoryNodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddMetadataRepositoryNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9416,27 +12876,55 @@ func (m *SyncResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &snpb.SyncStatus{}
+			m.RaftURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RPCAddr", wireType)
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RPCAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9459,7 +12947,7 @@ func (m *SyncResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TrimRequest) Unmarshal(dAtA []byte) error {
+func (m *AddMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9482,17 +12970,17 @@ func (m *TrimRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TrimRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddMetadataRepositoryNodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrimRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddMetadataRepositoryNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
 			}
-			m.TopicID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9502,30 +12990,28 @@ func (m *TrimRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastGLSN", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			m.LastGLSN = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Node == nil {
+				m.Node = &varlogpb.MetadataRepositoryNode{}
+			}
+			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9547,7 +13033,7 @@ func (m *TrimRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TrimResult) Unmarshal(dAtA []byte) error {
+func (m *AddMRPeerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9570,17 +13056,17 @@ func (m *TrimResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TrimResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddMRPeerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrimResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddMRPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftURL", wireType)
 			}
-			m.StorageNodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9590,33 +13076,27 @@ func (m *TrimResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			m.LogStreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RaftURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RPCAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9644,7 +13124,7 @@ func (m *TrimResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.RPCAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9667,7 +13147,7 @@ func (m *TrimResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TrimResponse) Unmarshal(dAtA []byte) error {
+func (m *AddMRPeerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9690,17 +13170,17 @@ func (m *TrimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TrimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddMRPeerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddMRPeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			var msglen int
+			m.NodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9710,26 +13190,11 @@ func (m *TrimResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Results = append(m.Results, TrimResult{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9751,7 +13216,7 @@ func (m *TrimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9774,10 +13239,10 @@ func (m *GetMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetadataRepositoryNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetadataRepositoryNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9820,7 +13285,7 @@ func (m *GetMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9843,48 +13308,12 @@ func (m *GetMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetadataRepositoryNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetadataRepositoryNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Node == nil {
-				m.Node = &varlogpb.MetadataRepositoryNode{}
-			}
-			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9906,7 +13335,7 @@ func (m *GetMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListMetadataRepositoryNodesRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveMRPeerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9929,12 +13358,44 @@ func (m *ListMetadataRepositoryNodesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListMetadataRepositoryNodesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveMRPeerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListMetadataRepositoryNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveMRPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RaftURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9956,7 +13417,7 @@ func (m *ListMetadataRepositoryNodesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListMetadataRepositoryNodesResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveMRPeerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9979,46 +13440,12 @@ func (m *ListMetadataRepositoryNodesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListMetadataRepositoryNodesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListMetadataRepositoryNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Nodes = append(m.Nodes, varlogpb.MetadataRepositoryNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			return fmt.Errorf("proto: RemoveMRPeerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveMRPeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -10040,7 +13467,7 @@ func (m *ListMetadataRepositoryNodesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMRMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10063,17 +13490,17 @@ func (m *GetMRMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMRMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGroupHeartbeatRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMRMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGroupHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			m.Leader = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -10083,16 +13510,29 @@ func (m *GetMRMembersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Leader |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			m.ReplicationFactor = 0
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -10102,16 +13542,16 @@ func (m *GetMRMembersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReplicationFactor |= int32(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -10121,29 +13561,27 @@ func (m *GetMRMembersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Members == nil {
-				m.Members = make(map[github_com_kakao_varlog_pkg_types.NodeID]string)
-			}
-			var mapkey uint64
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
+			m.MemberID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v github_com_kakao_varlog_pkg_types.LogStreamID
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowAdmin
@@ -10153,29 +13591,51 @@ func (m *GetMRMembersResponse) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= uint64(b&0x7F) << shift
+					v |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAdmin
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				m.OwnedLogStreamIDs = append(m.OwnedLogStreamIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
 					}
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OwnedLogStreamIDs) == 0 {
+					m.OwnedLogStreamIDs = make([]github_com_kakao_varlog_pkg_types.LogStreamID, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_kakao_varlog_pkg_types.LogStreamID
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowAdmin
@@ -10185,41 +13645,35 @@ func (m *GetMRMembersResponse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						v |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthAdmin
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthAdmin
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAdmin(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthAdmin
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+					m.OwnedLogStreamIDs = append(m.OwnedLogStreamIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnedLogStreamIDs", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Members[github_com_kakao_varlog_pkg_types.NodeID(mapkey)] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -10241,7 +13695,7 @@ func (m *GetMRMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10264,17 +13718,17 @@ func (m *AddMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddMetadataRepositoryNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGroupHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddMetadataRepositoryNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGroupHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftURL", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
 			}
-			var stringLen uint64
+			m.Generation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -10284,109 +13738,90 @@ func (m *AddMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Generation |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RaftURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RPCAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
+			if wireType == 0 {
+				var v github_com_kakao_varlog_pkg_types.LogStreamID
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.LogStreamIDs = append(m.LogStreamIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAdmin
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.LogStreamIDs) == 0 {
+					m.LogStreamIDs = make([]github_com_kakao_varlog_pkg_types.LogStreamID, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_kakao_varlog_pkg_types.LogStreamID
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LogStreamIDs = append(m.LogStreamIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamIDs", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RPCAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AddMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddMetadataRepositoryNodeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddMetadataRepositoryNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10413,10 +13848,7 @@ func (m *AddMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Node == nil {
-				m.Node = &varlogpb.MetadataRepositoryNode{}
-			}
-			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SessionTimeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10441,7 +13873,7 @@ func (m *AddMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddMRPeerRequest) Unmarshal(dAtA []byte) error {
+func (m *LeaveConsumerGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10464,15 +13896,15 @@ func (m *AddMRPeerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddMRPeerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaveConsumerGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddMRPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaveConsumerGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10500,11 +13932,30 @@ func (m *AddMRPeerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RaftURL = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RPCAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10532,7 +13983,7 @@ func (m *AddMRPeerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RPCAddr = string(dAtA[iNdEx:postIndex])
+			m.MemberID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10555,7 +14006,7 @@ func (m *AddMRPeerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddMRPeerResponse) Unmarshal(dAtA []byte) error {
+func (m *LeaveConsumerGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10578,31 +14029,12 @@ func (m *AddMRPeerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddMRPeerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaveConsumerGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddMRPeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaveConsumerGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
-			}
-			m.NodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -10624,7 +14056,7 @@ func (m *AddMRPeerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *CommitConsumerGroupOffsetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10647,17 +14079,17 @@ func (m *DeleteMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitConsumerGroupOffsetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitConsumerGroupOffsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			m.NodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -10667,114 +14099,46 @@ func (m *DeleteMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveMRPeerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveMRPeerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveMRPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10802,8 +14166,65 @@ func (m *RemoveMRPeerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RaftURL = string(dAtA[iNdEx:postIndex])
+			m.MemberID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSN", wireType)
+			}
+			m.LLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LLSN |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -10825,7 +14246,7 @@ func (m *RemoveMRPeerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveMRPeerResponse) Unmarshal(dAtA []byte) error {
+func (m *CommitConsumerGroupOffsetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10848,10 +14269,10 @@ func (m *RemoveMRPeerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveMRPeerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitConsumerGroupOffsetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveMRPeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitConsumerGroupOffsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -136,6 +136,66 @@ func (mr *MockClusterManagerClientMockRecorder) AddTopic(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTopic", reflect.TypeOf((*MockClusterManagerClient)(nil).AddTopic), varargs...)
 }
 
+// Backup mocks base method.
+func (m *MockClusterManagerClient) Backup(arg0 context.Context, arg1 *BackupRequest, arg2 ...grpc.CallOption) (*BackupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Backup", varargs...)
+	ret0, _ := ret[0].(*BackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockClusterManagerClientMockRecorder) Backup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockClusterManagerClient)(nil).Backup), varargs...)
+}
+
+// CommitConsumerGroupOffset mocks base method.
+func (m *MockClusterManagerClient) CommitConsumerGroupOffset(arg0 context.Context, arg1 *CommitConsumerGroupOffsetRequest, arg2 ...grpc.CallOption) (*CommitConsumerGroupOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitConsumerGroupOffset", varargs...)
+	ret0, _ := ret[0].(*CommitConsumerGroupOffsetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitConsumerGroupOffset indicates an expected call of CommitConsumerGroupOffset.
+func (mr *MockClusterManagerClientMockRecorder) CommitConsumerGroupOffset(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConsumerGroupOffset", reflect.TypeOf((*MockClusterManagerClient)(nil).CommitConsumerGroupOffset), varargs...)
+}
+
+// ConsumerGroupHeartbeat mocks base method.
+func (m *MockClusterManagerClient) ConsumerGroupHeartbeat(arg0 context.Context, arg1 *ConsumerGroupHeartbeatRequest, arg2 ...grpc.CallOption) (*ConsumerGroupHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConsumerGroupHeartbeat", varargs...)
+	ret0, _ := ret[0].(*ConsumerGroupHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumerGroupHeartbeat indicates an expected call of ConsumerGroupHeartbeat.
+func (mr *MockClusterManagerClientMockRecorder) ConsumerGroupHeartbeat(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumerGroupHeartbeat", reflect.TypeOf((*MockClusterManagerClient)(nil).ConsumerGroupHeartbeat), varargs...)
+}
+
 // DeleteMetadataRepositoryNode mocks base method.
 func (m *MockClusterManagerClient) DeleteMetadataRepositoryNode(arg0 context.Context, arg1 *DeleteMetadataRepositoryNodeRequest, arg2 ...grpc.CallOption) (*DeleteMetadataRepositoryNodeResponse, error) {
 	m.ctrl.T.Helper()
@@ -276,6 +336,26 @@ func (mr *MockClusterManagerClientMockRecorder) GetTopic(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopic", reflect.TypeOf((*MockClusterManagerClient)(nil).GetTopic), varargs...)
 }
 
+// LeaveConsumerGroup mocks base method.
+func (m *MockClusterManagerClient) LeaveConsumerGroup(arg0 context.Context, arg1 *LeaveConsumerGroupRequest, arg2 ...grpc.CallOption) (*LeaveConsumerGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LeaveConsumerGroup", varargs...)
+	ret0, _ := ret[0].(*LeaveConsumerGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveConsumerGroup indicates an expected call of LeaveConsumerGroup.
+func (mr *MockClusterManagerClientMockRecorder) LeaveConsumerGroup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveConsumerGroup", reflect.TypeOf((*MockClusterManagerClient)(nil).LeaveConsumerGroup), varargs...)
+}
+
 // ListLogStreams mocks base method.
 func (m *MockClusterManagerClient) ListLogStreams(arg0 context.Context, arg1 *ListLogStreamsRequest, arg2 ...grpc.CallOption) (*ListLogStreamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -416,6 +496,26 @@ func (mr *MockClusterManagerClientMockRecorder) Seal(arg0, arg1 interface{}, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockClusterManagerClient)(nil).Seal), varargs...)
 }
 
+// SetRetentionPolicy mocks base method.
+func (m *MockClusterManagerClient) SetRetentionPolicy(arg0 context.Context, arg1 *SetRetentionPolicyRequest, arg2 ...grpc.CallOption) (*SetRetentionPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetRetentionPolicy", varargs...)
+	ret0, _ := ret[0].(*SetRetentionPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRetentionPolicy indicates an expected call of SetRetentionPolicy.
+func (mr *MockClusterManagerClientMockRecorder) SetRetentionPolicy(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetentionPolicy", reflect.TypeOf((*MockClusterManagerClient)(nil).SetRetentionPolicy), varargs...)
+}
+
 // Sync mocks base method.
 func (m *MockClusterManagerClient) Sync(arg0 context.Context, arg1 *SyncRequest, arg2 ...grpc.CallOption) (*SyncResponse, error) {
 	m.ctrl.T.Helper()
@@ -654,6 +754,51 @@ func (mr *MockClusterManagerServerMockRecorder) AddTopic(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTopic", reflect.TypeOf((*MockClusterManagerServer)(nil).AddTopic), arg0, arg1)
 }
 
+// Backup mocks base method.
+func (m *MockClusterManagerServer) Backup(arg0 context.Context, arg1 *BackupRequest) (*BackupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup", arg0, arg1)
+	ret0, _ := ret[0].(*BackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockClusterManagerServerMockRecorder) Backup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockClusterManagerServer)(nil).Backup), arg0, arg1)
+}
+
+// CommitConsumerGroupOffset mocks base method.
+func (m *MockClusterManagerServer) CommitConsumerGroupOffset(arg0 context.Context, arg1 *CommitConsumerGroupOffsetRequest) (*CommitConsumerGroupOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitConsumerGroupOffset", arg0, arg1)
+	ret0, _ := ret[0].(*CommitConsumerGroupOffsetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitConsumerGroupOffset indicates an expected call of CommitConsumerGroupOffset.
+func (mr *MockClusterManagerServerMockRecorder) CommitConsumerGroupOffset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConsumerGroupOffset", reflect.TypeOf((*MockClusterManagerServer)(nil).CommitConsumerGroupOffset), arg0, arg1)
+}
+
+// ConsumerGroupHeartbeat mocks base method.
+func (m *MockClusterManagerServer) ConsumerGroupHeartbeat(arg0 context.Context, arg1 *ConsumerGroupHeartbeatRequest) (*ConsumerGroupHeartbeatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumerGroupHeartbeat", arg0, arg1)
+	ret0, _ := ret[0].(*ConsumerGroupHeartbeatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumerGroupHeartbeat indicates an expected call of ConsumerGroupHeartbeat.
+func (mr *MockClusterManagerServerMockRecorder) ConsumerGroupHeartbeat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumerGroupHeartbeat", reflect.TypeOf((*MockClusterManagerServer)(nil).ConsumerGroupHeartbeat), arg0, arg1)
+}
+
 // DeleteMetadataRepositoryNode mocks base method.
 func (m *MockClusterManagerServer) DeleteMetadataRepositoryNode(arg0 context.Context, arg1 *DeleteMetadataRepositoryNodeRequest) (*DeleteMetadataRepositoryNodeResponse, error) {
 	m.ctrl.T.Helper()
@@ -759,6 +904,21 @@ func (mr *MockClusterManagerServerMockRecorder) GetTopic(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopic", reflect.TypeOf((*MockClusterManagerServer)(nil).GetTopic), arg0, arg1)
 }
 
+// LeaveConsumerGroup mocks base method.
+func (m *MockClusterManagerServer) LeaveConsumerGroup(arg0 context.Context, arg1 *LeaveConsumerGroupRequest) (*LeaveConsumerGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(*LeaveConsumerGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveConsumerGroup indicates an expected call of LeaveConsumerGroup.
+func (mr *MockClusterManagerServerMockRecorder) LeaveConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveConsumerGroup", reflect.TypeOf((*MockClusterManagerServer)(nil).LeaveConsumerGroup), arg0, arg1)
+}
+
 // ListLogStreams mocks base method.
 func (m *MockClusterManagerServer) ListLogStreams(arg0 context.Context, arg1 *ListLogStreamsRequest) (*ListLogStreamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -864,6 +1024,21 @@ func (mr *MockClusterManagerServerMockRecorder) Seal(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockClusterManagerServer)(nil).Seal), arg0, arg1)
 }
 
+// SetRetentionPolicy mocks base method.
+func (m *MockClusterManagerServer) SetRetentionPolicy(arg0 context.Context, arg1 *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRetentionPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*SetRetentionPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRetentionPolicy indicates an expected call of SetRetentionPolicy.
+func (mr *MockClusterManagerServerMockRecorder) SetRetentionPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetentionPolicy", reflect.TypeOf((*MockClusterManagerServer)(nil).SetRetentionPolicy), arg0, arg1)
+}
+
 // Sync mocks base method.
 func (m *MockClusterManagerServer) Sync(arg0 context.Context, arg1 *SyncRequest) (*SyncResponse, error) {
 	m.ctrl.T.Helper()
@@ -112,6 +112,97 @@ func (m *GetMetadataResponse) GetMetadata() *varlogpb.MetadataDescriptor {
 	return nil
 }
 
+type WatchMetadataRequest struct {
+	// AppliedIndex is the applied index of the metadata that the client already
+	// has. The server sends only metadata whose applied index is greater than
+	// it.
+	AppliedIndex uint64 `protobuf:"varint,1,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
+}
+
+func (m *WatchMetadataRequest) Reset()         { *m = WatchMetadataRequest{} }
+func (m *WatchMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchMetadataRequest) ProtoMessage()    {}
+func (*WatchMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{2}
+}
+func (m *WatchMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchMetadataRequest.Merge(m, src)
+}
+func (m *WatchMetadataRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *WatchMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchMetadataRequest proto.InternalMessageInfo
+
+func (m *WatchMetadataRequest) GetAppliedIndex() uint64 {
+	if m != nil {
+		return m.AppliedIndex
+	}
+	return 0
+}
+
+type WatchMetadataResponse struct {
+	Metadata *varlogpb.MetadataDescriptor `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *WatchMetadataResponse) Reset()         { *m = WatchMetadataResponse{} }
+func (m *WatchMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchMetadataResponse) ProtoMessage()    {}
+func (*WatchMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{3}
+}
+func (m *WatchMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchMetadataResponse.Merge(m, src)
+}
+func (m *WatchMetadataResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *WatchMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchMetadataResponse proto.InternalMessageInfo
+
+func (m *WatchMetadataResponse) GetMetadata() *varlogpb.MetadataDescriptor {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 type StorageNodeRequest struct {
 	StorageNode *varlogpb.StorageNodeDescriptor `protobuf:"bytes,1,opt,name=storage_node,json=storageNode,proto3" json:"storage_node,omitempty"`
 }
@@ -120,7 +211,7 @@ func (m *StorageNodeRequest) Reset()         { *m = StorageNodeRequest{} }
 func (m *StorageNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*StorageNodeRequest) ProtoMessage()    {}
 func (*StorageNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffe516e0fdff161, []int{2}
+	return fileDescriptor_0ffe516e0fdff161, []int{4}
 }
 func (m *StorageNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,7 +255,7 @@ func (m *LogStreamRequest) Reset()         { *m = LogStreamRequest{} }
 func (m *LogStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*LogStreamRequest) ProtoMessage()    {}
 func (*LogStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffe516e0fdff161, []int{3}
+	return fileDescriptor_0ffe516e0fdff161, []int{5}
 }
 func (m *LogStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -209,7 +300,7 @@ func (m *SealRequest) Reset()         { *m = SealRequest{} }
 func (m *SealRequest) String() string { return proto.CompactTextString(m) }
 func (*SealRequest) ProtoMessage()    {}
 func (*SealRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffe516e0fdff161, []int{4}
+	return fileDescriptor_0ffe516e0fdff161, []int{6}
 }
 func (m *SealRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,7 +352,7 @@ func (m *SealResponse) Reset()         { *m = SealResponse{} }
 func (m *SealResponse) String() string { return proto.CompactTextString(m) }
 func (*SealResponse) ProtoMessage()    {}
 func (*SealResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffe516e0fdff161, []int{5}
+	return fileDescriptor_0ffe516e0fdff161, []int{7}
 }
 func (m *SealResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -313,7 +404,7 @@ func (m *UnsealRequest) Reset()         { *m = UnsealRequest{} }
 func (m *UnsealRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsealRequest) ProtoMessage()    {}
 func (*UnsealRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffe516e0fdff161, []int{6}
+	return fileDescriptor_0ffe516e0fdff161, []int{8}
 }
 func (m *UnsealRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -364,7 +455,7 @@ func (m *UnsealResponse) Reset()         { *m = UnsealResponse{} }
 func (m *UnsealResponse) String() string { return proto.CompactTextString(m) }
 func (*UnsealResponse) ProtoMessage()    {}
 func (*UnsealResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffe516e0fdff161, []int{7}
+	return fileDescriptor_0ffe516e0fdff161, []int{9}
 }
 func (m *UnsealResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,7 +499,7 @@ func (m *TopicRequest) Reset()         { *m = TopicRequest{} }
 func (m *TopicRequest) String() string { return proto.CompactTextString(m) }
 func (*TopicRequest) ProtoMessage()    {}
 func (*TopicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0ffe516e0fdff161, []int{8}
+	return fileDescriptor_0ffe516e0fdff161, []int{10}
 }
 func (m *TopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,893 +535,3069 @@ func (m *TopicRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*GetMetadataRequest)(nil), "varlog.mrpb.GetMetadataRequest")
-	proto.RegisterType((*GetMetadataResponse)(nil), "varlog.mrpb.GetMetadataResponse")
-	proto.RegisterType((*StorageNodeRequest)(nil), "varlog.mrpb.StorageNodeRequest")
-	proto.RegisterType((*LogStreamRequest)(nil), "varlog.mrpb.LogStreamRequest")
-	proto.RegisterType((*SealRequest)(nil), "varlog.mrpb.SealRequest")
-	proto.RegisterType((*SealResponse)(nil), "varlog.mrpb.SealResponse")
-	proto.RegisterType((*UnsealRequest)(nil), "varlog.mrpb.UnsealRequest")
-	proto.RegisterType((*UnsealResponse)(nil), "varlog.mrpb.UnsealResponse")
-	proto.RegisterType((*TopicRequest)(nil), "varlog.mrpb.TopicRequest")
+// SetRetentionPolicyRequest replaces the retention policy of the topic. A nil
+// or empty retention_policy removes the retention policy.
+type SetRetentionPolicyRequest struct {
+	TopicID         github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	RetentionPolicy *varlogpb.RetentionPolicy                 `protobuf:"bytes,2,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("proto/mrpb/metadata_repository.proto", fileDescriptor_0ffe516e0fdff161)
+func (m *SetRetentionPolicyRequest) Reset()         { *m = SetRetentionPolicyRequest{} }
+func (m *SetRetentionPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRetentionPolicyRequest) ProtoMessage()    {}
+func (*SetRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{11}
 }
-
-var fileDescriptor_0ffe516e0fdff161 = []byte{
-	// 719 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x96, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0xc7, 0xe3, 0x0a, 0x02, 0x4c, 0x08, 0x94, 0x4d, 0x3f, 0xc0, 0xa8, 0x71, 0xe4, 0x22, 0x44,
-	0x55, 0xe1, 0x48, 0xf4, 0xc2, 0x81, 0x8a, 0x2a, 0xd0, 0xa2, 0xa0, 0x94, 0x56, 0x4e, 0xe9, 0xa1,
-	0x55, 0x15, 0x39, 0xf1, 0xd6, 0xb5, 0xb0, 0xb3, 0xae, 0x77, 0x83, 0xc4, 0x5b, 0xb4, 0x6f, 0xd0,
-	0xd7, 0xe8, 0x1b, 0x70, 0x44, 0x3d, 0xf5, 0xe4, 0x43, 0xf2, 0x16, 0x9c, 0x2a, 0xaf, 0xbd, 0xfe,
-	0x20, 0x7c, 0x1c, 0xe0, 0xd4, 0x9b, 0xbd, 0x33, 0xf3, 0x9b, 0xff, 0xee, 0x64, 0xff, 0x0e, 0xac,
-	0x78, 0x3e, 0x61, 0xa4, 0xee, 0xfa, 0x5e, 0xb7, 0xee, 0x62, 0x66, 0x98, 0x06, 0x33, 0x3a, 0x3e,
-	0xf6, 0x08, 0xb5, 0x19, 0xf1, 0x4f, 0x34, 0x1e, 0x46, 0xa5, 0x63, 0xc3, 0x77, 0x88, 0xa5, 0x85,
-	0x69, 0xf2, 0xba, 0x65, 0xb3, 0x6f, 0x83, 0xae, 0xd6, 0x23, 0x6e, 0xdd, 0x22, 0x16, 0xa9, 0xf3,
-	0x9c, 0xee, 0xe0, 0x2b, 0x7f, 0x8b, 0x78, 0xe1, 0x53, 0x54, 0x2b, 0x2f, 0x5b, 0x84, 0x58, 0x0e,
-	0x4e, 0xb3, 0xb0, 0xeb, 0xb1, 0x18, 0x2c, 0x3f, 0x8e, 0xc0, 0x99, 0xe6, 0x51, 0x40, 0x7d, 0x00,
-	0x68, 0x0f, 0xb3, 0xb7, 0xf1, 0xa2, 0x8e, 0xbf, 0x0f, 0x30, 0x65, 0xea, 0x47, 0xa8, 0xe4, 0x56,
-	0xa9, 0x47, 0xfa, 0x14, 0xa3, 0x6d, 0x98, 0x16, 0xe5, 0x8b, 0x52, 0x4d, 0x5a, 0x2b, 0x6d, 0x3c,
-	0xd5, 0x62, 0xc5, 0x82, 0xaf, 0x89, 0xa2, 0x5d, 0x4c, 0x7b, 0xbe, 0xed, 0x31, 0xe2, 0xeb, 0x49,
-	0x91, 0x8a, 0x01, 0xb5, 0x19, 0xf1, 0x0d, 0x0b, 0x1f, 0x10, 0x13, 0xc7, 0xdd, 0xd0, 0x3b, 0x98,
-	0xa5, 0xd1, 0x6a, 0xa7, 0x4f, 0x4c, 0x1c, 0xa3, 0x57, 0xc7, 0xd0, 0x99, 0xd2, 0x94, 0xde, 0x98,
-	0x38, 0x0d, 0x14, 0x49, 0x2f, 0xd1, 0x34, 0xa8, 0x7e, 0x81, 0xfb, 0x2d, 0x62, 0xb5, 0x99, 0x8f,
-	0x0d, 0x57, 0x34, 0x69, 0x02, 0x38, 0xc4, 0xea, 0x50, 0xbe, 0x18, 0xb7, 0x58, 0x19, 0x6b, 0x91,
-	0x94, 0x8d, 0x35, 0x98, 0x71, 0x44, 0x48, 0x3d, 0x93, 0xa0, 0xd4, 0xc6, 0x86, 0x23, 0xd0, 0x9f,
-	0x01, 0x7a, 0xce, 0x80, 0x32, 0xec, 0x77, 0x6c, 0x93, 0xa3, 0xcb, 0x8d, 0xad, 0x61, 0xa0, 0xcc,
-	0xec, 0x44, 0xab, 0xcd, 0xdd, 0xf3, 0x40, 0x79, 0x9e, 0x99, 0xe6, 0x91, 0x71, 0x64, 0x90, 0x7a,
-	0xd4, 0xb4, 0xee, 0x1d, 0x59, 0x75, 0x76, 0xe2, 0x61, 0xaa, 0x25, 0xe9, 0xfa, 0x4c, 0xcc, 0x6b,
-	0x9a, 0xc8, 0x84, 0x72, 0xaa, 0x3b, 0xe4, 0xdf, 0xab, 0x49, 0x6b, 0x93, 0x8d, 0x57, 0xc3, 0x40,
-	0x29, 0x25, 0x6a, 0x79, 0x87, 0xf5, 0x9b, 0x3b, 0x64, 0x0a, 0xf4, 0x52, 0xb2, 0xa1, 0xa6, 0xa9,
-	0xfe, 0x96, 0x60, 0x36, 0xda, 0x52, 0x3c, 0xea, 0x4d, 0x28, 0x52, 0x66, 0xb0, 0x01, 0xe5, 0xfb,
-	0x99, 0xdb, 0xa8, 0x5d, 0x7d, 0x54, 0x6d, 0x9e, 0xa7, 0xc7, 0xf9, 0x88, 0x40, 0xc5, 0x31, 0x28,
-	0xeb, 0xf4, 0x88, 0xeb, 0xda, 0x8c, 0x61, 0xb3, 0x63, 0x39, 0xb4, 0xcf, 0x65, 0x4f, 0x34, 0xb6,
-	0x87, 0x81, 0xb2, 0xd0, 0x32, 0x28, 0xdb, 0x11, 0xd1, 0xbd, 0x56, 0xfb, 0xe0, 0x3c, 0x50, 0x56,
-	0x6f, 0x16, 0x1f, 0x66, 0xea, 0x0b, 0x4e, 0xae, 0xd8, 0xa1, 0x7d, 0xf5, 0x8f, 0x04, 0xe5, 0xc3,
-	0x3e, 0xfd, 0xbf, 0x06, 0xb2, 0x0f, 0x73, 0x62, 0x4f, 0xb7, 0x9d, 0x88, 0xda, 0x83, 0xd9, 0x0f,
-	0xc4, 0xb3, 0x7b, 0xe2, 0x78, 0xda, 0x30, 0xcd, 0xc2, 0x77, 0x71, 0x38, 0x93, 0x8d, 0xcd, 0x61,
-	0xa0, 0x4c, 0xf1, 0x1c, 0x2e, 0xfc, 0xd9, 0xcd, 0xc2, 0xe3, 0x64, 0x7d, 0x8a, 0x93, 0x9a, 0xe6,
-	0xc6, 0xcf, 0x22, 0x2c, 0xa5, 0x86, 0x21, 0x7c, 0xad, 0x8d, 0xfd, 0x63, 0xbb, 0x87, 0xd1, 0x7b,
-	0xa8, 0xe8, 0xd8, 0xb2, 0xc3, 0x23, 0xcc, 0xdc, 0x62, 0xa4, 0x68, 0x19, 0xc3, 0xd3, 0xc6, 0xad,
-	0x41, 0x7e, 0xa4, 0x45, 0xae, 0xa6, 0x09, 0x57, 0xd3, 0x5e, 0x87, 0xae, 0xa6, 0x16, 0x90, 0x0e,
-	0x0f, 0x0f, 0xfb, 0xfe, 0xdd, 0x32, 0x77, 0xa1, 0x2c, 0x54, 0xf2, 0xfd, 0xa1, 0xa5, 0x1c, 0x2b,
-	0x7b, 0x88, 0xd7, 0x50, 0xde, 0xc0, 0x7c, 0xaa, 0xec, 0x16, 0x9c, 0x16, 0x2c, 0x08, 0x35, 0xc9,
-	0x64, 0xd1, 0x93, 0x1c, 0xe9, 0xa2, 0xcb, 0x5d, 0x43, 0x3b, 0x80, 0x4a, 0xaa, 0xea, 0x0e, 0x78,
-	0xfb, 0x30, 0x7f, 0xe8, 0x99, 0x06, 0xc3, 0x77, 0xc0, 0xd2, 0xa1, 0x94, 0xf9, 0xdc, 0x5c, 0x98,
-	0xe0, 0xf8, 0xe7, 0x49, 0xae, 0x5d, 0x9d, 0x10, 0x5d, 0x16, 0xb5, 0x80, 0x5e, 0xc2, 0x44, 0x68,
-	0x68, 0x68, 0x31, 0xff, 0x73, 0x48, 0x5d, 0x42, 0x5e, 0xba, 0x24, 0x92, 0x94, 0xef, 0x40, 0x31,
-	0xba, 0x7f, 0x48, 0xce, 0xa5, 0xe5, 0x8c, 0x46, 0x5e, 0xbe, 0x34, 0x26, 0x20, 0x8d, 0xad, 0xd3,
-	0x61, 0x55, 0x3a, 0x1b, 0x56, 0xa5, 0x1f, 0xa3, 0x6a, 0xe1, 0xd7, 0xa8, 0x2a, 0x9d, 0x8d, 0xaa,
-	0x85, 0xbf, 0xa3, 0x6a, 0xe1, 0x93, 0x7a, 0xe5, 0x0d, 0x4b, 0xfe, 0x26, 0x74, 0x8b, 0xfc, 0xf9,
-	0xc5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x84, 0x18, 0x7e, 0x3b, 0x08, 0x00, 0x00,
+func (m *SetRetentionPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MetadataRepositoryServiceClient is the client API for MetadataRepositoryService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MetadataRepositoryServiceClient interface {
-	RegisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	UnregisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	RegisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	UnregisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	RegisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	UnregisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	UpdateLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
-	Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error)
-	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error)
+func (m *SetRetentionPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetRetentionPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type metadataRepositoryServiceClient struct {
-	cc *grpc.ClientConn
+func (m *SetRetentionPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRetentionPolicyRequest.Merge(m, src)
 }
-
-func NewMetadataRepositoryServiceClient(cc *grpc.ClientConn) MetadataRepositoryServiceClient {
-	return &metadataRepositoryServiceClient{cc}
+func (m *SetRetentionPolicyRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SetRetentionPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRetentionPolicyRequest.DiscardUnknown(m)
 }
 
-func (c *metadataRepositoryServiceClient) RegisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/RegisterStorageNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SetRetentionPolicyRequest proto.InternalMessageInfo
+
+func (m *SetRetentionPolicyRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *metadataRepositoryServiceClient) UnregisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UnregisterStorageNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SetRetentionPolicyRequest) GetRetentionPolicy() *varlogpb.RetentionPolicy {
+	if m != nil {
+		return m.RetentionPolicy
 	}
-	return out, nil
+	return nil
 }
 
-func (c *metadataRepositoryServiceClient) RegisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/RegisterTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// CommitOffsetRequest commits the offset of the consumer group named group.
+type CommitOffsetRequest struct {
+	Group  string              `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Offset ConsumerGroupOffset `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset"`
 }
 
-func (c *metadataRepositoryServiceClient) UnregisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UnregisterTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CommitOffsetRequest) Reset()         { *m = CommitOffsetRequest{} }
+func (m *CommitOffsetRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitOffsetRequest) ProtoMessage()    {}
+func (*CommitOffsetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{12}
+}
+func (m *CommitOffsetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitOffsetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitOffsetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *CommitOffsetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitOffsetRequest.Merge(m, src)
+}
+func (m *CommitOffsetRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CommitOffsetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitOffsetRequest.DiscardUnknown(m)
 }
 
-func (c *metadataRepositoryServiceClient) RegisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/RegisterLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CommitOffsetRequest proto.InternalMessageInfo
+
+func (m *CommitOffsetRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return out, nil
+	return ""
 }
 
-func (c *metadataRepositoryServiceClient) UnregisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UnregisterLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CommitOffsetRequest) GetOffset() ConsumerGroupOffset {
+	if m != nil {
+		return m.Offset
 	}
-	return out, nil
+	return ConsumerGroupOffset{}
 }
 
-func (c *metadataRepositoryServiceClient) UpdateLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UpdateLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// FetchOffsetRequest fetches the offset of the consumer group named group in
+// the topic, or in the log stream if the log_stream_id is not zero.
+type FetchOffsetRequest struct {
+	Group       string                                        `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,3,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 }
 
-func (c *metadataRepositoryServiceClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
-	out := new(GetMetadataResponse)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/GetMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FetchOffsetRequest) Reset()         { *m = FetchOffsetRequest{} }
+func (m *FetchOffsetRequest) String() string { return proto.CompactTextString(m) }
+func (*FetchOffsetRequest) ProtoMessage()    {}
+func (*FetchOffsetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{13}
+}
+func (m *FetchOffsetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FetchOffsetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchOffsetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *FetchOffsetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchOffsetRequest.Merge(m, src)
+}
+func (m *FetchOffsetRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *FetchOffsetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchOffsetRequest.DiscardUnknown(m)
 }
 
-func (c *metadataRepositoryServiceClient) Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error) {
-	out := new(SealResponse)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/Seal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FetchOffsetRequest proto.InternalMessageInfo
+
+func (m *FetchOffsetRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return out, nil
+	return ""
 }
 
-func (c *metadataRepositoryServiceClient) Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error) {
-	out := new(UnsealResponse)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/Unseal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *FetchOffsetRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
-	return out, nil
+	return 0
 }
 
-// MetadataRepositoryServiceServer is the server API for MetadataRepositoryService service.
-type MetadataRepositoryServiceServer interface {
-	RegisterStorageNode(context.Context, *StorageNodeRequest) (*types.Empty, error)
-	UnregisterStorageNode(context.Context, *StorageNodeRequest) (*types.Empty, error)
-	RegisterTopic(context.Context, *TopicRequest) (*types.Empty, error)
-	UnregisterTopic(context.Context, *TopicRequest) (*types.Empty, error)
-	RegisterLogStream(context.Context, *LogStreamRequest) (*types.Empty, error)
-	UnregisterLogStream(context.Context, *LogStreamRequest) (*types.Empty, error)
-	UpdateLogStream(context.Context, *LogStreamRequest) (*types.Empty, error)
-	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
-	Seal(context.Context, *SealRequest) (*SealResponse, error)
-	Unseal(context.Context, *UnsealRequest) (*UnsealResponse, error)
+func (m *FetchOffsetRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
 }
 
-// UnimplementedMetadataRepositoryServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedMetadataRepositoryServiceServer struct {
+type FetchOffsetResponse struct {
+	Offset ConsumerGroupOffset `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset"`
 }
 
-func (*UnimplementedMetadataRepositoryServiceServer) RegisterStorageNode(ctx context.Context, req *StorageNodeRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterStorageNode not implemented")
-}
-func (*UnimplementedMetadataRepositoryServiceServer) UnregisterStorageNode(ctx context.Context, req *StorageNodeRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterStorageNode not implemented")
+func (m *FetchOffsetResponse) Reset()         { *m = FetchOffsetResponse{} }
+func (m *FetchOffsetResponse) String() string { return proto.CompactTextString(m) }
+func (*FetchOffsetResponse) ProtoMessage()    {}
+func (*FetchOffsetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{14}
 }
-func (*UnimplementedMetadataRepositoryServiceServer) RegisterTopic(ctx context.Context, req *TopicRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterTopic not implemented")
+func (m *FetchOffsetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMetadataRepositoryServiceServer) UnregisterTopic(ctx context.Context, req *TopicRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterTopic not implemented")
-}
-func (*UnimplementedMetadataRepositoryServiceServer) RegisterLogStream(ctx context.Context, req *LogStreamRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterLogStream not implemented")
-}
-func (*UnimplementedMetadataRepositoryServiceServer) UnregisterLogStream(ctx context.Context, req *LogStreamRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterLogStream not implemented")
+func (m *FetchOffsetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FetchOffsetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMetadataRepositoryServiceServer) UpdateLogStream(ctx context.Context, req *LogStreamRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateLogStream not implemented")
+func (m *FetchOffsetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchOffsetResponse.Merge(m, src)
 }
-func (*UnimplementedMetadataRepositoryServiceServer) GetMetadata(ctx context.Context, req *GetMetadataRequest) (*GetMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+func (m *FetchOffsetResponse) XXX_Size() int {
+	return m.ProtoSize()
 }
-func (*UnimplementedMetadataRepositoryServiceServer) Seal(ctx context.Context, req *SealRequest) (*SealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Seal not implemented")
+func (m *FetchOffsetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchOffsetResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMetadataRepositoryServiceServer) Unseal(ctx context.Context, req *UnsealRequest) (*UnsealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
+
+var xxx_messageInfo_FetchOffsetResponse proto.InternalMessageInfo
+
+func (m *FetchOffsetResponse) GetOffset() ConsumerGroupOffset {
+	if m != nil {
+		return m.Offset
+	}
+	return ConsumerGroupOffset{}
 }
 
-func RegisterMetadataRepositoryServiceServer(s *grpc.Server, srv MetadataRepositoryServiceServer) {
-	s.RegisterService(&_MetadataRepositoryService_serviceDesc, srv)
+// DeleteConsumerGroupRequest deletes the consumer group named group.
+type DeleteConsumerGroupRequest struct {
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 }
 
-func _MetadataRepositoryService_RegisterStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StorageNodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).RegisterStorageNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/RegisterStorageNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).RegisterStorageNode(ctx, req.(*StorageNodeRequest))
+func (m *DeleteConsumerGroupRequest) Reset()         { *m = DeleteConsumerGroupRequest{} }
+func (m *DeleteConsumerGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteConsumerGroupRequest) ProtoMessage()    {}
+func (*DeleteConsumerGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{15}
+}
+func (m *DeleteConsumerGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteConsumerGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteConsumerGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DeleteConsumerGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteConsumerGroupRequest.Merge(m, src)
+}
+func (m *DeleteConsumerGroupRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DeleteConsumerGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteConsumerGroupRequest.DiscardUnknown(m)
 }
 
-func _MetadataRepositoryService_UnregisterStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StorageNodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).UnregisterStorageNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UnregisterStorageNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).UnregisterStorageNode(ctx, req.(*StorageNodeRequest))
+var xxx_messageInfo_DeleteConsumerGroupRequest proto.InternalMessageInfo
+
+func (m *DeleteConsumerGroupRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _MetadataRepositoryService_RegisterTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).RegisterTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/RegisterTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).RegisterTopic(ctx, req.(*TopicRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type GetHighWatermarkRequest struct {
+	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 }
 
-func _MetadataRepositoryService_UnregisterTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).UnregisterTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UnregisterTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).UnregisterTopic(ctx, req.(*TopicRequest))
+func (m *GetHighWatermarkRequest) Reset()         { *m = GetHighWatermarkRequest{} }
+func (m *GetHighWatermarkRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHighWatermarkRequest) ProtoMessage()    {}
+func (*GetHighWatermarkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{16}
+}
+func (m *GetHighWatermarkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetHighWatermarkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetHighWatermarkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GetHighWatermarkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHighWatermarkRequest.Merge(m, src)
+}
+func (m *GetHighWatermarkRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetHighWatermarkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHighWatermarkRequest.DiscardUnknown(m)
 }
 
-func _MetadataRepositoryService_RegisterLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogStreamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).RegisterLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/RegisterLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).RegisterLogStream(ctx, req.(*LogStreamRequest))
+var xxx_messageInfo_GetHighWatermarkRequest proto.InternalMessageInfo
+
+func (m *GetHighWatermarkRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _MetadataRepositoryService_UnregisterLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogStreamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).UnregisterLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UnregisterLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).UnregisterLogStream(ctx, req.(*LogStreamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type GetHighWatermarkResponse struct {
+	// HighWatermark is the GLSN of the last log entry committed to the topic.
+	// It is zero if no log entry has been committed yet.
+	HighWatermark github_com_kakao_varlog_pkg_types.GLSN `protobuf:"varint,1,opt,name=high_watermark,json=highWatermark,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"high_watermark,omitempty"`
 }
 
-func _MetadataRepositoryService_UpdateLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogStreamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).UpdateLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UpdateLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).UpdateLogStream(ctx, req.(*LogStreamRequest))
+func (m *GetHighWatermarkResponse) Reset()         { *m = GetHighWatermarkResponse{} }
+func (m *GetHighWatermarkResponse) String() string { return proto.CompactTextString(m) }
+func (*GetHighWatermarkResponse) ProtoMessage()    {}
+func (*GetHighWatermarkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{17}
+}
+func (m *GetHighWatermarkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetHighWatermarkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetHighWatermarkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GetHighWatermarkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHighWatermarkResponse.Merge(m, src)
+}
+func (m *GetHighWatermarkResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetHighWatermarkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHighWatermarkResponse.DiscardUnknown(m)
 }
 
-func _MetadataRepositoryService_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).GetMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/GetMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+var xxx_messageInfo_GetHighWatermarkResponse proto.InternalMessageInfo
+
+func (m *GetHighWatermarkResponse) GetHighWatermark() github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.HighWatermark
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _MetadataRepositoryService_Seal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SealRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).Seal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/Seal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).Seal(ctx, req.(*SealRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type BackupRequest struct {
 }
 
-func _MetadataRepositoryService_Unseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsealRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).Unseal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/Unseal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).Unseal(ctx, req.(*UnsealRequest))
+func (m *BackupRequest) Reset()         { *m = BackupRequest{} }
+func (m *BackupRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()    {}
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{18}
+}
+func (m *BackupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *BackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupRequest.Merge(m, src)
+}
+func (m *BackupRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *BackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupRequest.DiscardUnknown(m)
 }
 
-var _MetadataRepositoryService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "varlog.mrpb.MetadataRepositoryService",
-	HandlerType: (*MetadataRepositoryServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterStorageNode",
-			Handler:    _MetadataRepositoryService_RegisterStorageNode_Handler,
-		},
-		{
-			MethodName: "UnregisterStorageNode",
-			Handler:    _MetadataRepositoryService_UnregisterStorageNode_Handler,
-		},
-		{
-			MethodName: "RegisterTopic",
-			Handler:    _MetadataRepositoryService_RegisterTopic_Handler,
-		},
-		{
-			MethodName: "UnregisterTopic",
-			Handler:    _MetadataRepositoryService_UnregisterTopic_Handler,
-		},
-		{
-			MethodName: "RegisterLogStream",
-			Handler:    _MetadataRepositoryService_RegisterLogStream_Handler,
-		},
-		{
-			MethodName: "UnregisterLogStream",
-			Handler:    _MetadataRepositoryService_UnregisterLogStream_Handler,
-		},
-		{
-			MethodName: "UpdateLogStream",
-			Handler:    _MetadataRepositoryService_UpdateLogStream_Handler,
-		},
-		{
-			MethodName: "GetMetadata",
-			Handler:    _MetadataRepositoryService_GetMetadata_Handler,
-		},
-		{
-			MethodName: "Seal",
-			Handler:    _MetadataRepositoryService_Seal_Handler,
-		},
-		{
-			MethodName: "Unseal",
-			Handler:    _MetadataRepositoryService_Unseal_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/mrpb/metadata_repository.proto",
-}
+var xxx_messageInfo_BackupRequest proto.InternalMessageInfo
 
-func (m *GetMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type BackupResponse struct {
+	// StateMachine is the copy of the state machine. Its last commit results
+	// decide the version of the backup.
+	StateMachine *MetadataRepositoryDescriptor `protobuf:"bytes,1,opt,name=state_machine,json=stateMachine,proto3" json:"state_machine,omitempty"`
+	// AppliedIndex is the index of the raft entry that took the backup.
+	AppliedIndex uint64 `protobuf:"varint,2,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
 }
 
-func (m *GetMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BackupResponse) Reset()         { *m = BackupResponse{} }
+func (m *BackupResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupResponse) ProtoMessage()    {}
+func (*BackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{19}
 }
-
-func (m *GetMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *BackupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *GetMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupResponse.Merge(m, src)
+}
+func (m *BackupResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *BackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupResponse.DiscardUnknown(m)
 }
 
-func (m *GetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_BackupResponse proto.InternalMessageInfo
+
+func (m *BackupResponse) GetStateMachine() *MetadataRepositoryDescriptor {
+	if m != nil {
+		return m.StateMachine
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *StorageNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BackupResponse) GetAppliedIndex() uint64 {
+	if m != nil {
+		return m.AppliedIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *StorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*GetMetadataRequest)(nil), "varlog.mrpb.GetMetadataRequest")
+	proto.RegisterType((*GetMetadataResponse)(nil), "varlog.mrpb.GetMetadataResponse")
+	proto.RegisterType((*WatchMetadataRequest)(nil), "varlog.mrpb.WatchMetadataRequest")
+	proto.RegisterType((*WatchMetadataResponse)(nil), "varlog.mrpb.WatchMetadataResponse")
+	proto.RegisterType((*StorageNodeRequest)(nil), "varlog.mrpb.StorageNodeRequest")
+	proto.RegisterType((*LogStreamRequest)(nil), "varlog.mrpb.LogStreamRequest")
+	proto.RegisterType((*SealRequest)(nil), "varlog.mrpb.SealRequest")
+	proto.RegisterType((*SealResponse)(nil), "varlog.mrpb.SealResponse")
+	proto.RegisterType((*UnsealRequest)(nil), "varlog.mrpb.UnsealRequest")
+	proto.RegisterType((*UnsealResponse)(nil), "varlog.mrpb.UnsealResponse")
+	proto.RegisterType((*TopicRequest)(nil), "varlog.mrpb.TopicRequest")
+	proto.RegisterType((*SetRetentionPolicyRequest)(nil), "varlog.mrpb.SetRetentionPolicyRequest")
+	proto.RegisterType((*CommitOffsetRequest)(nil), "varlog.mrpb.CommitOffsetRequest")
+	proto.RegisterType((*FetchOffsetRequest)(nil), "varlog.mrpb.FetchOffsetRequest")
+	proto.RegisterType((*FetchOffsetResponse)(nil), "varlog.mrpb.FetchOffsetResponse")
+	proto.RegisterType((*DeleteConsumerGroupRequest)(nil), "varlog.mrpb.DeleteConsumerGroupRequest")
+	proto.RegisterType((*GetHighWatermarkRequest)(nil), "varlog.mrpb.GetHighWatermarkRequest")
+	proto.RegisterType((*GetHighWatermarkResponse)(nil), "varlog.mrpb.GetHighWatermarkResponse")
+	proto.RegisterType((*BackupRequest)(nil), "varlog.mrpb.BackupRequest")
+	proto.RegisterType((*BackupResponse)(nil), "varlog.mrpb.BackupResponse")
 }
 
-func (m *StorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		{
-			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("proto/mrpb/metadata_repository.proto", fileDescriptor_0ffe516e0fdff161)
 }
 
-func (m *LogStreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_0ffe516e0fdff161 = []byte{
+	// 1124 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0xb7, 0x4a, 0x9a, 0x36, 0xcf, 0x76, 0xfe, 0xac, 0x53, 0x9a, 0x28, 0x83, 0x1d, 0x94, 0x10,
+	0xda, 0x61, 0x6a, 0x33, 0xe1, 0xd2, 0x99, 0x16, 0xca, 0x38, 0xa1, 0xc1, 0x9d, 0x34, 0x2d, 0x32,
+	0x69, 0x3b, 0x65, 0x18, 0xcd, 0x46, 0xda, 0xc8, 0x1a, 0xcb, 0x5a, 0x21, 0xad, 0x0b, 0xb9, 0x70,
+	0xe3, 0xce, 0x47, 0xe0, 0x6b, 0x70, 0xe6, 0xd2, 0x63, 0x86, 0x13, 0x27, 0x1f, 0x9c, 0x61, 0xf8,
+	0x0e, 0x3d, 0x31, 0x5a, 0x69, 0x65, 0xfd, 0xb1, 0x1d, 0xa0, 0xc9, 0x85, 0x9b, 0xb5, 0xef, 0xbd,
+	0xdf, 0xfb, 0xed, 0x7b, 0xbb, 0xfb, 0x7e, 0x86, 0x4d, 0xd7, 0xa3, 0x8c, 0x36, 0x7a, 0x9e, 0x7b,
+	0xd4, 0xe8, 0x11, 0x86, 0x0d, 0xcc, 0xb0, 0xe6, 0x11, 0x97, 0xfa, 0x16, 0xa3, 0xde, 0x49, 0x9d,
+	0x9b, 0x51, 0xf1, 0x15, 0xf6, 0x6c, 0x6a, 0xd6, 0x03, 0x37, 0xf9, 0x8e, 0x69, 0xb1, 0x4e, 0xff,
+	0xa8, 0xae, 0xd3, 0x5e, 0xc3, 0xa4, 0x26, 0x6d, 0x70, 0x9f, 0xa3, 0xfe, 0x31, 0xff, 0x0a, 0xf1,
+	0x82, 0x5f, 0x61, 0xac, 0xbc, 0x66, 0x52, 0x6a, 0xda, 0x64, 0xe4, 0x45, 0x7a, 0x2e, 0x8b, 0x80,
+	0xe5, 0x9b, 0x21, 0x70, 0x22, 0x79, 0x64, 0xd8, 0xe0, 0x8c, 0x3c, 0x7c, 0xcc, 0xb4, 0x89, 0xb4,
+	0x94, 0x65, 0x40, 0x7b, 0x84, 0x3d, 0x8e, 0xec, 0x2a, 0xf9, 0xae, 0x4f, 0x7c, 0xa6, 0x3c, 0x83,
+	0x4a, 0x6a, 0xd5, 0x77, 0xa9, 0xe3, 0x13, 0xf4, 0x00, 0xae, 0x0b, 0xa4, 0x15, 0x69, 0x5d, 0xba,
+	0x55, 0xdc, 0xde, 0xa8, 0x47, 0xdb, 0x12, 0x24, 0xea, 0x22, 0x68, 0x97, 0xf8, 0xba, 0x67, 0xb9,
+	0x8c, 0x7a, 0x6a, 0x1c, 0xa4, 0xdc, 0x83, 0xe5, 0xe7, 0x98, 0xe9, 0x9d, 0x4c, 0x3e, 0xb4, 0x01,
+	0x65, 0xec, 0xba, 0xb6, 0x45, 0x0c, 0xcd, 0x72, 0x0c, 0xf2, 0x03, 0x47, 0x9f, 0x51, 0x4b, 0xd1,
+	0x62, 0x2b, 0x58, 0x53, 0x5e, 0xc0, 0x8d, 0x4c, 0xf0, 0x45, 0xd1, 0x22, 0x80, 0xda, 0x8c, 0x7a,
+	0xd8, 0x24, 0x07, 0xd4, 0x20, 0x82, 0xd4, 0x13, 0x28, 0xf9, 0xe1, 0xaa, 0xe6, 0x50, 0x83, 0x44,
+	0xd0, 0x5b, 0x39, 0xe8, 0x44, 0xe8, 0x08, 0xbd, 0x39, 0xf3, 0x7a, 0x50, 0x93, 0xd4, 0xa2, 0x3f,
+	0x32, 0x2a, 0xdf, 0xc2, 0xe2, 0x3e, 0x35, 0xdb, 0xcc, 0x23, 0xb8, 0x27, 0x92, 0xb4, 0x00, 0x6c,
+	0x6a, 0x6a, 0x3e, 0x5f, 0x8c, 0x52, 0x6c, 0xe6, 0x52, 0xc4, 0x61, 0xb9, 0x04, 0x73, 0xb6, 0x30,
+	0x29, 0xa7, 0x12, 0x14, 0xdb, 0x04, 0xdb, 0x02, 0xfa, 0x1b, 0x00, 0xdd, 0xee, 0xfb, 0x8c, 0x78,
+	0x9a, 0x65, 0x70, 0xe8, 0x72, 0xf3, 0xfe, 0x70, 0x50, 0x9b, 0xdb, 0x09, 0x57, 0x5b, 0xbb, 0x6f,
+	0x06, 0xb5, 0x8f, 0x12, 0x27, 0xb1, 0x8b, 0xbb, 0x98, 0x36, 0xc2, 0xa4, 0x0d, 0xb7, 0x6b, 0x36,
+	0xd8, 0x89, 0x4b, 0xfc, 0x7a, 0xec, 0xae, 0xce, 0x45, 0x78, 0x2d, 0x03, 0x19, 0x50, 0x1e, 0xf1,
+	0x0e, 0xf0, 0xaf, 0xac, 0x4b, 0xb7, 0xae, 0x36, 0x3f, 0x1f, 0x0e, 0x6a, 0xc5, 0x98, 0x2d, 0xcf,
+	0x70, 0xe7, 0xfc, 0x0c, 0x89, 0x00, 0xb5, 0x18, 0x6f, 0xa8, 0x65, 0x28, 0xbf, 0x4a, 0x50, 0x0a,
+	0xb7, 0x14, 0xb5, 0xfa, 0x2e, 0xcc, 0xfa, 0x0c, 0xb3, 0xbe, 0xcf, 0xf7, 0x33, 0xbf, 0xbd, 0x3e,
+	0xb9, 0x54, 0x6d, 0xee, 0xa7, 0x46, 0xfe, 0x88, 0x42, 0xc5, 0xc6, 0x3e, 0xd3, 0x74, 0xda, 0xeb,
+	0x59, 0x8c, 0x11, 0x43, 0x33, 0x6d, 0xdf, 0xe1, 0xb4, 0x67, 0x9a, 0x0f, 0x86, 0x83, 0xda, 0xd2,
+	0x3e, 0xf6, 0xd9, 0x8e, 0xb0, 0xee, 0xed, 0xb7, 0x0f, 0xde, 0x0c, 0x6a, 0x5b, 0xe7, 0x93, 0x0f,
+	0x3c, 0xd5, 0x25, 0x3b, 0x15, 0x6c, 0xfb, 0x8e, 0xf2, 0xbb, 0x04, 0xe5, 0x43, 0xc7, 0xff, 0x7f,
+	0x35, 0xe4, 0x11, 0xcc, 0x8b, 0x3d, 0xbd, 0x6d, 0x47, 0x14, 0x1d, 0x4a, 0x5f, 0x53, 0xd7, 0xd2,
+	0x45, 0x79, 0xda, 0x70, 0x9d, 0x05, 0xdf, 0xa2, 0x38, 0x57, 0x9b, 0x77, 0x87, 0x83, 0xda, 0x35,
+	0xee, 0xc3, 0x89, 0xdf, 0x3e, 0x9f, 0x78, 0xe4, 0xac, 0x5e, 0xe3, 0x48, 0x2d, 0x43, 0xf9, 0x4d,
+	0x82, 0xd5, 0x36, 0x61, 0x2a, 0x61, 0xc4, 0x61, 0x16, 0x75, 0x9e, 0x52, 0xdb, 0xd2, 0x4f, 0x2e,
+	0x33, 0x25, 0xfa, 0x0a, 0x16, 0x3d, 0x91, 0x4e, 0x73, 0x79, 0x3e, 0xde, 0x8c, 0xe2, 0x98, 0xda,
+	0x64, 0x78, 0x45, 0x97, 0x7a, 0xc1, 0x4b, 0x2f, 0x2b, 0x5d, 0xa8, 0x84, 0x87, 0xeb, 0xc9, 0xf1,
+	0xb1, 0x4f, 0x98, 0xa0, 0xbf, 0x0c, 0x57, 0x4d, 0x8f, 0xf6, 0x5d, 0xce, 0x7d, 0x4e, 0x0d, 0x3f,
+	0xd0, 0x67, 0x30, 0x4b, 0xb9, 0x5b, 0x36, 0x6b, 0x30, 0x0f, 0xea, 0x3b, 0xd4, 0xf1, 0xfb, 0x3d,
+	0xe2, 0xed, 0x05, 0xbe, 0x21, 0x1c, 0xcf, 0x5a, 0x50, 0xa3, 0x28, 0xe5, 0x4f, 0x09, 0xd0, 0x43,
+	0xc2, 0xf4, 0xce, 0x3f, 0x49, 0x96, 0xac, 0xe0, 0x95, 0x8b, 0xaa, 0x60, 0xee, 0x2c, 0xbf, 0x73,
+	0x19, 0x67, 0xf9, 0x10, 0x2a, 0xa9, 0x6d, 0x46, 0x07, 0x7a, 0x54, 0x3e, 0xe9, 0x3f, 0x95, 0x6f,
+	0x1b, 0xe4, 0x5d, 0x62, 0x13, 0x46, 0x52, 0xae, 0x53, 0xab, 0xa8, 0x38, 0x70, 0x73, 0x8f, 0xb0,
+	0x2f, 0x2d, 0xb3, 0xf3, 0x1c, 0x33, 0xe2, 0xf5, 0xb0, 0xd7, 0xbd, 0xd4, 0x5b, 0xf1, 0x23, 0xac,
+	0xe4, 0xf3, 0x45, 0xfb, 0x3f, 0x82, 0xf9, 0x8e, 0x65, 0x76, 0xb4, 0xef, 0x85, 0x25, 0x1c, 0xc6,
+	0xcd, 0x7b, 0xc3, 0x41, 0xad, 0x9c, 0x0a, 0xf9, 0x17, 0xef, 0x63, 0xb9, 0x93, 0x0c, 0x54, 0x16,
+	0xa0, 0xdc, 0xc4, 0x7a, 0x37, 0x2e, 0x8b, 0xf2, 0x93, 0x04, 0xf3, 0x62, 0x25, 0xe2, 0x71, 0x00,
+	0xe5, 0xe0, 0xa1, 0x20, 0x5a, 0x0f, 0xeb, 0x1d, 0xcb, 0x11, 0xf3, 0xf7, 0x76, 0xaa, 0x1d, 0x23,
+	0x2d, 0x20, 0x74, 0x4d, 0x62, 0xc0, 0x97, 0x78, 0xfc, 0xe3, 0x30, 0x3c, 0xaf, 0x31, 0xae, 0xe4,
+	0x35, 0xc6, 0xf6, 0x5f, 0x00, 0xab, 0x79, 0xcc, 0x36, 0xf1, 0x5e, 0x59, 0x3a, 0x41, 0x4f, 0xa1,
+	0xa2, 0x12, 0xd3, 0x0a, 0x5e, 0xdc, 0xc4, 0xd0, 0x47, 0xb5, 0x14, 0xa5, 0xbc, 0x92, 0x90, 0xdf,
+	0xad, 0x87, 0x02, 0xae, 0x2e, 0x04, 0x5c, 0xfd, 0x8b, 0x40, 0xc0, 0x29, 0x05, 0xa4, 0xc2, 0x8d,
+	0x43, 0xc7, 0xbb, 0x58, 0xcc, 0x5d, 0x28, 0x0b, 0x96, 0xbc, 0xf1, 0x68, 0x35, 0x85, 0x95, 0x7c,
+	0x73, 0xa7, 0xa0, 0x3c, 0x84, 0x85, 0x11, 0xb3, 0xb7, 0xc0, 0xd9, 0x87, 0x25, 0xc1, 0x26, 0xbe,
+	0x89, 0xe8, 0xbd, 0x14, 0x52, 0x56, 0x14, 0x4d, 0x41, 0x3b, 0x80, 0xca, 0x88, 0xd5, 0x05, 0xe0,
+	0x3d, 0x82, 0x85, 0x43, 0xd7, 0xc0, 0x8c, 0x5c, 0x00, 0x96, 0x0a, 0xc5, 0x84, 0x68, 0xce, 0x74,
+	0x30, 0x2f, 0xb2, 0xe5, 0xf5, 0xc9, 0x0e, 0xe1, 0x15, 0x50, 0x0a, 0xe8, 0x25, 0x94, 0x53, 0x9a,
+	0x17, 0xbd, 0x9f, 0x0a, 0x1a, 0x27, 0xa6, 0x65, 0x65, 0x9a, 0x8b, 0x40, 0xfe, 0x58, 0x42, 0x9f,
+	0xc2, 0x4c, 0xa0, 0xad, 0xd0, 0x4a, 0xfa, 0xa8, 0x8d, 0x04, 0x8b, 0xbc, 0x3a, 0xc6, 0x12, 0x53,
+	0xdb, 0x81, 0xd9, 0x50, 0x0a, 0x20, 0x39, 0xe5, 0x96, 0xd2, 0x3c, 0xf2, 0xda, 0x58, 0x5b, 0x0c,
+	0xf2, 0x0c, 0x50, 0x7e, 0x3a, 0xa3, 0xad, 0x4c, 0xde, 0x09, 0xe3, 0x7b, 0x6a, 0x5f, 0x4b, 0xc9,
+	0x81, 0x89, 0xb2, 0x8f, 0x78, 0x6e, 0x96, 0x4e, 0xef, 0x6b, 0x62, 0x4e, 0x64, 0xfa, 0x9a, 0x1f,
+	0x94, 0xf2, 0xfa, 0x64, 0x87, 0x78, 0xdf, 0x2f, 0xa0, 0x32, 0x66, 0x48, 0xa0, 0x0f, 0x53, 0xa1,
+	0x93, 0xc7, 0xc8, 0x14, 0xb6, 0x18, 0x16, 0xb3, 0x4f, 0x3b, 0xda, 0xcc, 0x9e, 0xb4, 0x71, 0x93,
+	0x46, 0xfe, 0xe0, 0x1c, 0xaf, 0x64, 0xe7, 0xc3, 0xb7, 0x3a, 0xd3, 0xf9, 0xd4, 0x93, 0x2e, 0xaf,
+	0x8d, 0xb5, 0x09, 0x90, 0xe6, 0xfd, 0xd7, 0xc3, 0xaa, 0x74, 0x3a, 0xac, 0x4a, 0x3f, 0x9f, 0x55,
+	0x0b, 0xbf, 0x9c, 0x55, 0xa5, 0xd3, 0xb3, 0x6a, 0xe1, 0x8f, 0xb3, 0x6a, 0xe1, 0xa5, 0x32, 0x71,
+	0xa6, 0xc4, 0xff, 0xb3, 0x8f, 0x66, 0xf9, 0xef, 0x4f, 0xfe, 0x1e, 0x00, 0xf3, 0x6f, 0x42, 0x6f,
+	0x7c, 0x0f, 0x00, 0x00,
 }
 
-func (m *LogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MetadataRepositoryServiceClient is the client API for MetadataRepositoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MetadataRepositoryServiceClient interface {
+	RegisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	UnregisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	RegisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	UnregisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	RegisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	UnregisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	UpdateLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
+	// WatchMetadata sends the metadata whenever it changes, i.e., its applied
+	// index increases. The first response is the current metadata if its
+	// applied index is greater than the one in the request. Since it sends
+	// only the latest metadata, clients can miss intermediate changes. The
+	// stream lasts until the client cancels it or the server stops.
+	WatchMetadata(ctx context.Context, in *WatchMetadataRequest, opts ...grpc.CallOption) (MetadataRepositoryService_WatchMetadataClient, error)
+	Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error)
+	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error)
+	// SetRetentionPolicy replaces the retention policy of a topic. It returns
+	// an error with the code NotFound if the topic does not exist.
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// CommitOffset stores the offset of a consumer group. It returns an error
+	// with the code NotFound if the topic does not exist.
+	CommitOffset(ctx context.Context, in *CommitOffsetRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// FetchOffset returns the offset committed by a consumer group. It returns
+	// an error if the group has not committed any offset to the topic or the
+	// log stream. The read is linearizable, that is, it reflects every offset
+	// committed before it, even through other nodes.
+	FetchOffset(ctx context.Context, in *FetchOffsetRequest, opts ...grpc.CallOption) (*FetchOffsetResponse, error)
+	// DeleteConsumerGroup deletes all offsets committed by a consumer group.
+	// It returns an error if the group does not exist.
+	DeleteConsumerGroup(ctx context.Context, in *DeleteConsumerGroupRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// GetHighWatermark returns the global high watermark of a topic in the
+	// last commit results applied to the node. It returns an error with the
+	// code NotFound if the topic does not exist.
+	GetHighWatermark(ctx context.Context, in *GetHighWatermarkRequest, opts ...grpc.CallOption) (*GetHighWatermarkResponse, error)
+	// Backup returns a copy of the state machine taken at a point in the raft
+	// log. All commit results up to the last one in the copy are included,
+	// thus, it can be a consistent cut of the cluster.
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error)
 }
 
-func (m *LogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStream != nil {
-		{
-			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type metadataRepositoryServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *SealRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewMetadataRepositoryServiceClient(cc *grpc.ClientConn) MetadataRepositoryServiceClient {
+	return &metadataRepositoryServiceClient{cc}
+}
+
+func (c *metadataRepositoryServiceClient) RegisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/RegisterStorageNode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SealRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *metadataRepositoryServiceClient) UnregisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UnregisterStorageNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ClusterID != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.ClusterID))
-		i--
-		dAtA[i] = 0x8
+func (c *metadataRepositoryServiceClient) RegisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/RegisterTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SealResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *metadataRepositoryServiceClient) UnregisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UnregisterTopic", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SealResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastCommittedGLSN != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.LastCommittedGLSN))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Status != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+func (c *metadataRepositoryServiceClient) RegisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/RegisterLogStream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UnsealRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *metadataRepositoryServiceClient) UnregisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UnregisterLogStream", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UnsealRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *metadataRepositoryServiceClient) UpdateLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UpdateLogStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UnsealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ClusterID != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.ClusterID))
-		i--
-		dAtA[i] = 0x8
+func (c *metadataRepositoryServiceClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
+	out := new(GetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/GetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UnsealResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *metadataRepositoryServiceClient) WatchMetadata(ctx context.Context, in *WatchMetadataRequest, opts ...grpc.CallOption) (MetadataRepositoryService_WatchMetadataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MetadataRepositoryService_serviceDesc.Streams[0], "/varlog.mrpb.MetadataRepositoryService/WatchMetadata", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &metadataRepositoryServiceWatchMetadataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *UnsealResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MetadataRepositoryService_WatchMetadataClient interface {
+	Recv() (*WatchMetadataResponse, error)
+	grpc.ClientStream
 }
 
-func (m *UnsealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type metadataRepositoryServiceWatchMetadataClient struct {
+	grpc.ClientStream
 }
 
-func (m *TopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *metadataRepositoryServiceWatchMetadataClient) Recv() (*WatchMetadataResponse, error) {
+	m := new(WatchMetadataResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return m, nil
 }
 
-func (m *TopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+func (c *metadataRepositoryServiceClient) Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error) {
+	out := new(SealResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/Seal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintMetadataRepository(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMetadataRepository(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *metadataRepositoryServiceClient) Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error) {
+	out := new(UnsealResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/Unseal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *GetMetadataRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+
+func (c *metadataRepositoryServiceClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/SetRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *GetMetadataResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.ProtoSize()
-		n += 1 + l + sovMetadataRepository(uint64(l))
+func (c *metadataRepositoryServiceClient) CommitOffset(ctx context.Context, in *CommitOffsetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/CommitOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *StorageNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		l = m.StorageNode.ProtoSize()
-		n += 1 + l + sovMetadataRepository(uint64(l))
+func (c *metadataRepositoryServiceClient) FetchOffset(ctx context.Context, in *FetchOffsetRequest, opts ...grpc.CallOption) (*FetchOffsetResponse, error) {
+	out := new(FetchOffsetResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/FetchOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *LogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LogStream != nil {
-		l = m.LogStream.ProtoSize()
-		n += 1 + l + sovMetadataRepository(uint64(l))
+func (c *metadataRepositoryServiceClient) DeleteConsumerGroup(ctx context.Context, in *DeleteConsumerGroupRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/DeleteConsumerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *SealRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClusterID != 0 {
-		n += 1 + sovMetadataRepository(uint64(m.ClusterID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovMetadataRepository(uint64(m.LogStreamID))
+func (c *metadataRepositoryServiceClient) GetHighWatermark(ctx context.Context, in *GetHighWatermarkRequest, opts ...grpc.CallOption) (*GetHighWatermarkResponse, error) {
+	out := new(GetHighWatermarkResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/GetHighWatermark", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *SealResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovMetadataRepository(uint64(m.Status))
-	}
-	if m.LastCommittedGLSN != 0 {
-		n += 1 + sovMetadataRepository(uint64(m.LastCommittedGLSN))
+func (c *metadataRepositoryServiceClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error) {
+	out := new(BackupResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/Backup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *UnsealRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClusterID != 0 {
-		n += 1 + sovMetadataRepository(uint64(m.ClusterID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovMetadataRepository(uint64(m.LogStreamID))
-	}
-	return n
+// MetadataRepositoryServiceServer is the server API for MetadataRepositoryService service.
+type MetadataRepositoryServiceServer interface {
+	RegisterStorageNode(context.Context, *StorageNodeRequest) (*types.Empty, error)
+	UnregisterStorageNode(context.Context, *StorageNodeRequest) (*types.Empty, error)
+	RegisterTopic(context.Context, *TopicRequest) (*types.Empty, error)
+	UnregisterTopic(context.Context, *TopicRequest) (*types.Empty, error)
+	RegisterLogStream(context.Context, *LogStreamRequest) (*types.Empty, error)
+	UnregisterLogStream(context.Context, *LogStreamRequest) (*types.Empty, error)
+	UpdateLogStream(context.Context, *LogStreamRequest) (*types.Empty, error)
+	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
+	// WatchMetadata sends the metadata whenever it changes, i.e., its applied
+	// index increases. The first response is the current metadata if its
+	// applied index is greater than the one in the request. Since it sends
+	// only the latest metadata, clients can miss intermediate changes. The
+	// stream lasts until the client cancels it or the server stops.
+	WatchMetadata(*WatchMetadataRequest, MetadataRepositoryService_WatchMetadataServer) error
+	Seal(context.Context, *SealRequest) (*SealResponse, error)
+	Unseal(context.Context, *UnsealRequest) (*UnsealResponse, error)
+	// SetRetentionPolicy replaces the retention policy of a topic. It returns
+	// an error with the code NotFound if the topic does not exist.
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*types.Empty, error)
+	// CommitOffset stores the offset of a consumer group. It returns an error
+	// with the code NotFound if the topic does not exist.
+	CommitOffset(context.Context, *CommitOffsetRequest) (*types.Empty, error)
+	// FetchOffset returns the offset committed by a consumer group. It returns
+	// an error if the group has not committed any offset to the topic or the
+	// log stream. The read is linearizable, that is, it reflects every offset
+	// committed before it, even through other nodes.
+	FetchOffset(context.Context, *FetchOffsetRequest) (*FetchOffsetResponse, error)
+	// DeleteConsumerGroup deletes all offsets committed by a consumer group.
+	// It returns an error if the group does not exist.
+	DeleteConsumerGroup(context.Context, *DeleteConsumerGroupRequest) (*types.Empty, error)
+	// GetHighWatermark returns the global high watermark of a topic in the
+	// last commit results applied to the node. It returns an error with the
+	// code NotFound if the topic does not exist.
+	GetHighWatermark(context.Context, *GetHighWatermarkRequest) (*GetHighWatermarkResponse, error)
+	// Backup returns a copy of the state machine taken at a point in the raft
+	// log. All commit results up to the last one in the copy are included,
+	// thus, it can be a consistent cut of the cluster.
+	Backup(context.Context, *BackupRequest) (*BackupResponse, error)
 }
 
-func (m *UnsealResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovMetadataRepository(uint64(m.Status))
-	}
-	return n
+// UnimplementedMetadataRepositoryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMetadataRepositoryServiceServer struct {
 }
 
-func (m *TopicRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (*UnimplementedMetadataRepositoryServiceServer) RegisterStorageNode(ctx context.Context, req *StorageNodeRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterStorageNode not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) UnregisterStorageNode(ctx context.Context, req *StorageNodeRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterStorageNode not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) RegisterTopic(ctx context.Context, req *TopicRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTopic not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) UnregisterTopic(ctx context.Context, req *TopicRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterTopic not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) RegisterLogStream(ctx context.Context, req *LogStreamRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterLogStream not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) UnregisterLogStream(ctx context.Context, req *LogStreamRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterLogStream not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) UpdateLogStream(ctx context.Context, req *LogStreamRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLogStream not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) GetMetadata(ctx context.Context, req *GetMetadataRequest) (*GetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) WatchMetadata(req *WatchMetadataRequest, srv MetadataRepositoryService_WatchMetadataServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMetadata not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) Seal(ctx context.Context, req *SealRequest) (*SealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Seal not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) Unseal(ctx context.Context, req *UnsealRequest) (*UnsealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) SetRetentionPolicy(ctx context.Context, req *SetRetentionPolicyRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) CommitOffset(ctx context.Context, req *CommitOffsetRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitOffset not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) FetchOffset(ctx context.Context, req *FetchOffsetRequest) (*FetchOffsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchOffset not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) DeleteConsumerGroup(ctx context.Context, req *DeleteConsumerGroupRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConsumerGroup not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) GetHighWatermark(ctx context.Context, req *GetHighWatermarkRequest) (*GetHighWatermarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHighWatermark not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) Backup(ctx context.Context, req *BackupRequest) (*BackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+
+func RegisterMetadataRepositoryServiceServer(s *grpc.Server, srv MetadataRepositoryServiceServer) {
+	s.RegisterService(&_MetadataRepositoryService_serviceDesc, srv)
+}
+
+func _MetadataRepositoryService_RegisterStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).RegisterStorageNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/RegisterStorageNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).RegisterStorageNode(ctx, req.(*StorageNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_UnregisterStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).UnregisterStorageNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UnregisterStorageNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).UnregisterStorageNode(ctx, req.(*StorageNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_RegisterTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).RegisterTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/RegisterTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).RegisterTopic(ctx, req.(*TopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_UnregisterTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).UnregisterTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UnregisterTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).UnregisterTopic(ctx, req.(*TopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_RegisterLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).RegisterLogStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/RegisterLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).RegisterLogStream(ctx, req.(*LogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_UnregisterLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).UnregisterLogStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UnregisterLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).UnregisterLogStream(ctx, req.(*LogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_UpdateLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).UpdateLogStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UpdateLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).UpdateLogStream(ctx, req.(*LogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).GetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/GetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_WatchMetadata_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMetadataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetadataRepositoryServiceServer).WatchMetadata(m, &metadataRepositoryServiceWatchMetadataServer{stream})
+}
+
+type MetadataRepositoryService_WatchMetadataServer interface {
+	Send(*WatchMetadataResponse) error
+	grpc.ServerStream
+}
+
+type metadataRepositoryServiceWatchMetadataServer struct {
+	grpc.ServerStream
+}
+
+func (x *metadataRepositoryServiceWatchMetadataServer) Send(m *WatchMetadataResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MetadataRepositoryService_Seal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).Seal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/Seal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).Seal(ctx, req.(*SealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_Unseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).Unseal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/Unseal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).Unseal(ctx, req.(*UnsealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).SetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/SetRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_CommitOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).CommitOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/CommitOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).CommitOffset(ctx, req.(*CommitOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_FetchOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).FetchOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/FetchOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).FetchOffset(ctx, req.(*FetchOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_DeleteConsumerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConsumerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).DeleteConsumerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/DeleteConsumerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).DeleteConsumerGroup(ctx, req.(*DeleteConsumerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_GetHighWatermark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHighWatermarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).GetHighWatermark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/GetHighWatermark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).GetHighWatermark(ctx, req.(*GetHighWatermarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).Backup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/Backup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).Backup(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MetadataRepositoryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "varlog.mrpb.MetadataRepositoryService",
+	HandlerType: (*MetadataRepositoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterStorageNode",
+			Handler:    _MetadataRepositoryService_RegisterStorageNode_Handler,
+		},
+		{
+			MethodName: "UnregisterStorageNode",
+			Handler:    _MetadataRepositoryService_UnregisterStorageNode_Handler,
+		},
+		{
+			MethodName: "RegisterTopic",
+			Handler:    _MetadataRepositoryService_RegisterTopic_Handler,
+		},
+		{
+			MethodName: "UnregisterTopic",
+			Handler:    _MetadataRepositoryService_UnregisterTopic_Handler,
+		},
+		{
+			MethodName: "RegisterLogStream",
+			Handler:    _MetadataRepositoryService_RegisterLogStream_Handler,
+		},
+		{
+			MethodName: "UnregisterLogStream",
+			Handler:    _MetadataRepositoryService_UnregisterLogStream_Handler,
+		},
+		{
+			MethodName: "UpdateLogStream",
+			Handler:    _MetadataRepositoryService_UpdateLogStream_Handler,
+		},
+		{
+			MethodName: "GetMetadata",
+			Handler:    _MetadataRepositoryService_GetMetadata_Handler,
+		},
+		{
+			MethodName: "Seal",
+			Handler:    _MetadataRepositoryService_Seal_Handler,
+		},
+		{
+			MethodName: "Unseal",
+			Handler:    _MetadataRepositoryService_Unseal_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _MetadataRepositoryService_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "CommitOffset",
+			Handler:    _MetadataRepositoryService_CommitOffset_Handler,
+		},
+		{
+			MethodName: "FetchOffset",
+			Handler:    _MetadataRepositoryService_FetchOffset_Handler,
+		},
+		{
+			MethodName: "DeleteConsumerGroup",
+			Handler:    _MetadataRepositoryService_DeleteConsumerGroup_Handler,
+		},
+		{
+			MethodName: "GetHighWatermark",
+			Handler:    _MetadataRepositoryService_GetHighWatermark_Handler,
+		},
+		{
+			MethodName: "Backup",
+			Handler:    _MetadataRepositoryService_Backup_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchMetadata",
+			Handler:       _MetadataRepositoryService_WatchMetadata_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/mrpb/metadata_repository.proto",
+}
+
+func (m *GetMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppliedIndex != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.AppliedIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StorageNode != nil {
+		{
+			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		{
+			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SealRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStreamID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastCommittedGLSN != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.LastCommittedGLSN))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsealRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsealRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStreamID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetRetentionPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetRetentionPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetRetentionPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetentionPolicy != nil {
+		{
+			size, err := m.RetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitOffsetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitOffsetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitOffsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Offset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchOffsetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchOffsetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchOffsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStreamID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchOffsetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchOffsetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchOffsetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Offset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteConsumerGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteConsumerGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteConsumerGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetHighWatermarkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHighWatermarkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetHighWatermarkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetHighWatermarkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHighWatermarkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetHighWatermarkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HighWatermark != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.HighWatermark))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppliedIndex != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.AppliedIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StateMachine != nil {
+		{
+			size, err := m.StateMachine.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMetadataRepository(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMetadataRepository(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetMetadataRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetMetadataResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.ProtoSize()
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	return n
+}
+
+func (m *WatchMetadataRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppliedIndex != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.AppliedIndex))
+	}
+	return n
+}
+
+func (m *WatchMetadataResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.ProtoSize()
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	return n
+}
+
+func (m *StorageNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNode != nil {
+		l = m.StorageNode.ProtoSize()
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	return n
+}
+
+func (m *LogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		l = m.LogStream.ProtoSize()
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	return n
+}
+
+func (m *SealRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.ClusterID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *SealResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.Status))
+	}
+	if m.LastCommittedGLSN != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.LastCommittedGLSN))
+	}
+	return n
+}
+
+func (m *UnsealRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.ClusterID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *UnsealResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *TopicRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.TopicID != 0 {
 		n += 1 + sovMetadataRepository(uint64(m.TopicID))
 	}
-	return n
+	return n
+}
+
+func (m *SetRetentionPolicyRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.TopicID))
+	}
+	if m.RetentionPolicy != nil {
+		l = m.RetentionPolicy.ProtoSize()
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitOffsetRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	l = m.Offset.ProtoSize()
+	n += 1 + l + sovMetadataRepository(uint64(l))
+	return n
+}
+
+func (m *FetchOffsetRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *FetchOffsetResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Offset.ProtoSize()
+	n += 1 + l + sovMetadataRepository(uint64(l))
+	return n
+}
+
+func (m *DeleteConsumerGroupRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	return n
+}
+
+func (m *GetHighWatermarkRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.TopicID))
+	}
+	return n
+}
+
+func (m *GetHighWatermarkResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HighWatermark != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.HighWatermark))
+	}
+	return n
+}
+
+func (m *BackupRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BackupResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StateMachine != nil {
+		l = m.StateMachine.ProtoSize()
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	if m.AppliedIndex != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.AppliedIndex))
+	}
+	return n
+}
+
+func sovMetadataRepository(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMetadataRepository(x uint64) (n int) {
+	return sovMetadataRepository(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &varlogpb.MetadataDescriptor{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedIndex", wireType)
+			}
+			m.AppliedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppliedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &varlogpb.MetadataDescriptor{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageNode == nil {
+				m.StorageNode = &varlogpb.StorageNodeDescriptor{}
+			}
+			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogStream == nil {
+				m.LogStream = &varlogpb.LogStreamDescriptor{}
+			}
+			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommittedGLSN", wireType)
+			}
+			m.LastCommittedGLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCommittedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsealRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsealResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovMetadataRepository(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozMetadataRepository(x uint64) (n int) {
-	return sovMetadataRepository(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *TopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *SetRetentionPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1340,25 +3607,80 @@ func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowMetadataRepository
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetRetentionPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetRetentionPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.RetentionPolicy == nil {
+				m.RetentionPolicy = &varlogpb.RetentionPolicy{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetadataRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.RetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
@@ -1380,7 +3702,7 @@ func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *CommitOffsetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1403,15 +3725,47 @@ func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitOffsetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitOffsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1438,10 +3792,7 @@ func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &varlogpb.MetadataDescriptor{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1466,7 +3817,7 @@ func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorageNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *FetchOffsetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1489,17 +3840,17 @@ func (m *StorageNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchOffsetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchOffsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1509,28 +3860,62 @@ func (m *StorageNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetadataRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetadataRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageNode == nil {
-				m.StorageNode = &varlogpb.StorageNodeDescriptor{}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
@@ -1552,7 +3937,7 @@ func (m *StorageNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *FetchOffsetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1575,15 +3960,15 @@ func (m *LogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchOffsetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchOffsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1610,10 +3995,7 @@ func (m *LogStreamRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LogStream == nil {
-				m.LogStream = &varlogpb.LogStreamDescriptor{}
-			}
-			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1638,7 +4020,7 @@ func (m *LogStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SealRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteConsumerGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1661,17 +4043,17 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SealRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteConsumerGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteConsumerGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			m.ClusterID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1681,30 +4063,24 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadataRepository
 			}
-			m.LogStreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetadataRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
@@ -1726,7 +4102,7 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SealResponse) Unmarshal(dAtA []byte) error {
+func (m *GetHighWatermarkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1749,36 +4125,17 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SealResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetHighWatermarkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetHighWatermarkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetadataRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCommittedGLSN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			m.LastCommittedGLSN = 0
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1788,7 +4145,7 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastCommittedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1814,7 +4171,7 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
+func (m *GetHighWatermarkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1837,36 +4194,17 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsealRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetHighWatermarkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetHighWatermarkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
-			}
-			m.ClusterID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetadataRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWatermark", wireType)
 			}
-			m.LogStreamID = 0
+			m.HighWatermark = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1876,7 +4214,7 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				m.HighWatermark |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1902,7 +4240,7 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
+func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1925,31 +4263,12 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsealResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetadataRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
@@ -1971,7 +4290,7 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicRequest) Unmarshal(dAtA []byte) error {
+func (m *BackupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1994,17 +4313,53 @@ func (m *TopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateMachine", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StateMachine == nil {
+				m.StateMachine = &MetadataRepositoryDescriptor{}
+			}
+			if err := m.StateMachine.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedIndex", wireType)
 			}
-			m.TopicID = 0
+			m.AppliedIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -2014,7 +4369,7 @@ func (m *TopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.AppliedIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -189,6 +189,106 @@ func (m *MockMetadataRepositoryServiceClient) EXPECT() *MockMetadataRepositorySe
 	return m.recorder
 }
 
+// Backup mocks base method.
+func (m *MockMetadataRepositoryServiceClient) Backup(arg0 context.Context, arg1 *mrpb.BackupRequest, arg2 ...grpc.CallOption) (*mrpb.BackupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Backup", varargs...)
+	ret0, _ := ret[0].(*mrpb.BackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) Backup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).Backup), varargs...)
+}
+
+// CommitOffset mocks base method.
+func (m *MockMetadataRepositoryServiceClient) CommitOffset(arg0 context.Context, arg1 *mrpb.CommitOffsetRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitOffset", varargs...)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitOffset indicates an expected call of CommitOffset.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) CommitOffset(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitOffset", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).CommitOffset), varargs...)
+}
+
+// DeleteConsumerGroup mocks base method.
+func (m *MockMetadataRepositoryServiceClient) DeleteConsumerGroup(arg0 context.Context, arg1 *mrpb.DeleteConsumerGroupRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteConsumerGroup", varargs...)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConsumerGroup indicates an expected call of DeleteConsumerGroup.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) DeleteConsumerGroup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConsumerGroup", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).DeleteConsumerGroup), varargs...)
+}
+
+// FetchOffset mocks base method.
+func (m *MockMetadataRepositoryServiceClient) FetchOffset(arg0 context.Context, arg1 *mrpb.FetchOffsetRequest, arg2 ...grpc.CallOption) (*mrpb.FetchOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FetchOffset", varargs...)
+	ret0, _ := ret[0].(*mrpb.FetchOffsetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchOffset indicates an expected call of FetchOffset.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) FetchOffset(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchOffset", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).FetchOffset), varargs...)
+}
+
+// GetHighWatermark mocks base method.
+func (m *MockMetadataRepositoryServiceClient) GetHighWatermark(arg0 context.Context, arg1 *mrpb.GetHighWatermarkRequest, arg2 ...grpc.CallOption) (*mrpb.GetHighWatermarkResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHighWatermark", varargs...)
+	ret0, _ := ret[0].(*mrpb.GetHighWatermarkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHighWatermark indicates an expected call of GetHighWatermark.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) GetHighWatermark(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHighWatermark", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).GetHighWatermark), varargs...)
+}
+
 // GetMetadata mocks base method.
 func (m *MockMetadataRepositoryServiceClient) GetMetadata(arg0 context.Context, arg1 *mrpb.GetMetadataRequest, arg2 ...grpc.CallOption) (*mrpb.GetMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -289,6 +389,26 @@ func (mr *MockMetadataRepositoryServiceClientMockRecorder) Seal(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).Seal), varargs...)
 }
 
+// SetRetentionPolicy mocks base method.
+func (m *MockMetadataRepositoryServiceClient) SetRetentionPolicy(arg0 context.Context, arg1 *mrpb.SetRetentionPolicyRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetRetentionPolicy", varargs...)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRetentionPolicy indicates an expected call of SetRetentionPolicy.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) SetRetentionPolicy(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetentionPolicy", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).SetRetentionPolicy), varargs...)
+}
+
 // UnregisterLogStream mocks base method.
 func (m *MockMetadataRepositoryServiceClient) UnregisterLogStream(arg0 context.Context, arg1 *mrpb.LogStreamRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
 	m.ctrl.T.Helper()
@@ -389,6 +509,26 @@ func (mr *MockMetadataRepositoryServiceClientMockRecorder) UpdateLogStream(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogStream", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).UpdateLogStream), varargs...)
 }
 
+// WatchMetadata mocks base method.
+func (m *MockMetadataRepositoryServiceClient) WatchMetadata(arg0 context.Context, arg1 *mrpb.WatchMetadataRequest, arg2 ...grpc.CallOption) (mrpb.MetadataRepositoryService_WatchMetadataClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchMetadata", varargs...)
+	ret0, _ := ret[0].(mrpb.MetadataRepositoryService_WatchMetadataClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchMetadata indicates an expected call of WatchMetadata.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) WatchMetadata(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchMetadata", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).WatchMetadata), varargs...)
+}
+
 // MockMetadataRepositoryServiceServer is a mock of MetadataRepositoryServiceServer interface.
 type MockMetadataRepositoryServiceServer struct {
 	ctrl     *gomock.Controller
@@ -412,6 +552,81 @@ func (m *MockMetadataRepositoryServiceServer) EXPECT() *MockMetadataRepositorySe
 	return m.recorder
 }
 
+// Backup mocks base method.
+func (m *MockMetadataRepositoryServiceServer) Backup(arg0 context.Context, arg1 *mrpb.BackupRequest) (*mrpb.BackupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup", arg0, arg1)
+	ret0, _ := ret[0].(*mrpb.BackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) Backup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).Backup), arg0, arg1)
+}
+
+// CommitOffset mocks base method.
+func (m *MockMetadataRepositoryServiceServer) CommitOffset(arg0 context.Context, arg1 *mrpb.CommitOffsetRequest) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitOffset", arg0, arg1)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitOffset indicates an expected call of CommitOffset.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) CommitOffset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitOffset", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).CommitOffset), arg0, arg1)
+}
+
+// DeleteConsumerGroup mocks base method.
+func (m *MockMetadataRepositoryServiceServer) DeleteConsumerGroup(arg0 context.Context, arg1 *mrpb.DeleteConsumerGroupRequest) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConsumerGroup indicates an expected call of DeleteConsumerGroup.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) DeleteConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConsumerGroup", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).DeleteConsumerGroup), arg0, arg1)
+}
+
+// FetchOffset mocks base method.
+func (m *MockMetadataRepositoryServiceServer) FetchOffset(arg0 context.Context, arg1 *mrpb.FetchOffsetRequest) (*mrpb.FetchOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchOffset", arg0, arg1)
+	ret0, _ := ret[0].(*mrpb.FetchOffsetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchOffset indicates an expected call of FetchOffset.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) FetchOffset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchOffset", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).FetchOffset), arg0, arg1)
+}
+
+// GetHighWatermark mocks base method.
+func (m *MockMetadataRepositoryServiceServer) GetHighWatermark(arg0 context.Context, arg1 *mrpb.GetHighWatermarkRequest) (*mrpb.GetHighWatermarkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHighWatermark", arg0, arg1)
+	ret0, _ := ret[0].(*mrpb.GetHighWatermarkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHighWatermark indicates an expected call of GetHighWatermark.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) GetHighWatermark(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHighWatermark", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).GetHighWatermark), arg0, arg1)
+}
+
 // GetMetadata mocks base method.
 func (m *MockMetadataRepositoryServiceServer) GetMetadata(arg0 context.Context, arg1 *mrpb.GetMetadataRequest) (*mrpb.GetMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -487,6 +702,21 @@ func (mr *MockMetadataRepositoryServiceServerMockRecorder) Seal(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).Seal), arg0, arg1)
 }
 
+// SetRetentionPolicy mocks base method.
+func (m *MockMetadataRepositoryServiceServer) SetRetentionPolicy(arg0 context.Context, arg1 *mrpb.SetRetentionPolicyRequest) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRetentionPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRetentionPolicy indicates an expected call of SetRetentionPolicy.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) SetRetentionPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetentionPolicy", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).SetRetentionPolicy), arg0, arg1)
+}
+
 // UnregisterLogStream mocks base method.
 func (m *MockMetadataRepositoryServiceServer) UnregisterLogStream(arg0 context.Context, arg1 *mrpb.LogStreamRequest) (*types.Empty, error) {
 	m.ctrl.T.Helper()
@@ -561,3 +791,17 @@ func (mr *MockMetadataRepositoryServiceServerMockRecorder) UpdateLogStream(arg0,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogStream", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).UpdateLogStream), arg0, arg1)
 }
+
+// WatchMetadata mocks base method.
+func (m *MockMetadataRepositoryServiceServer) WatchMetadata(arg0 *mrpb.WatchMetadataRequest, arg1 mrpb.MetadataRepositoryService_WatchMetadataServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchMetadata", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchMetadata indicates an expected call of WatchMetadata.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) WatchMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchMetadata", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).WatchMetadata), arg0, arg1)
+}
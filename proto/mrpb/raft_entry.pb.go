@@ -792,6 +792,248 @@ func (m *RecoverStateMachine) GetStateMachine() *MetadataRepositoryDescriptor {
 	return nil
 }
 
+type CommitOffset struct {
+	Group  string              `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Offset ConsumerGroupOffset `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset"`
+}
+
+func (m *CommitOffset) Reset()         { *m = CommitOffset{} }
+func (m *CommitOffset) String() string { return proto.CompactTextString(m) }
+func (*CommitOffset) ProtoMessage()    {}
+func (*CommitOffset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9661c8402dd472d1, []int{16}
+}
+func (m *CommitOffset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitOffset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitOffset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitOffset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitOffset.Merge(m, src)
+}
+func (m *CommitOffset) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CommitOffset) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitOffset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitOffset proto.InternalMessageInfo
+
+func (m *CommitOffset) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *CommitOffset) GetOffset() ConsumerGroupOffset {
+	if m != nil {
+		return m.Offset
+	}
+	return ConsumerGroupOffset{}
+}
+
+type SetRetentionPolicy struct {
+	TopicID         github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	RetentionPolicy *varlogpb.RetentionPolicy                 `protobuf:"bytes,2,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
+}
+
+func (m *SetRetentionPolicy) Reset()         { *m = SetRetentionPolicy{} }
+func (m *SetRetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*SetRetentionPolicy) ProtoMessage()    {}
+func (*SetRetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9661c8402dd472d1, []int{17}
+}
+func (m *SetRetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetRetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetRetentionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetRetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRetentionPolicy.Merge(m, src)
+}
+func (m *SetRetentionPolicy) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SetRetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRetentionPolicy proto.InternalMessageInfo
+
+func (m *SetRetentionPolicy) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *SetRetentionPolicy) GetRetentionPolicy() *varlogpb.RetentionPolicy {
+	if m != nil {
+		return m.RetentionPolicy
+	}
+	return nil
+}
+
+// Backup asks the node that proposed it to take a copy of the state machine
+// once the entry is applied. Since every entry applied before it is in the
+// copy, the copy is a consistent cut of the cluster metadata.
+type Backup struct {
+	NodeID   github_com_kakao_varlog_pkg_types.NodeID `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.NodeID" json:"node_id,omitempty"`
+	BackupID uint64                                   `protobuf:"varint,2,opt,name=backup_id,json=backupId,proto3" json:"backup_id,omitempty"`
+}
+
+func (m *Backup) Reset()         { *m = Backup{} }
+func (m *Backup) String() string { return proto.CompactTextString(m) }
+func (*Backup) ProtoMessage()    {}
+func (*Backup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9661c8402dd472d1, []int{18}
+}
+func (m *Backup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Backup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Backup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Backup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Backup.Merge(m, src)
+}
+func (m *Backup) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Backup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Backup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Backup proto.InternalMessageInfo
+
+func (m *Backup) GetNodeID() github_com_kakao_varlog_pkg_types.NodeID {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *Backup) GetBackupID() uint64 {
+	if m != nil {
+		return m.BackupID
+	}
+	return 0
+}
+
+type DeleteConsumerGroup struct {
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (m *DeleteConsumerGroup) Reset()         { *m = DeleteConsumerGroup{} }
+func (m *DeleteConsumerGroup) String() string { return proto.CompactTextString(m) }
+func (*DeleteConsumerGroup) ProtoMessage()    {}
+func (*DeleteConsumerGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9661c8402dd472d1, []int{19}
+}
+func (m *DeleteConsumerGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteConsumerGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteConsumerGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteConsumerGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteConsumerGroup.Merge(m, src)
+}
+func (m *DeleteConsumerGroup) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DeleteConsumerGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteConsumerGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteConsumerGroup proto.InternalMessageInfo
+
+func (m *DeleteConsumerGroup) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+// ReadBarrier does not change the state machine. A node proposing it serves
+// a read from its state machine once the entry is applied, so that the read
+// reflects every entry committed before the proposal.
+type ReadBarrier struct {
+}
+
+func (m *ReadBarrier) Reset()         { *m = ReadBarrier{} }
+func (m *ReadBarrier) String() string { return proto.CompactTextString(m) }
+func (*ReadBarrier) ProtoMessage()    {}
+func (*ReadBarrier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9661c8402dd472d1, []int{20}
+}
+func (m *ReadBarrier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadBarrier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadBarrier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadBarrier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadBarrier.Merge(m, src)
+}
+func (m *ReadBarrier) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ReadBarrier) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadBarrier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadBarrier proto.InternalMessageInfo
+
 type RaftEntry struct {
 	NodeIndex    uint64            `protobuf:"varint,1,opt,name=node_index,json=nodeIndex,proto3" json:"node_index,omitempty"`
 	RequestIndex uint64            `protobuf:"varint,2,opt,name=request_index,json=requestIndex,proto3" json:"request_index,omitempty"`
@@ -803,7 +1045,7 @@ func (m *RaftEntry) Reset()         { *m = RaftEntry{} }
 func (m *RaftEntry) String() string { return proto.CompactTextString(m) }
 func (*RaftEntry) ProtoMessage()    {}
 func (*RaftEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9661c8402dd472d1, []int{16}
+	return fileDescriptor_9661c8402dd472d1, []int{21}
 }
 func (m *RaftEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -876,13 +1118,18 @@ type RaftEntry_Request struct {
 	RecoverStateMachine   *RecoverStateMachine   `protobuf:"bytes,13,opt,name=recover_state_machine,json=recoverStateMachine,proto3" json:"recover_state_machine,omitempty"`
 	RegisterTopic         *RegisterTopic         `protobuf:"bytes,14,opt,name=register_topic,json=registerTopic,proto3" json:"register_topic,omitempty"`
 	UnregisterTopic       *UnregisterTopic       `protobuf:"bytes,15,opt,name=unregister_topic,json=unregisterTopic,proto3" json:"unregister_topic,omitempty"`
+	CommitOffset          *CommitOffset          `protobuf:"bytes,16,opt,name=commit_offset,json=commitOffset,proto3" json:"commit_offset,omitempty"`
+	SetRetentionPolicy    *SetRetentionPolicy    `protobuf:"bytes,17,opt,name=set_retention_policy,json=setRetentionPolicy,proto3" json:"set_retention_policy,omitempty"`
+	Backup                *Backup                `protobuf:"bytes,18,opt,name=backup,proto3" json:"backup,omitempty"`
+	DeleteConsumerGroup   *DeleteConsumerGroup   `protobuf:"bytes,19,opt,name=delete_consumer_group,json=deleteConsumerGroup,proto3" json:"delete_consumer_group,omitempty"`
+	ReadBarrier           *ReadBarrier           `protobuf:"bytes,20,opt,name=read_barrier,json=readBarrier,proto3" json:"read_barrier,omitempty"`
 }
 
 func (m *RaftEntry_Request) Reset()         { *m = RaftEntry_Request{} }
 func (m *RaftEntry_Request) String() string { return proto.CompactTextString(m) }
 func (*RaftEntry_Request) ProtoMessage()    {}
 func (*RaftEntry_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9661c8402dd472d1, []int{16, 0}
+	return fileDescriptor_9661c8402dd472d1, []int{21, 0}
 }
 func (m *RaftEntry_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1016,6 +1263,41 @@ func (m *RaftEntry_Request) GetUnregisterTopic() *UnregisterTopic {
 	return nil
 }
 
+func (m *RaftEntry_Request) GetCommitOffset() *CommitOffset {
+	if m != nil {
+		return m.CommitOffset
+	}
+	return nil
+}
+
+func (m *RaftEntry_Request) GetSetRetentionPolicy() *SetRetentionPolicy {
+	if m != nil {
+		return m.SetRetentionPolicy
+	}
+	return nil
+}
+
+func (m *RaftEntry_Request) GetBackup() *Backup {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+func (m *RaftEntry_Request) GetDeleteConsumerGroup() *DeleteConsumerGroup {
+	if m != nil {
+		return m.DeleteConsumerGroup
+	}
+	return nil
+}
+
+func (m *RaftEntry_Request) GetReadBarrier() *ReadBarrier {
+	if m != nil {
+		return m.ReadBarrier
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RegisterStorageNode)(nil), "varlog.mrpb.RegisterStorageNode")
 	proto.RegisterType((*UnregisterStorageNode)(nil), "varlog.mrpb.UnregisterStorageNode")
@@ -1033,6 +1315,11 @@ func init() {
 	proto.RegisterType((*RemovePeer)(nil), "varlog.mrpb.RemovePeer")
 	proto.RegisterType((*Endpoint)(nil), "varlog.mrpb.Endpoint")
 	proto.RegisterType((*RecoverStateMachine)(nil), "varlog.mrpb.RecoverStateMachine")
+	proto.RegisterType((*CommitOffset)(nil), "varlog.mrpb.CommitOffset")
+	proto.RegisterType((*SetRetentionPolicy)(nil), "varlog.mrpb.SetRetentionPolicy")
+	proto.RegisterType((*Backup)(nil), "varlog.mrpb.Backup")
+	proto.RegisterType((*DeleteConsumerGroup)(nil), "varlog.mrpb.DeleteConsumerGroup")
+	proto.RegisterType((*ReadBarrier)(nil), "varlog.mrpb.ReadBarrier")
 	proto.RegisterType((*RaftEntry)(nil), "varlog.mrpb.RaftEntry")
 	proto.RegisterType((*RaftEntry_Request)(nil), "varlog.mrpb.RaftEntry.Request")
 }
@@ -1040,76 +1327,92 @@ func init() {
 func init() { proto.RegisterFile("proto/mrpb/raft_entry.proto", fileDescriptor_9661c8402dd472d1) }
 
 var fileDescriptor_9661c8402dd472d1 = []byte{
-	// 1097 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0xdc, 0x44,
-	0x14, 0x8f, 0xbb, 0xdb, 0xfd, 0x78, 0x9b, 0xcd, 0x36, 0x0e, 0x51, 0xac, 0x00, 0xbb, 0x91, 0x0b,
-	0x28, 0x15, 0xc4, 0x16, 0x20, 0xa1, 0x0a, 0x21, 0x44, 0x43, 0xaa, 0x12, 0xa9, 0x4d, 0xd1, 0x24,
-	0xb9, 0x54, 0x80, 0xe5, 0x5d, 0x4f, 0x5c, 0x2b, 0x6b, 0x8f, 0x19, 0x8f, 0x23, 0x2a, 0xce, 0x9c,
-	0xb8, 0xf4, 0xc6, 0xb5, 0xe2, 0xaf, 0x89, 0xc4, 0xa5, 0xe2, 0xc4, 0x69, 0x41, 0x9b, 0xff, 0x82,
-	0x13, 0x9a, 0x0f, 0x7f, 0x65, 0x8d, 0x72, 0x21, 0x11, 0xb7, 0xd9, 0x99, 0xdf, 0xfb, 0xf8, 0x8d,
-	0xdf, 0xfc, 0xde, 0x5b, 0x78, 0x33, 0xa6, 0x84, 0x11, 0x3b, 0xa4, 0xf1, 0xd8, 0xa6, 0xee, 0x09,
-	0x73, 0x70, 0xc4, 0xe8, 0x0b, 0x4b, 0xec, 0xea, 0xbd, 0x33, 0x97, 0x4e, 0x89, 0x6f, 0xf1, 0xd3,
-	0xcd, 0x91, 0x4f, 0x88, 0x3f, 0xc5, 0xb6, 0x38, 0x1a, 0xa7, 0x27, 0x36, 0x0b, 0x42, 0x9c, 0x30,
-	0x37, 0x8c, 0x25, 0x7a, 0x73, 0xc7, 0x0f, 0xd8, 0xf3, 0x74, 0x6c, 0x4d, 0x48, 0x68, 0xfb, 0xc4,
-	0x27, 0x05, 0x92, 0xff, 0x92, 0x71, 0xf8, 0x4a, 0xc1, 0x37, 0xa4, 0xf3, 0x78, 0x6c, 0x87, 0x98,
-	0xb9, 0x9e, 0xcb, 0x5c, 0x75, 0x30, 0x4c, 0xa2, 0x78, 0x6c, 0x4f, 0x89, 0xef, 0x24, 0x8c, 0x62,
-	0x37, 0x74, 0x28, 0x8e, 0x09, 0x65, 0x98, 0xaa, 0xf3, 0xbb, 0x45, 0xb2, 0x99, 0xa5, 0x80, 0x24,
-	0x01, 0x23, 0x59, 0xea, 0xe6, 0x09, 0xac, 0x21, 0xec, 0x07, 0x09, 0xc3, 0xf4, 0x90, 0x11, 0xea,
-	0xfa, 0xf8, 0x80, 0x78, 0x58, 0x7f, 0x0a, 0xcb, 0x89, 0xfc, 0xe9, 0x44, 0xc4, 0xc3, 0x86, 0xb6,
-	0xa5, 0x6d, 0xf7, 0x3e, 0x7a, 0xcf, 0x52, 0x44, 0xb3, 0x94, 0xac, 0x92, 0xcd, 0x1e, 0x4e, 0x26,
-	0x34, 0x88, 0x19, 0xa1, 0xbb, 0xcd, 0xf3, 0xd9, 0x48, 0x43, 0xbd, 0xa4, 0x38, 0x34, 0x7f, 0xd2,
-	0x60, 0xfd, 0x38, 0xa2, 0x35, 0xa1, 0xa6, 0x30, 0x28, 0x87, 0x72, 0x02, 0x4f, 0x44, 0xbb, 0xbd,
-	0xbb, 0x37, 0x9f, 0x8d, 0xfa, 0x25, 0xe4, 0xfe, 0xde, 0xdf, 0xb3, 0x91, 0x5d, 0xba, 0xbc, 0x53,
-	0xf7, 0xd4, 0x25, 0xb6, 0xcc, 0xc5, 0x8e, 0x4f, 0x7d, 0x9b, 0xbd, 0x88, 0x71, 0x62, 0x55, 0x4c,
-	0x50, 0xbf, 0x94, 0xc5, 0xbe, 0x67, 0x7a, 0xd0, 0xcf, 0xf8, 0x1e, 0x91, 0x38, 0x98, 0xe8, 0x87,
-	0xd0, 0x61, 0x7c, 0x51, 0xc4, 0xbd, 0x3f, 0x9f, 0x8d, 0xda, 0xe2, 0x50, 0x44, 0xbc, 0x77, 0x75,
-	0x44, 0x05, 0x46, 0x6d, 0xe1, 0x69, 0xdf, 0x33, 0x4f, 0x60, 0x50, 0x90, 0xbd, 0xc6, 0x38, 0xdf,
-	0xc1, 0x6a, 0xc6, 0xe6, 0x31, 0xf1, 0x0f, 0x45, 0x19, 0xe8, 0xfb, 0x00, 0x45, 0x51, 0xa8, 0x2f,
-	0xf7, 0xce, 0xc2, 0x97, 0xcb, 0xf1, 0x0b, 0xdf, 0xad, 0x3b, 0xcd, 0x8e, 0xcc, 0x1f, 0x61, 0xad,
-	0xe0, 0x51, 0x44, 0xf0, 0xa0, 0x5f, 0x2a, 0xbb, 0x9c, 0xd0, 0x17, 0xf3, 0xd9, 0xa8, 0x97, 0xa3,
-	0x04, 0xa9, 0x9d, 0xab, 0x49, 0x95, 0x0c, 0x50, 0x2f, 0x0f, 0xbd, 0xef, 0x99, 0xdf, 0xc0, 0xe0,
-	0x38, 0xf6, 0x5c, 0x86, 0xaf, 0x85, 0xda, 0x6f, 0x1a, 0xb4, 0x90, 0x78, 0x30, 0x37, 0x5b, 0x81,
-	0xfa, 0x21, 0x0c, 0xd2, 0x68, 0x42, 0xc2, 0x30, 0x60, 0xea, 0xc5, 0x1a, 0x8d, 0xad, 0x46, 0x99,
-	0x08, 0x7f, 0xd7, 0x05, 0x89, 0x63, 0x05, 0x96, 0xc9, 0x0a, 0x22, 0x4b, 0x68, 0x25, 0xad, 0xec,
-	0x9a, 0xbf, 0x6b, 0xd0, 0x96, 0xcb, 0x44, 0x7f, 0x0a, 0xed, 0x32, 0x8d, 0xe6, 0xee, 0x27, 0xf3,
-	0xd9, 0xa8, 0x95, 0xe7, 0xbf, 0x7d, 0x75, 0xfe, 0x2a, 0xf1, 0x56, 0x24, 0x33, 0x7e, 0x04, 0xcb,
-	0x13, 0x8a, 0x5d, 0x86, 0x3d, 0x87, 0x6b, 0x99, 0x71, 0x4b, 0xdc, 0xfb, 0xa6, 0x25, 0x85, 0xce,
-	0xca, 0xe4, 0xcb, 0x3a, 0xca, 0x84, 0x6e, 0xb7, 0xc3, 0x93, 0x7c, 0xf9, 0x27, 0x17, 0x01, 0x65,
-	0xc9, 0xcf, 0xf4, 0x1d, 0x68, 0x4b, 0xc6, 0x89, 0xa2, 0xbc, 0x66, 0x95, 0x94, 0xd3, 0x92, 0x04,
-	0x50, 0x86, 0x31, 0x7f, 0xd5, 0xa0, 0xf5, 0xa5, 0x60, 0xf9, 0xff, 0xe5, 0x64, 0x4e, 0xa1, 0x79,
-	0x88, 0xdd, 0xe9, 0x0d, 0xbd, 0x89, 0x08, 0x5a, 0xc7, 0x51, 0x72, 0x73, 0xf1, 0x7e, 0xd6, 0xa0,
-	0xfd, 0xc0, 0xf3, 0xbe, 0xc6, 0x98, 0xfe, 0xf7, 0xdf, 0xe0, 0x0e, 0x34, 0x52, 0x3a, 0x15, 0x57,
-	0xdf, 0x45, 0x7c, 0xa9, 0xbf, 0x0d, 0x10, 0x24, 0xce, 0x14, 0xbb, 0x34, 0xc2, 0xd4, 0x68, 0x6c,
-	0x69, 0xdb, 0x1d, 0xd4, 0x0d, 0x92, 0xc7, 0x72, 0xc3, 0xfc, 0x16, 0x00, 0xe1, 0x90, 0x9c, 0xe1,
-	0x6b, 0xc9, 0xc7, 0x0c, 0xa1, 0xf3, 0x30, 0xf2, 0x62, 0x12, 0x44, 0xec, 0x06, 0xc8, 0x9a, 0x98,
-	0xb7, 0xde, 0x09, 0x39, 0xe3, 0xed, 0xd0, 0x65, 0xf8, 0x89, 0x3b, 0x79, 0x1e, 0x44, 0x58, 0x3f,
-	0x80, 0x7e, 0xc2, 0x7f, 0x3b, 0xa1, 0xdc, 0x50, 0x32, 0x77, 0xaf, 0xf2, 0x54, 0x9e, 0xa8, 0x86,
-	0x8e, 0xf2, 0x7e, 0x5e, 0x68, 0x1d, 0x5a, 0x4e, 0x4a, 0xfe, 0xcc, 0x5f, 0xba, 0xd0, 0x45, 0xee,
-	0x09, 0x7b, 0xc8, 0x07, 0x16, 0x7e, 0xc3, 0x92, 0x57, 0xe4, 0xe1, 0x1f, 0x24, 0x35, 0xd4, 0x15,
-	0x29, 0xf2, 0x0d, 0xfd, 0x2e, 0xf4, 0x29, 0xfe, 0x3e, 0xc5, 0x09, 0x53, 0x88, 0x5b, 0x02, 0xb1,
-	0xac, 0x36, 0x73, 0x90, 0x1b, 0xc7, 0xd3, 0x00, 0x7b, 0x0a, 0xd4, 0x90, 0x20, 0xb5, 0x29, 0x41,
-	0x9f, 0xf3, 0xb7, 0x2e, 0x8c, 0x8c, 0xa6, 0x20, 0x30, 0xac, 0xbe, 0xf5, 0x2c, 0x23, 0x0b, 0x49,
-	0x94, 0x12, 0xb6, 0xcc, 0x68, 0xf3, 0xaf, 0x36, 0x57, 0x34, 0xb1, 0xd6, 0x8f, 0x60, 0x3d, 0x6b,
-	0x42, 0x4e, 0xcd, 0x58, 0xb2, 0x75, 0x49, 0x45, 0x16, 0x66, 0x0c, 0xb4, 0x56, 0x37, 0x78, 0x3c,
-	0x83, 0x8d, 0x34, 0xaa, 0xf7, 0x2b, 0xd5, 0xc0, 0xac, 0xf8, 0xad, 0x9d, 0x5e, 0xd0, 0x7a, 0x5a,
-	0x3b, 0xd4, 0x1c, 0x40, 0x1e, 0xd2, 0x29, 0x75, 0xac, 0x46, 0xdd, 0x4d, 0x5c, 0x6e, 0xaf, 0x68,
-	0x75, 0xb1, 0xe3, 0x1e, 0x41, 0x29, 0x50, 0xd9, 0x63, 0xb3, 0xe6, 0x06, 0x6a, 0x5a, 0x36, 0x5a,
-	0x4b, 0x6b, 0xfa, 0xf8, 0x57, 0xb0, 0x9a, 0x8a, 0x0e, 0x5b, 0xf6, 0x78, 0x5b, 0x78, 0x7c, 0xab,
-	0xea, 0xb1, 0xda, 0x87, 0xd1, 0x20, 0xbd, 0xd4, 0x98, 0x3f, 0x80, 0x96, 0xea, 0x65, 0x2d, 0x61,
-	0xfe, 0x46, 0x8d, 0xb0, 0x27, 0x48, 0x61, 0xf4, 0xf7, 0xa1, 0x25, 0xbb, 0x97, 0xd1, 0x16, 0xe8,
-	0x6a, 0x1b, 0x90, 0x92, 0x8f, 0x14, 0x44, 0x7f, 0x17, 0x9a, 0x5c, 0xf0, 0x8c, 0x8e, 0x80, 0xae,
-	0x56, 0xa0, 0x5c, 0x79, 0x91, 0x38, 0xe6, 0x3e, 0x53, 0xa1, 0x8c, 0x46, 0xb7, 0xc6, 0xa7, 0x14,
-	0x4d, 0xa4, 0x20, 0xba, 0x0d, 0x1d, 0xd7, 0xf3, 0x9c, 0x18, 0x63, 0x6a, 0x40, 0x4d, 0xc2, 0x4a,
-	0xf2, 0x50, 0xdb, 0x55, 0xda, 0x77, 0x1f, 0x7a, 0x54, 0x28, 0x8f, 0xb4, 0xe9, 0x09, 0x9b, 0x8d,
-	0x4b, 0x24, 0x33, 0x65, 0x42, 0x40, 0x0b, 0x95, 0xfa, 0x10, 0x3a, 0x58, 0x89, 0x8a, 0xb1, 0x2c,
-	0xcc, 0xd6, 0x2b, 0x66, 0x99, 0xe2, 0xa0, 0x1c, 0x26, 0xcb, 0x5d, 0x08, 0x83, 0x53, 0x55, 0x82,
-	0x7e, 0x6d, 0xb9, 0x2f, 0x48, 0x08, 0x2f, 0xf7, 0x45, 0x5d, 0x79, 0x00, 0x2b, 0x79, 0x01, 0x89,
-	0xf9, 0xd1, 0x58, 0x51, 0x3d, 0xaf, 0xae, 0x1a, 0xc5, 0xa8, 0x89, 0xfa, 0xd5, 0x19, 0xf6, 0x11,
-	0xdc, 0x29, 0x55, 0xa1, 0x74, 0x32, 0xa8, 0x2b, 0x97, 0xea, 0xec, 0x8b, 0x06, 0x69, 0x75, 0xe3,
-	0xd3, 0xe6, 0xf9, 0xab, 0x91, 0xb6, 0xfb, 0xd9, 0xf9, 0x7c, 0xa8, 0xbd, 0x9e, 0x0f, 0xb5, 0x97,
-	0x17, 0xc3, 0xa5, 0x57, 0x17, 0x43, 0xed, 0xf5, 0xc5, 0x70, 0xe9, 0x8f, 0x8b, 0xe1, 0xd2, 0x33,
-	0xf3, 0x5f, 0xe5, 0x35, 0xff, 0x17, 0x36, 0x6e, 0x89, 0xf5, 0xc7, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xe2, 0x66, 0x54, 0x77, 0x9a, 0x0d, 0x00, 0x00,
+	// 1349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x8f, 0xdb, 0x44,
+	0x18, 0x5e, 0x77, 0xd3, 0x7c, 0xbc, 0x49, 0x9a, 0xdd, 0xc9, 0xae, 0x6a, 0x16, 0x48, 0x56, 0x2e,
+	0xa0, 0x56, 0xa5, 0x89, 0x00, 0x09, 0x55, 0x80, 0x2a, 0x9a, 0x6e, 0x55, 0x22, 0xf5, 0x73, 0xb2,
+	0x7b, 0xa9, 0x00, 0xcb, 0x89, 0x27, 0xa9, 0xb5, 0x89, 0xc7, 0x8c, 0xc7, 0x15, 0x15, 0x57, 0x38,
+	0x71, 0xe9, 0x4f, 0xa8, 0xf8, 0x0b, 0x5c, 0xf9, 0x01, 0x95, 0xb8, 0x54, 0x9c, 0x38, 0x05, 0x29,
+	0x7b, 0xe3, 0x27, 0x70, 0x42, 0xf3, 0x61, 0xc7, 0x4e, 0x8c, 0x7a, 0xe9, 0xae, 0xb8, 0x8d, 0xdf,
+	0x79, 0x3f, 0x67, 0xde, 0x79, 0x9e, 0x37, 0x81, 0xb7, 0x03, 0x46, 0x39, 0xed, 0xce, 0x58, 0x30,
+	0xec, 0x32, 0x67, 0xcc, 0x6d, 0xe2, 0x73, 0xf6, 0xac, 0x23, 0xa5, 0xa8, 0xfa, 0xd4, 0x61, 0x53,
+	0x3a, 0xe9, 0x88, 0xdd, 0xbd, 0xf6, 0x84, 0xd2, 0xc9, 0x94, 0x74, 0xe5, 0xd6, 0x30, 0x1a, 0x77,
+	0xb9, 0x37, 0x23, 0x21, 0x77, 0x66, 0x81, 0xd2, 0xde, 0xbb, 0x36, 0xf1, 0xf8, 0x93, 0x68, 0xd8,
+	0x19, 0xd1, 0x59, 0x77, 0x42, 0x27, 0x74, 0xa9, 0x29, 0xbe, 0x54, 0x1c, 0xb1, 0xd2, 0xea, 0x17,
+	0x95, 0xf3, 0x60, 0xd8, 0x9d, 0x11, 0xee, 0xb8, 0x0e, 0x77, 0xf4, 0x46, 0x2b, 0xf4, 0x83, 0x61,
+	0x77, 0x4a, 0x27, 0x76, 0xc8, 0x19, 0x71, 0x66, 0x36, 0x23, 0x01, 0x65, 0x9c, 0x30, 0xbd, 0x7f,
+	0x69, 0x99, 0x6c, 0x6c, 0x29, 0x55, 0x42, 0x8f, 0xd3, 0x38, 0x75, 0x6b, 0x0c, 0x4d, 0x4c, 0x26,
+	0x5e, 0xc8, 0x09, 0x1b, 0x70, 0xca, 0x9c, 0x09, 0xb9, 0x4f, 0x5d, 0x82, 0x1e, 0x40, 0x2d, 0x54,
+	0x9f, 0xb6, 0x4f, 0x5d, 0x62, 0x1a, 0xfb, 0xc6, 0xe5, 0xea, 0xc7, 0x1f, 0x74, 0x74, 0xa1, 0x71,
+	0x4a, 0x9d, 0x94, 0xcd, 0x01, 0x09, 0x47, 0xcc, 0x0b, 0x38, 0x65, 0xbd, 0xc2, 0xcb, 0x79, 0xdb,
+	0xc0, 0xd5, 0x70, 0xb9, 0x69, 0xfd, 0x64, 0xc0, 0xee, 0x91, 0xcf, 0x72, 0x42, 0x4d, 0xa1, 0x91,
+	0x0e, 0x65, 0x7b, 0xae, 0x8c, 0x76, 0xbe, 0x77, 0xb0, 0x98, 0xb7, 0xeb, 0x29, 0xcd, 0xfe, 0xc1,
+	0x3f, 0xf3, 0x76, 0x37, 0x75, 0x78, 0xc7, 0xce, 0xb1, 0x43, 0xbb, 0x2a, 0x97, 0x6e, 0x70, 0x3c,
+	0xe9, 0xf2, 0x67, 0x01, 0x09, 0x3b, 0x19, 0x13, 0x5c, 0x4f, 0x65, 0xd1, 0x77, 0x2d, 0x17, 0xea,
+	0x71, 0xbd, 0x87, 0x34, 0xf0, 0x46, 0x68, 0x00, 0x65, 0x2e, 0x16, 0xcb, 0xb8, 0xd7, 0x17, 0xf3,
+	0x76, 0x49, 0x6e, 0xca, 0x88, 0x57, 0x5e, 0x1f, 0x51, 0x2b, 0xe3, 0x92, 0xf4, 0xd4, 0x77, 0xad,
+	0x31, 0x34, 0x96, 0xc5, 0x9e, 0x62, 0x9c, 0x6f, 0x61, 0x3b, 0xae, 0xe6, 0x2e, 0x9d, 0x0c, 0x64,
+	0x1b, 0xa0, 0x3e, 0xc0, 0xb2, 0x29, 0xf4, 0xcd, 0xbd, 0xb7, 0x76, 0x73, 0x89, 0xfe, 0xda, 0xbd,
+	0x55, 0xa6, 0xf1, 0x96, 0xf5, 0x03, 0x34, 0x97, 0x75, 0x2c, 0x23, 0xb8, 0x50, 0x4f, 0xb5, 0x5d,
+	0x52, 0xd0, 0x97, 0x8b, 0x79, 0xbb, 0x9a, 0x68, 0xc9, 0xa2, 0xae, 0xbd, 0xbe, 0xa8, 0x94, 0x01,
+	0xae, 0x26, 0xa1, 0xfb, 0xae, 0xf5, 0x35, 0x34, 0x8e, 0x02, 0xd7, 0xe1, 0xe4, 0x54, 0x4a, 0xfb,
+	0xdd, 0x80, 0x22, 0x96, 0x0f, 0xe6, 0x6c, 0x3b, 0x10, 0x0d, 0xa0, 0x11, 0xf9, 0x23, 0x3a, 0x9b,
+	0x79, 0x5c, 0xbf, 0x58, 0x73, 0x73, 0x7f, 0x33, 0x5d, 0x48, 0xe8, 0xa7, 0x8b, 0x38, 0xd2, 0xca,
+	0x2a, 0x59, 0x59, 0xc8, 0x06, 0xbe, 0x10, 0x65, 0xa4, 0xd6, 0x1f, 0x06, 0x94, 0xd4, 0x32, 0x44,
+	0x0f, 0xa0, 0x94, 0x2e, 0xa3, 0xd0, 0xfb, 0x74, 0x31, 0x6f, 0x17, 0x93, 0xfc, 0x2f, 0xbf, 0x3e,
+	0x7f, 0x9d, 0x78, 0xd1, 0x57, 0x19, 0xdf, 0x81, 0xda, 0x88, 0x11, 0x87, 0x13, 0xd7, 0x16, 0x58,
+	0x66, 0x9e, 0x93, 0xe7, 0xbe, 0xd7, 0x51, 0x40, 0xd7, 0x89, 0xe1, 0xab, 0x73, 0x18, 0x03, 0x5d,
+	0xaf, 0x2c, 0x92, 0x7c, 0xfe, 0x97, 0x00, 0x01, 0x6d, 0x29, 0xf6, 0xd0, 0x35, 0x28, 0xa9, 0x8a,
+	0x43, 0x5d, 0x72, 0xb3, 0x93, 0x42, 0xce, 0x8e, 0x2a, 0x00, 0xc7, 0x3a, 0xd6, 0x2f, 0x06, 0x14,
+	0x6f, 0xc9, 0x2a, 0xff, 0xbf, 0x35, 0x59, 0x53, 0x28, 0x0c, 0x88, 0x33, 0x3d, 0xa3, 0x37, 0xe1,
+	0x43, 0xf1, 0xc8, 0x0f, 0xcf, 0x2e, 0xde, 0xcf, 0x06, 0x94, 0x6e, 0xba, 0xee, 0x43, 0x42, 0xd8,
+	0x9b, 0xbf, 0x83, 0x2d, 0xd8, 0x8c, 0xd8, 0x54, 0x1e, 0x7d, 0x05, 0x8b, 0x25, 0x7a, 0x17, 0xc0,
+	0x0b, 0xed, 0x29, 0x71, 0x98, 0x4f, 0x98, 0xb9, 0xb9, 0x6f, 0x5c, 0x2e, 0xe3, 0x8a, 0x17, 0xde,
+	0x55, 0x02, 0xeb, 0x1b, 0x00, 0x4c, 0x66, 0xf4, 0x29, 0x39, 0x95, 0x7c, 0xac, 0x19, 0x94, 0x6f,
+	0xfb, 0x6e, 0x40, 0x3d, 0x9f, 0x9f, 0x41, 0xb1, 0x16, 0x11, 0xd4, 0x3b, 0xa2, 0x4f, 0x05, 0x1d,
+	0x3a, 0x9c, 0xdc, 0x73, 0x46, 0x4f, 0x3c, 0x9f, 0xa0, 0xfb, 0x50, 0x0f, 0xc5, 0xb7, 0x3d, 0x53,
+	0x02, 0x0d, 0x73, 0x57, 0x32, 0x4f, 0xe5, 0x9e, 0x26, 0x74, 0x9c, 0xf0, 0xf9, 0x12, 0xeb, 0x70,
+	0x2d, 0x4c, 0xf9, 0xb3, 0x5c, 0xa8, 0xa9, 0x47, 0xf4, 0x60, 0x3c, 0x0e, 0x09, 0x47, 0x3b, 0x70,
+	0x7e, 0xc2, 0x68, 0x14, 0x48, 0xbf, 0x15, 0xac, 0x3e, 0xd0, 0x0d, 0x28, 0x52, 0xb9, 0xaf, 0x5f,
+	0xc2, 0x7e, 0x26, 0xdc, 0x2d, 0xea, 0x87, 0xd1, 0x8c, 0xb0, 0x3b, 0x42, 0x57, 0xf9, 0xd1, 0x40,
+	0xa4, 0xad, 0xac, 0xdf, 0x0c, 0x40, 0x03, 0xc2, 0x31, 0xe1, 0xc4, 0xe7, 0x1e, 0xf5, 0x1f, 0xd2,
+	0xa9, 0x37, 0x7a, 0x76, 0x2a, 0xac, 0x87, 0x1e, 0xc1, 0x16, 0x8b, 0xe3, 0xd8, 0x81, 0x0c, 0xb4,
+	0x9a, 0x75, 0xc2, 0x05, 0x2b, 0x09, 0x69, 0x1e, 0x68, 0xb0, 0xac, 0xd8, 0xfa, 0xd1, 0x80, 0x62,
+	0xcf, 0x19, 0x1d, 0x47, 0xc1, 0x9b, 0xbf, 0xf9, 0x2b, 0x50, 0x19, 0x4a, 0xd7, 0xc2, 0xe5, 0x39,
+	0xe9, 0xb2, 0xb6, 0x98, 0xb7, 0xcb, 0x2a, 0x5e, 0xff, 0x00, 0x97, 0xd5, 0x76, 0xdf, 0xb5, 0xae,
+	0x42, 0xf3, 0x80, 0x4c, 0x09, 0x27, 0x99, 0x03, 0xcf, 0xbf, 0x32, 0xab, 0x0e, 0x55, 0x4c, 0x1c,
+	0xb7, 0xe7, 0x30, 0xe6, 0x11, 0x66, 0xfd, 0x5d, 0x85, 0x0a, 0x76, 0xc6, 0xfc, 0xb6, 0x18, 0x4c,
+	0xc5, 0x4b, 0x52, 0x55, 0xf8, 0x2e, 0xf9, 0x5e, 0x15, 0x82, 0x2b, 0x32, 0x21, 0x21, 0x40, 0x97,
+	0xa0, 0xce, 0xc8, 0x77, 0x11, 0x09, 0xb9, 0xd6, 0x90, 0x79, 0xe1, 0x9a, 0x16, 0x26, 0x4a, 0x4e,
+	0x10, 0x4c, 0x3d, 0xe2, 0x6a, 0xa5, 0x4d, 0xa5, 0xa4, 0x85, 0x4a, 0xe9, 0x06, 0x94, 0xb4, 0x91,
+	0x59, 0x90, 0x77, 0xd0, 0xca, 0x62, 0x7a, 0x9c, 0x51, 0x07, 0x2b, 0x2d, 0xdd, 0x37, 0xb1, 0xd1,
+	0xde, 0xaf, 0x20, 0x98, 0x4b, 0xae, 0xd1, 0x21, 0xec, 0xc6, 0xc3, 0x86, 0x9d, 0x33, 0x7e, 0x66,
+	0x7b, 0x32, 0x67, 0x6c, 0xc5, 0xcd, 0xbc, 0x01, 0xf3, 0x31, 0x5c, 0x8c, 0xfc, 0x7c, 0xbf, 0xaa,
+	0x6b, 0xac, 0x8c, 0xdf, 0xdc, 0x29, 0x15, 0xef, 0x46, 0x79, 0x62, 0x74, 0x1f, 0x92, 0x90, 0x76,
+	0x6a, 0x32, 0xd9, 0xcc, 0x3b, 0x89, 0xd5, 0x31, 0x0a, 0x6f, 0xaf, 0x4f, 0x56, 0x87, 0x90, 0x0a,
+	0x94, 0xf6, 0x58, 0xc8, 0x39, 0x81, 0x9c, 0xd1, 0x0c, 0x37, 0xa3, 0x75, 0x21, 0xfa, 0x0a, 0xb6,
+	0x23, 0x39, 0x49, 0xa5, 0x3d, 0x9e, 0x97, 0x1e, 0xdf, 0xc9, 0x7a, 0xcc, 0xce, 0x5b, 0xb8, 0x11,
+	0x65, 0x05, 0xe8, 0x43, 0x28, 0xea, 0x99, 0xa5, 0x28, 0xcd, 0x77, 0x72, 0x08, 0x3c, 0xc4, 0x5a,
+	0x07, 0x5d, 0x85, 0xa2, 0x9a, 0x52, 0xcc, 0xd2, 0xbe, 0xb1, 0x46, 0xf7, 0x0a, 0x95, 0xb0, 0x56,
+	0x41, 0xef, 0x43, 0x41, 0x10, 0x9b, 0x59, 0x96, 0xaa, 0xdb, 0x19, 0x55, 0xc1, 0xb0, 0x58, 0x6e,
+	0x0b, 0x9f, 0x91, 0x64, 0x40, 0xb3, 0x92, 0xe3, 0x53, 0x91, 0x23, 0xd6, 0x2a, 0xa8, 0x0b, 0x65,
+	0xc7, 0x75, 0xed, 0x80, 0x10, 0x66, 0x42, 0x4e, 0xc2, 0x9a, 0xda, 0x70, 0xc9, 0x51, 0x0b, 0x74,
+	0x1d, 0xaa, 0x4c, 0x32, 0x8c, 0xb2, 0xa9, 0x4a, 0x9b, 0x8b, 0x2b, 0x45, 0xc6, 0x0c, 0x84, 0x81,
+	0x25, 0x6b, 0xf4, 0x11, 0x94, 0x89, 0x26, 0x0f, 0xb3, 0x26, 0xcd, 0x76, 0x33, 0x66, 0x31, 0xb3,
+	0xe0, 0x44, 0x4d, 0xb5, 0xbb, 0x24, 0x00, 0x3b, 0x8b, 0xf8, 0xf5, 0xdc, 0x76, 0x5f, 0xa3, 0x0a,
+	0xd1, 0xee, 0x6b, 0x42, 0x74, 0x13, 0x2e, 0x24, 0x0d, 0x24, 0x11, 0xd3, 0xbc, 0xa0, 0x67, 0x9b,
+	0xbc, 0x6e, 0x94, 0xe0, 0x8a, 0xeb, 0xd9, 0xdf, 0x2a, 0x77, 0x60, 0x2b, 0xf2, 0x57, 0x9c, 0x34,
+	0xf2, 0xda, 0x25, 0xfb, 0x1b, 0x07, 0x37, 0xa2, 0xac, 0x00, 0xdd, 0x80, 0xba, 0x9e, 0x74, 0x35,
+	0xb9, 0x6c, 0x49, 0x2f, 0x6f, 0xe5, 0xf4, 0x81, 0x62, 0x15, 0x5c, 0x1b, 0xa5, 0xbe, 0xd0, 0x23,
+	0xd8, 0x09, 0x09, 0xb7, 0x13, 0xb4, 0x8e, 0xd1, 0x7e, 0x5b, 0xba, 0x69, 0xaf, 0xf4, 0xc8, 0x2a,
+	0xfb, 0x60, 0x14, 0xae, 0xc9, 0x44, 0xff, 0x28, 0xb8, 0x35, 0x51, 0x4e, 0xff, 0x28, 0x4c, 0xc6,
+	0x5a, 0x45, 0xdc, 0x90, 0x2b, 0xf1, 0xd8, 0x1e, 0x69, 0x40, 0xb6, 0x15, 0x10, 0x37, 0x73, 0x6e,
+	0x28, 0x07, 0xb9, 0x71, 0xd3, 0x5d, 0x17, 0xa2, 0xcf, 0xa1, 0xc6, 0x88, 0xe3, 0xda, 0x43, 0x85,
+	0xdc, 0xe6, 0x8e, 0x74, 0x66, 0xae, 0xdc, 0x4f, 0x82, 0xec, 0xb8, 0xca, 0x96, 0x1f, 0x9f, 0x15,
+	0x5e, 0xbe, 0x68, 0x1b, 0xbd, 0x2f, 0x5e, 0x2e, 0x5a, 0xc6, 0xab, 0x45, 0xcb, 0x78, 0x7e, 0xd2,
+	0xda, 0x78, 0x71, 0xd2, 0x32, 0x5e, 0x9d, 0xb4, 0x36, 0xfe, 0x3c, 0x69, 0x6d, 0x3c, 0xb6, 0xfe,
+	0x93, 0x9f, 0x92, 0x3f, 0x30, 0x86, 0x45, 0xb9, 0xfe, 0xe4, 0xdf, 0x01, 0x00, 0x1e, 0xf1, 0xb1,
+	0xf8, 0xd5, 0x10, 0x00, 0x00,
 }
 
 func (m *RegisterStorageNode) Marshal() (dAtA []byte, err error) {
@@ -1656,7 +1959,7 @@ func (m *RecoverStateMachine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RaftEntry) Marshal() (dAtA []byte, err error) {
+func (m *CommitOffset) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1666,18 +1969,18 @@ func (m *RaftEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitOffset) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RaftEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitOffset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Offset.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1685,26 +1988,18 @@ func (m *RaftEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintRaftEntry(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if m.AppliedIndex != 0 {
-		i = encodeVarintRaftEntry(dAtA, i, uint64(m.AppliedIndex))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.RequestIndex != 0 {
-		i = encodeVarintRaftEntry(dAtA, i, uint64(m.RequestIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.NodeIndex != 0 {
-		i = encodeVarintRaftEntry(dAtA, i, uint64(m.NodeIndex))
+	dAtA[i] = 0x12
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintRaftEntry(dAtA, i, uint64(len(m.Group)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RaftEntry_Request) Marshal() (dAtA []byte, err error) {
+func (m *SetRetentionPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1714,19 +2009,19 @@ func (m *RaftEntry_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftEntry_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetRetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RaftEntry_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetRetentionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UnregisterTopic != nil {
+	if m.RetentionPolicy != nil {
 		{
-			size, err := m.UnregisterTopic.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1734,48 +2029,292 @@ func (m *RaftEntry_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x7a
+		dAtA[i] = 0x12
 	}
-	if m.RegisterTopic != nil {
-		{
-			size, err := m.RegisterTopic.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
-		}
+	if m.TopicID != 0 {
+		i = encodeVarintRaftEntry(dAtA, i, uint64(m.TopicID))
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x8
 	}
-	if m.RecoverStateMachine != nil {
-		{
-			size, err := m.RecoverStateMachine.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *Backup) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Backup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Backup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BackupID != 0 {
+		i = encodeVarintRaftEntry(dAtA, i, uint64(m.BackupID))
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x10
 	}
-	if m.Endpoint != nil {
-		{
-			size, err := m.Endpoint.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
-		}
+	if m.NodeID != 0 {
+		i = encodeVarintRaftEntry(dAtA, i, uint64(m.NodeID))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x8
 	}
-	if m.RemovePeer != nil {
-		{
-			size, err := m.RemovePeer.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteConsumerGroup) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteConsumerGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteConsumerGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintRaftEntry(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadBarrier) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadBarrier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadBarrier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RaftEntry) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AppliedIndex != 0 {
+		i = encodeVarintRaftEntry(dAtA, i, uint64(m.AppliedIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RequestIndex != 0 {
+		i = encodeVarintRaftEntry(dAtA, i, uint64(m.RequestIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NodeIndex != 0 {
+		i = encodeVarintRaftEntry(dAtA, i, uint64(m.NodeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RaftEntry_Request) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftEntry_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftEntry_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReadBarrier != nil {
+		{
+			size, err := m.ReadBarrier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.DeleteConsumerGroup != nil {
+		{
+			size, err := m.DeleteConsumerGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Backup != nil {
+		{
+			size, err := m.Backup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.SetRetentionPolicy != nil {
+		{
+			size, err := m.SetRetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.CommitOffset != nil {
+		{
+			size, err := m.CommitOffset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.UnregisterTopic != nil {
+		{
+			size, err := m.UnregisterTopic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.RegisterTopic != nil {
+		{
+			size, err := m.RegisterTopic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.RecoverStateMachine != nil {
+		{
+			size, err := m.RecoverStateMachine.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Endpoint != nil {
+		{
+			size, err := m.Endpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.RemovePeer != nil {
+		{
+			size, err := m.RemovePeer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
 			i -= size
@@ -2141,54 +2680,122 @@ func (m *RecoverStateMachine) ProtoSize() (n int) {
 	return n
 }
 
-func (m *RaftEntry) ProtoSize() (n int) {
+func (m *CommitOffset) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.NodeIndex != 0 {
-		n += 1 + sovRaftEntry(uint64(m.NodeIndex))
-	}
-	if m.RequestIndex != 0 {
-		n += 1 + sovRaftEntry(uint64(m.RequestIndex))
-	}
-	if m.AppliedIndex != 0 {
-		n += 1 + sovRaftEntry(uint64(m.AppliedIndex))
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovRaftEntry(uint64(l))
 	}
-	l = m.Request.ProtoSize()
+	l = m.Offset.ProtoSize()
 	n += 1 + l + sovRaftEntry(uint64(l))
 	return n
 }
 
-func (m *RaftEntry_Request) ProtoSize() (n int) {
+func (m *SetRetentionPolicy) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.RegisterStorageNode != nil {
-		l = m.RegisterStorageNode.ProtoSize()
-		n += 1 + l + sovRaftEntry(uint64(l))
+	if m.TopicID != 0 {
+		n += 1 + sovRaftEntry(uint64(m.TopicID))
 	}
-	if m.UnregisterStorageNode != nil {
-		l = m.UnregisterStorageNode.ProtoSize()
+	if m.RetentionPolicy != nil {
+		l = m.RetentionPolicy.ProtoSize()
 		n += 1 + l + sovRaftEntry(uint64(l))
 	}
-	if m.RegisterLogStream != nil {
-		l = m.RegisterLogStream.ProtoSize()
-		n += 1 + l + sovRaftEntry(uint64(l))
+	return n
+}
+
+func (m *Backup) ProtoSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.UnregisterLogStream != nil {
-		l = m.UnregisterLogStream.ProtoSize()
-		n += 1 + l + sovRaftEntry(uint64(l))
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovRaftEntry(uint64(m.NodeID))
 	}
-	if m.UpdateLogStream != nil {
-		l = m.UpdateLogStream.ProtoSize()
-		n += 1 + l + sovRaftEntry(uint64(l))
+	if m.BackupID != 0 {
+		n += 1 + sovRaftEntry(uint64(m.BackupID))
 	}
-	if m.Report != nil {
-		l = m.Report.ProtoSize()
+	return n
+}
+
+func (m *DeleteConsumerGroup) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovRaftEntry(uint64(l))
+	}
+	return n
+}
+
+func (m *ReadBarrier) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RaftEntry) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeIndex != 0 {
+		n += 1 + sovRaftEntry(uint64(m.NodeIndex))
+	}
+	if m.RequestIndex != 0 {
+		n += 1 + sovRaftEntry(uint64(m.RequestIndex))
+	}
+	if m.AppliedIndex != 0 {
+		n += 1 + sovRaftEntry(uint64(m.AppliedIndex))
+	}
+	l = m.Request.ProtoSize()
+	n += 1 + l + sovRaftEntry(uint64(l))
+	return n
+}
+
+func (m *RaftEntry_Request) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RegisterStorageNode != nil {
+		l = m.RegisterStorageNode.ProtoSize()
+		n += 1 + l + sovRaftEntry(uint64(l))
+	}
+	if m.UnregisterStorageNode != nil {
+		l = m.UnregisterStorageNode.ProtoSize()
+		n += 1 + l + sovRaftEntry(uint64(l))
+	}
+	if m.RegisterLogStream != nil {
+		l = m.RegisterLogStream.ProtoSize()
+		n += 1 + l + sovRaftEntry(uint64(l))
+	}
+	if m.UnregisterLogStream != nil {
+		l = m.UnregisterLogStream.ProtoSize()
+		n += 1 + l + sovRaftEntry(uint64(l))
+	}
+	if m.UpdateLogStream != nil {
+		l = m.UpdateLogStream.ProtoSize()
+		n += 1 + l + sovRaftEntry(uint64(l))
+	}
+	if m.Report != nil {
+		l = m.Report.ProtoSize()
 		n += 1 + l + sovRaftEntry(uint64(l))
 	}
 	if m.Commit != nil {
@@ -2227,6 +2834,26 @@ func (m *RaftEntry_Request) ProtoSize() (n int) {
 		l = m.UnregisterTopic.ProtoSize()
 		n += 1 + l + sovRaftEntry(uint64(l))
 	}
+	if m.CommitOffset != nil {
+		l = m.CommitOffset.ProtoSize()
+		n += 2 + l + sovRaftEntry(uint64(l))
+	}
+	if m.SetRetentionPolicy != nil {
+		l = m.SetRetentionPolicy.ProtoSize()
+		n += 2 + l + sovRaftEntry(uint64(l))
+	}
+	if m.Backup != nil {
+		l = m.Backup.ProtoSize()
+		n += 2 + l + sovRaftEntry(uint64(l))
+	}
+	if m.DeleteConsumerGroup != nil {
+		l = m.DeleteConsumerGroup.ProtoSize()
+		n += 2 + l + sovRaftEntry(uint64(l))
+	}
+	if m.ReadBarrier != nil {
+		l = m.ReadBarrier.ProtoSize()
+		n += 2 + l + sovRaftEntry(uint64(l))
+	}
 	return n
 }
 
@@ -2282,6 +2909,21 @@ func (this *RaftEntry_Request) GetValue() interface{} {
 	if this.UnregisterTopic != nil {
 		return this.UnregisterTopic
 	}
+	if this.CommitOffset != nil {
+		return this.CommitOffset
+	}
+	if this.SetRetentionPolicy != nil {
+		return this.SetRetentionPolicy
+	}
+	if this.Backup != nil {
+		return this.Backup
+	}
+	if this.DeleteConsumerGroup != nil {
+		return this.DeleteConsumerGroup
+	}
+	if this.ReadBarrier != nil {
+		return this.ReadBarrier
+	}
 	return nil
 }
 
@@ -2317,6 +2959,16 @@ func (this *RaftEntry_Request) SetValue(value interface{}) bool {
 		this.RegisterTopic = vt
 	case *UnregisterTopic:
 		this.UnregisterTopic = vt
+	case *CommitOffset:
+		this.CommitOffset = vt
+	case *SetRetentionPolicy:
+		this.SetRetentionPolicy = vt
+	case *Backup:
+		this.Backup = vt
+	case *DeleteConsumerGroup:
+		this.DeleteConsumerGroup = vt
+	case *ReadBarrier:
+		this.ReadBarrier = vt
 	default:
 		return false
 	}
@@ -2937,7 +3589,383 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 			if err := m.UncommitReport[len(m.UncommitReport)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftEntry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Reports) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftEntry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reports: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reports: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reports = append(m.Reports, &Report{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftEntry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftEntry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftEntry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Seal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftEntry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Seal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Seal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftEntry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Unseal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftEntry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Unseal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Unseal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftEntry(dAtA[iNdEx:])
@@ -2959,7 +3987,7 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Reports) Unmarshal(dAtA []byte) error {
+func (m *AddPeer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2982,10 +4010,10 @@ func (m *Reports) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Reports: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddPeer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Reports: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddPeer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3009,9 +4037,9 @@ func (m *Reports) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftEntry
@@ -3021,30 +4049,29 @@ func (m *Reports) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRaftEntry
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRaftEntry
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftEntry
@@ -3054,26 +4081,81 @@ func (m *Reports) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftEntry
+			m.IsLearner = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftEntry(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRaftEntry
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reports = append(m.Reports, &Report{})
-			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemovePeer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftEntry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemovePeer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemovePeer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftEntry(dAtA[iNdEx:])
@@ -3095,7 +4177,7 @@ func (m *Reports) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commit) Unmarshal(dAtA []byte) error {
+func (m *Endpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3118,10 +4200,10 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+			return fmt.Errorf("proto: Endpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Endpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3145,9 +4227,9 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftEntry
@@ -3157,24 +4239,23 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRaftEntry
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRaftEntry
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3197,7 +4278,7 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Seal) Unmarshal(dAtA []byte) error {
+func (m *RecoverStateMachine) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3220,17 +4301,17 @@ func (m *Seal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Seal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecoverStateMachine: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Seal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecoverStateMachine: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateMachine", wireType)
 			}
-			m.LogStreamID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftEntry
@@ -3240,11 +4321,28 @@ func (m *Seal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StateMachine == nil {
+				m.StateMachine = &MetadataRepositoryDescriptor{}
+			}
+			if err := m.StateMachine.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftEntry(dAtA[iNdEx:])
@@ -3266,7 +4364,7 @@ func (m *Seal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Unseal) Unmarshal(dAtA []byte) error {
+func (m *CommitOffset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3289,17 +4387,17 @@ func (m *Unseal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Unseal: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitOffset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Unseal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitOffset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			m.LogStreamID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftEntry
@@ -3309,11 +4407,57 @@ func (m *Unseal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftEntry(dAtA[iNdEx:])
@@ -3335,7 +4479,7 @@ func (m *Unseal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddPeer) Unmarshal(dAtA []byte) error {
+func (m *SetRetentionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3358,17 +4502,17 @@ func (m *AddPeer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddPeer: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetRetentionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddPeer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetRetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			m.NodeID = 0
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftEntry
@@ -3378,48 +4522,16 @@ func (m *AddPeer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftEntry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRaftEntry
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftEntry
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPolicy", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftEntry
@@ -3429,12 +4541,28 @@ func (m *AddPeer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsLearner = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetentionPolicy == nil {
+				m.RetentionPolicy = &varlogpb.RetentionPolicy{}
+			}
+			if err := m.RetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftEntry(dAtA[iNdEx:])
@@ -3456,7 +4584,7 @@ func (m *AddPeer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemovePeer) Unmarshal(dAtA []byte) error {
+func (m *Backup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3479,10 +4607,10 @@ func (m *RemovePeer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemovePeer: wiretype end group for non-group")
+			return fmt.Errorf("proto: Backup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemovePeer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Backup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3504,6 +4632,25 @@ func (m *RemovePeer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupID", wireType)
+			}
+			m.BackupID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackupID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftEntry(dAtA[iNdEx:])
@@ -3525,7 +4672,7 @@ func (m *RemovePeer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Endpoint) Unmarshal(dAtA []byte) error {
+func (m *DeleteConsumerGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3548,34 +4695,15 @@ func (m *Endpoint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Endpoint: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteConsumerGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Endpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteConsumerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
-			}
-			m.NodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftEntry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3603,7 +4731,7 @@ func (m *Endpoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3626,7 +4754,7 @@ func (m *Endpoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecoverStateMachine) Unmarshal(dAtA []byte) error {
+func (m *ReadBarrier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3649,48 +4777,12 @@ func (m *RecoverStateMachine) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecoverStateMachine: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadBarrier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecoverStateMachine: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadBarrier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateMachine", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftEntry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftEntry
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftEntry
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StateMachine == nil {
-				m.StateMachine = &MetadataRepositoryDescriptor{}
-			}
-			if err := m.StateMachine.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftEntry(dAtA[iNdEx:])
@@ -4421,6 +5513,186 @@ func (m *RaftEntry_Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitOffset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitOffset == nil {
+				m.CommitOffset = &CommitOffset{}
+			}
+			if err := m.CommitOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetRetentionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetRetentionPolicy == nil {
+				m.SetRetentionPolicy = &SetRetentionPolicy{}
+			}
+			if err := m.SetRetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backup == nil {
+				m.Backup = &Backup{}
+			}
+			if err := m.Backup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteConsumerGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteConsumerGroup == nil {
+				m.DeleteConsumerGroup = &DeleteConsumerGroup{}
+			}
+			if err := m.DeleteConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBarrier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadBarrier == nil {
+				m.ReadBarrier = &ReadBarrier{}
+			}
+			if err := m.ReadBarrier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftEntry(dAtA[iNdEx:])
@@ -184,18 +184,149 @@ func (m *LogStreamUncommitReports) GetStatus() varlogpb.LogStreamStatus {
 	return varlogpb.LogStreamStatusRunning
 }
 
+// ConsumerGroupOffset is the position committed by a consumer group. If the
+// log_stream_id is zero, it is the position in the topic, that is, the glsn is
+// the GLSN of the last log entry consumed by the group. Otherwise, it is the
+// position in the log stream, that is, the llsn is the LLSN of the last log
+// entry consumed by the group.
+type ConsumerGroupOffset struct {
+	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	GLSN        github_com_kakao_varlog_pkg_types.GLSN        `protobuf:"varint,3,opt,name=glsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn,omitempty"`
+	LLSN        github_com_kakao_varlog_pkg_types.LLSN        `protobuf:"varint,4,opt,name=llsn,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn,omitempty"`
+}
+
+func (m *ConsumerGroupOffset) Reset()         { *m = ConsumerGroupOffset{} }
+func (m *ConsumerGroupOffset) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupOffset) ProtoMessage()    {}
+func (*ConsumerGroupOffset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60447af781d89487, []int{3}
+}
+func (m *ConsumerGroupOffset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroupOffset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupOffset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerGroupOffset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupOffset.Merge(m, src)
+}
+func (m *ConsumerGroupOffset) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ConsumerGroupOffset) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupOffset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerGroupOffset proto.InternalMessageInfo
+
+func (m *ConsumerGroupOffset) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *ConsumerGroupOffset) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
+}
+
+func (m *ConsumerGroupOffset) GetGLSN() github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.GLSN
+	}
+	return 0
+}
+
+func (m *ConsumerGroupOffset) GetLLSN() github_com_kakao_varlog_pkg_types.LLSN {
+	if m != nil {
+		return m.LLSN
+	}
+	return 0
+}
+
+// ConsumerGroupDescriptor has offsets committed by a consumer group. A
+// descriptor whose deleted is set marks the group deleted until it is merged
+// into the state machine.
+type ConsumerGroupDescriptor struct {
+	Offsets []ConsumerGroupOffset `protobuf:"bytes,1,rep,name=offsets,proto3" json:"offsets"`
+	Deleted bool                  `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (m *ConsumerGroupDescriptor) Reset()         { *m = ConsumerGroupDescriptor{} }
+func (m *ConsumerGroupDescriptor) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupDescriptor) ProtoMessage()    {}
+func (*ConsumerGroupDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60447af781d89487, []int{4}
+}
+func (m *ConsumerGroupDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroupDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerGroupDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupDescriptor.Merge(m, src)
+}
+func (m *ConsumerGroupDescriptor) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ConsumerGroupDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerGroupDescriptor proto.InternalMessageInfo
+
+func (m *ConsumerGroupDescriptor) GetOffsets() []ConsumerGroupOffset {
+	if m != nil {
+		return m.Offsets
+	}
+	return nil
+}
+
+func (m *ConsumerGroupDescriptor) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type MetadataRepositoryDescriptor struct {
 	Metadata  *varlogpb.MetadataDescriptor                        `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	LogStream *MetadataRepositoryDescriptor_LogStreamDescriptor   `protobuf:"bytes,2,opt,name=log_stream,json=logStream,proto3" json:"log_stream,omitempty"`
 	PeersMap  MetadataRepositoryDescriptor_PeerDescriptorMap      `protobuf:"bytes,3,opt,name=peers_map,json=peersMap,proto3" json:"peers_map"`
 	Endpoints map[github_com_kakao_varlog_pkg_types.NodeID]string `protobuf:"bytes,4,rep,name=endpoints,proto3,castkey=github.com/kakao/varlog/pkg/types.NodeID" json:"endpoints,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// consumer_groups are offsets committed by consumer groups keyed by their
+	// names.
+	ConsumerGroups map[string]*ConsumerGroupDescriptor `protobuf:"bytes,5,rep,name=consumer_groups,json=consumerGroups,proto3" json:"consumer_groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *MetadataRepositoryDescriptor) Reset()         { *m = MetadataRepositoryDescriptor{} }
 func (m *MetadataRepositoryDescriptor) String() string { return proto.CompactTextString(m) }
 func (*MetadataRepositoryDescriptor) ProtoMessage()    {}
 func (*MetadataRepositoryDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60447af781d89487, []int{3}
+	return fileDescriptor_60447af781d89487, []int{5}
 }
 func (m *MetadataRepositoryDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,6 +383,13 @@ func (m *MetadataRepositoryDescriptor) GetEndpoints() map[github_com_kakao_varlo
 	return nil
 }
 
+func (m *MetadataRepositoryDescriptor) GetConsumerGroups() map[string]*ConsumerGroupDescriptor {
+	if m != nil {
+		return m.ConsumerGroups
+	}
+	return nil
+}
+
 type MetadataRepositoryDescriptor_LogStreamDescriptor struct {
 	TrimVersion     github_com_kakao_varlog_pkg_types.Version                                   `protobuf:"varint,1,opt,name=trim_version,json=trimVersion,proto3,casttype=github.com/kakao/varlog/pkg/types.Version" json:"trim_version,omitempty"`
 	CommitHistory   []*LogStreamCommitResults                                                   `protobuf:"bytes,2,rep,name=commit_history,json=commitHistory,proto3" json:"commit_history,omitempty"`
@@ -266,7 +404,7 @@ func (m *MetadataRepositoryDescriptor_LogStreamDescriptor) String() string {
 }
 func (*MetadataRepositoryDescriptor_LogStreamDescriptor) ProtoMessage() {}
 func (*MetadataRepositoryDescriptor_LogStreamDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60447af781d89487, []int{3, 0}
+	return fileDescriptor_60447af781d89487, []int{5, 0}
 }
 func (m *MetadataRepositoryDescriptor_LogStreamDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,7 +467,7 @@ func (m *MetadataRepositoryDescriptor_PeerDescriptor) String() string {
 }
 func (*MetadataRepositoryDescriptor_PeerDescriptor) ProtoMessage() {}
 func (*MetadataRepositoryDescriptor_PeerDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60447af781d89487, []int{3, 1}
+	return fileDescriptor_60447af781d89487, []int{5, 1}
 }
 func (m *MetadataRepositoryDescriptor_PeerDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +526,7 @@ func (m *MetadataRepositoryDescriptor_PeerDescriptorMap) String() string {
 }
 func (*MetadataRepositoryDescriptor_PeerDescriptorMap) ProtoMessage() {}
 func (*MetadataRepositoryDescriptor_PeerDescriptorMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60447af781d89487, []int{3, 2}
+	return fileDescriptor_60447af781d89487, []int{5, 2}
 }
 func (m *MetadataRepositoryDescriptor_PeerDescriptorMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,7 +574,10 @@ func init() {
 	proto.RegisterType((*StorageNodeUncommitReport)(nil), "varlog.mrpb.StorageNodeUncommitReport")
 	proto.RegisterType((*LogStreamUncommitReports)(nil), "varlog.mrpb.LogStreamUncommitReports")
 	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.StorageNodeID]snpb.LogStreamUncommitReport)(nil), "varlog.mrpb.LogStreamUncommitReports.ReplicasEntry")
+	proto.RegisterType((*ConsumerGroupOffset)(nil), "varlog.mrpb.ConsumerGroupOffset")
+	proto.RegisterType((*ConsumerGroupDescriptor)(nil), "varlog.mrpb.ConsumerGroupDescriptor")
 	proto.RegisterType((*MetadataRepositoryDescriptor)(nil), "varlog.mrpb.MetadataRepositoryDescriptor")
+	proto.RegisterMapType((map[string]*ConsumerGroupDescriptor)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.ConsumerGroupsEntry")
 	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.NodeID]string)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.EndpointsEntry")
 	proto.RegisterType((*MetadataRepositoryDescriptor_LogStreamDescriptor)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.LogStreamDescriptor")
 	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.LogStreamID]*LogStreamUncommitReports)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.LogStreamDescriptor.UncommitReportsEntry")
@@ -450,61 +591,74 @@ func init() {
 }
 
 var fileDescriptor_60447af781d89487 = []byte{
-	// 862 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc4, 0x4e, 0x1b, 0xbf, 0xd4, 0x69, 0x19, 0x2a, 0x70, 0x2d, 0xb0, 0x23, 0x07, 0xa4,
-	0x54, 0x22, 0xbb, 0x22, 0x3d, 0x10, 0xa5, 0xe5, 0x62, 0x12, 0x20, 0x28, 0x29, 0xd1, 0x46, 0xe1,
-	0xc0, 0x81, 0xd5, 0xd8, 0x9e, 0xba, 0xab, 0xec, 0xee, 0x8c, 0x66, 0x66, 0x23, 0x7c, 0x8d, 0x38,
-	0x20, 0x4e, 0x7c, 0x84, 0x7e, 0x04, 0x8e, 0xf0, 0x0d, 0x72, 0xcc, 0x0d, 0x4e, 0x0e, 0x4a, 0x2e,
-	0x7c, 0x86, 0x9e, 0xd0, 0xce, 0xcc, 0xda, 0xbb, 0x64, 0x43, 0xd2, 0xe6, 0xb6, 0xf3, 0xe7, 0xf7,
-	0x7e, 0xef, 0xf7, 0xe6, 0xfd, 0x9e, 0x16, 0x1e, 0x73, 0xc1, 0x14, 0x73, 0x23, 0xc1, 0x7b, 0xae,
-	0x20, 0x2f, 0x94, 0x1f, 0x51, 0x45, 0x06, 0x44, 0x11, 0x5f, 0x50, 0xce, 0x64, 0xa0, 0x98, 0x18,
-	0x39, 0xfa, 0x0e, 0x5e, 0x38, 0x22, 0x22, 0x64, 0x43, 0x27, 0xbd, 0xdb, 0x5c, 0x1d, 0x06, 0xea,
-	0x65, 0xd2, 0x73, 0xfa, 0x2c, 0x72, 0x87, 0x6c, 0xc8, 0x5c, 0x7d, 0xa7, 0x97, 0xbc, 0xd0, 0x2b,
-	0x13, 0x34, 0xfd, 0x32, 0xd8, 0xe6, 0xfb, 0x06, 0xcb, 0x7b, 0x6e, 0x16, 0xdf, 0x1e, 0xb4, 0x64,
-	0xcc, 0x7b, 0x6e, 0xc8, 0x86, 0xbe, 0x54, 0x82, 0x92, 0x48, 0xd3, 0x0a, 0x45, 0x85, 0x39, 0xef,
-	0xfc, 0x8e, 0xe0, 0xbd, 0x1d, 0x36, 0xdc, 0xd7, 0x87, 0x5f, 0xb0, 0x28, 0x0a, 0x94, 0x47, 0x65,
-	0x12, 0x2a, 0x89, 0xbf, 0x82, 0xbb, 0x47, 0x54, 0xc8, 0x80, 0xc5, 0x0d, 0xb4, 0x84, 0x56, 0xaa,
-	0xdd, 0xd5, 0xd7, 0xe3, 0xf6, 0xe3, 0x5c, 0x5e, 0x87, 0xe4, 0x90, 0x30, 0xd7, 0x30, 0xbb, 0xfc,
-	0x70, 0xe8, 0xaa, 0x11, 0xa7, 0xd2, 0xf9, 0xce, 0x80, 0xbc, 0x0c, 0x8d, 0xbf, 0x85, 0xc5, 0xbe,
-	0x8e, 0xec, 0x0b, 0x13, 0xba, 0x51, 0x59, 0xaa, 0xac, 0x2c, 0xac, 0x75, 0x1c, 0xab, 0x38, 0xcd,
-	0xd1, 0x29, 0xcd, 0xa2, 0x5b, 0x3d, 0x19, 0xb7, 0x67, 0xbc, 0x7a, 0x3f, 0x9f, 0xd9, 0x46, 0xf5,
-	0x9f, 0x57, 0x6d, 0xd4, 0xf9, 0x1b, 0xc1, 0xa3, 0x7d, 0xc5, 0x04, 0x19, 0xd2, 0xe7, 0x6c, 0x40,
-	0x0f, 0xe2, 0xec, 0x52, 0x2a, 0x10, 0x87, 0x70, 0x5f, 0x9a, 0x43, 0x3f, 0x66, 0x03, 0xea, 0x07,
-	0x03, 0xad, 0x62, 0xae, 0xbb, 0x79, 0x3e, 0x6e, 0xd7, 0x73, 0xb8, 0xed, 0xcd, 0xd7, 0xe3, 0xb6,
-	0x7b, 0xbd, 0xac, 0x02, 0xc4, 0xab, 0xcb, 0xdc, 0x72, 0x80, 0x0f, 0xe0, 0x41, 0x12, 0x4f, 0x44,
-	0xa6, 0x09, 0xc8, 0xc6, 0xac, 0x16, 0xf9, 0x51, 0xb9, 0xc8, 0x62, 0xb6, 0x56, 0xe6, 0xfd, 0xa4,
-	0xb0, 0x2b, 0x3b, 0x27, 0xb3, 0xd0, 0xb8, 0x02, 0x22, 0xf1, 0xcf, 0x08, 0xe6, 0x05, 0xe5, 0x61,
-	0xd0, 0x27, 0xb2, 0x81, 0x34, 0xd9, 0x13, 0x27, 0xd7, 0x43, 0x57, 0x91, 0x49, 0xc7, 0xb3, 0xa8,
-	0xad, 0x58, 0x89, 0x51, 0xf7, 0xb3, 0x94, 0xfb, 0xf8, 0xec, 0xcd, 0x6b, 0x30, 0x61, 0xc7, 0xeb,
-	0x70, 0x47, 0x2a, 0xa2, 0x92, 0x54, 0x34, 0x5a, 0x59, 0x5c, 0x5b, 0xca, 0xf2, 0xc8, 0xda, 0x72,
-	0x9a, 0xcb, 0xbe, 0xbe, 0xe7, 0xd9, 0xfb, 0x4d, 0x02, 0xf5, 0x42, 0x36, 0xf8, 0x01, 0x54, 0x0e,
-	0xe9, 0xc8, 0xbc, 0x95, 0x97, 0x7e, 0xe2, 0x0d, 0x98, 0x3b, 0x22, 0x61, 0x42, 0x75, 0xec, 0x1b,
-	0x16, 0xd4, 0x33, 0x90, 0x8d, 0xd9, 0x75, 0x64, 0xbb, 0xe5, 0x14, 0xe0, 0x83, 0x5d, 0xeb, 0x0d,
-	0x6f, 0x62, 0xbd, 0x4d, 0x2a, 0xfb, 0x22, 0xe0, 0x8a, 0x09, 0xbc, 0x05, 0xf3, 0x99, 0x77, 0x34,
-	0xfb, 0xc2, 0xda, 0xf2, 0x25, 0x15, 0x59, 0x80, 0x29, 0x4c, 0xbf, 0x1c, 0xf2, 0x26, 0x50, 0xdc,
-	0x03, 0x98, 0xba, 0xcd, 0xa6, 0xfc, 0x79, 0xe1, 0x59, 0xfe, 0x2f, 0x8b, 0xa9, 0x9e, 0x4b, 0x14,
-	0xb5, 0x30, 0x3b, 0xc2, 0x3f, 0x40, 0x8d, 0x53, 0x2a, 0xa4, 0x1f, 0x11, 0xde, 0xa8, 0x68, 0x8a,
-	0xa7, 0x37, 0xa7, 0xd8, 0xa3, 0x54, 0x4c, 0x97, 0xbb, 0x84, 0xdb, 0xee, 0x9b, 0xd7, 0x31, 0x77,
-	0x09, 0xc7, 0x3f, 0x21, 0xa8, 0xd1, 0x78, 0xc0, 0x59, 0x10, 0x2b, 0xd9, 0xa8, 0xea, 0xd6, 0x5a,
-	0xbf, 0x39, 0xc1, 0x56, 0x06, 0x35, 0xfd, 0xf5, 0xc9, 0xf1, 0x59, 0x7b, 0xe5, 0xfa, 0xde, 0xb2,
-	0x4d, 0x35, 0x25, 0x6e, 0xfe, 0x59, 0x81, 0x77, 0x4b, 0xea, 0x81, 0xf7, 0xe0, 0x9e, 0x12, 0x41,
-	0xe4, 0xdf, 0x6a, 0x3a, 0x2d, 0xa4, 0x21, 0xec, 0x02, 0xef, 0x4d, 0x26, 0xd4, 0xcb, 0x20, 0x35,
-	0xf6, 0xc8, 0x9a, 0x77, 0xb9, 0xdc, 0x4f, 0x85, 0x39, 0x69, 0x9f, 0xc7, 0x8e, 0xa8, 0xaf, 0x0d,
-	0x1e, 0xff, 0x86, 0x4a, 0x26, 0x82, 0x19, 0x7b, 0xde, 0xad, 0xba, 0xc1, 0xf9, 0x8f, 0x99, 0x4d,
-	0x8d, 0x3f, 0x3d, 0x3e, 0x6b, 0xaf, 0x5e, 0x2f, 0x7e, 0x12, 0x6f, 0x7b, 0xf3, 0xd2, 0xb0, 0x69,
-	0x06, 0xf0, 0xb0, 0x2c, 0x76, 0x89, 0x23, 0x9f, 0x16, 0x1d, 0xf9, 0xf1, 0x8d, 0xa6, 0x4e, 0xce,
-	0x92, 0xcd, 0x6f, 0x60, 0xb1, 0xd8, 0x85, 0xf8, 0x11, 0x54, 0x12, 0x11, 0x6a, 0x92, 0x5a, 0xf7,
-	0xee, 0xf9, 0xb8, 0x5d, 0x39, 0xf0, 0x76, 0xbc, 0x74, 0x0f, 0x7f, 0x08, 0x10, 0x48, 0x3f, 0xa4,
-	0x44, 0xc4, 0x54, 0x68, 0xca, 0x79, 0xaf, 0x16, 0xc8, 0x1d, 0xb3, 0xd1, 0xfc, 0x63, 0x16, 0xde,
-	0xb9, 0xd4, 0xd2, 0xf8, 0x17, 0x04, 0x73, 0xba, 0x9f, 0xed, 0x64, 0xfc, 0xf2, 0x16, 0xfe, 0xd0,
-	0x3b, 0x6f, 0xd5, 0xcc, 0x26, 0x05, 0xbc, 0x0c, 0x75, 0xc2, 0x79, 0x18, 0xd0, 0x81, 0x1f, 0xc4,
-	0x03, 0xfa, 0xa3, 0x16, 0x51, 0xf5, 0xee, 0xd9, 0xcd, 0xed, 0x74, 0xaf, 0x29, 0x00, 0xa6, 0x3c,
-	0xf9, 0xa2, 0x57, 0x4d, 0xd1, 0x9f, 0x17, 0x8b, 0xbe, 0xfe, 0xb6, 0x82, 0xf2, 0xef, 0xf0, 0x0c,
-	0x16, 0x8b, 0x66, 0x2d, 0xe1, 0x7d, 0x98, 0xe7, 0xad, 0xe5, 0xd0, 0xdd, 0x67, 0x27, 0xe7, 0x2d,
-	0x74, 0x7a, 0xde, 0x42, 0xbf, 0x5e, 0xb4, 0x66, 0x5e, 0x5d, 0xb4, 0xd0, 0xe9, 0x45, 0x6b, 0xe6,
-	0xaf, 0x8b, 0xd6, 0xcc, 0xf7, 0x9d, 0x2b, 0x2b, 0x34, 0xf9, 0x1b, 0xea, 0xdd, 0xd1, 0xdf, 0x4f,
-	0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xad, 0x94, 0x9c, 0xd0, 0x22, 0x09, 0x00, 0x00,
+	// 1060 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x4f, 0xe3, 0x46,
+	0x14, 0xc7, 0x24, 0x6c, 0xc8, 0x0b, 0x81, 0xed, 0xec, 0xaa, 0x9b, 0x8d, 0xda, 0x18, 0x41, 0x5b,
+	0xb1, 0x52, 0x89, 0x55, 0xf6, 0x50, 0xc4, 0x6e, 0xa5, 0x55, 0x16, 0x96, 0x52, 0x05, 0x16, 0x99,
+	0xd2, 0x43, 0x0f, 0xb5, 0x9c, 0x78, 0xe2, 0xb5, 0x70, 0x3c, 0xd6, 0xcc, 0x18, 0x35, 0xea, 0x0d,
+	0xf5, 0x50, 0xf5, 0xd4, 0x8f, 0xb0, 0xfd, 0x06, 0x3d, 0xb6, 0xdf, 0x80, 0xe3, 0xde, 0xda, 0x53,
+	0xa8, 0x82, 0x54, 0xf5, 0x33, 0x70, 0x5a, 0x79, 0x3c, 0x4e, 0x6c, 0x62, 0x96, 0xb0, 0xdc, 0x3c,
+	0x7f, 0x7e, 0xbf, 0xf7, 0x7b, 0x7f, 0xe6, 0x3d, 0xc3, 0x23, 0x9f, 0x12, 0x4e, 0xb4, 0x2e, 0xf5,
+	0x5b, 0x1a, 0x35, 0x3b, 0xdc, 0xe8, 0x62, 0x6e, 0x5a, 0x26, 0x37, 0x0d, 0x8a, 0x7d, 0xc2, 0x1c,
+	0x4e, 0x68, 0xaf, 0x2e, 0xee, 0xa0, 0xd2, 0xb1, 0x49, 0x5d, 0x62, 0xd7, 0xc3, 0xbb, 0xd5, 0x55,
+	0xdb, 0xe1, 0xaf, 0x82, 0x56, 0xbd, 0x4d, 0xba, 0x9a, 0x4d, 0x6c, 0xa2, 0x89, 0x3b, 0xad, 0xa0,
+	0x23, 0x56, 0x11, 0x69, 0xf8, 0x15, 0x61, 0xab, 0x0f, 0x22, 0xac, 0xdf, 0xd2, 0x62, 0x7e, 0x79,
+	0x50, 0x63, 0x9e, 0xdf, 0xd2, 0x5c, 0x62, 0x1b, 0x8c, 0x53, 0x6c, 0x76, 0x85, 0x59, 0xca, 0x31,
+	0x8d, 0xce, 0x97, 0xfe, 0x54, 0xe0, 0xc3, 0x26, 0xb1, 0x0f, 0xc4, 0xe1, 0x73, 0xd2, 0xed, 0x3a,
+	0x5c, 0xc7, 0x2c, 0x70, 0x39, 0x43, 0xdb, 0x50, 0x38, 0xc6, 0x94, 0x39, 0xc4, 0xab, 0x28, 0x8b,
+	0xca, 0x4a, 0xbe, 0xb1, 0x7a, 0xd1, 0x57, 0x1f, 0x25, 0x74, 0x1d, 0x99, 0x47, 0x26, 0xd1, 0x22,
+	0xcb, 0x9a, 0x7f, 0x64, 0x6b, 0xbc, 0xe7, 0x63, 0x56, 0xff, 0x2e, 0x02, 0xe9, 0x31, 0x1a, 0xbd,
+	0x84, 0xf9, 0xb6, 0x60, 0x36, 0x68, 0x44, 0x5d, 0xc9, 0x2d, 0xe6, 0x56, 0x4a, 0x6b, 0x4b, 0x75,
+	0xe9, 0x71, 0xa8, 0xb1, 0x9e, 0xa9, 0xa2, 0x91, 0x3f, 0xed, 0xab, 0x53, 0x7a, 0xb9, 0x9d, 0x54,
+	0xb6, 0x91, 0xff, 0xff, 0xb5, 0xaa, 0x2c, 0xfd, 0xab, 0xc0, 0xc3, 0x03, 0x4e, 0xa8, 0x69, 0xe3,
+	0x3d, 0x62, 0xe1, 0x43, 0x2f, 0xbe, 0x14, 0x3a, 0x88, 0x5c, 0x58, 0x60, 0xd1, 0xa1, 0xe1, 0x11,
+	0x0b, 0x1b, 0x8e, 0x25, 0xbc, 0x98, 0x69, 0x6c, 0x0e, 0xfa, 0x6a, 0x39, 0x81, 0xdb, 0xd9, 0xbc,
+	0xe8, 0xab, 0xda, 0xf5, 0x6e, 0xa5, 0x20, 0x7a, 0x99, 0x25, 0x96, 0x16, 0x3a, 0x84, 0xbb, 0x81,
+	0x37, 0x74, 0x32, 0x14, 0xc0, 0x2a, 0xd3, 0xc2, 0xc9, 0x4f, 0xb2, 0x9d, 0x4c, 0xab, 0x95, 0x6e,
+	0x2e, 0x04, 0xa9, 0x5d, 0xb6, 0x74, 0x3a, 0x0d, 0x95, 0x2b, 0x20, 0x0c, 0xfd, 0xa2, 0xc0, 0x2c,
+	0xc5, 0xbe, 0xeb, 0xb4, 0x4d, 0x56, 0x51, 0x84, 0xb1, 0xc7, 0xf5, 0x44, 0x0d, 0x5d, 0x65, 0x8c,
+	0xd5, 0x75, 0x89, 0xda, 0xf2, 0x38, 0xed, 0x35, 0xbe, 0x0c, 0x6d, 0x9f, 0x9c, 0xdd, 0x3c, 0x06,
+	0x43, 0xeb, 0x68, 0x1d, 0xee, 0x30, 0x6e, 0xf2, 0x20, 0x74, 0x5a, 0x59, 0x99, 0x5f, 0x5b, 0x8c,
+	0x75, 0xc4, 0x65, 0x39, 0xd2, 0x72, 0x20, 0xee, 0xe9, 0xf2, 0x7e, 0xd5, 0x84, 0x72, 0x4a, 0x0d,
+	0xba, 0x0b, 0xb9, 0x23, 0xdc, 0x8b, 0x72, 0xa5, 0x87, 0x9f, 0x68, 0x03, 0x66, 0x8e, 0x4d, 0x37,
+	0xc0, 0x82, 0x7b, 0xc2, 0x80, 0xea, 0x11, 0x64, 0x63, 0x7a, 0x5d, 0x91, 0xd5, 0xf2, 0xdf, 0x34,
+	0xdc, 0x7b, 0x4e, 0x3c, 0x16, 0x74, 0x31, 0xdd, 0xa6, 0x24, 0xf0, 0x5f, 0x76, 0x3a, 0x0c, 0x73,
+	0x74, 0x00, 0xb3, 0x9c, 0xf8, 0x4e, 0x7b, 0x54, 0x20, 0xeb, 0x83, 0xbe, 0x5a, 0xf8, 0x36, 0xdc,
+	0xdb, 0xd9, 0x9c, 0xac, 0xe2, 0xe5, 0x65, 0xbd, 0x20, 0x98, 0x76, 0x2c, 0x64, 0x41, 0x39, 0xf1,
+	0xe4, 0x1c, 0x4b, 0x48, 0x9f, 0x69, 0x3c, 0x1b, 0xf4, 0xd5, 0xd2, 0x50, 0xb1, 0x60, 0x5f, 0xbd,
+	0x9e, 0x3d, 0x01, 0xd0, 0x4b, 0xee, 0x70, 0x61, 0xa1, 0x17, 0x90, 0xb7, 0x5d, 0xe6, 0x55, 0x72,
+	0xe2, 0x75, 0xae, 0x0d, 0xfa, 0x6a, 0x7e, 0xbb, 0x79, 0xb0, 0x77, 0xd1, 0x57, 0x3f, 0xbb, 0x9e,
+	0x35, 0xbc, 0xa9, 0x0b, 0x7c, 0xc8, 0xe3, 0x86, 0x3c, 0xf9, 0x11, 0x4f, 0x73, 0x62, 0x9e, 0xa6,
+	0xe0, 0x09, 0xf1, 0x32, 0xd0, 0x3f, 0xc1, 0x83, 0x54, 0x9c, 0x37, 0x31, 0x6b, 0x53, 0xc7, 0xe7,
+	0x84, 0xa2, 0x67, 0x50, 0x20, 0x22, 0xea, 0x71, 0xbd, 0x2e, 0xa6, 0xea, 0x35, 0x23, 0x3d, 0xf2,
+	0x61, 0xc4, 0x30, 0x54, 0x81, 0x82, 0x85, 0x5d, 0xcc, 0x71, 0x14, 0xd2, 0x59, 0x3d, 0x5e, 0x4a,
+	0xe3, 0xbf, 0xcf, 0xc1, 0x47, 0xbb, 0xb2, 0x03, 0xea, 0xc3, 0x06, 0x9b, 0x90, 0xb0, 0x05, 0xb3,
+	0x71, 0x87, 0x14, 0xe9, 0x2e, 0xad, 0x2d, 0x8f, 0xd5, 0x6a, 0x4c, 0x30, 0x82, 0x09, 0x19, 0x8a,
+	0x3e, 0x84, 0xa2, 0x16, 0xc0, 0x28, 0xc1, 0xb2, 0x30, 0xbf, 0x4a, 0x39, 0xf3, 0x2e, 0x15, 0xa3,
+	0x94, 0x8e, 0x99, 0x28, 0x0e, 0x13, 0x8c, 0x7e, 0x80, 0xa2, 0x8f, 0x31, 0x65, 0x46, 0xd7, 0xf4,
+	0x45, 0x8e, 0x4b, 0x6b, 0x4f, 0x26, 0x37, 0xb1, 0x8f, 0x31, 0x1d, 0x2d, 0x77, 0x4d, 0x5f, 0x86,
+	0x72, 0x56, 0x70, 0xee, 0x9a, 0x3e, 0xfa, 0x59, 0x81, 0x22, 0xf6, 0x2c, 0x9f, 0x38, 0x1e, 0x67,
+	0x95, 0xbc, 0x48, 0xc8, 0xfa, 0xe4, 0x06, 0xb6, 0x62, 0x68, 0xd4, 0x45, 0x3e, 0x3f, 0x39, 0x53,
+	0x57, 0xae, 0x2f, 0x17, 0xd9, 0x3a, 0x46, 0x86, 0x51, 0x07, 0x16, 0xda, 0x32, 0xf1, 0x86, 0x1d,
+	0x66, 0x9e, 0x55, 0x66, 0x16, 0x73, 0x37, 0x8b, 0x67, 0xaa, 0x72, 0x22, 0x41, 0xfa, 0x7c, 0x3b,
+	0xb5, 0x59, 0xfd, 0x3b, 0x07, 0xf7, 0x32, 0xe2, 0x8e, 0xf6, 0x61, 0x8e, 0x53, 0xa7, 0x6b, 0xdc,
+	0x6a, 0xd6, 0x95, 0x42, 0x0a, 0xb9, 0x40, 0xfb, 0xc3, 0x79, 0xf7, 0xca, 0x09, 0xc7, 0x44, 0x4f,
+	0x8e, 0x82, 0xe5, 0xec, 0xee, 0x9c, 0x9a, 0xba, 0xb2, 0x0c, 0xe4, 0xc0, 0xfb, 0x3a, 0xc2, 0xa3,
+	0x3f, 0x94, 0x8c, 0xf9, 0x12, 0x0d, 0x51, 0xfd, 0x56, 0x55, 0x57, 0xbf, 0x34, 0x1a, 0xa2, 0x5c,
+	0x7e, 0x71, 0x72, 0x76, 0xd3, 0xc6, 0x74, 0x79, 0x74, 0x55, 0x1d, 0xb8, 0x9f, 0xc5, 0x9d, 0xd1,
+	0xdf, 0x9f, 0xa4, 0xfb, 0xfb, 0xa7, 0x13, 0xcd, 0xb0, 0x44, 0x83, 0xaf, 0x7e, 0x03, 0xf3, 0xe9,
+	0x6a, 0x47, 0x0f, 0x21, 0x17, 0x50, 0x57, 0x18, 0x29, 0x36, 0x0a, 0x83, 0xbe, 0x9a, 0x3b, 0xd4,
+	0x9b, 0x7a, 0xb8, 0x87, 0x3e, 0x06, 0x70, 0x98, 0xe1, 0x62, 0x93, 0x7a, 0x98, 0xca, 0x26, 0x52,
+	0x74, 0x58, 0x33, 0xda, 0xa8, 0xfe, 0x35, 0x0d, 0x1f, 0x8c, 0x3d, 0x1d, 0xf4, 0xab, 0x02, 0x33,
+	0xe2, 0xdd, 0xc8, 0xbe, 0xf5, 0xe2, 0x16, 0xef, 0x50, 0xec, 0xbc, 0xd7, 0xa3, 0x89, 0x24, 0xa0,
+	0x65, 0x28, 0x9b, 0xbe, 0xef, 0x3a, 0xd8, 0x32, 0x1c, 0xcf, 0xc2, 0x3f, 0x0a, 0x27, 0xf2, 0xfa,
+	0x9c, 0xdc, 0xdc, 0x09, 0xf7, 0xaa, 0x14, 0x60, 0x64, 0x27, 0x19, 0xf4, 0x7c, 0x14, 0xf4, 0xbd,
+	0x74, 0xd0, 0xd7, 0xdf, 0xd7, 0xa1, 0x64, 0x1e, 0x9e, 0xc2, 0x7c, 0xba, 0x29, 0x64, 0xd8, 0xbd,
+	0x9f, 0xb4, 0x5b, 0x4c, 0xa2, 0xed, 0x4b, 0xf3, 0x79, 0x9c, 0xa2, 0xf8, 0xee, 0xff, 0x81, 0xf1,
+	0x19, 0x92, 0x29, 0xb3, 0xf1, 0xf4, 0x74, 0x50, 0x53, 0xde, 0x0c, 0x6a, 0xca, 0x6f, 0xe7, 0xb5,
+	0xa9, 0xd7, 0xe7, 0x35, 0xe5, 0xcd, 0x79, 0x6d, 0xea, 0x9f, 0xf3, 0xda, 0xd4, 0xf7, 0x4b, 0x57,
+	0xa6, 0x62, 0xf8, 0x13, 0xdf, 0xba, 0x23, 0xbe, 0x1f, 0xbf, 0x1d, 0x00, 0x0a, 0x60, 0xb2, 0x55,
+	0xd9, 0x0b, 0x00, 0x00,
 }
 
 func (this *LogStreamCommitResults) Equal(that interface{}) bool {
@@ -573,6 +727,71 @@ func (this *LogStreamUncommitReports) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ConsumerGroupOffset) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConsumerGroupOffset)
+	if !ok {
+		that2, ok := that.(ConsumerGroupOffset)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TopicID != that1.TopicID {
+		return false
+	}
+	if this.LogStreamID != that1.LogStreamID {
+		return false
+	}
+	if this.GLSN != that1.GLSN {
+		return false
+	}
+	if this.LLSN != that1.LLSN {
+		return false
+	}
+	return true
+}
+func (this *ConsumerGroupDescriptor) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConsumerGroupDescriptor)
+	if !ok {
+		that2, ok := that.(ConsumerGroupDescriptor)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Offsets) != len(that1.Offsets) {
+		return false
+	}
+	for i := range this.Offsets {
+		if !this.Offsets[i].Equal(&that1.Offsets[i]) {
+			return false
+		}
+	}
+	if this.Deleted != that1.Deleted {
+		return false
+	}
+	return true
+}
 func (m *LogStreamCommitResults) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -707,6 +926,96 @@ func (m *LogStreamUncommitReports) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *ConsumerGroupOffset) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupOffset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerGroupOffset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LLSN != 0 {
+		i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(m.LLSN))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GLSN != 0 {
+		i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(m.GLSN))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerGroupDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerGroupDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Offsets) > 0 {
+		for iNdEx := len(m.Offsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MetadataRepositoryDescriptor) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -727,6 +1036,32 @@ func (m *MetadataRepositoryDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsumerGroups) > 0 {
+		for k := range m.ConsumerGroups {
+			v := m.ConsumerGroups[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Endpoints) > 0 {
 		for k := range m.Endpoints {
 			v := m.Endpoints[k]
@@ -1007,6 +1342,45 @@ func (m *LogStreamUncommitReports) ProtoSize() (n int) {
 	return n
 }
 
+func (m *ConsumerGroupOffset) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovRaftMetadataRepository(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovRaftMetadataRepository(uint64(m.LogStreamID))
+	}
+	if m.GLSN != 0 {
+		n += 1 + sovRaftMetadataRepository(uint64(m.GLSN))
+	}
+	if m.LLSN != 0 {
+		n += 1 + sovRaftMetadataRepository(uint64(m.LLSN))
+	}
+	return n
+}
+
+func (m *ConsumerGroupDescriptor) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offsets) > 0 {
+		for _, e := range m.Offsets {
+			l = e.ProtoSize()
+			n += 1 + l + sovRaftMetadataRepository(uint64(l))
+		}
+	}
+	if m.Deleted {
+		n += 2
+	}
+	return n
+}
+
 func (m *MetadataRepositoryDescriptor) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -1031,6 +1405,19 @@ func (m *MetadataRepositoryDescriptor) ProtoSize() (n int) {
 			n += mapEntrySize + 1 + sovRaftMetadataRepository(uint64(mapEntrySize))
 		}
 	}
+	if len(m.ConsumerGroups) > 0 {
+		for k, v := range m.ConsumerGroups {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.ProtoSize()
+				l += 1 + sovRaftMetadataRepository(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRaftMetadataRepository(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRaftMetadataRepository(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -1502,7 +1889,7 @@ func (m *LogStreamUncommitReports) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetadataRepositoryDescriptor) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupOffset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1525,14 +1912,244 @@ func (m *MetadataRepositoryDescriptor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetadataRepositoryDescriptor: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGroupOffset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetadataRepositoryDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGroupOffset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
+			}
+			m.GLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSN", wireType)
+			}
+			m.LLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LLSN |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerGroupDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerGroupDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerGroupDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offsets = append(m.Offsets, ConsumerGroupOffset{})
+			if err := m.Offsets[len(m.Offsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetadataRepositoryDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataRepositoryDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataRepositoryDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
@@ -1749,6 +2366,135 @@ func (m *MetadataRepositoryDescriptor) Unmarshal(dAtA []byte) error {
 			}
 			m.Endpoints[github_com_kakao_varlog_pkg_types.NodeID(mapkey)] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerGroups == nil {
+				m.ConsumerGroups = make(map[string]*ConsumerGroupDescriptor)
+			}
+			var mapkey string
+			var mapvalue *ConsumerGroupDescriptor
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaftMetadataRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftMetadataRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftMetadataRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ConsumerGroupDescriptor{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ConsumerGroups[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
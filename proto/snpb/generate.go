@@ -1,3 +1,3 @@
 package snpb
 
-//go:generate mockgen -build_flags -mod=vendor -package mock -destination mock/snpb_mock.go . ReplicatorClient,ReplicatorServer,Replicator_ReplicateClient,LogIOClient,LogIOServer,LogIO_SubscribeClient,LogIO_SubscribeServer,LogStreamReporterClient,LogStreamReporterServer,ManagementClient,ManagementServer
+//go:generate mockgen -build_flags -mod=vendor -package mock -destination mock/snpb_mock.go . ReplicatorClient,ReplicatorServer,Replicator_ReplicateClient,LogIOClient,LogIOServer,LogIO_AppendStreamClient,LogIO_AppendStreamServer,LogIO_SubscribeClient,LogIO_SubscribeServer,LogIO_ReadBatchClient,LogIO_ReadBatchServer,LogStreamReporterClient,LogStreamReporterServer,ManagementClient,ManagementServer,Management_ExportClient,Management_ExportServer,Management_ImportClient,Management_ImportServer
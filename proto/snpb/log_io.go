@@ -1,11 +1,19 @@
 package snpb
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
 
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
+// ReservedHeaderPrefix is the prefix of keys of headers reserved by the
+// client, for instance, to record how data of log entries are compressed or
+// encrypted.
+const ReservedHeaderPrefix = "varlog-"
+
 func ValidateTopicLogStream(iface interface {
 	GetTopicID() types.TopicID
 	GetLogStreamID() types.LogStreamID
@@ -18,3 +26,67 @@ func ValidateTopicLogStream(iface interface {
 	}
 	return nil
 }
+
+// Match returns true if the log entry satisfies all conditions of the filter.
+// A nil filter matches all log entries.
+func (f *SubscribeFilter) Match(le varlogpb.LogEntry) bool {
+	if f == nil {
+		return true
+	}
+	for key, value := range f.Headers {
+		v, ok := le.Headers[key]
+		if !ok || !bytes.Equal(v, value) {
+			return false
+		}
+	}
+	if !bytes.HasPrefix(le.Data, f.DataPrefix) {
+		return false
+	}
+	for _, r := range f.DataRanges {
+		if !r.Match(le.Data) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasDataPredicates returns true if the filter has conditions on the data of
+// log entries.
+func (f *SubscribeFilter) HasDataPredicates() bool {
+	return f != nil && (len(f.DataPrefix) > 0 || len(f.DataRanges) > 0)
+}
+
+// Project removes fields of the log entry excluded by the filter. Reserved
+// headers are never removed since the client needs them to decode log
+// entries.
+func (f *SubscribeFilter) Project(le *varlogpb.LogEntry) {
+	if f == nil {
+		return
+	}
+	if f.ExcludeData {
+		le.Data = nil
+		le.Checksum = 0
+	}
+	if f.ExcludeHeaders {
+		var reserved map[string][]byte
+		for key, value := range le.Headers {
+			if !strings.HasPrefix(key, ReservedHeaderPrefix) {
+				continue
+			}
+			if reserved == nil {
+				reserved = make(map[string][]byte)
+			}
+			reserved[key] = value
+		}
+		le.Headers = reserved
+	}
+}
+
+// Match returns true if the argument data has the value at the offset.
+func (r SubscribeFilter_ByteRange) Match(data []byte) bool {
+	offset := uint64(r.Offset)
+	if offset+uint64(len(r.Value)) > uint64(len(data)) {
+		return false
+	}
+	return bytes.Equal(data[offset:offset+uint64(len(r.Value))], r.Value)
+}
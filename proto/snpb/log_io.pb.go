@@ -5,17 +5,21 @@ package snpb
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 
 	github_com_kakao_varlog_pkg_types "github.com/kakao/varlog/pkg/types"
 	varlogpb "github.com/kakao/varlog/proto/varlogpb"
@@ -25,6 +29,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -39,6 +44,25 @@ type AppendRequest struct {
 	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 	Payload     [][]byte                                      `protobuf:"bytes,3,rep,name=payload,proto3" json:"payload,omitempty"`
 	Backups     []varlogpb.StorageNode                        `protobuf:"bytes,4,rep,name=backups,proto3" json:"backups"`
+	// headers are headers of log entries in the payload. If it is not empty, its
+	// length should be the same as that of the payload.
+	Headers []varlogpb.LogEntryHeaders `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers"`
+	// producer_id identifies an idempotent producer. If it is set, the log
+	// stream appends the payload only once for each sequence number.
+	ProducerID string `protobuf:"bytes,6,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	// sequence is the sequence number of the first log entry in the payload.
+	// The following log entries have consecutive sequence numbers. It is
+	// meaningful only if producer_id is set.
+	Sequence uint64 `protobuf:"varint,7,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// trace_context carries the W3C trace context of the client to trace the
+	// append across the storage nodes. It is empty if the client does not
+	// trace the append.
+	TraceContext map[string]string `protobuf:"bytes,8,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// checksums are CRC32C checksums of log entries in the payload computed by
+	// the client. If it is not empty, its length should be the same as that of
+	// the payload, and the storage node rejects the request unless they match
+	// the payload. If it is empty, the storage node computes them.
+	Checksums []uint32 `protobuf:"fixed32,9,rep,packed,name=checksums,proto3" json:"checksums,omitempty"`
 }
 
 func (m *AppendRequest) Reset()         { *m = AppendRequest{} }
@@ -102,6 +126,41 @@ func (m *AppendRequest) GetBackups() []varlogpb.StorageNode {
 	return nil
 }
 
+func (m *AppendRequest) GetHeaders() []varlogpb.LogEntryHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *AppendRequest) GetProducerID() string {
+	if m != nil {
+		return m.ProducerID
+	}
+	return ""
+}
+
+func (m *AppendRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AppendRequest) GetTraceContext() map[string]string {
+	if m != nil {
+		return m.TraceContext
+	}
+	return nil
+}
+
+func (m *AppendRequest) GetChecksums() []uint32 {
+	if m != nil {
+		return m.Checksums
+	}
+	return nil
+}
+
 type AppendResult struct {
 	Meta  varlogpb.LogEntryMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta"`
 	Error string                `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
@@ -199,11 +258,15 @@ func (m *AppendResponse) GetResults() []AppendResult {
 	return nil
 }
 
-// ReadRequest asks a storage node to retrieve log entry at the GLSN.
+// ReadRequest asks a storage node to retrieve a committed log entry from the
+// log stream replica specified by topic_id and log_stream_id. Exactly one of
+// glsn and llsn should be set; glsn locates the log entry by its global
+// position, and llsn does it by the local position in the log stream.
 type ReadRequest struct {
 	GLSN        github_com_kakao_varlog_pkg_types.GLSN        `protobuf:"varint,1,opt,name=glsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn,omitempty"`
 	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,3,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	LLSN        github_com_kakao_varlog_pkg_types.LLSN        `protobuf:"varint,4,opt,name=llsn,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn,omitempty"`
 }
 
 func (m *ReadRequest) Reset()         { *m = ReadRequest{} }
@@ -260,12 +323,24 @@ func (m *ReadRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStre
 	return 0
 }
 
+func (m *ReadRequest) GetLLSN() github_com_kakao_varlog_pkg_types.LLSN {
+	if m != nil {
+		return m.LLSN
+	}
+	return 0
+}
+
 // ReadResponse contains the contents of the log entry which is retrieved by
 // the ReadRequest.
 type ReadResponse struct {
 	GLSN    github_com_kakao_varlog_pkg_types.GLSN `protobuf:"varint,1,opt,name=glsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn,omitempty"`
 	LLSN    github_com_kakao_varlog_pkg_types.LLSN `protobuf:"varint,2,opt,name=llsn,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn,omitempty"`
 	Payload []byte                                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Headers map[string][]byte                      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// filtered is true if the log entry does not match the filter of the
+	// request. Only the GLSN is set in that case so that the client, which
+	// merges log streams in the order of GLSN, can move on to the next GLSN.
+	Filtered bool `protobuf:"varint,5,opt,name=filtered,proto3" json:"filtered,omitempty"`
 }
 
 func (m *ReadResponse) Reset()         { *m = ReadResponse{} }
@@ -322,6 +397,139 @@ func (m *ReadResponse) GetPayload() []byte {
 	return nil
 }
 
+func (m *ReadResponse) GetHeaders() map[string][]byte {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *ReadResponse) GetFiltered() bool {
+	if m != nil {
+		return m.Filtered
+	}
+	return false
+}
+
+// ReadBatchRequest asks a storage node to retrieve committed log entries at
+// the list of glsns from the log stream replica specified by topic_id and
+// log_stream_id. The glsns can contain positions of log entries that belong to
+// other log streams in the topic.
+type ReadBatchRequest struct {
+	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	GLSNs       []github_com_kakao_varlog_pkg_types.GLSN      `protobuf:"varint,3,rep,packed,name=glsns,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsns,omitempty"`
+}
+
+func (m *ReadBatchRequest) Reset()         { *m = ReadBatchRequest{} }
+func (m *ReadBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadBatchRequest) ProtoMessage()    {}
+func (*ReadBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{5}
+}
+func (m *ReadBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadBatchRequest.Merge(m, src)
+}
+func (m *ReadBatchRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ReadBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadBatchRequest proto.InternalMessageInfo
+
+func (m *ReadBatchRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *ReadBatchRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
+}
+
+func (m *ReadBatchRequest) GetGLSNs() []github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.GLSNs
+	}
+	return nil
+}
+
+// ReadBatchResponse contains a log entry retrieved by the ReadBatchRequest.
+// If trimmed is true, the log entry at the GLSN of log_entry was already
+// trimmed, and only the GLSN of log_entry is valid.
+type ReadBatchResponse struct {
+	LogEntry varlogpb.LogEntry `protobuf:"bytes,1,opt,name=log_entry,json=logEntry,proto3" json:"log_entry"`
+	Trimmed  bool              `protobuf:"varint,2,opt,name=trimmed,proto3" json:"trimmed,omitempty"`
+}
+
+func (m *ReadBatchResponse) Reset()         { *m = ReadBatchResponse{} }
+func (m *ReadBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadBatchResponse) ProtoMessage()    {}
+func (*ReadBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{6}
+}
+func (m *ReadBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadBatchResponse.Merge(m, src)
+}
+func (m *ReadBatchResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ReadBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadBatchResponse proto.InternalMessageInfo
+
+func (m *ReadBatchResponse) GetLogEntry() varlogpb.LogEntry {
+	if m != nil {
+		return m.LogEntry
+	}
+	return varlogpb.LogEntry{}
+}
+
+func (m *ReadBatchResponse) GetTrimmed() bool {
+	if m != nil {
+		return m.Trimmed
+	}
+	return false
+}
+
 // SubscribeRequest has GLSN which indicates an inclusive starting position
 // from which a client wants to receive.
 type SubscribeRequest struct {
@@ -329,13 +537,15 @@ type SubscribeRequest struct {
 	GLSNEnd     github_com_kakao_varlog_pkg_types.GLSN        `protobuf:"varint,2,opt,name=glsn_end,json=glsnEnd,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn_end,omitempty"`
 	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,4,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	// filter selects log entries sent to the client. It is optional.
+	Filter *SubscribeFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
 func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeRequest) ProtoMessage()    {}
 func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{5}
+	return fileDescriptor_7692726f23e518ee, []int{7}
 }
 func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -392,18 +602,178 @@ func (m *SubscribeRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.Lo
 	return 0
 }
 
+func (m *SubscribeRequest) GetFilter() *SubscribeFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+// SubscribeFilter selects log entries of a subscription and fields of them in
+// the storage node so that log entries not needed by the client do not cross
+// the network. A log entry matches the filter if it satisfies all conditions;
+// hence, an empty filter matches all log entries.
+type SubscribeFilter struct {
+	// headers are satisfied if a log entry has all of them with the same
+	// values.
+	Headers map[string][]byte `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// data_prefix is satisfied if the data of a log entry starts with it.
+	DataPrefix []byte `protobuf:"bytes,2,opt,name=data_prefix,json=dataPrefix,proto3" json:"data_prefix,omitempty"`
+	// data_ranges are satisfied if the data of a log entry satisfies all of
+	// them.
+	DataRanges []SubscribeFilter_ByteRange `protobuf:"bytes,3,rep,name=data_ranges,json=dataRanges,proto3" json:"data_ranges"`
+	// exclude_data removes the data from log entries sent to the client.
+	ExcludeData bool `protobuf:"varint,4,opt,name=exclude_data,json=excludeData,proto3" json:"exclude_data,omitempty"`
+	// exclude_headers removes headers from log entries sent to the client
+	// except reserved headers, whose keys start with "varlog-", since the
+	// client needs them to decode log entries.
+	ExcludeHeaders bool `protobuf:"varint,5,opt,name=exclude_headers,json=excludeHeaders,proto3" json:"exclude_headers,omitempty"`
+}
+
+func (m *SubscribeFilter) Reset()         { *m = SubscribeFilter{} }
+func (m *SubscribeFilter) String() string { return proto.CompactTextString(m) }
+func (*SubscribeFilter) ProtoMessage()    {}
+func (*SubscribeFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{8}
+}
+func (m *SubscribeFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeFilter.Merge(m, src)
+}
+func (m *SubscribeFilter) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SubscribeFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeFilter proto.InternalMessageInfo
+
+func (m *SubscribeFilter) GetHeaders() map[string][]byte {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *SubscribeFilter) GetDataPrefix() []byte {
+	if m != nil {
+		return m.DataPrefix
+	}
+	return nil
+}
+
+func (m *SubscribeFilter) GetDataRanges() []SubscribeFilter_ByteRange {
+	if m != nil {
+		return m.DataRanges
+	}
+	return nil
+}
+
+func (m *SubscribeFilter) GetExcludeData() bool {
+	if m != nil {
+		return m.ExcludeData
+	}
+	return false
+}
+
+func (m *SubscribeFilter) GetExcludeHeaders() bool {
+	if m != nil {
+		return m.ExcludeHeaders
+	}
+	return false
+}
+
+// ByteRange is satisfied if the data of a log entry has the value at the
+// offset.
+type SubscribeFilter_ByteRange struct {
+	Offset uint32 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Value  []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *SubscribeFilter_ByteRange) Reset()         { *m = SubscribeFilter_ByteRange{} }
+func (m *SubscribeFilter_ByteRange) String() string { return proto.CompactTextString(m) }
+func (*SubscribeFilter_ByteRange) ProtoMessage()    {}
+func (*SubscribeFilter_ByteRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{8, 0}
+}
+func (m *SubscribeFilter_ByteRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeFilter_ByteRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeFilter_ByteRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeFilter_ByteRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeFilter_ByteRange.Merge(m, src)
+}
+func (m *SubscribeFilter_ByteRange) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SubscribeFilter_ByteRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeFilter_ByteRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeFilter_ByteRange proto.InternalMessageInfo
+
+func (m *SubscribeFilter_ByteRange) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *SubscribeFilter_ByteRange) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 // SubscribeResponse comprises the contents of the log entry and its GLSN.
 type SubscribeResponse struct {
 	GLSN    github_com_kakao_varlog_pkg_types.GLSN `protobuf:"varint,1,opt,name=glsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn,omitempty"`
 	LLSN    github_com_kakao_varlog_pkg_types.LLSN `protobuf:"varint,2,opt,name=llsn,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn,omitempty"`
 	Payload []byte                                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Headers map[string][]byte                      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// filtered is true if the response reports log entries that do not match
+	// the filter of the request rather than a log entry. Consecutive log
+	// entries not matched are coalesced into a response, whose
+	// filtered_ranges are ranges of their GLSNs in ascending order, so that
+	// the client, which merges log streams in the order of GLSN, can move on
+	// past them. Only the GLSN, which is the beginning of the first range, is
+	// set in that case.
+	Filtered       bool                          `protobuf:"varint,5,opt,name=filtered,proto3" json:"filtered,omitempty"`
+	FilteredRanges []SubscribeResponse_GLSNRange `protobuf:"bytes,6,rep,name=filtered_ranges,json=filteredRanges,proto3" json:"filtered_ranges"`
 }
 
 func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
 func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
 func (*SubscribeResponse) ProtoMessage()    {}
 func (*SubscribeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{6}
+	return fileDescriptor_7692726f23e518ee, []int{9}
 }
 func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,18 +823,95 @@ func (m *SubscribeResponse) GetPayload() []byte {
 	return nil
 }
 
+func (m *SubscribeResponse) GetHeaders() map[string][]byte {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *SubscribeResponse) GetFiltered() bool {
+	if m != nil {
+		return m.Filtered
+	}
+	return false
+}
+
+func (m *SubscribeResponse) GetFilteredRanges() []SubscribeResponse_GLSNRange {
+	if m != nil {
+		return m.FilteredRanges
+	}
+	return nil
+}
+
+// GLSNRange is a range of GLSNs, [begin, end).
+type SubscribeResponse_GLSNRange struct {
+	Begin github_com_kakao_varlog_pkg_types.GLSN `protobuf:"varint,1,opt,name=begin,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"begin,omitempty"`
+	End   github_com_kakao_varlog_pkg_types.GLSN `protobuf:"varint,2,opt,name=end,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"end,omitempty"`
+}
+
+func (m *SubscribeResponse_GLSNRange) Reset()         { *m = SubscribeResponse_GLSNRange{} }
+func (m *SubscribeResponse_GLSNRange) String() string { return proto.CompactTextString(m) }
+func (*SubscribeResponse_GLSNRange) ProtoMessage()    {}
+func (*SubscribeResponse_GLSNRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{9, 0}
+}
+func (m *SubscribeResponse_GLSNRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeResponse_GLSNRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeResponse_GLSNRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeResponse_GLSNRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeResponse_GLSNRange.Merge(m, src)
+}
+func (m *SubscribeResponse_GLSNRange) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SubscribeResponse_GLSNRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeResponse_GLSNRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeResponse_GLSNRange proto.InternalMessageInfo
+
+func (m *SubscribeResponse_GLSNRange) GetBegin() github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.Begin
+	}
+	return 0
+}
+
+func (m *SubscribeResponse_GLSNRange) GetEnd() github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
 type SubscribeToRequest struct {
 	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 	LLSNBegin   github_com_kakao_varlog_pkg_types.LLSN        `protobuf:"varint,3,opt,name=llsn_begin,json=llsnBegin,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn_begin,omitempty"`
 	LLSNEnd     github_com_kakao_varlog_pkg_types.LLSN        `protobuf:"varint,4,opt,name=llsn_end,json=llsnEnd,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn_end,omitempty"`
+	// filter selects log entries sent to the client. It is optional. Unlike
+	// Subscribe, log entries not matched are not sent at all.
+	Filter *SubscribeFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (m *SubscribeToRequest) Reset()         { *m = SubscribeToRequest{} }
 func (m *SubscribeToRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeToRequest) ProtoMessage()    {}
 func (*SubscribeToRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{7}
+	return fileDescriptor_7692726f23e518ee, []int{10}
 }
 func (m *SubscribeToRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,6 +968,13 @@ func (m *SubscribeToRequest) GetLLSNEnd() github_com_kakao_varlog_pkg_types.LLSN
 	return 0
 }
 
+func (m *SubscribeToRequest) GetFilter() *SubscribeFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
 type SubscribeToResponse struct {
 	LogEntry varlogpb.LogEntry `protobuf:"bytes,1,opt,name=log_entry,json=logEntry,proto3" json:"log_entry"`
 }
@@ -529,7 +983,7 @@ func (m *SubscribeToResponse) Reset()         { *m = SubscribeToResponse{} }
 func (m *SubscribeToResponse) String() string { return proto.CompactTextString(m) }
 func (*SubscribeToResponse) ProtoMessage()    {}
 func (*SubscribeToResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{8}
+	return fileDescriptor_7692726f23e518ee, []int{11}
 }
 func (m *SubscribeToResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,7 +1031,7 @@ func (m *TrimDeprecatedRequest) Reset()         { *m = TrimDeprecatedRequest{} }
 func (m *TrimDeprecatedRequest) String() string { return proto.CompactTextString(m) }
 func (*TrimDeprecatedRequest) ProtoMessage()    {}
 func (*TrimDeprecatedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{9}
+	return fileDescriptor_7692726f23e518ee, []int{12}
 }
 func (m *TrimDeprecatedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,7 +1083,7 @@ func (m *LogStreamMetadataRequest) Reset()         { *m = LogStreamMetadataReque
 func (m *LogStreamMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*LogStreamMetadataRequest) ProtoMessage()    {}
 func (*LogStreamMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{10}
+	return fileDescriptor_7692726f23e518ee, []int{13}
 }
 func (m *LogStreamMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -680,7 +1134,7 @@ func (m *LogStreamMetadataResponse) Reset()         { *m = LogStreamMetadataResp
 func (m *LogStreamMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*LogStreamMetadataResponse) ProtoMessage()    {}
 func (*LogStreamMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{11}
+	return fileDescriptor_7692726f23e518ee, []int{14}
 }
 func (m *LogStreamMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -725,7 +1179,7 @@ func (m *LogStreamReplicaMetadataRequest) Reset()         { *m = LogStreamReplic
 func (m *LogStreamReplicaMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*LogStreamReplicaMetadataRequest) ProtoMessage()    {}
 func (*LogStreamReplicaMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{12}
+	return fileDescriptor_7692726f23e518ee, []int{15}
 }
 func (m *LogStreamReplicaMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,7 +1230,7 @@ func (m *LogStreamReplicaMetadataResponse) Reset()         { *m = LogStreamRepli
 func (m *LogStreamReplicaMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*LogStreamReplicaMetadataResponse) ProtoMessage()    {}
 func (*LogStreamReplicaMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{13}
+	return fileDescriptor_7692726f23e518ee, []int{16}
 }
 func (m *LogStreamReplicaMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,14 +1266,138 @@ func (m *LogStreamReplicaMetadataResponse) GetLogStreamReplica() LogStreamReplic
 	return LogStreamReplicaMetadataDescriptor{}
 }
 
+type SeekTimeRequest struct {
+	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	Time        time.Time                                     `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *SeekTimeRequest) Reset()         { *m = SeekTimeRequest{} }
+func (m *SeekTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*SeekTimeRequest) ProtoMessage()    {}
+func (*SeekTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{17}
+}
+func (m *SeekTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeekTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SeekTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SeekTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeekTimeRequest.Merge(m, src)
+}
+func (m *SeekTimeRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SeekTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeekTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeekTimeRequest proto.InternalMessageInfo
+
+func (m *SeekTimeRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *SeekTimeRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
+}
+
+func (m *SeekTimeRequest) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+type SeekTimeResponse struct {
+	// glsn is the first GLSN of log entries committed at or after the time if
+	// found is true. Otherwise, it is the GLSN next to the global high
+	// watermark known to the log stream replica.
+	GLSN  github_com_kakao_varlog_pkg_types.GLSN `protobuf:"varint,1,opt,name=glsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn,omitempty"`
+	Found bool                                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+}
+
+func (m *SeekTimeResponse) Reset()         { *m = SeekTimeResponse{} }
+func (m *SeekTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*SeekTimeResponse) ProtoMessage()    {}
+func (*SeekTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{18}
+}
+func (m *SeekTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeekTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SeekTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SeekTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeekTimeResponse.Merge(m, src)
+}
+func (m *SeekTimeResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SeekTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeekTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeekTimeResponse proto.InternalMessageInfo
+
+func (m *SeekTimeResponse) GetGLSN() github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.GLSN
+	}
+	return 0
+}
+
+func (m *SeekTimeResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*AppendRequest)(nil), "varlog.snpb.AppendRequest")
+	proto.RegisterMapType((map[string]string)(nil), "varlog.snpb.AppendRequest.TraceContextEntry")
 	proto.RegisterType((*AppendResult)(nil), "varlog.snpb.AppendResult")
 	proto.RegisterType((*AppendResponse)(nil), "varlog.snpb.AppendResponse")
 	proto.RegisterType((*ReadRequest)(nil), "varlog.snpb.ReadRequest")
 	proto.RegisterType((*ReadResponse)(nil), "varlog.snpb.ReadResponse")
+	proto.RegisterMapType((map[string][]byte)(nil), "varlog.snpb.ReadResponse.HeadersEntry")
+	proto.RegisterType((*ReadBatchRequest)(nil), "varlog.snpb.ReadBatchRequest")
+	proto.RegisterType((*ReadBatchResponse)(nil), "varlog.snpb.ReadBatchResponse")
 	proto.RegisterType((*SubscribeRequest)(nil), "varlog.snpb.SubscribeRequest")
+	proto.RegisterType((*SubscribeFilter)(nil), "varlog.snpb.SubscribeFilter")
+	proto.RegisterMapType((map[string][]byte)(nil), "varlog.snpb.SubscribeFilter.HeadersEntry")
+	proto.RegisterType((*SubscribeFilter_ByteRange)(nil), "varlog.snpb.SubscribeFilter.ByteRange")
 	proto.RegisterType((*SubscribeResponse)(nil), "varlog.snpb.SubscribeResponse")
+	proto.RegisterMapType((map[string][]byte)(nil), "varlog.snpb.SubscribeResponse.HeadersEntry")
+	proto.RegisterType((*SubscribeResponse_GLSNRange)(nil), "varlog.snpb.SubscribeResponse.GLSNRange")
 	proto.RegisterType((*SubscribeToRequest)(nil), "varlog.snpb.SubscribeToRequest")
 	proto.RegisterType((*SubscribeToResponse)(nil), "varlog.snpb.SubscribeToResponse")
 	proto.RegisterType((*TrimDeprecatedRequest)(nil), "varlog.snpb.TrimDeprecatedRequest")
@@ -827,71 +1405,109 @@ func init() {
 	proto.RegisterType((*LogStreamMetadataResponse)(nil), "varlog.snpb.LogStreamMetadataResponse")
 	proto.RegisterType((*LogStreamReplicaMetadataRequest)(nil), "varlog.snpb.LogStreamReplicaMetadataRequest")
 	proto.RegisterType((*LogStreamReplicaMetadataResponse)(nil), "varlog.snpb.LogStreamReplicaMetadataResponse")
+	proto.RegisterType((*SeekTimeRequest)(nil), "varlog.snpb.SeekTimeRequest")
+	proto.RegisterType((*SeekTimeResponse)(nil), "varlog.snpb.SeekTimeResponse")
 }
 
 func init() { proto.RegisterFile("proto/snpb/log_io.proto", fileDescriptor_7692726f23e518ee) }
 
 var fileDescriptor_7692726f23e518ee = []byte{
-	// 932 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x1d, 0x77, 0xd3, 0xbc, 0x74, 0x57, 0xcb, 0x94, 0x65, 0x53, 0x2f, 0x1b, 0x47, 0x16,
-	0x42, 0x45, 0xa2, 0x36, 0x2a, 0x42, 0x0b, 0x52, 0x91, 0x20, 0xb4, 0x8b, 0x2a, 0xbc, 0x05, 0x39,
-	0xd5, 0x1e, 0x90, 0xa0, 0xb2, 0xe3, 0xc1, 0x58, 0x9d, 0x78, 0x8c, 0xed, 0x20, 0x45, 0xfc, 0x00,
-	0xae, 0xfb, 0x13, 0x10, 0xbf, 0x81, 0x03, 0x17, 0xee, 0x7b, 0xdc, 0x0b, 0x12, 0x07, 0x94, 0x43,
-	0xf2, 0x23, 0x10, 0x2b, 0x0e, 0x68, 0xc6, 0x63, 0xc7, 0x6e, 0x13, 0x6d, 0x23, 0xc8, 0x61, 0x7b,
-	0xf3, 0xcc, 0xbc, 0xf7, 0xf9, 0xbd, 0xef, 0x7d, 0x7a, 0xf3, 0x06, 0xee, 0x46, 0x31, 0x4d, 0xa9,
-	0x99, 0x84, 0x91, 0x6b, 0x12, 0xea, 0x9f, 0x05, 0xd4, 0xe0, 0x3b, 0xa8, 0xf5, 0xbd, 0x13, 0x13,
-	0xea, 0x1b, 0xec, 0x44, 0xdd, 0xf3, 0x83, 0xf4, 0xdb, 0x91, 0x6b, 0x0c, 0xe8, 0xd0, 0xf4, 0xa9,
-	0x4f, 0x4d, 0x6e, 0xe3, 0x8e, 0xbe, 0xe1, 0xab, 0x0c, 0x82, 0x7d, 0x65, 0xbe, 0xea, 0x3d, 0x9f,
-	0x52, 0x9f, 0xe0, 0xb9, 0x15, 0x1e, 0x46, 0xe9, 0x58, 0x1c, 0xde, 0xcd, 0x80, 0x23, 0xd7, 0x1c,
-	0xe2, 0xd4, 0xf1, 0x9c, 0xd4, 0x11, 0x07, 0xdb, 0x3c, 0x88, 0xea, 0xa6, 0xfe, 0xb3, 0x0c, 0x37,
-	0x3f, 0x8e, 0x22, 0x1c, 0x7a, 0x36, 0xfe, 0x6e, 0x84, 0x93, 0x14, 0xf5, 0x61, 0x33, 0xa5, 0x51,
-	0x30, 0x38, 0x0b, 0xbc, 0xb6, 0xd4, 0x95, 0x76, 0x37, 0x7a, 0xef, 0x4f, 0x27, 0x5a, 0xe3, 0x94,
-	0xed, 0x1d, 0x1f, 0x3e, 0x9f, 0x68, 0x6f, 0x95, 0x82, 0x3d, 0x77, 0xce, 0x1d, 0x6a, 0x66, 0x7f,
-	0x34, 0xa3, 0x73, 0xdf, 0x4c, 0xc7, 0x11, 0x4e, 0x0c, 0x61, 0x6c, 0x37, 0x38, 0xd2, 0xb1, 0x87,
-	0x3c, 0xb8, 0xc9, 0xb2, 0x4f, 0xd2, 0x18, 0x3b, 0x43, 0x86, 0x2c, 0x73, 0xe4, 0x8f, 0xa6, 0x13,
-	0xad, 0x65, 0x51, 0xbf, 0xcf, 0xf7, 0x39, 0xfa, 0xde, 0x8b, 0xd1, 0x4b, 0x0e, 0x76, 0x8b, 0x14,
-	0x0b, 0x0f, 0xb5, 0xa1, 0x11, 0x39, 0x63, 0x42, 0x1d, 0xaf, 0x5d, 0xef, 0xd6, 0x77, 0xb7, 0xec,
-	0x7c, 0x89, 0x0e, 0xa0, 0xe1, 0x3a, 0x83, 0xf3, 0x51, 0x94, 0xb4, 0x95, 0x6e, 0x7d, 0xb7, 0xb5,
-	0xff, 0xba, 0x21, 0xf8, 0xcf, 0xd9, 0x32, 0xfa, 0x29, 0x8d, 0x1d, 0x1f, 0x9f, 0x50, 0x0f, 0xf7,
-	0x94, 0xa7, 0x13, 0xad, 0x66, 0xe7, 0x2e, 0xfa, 0x57, 0xb0, 0x95, 0x73, 0x94, 0x8c, 0x48, 0x8a,
-	0x1e, 0x80, 0xc2, 0x68, 0xe4, 0xf4, 0xb4, 0xf6, 0xef, 0x5f, 0x82, 0xb2, 0xa8, 0x7f, 0x14, 0xa6,
-	0xf1, 0xf8, 0x11, 0x4e, 0x1d, 0x81, 0xc5, 0x1d, 0xd0, 0xab, 0xb0, 0x81, 0xe3, 0x98, 0xc6, 0x3c,
-	0xfd, 0xa6, 0x9d, 0x2d, 0xf4, 0xcf, 0xe0, 0x56, 0x01, 0x1f, 0xd1, 0x30, 0xc1, 0xe8, 0x03, 0x68,
-	0xc4, 0xfc, 0x57, 0x49, 0x5b, 0xe2, 0xe1, 0xee, 0x18, 0x25, 0xb9, 0x18, 0xe5, 0x60, 0xf2, 0x58,
-	0x85, 0xbd, 0xfe, 0x44, 0x86, 0x96, 0x8d, 0x9d, 0xa2, 0x9c, 0x0f, 0x41, 0xf1, 0x49, 0x12, 0xf2,
-	0x58, 0x95, 0xde, 0xfe, 0x74, 0xa2, 0x29, 0x9f, 0x5a, 0xfd, 0x93, 0xe7, 0x13, 0xed, 0xcd, 0x17,
-	0x33, 0xcd, 0x2c, 0x6d, 0xee, 0x5f, 0x91, 0x85, 0xbc, 0x36, 0x59, 0xd4, 0xd7, 0x20, 0x0b, 0xfd,
-	0x57, 0x09, 0xb6, 0x32, 0x4a, 0x04, 0xbd, 0xff, 0x17, 0x27, 0x0f, 0x41, 0x21, 0x0c, 0x47, 0x9e,
-	0xe3, 0x58, 0x57, 0xc6, 0xb1, 0x38, 0x0e, 0xf3, 0xaf, 0xea, 0x56, 0x2a, 0xe9, 0x56, 0xff, 0x4b,
-	0x86, 0xdb, 0xfd, 0x91, 0x9b, 0x0c, 0xe2, 0xc0, 0xc5, 0x79, 0x49, 0x1f, 0x03, 0xb0, 0xdf, 0x9f,
-	0xb9, 0xd8, 0x0f, 0xf2, 0x24, 0x1e, 0x4c, 0x27, 0x5a, 0x93, 0x85, 0xd6, 0x63, 0x9b, 0x2b, 0x64,
-	0xd2, 0x64, 0x50, 0xdc, 0x09, 0x7d, 0x01, 0x9b, 0x1c, 0x17, 0x87, 0x9e, 0x48, 0xe9, 0x3d, 0x56,
-	0x62, 0x66, 0x76, 0x14, 0x7a, 0x2b, 0x60, 0x36, 0x18, 0xcc, 0x51, 0xe8, 0x55, 0x44, 0x53, 0x5f,
-	0x9b, 0x68, 0x94, 0x75, 0x88, 0xe6, 0x37, 0x09, 0x5e, 0x29, 0x31, 0xff, 0xd2, 0x29, 0xe7, 0x6f,
-	0x19, 0x50, 0x11, 0xff, 0x29, 0xbd, 0x06, 0xdd, 0xfd, 0x31, 0x00, 0x99, 0xcb, 0xbe, 0x3e, 0x97,
-	0xbd, 0xb5, 0x9a, 0xec, 0x39, 0x7d, 0x4d, 0x52, 0x96, 0x3d, 0xc9, 0x65, 0xaf, 0xcc, 0x65, 0x6f,
-	0xad, 0x22, 0x7b, 0x8e, 0xd9, 0x20, 0x99, 0xec, 0xf5, 0x3e, 0x6c, 0x57, 0xa8, 0x17, 0xe2, 0x39,
-	0x80, 0x26, 0xa3, 0x09, 0xb3, 0xab, 0x41, 0xdc, 0x1d, 0x3b, 0x4b, 0xef, 0x0e, 0xd1, 0xd7, 0x37,
-	0x89, 0x58, 0xeb, 0xbf, 0x48, 0x70, 0xe7, 0x34, 0x0e, 0x86, 0x87, 0x38, 0x8a, 0xf1, 0xc0, 0x49,
-	0xf1, 0x7a, 0x6f, 0xec, 0x5c, 0xe9, 0xf2, 0x7f, 0x53, 0xba, 0xfe, 0xbb, 0x04, 0xed, 0xa2, 0xa4,
-	0x8f, 0xc4, 0xf0, 0xf1, 0xf2, 0xab, 0x51, 0xff, 0x01, 0x76, 0x16, 0xa4, 0x25, 0x2a, 0xfd, 0x35,
-	0xdc, 0x29, 0x85, 0xe0, 0x61, 0x26, 0x85, 0x28, 0xa5, 0xb1, 0xa8, 0xfa, 0x1b, 0x8b, 0xaa, 0x9e,
-	0x41, 0x1d, 0x16, 0xb6, 0x42, 0x00, 0xdb, 0xe4, 0xf2, 0x91, 0xfe, 0xa7, 0x04, 0x5a, 0xe1, 0x62,
-	0xe3, 0x88, 0x04, 0x03, 0xe7, 0x1a, 0x71, 0xfb, 0xa3, 0x04, 0xdd, 0xe5, 0xe9, 0x09, 0x8e, 0x07,
-	0x80, 0x4a, 0xa1, 0xc4, 0x99, 0x95, 0x20, 0xd8, 0xac, 0x8c, 0x4b, 0xcb, 0xa0, 0x2e, 0x71, 0x7d,
-	0x9b, 0x5c, 0xb0, 0xdc, 0xff, 0xa7, 0x0e, 0x1b, 0x16, 0xf5, 0x8f, 0x3f, 0x47, 0x9f, 0xc0, 0x8d,
-	0x6c, 0xec, 0x42, 0xea, 0xc2, 0x59, 0x8c, 0x93, 0xae, 0xde, 0x5b, 0x3c, 0xa7, 0xf1, 0x88, 0xf5,
-	0x1a, 0xfa, 0x10, 0x14, 0x36, 0x88, 0xa0, 0x76, 0xc5, 0xac, 0x34, 0xae, 0xa9, 0x3b, 0x0b, 0x4e,
-	0x0a, 0xf7, 0x13, 0x68, 0x16, 0x7d, 0x05, 0xdd, 0xaf, 0x58, 0x5e, 0x1c, 0x12, 0xd4, 0xce, 0xb2,
-	0xe3, 0x1c, 0xed, 0x1d, 0x09, 0x9d, 0x42, 0xab, 0xd4, 0xa7, 0x90, 0xb6, 0xd8, 0xa5, 0xb8, 0x3c,
-	0xd4, 0xee, 0x72, 0x83, 0x12, 0xea, 0x09, 0xdc, 0xaa, 0xf6, 0x29, 0xa4, 0x57, 0xfc, 0x16, 0x36,
-	0x31, 0xf5, 0x35, 0x23, 0x7b, 0xd4, 0x18, 0xf9, 0xa3, 0xc6, 0x38, 0x62, 0x8f, 0x1a, 0xbd, 0x86,
-	0xc6, 0xa5, 0x06, 0x72, 0xa1, 0x82, 0xe8, 0xed, 0x2b, 0x15, 0x3a, 0xff, 0xc7, 0xde, 0x15, 0xad,
-	0xf3, 0x64, 0x7a, 0x07, 0x4f, 0xa7, 0x1d, 0xe9, 0xd9, 0xb4, 0x23, 0x3d, 0x99, 0x75, 0x6a, 0x3f,
-	0xcd, 0x3a, 0xd2, 0xb3, 0x59, 0xa7, 0xf6, 0xc7, 0xac, 0x53, 0xfb, 0x52, 0x5f, 0x2a, 0xef, 0xe2,
-	0xbd, 0xe7, 0xde, 0xe0, 0xdf, 0xef, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x66, 0xf8, 0x83,
-	0x04, 0x0e, 0x00, 0x00,
+	// 1519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x25, 0xca, 0x92, 0x46, 0xfe, 0xca, 0xe6, 0x4b, 0x66, 0x12, 0x51, 0x2f, 0xf1, 0x22,
+	0xaf, 0xf2, 0x36, 0x91, 0x02, 0xa7, 0x45, 0x3e, 0xe0, 0xa2, 0xa9, 0x62, 0xa7, 0x15, 0x2a, 0xa7,
+	0x29, 0x25, 0xa4, 0x40, 0x81, 0xd6, 0xa0, 0xc8, 0x35, 0x2d, 0x88, 0x12, 0x59, 0x72, 0x15, 0x44,
+	0xe8, 0xbd, 0x45, 0xd1, 0x4b, 0x8e, 0x3d, 0xf6, 0x0f, 0x14, 0xbd, 0xf4, 0x1f, 0xf4, 0x92, 0x63,
+	0x50, 0xa0, 0x40, 0x0e, 0x85, 0x0a, 0xc8, 0x40, 0x7f, 0x44, 0x4e, 0xc5, 0x2e, 0x97, 0x14, 0xf5,
+	0x55, 0x47, 0x71, 0x7c, 0x88, 0x6f, 0xdc, 0xd9, 0x99, 0x67, 0x77, 0x67, 0x9e, 0x99, 0xe1, 0x2e,
+	0x9c, 0x77, 0x5c, 0x9b, 0xd8, 0x25, 0xaf, 0xe3, 0x34, 0x4a, 0x96, 0x6d, 0xee, 0x36, 0xed, 0x22,
+	0x93, 0xa0, 0xcc, 0x63, 0xcd, 0xb5, 0x6c, 0xb3, 0x48, 0x67, 0xa4, 0x6b, 0x66, 0x93, 0xec, 0x77,
+	0x1b, 0x45, 0xdd, 0x6e, 0x97, 0x4c, 0xdb, 0xb4, 0x4b, 0x4c, 0xa7, 0xd1, 0xdd, 0x63, 0x23, 0x1f,
+	0x82, 0x7e, 0xf9, 0xb6, 0xd2, 0x05, 0xd3, 0xb6, 0x4d, 0x0b, 0x0f, 0xb5, 0x70, 0xdb, 0x21, 0x3d,
+	0x3e, 0x29, 0x8f, 0x4f, 0x92, 0x66, 0x1b, 0x7b, 0x44, 0x6b, 0x3b, 0x5c, 0xe1, 0xbc, 0xbf, 0xb2,
+	0xd3, 0x28, 0xb5, 0x31, 0xd1, 0x0c, 0x8d, 0x68, 0x7c, 0xe2, 0xb4, 0xd7, 0x99, 0x10, 0x2a, 0x07,
+	0x22, 0x2c, 0x7f, 0xe8, 0x38, 0xb8, 0x63, 0xa8, 0xf8, 0xeb, 0x2e, 0xf6, 0x08, 0xaa, 0x41, 0x8a,
+	0xd8, 0x4e, 0x53, 0xdf, 0x6d, 0x1a, 0x59, 0x21, 0x2f, 0x14, 0x12, 0xe5, 0x5b, 0x83, 0xbe, 0x9c,
+	0xac, 0x53, 0x59, 0x65, 0xeb, 0x65, 0x5f, 0xbe, 0x12, 0x39, 0x4d, 0x4b, 0x6b, 0x69, 0x76, 0xc9,
+	0x5f, 0xb1, 0xe4, 0xb4, 0xcc, 0x12, 0xe9, 0x39, 0xd8, 0x2b, 0x72, 0x65, 0x35, 0xc9, 0x90, 0x2a,
+	0x06, 0x32, 0x60, 0x99, 0xba, 0xc7, 0x23, 0x2e, 0xd6, 0xda, 0x14, 0x39, 0xc6, 0x90, 0xef, 0x0e,
+	0xfa, 0x72, 0xa6, 0x6a, 0x9b, 0x35, 0x26, 0x67, 0xe8, 0xd7, 0x0e, 0x47, 0x8f, 0x18, 0xa8, 0x19,
+	0x2b, 0x1c, 0x18, 0x28, 0x0b, 0x49, 0x47, 0xeb, 0x59, 0xb6, 0x66, 0x64, 0xe3, 0xf9, 0x78, 0x61,
+	0x49, 0x0d, 0x86, 0x68, 0x13, 0x92, 0x0d, 0x4d, 0x6f, 0x75, 0x1d, 0x2f, 0x2b, 0xe6, 0xe3, 0x85,
+	0xcc, 0xc6, 0xc5, 0x22, 0x0f, 0x50, 0xe0, 0xad, 0x62, 0x8d, 0xd8, 0xae, 0x66, 0xe2, 0x07, 0xb6,
+	0x81, 0xcb, 0xe2, 0xb3, 0xbe, 0xbc, 0xa0, 0x06, 0x26, 0xe8, 0x2e, 0x24, 0xf7, 0xb1, 0x66, 0x60,
+	0xd7, 0xcb, 0x26, 0x98, 0x75, 0x7e, 0xc2, 0xba, 0x6a, 0x9b, 0xdb, 0x1d, 0xe2, 0xf6, 0x3e, 0xf6,
+	0xf5, 0x02, 0x04, 0x6e, 0x86, 0x4a, 0x90, 0x71, 0x5c, 0xdb, 0xe8, 0xea, 0xd8, 0xa5, 0xa7, 0x5f,
+	0xcc, 0x0b, 0x85, 0x74, 0x79, 0x65, 0xd0, 0x97, 0xe1, 0x21, 0x17, 0x57, 0xb6, 0x54, 0x08, 0x54,
+	0x2a, 0x06, 0x92, 0x20, 0xe5, 0xd1, 0x80, 0x74, 0x74, 0x9c, 0x4d, 0xe6, 0x85, 0x82, 0xa8, 0x86,
+	0x63, 0xf4, 0x19, 0x2c, 0x13, 0x57, 0xd3, 0xf1, 0xae, 0x6e, 0x77, 0x08, 0x7e, 0x42, 0xb2, 0x29,
+	0xb6, 0xa9, 0xab, 0xc5, 0x08, 0xe7, 0x8a, 0x23, 0x41, 0x2d, 0xd6, 0xa9, 0xfe, 0x3d, 0x5f, 0x9d,
+	0xed, 0x53, 0x5d, 0x22, 0x11, 0x11, 0xba, 0x08, 0x69, 0x7d, 0x1f, 0xeb, 0x2d, 0xaf, 0xdb, 0xf6,
+	0xb2, 0xe9, 0x7c, 0xbc, 0x90, 0x54, 0x87, 0x02, 0xe9, 0x03, 0x38, 0x35, 0x01, 0x80, 0xd6, 0x20,
+	0xde, 0xc2, 0x3d, 0x46, 0x91, 0xb4, 0x4a, 0x3f, 0xd1, 0x19, 0x48, 0x3c, 0xd6, 0xac, 0x2e, 0x66,
+	0xc1, 0x4d, 0xab, 0xfe, 0xe0, 0x4e, 0xec, 0x96, 0xa0, 0x7c, 0x09, 0x4b, 0xc1, 0x7e, 0xbc, 0xae,
+	0x45, 0xd0, 0x4d, 0x10, 0x29, 0x0f, 0x99, 0x71, 0x66, 0xe3, 0xd2, 0x4c, 0x6f, 0xee, 0x60, 0xa2,
+	0x71, 0x57, 0x32, 0x03, 0xba, 0x04, 0x76, 0x5d, 0xdb, 0x0d, 0x96, 0x60, 0x03, 0xe5, 0x13, 0x58,
+	0x09, 0xe1, 0x1d, 0xbb, 0xe3, 0x61, 0x74, 0x1b, 0x92, 0x2e, 0x5b, 0xca, 0xcb, 0x0a, 0xcc, 0x39,
+	0xeb, 0x53, 0x9d, 0x43, 0x35, 0x82, 0x50, 0x71, 0x7d, 0xe5, 0x45, 0x0c, 0x32, 0x2a, 0xd6, 0xc2,
+	0x7c, 0xb8, 0x0f, 0xa2, 0x69, 0x79, 0x1d, 0xb6, 0x57, 0xb1, 0xbc, 0x31, 0xe8, 0xcb, 0xe2, 0x47,
+	0xd5, 0xda, 0x83, 0x97, 0x7d, 0xf9, 0xf2, 0xe1, 0x54, 0xa5, 0x9a, 0x2a, 0xb3, 0x1f, 0xc9, 0xab,
+	0xd8, 0xb1, 0xe5, 0x55, 0xfc, 0x38, 0xf2, 0xea, 0x3e, 0x88, 0x16, 0x75, 0x81, 0x38, 0x74, 0x41,
+	0xf5, 0x95, 0x5d, 0x50, 0x65, 0x2e, 0xa0, 0xf6, 0xca, 0xef, 0x31, 0x58, 0xf2, 0x5d, 0xcb, 0xc3,
+	0xf4, 0xa6, 0x7c, 0x1b, 0x6c, 0x30, 0x76, 0xb4, 0x0d, 0x8e, 0x16, 0x10, 0x21, 0x5a, 0x40, 0x22,
+	0x25, 0xc0, 0x2f, 0x20, 0x97, 0x47, 0x08, 0x15, 0x3d, 0x55, 0x91, 0xd7, 0x00, 0x3f, 0xcf, 0xc2,
+	0x12, 0x20, 0x41, 0x6a, 0xaf, 0x69, 0x11, 0xec, 0x62, 0x23, 0x9b, 0xc8, 0x0b, 0x85, 0x94, 0x1a,
+	0x8e, 0xa5, 0x3b, 0xb0, 0x14, 0x35, 0x3a, 0x2c, 0xb7, 0x96, 0xa2, 0xb9, 0xf5, 0x63, 0x0c, 0xd6,
+	0xe8, 0xf2, 0x65, 0x8d, 0xe8, 0xfb, 0x27, 0xa0, 0x88, 0x57, 0x20, 0x41, 0x63, 0xea, 0xb1, 0x12,
+	0x2e, 0x96, 0x6f, 0x0c, 0xfa, 0x72, 0x82, 0x86, 0xda, 0x9b, 0x83, 0x15, 0x3e, 0x82, 0xd2, 0x82,
+	0x53, 0x11, 0xcf, 0x70, 0xce, 0x6d, 0x42, 0x9a, 0x9e, 0x02, 0x53, 0x47, 0xf3, 0x02, 0xb4, 0x3e,
+	0xb3, 0x00, 0xf1, 0xe2, 0x90, 0xb2, 0xf8, 0x98, 0x32, 0x84, 0xb8, 0xcd, 0x76, 0x1b, 0xfb, 0xa7,
+	0x4f, 0xa9, 0xc1, 0x50, 0xf9, 0x39, 0x0e, 0x6b, 0xb5, 0x6e, 0xc3, 0xd3, 0xdd, 0x66, 0x03, 0x07,
+	0x71, 0x78, 0x04, 0x40, 0xb7, 0xb2, 0xdb, 0xc0, 0x66, 0x33, 0xa0, 0xf9, 0xcd, 0x41, 0x5f, 0x4e,
+	0xd3, 0x6d, 0x96, 0xa9, 0x70, 0x8e, 0x53, 0xa5, 0x29, 0x14, 0x33, 0x42, 0x0f, 0x21, 0xc5, 0x70,
+	0x71, 0xc7, 0xe0, 0xa4, 0x7f, 0x8f, 0xc6, 0x97, 0xaa, 0x6d, 0x77, 0x8c, 0x39, 0x30, 0x93, 0x14,
+	0x66, 0xbb, 0x63, 0x8c, 0x30, 0x26, 0x7e, 0x6c, 0x8c, 0x11, 0x8f, 0x83, 0x31, 0xef, 0xc2, 0xa2,
+	0x9f, 0x49, 0x2c, 0xaf, 0x22, 0xbd, 0x9d, 0xa5, 0x66, 0x18, 0x93, 0xfb, 0x4c, 0x47, 0xe5, 0xba,
+	0xca, 0xf7, 0x71, 0x58, 0x1d, 0x9b, 0x43, 0xf7, 0x86, 0x59, 0xee, 0xb7, 0x8d, 0x2b, 0xff, 0x06,
+	0x35, 0x23, 0xd1, 0x65, 0xc8, 0xd0, 0x1f, 0xac, 0x5d, 0xc7, 0xc5, 0x7b, 0xcd, 0x27, 0x3c, 0x61,
+	0x81, 0x8a, 0x1e, 0x32, 0x09, 0xda, 0xe1, 0x0a, 0xae, 0xd6, 0x31, 0xb1, 0xcf, 0xf3, 0xf1, 0x7a,
+	0x32, 0xbe, 0x52, 0xb9, 0x47, 0xb0, 0x4a, 0xd5, 0x39, 0x21, 0x19, 0x1c, 0x13, 0x78, 0xe8, 0x3f,
+	0xb0, 0x84, 0x9f, 0xe8, 0x56, 0xd7, 0xc0, 0xbb, 0x54, 0xca, 0x7c, 0x9c, 0x52, 0x33, 0x5c, 0xb6,
+	0xa5, 0x11, 0x0d, 0xfd, 0x0f, 0x56, 0x03, 0x95, 0xe1, 0x8f, 0x0c, 0xd5, 0x5a, 0xe1, 0x62, 0x7e,
+	0x12, 0xe9, 0x36, 0xa4, 0xc3, 0xa5, 0xd0, 0x39, 0x58, 0xb4, 0xf7, 0xf6, 0x3c, 0x4c, 0x18, 0x71,
+	0x97, 0x55, 0x3e, 0x9a, 0x5e, 0x8b, 0x8e, 0x54, 0xc3, 0x7e, 0x13, 0xe1, 0x54, 0x24, 0x77, 0xde,
+	0xba, 0xee, 0xb0, 0x3d, 0xde, 0x1d, 0xde, 0x99, 0x1e, 0xcd, 0xd7, 0x6f, 0x11, 0xe8, 0x73, 0x58,
+	0x0d, 0xbe, 0x03, 0xe2, 0x2c, 0xb2, 0xa5, 0x0a, 0x87, 0x2c, 0xc5, 0x1c, 0x11, 0xa1, 0xce, 0x4a,
+	0x00, 0xc3, 0x84, 0x9e, 0xf4, 0x83, 0x00, 0xe9, 0x50, 0x07, 0xdd, 0x85, 0x44, 0xb4, 0x56, 0xfd,
+	0x7f, 0x9e, 0xa2, 0xcb, 0x0c, 0xd1, 0x26, 0xc4, 0x87, 0x55, 0x69, 0x1e, 0x7b, 0x6a, 0x76, 0x24,
+	0x16, 0xfd, 0x12, 0x07, 0x14, 0x9e, 0xbf, 0x6e, 0x9f, 0x80, 0x5e, 0xf8, 0x08, 0xc0, 0x1a, 0xb6,
+	0x8f, 0xf8, 0xb0, 0x7d, 0x54, 0xe7, 0x6b, 0x1f, 0x8c, 0xc4, 0x69, 0x2b, 0xda, 0x3e, 0xac, 0xa0,
+	0x7d, 0x88, 0xc3, 0xf6, 0x51, 0x9d, 0xa7, 0x7d, 0x30, 0xcc, 0xa4, 0xc5, 0xdb, 0xc7, 0xeb, 0xd5,
+	0xe0, 0x1a, 0x9c, 0x1e, 0x09, 0xd8, 0x9b, 0x68, 0xd1, 0xca, 0xaf, 0x02, 0x9c, 0xad, 0xbb, 0xcd,
+	0xf6, 0x16, 0x76, 0x5c, 0xac, 0x6b, 0x04, 0x1f, 0xef, 0xd5, 0x36, 0xa8, 0x52, 0xb1, 0xa3, 0x55,
+	0x29, 0xe5, 0x0f, 0x01, 0xb2, 0x21, 0x11, 0x76, 0xf8, 0x2d, 0xfd, 0xed, 0xe7, 0xb0, 0xf2, 0x0d,
+	0xac, 0x4f, 0x39, 0x16, 0x8f, 0xf4, 0x57, 0x70, 0x36, 0xb2, 0x05, 0x03, 0x53, 0x2a, 0x38, 0xc4,
+	0x76, 0x79, 0xd4, 0xff, 0x3b, 0x2d, 0xea, 0x3e, 0xd4, 0x56, 0xa8, 0xcb, 0x09, 0x70, 0xda, 0x9a,
+	0x9c, 0x52, 0xfe, 0x14, 0x40, 0x0e, 0x4d, 0x54, 0xec, 0x58, 0x4d, 0x5d, 0x3b, 0x41, 0xbe, 0xfd,
+	0x4e, 0x80, 0xfc, 0xec, 0xe3, 0x71, 0x1f, 0xeb, 0x80, 0x22, 0x5b, 0x71, 0x7d, 0x2d, 0xee, 0xe0,
+	0xd2, 0x48, 0x9a, 0xce, 0x82, 0x9a, 0xf0, 0xf5, 0x9a, 0x35, 0xa6, 0xa9, 0x7c, 0x1b, 0x83, 0xd5,
+	0x1a, 0xc6, 0xad, 0x7a, 0xb3, 0x8d, 0x4f, 0x40, 0xe1, 0xbd, 0x05, 0x22, 0x7d, 0x57, 0x63, 0x25,
+	0x37, 0xb3, 0x21, 0x15, 0xfd, 0x47, 0xb7, 0x62, 0xf0, 0xe8, 0x56, 0xac, 0x07, 0x8f, 0x6e, 0xe5,
+	0x14, 0x75, 0xc8, 0xd3, 0xbf, 0x64, 0x41, 0x65, 0x16, 0x8a, 0x03, 0x6b, 0x43, 0x3f, 0xbc, 0xe1,
+	0x1f, 0x99, 0x33, 0x90, 0xd8, 0xb3, 0xbb, 0x9d, 0xe0, 0xea, 0xe1, 0x0f, 0x36, 0xfe, 0x4e, 0x40,
+	0xa2, 0x6a, 0x9b, 0x95, 0x4f, 0xd1, 0x3d, 0x58, 0xf4, 0x5f, 0x36, 0x90, 0x34, 0xfb, 0x2d, 0x48,
+	0xba, 0x30, 0x75, 0xce, 0xdf, 0xaa, 0xb2, 0x80, 0x76, 0x82, 0xb7, 0x1a, 0xdf, 0x19, 0x47, 0x80,
+	0x2a, 0x08, 0xd7, 0x05, 0xf4, 0x3e, 0x88, 0xf4, 0x0e, 0x86, 0xb2, 0x53, 0xee, 0xcb, 0x3e, 0xc8,
+	0xfa, 0xcc, 0x9b, 0xb4, 0xb2, 0x80, 0x1e, 0x40, 0x3a, 0xbc, 0xc2, 0xa1, 0x4b, 0x13, 0x9a, 0xd1,
+	0x4b, 0xaf, 0x94, 0x9b, 0x35, 0x1d, 0xa0, 0x5d, 0x17, 0x28, 0x5e, 0xd8, 0x71, 0xc6, 0xf0, 0xc6,
+	0x2f, 0x6f, 0x52, 0x6e, 0xd6, 0x74, 0x04, 0xaf, 0x0e, 0x99, 0x48, 0x07, 0x43, 0xf2, 0x74, 0x93,
+	0xf0, 0x67, 0x44, 0xca, 0xcf, 0x56, 0x18, 0xd9, 0xe5, 0xca, 0x68, 0x07, 0x43, 0xca, 0x88, 0xdd,
+	0xd4, 0xf6, 0x26, 0x9d, 0x9b, 0xa0, 0xe9, 0x36, 0x7d, 0x38, 0x56, 0x16, 0x50, 0x05, 0x52, 0x01,
+	0x29, 0xd1, 0x58, 0x67, 0x1e, 0xcd, 0x59, 0xe9, 0xd2, 0x8c, 0xd9, 0x30, 0x20, 0xbd, 0x48, 0x97,
+	0x1a, 0x2b, 0x13, 0xe8, 0xea, 0x2b, 0x55, 0x93, 0x60, 0xa9, 0x6b, 0xaf, 0xa8, 0x1d, 0x2c, 0x5d,
+	0xde, 0x7c, 0x36, 0xc8, 0x09, 0xcf, 0x07, 0x39, 0xe1, 0xe9, 0x41, 0x6e, 0xe1, 0xa7, 0x83, 0x9c,
+	0xf0, 0xfc, 0x20, 0xb7, 0xf0, 0xe2, 0x20, 0xb7, 0xf0, 0x85, 0x32, 0x33, 0x8d, 0xc2, 0xe7, 0xf9,
+	0xc6, 0x22, 0xfb, 0xbe, 0xf1, 0xcf, 0x00, 0x51, 0xac, 0x0a, 0xf2, 0xb3, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -919,6 +1535,10 @@ type LogIOClient interface {
 	// - FailedPrecondition: The log stream may be sealed; thus, clients cannot
 	// write the log entry. Clients should unseal the log stream to append a log
 	// entry to the log stream.
+	// - AlreadyExists: The idempotent producer specified by producer_id has
+	// already appended log entries with sequence numbers newer than the
+	// sequence, and the log stream no longer remembers the result of the
+	// sequence.
 	// - Unavailable: The storage node is shutting down, or the log stream replica
 	// is not primary.
 	// - Canceled: The client canceled the request.
@@ -926,9 +1546,38 @@ type LogIOClient interface {
 	//
 	// FIXME: Partial failures are not specified by the gRPC error codes.
 	Append(ctx context.Context, in *AppendRequest, opts ...grpc.CallOption) (*AppendResponse, error)
-	// Read reads a log entry from the log stream specified by ReadRequest.
-	// Deprecated: Use Subscribe or SubscribeTo.
+	// AppendStream is similar to Append except that clients can send
+	// AppendRequests without waiting for responses of previous ones. The
+	// storage node appends log entries in the order of AppendRequests and sends
+	// an AppendResponse for each AppendRequest in the same order.
+	//
+	// Failures of an AppendRequest do not close the stream; instead, the
+	// AppendResponse has errors in results of the failed log entries. Note that
+	// AppendRequests for idempotent producers are not allowed in the stream.
+	AppendStream(ctx context.Context, opts ...grpc.CallOption) (LogIO_AppendStreamClient, error)
+	// Read reads a committed log entry from the log stream specified by
+	// ReadRequest.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: ReadRequest has invalid fields; for instance, both or
+	// neither of GLSN and LLSN are set.
+	// - NotFound: The log stream replica specified by the ReadRequest does not
+	// exist in the storage node, or the log stream replica does not have a
+	// committed log entry at the position.
+	// - OutOfRange: The log entry at the position is already trimmed.
+	// - Unavailable: The storage node is shutting down.
 	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
+	// ReadBatch reads committed log entries at the GLSNs specified by
+	// ReadBatchRequest from the log stream replica. It sends log entries in
+	// ascending order of GLSN and skips GLSNs that the log stream replica does
+	// not have.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: ReadBatchRequest has invalid fields.
+	// - NotFound: The log stream replica specified by the ReadBatchRequest does
+	// not exist in the storage node.
+	// - Unavailable: The storage node is shutting down.
+	ReadBatch(ctx context.Context, in *ReadBatchRequest, opts ...grpc.CallOption) (LogIO_ReadBatchClient, error)
 	// Subscribe reads a range of log entries specified by SubscribeRequest.
 	//
 	// It returns the following gRPC errors:
@@ -944,6 +1593,17 @@ type LogIOClient interface {
 	// LLSN.
 	SubscribeTo(ctx context.Context, in *SubscribeToRequest, opts ...grpc.CallOption) (LogIO_SubscribeToClient, error)
 	TrimDeprecated(ctx context.Context, in *TrimDeprecatedRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// SeekTime finds the first GLSN of log entries committed at or after the
+	// time specified by SeekTimeRequest in the log stream replica. The log
+	// stream replica keeps a time index that records the commit time of each
+	// commit.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: SeekTimeRequest has invalid fields.
+	// - NotFound: The log stream replica specified by the SeekTimeRequest does
+	// not exist in the storage node.
+	// - Unavailable: The storage node is shutting down.
+	SeekTime(ctx context.Context, in *SeekTimeRequest, opts ...grpc.CallOption) (*SeekTimeResponse, error)
 	// LogStreamReplicaMetadata returns metadata of the log stream replica
 	// specified by the LogStreamReplicaMetadataRequest.
 	LogStreamReplicaMetadata(ctx context.Context, in *LogStreamReplicaMetadataRequest, opts ...grpc.CallOption) (*LogStreamReplicaMetadataResponse, error)
@@ -966,6 +1626,37 @@ func (c *logIOClient) Append(ctx context.Context, in *AppendRequest, opts ...grp
 	return out, nil
 }
 
+func (c *logIOClient) AppendStream(ctx context.Context, opts ...grpc.CallOption) (LogIO_AppendStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_LogIO_serviceDesc.Streams[0], "/varlog.snpb.LogIO/AppendStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logIOAppendStreamClient{stream}
+	return x, nil
+}
+
+type LogIO_AppendStreamClient interface {
+	Send(*AppendRequest) error
+	Recv() (*AppendResponse, error)
+	grpc.ClientStream
+}
+
+type logIOAppendStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *logIOAppendStreamClient) Send(m *AppendRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *logIOAppendStreamClient) Recv() (*AppendResponse, error) {
+	m := new(AppendResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *logIOClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error) {
 	out := new(ReadResponse)
 	err := c.cc.Invoke(ctx, "/varlog.snpb.LogIO/Read", in, out, opts...)
@@ -975,8 +1666,40 @@ func (c *logIOClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *logIOClient) ReadBatch(ctx context.Context, in *ReadBatchRequest, opts ...grpc.CallOption) (LogIO_ReadBatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_LogIO_serviceDesc.Streams[1], "/varlog.snpb.LogIO/ReadBatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logIOReadBatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LogIO_ReadBatchClient interface {
+	Recv() (*ReadBatchResponse, error)
+	grpc.ClientStream
+}
+
+type logIOReadBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *logIOReadBatchClient) Recv() (*ReadBatchResponse, error) {
+	m := new(ReadBatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *logIOClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (LogIO_SubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_LogIO_serviceDesc.Streams[0], "/varlog.snpb.LogIO/Subscribe", opts...)
+	stream, err := c.cc.NewStream(ctx, &_LogIO_serviceDesc.Streams[2], "/varlog.snpb.LogIO/Subscribe", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1008,7 +1731,7 @@ func (x *logIOSubscribeClient) Recv() (*SubscribeResponse, error) {
 }
 
 func (c *logIOClient) SubscribeTo(ctx context.Context, in *SubscribeToRequest, opts ...grpc.CallOption) (LogIO_SubscribeToClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_LogIO_serviceDesc.Streams[1], "/varlog.snpb.LogIO/SubscribeTo", opts...)
+	stream, err := c.cc.NewStream(ctx, &_LogIO_serviceDesc.Streams[3], "/varlog.snpb.LogIO/SubscribeTo", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1048,6 +1771,15 @@ func (c *logIOClient) TrimDeprecated(ctx context.Context, in *TrimDeprecatedRequ
 	return out, nil
 }
 
+func (c *logIOClient) SeekTime(ctx context.Context, in *SeekTimeRequest, opts ...grpc.CallOption) (*SeekTimeResponse, error) {
+	out := new(SeekTimeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.LogIO/SeekTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *logIOClient) LogStreamReplicaMetadata(ctx context.Context, in *LogStreamReplicaMetadataRequest, opts ...grpc.CallOption) (*LogStreamReplicaMetadataResponse, error) {
 	out := new(LogStreamReplicaMetadataResponse)
 	err := c.cc.Invoke(ctx, "/varlog.snpb.LogIO/LogStreamReplicaMetadata", in, out, opts...)
@@ -1072,6 +1804,10 @@ type LogIOServer interface {
 	// - FailedPrecondition: The log stream may be sealed; thus, clients cannot
 	// write the log entry. Clients should unseal the log stream to append a log
 	// entry to the log stream.
+	// - AlreadyExists: The idempotent producer specified by producer_id has
+	// already appended log entries with sequence numbers newer than the
+	// sequence, and the log stream no longer remembers the result of the
+	// sequence.
 	// - Unavailable: The storage node is shutting down, or the log stream replica
 	// is not primary.
 	// - Canceled: The client canceled the request.
@@ -1079,9 +1815,38 @@ type LogIOServer interface {
 	//
 	// FIXME: Partial failures are not specified by the gRPC error codes.
 	Append(context.Context, *AppendRequest) (*AppendResponse, error)
-	// Read reads a log entry from the log stream specified by ReadRequest.
-	// Deprecated: Use Subscribe or SubscribeTo.
+	// AppendStream is similar to Append except that clients can send
+	// AppendRequests without waiting for responses of previous ones. The
+	// storage node appends log entries in the order of AppendRequests and sends
+	// an AppendResponse for each AppendRequest in the same order.
+	//
+	// Failures of an AppendRequest do not close the stream; instead, the
+	// AppendResponse has errors in results of the failed log entries. Note that
+	// AppendRequests for idempotent producers are not allowed in the stream.
+	AppendStream(LogIO_AppendStreamServer) error
+	// Read reads a committed log entry from the log stream specified by
+	// ReadRequest.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: ReadRequest has invalid fields; for instance, both or
+	// neither of GLSN and LLSN are set.
+	// - NotFound: The log stream replica specified by the ReadRequest does not
+	// exist in the storage node, or the log stream replica does not have a
+	// committed log entry at the position.
+	// - OutOfRange: The log entry at the position is already trimmed.
+	// - Unavailable: The storage node is shutting down.
 	Read(context.Context, *ReadRequest) (*ReadResponse, error)
+	// ReadBatch reads committed log entries at the GLSNs specified by
+	// ReadBatchRequest from the log stream replica. It sends log entries in
+	// ascending order of GLSN and skips GLSNs that the log stream replica does
+	// not have.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: ReadBatchRequest has invalid fields.
+	// - NotFound: The log stream replica specified by the ReadBatchRequest does
+	// not exist in the storage node.
+	// - Unavailable: The storage node is shutting down.
+	ReadBatch(*ReadBatchRequest, LogIO_ReadBatchServer) error
 	// Subscribe reads a range of log entries specified by SubscribeRequest.
 	//
 	// It returns the following gRPC errors:
@@ -1097,6 +1862,17 @@ type LogIOServer interface {
 	// LLSN.
 	SubscribeTo(*SubscribeToRequest, LogIO_SubscribeToServer) error
 	TrimDeprecated(context.Context, *TrimDeprecatedRequest) (*types.Empty, error)
+	// SeekTime finds the first GLSN of log entries committed at or after the
+	// time specified by SeekTimeRequest in the log stream replica. The log
+	// stream replica keeps a time index that records the commit time of each
+	// commit.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: SeekTimeRequest has invalid fields.
+	// - NotFound: The log stream replica specified by the SeekTimeRequest does
+	// not exist in the storage node.
+	// - Unavailable: The storage node is shutting down.
+	SeekTime(context.Context, *SeekTimeRequest) (*SeekTimeResponse, error)
 	// LogStreamReplicaMetadata returns metadata of the log stream replica
 	// specified by the LogStreamReplicaMetadataRequest.
 	LogStreamReplicaMetadata(context.Context, *LogStreamReplicaMetadataRequest) (*LogStreamReplicaMetadataResponse, error)
@@ -1109,9 +1885,15 @@ type UnimplementedLogIOServer struct {
 func (*UnimplementedLogIOServer) Append(ctx context.Context, req *AppendRequest) (*AppendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Append not implemented")
 }
+func (*UnimplementedLogIOServer) AppendStream(srv LogIO_AppendStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method AppendStream not implemented")
+}
 func (*UnimplementedLogIOServer) Read(ctx context.Context, req *ReadRequest) (*ReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
+func (*UnimplementedLogIOServer) ReadBatch(req *ReadBatchRequest, srv LogIO_ReadBatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadBatch not implemented")
+}
 func (*UnimplementedLogIOServer) Subscribe(req *SubscribeRequest, srv LogIO_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
@@ -1121,6 +1903,9 @@ func (*UnimplementedLogIOServer) SubscribeTo(req *SubscribeToRequest, srv LogIO_
 func (*UnimplementedLogIOServer) TrimDeprecated(ctx context.Context, req *TrimDeprecatedRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TrimDeprecated not implemented")
 }
+func (*UnimplementedLogIOServer) SeekTime(ctx context.Context, req *SeekTimeRequest) (*SeekTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeekTime not implemented")
+}
 func (*UnimplementedLogIOServer) LogStreamReplicaMetadata(ctx context.Context, req *LogStreamReplicaMetadataRequest) (*LogStreamReplicaMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LogStreamReplicaMetadata not implemented")
 }
@@ -1147,6 +1932,32 @@ func _LogIO_Append_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogIO_AppendStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LogIOServer).AppendStream(&logIOAppendStreamServer{stream})
+}
+
+type LogIO_AppendStreamServer interface {
+	Send(*AppendResponse) error
+	Recv() (*AppendRequest, error)
+	grpc.ServerStream
+}
+
+type logIOAppendStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *logIOAppendStreamServer) Send(m *AppendResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *logIOAppendStreamServer) Recv() (*AppendRequest, error) {
+	m := new(AppendRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _LogIO_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadRequest)
 	if err := dec(in); err != nil {
@@ -1165,6 +1976,27 @@ func _LogIO_Read_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogIO_ReadBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadBatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LogIOServer).ReadBatch(m, &logIOReadBatchServer{stream})
+}
+
+type LogIO_ReadBatchServer interface {
+	Send(*ReadBatchResponse) error
+	grpc.ServerStream
+}
+
+type logIOReadBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *logIOReadBatchServer) Send(m *ReadBatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _LogIO_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1225,6 +2057,24 @@ func _LogIO_TrimDeprecated_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogIO_SeekTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeekTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogIOServer).SeekTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.LogIO/SeekTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogIOServer).SeekTime(ctx, req.(*SeekTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LogIO_LogStreamReplicaMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LogStreamReplicaMetadataRequest)
 	if err := dec(in); err != nil {
@@ -1259,12 +2109,27 @@ var _LogIO_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TrimDeprecated",
 			Handler:    _LogIO_TrimDeprecated_Handler,
 		},
+		{
+			MethodName: "SeekTime",
+			Handler:    _LogIO_SeekTime_Handler,
+		},
 		{
 			MethodName: "LogStreamReplicaMetadata",
 			Handler:    _LogIO_LogStreamReplicaMetadata_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AppendStream",
+			Handler:       _LogIO_AppendStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReadBatch",
+			Handler:       _LogIO_ReadBatch_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Subscribe",
 			Handler:       _LogIO_Subscribe_Handler,
@@ -1299,6 +2164,60 @@ func (m *AppendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.Checksums[iNdEx]))
+		}
+		i = encodeVarintLogIo(dAtA, i, uint64(len(m.Checksums)*4))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.TraceContext) > 0 {
+		for k := range m.TraceContext {
+			v := m.TraceContext[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintLogIo(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLogIo(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLogIo(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ProducerID) > 0 {
+		i -= len(m.ProducerID)
+		copy(dAtA[i:], m.ProducerID)
+		i = encodeVarintLogIo(dAtA, i, uint64(len(m.ProducerID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogIo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Backups) > 0 {
 		for iNdEx := len(m.Backups) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1432,6 +2351,11 @@ func (m *ReadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LLSN != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.LLSN))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.LogStreamID != 0 {
 		i = encodeVarintLogIo(dAtA, i, uint64(m.LogStreamID))
 		i--
@@ -1470,6 +2394,37 @@ func (m *ReadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Filtered {
+		i--
+		if m.Filtered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintLogIo(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLogIo(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLogIo(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Payload) > 0 {
 		i -= len(m.Payload)
 		copy(dAtA[i:], m.Payload)
@@ -1490,7 +2445,7 @@ func (m *ReadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+func (m *ReadBatchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1500,40 +2455,48 @@ func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReadBatchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReadBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.GLSNs) > 0 {
+		dAtA3 := make([]byte, len(m.GLSNs)*10)
+		var j2 int
+		for _, num := range m.GLSNs {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintLogIo(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.LogStreamID != 0 {
 		i = encodeVarintLogIo(dAtA, i, uint64(m.LogStreamID))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x10
 	}
 	if m.TopicID != 0 {
 		i = encodeVarintLogIo(dAtA, i, uint64(m.TopicID))
 		i--
-		dAtA[i] = 0x18
-	}
-	if m.GLSNEnd != 0 {
-		i = encodeVarintLogIo(dAtA, i, uint64(m.GLSNEnd))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.GLSNBegin != 0 {
-		i = encodeVarintLogIo(dAtA, i, uint64(m.GLSNBegin))
-		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SubscribeResponse) Marshal() (dAtA []byte, err error) {
+func (m *ReadBatchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1543,18 +2506,281 @@ func (m *SubscribeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubscribeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReadBatchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubscribeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReadBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
+	if m.Trimmed {
+		i--
+		if m.Trimmed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.LogEntry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLogIo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLogIo(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GLSNEnd != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.GLSNEnd))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GLSNBegin != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.GLSNBegin))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeFilter) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExcludeHeaders {
+		i--
+		if m.ExcludeHeaders {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExcludeData {
+		i--
+		if m.ExcludeData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DataRanges) > 0 {
+		for iNdEx := len(m.DataRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogIo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DataPrefix) > 0 {
+		i -= len(m.DataPrefix)
+		copy(dAtA[i:], m.DataPrefix)
+		i = encodeVarintLogIo(dAtA, i, uint64(len(m.DataPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintLogIo(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLogIo(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLogIo(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeFilter_ByteRange) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeFilter_ByteRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeFilter_ByteRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintLogIo(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Offset != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FilteredRanges) > 0 {
+		for iNdEx := len(m.FilteredRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FilteredRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogIo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Filtered {
+		i--
+		if m.Filtered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintLogIo(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLogIo(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLogIo(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
 		copy(dAtA[i:], m.Payload)
 		i = encodeVarintLogIo(dAtA, i, uint64(len(m.Payload)))
 		i--
@@ -1573,6 +2799,39 @@ func (m *SubscribeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SubscribeResponse_GLSNRange) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeResponse_GLSNRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeResponse_GLSNRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Begin != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.Begin))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SubscribeToRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1593,6 +2852,18 @@ func (m *SubscribeToRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLogIo(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.LLSNEnd != 0 {
 		i = encodeVarintLogIo(dAtA, i, uint64(m.LLSNEnd))
 		i--
@@ -1814,41 +3085,144 @@ func (m *LogStreamReplicaMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLogIo(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLogIo(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SeekTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AppendRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SeekTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeekTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovLogIo(uint64(m.TopicID))
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err10 != nil {
+		return 0, err10
 	}
+	i -= n10
+	i = encodeVarintLogIo(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
 	if m.LogStreamID != 0 {
-		n += 1 + sovLogIo(uint64(m.LogStreamID))
-	}
-	if len(m.Payload) > 0 {
-		for _, b := range m.Payload {
-			l = len(b)
-			n += 1 + l + sovLogIo(uint64(l))
-		}
+		i = encodeVarintLogIo(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Backups) > 0 {
-		for _, e := range m.Backups {
+	if m.TopicID != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SeekTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeekTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeekTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GLSN != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.GLSN))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLogIo(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLogIo(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AppendRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovLogIo(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovLogIo(uint64(m.LogStreamID))
+	}
+	if len(m.Payload) > 0 {
+		for _, b := range m.Payload {
+			l = len(b)
+			n += 1 + l + sovLogIo(uint64(l))
+		}
+	}
+	if len(m.Backups) > 0 {
+		for _, e := range m.Backups {
+			l = e.ProtoSize()
+			n += 1 + l + sovLogIo(uint64(l))
+		}
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
 			l = e.ProtoSize()
 			n += 1 + l + sovLogIo(uint64(l))
 		}
 	}
+	l = len(m.ProducerID)
+	if l > 0 {
+		n += 1 + l + sovLogIo(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovLogIo(uint64(m.Sequence))
+	}
+	if len(m.TraceContext) > 0 {
+		for k, v := range m.TraceContext {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLogIo(uint64(len(k))) + 1 + len(v) + sovLogIo(uint64(len(v)))
+			n += mapEntrySize + 1 + sovLogIo(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Checksums) > 0 {
+		n += 1 + sovLogIo(uint64(len(m.Checksums)*4)) + len(m.Checksums)*4
+	}
 	return n
 }
 
@@ -1897,6 +3271,9 @@ func (m *ReadRequest) ProtoSize() (n int) {
 	if m.LogStreamID != 0 {
 		n += 1 + sovLogIo(uint64(m.LogStreamID))
 	}
+	if m.LLSN != 0 {
+		n += 1 + sovLogIo(uint64(m.LLSN))
+	}
 	return n
 }
 
@@ -1916,6 +3293,57 @@ func (m *ReadResponse) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLogIo(uint64(l))
 	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovLogIo(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovLogIo(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovLogIo(uint64(mapEntrySize))
+		}
+	}
+	if m.Filtered {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReadBatchRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovLogIo(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovLogIo(uint64(m.LogStreamID))
+	}
+	if len(m.GLSNs) > 0 {
+		l = 0
+		for _, e := range m.GLSNs {
+			l += sovLogIo(uint64(e))
+		}
+		n += 1 + sovLogIo(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ReadBatchResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LogEntry.ProtoSize()
+	n += 1 + l + sovLogIo(uint64(l))
+	if m.Trimmed {
+		n += 2
+	}
 	return n
 }
 
@@ -1937,6 +3365,63 @@ func (m *SubscribeRequest) ProtoSize() (n int) {
 	if m.LogStreamID != 0 {
 		n += 1 + sovLogIo(uint64(m.LogStreamID))
 	}
+	if m.Filter != nil {
+		l = m.Filter.ProtoSize()
+		n += 1 + l + sovLogIo(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscribeFilter) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovLogIo(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovLogIo(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovLogIo(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.DataPrefix)
+	if l > 0 {
+		n += 1 + l + sovLogIo(uint64(l))
+	}
+	if len(m.DataRanges) > 0 {
+		for _, e := range m.DataRanges {
+			l = e.ProtoSize()
+			n += 1 + l + sovLogIo(uint64(l))
+		}
+	}
+	if m.ExcludeData {
+		n += 2
+	}
+	if m.ExcludeHeaders {
+		n += 2
+	}
+	return n
+}
+
+func (m *SubscribeFilter_ByteRange) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovLogIo(uint64(m.Offset))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovLogIo(uint64(l))
+	}
 	return n
 }
 
@@ -1956,6 +3441,42 @@ func (m *SubscribeResponse) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLogIo(uint64(l))
 	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovLogIo(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovLogIo(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovLogIo(uint64(mapEntrySize))
+		}
+	}
+	if m.Filtered {
+		n += 2
+	}
+	if len(m.FilteredRanges) > 0 {
+		for _, e := range m.FilteredRanges {
+			l = e.ProtoSize()
+			n += 1 + l + sovLogIo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubscribeResponse_GLSNRange) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Begin != 0 {
+		n += 1 + sovLogIo(uint64(m.Begin))
+	}
+	if m.End != 0 {
+		n += 1 + sovLogIo(uint64(m.End))
+	}
 	return n
 }
 
@@ -1977,6 +3498,10 @@ func (m *SubscribeToRequest) ProtoSize() (n int) {
 	if m.LLSNEnd != 0 {
 		n += 1 + sovLogIo(uint64(m.LLSNEnd))
 	}
+	if m.Filter != nil {
+		l = m.Filter.ProtoSize()
+		n += 1 + l + sovLogIo(uint64(l))
+	}
 	return n
 }
 
@@ -2058,6 +3583,38 @@ func (m *LogStreamReplicaMetadataResponse) ProtoSize() (n int) {
 	return n
 }
 
+func (m *SeekTimeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovLogIo(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovLogIo(uint64(m.LogStreamID))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLogIo(uint64(l))
+	return n
+}
+
+func (m *SeekTimeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GLSN != 0 {
+		n += 1 + sovLogIo(uint64(m.GLSN))
+	}
+	if m.Found {
+		n += 2
+	}
+	return n
+}
+
 func sovLogIo(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2197,59 +3754,9 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLogIo(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLogIo
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AppendResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLogIo
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AppendResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppendResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2276,13 +3783,14 @@ func (m *AppendResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, varlogpb.LogEntryHeaders{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2310,13 +3818,326 @@ func (m *AppendResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.ProducerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLogIo(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceContext == nil {
+				m.TraceContext = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogIo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogIo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogIo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogIo(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TraceContext[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.Checksums = append(m.Checksums, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogIo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLogIo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLogIo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.Checksums) == 0 {
+					m.Checksums = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.Checksums = append(m.Checksums, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppendResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppendResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppendResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLogIo
@@ -2364,7 +4185,830 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, AppendResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
+			}
+			m.GLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSN", wireType)
+			}
+			m.LLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LLSN |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
+			}
+			m.GLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSN", wireType)
+			}
+			m.LLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LLSN |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogIo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogIo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogIo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogIo(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filtered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Filtered = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v github_com_kakao_varlog_pkg_types.GLSN
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogIo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GLSNs = append(m.GLSNs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogIo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLogIo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLogIo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GLSNs) == 0 {
+					m.GLSNs = make([]github_com_kakao_varlog_pkg_types.GLSN, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_kakao_varlog_pkg_types.GLSN
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogIo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GLSNs = append(m.GLSNs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSNs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LogEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trimmed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Trimmed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSNBegin", wireType)
+			}
+			m.GLSNBegin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GLSNBegin |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSNEnd", wireType)
+			}
+			m.GLSNEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GLSNEnd |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2391,8 +5035,10 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Results = append(m.Results, AppendResult{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Filter == nil {
+				m.Filter = &SubscribeFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2417,7 +5063,7 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadRequest) Unmarshal(dAtA []byte) error {
+func (m *SubscribeFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2440,17 +5086,17 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			m.GLSN = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2460,16 +5106,125 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogIo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogIo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogIo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogIo(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataPrefix", wireType)
 			}
-			m.TopicID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2479,16 +5234,65 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataPrefix = append(m.DataPrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataPrefix == nil {
+				m.DataPrefix = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRanges = append(m.DataRanges, SubscribeFilter_ByteRange{})
+			if err := m.DataRanges[len(m.DataRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeData", wireType)
 			}
-			m.LogStreamID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2498,11 +5302,32 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeData = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeHeaders", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ExcludeHeaders = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogIo(dAtA[iNdEx:])
@@ -2524,7 +5349,7 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadResponse) Unmarshal(dAtA []byte) error {
+func (m *SubscribeFilter_ByteRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2547,17 +5372,17 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ByteRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ByteRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			m.GLSN = 0
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2567,33 +5392,14 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				m.Offset |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LLSN", wireType)
-			}
-			m.LLSN = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLogIo
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LLSN |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2620,9 +5426,9 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2646,7 +5452,7 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2669,17 +5475,17 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GLSNBegin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
 			}
-			m.GLSNBegin = 0
+			m.GLSN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2689,16 +5495,16 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GLSNBegin |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GLSNEnd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSN", wireType)
 			}
-			m.GLSNEnd = 0
+			m.LLSN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2708,16 +5514,16 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GLSNEnd |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				m.LLSN |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			m.TopicID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2727,16 +5533,159 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogIo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogIo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogIo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogIo(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogIo
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filtered", wireType)
 			}
-			m.LogStreamID = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2746,11 +5695,46 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Filtered = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilteredRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilteredRanges = append(m.FilteredRanges, SubscribeResponse_GLSNRange{})
+			if err := m.FilteredRanges[len(m.FilteredRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogIo(dAtA[iNdEx:])
@@ -2772,7 +5756,7 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
+func (m *SubscribeResponse_GLSNRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2795,36 +5779,17 @@ func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GLSNRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GLSNRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
-			}
-			m.GLSN = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLogIo
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LLSN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
 			}
-			m.LLSN = 0
+			m.Begin = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2834,16 +5799,16 @@ func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LLSN |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				m.Begin |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			var byteLen int
+			m.End = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2853,26 +5818,11 @@ func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.End |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthLogIo
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLogIo
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogIo(dAtA[iNdEx:])
@@ -2999,6 +5949,42 @@ func (m *SubscribeToRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &SubscribeFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogIo(dAtA[iNdEx:])
@@ -3533,6 +6519,216 @@ func (m *LogStreamReplicaMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SeekTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeekTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeekTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeekTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeekTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeekTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
+			}
+			m.GLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLogIo(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,127 @@
+package snpb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func TestSubscribeFilterMatch(t *testing.T) {
+	le := varlogpb.LogEntry{
+		Data:    []byte("hello, world"),
+		Headers: map[string][]byte{"type": []byte("greeting")},
+	}
+
+	tcs := []struct {
+		name     string
+		filter   *SubscribeFilter
+		expected bool
+	}{
+		{
+			name:     "Nil",
+			filter:   nil,
+			expected: true,
+		},
+		{
+			name:     "Empty",
+			filter:   &SubscribeFilter{},
+			expected: true,
+		},
+		{
+			name:     "HeaderMatched",
+			filter:   &SubscribeFilter{Headers: map[string][]byte{"type": []byte("greeting")}},
+			expected: true,
+		},
+		{
+			name:     "HeaderValueNotMatched",
+			filter:   &SubscribeFilter{Headers: map[string][]byte{"type": []byte("farewell")}},
+			expected: false,
+		},
+		{
+			name:     "HeaderNotExist",
+			filter:   &SubscribeFilter{Headers: map[string][]byte{"lang": []byte("en")}},
+			expected: false,
+		},
+		{
+			name:     "PrefixMatched",
+			filter:   &SubscribeFilter{DataPrefix: []byte("hello")},
+			expected: true,
+		},
+		{
+			name:     "PrefixNotMatched",
+			filter:   &SubscribeFilter{DataPrefix: []byte("world")},
+			expected: false,
+		},
+		{
+			name: "ByteRangeMatched",
+			filter: &SubscribeFilter{DataRanges: []SubscribeFilter_ByteRange{
+				{Offset: 7, Value: []byte("world")},
+			}},
+			expected: true,
+		},
+		{
+			name: "ByteRangeOutOfData",
+			filter: &SubscribeFilter{DataRanges: []SubscribeFilter_ByteRange{
+				{Offset: 10, Value: []byte("world")},
+			}},
+			expected: false,
+		},
+		{
+			name: "AllConditions",
+			filter: &SubscribeFilter{
+				Headers:    map[string][]byte{"type": []byte("greeting")},
+				DataPrefix: []byte("hello"),
+				DataRanges: []SubscribeFilter_ByteRange{
+					{Offset: 5, Value: []byte(",")},
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.filter.Match(le))
+		})
+	}
+}
+
+func TestSubscribeFilterProject(t *testing.T) {
+	newLogEntry := func() varlogpb.LogEntry {
+		return varlogpb.LogEntry{
+			Data:    []byte("data"),
+			Headers: map[string][]byte{"key": []byte("value")},
+		}
+	}
+
+	le := newLogEntry()
+	(*SubscribeFilter)(nil).Project(&le)
+	require.Equal(t, newLogEntry(), le)
+
+	le = newLogEntry()
+	(&SubscribeFilter{ExcludeData: true}).Project(&le)
+	require.Nil(t, le.Data)
+	require.NotNil(t, le.Headers)
+
+	le = newLogEntry()
+	(&SubscribeFilter{ExcludeHeaders: true}).Project(&le)
+	require.NotNil(t, le.Data)
+	require.Nil(t, le.Headers)
+
+	// Reserved headers are kept.
+	le = newLogEntry()
+	le.Headers[ReservedHeaderPrefix+"compression"] = []byte("gzip")
+	(&SubscribeFilter{ExcludeData: true, ExcludeHeaders: true}).Project(&le)
+	require.Nil(t, le.Data)
+	require.Equal(t, map[string][]byte{ReservedHeaderPrefix + "compression": []byte("gzip")}, le.Headers)
+}
+
+func TestSubscribeFilterHasDataPredicates(t *testing.T) {
+	require.False(t, (*SubscribeFilter)(nil).HasDataPredicates())
+	require.False(t, (&SubscribeFilter{Headers: map[string][]byte{"key": nil}, ExcludeData: true}).HasDataPredicates())
+	require.True(t, (&SubscribeFilter{DataPrefix: []byte("a")}).HasDataPredicates())
+	require.True(t, (&SubscribeFilter{DataRanges: []SubscribeFilter_ByteRange{{Value: []byte("a")}}}).HasDataPredicates())
+}
@@ -1,6 +1,9 @@
 package snpb
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/kakao/varlog/pkg/types"
 )
 
@@ -40,3 +43,62 @@ func (m *LogStreamUncommitReport) Seal(end types.LLSN) types.LLSN {
 
 	return m.UncommittedLLSNEnd()
 }
+
+const (
+	// TxnHeader is the key of the header that marks a log entry appended by
+	// a transaction. Its value is the encoded Txn.
+	TxnHeader = ReservedHeaderPrefix + "txn"
+	// TxnAbortedHeader is the key of the header that a log stream puts on
+	// log entries of an aborted transaction instead of their original
+	// headers. Log streams never deliver such log entries to readers.
+	TxnAbortedHeader = ReservedHeaderPrefix + "txn-aborted"
+)
+
+// ParseTxn decodes the value of the TxnHeader and validates it.
+func ParseTxn(value []byte) (Txn, error) {
+	var txn Txn
+	if err := txn.Unmarshal(value); err != nil {
+		return txn, fmt.Errorf("txn: %w", err)
+	}
+	if len(txn.ID) == 0 {
+		return txn, errors.New("txn: no id")
+	}
+	if len(txn.Participants) == 0 {
+		return txn, fmt.Errorf("txn %s: no participant", txn.ID)
+	}
+	seen := make(map[types.LogStreamID]struct{}, len(txn.Participants))
+	for i := range txn.Participants {
+		p := &txn.Participants[i]
+		if err := ValidateTopicLogStream(p); err != nil {
+			return txn, fmt.Errorf("txn %s: %w", txn.ID, err)
+		}
+		if _, ok := seen[p.LogStreamID]; ok {
+			return txn, fmt.Errorf("txn %s: duplicate log stream %d", txn.ID, p.LogStreamID)
+		}
+		seen[p.LogStreamID] = struct{}{}
+		if p.Length == 0 {
+			return txn, fmt.Errorf("txn %s: no log entry in log stream %d", txn.ID, p.LogStreamID)
+		}
+	}
+	if txn.Timeout <= 0 {
+		return txn, fmt.Errorf("txn %s: invalid timeout %s", txn.ID, txn.Timeout)
+	}
+	return txn, nil
+}
+
+// Participant returns the participant of the transaction for the log stream.
+func (m *Txn) Participant(tpid types.TopicID, lsid types.LogStreamID) (TxnParticipant, bool) {
+	for _, p := range m.Participants {
+		if p.TopicID == tpid && p.LogStreamID == lsid {
+			return p, true
+		}
+	}
+	return TxnParticipant{}, false
+}
+
+// IsTxnAborted returns true if the headers belong to a log entry of an
+// aborted transaction.
+func IsTxnAborted(headers map[string][]byte) bool {
+	_, ok := headers[TxnAbortedHeader]
+	return ok
+}
@@ -9,12 +9,16 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 
 	github_com_kakao_varlog_pkg_types "github.com/kakao/varlog/pkg/types"
 )
@@ -23,6 +27,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,6 +43,10 @@ type LogStreamUncommitReport struct {
 	UncommittedLLSNLength uint64                                        `protobuf:"varint,3,opt,name=uncommitted_llsn_length,json=uncommittedLlsnLength,proto3" json:"uncommitted_llsn_length,omitempty"`
 	Version               github_com_kakao_varlog_pkg_types.Version     `protobuf:"varint,4,opt,name=version,proto3,casttype=github.com/kakao/varlog/pkg/types.Version" json:"version,omitempty"`
 	HighWatermark         github_com_kakao_varlog_pkg_types.GLSN        `protobuf:"varint,5,opt,name=high_watermark,json=highWatermark,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"high_watermark,omitempty"`
+	// TxnSpans are runs of uncommitted log entries of transactions in the
+	// order of LLSN. The metadata repository holds them back until it can
+	// commit all log entries of each transaction at once.
+	TxnSpans []TxnSpan `protobuf:"bytes,6,rep,name=txn_spans,json=txnSpans,proto3" json:"txn_spans"`
 }
 
 func (m *LogStreamUncommitReport) Reset()         { *m = LogStreamUncommitReport{} }
@@ -108,6 +117,215 @@ func (m *LogStreamUncommitReport) GetHighWatermark() github_com_kakao_varlog_pkg
 	return 0
 }
 
+func (m *LogStreamUncommitReport) GetTxnSpans() []TxnSpan {
+	if m != nil {
+		return m.TxnSpans
+	}
+	return nil
+}
+
+// Txn describes a transaction that appends log entries to several log
+// streams atomically. It is encoded in the value of the varlog-txn header of
+// every log entry in the transaction.
+type Txn struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Participants are the log streams to which the transaction appends log
+	// entries.
+	Participants []TxnParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants"`
+	// Timeout is how long the metadata repository waits for all log entries
+	// of the transaction before aborting it. Storage nodes start counting it
+	// when they receive log entries of the transaction.
+	Timeout time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout"`
+}
+
+func (m *Txn) Reset()         { *m = Txn{} }
+func (m *Txn) String() string { return proto.CompactTextString(m) }
+func (*Txn) ProtoMessage()    {}
+func (*Txn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6a839cf0bdc32d5, []int{1}
+}
+func (m *Txn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Txn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Txn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Txn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Txn.Merge(m, src)
+}
+func (m *Txn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Txn) XXX_DiscardUnknown() {
+	xxx_messageInfo_Txn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Txn proto.InternalMessageInfo
+
+func (m *Txn) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Txn) GetParticipants() []TxnParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *Txn) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+type TxnParticipant struct {
+	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	// Length is the number of log entries that the transaction appends to the
+	// log stream.
+	Length uint64 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (m *TxnParticipant) Reset()         { *m = TxnParticipant{} }
+func (m *TxnParticipant) String() string { return proto.CompactTextString(m) }
+func (*TxnParticipant) ProtoMessage()    {}
+func (*TxnParticipant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6a839cf0bdc32d5, []int{2}
+}
+func (m *TxnParticipant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnParticipant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxnParticipant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnParticipant.Merge(m, src)
+}
+func (m *TxnParticipant) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TxnParticipant) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnParticipant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnParticipant proto.InternalMessageInfo
+
+func (m *TxnParticipant) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *TxnParticipant) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
+}
+
+func (m *TxnParticipant) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+// TxnSpan is a run of log entries of a transaction in a log stream, whose
+// range is [LLSNBegin, LLSNEnd).
+type TxnSpan struct {
+	Txn       Txn                                    `protobuf:"bytes,1,opt,name=txn,proto3" json:"txn"`
+	LLSNBegin github_com_kakao_varlog_pkg_types.LLSN `protobuf:"varint,2,opt,name=llsn_begin,json=llsnBegin,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn_begin,omitempty"`
+	LLSNEnd   github_com_kakao_varlog_pkg_types.LLSN `protobuf:"varint,3,opt,name=llsn_end,json=llsnEnd,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn_end,omitempty"`
+	// Deadline is when the transaction times out according to the clock of
+	// the storage node.
+	Deadline time.Time `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *TxnSpan) Reset()         { *m = TxnSpan{} }
+func (m *TxnSpan) String() string { return proto.CompactTextString(m) }
+func (*TxnSpan) ProtoMessage()    {}
+func (*TxnSpan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6a839cf0bdc32d5, []int{3}
+}
+func (m *TxnSpan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxnSpan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxnSpan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxnSpan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxnSpan.Merge(m, src)
+}
+func (m *TxnSpan) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TxnSpan) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxnSpan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxnSpan proto.InternalMessageInfo
+
+func (m *TxnSpan) GetTxn() Txn {
+	if m != nil {
+		return m.Txn
+	}
+	return Txn{}
+}
+
+func (m *TxnSpan) GetLLSNBegin() github_com_kakao_varlog_pkg_types.LLSN {
+	if m != nil {
+		return m.LLSNBegin
+	}
+	return 0
+}
+
+func (m *TxnSpan) GetLLSNEnd() github_com_kakao_varlog_pkg_types.LLSN {
+	if m != nil {
+		return m.LLSNEnd
+	}
+	return 0
+}
+
+func (m *TxnSpan) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
 type GetReportRequest struct {
 }
 
@@ -115,7 +333,7 @@ func (m *GetReportRequest) Reset()         { *m = GetReportRequest{} }
 func (m *GetReportRequest) String() string { return proto.CompactTextString(m) }
 func (*GetReportRequest) ProtoMessage()    {}
 func (*GetReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6a839cf0bdc32d5, []int{1}
+	return fileDescriptor_b6a839cf0bdc32d5, []int{4}
 }
 func (m *GetReportRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -153,7 +371,7 @@ func (m *GetReportResponse) Reset()         { *m = GetReportResponse{} }
 func (m *GetReportResponse) String() string { return proto.CompactTextString(m) }
 func (*GetReportResponse) ProtoMessage()    {}
 func (*GetReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6a839cf0bdc32d5, []int{2}
+	return fileDescriptor_b6a839cf0bdc32d5, []int{5}
 }
 func (m *GetReportResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,13 +527,17 @@ type LogStreamCommitResult struct {
 	// HighWatermark is the maximum GLSN across all log streams of the topic in a
 	// specific commit version.
 	HighWatermark github_com_kakao_varlog_pkg_types.GLSN `protobuf:"varint,7,opt,name=high_watermark,json=highWatermark,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"high_watermark,omitempty"`
+	// AbortedTxnSpans are runs of log entries in the commit range whose
+	// transactions are aborted. They are committed to keep LLSNs contiguous,
+	// but the log stream never delivers them to readers.
+	AbortedTxnSpans []TxnSpan `protobuf:"bytes,8,rep,name=aborted_txn_spans,json=abortedTxnSpans,proto3" json:"aborted_txn_spans"`
 }
 
 func (m *LogStreamCommitResult) Reset()         { *m = LogStreamCommitResult{} }
 func (m *LogStreamCommitResult) String() string { return proto.CompactTextString(m) }
 func (*LogStreamCommitResult) ProtoMessage()    {}
 func (*LogStreamCommitResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6a839cf0bdc32d5, []int{3}
+	return fileDescriptor_b6a839cf0bdc32d5, []int{6}
 }
 func (m *LogStreamCommitResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,6 +615,13 @@ func (m *LogStreamCommitResult) GetHighWatermark() github_com_kakao_varlog_pkg_t
 	return 0
 }
 
+func (m *LogStreamCommitResult) GetAbortedTxnSpans() []TxnSpan {
+	if m != nil {
+		return m.AbortedTxnSpans
+	}
+	return nil
+}
+
 type CommitRequest struct {
 	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
 	CommitResult  LogStreamCommitResult                           `protobuf:"bytes,2,opt,name=commit_result,json=commitResult,proto3" json:"commit_result"`
@@ -402,7 +631,7 @@ func (m *CommitRequest) Reset()         { *m = CommitRequest{} }
 func (m *CommitRequest) String() string { return proto.CompactTextString(m) }
 func (*CommitRequest) ProtoMessage()    {}
 func (*CommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6a839cf0bdc32d5, []int{4}
+	return fileDescriptor_b6a839cf0bdc32d5, []int{7}
 }
 func (m *CommitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,7 +681,7 @@ func (m *CommitResponse) Reset()         { *m = CommitResponse{} }
 func (m *CommitResponse) String() string { return proto.CompactTextString(m) }
 func (*CommitResponse) ProtoMessage()    {}
 func (*CommitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6a839cf0bdc32d5, []int{5}
+	return fileDescriptor_b6a839cf0bdc32d5, []int{8}
 }
 func (m *CommitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +719,7 @@ func (m *CommitBatchRequest) Reset()         { *m = CommitBatchRequest{} }
 func (m *CommitBatchRequest) String() string { return proto.CompactTextString(m) }
 func (*CommitBatchRequest) ProtoMessage()    {}
 func (*CommitBatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6a839cf0bdc32d5, []int{6}
+	return fileDescriptor_b6a839cf0bdc32d5, []int{9}
 }
 func (m *CommitBatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,7 +769,7 @@ func (m *CommitBatchResponse) Reset()         { *m = CommitBatchResponse{} }
 func (m *CommitBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*CommitBatchResponse) ProtoMessage()    {}
 func (*CommitBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6a839cf0bdc32d5, []int{7}
+	return fileDescriptor_b6a839cf0bdc32d5, []int{10}
 }
 func (m *CommitBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,6 +800,9 @@ var xxx_messageInfo_CommitBatchResponse proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*LogStreamUncommitReport)(nil), "varlog.snpb.LogStreamUncommitReport")
+	proto.RegisterType((*Txn)(nil), "varlog.snpb.Txn")
+	proto.RegisterType((*TxnParticipant)(nil), "varlog.snpb.TxnParticipant")
+	proto.RegisterType((*TxnSpan)(nil), "varlog.snpb.TxnSpan")
 	proto.RegisterType((*GetReportRequest)(nil), "varlog.snpb.GetReportRequest")
 	proto.RegisterType((*GetReportResponse)(nil), "varlog.snpb.GetReportResponse")
 	proto.RegisterType((*LogStreamCommitResult)(nil), "varlog.snpb.LogStreamCommitResult")
@@ -585,54 +817,73 @@ func init() {
 }
 
 var fileDescriptor_b6a839cf0bdc32d5 = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0xd3, 0xb4, 0x81, 0x0b, 0xe9, 0x9f, 0xab, 0xa2, 0x86, 0x20, 0xe2, 0xc8, 0xaa, 0x50,
-	0x40, 0x6a, 0x8c, 0xca, 0x82, 0x80, 0x01, 0xd2, 0xa2, 0x50, 0x11, 0x5a, 0xea, 0xb4, 0x20, 0xb1,
-	0x44, 0x4e, 0x7c, 0x75, 0xa2, 0x38, 0xbe, 0xe0, 0x3b, 0x17, 0x21, 0x36, 0x3e, 0x01, 0x1f, 0xa1,
-	0x1f, 0x85, 0xb1, 0x03, 0x43, 0x25, 0x96, 0x4e, 0x1e, 0x9c, 0x85, 0x95, 0xb5, 0x13, 0xf2, 0xd9,
-	0x71, 0xce, 0xc4, 0x51, 0x83, 0x2a, 0x95, 0xcd, 0xfe, 0xdd, 0xfb, 0xbd, 0x77, 0x3f, 0xdf, 0x7b,
-	0x97, 0x80, 0xf5, 0x81, 0x85, 0x29, 0x96, 0x89, 0x39, 0x68, 0xc9, 0x06, 0xd6, 0x9b, 0x84, 0x5a,
-	0x48, 0xed, 0x37, 0x2d, 0x34, 0xc0, 0x16, 0x45, 0x56, 0x85, 0x2d, 0xc3, 0xcc, 0xb1, 0x6a, 0x19,
-	0x58, 0xaf, 0x78, 0xb0, 0xc2, 0x86, 0xde, 0xa5, 0x1d, 0xbb, 0x55, 0x69, 0xe3, 0xbe, 0xac, 0x63,
-	0x1d, 0xcb, 0x0c, 0xd3, 0xb2, 0x8f, 0xd8, 0x9b, 0xcf, 0xe7, 0x3d, 0xf9, 0xbd, 0xd2, 0xef, 0x39,
-	0xb0, 0x56, 0xc7, 0x7a, 0x83, 0x11, 0x1f, 0x9a, 0x6d, 0xdc, 0xef, 0x77, 0xa9, 0xc2, 0xf8, 0xa1,
-	0x06, 0xb2, 0x9c, 0x68, 0x57, 0xcb, 0x0b, 0x25, 0xa1, 0x3c, 0x5f, 0x7d, 0xee, 0x3a, 0x62, 0x26,
-	0xec, 0xd9, 0xd9, 0xbe, 0x70, 0x44, 0x5e, 0xb4, 0xa7, 0xf6, 0x54, 0x2c, 0xfb, 0x5b, 0x92, 0x07,
-	0x3d, 0x5d, 0xa6, 0x9f, 0x07, 0x88, 0x54, 0xb8, 0x06, 0x25, 0x63, 0x84, 0x2f, 0x1a, 0xfc, 0x02,
-	0xd6, 0xec, 0x40, 0x97, 0x22, 0xad, 0x69, 0x18, 0xc4, 0x6c, 0xe2, 0xa3, 0x23, 0x82, 0x68, 0x3e,
-	0x59, 0x12, 0xca, 0xa9, 0xea, 0x96, 0xeb, 0x88, 0xb9, 0xc3, 0x31, 0xa4, 0x5e, 0x6f, 0xec, 0xee,
-	0x31, 0xc0, 0x85, 0x23, 0xde, 0x9b, 0x41, 0xb9, 0xde, 0xd8, 0x55, 0x72, 0x9c, 0x46, 0xdd, 0x20,
-	0xa6, 0x4f, 0x00, 0xf7, 0x63, 0xc4, 0x0d, 0x64, 0xea, 0xb4, 0x93, 0x9f, 0x63, 0xe2, 0xb7, 0x63,
-	0xc4, 0xeb, 0x0c, 0x30, 0x41, 0xe9, 0x97, 0x61, 0x0d, 0xa4, 0x8f, 0x91, 0x45, 0xba, 0xd8, 0xcc,
-	0xa7, 0x18, 0xc5, 0xc6, 0x85, 0x23, 0xde, 0xbf, 0x7c, 0x9b, 0xef, 0xfc, 0x26, 0x65, 0xd4, 0x0d,
-	0xf7, 0xc1, 0x62, 0xa7, 0xab, 0x77, 0x9a, 0x9f, 0x54, 0x8a, 0xac, 0xbe, 0x6a, 0xf5, 0xf2, 0xf3,
-	0x8c, 0xef, 0xc1, 0x6c, 0x63, 0xd7, 0xbc, 0xb1, 0xb3, 0x1e, 0xc3, 0xfb, 0x11, 0xc1, 0x93, 0xd4,
-	0xaf, 0x13, 0x51, 0x90, 0x20, 0x58, 0xae, 0xa1, 0xe0, 0x90, 0x15, 0xf4, 0xd1, 0x46, 0x84, 0x4a,
-	0xe7, 0x02, 0x58, 0xe1, 0x8a, 0x64, 0x80, 0x4d, 0x82, 0xa0, 0x01, 0x96, 0x08, 0xc5, 0x96, 0xaa,
-	0xa3, 0xa6, 0x89, 0x35, 0x34, 0xf6, 0xc0, 0xb6, 0xeb, 0x88, 0xd9, 0x86, 0xbf, 0xb4, 0x8b, 0x35,
-	0xc4, 0x5c, 0x20, 0x5f, 0xbe, 0xa9, 0x48, 0x8b, 0x92, 0x25, 0xdc, 0xab, 0x06, 0x0f, 0xc1, 0xf2,
-	0xe8, 0x93, 0x06, 0x16, 0x27, 0xf9, 0x64, 0x69, 0xae, 0x9c, 0xd9, 0x5c, 0xaf, 0x70, 0x16, 0xaf,
-	0x4c, 0xf1, 0x6b, 0x35, 0x75, 0xea, 0x88, 0x09, 0x65, 0xc9, 0x8e, 0x54, 0x89, 0xf4, 0x7d, 0x1e,
-	0xe4, 0xc2, 0x96, 0xad, 0x60, 0x89, 0xd8, 0xc6, 0x75, 0x19, 0xbc, 0x01, 0x6e, 0x50, 0x3c, 0xe8,
-	0xb6, 0x3d, 0x81, 0x24, 0x13, 0x78, 0xec, 0x3a, 0x62, 0xfa, 0xc0, 0xab, 0x31, 0xf2, 0x19, 0xcc,
-	0x11, 0x80, 0x95, 0x34, 0x63, 0xda, 0xd1, 0xa0, 0x0d, 0x72, 0xf1, 0x99, 0xf1, 0x6d, 0xfb, 0xc2,
-	0x75, 0xc4, 0xd5, 0xad, 0x2b, 0x25, 0x66, 0x35, 0x2e, 0x2f, 0x11, 0x59, 0x9d, 0x93, 0x4d, 0xc5,
-	0xc8, 0xd6, 0xfe, 0x51, 0xb6, 0x16, 0x95, 0xad, 0x8d, 0x65, 0x5f, 0x4f, 0xc8, 0x06, 0x21, 0xf5,
-	0x13, 0xb1, 0x36, 0x21, 0x1b, 0x44, 0x34, 0x4a, 0x36, 0x19, 0xd0, 0x85, 0x2b, 0x05, 0xb4, 0x35,
-	0x11, 0xd0, 0x34, 0xe3, 0x7b, 0xea, 0x85, 0xe3, 0x15, 0x1f, 0xbc, 0xab, 0x26, 0xf6, 0x87, 0x00,
-	0xb2, 0x23, 0xe7, 0xb2, 0xbc, 0x5e, 0x73, 0x32, 0xdf, 0x80, 0x6c, 0x98, 0x4b, 0x2f, 0x39, 0xcc,
-	0xc7, 0x99, 0x4d, 0x29, 0x3e, 0x96, 0x7c, 0xc6, 0x82, 0x50, 0xde, 0x6a, 0x73, 0x35, 0x69, 0x19,
-	0x2c, 0x86, 0x18, 0x76, 0xd1, 0x48, 0x3f, 0x05, 0x00, 0xfd, 0x52, 0x55, 0xa5, 0xed, 0xce, 0xff,
-	0x99, 0x72, 0x0f, 0x2c, 0x46, 0xa6, 0x1c, 0xdd, 0x3e, 0xb3, 0x8f, 0x99, 0xe5, 0xc7, 0x24, 0x52,
-	0x0e, 0xac, 0x46, 0x86, 0xf2, 0x87, 0xdd, 0xfc, 0x9a, 0x04, 0x2b, 0x21, 0x8b, 0x12, 0xfc, 0x96,
-	0xc3, 0xb7, 0xe0, 0x66, 0x78, 0x01, 0xc3, 0xbb, 0x11, 0xc9, 0xbf, 0x6f, 0xeb, 0x42, 0x71, 0xda,
-	0x72, 0xf0, 0x39, 0x13, 0x65, 0xe1, 0xa1, 0x00, 0x5f, 0x82, 0x05, 0x5f, 0x1e, 0x16, 0x22, 0xf8,
-	0x88, 0x93, 0x0a, 0x77, 0x62, 0xd7, 0xc6, 0x44, 0xf0, 0x00, 0x64, 0xb8, 0x29, 0xa0, 0x18, 0x83,
-	0xe7, 0x0f, 0xad, 0x50, 0x9a, 0x0e, 0x18, 0xb3, 0x56, 0x9f, 0x9d, 0xba, 0x45, 0xe1, 0xcc, 0x2d,
-	0x0a, 0xdf, 0x86, 0xc5, 0xc4, 0xc9, 0xb0, 0x28, 0x9c, 0x0d, 0x8b, 0x89, 0xf3, 0x61, 0x31, 0xf1,
-	0x41, 0x9a, 0x7a, 0x8c, 0xe1, 0x9f, 0xa1, 0xd6, 0x02, 0x7b, 0x7e, 0xf4, 0x27, 0x00, 0x00, 0xff,
-	0xff, 0xb2, 0xcb, 0x7a, 0x75, 0x21, 0x09, 0x00, 0x00,
+	// 1045 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x3a, 0xae, 0xed, 0x3c, 0xe3, 0xfc, 0x98, 0xe0, 0xc6, 0x75, 0x85, 0x37, 0xb2, 0x2a,
+	0x64, 0x90, 0x6a, 0x23, 0x23, 0x54, 0xc4, 0x0f, 0xa9, 0x38, 0x0e, 0x26, 0xc2, 0xa4, 0xe9, 0xda,
+	0x29, 0x12, 0x17, 0x6b, 0xed, 0x9d, 0xac, 0x57, 0x59, 0xcf, 0x2c, 0x3b, 0xe3, 0x12, 0xc4, 0x8d,
+	0x13, 0xc7, 0x1e, 0x39, 0xf6, 0xc2, 0x01, 0xfe, 0x92, 0x1e, 0x38, 0x54, 0xe2, 0xd2, 0xd3, 0x22,
+	0x39, 0x17, 0xf8, 0x17, 0x72, 0x42, 0x3b, 0x3b, 0x6b, 0xef, 0xc6, 0x8e, 0x92, 0x50, 0x68, 0x6f,
+	0x3b, 0x33, 0xef, 0x7d, 0xdf, 0xbc, 0x79, 0xef, 0x7b, 0xcf, 0x86, 0x3b, 0x8e, 0x4b, 0x39, 0xad,
+	0x31, 0xe2, 0xf4, 0x6b, 0x36, 0x35, 0x7b, 0x8c, 0xbb, 0x58, 0x1f, 0xf5, 0x5c, 0xec, 0x50, 0x97,
+	0x63, 0xb7, 0x2a, 0x8e, 0x51, 0xf6, 0xb1, 0xee, 0xda, 0xd4, 0xac, 0xfa, 0x66, 0xc5, 0xbb, 0xa6,
+	0xc5, 0x87, 0xe3, 0x7e, 0x75, 0x40, 0x47, 0x35, 0x93, 0x9a, 0xb4, 0x26, 0x6c, 0xfa, 0xe3, 0x23,
+	0xb1, 0x0a, 0xf0, 0xfc, 0xaf, 0xc0, 0xb7, 0x58, 0x32, 0x29, 0x35, 0x6d, 0x3c, 0xb3, 0x32, 0xc6,
+	0xae, 0xce, 0x2d, 0x4a, 0xe4, 0xb9, 0x7a, 0xfe, 0x9c, 0x5b, 0x23, 0xcc, 0xb8, 0x3e, 0x72, 0x02,
+	0x83, 0xf2, 0x6f, 0x49, 0xd8, 0x6a, 0x53, 0xb3, 0x23, 0x6e, 0x76, 0x48, 0x06, 0x74, 0x34, 0xb2,
+	0xb8, 0x26, 0x2e, 0x88, 0x0c, 0xc8, 0x45, 0x6e, 0x6d, 0x19, 0x05, 0x65, 0x5b, 0xa9, 0xdc, 0x68,
+	0xdc, 0x9f, 0x78, 0x6a, 0x76, 0xea, 0xb3, 0xd7, 0x3c, 0xf3, 0xd4, 0xe8, 0xad, 0x8f, 0xf5, 0x63,
+	0x9d, 0xd6, 0x82, 0x98, 0x6a, 0xce, 0xb1, 0x59, 0xe3, 0xdf, 0x3b, 0x98, 0x55, 0x23, 0x0e, 0x5a,
+	0xd6, 0x9e, 0x2e, 0x0c, 0xf4, 0x03, 0x6c, 0x8d, 0x25, 0x2f, 0xc7, 0x46, 0xcf, 0xb6, 0x19, 0xe9,
+	0xd1, 0xa3, 0x23, 0x86, 0x79, 0x21, 0xb1, 0xad, 0x54, 0x92, 0x8d, 0x9d, 0x89, 0xa7, 0xe6, 0x0f,
+	0x67, 0x26, 0xed, 0x76, 0x67, 0xff, 0x81, 0x30, 0x38, 0xf3, 0xd4, 0xb7, 0xaf, 0xc0, 0xdc, 0xee,
+	0xec, 0x6b, 0xf9, 0x08, 0x47, 0xdb, 0x66, 0x24, 0x00, 0x40, 0x0f, 0x17, 0x90, 0xdb, 0x98, 0x98,
+	0x7c, 0x58, 0x58, 0x16, 0xe4, 0xb7, 0x16, 0x90, 0xb7, 0x85, 0xc1, 0x1c, 0x64, 0xb0, 0x8d, 0x5a,
+	0x90, 0x7e, 0x8c, 0x5d, 0x66, 0x51, 0x52, 0x48, 0x0a, 0x88, 0xbb, 0x67, 0x9e, 0xfa, 0xce, 0xe5,
+	0xd7, 0x7c, 0x14, 0x38, 0x69, 0xa1, 0x37, 0x7a, 0x08, 0xab, 0x43, 0xcb, 0x1c, 0xf6, 0xbe, 0xd3,
+	0x39, 0x76, 0x47, 0xba, 0x7b, 0x5c, 0xb8, 0x21, 0xf0, 0xde, 0xbd, 0x5a, 0xd8, 0x2d, 0x3f, 0xec,
+	0x9c, 0x8f, 0xf0, 0x75, 0x08, 0x80, 0xee, 0xc1, 0x0a, 0x3f, 0x21, 0x3d, 0xe6, 0xe8, 0x84, 0x15,
+	0x52, 0xdb, 0xcb, 0x95, 0x6c, 0xfd, 0xcd, 0x6a, 0xa4, 0xfc, 0xaa, 0xdd, 0x13, 0xd2, 0x71, 0x74,
+	0xd2, 0x48, 0x3e, 0xf3, 0xd4, 0x25, 0x2d, 0xc3, 0x83, 0x25, 0xfb, 0x28, 0xf9, 0xd7, 0x53, 0x55,
+	0x29, 0xff, 0xaa, 0xc0, 0x72, 0xf7, 0x84, 0xa0, 0x9b, 0x90, 0x90, 0xd5, 0xb0, 0xd2, 0x48, 0x4d,
+	0x3c, 0x35, 0xb1, 0xd7, 0xd4, 0x12, 0x96, 0x81, 0x76, 0xe1, 0x0d, 0x47, 0x77, 0xb9, 0x35, 0xb0,
+	0x1c, 0x9d, 0x70, 0x56, 0x48, 0x08, 0x86, 0xdb, 0xe7, 0x19, 0x0e, 0x66, 0x36, 0x92, 0x28, 0xe6,
+	0x86, 0x3e, 0x85, 0xb4, 0x5f, 0xa6, 0x74, 0xcc, 0x45, 0x12, 0xb2, 0xf5, 0x5b, 0xd5, 0xa0, 0x8c,
+	0xab, 0x61, 0x19, 0x57, 0x9b, 0xb2, 0xcc, 0x1b, 0x19, 0xdf, 0xff, 0xe7, 0x3f, 0x55, 0x45, 0x0b,
+	0x7d, 0xe4, 0x5d, 0xff, 0x56, 0x60, 0x35, 0xce, 0x85, 0x3a, 0x90, 0xe1, 0xd4, 0xb1, 0x06, 0xb3,
+	0x52, 0xfe, 0x70, 0xe2, 0xa9, 0xe9, 0xae, 0xbf, 0xb7, 0xd7, 0xbc, 0x5a, 0x96, 0xa4, 0xb1, 0x96,
+	0x16, 0x48, 0x7b, 0xc6, 0xbc, 0x48, 0x12, 0xff, 0x87, 0x48, 0x6e, 0x42, 0x2a, 0x5a, 0x96, 0x9a,
+	0x5c, 0xc9, 0x58, 0x7f, 0x49, 0x40, 0x5a, 0x66, 0x0e, 0x55, 0x60, 0x99, 0x9f, 0x10, 0x11, 0x5f,
+	0xb6, 0xbe, 0x7e, 0xfe, 0xe9, 0xe5, 0x7b, 0xfb, 0x26, 0xe8, 0x11, 0x80, 0xa8, 0xf7, 0x3e, 0x36,
+	0x2d, 0x22, 0xb5, 0x76, 0x6f, 0xe2, 0xa9, 0x2b, 0x7e, 0x8d, 0x37, 0xfc, 0xcd, 0x6b, 0xe8, 0x6b,
+	0xc5, 0x87, 0x12, 0x4e, 0xe8, 0x00, 0x32, 0x02, 0x17, 0x13, 0x43, 0x8a, 0xe8, 0x03, 0xff, 0x99,
+	0x7d, 0xb3, 0x5d, 0x62, 0x5c, 0x03, 0x33, 0xed, 0xc3, 0xec, 0x12, 0x03, 0xdd, 0x87, 0x8c, 0x81,
+	0x75, 0xc3, 0xb6, 0x08, 0x16, 0x9a, 0xca, 0xd6, 0x8b, 0x73, 0x15, 0xd1, 0x0d, 0x1b, 0x5b, 0x50,
+	0x12, 0x4f, 0xfc, 0x92, 0x98, 0x7a, 0xc9, 0x77, 0x42, 0xb0, 0xde, 0xc2, 0xb2, 0xbb, 0x69, 0xf8,
+	0xdb, 0x31, 0x66, 0xbc, 0xfc, 0x42, 0x81, 0x8d, 0xc8, 0x26, 0x73, 0x28, 0x61, 0x18, 0xd9, 0xb0,
+	0xc6, 0x38, 0x75, 0x75, 0x13, 0xf7, 0x08, 0x35, 0xf0, 0xac, 0x62, 0x9a, 0x13, 0x4f, 0xcd, 0x75,
+	0x82, 0xa3, 0x7d, 0x6a, 0x60, 0x91, 0xd9, 0xda, 0xe5, 0x01, 0xc5, 0x5c, 0xb4, 0x1c, 0x8b, 0x2c,
+	0x0d, 0x74, 0x08, 0xeb, 0x61, 0x2f, 0x91, 0xc3, 0x21, 0xd4, 0xce, 0x9d, 0x58, 0x02, 0x2f, 0x68,
+	0xd4, 0x32, 0xa9, 0x6b, 0xe3, 0xd8, 0x2e, 0x2b, 0xff, 0x94, 0x82, 0xfc, 0xd4, 0x65, 0x47, 0x1e,
+	0xb1, 0xb1, 0xfd, 0xaa, 0x3a, 0x7b, 0x54, 0x6f, 0x89, 0xff, 0x4a, 0x6f, 0x63, 0xc8, 0x2f, 0x1e,
+	0x16, 0x41, 0xa9, 0x7d, 0x36, 0xf1, 0xd4, 0xcd, 0x9d, 0x97, 0x1a, 0x15, 0x9b, 0x8b, 0x06, 0x45,
+	0x8c, 0xd6, 0x8c, 0xd0, 0x26, 0x17, 0xd0, 0xb6, 0xae, 0x49, 0xdb, 0x8a, 0xd3, 0xb6, 0x66, 0xb4,
+	0x5f, 0xce, 0xd1, 0xca, 0x36, 0x10, 0x8c, 0x82, 0xad, 0x39, 0x5a, 0x39, 0x9b, 0xe2, 0x60, 0xf3,
+	0x93, 0x29, 0xf5, 0x52, 0x93, 0xa9, 0x3f, 0x37, 0x99, 0xd2, 0x02, 0xef, 0x63, 0x5f, 0x1c, 0x5f,
+	0x44, 0x27, 0xce, 0xbf, 0x1f, 0x55, 0x9f, 0xc3, 0x86, 0xde, 0xa7, 0xae, 0x1f, 0xf7, 0x6c, 0x64,
+	0x65, 0x2e, 0x1d, 0x59, 0x6b, 0xd2, 0xa9, 0x1b, 0x9f, 0x5c, 0xbf, 0x2b, 0x90, 0x0b, 0x15, 0x20,
+	0x74, 0xff, 0x8a, 0x15, 0xfe, 0x15, 0xe4, 0xa6, 0xfa, 0xf6, 0x15, 0x28, 0xf4, 0x90, 0xad, 0x97,
+	0x17, 0xcb, 0x3b, 0xaa, 0xd5, 0x70, 0x42, 0x0e, 0x22, 0x7b, 0xe5, 0x75, 0x58, 0x9d, 0xda, 0x88,
+	0x86, 0x55, 0xfe, 0x43, 0x01, 0x14, 0x6c, 0x35, 0x74, 0x3e, 0x18, 0xbe, 0x9e, 0x28, 0x1f, 0xc0,
+	0x6a, 0x2c, 0xca, 0xb0, 0x8b, 0x5d, 0x3d, 0xcc, 0x5c, 0x34, 0x4c, 0x56, 0xce, 0xc3, 0x66, 0x2c,
+	0xa8, 0x20, 0xd8, 0xfa, 0x8f, 0x09, 0xd8, 0x98, 0xa2, 0x68, 0xf2, 0xd7, 0x34, 0x3a, 0x80, 0x95,
+	0x69, 0x23, 0x47, 0x6f, 0xc5, 0x28, 0xcf, 0x77, 0xfd, 0x62, 0xe9, 0xa2, 0x63, 0xf9, 0x9c, 0x4b,
+	0x15, 0xe5, 0x3d, 0x05, 0xed, 0x42, 0x2a, 0xa0, 0x47, 0xc5, 0x98, 0x7d, 0xac, 0x92, 0x8a, 0xb7,
+	0x17, 0x9e, 0xcd, 0x80, 0x50, 0x17, 0xb2, 0x91, 0x28, 0x90, 0xba, 0xc0, 0x3e, 0x9a, 0xb4, 0xe2,
+	0xf6, 0xc5, 0x06, 0x33, 0xd4, 0xc6, 0x27, 0xcf, 0x26, 0x25, 0xe5, 0xf9, 0xa4, 0xa4, 0x3c, 0x39,
+	0x2d, 0x2d, 0x3d, 0x3d, 0x2d, 0x29, 0xcf, 0x4f, 0x4b, 0x4b, 0x2f, 0x4e, 0x4b, 0x4b, 0xdf, 0x94,
+	0x2f, 0x4c, 0xe3, 0xf4, 0xef, 0x48, 0x3f, 0x25, 0xbe, 0xdf, 0xff, 0x67, 0x00, 0x43, 0x59, 0x2c,
+	0xd9, 0xa3, 0x0c, 0x00, 0x00,
 }
 
 func (this *LogStreamUncommitReport) Equal(that interface{}) bool {
@@ -669,6 +920,112 @@ func (this *LogStreamUncommitReport) Equal(that interface{}) bool {
 	if this.HighWatermark != that1.HighWatermark {
 		return false
 	}
+	if len(this.TxnSpans) != len(that1.TxnSpans) {
+		return false
+	}
+	for i := range this.TxnSpans {
+		if !this.TxnSpans[i].Equal(&that1.TxnSpans[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Txn) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Txn)
+	if !ok {
+		that2, ok := that.(Txn)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if len(this.Participants) != len(that1.Participants) {
+		return false
+	}
+	for i := range this.Participants {
+		if !this.Participants[i].Equal(&that1.Participants[i]) {
+			return false
+		}
+	}
+	if this.Timeout != that1.Timeout {
+		return false
+	}
+	return true
+}
+func (this *TxnParticipant) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxnParticipant)
+	if !ok {
+		that2, ok := that.(TxnParticipant)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TopicID != that1.TopicID {
+		return false
+	}
+	if this.LogStreamID != that1.LogStreamID {
+		return false
+	}
+	if this.Length != that1.Length {
+		return false
+	}
+	return true
+}
+func (this *TxnSpan) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxnSpan)
+	if !ok {
+		that2, ok := that.(TxnSpan)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Txn.Equal(&that1.Txn) {
+		return false
+	}
+	if this.LLSNBegin != that1.LLSNBegin {
+		return false
+	}
+	if this.LLSNEnd != that1.LLSNEnd {
+		return false
+	}
+	if !this.Deadline.Equal(that1.Deadline) {
+		return false
+	}
 	return true
 }
 func (this *LogStreamCommitResult) Equal(that interface{}) bool {
@@ -711,6 +1068,14 @@ func (this *LogStreamCommitResult) Equal(that interface{}) bool {
 	if this.HighWatermark != that1.HighWatermark {
 		return false
 	}
+	if len(this.AbortedTxnSpans) != len(that1.AbortedTxnSpans) {
+		return false
+	}
+	for i := range this.AbortedTxnSpans {
+		if !this.AbortedTxnSpans[i].Equal(&that1.AbortedTxnSpans[i]) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -986,6 +1351,20 @@ func (m *LogStreamUncommitReport) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.TxnSpans) > 0 {
+		for iNdEx := len(m.TxnSpans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxnSpans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogStreamReporter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.HighWatermark != 0 {
 		i = encodeVarintLogStreamReporter(dAtA, i, uint64(m.HighWatermark))
 		i--
@@ -1014,7 +1393,7 @@ func (m *LogStreamUncommitReport) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *GetReportRequest) Marshal() (dAtA []byte, err error) {
+func (m *Txn) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1024,20 +1403,49 @@ func (m *GetReportRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetReportRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Txn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Txn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLogStreamReporter(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogStreamReporter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintLogStreamReporter(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetReportResponse) Marshal() (dAtA []byte, err error) {
+func (m *TxnParticipant) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1047,18 +1455,130 @@ func (m *GetReportResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetReportResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxnParticipant) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxnParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UncommitReports) > 0 {
-		for iNdEx := len(m.UncommitReports) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Length != 0 {
+		i = encodeVarintLogStreamReporter(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintLogStreamReporter(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintLogStreamReporter(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxnSpan) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxnSpan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxnSpan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintLogStreamReporter(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.LLSNEnd != 0 {
+		i = encodeVarintLogStreamReporter(dAtA, i, uint64(m.LLSNEnd))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LLSNBegin != 0 {
+		i = encodeVarintLogStreamReporter(dAtA, i, uint64(m.LLSNBegin))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Txn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLogStreamReporter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UncommitReports) > 0 {
+		for iNdEx := len(m.UncommitReports) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.UncommitReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
@@ -1099,6 +1619,20 @@ func (m *LogStreamCommitResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AbortedTxnSpans) > 0 {
+		for iNdEx := len(m.AbortedTxnSpans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AbortedTxnSpans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogStreamReporter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.HighWatermark != 0 {
 		i = encodeVarintLogStreamReporter(dAtA, i, uint64(m.HighWatermark))
 		i--
@@ -1295,6 +1829,70 @@ func (m *LogStreamUncommitReport) ProtoSize() (n int) {
 	if m.HighWatermark != 0 {
 		n += 1 + sovLogStreamReporter(uint64(m.HighWatermark))
 	}
+	if len(m.TxnSpans) > 0 {
+		for _, e := range m.TxnSpans {
+			l = e.ProtoSize()
+			n += 1 + l + sovLogStreamReporter(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Txn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovLogStreamReporter(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.ProtoSize()
+			n += 1 + l + sovLogStreamReporter(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovLogStreamReporter(uint64(l))
+	return n
+}
+
+func (m *TxnParticipant) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovLogStreamReporter(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovLogStreamReporter(uint64(m.LogStreamID))
+	}
+	if m.Length != 0 {
+		n += 1 + sovLogStreamReporter(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *TxnSpan) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Txn.ProtoSize()
+	n += 1 + l + sovLogStreamReporter(uint64(l))
+	if m.LLSNBegin != 0 {
+		n += 1 + sovLogStreamReporter(uint64(m.LLSNBegin))
+	}
+	if m.LLSNEnd != 0 {
+		n += 1 + sovLogStreamReporter(uint64(m.LLSNEnd))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovLogStreamReporter(uint64(l))
 	return n
 }
 
@@ -1352,6 +1950,12 @@ func (m *LogStreamCommitResult) ProtoSize() (n int) {
 	if m.HighWatermark != 0 {
 		n += 1 + sovLogStreamReporter(uint64(m.HighWatermark))
 	}
+	if len(m.AbortedTxnSpans) > 0 {
+		for _, e := range m.AbortedTxnSpans {
+			l = e.ProtoSize()
+			n += 1 + l + sovLogStreamReporter(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1535,56 +2139,40 @@ func (m *LogStreamUncommitReport) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLogStreamReporter(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnSpans", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLogStreamReporter
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthLogStreamReporter
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetReportRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLogStreamReporter
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogStreamReporter
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TxnSpans = append(m.TxnSpans, TxnSpan{})
+			if err := m.TxnSpans[len(m.TxnSpans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetReportRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogStreamReporter(dAtA[iNdEx:])
@@ -1606,7 +2194,7 @@ func (m *GetReportRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReportResponse) Unmarshal(dAtA []byte) error {
+func (m *Txn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1629,17 +2217,17 @@ func (m *GetReportResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReportResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Txn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Txn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.StorageNodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogStreamReporter
@@ -1649,14 +2237,27 @@ func (m *GetReportResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UncommitReports", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1683,22 +2284,469 @@ func (m *GetReportResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UncommitReports = append(m.UncommitReports, LogStreamUncommitReport{})
-			if err := m.UncommitReports[len(m.UncommitReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Participants = append(m.Participants, TxnParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLogStreamReporter(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLogStreamReporter
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogStreamReporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogStreamReporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogStreamReporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxnSpan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogStreamReporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxnSpan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxnSpan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Txn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSNBegin", wireType)
+			}
+			m.LLSNBegin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LLSNBegin |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSNEnd", wireType)
+			}
+			m.LLSNEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LLSNEnd |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogStreamReporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogStreamReporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogStreamReporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogStreamReporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncommitReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UncommitReports = append(m.UncommitReports, LogStreamUncommitReport{})
+			if err := m.UncommitReports[len(m.UncommitReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogStreamReporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -1871,6 +2919,40 @@ func (m *LogStreamCommitResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortedTxnSpans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbortedTxnSpans = append(m.AbortedTxnSpans, TxnSpan{})
+			if err := m.AbortedTxnSpans[len(m.AbortedTxnSpans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogStreamReporter(dAtA[iNdEx:])
@@ -781,1263 +781,3224 @@ func (m *TrimResponse) GetResults() map[github_com_kakao_varlog_pkg_types.LogStr
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GetMetadataRequest)(nil), "varlog.snpb.GetMetadataRequest")
-	proto.RegisterType((*GetMetadataResponse)(nil), "varlog.snpb.GetMetadataResponse")
-	proto.RegisterType((*AddLogStreamReplicaRequest)(nil), "varlog.snpb.AddLogStreamReplicaRequest")
-	proto.RegisterType((*AddLogStreamReplicaResponse)(nil), "varlog.snpb.AddLogStreamReplicaResponse")
-	proto.RegisterType((*RemoveLogStreamRequest)(nil), "varlog.snpb.RemoveLogStreamRequest")
-	proto.RegisterType((*SealRequest)(nil), "varlog.snpb.SealRequest")
-	proto.RegisterType((*SealResponse)(nil), "varlog.snpb.SealResponse")
-	proto.RegisterType((*UnsealRequest)(nil), "varlog.snpb.UnsealRequest")
-	proto.RegisterType((*SyncRequest)(nil), "varlog.snpb.SyncRequest")
-	proto.RegisterType((*SyncRequest_BackupNode)(nil), "varlog.snpb.SyncRequest.BackupNode")
-	proto.RegisterType((*SyncResponse)(nil), "varlog.snpb.SyncResponse")
-	proto.RegisterType((*TrimRequest)(nil), "varlog.snpb.TrimRequest")
-	proto.RegisterType((*TrimResponse)(nil), "varlog.snpb.TrimResponse")
-	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.LogStreamID]string)(nil), "varlog.snpb.TrimResponse.ResultsEntry")
+// CheckpointRequest asks the storage node to copy the log stream replica into
+// the directory path. The copy contains log entries committed until the
+// commit_result, which should be the last commit result for the log stream in
+// a backup of the metadata repository.
+type CheckpointRequest struct {
+	ClusterID     github_com_kakao_varlog_pkg_types.ClusterID     `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"cluster_id,omitempty"`
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,2,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
+	TopicID       github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID   github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,4,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	Path          string                                          `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	CommitResult  LogStreamCommitResult                           `protobuf:"bytes,6,opt,name=commit_result,json=commitResult,proto3" json:"commit_result"`
+	// ColdTierPrefix is the prefix in the cold tier under which segments of the
+	// replica are copied. It must be owned by the copy, and it is ignored if the
+	// cold tier is not enabled.
+	ColdTierPrefix string `protobuf:"bytes,7,opt,name=cold_tier_prefix,json=coldTierPrefix,proto3" json:"cold_tier_prefix,omitempty"`
 }
 
-func init() { proto.RegisterFile("proto/snpb/management.proto", fileDescriptor_b2a108895042472a) }
-
-var fileDescriptor_b2a108895042472a = []byte{
-	// 1006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x8b, 0xdb, 0x46,
-	0x14, 0xb7, 0xd6, 0xde, 0x3f, 0x7e, 0x5a, 0x77, 0xb3, 0xe3, 0x26, 0x71, 0xb5, 0x60, 0xb9, 0x2a,
-	0xa4, 0x6e, 0x4a, 0x24, 0xea, 0x42, 0x59, 0x42, 0xda, 0x34, 0xde, 0x0d, 0x61, 0x61, 0x13, 0x82,
-	0x9c, 0x5e, 0x5a, 0xa8, 0x19, 0x4b, 0x53, 0xad, 0x6b, 0x59, 0xa3, 0x68, 0xc6, 0x0b, 0xbe, 0x86,
-	0x7e, 0x80, 0x1e, 0x7a, 0x2b, 0x94, 0x7e, 0x8b, 0xd2, 0x53, 0xaf, 0x39, 0x95, 0x1c, 0x4b, 0x0f,
-	0x2e, 0x78, 0xcf, 0xfd, 0x02, 0x39, 0x15, 0xcd, 0xc8, 0xb6, 0xe4, 0xb5, 0x31, 0x81, 0x6e, 0xd8,
-	0xc3, 0xde, 0xac, 0x99, 0xdf, 0xfb, 0xff, 0x9b, 0xf7, 0x66, 0x0c, 0x7b, 0x61, 0x44, 0x39, 0xb5,
-	0x58, 0x10, 0x76, 0xac, 0x3e, 0x0e, 0xb0, 0x47, 0xfa, 0x24, 0xe0, 0xa6, 0x58, 0x45, 0xea, 0x29,
-	0x8e, 0x7c, 0xea, 0x99, 0xf1, 0xae, 0x76, 0xc7, 0xeb, 0xf2, 0x93, 0x41, 0xc7, 0x74, 0x68, 0xdf,
-	0xf2, 0xa8, 0x47, 0x2d, 0x81, 0xe9, 0x0c, 0xbe, 0x13, 0x5f, 0x52, 0x4d, 0xfc, 0x4b, 0xca, 0x6a,
-	0x7b, 0x1e, 0xa5, 0x9e, 0x4f, 0x66, 0x28, 0xd2, 0x0f, 0xf9, 0x30, 0xd9, 0xbc, 0x29, 0x15, 0xc7,
-	0x36, 0x09, 0xc7, 0x2e, 0xe6, 0x38, 0xd9, 0x28, 0x4b, 0x47, 0xb2, 0x8b, 0xd7, 0xc5, 0x62, 0x44,
-	0x42, 0xbf, 0xeb, 0x60, 0x4e, 0x23, 0xb9, 0x6c, 0x3c, 0x07, 0xf4, 0x88, 0xf0, 0xc7, 0x09, 0xd6,
-	0x26, 0xcf, 0x07, 0x84, 0x71, 0xf4, 0x0d, 0x80, 0xe3, 0x0f, 0x18, 0x27, 0x51, 0xbb, 0xeb, 0x56,
-	0x94, 0x9a, 0x52, 0x2f, 0x35, 0xef, 0x8d, 0x47, 0x7a, 0xf1, 0x40, 0xae, 0x1e, 0x1d, 0xbe, 0x1e,
-	0xe9, 0x1f, 0xa7, 0x62, 0xe9, 0xe1, 0x1e, 0xa6, 0x96, 0x74, 0xc8, 0x0a, 0x7b, 0x9e, 0xc5, 0x87,
-	0x21, 0x61, 0xe6, 0x14, 0x6e, 0x17, 0x13, 0x7d, 0x47, 0xae, 0x31, 0x80, 0x72, 0xc6, 0x24, 0x0b,
-	0x69, 0xc0, 0x08, 0xfa, 0x16, 0xae, 0x33, 0x4e, 0x23, 0xec, 0x91, 0x76, 0x40, 0x5d, 0xd2, 0x9e,
-	0xf8, 0x2f, 0xcc, 0xab, 0x8d, 0xdb, 0x66, 0x2a, 0x8f, 0x66, 0x4b, 0x22, 0x9f, 0x50, 0x97, 0x4c,
-	0x14, 0x1d, 0x12, 0xe6, 0x44, 0xdd, 0x90, 0xd3, 0xc8, 0x2e, 0xb3, 0xf3, 0xdb, 0xc6, 0x9f, 0x79,
-	0xd0, 0x1e, 0xb8, 0xee, 0x31, 0xf5, 0x5a, 0x3c, 0x22, 0xb8, 0x6f, 0xcb, 0x54, 0xbc, 0x8d, 0x90,
-	0x91, 0x0f, 0x3b, 0x99, 0xd8, 0xba, 0x6e, 0x65, 0xad, 0xa6, 0xd4, 0xd7, 0x9b, 0x87, 0xe3, 0x91,
-	0x5e, 0x4a, 0x05, 0x23, 0xac, 0x58, 0xab, 0xad, 0x64, 0x44, 0xec, 0x52, 0x2a, 0xde, 0x23, 0x17,
-	0xb5, 0x60, 0x8b, 0xd3, 0xb0, 0xeb, 0xc4, 0x66, 0xf2, 0xc2, 0xcc, 0xfe, 0x78, 0xa4, 0x6f, 0x3e,
-	0x8b, 0xd7, 0x84, 0x81, 0x8f, 0x56, 0x1b, 0x48, 0xc0, 0xf6, 0xa6, 0xd0, 0x74, 0xe4, 0x22, 0x17,
-	0x4a, 0x3e, 0xf5, 0xda, 0x4c, 0xe4, 0x2e, 0xd6, 0x5c, 0x10, 0x9a, 0xbf, 0x1c, 0x8f, 0x74, 0x75,
-	0x9a, 0x53, 0xa1, 0xfd, 0xce, 0x6a, 0xed, 0x29, 0x01, 0x5b, 0xf5, 0xa7, 0x1f, 0x2e, 0xba, 0x0d,
-	0xbb, 0x99, 0x44, 0x85, 0x98, 0x9f, 0x54, 0xd6, 0x6b, 0x4a, 0xbd, 0x68, 0xef, 0xa4, 0x82, 0x7c,
-	0x8a, 0xf9, 0x89, 0xf1, 0x42, 0x81, 0xbd, 0x85, 0x05, 0x4d, 0x08, 0xe5, 0x00, 0x4a, 0x79, 0x9c,
-	0x30, 0x3f, 0x61, 0x93, 0x95, 0x61, 0xd3, 0xbc, 0x8a, 0xf3, 0x94, 0x6a, 0x16, 0x5e, 0x8e, 0xf4,
-	0x9c, 0x7d, 0xcd, 0x9f, 0x43, 0x1a, 0xbf, 0xe4, 0xe1, 0x86, 0x4d, 0xfa, 0xf4, 0x94, 0xa4, 0x94,
-	0x5c, 0x31, 0xea, 0xd2, 0x30, 0xca, 0xf8, 0xa1, 0x00, 0x6a, 0x8b, 0x60, 0xff, 0xaa, 0x2a, 0x97,
-	0xe9, 0x9c, 0x53, 0x28, 0xfb, 0x98, 0xf1, 0xb6, 0x43, 0xfb, 0xfd, 0x2e, 0xe7, 0xc4, 0x6d, 0x7b,
-	0x3e, 0x0b, 0xc4, 0x49, 0x2f, 0x34, 0xef, 0x8f, 0x47, 0xfa, 0xee, 0x31, 0x66, 0xfc, 0x60, 0xb2,
-	0xfb, 0xe8, 0xb8, 0xf5, 0xe4, 0xf5, 0x48, 0xbf, 0xb5, 0xda, 0x62, 0x8c, 0xb4, 0x77, 0xfd, 0x8c,
-	0xb0, 0xcf, 0x02, 0xe3, 0x77, 0x05, 0xb6, 0x25, 0x0d, 0x92, 0xee, 0xb0, 0x0f, 0x1b, 0x8c, 0x63,
-	0x3e, 0x60, 0x82, 0x03, 0xef, 0x34, 0x6a, 0x93, 0x8e, 0x30, 0x99, 0xaa, 0x33, 0xe7, 0x5b, 0x02,
-	0x67, 0x27, 0xf8, 0x65, 0xbe, 0xaf, 0x5d, 0x98, 0xef, 0x7f, 0xe7, 0xa1, 0xf4, 0x55, 0xc0, 0xae,
-	0x48, 0x7c, 0xc9, 0x48, 0x7c, 0x00, 0x5b, 0xc9, 0x54, 0x61, 0x95, 0xf5, 0x5a, 0xbe, 0xae, 0x36,
-	0xde, 0x5f, 0x4e, 0xa2, 0x64, 0x60, 0x24, 0x83, 0x64, 0x2a, 0x68, 0xfc, 0x1b, 0xf7, 0xa7, 0x61,
-	0xe0, 0x5c, 0x95, 0xf6, 0x32, 0x95, 0xf6, 0x01, 0x6c, 0x74, 0xb0, 0xd3, 0x1b, 0x84, 0xa2, 0x25,
-	0xa9, 0x8d, 0x0f, 0xb2, 0xb7, 0xcf, 0x59, 0xbd, 0xcc, 0xa6, 0x80, 0xc5, 0x11, 0x8b, 0xd2, 0x2a,
-	0x76, 0x22, 0xa8, 0xfd, 0xa4, 0x00, 0xcc, 0x36, 0x17, 0xa5, 0x5e, 0xb9, 0xb8, 0xd4, 0x57, 0x60,
-	0x13, 0xbb, 0x6e, 0x44, 0x18, 0x13, 0x05, 0x2e, 0xda, 0x93, 0x4f, 0xe3, 0x3e, 0x6c, 0x4b, 0xf7,
-	0x93, 0x3e, 0x68, 0x65, 0xfa, 0xa0, 0xda, 0xb8, 0x79, 0x2e, 0xd2, 0x6c, 0xfb, 0x33, 0x7e, 0x53,
-	0x40, 0x7d, 0x16, 0x75, 0xa7, 0xd7, 0x9c, 0x74, 0x95, 0x95, 0xff, 0xab, 0xca, 0x2d, 0x28, 0x8a,
-	0x1e, 0x9b, 0xea, 0xac, 0x9f, 0x8d, 0x47, 0xfa, 0x56, 0xdc, 0x59, 0xdf, 0xb0, 0xa1, 0x6e, 0xc5,
-	0x8a, 0x44, 0x1f, 0xfd, 0x43, 0x81, 0x6d, 0xe9, 0x79, 0x12, 0x3b, 0x83, 0xcd, 0x88, 0xb0, 0x81,
-	0xcf, 0xe3, 0xe0, 0xe3, 0xf3, 0x7b, 0x2b, 0x13, 0x7c, 0x1a, 0x6b, 0xda, 0x12, 0xf8, 0x30, 0xe0,
-	0xd1, 0xb0, 0xf9, 0xc9, 0x8b, 0x7f, 0xde, 0x94, 0x5e, 0x13, 0x4b, 0xda, 0x5d, 0xd8, 0x4e, 0xeb,
-	0x42, 0xd7, 0x20, 0xdf, 0x23, 0x43, 0x99, 0x3a, 0x3b, 0xfe, 0x89, 0xde, 0x85, 0xf5, 0x53, 0xec,
-	0x0f, 0x48, 0x52, 0x3a, 0xf9, 0x71, 0x77, 0x6d, 0x5f, 0x69, 0xfc, 0x5c, 0x00, 0x78, 0x3c, 0x7d,
-	0x60, 0x22, 0x1b, 0xd4, 0xd4, 0x4b, 0x0a, 0xe9, 0x19, 0xef, 0xcf, 0x3f, 0xeb, 0xb4, 0xda, 0x72,
-	0x80, 0x8c, 0xd2, 0xc8, 0xa1, 0xef, 0xa1, 0xbc, 0xe0, 0x52, 0x8d, 0x3e, 0xcc, 0x88, 0x2e, 0x7f,
-	0x47, 0x69, 0xf5, 0xd5, 0xc0, 0xa9, 0xad, 0xa7, 0xb0, 0x33, 0x77, 0x77, 0x46, 0xd9, 0x83, 0xb6,
-	0xf8, 0x66, 0xad, 0xdd, 0x30, 0xe5, 0xbb, 0xd8, 0x9c, 0xbc, 0x8b, 0xcd, 0x87, 0xf1, 0xbb, 0xd8,
-	0xc8, 0xa1, 0xcf, 0xa1, 0x10, 0x4f, 0x79, 0x54, 0xc9, 0xb2, 0x78, 0x36, 0x3a, 0xb5, 0xf7, 0x16,
-	0xec, 0x4c, 0x1d, 0xfa, 0x02, 0x36, 0xe4, 0xa0, 0x45, 0x5a, 0x06, 0x96, 0x99, 0xbe, 0x2b, 0xcc,
-	0x0f, 0x03, 0x67, 0xde, 0xfc, 0xac, 0x5d, 0xcc, 0x9b, 0x4f, 0x9d, 0x44, 0x29, 0x1e, 0x73, 0x6e,
-	0x4e, 0x3c, 0x75, 0xd8, 0xe6, 0xc4, 0xd3, 0x04, 0x35, 0x72, 0xcd, 0x7b, 0x2f, 0xc7, 0x55, 0xe5,
-	0xd5, 0xb8, 0xaa, 0xfc, 0x78, 0x56, 0xcd, 0xfd, 0x7a, 0x56, 0x55, 0x5e, 0x9d, 0x55, 0x73, 0x7f,
-	0x9d, 0x55, 0x73, 0x5f, 0x1b, 0x4b, 0xb9, 0x3a, 0xfd, 0xe3, 0xa2, 0xb3, 0x21, 0x7e, 0x7f, 0xfa,
-	0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0x4d, 0x55, 0xd6, 0xcd, 0x10, 0x00, 0x00,
+func (m *CheckpointRequest) Reset()         { *m = CheckpointRequest{} }
+func (m *CheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckpointRequest) ProtoMessage()    {}
+func (*CheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a108895042472a, []int{12}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ManagementClient is the client API for Management service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ManagementClient interface {
-	// GetMetadata returns the metadata of the storage node. It produces a gRPC
-	// Unavailable error if the storage node is shutting down.
-	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
-	// AddLogStreamReplica adds a new log stream replica to a storage node.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The storage node is shutting down.
-	// - ResourceExhausted: The number of log stream replicas in the storage node
-	// reaches the upper limit.
-	// - Canceled: The client canceled the request.
-	// DeadlineExceeded: The client's timeout has expired.
-	AddLogStreamReplica(ctx context.Context, in *AddLogStreamReplicaRequest, opts ...grpc.CallOption) (*AddLogStreamReplicaResponse, error)
-	// RemoveLogStream removes a log stream replica from the storage node.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The storage node is shutting down.
-	// - NotFound: The log stream replica does not exist.
-	// - Canceled: The client canceled the request.
-	// - DeadlineExceeded: The client's timeout has expired.
-	//
-	// TODO: It should be renamed to RemoveLogStreamReplica to represent its
-	// purpose precisely.
-	RemoveLogStream(ctx context.Context, in *RemoveLogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// Seal changes the status of the log stream replica to LogStreamStatusSealing
-	// or LogStreamStatusSealed.
-	//
-	// It returns the following gRPC errors:
-	// - InvalidArgument: SealRequest has invalid fields; for instance, TopicID
-	// is invalid.
-	// - Unavailable: The storage node is shutting down.
-	// - NotFound: The log stream replica does not exist.
-	// - Canceled: The client canceled the request.
-	// - DeadlineExceeded: The client's timeout has expired.
-	Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error)
-	// Unseal changes the status of the log stream replica to
-	// LogStreamStatusRunning.
-	//
-	// It returns the following gRPC errors:
-	// - InvalidArgument: UnsealRequest has invalid fields; for instance, TopicID
-	// is invalid.
-	// - Unavailable: The storage node is shutting down.
-	// - NotFound: The log stream replica does not exist.
-	// - Canceled: The client canceled the request.
-	// - DeadlineExceeded: The client's timeout has expired.
-	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// Sync duplicates log entries from the source log stream replica to the
-	// destination log stream replica.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The storage node is shutting down.
-	// - NotFound: The log stream replica does not exist.
-	// - Canceled: The client canceled the request.
-	// - DeadlineExceeded: The client's timeout has expired.
-	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
-	// Trim removes prefix log entries from each log stream replica in the topic.
-	Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error)
+func (m *CheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type managementClient struct {
-	cc *grpc.ClientConn
+func (m *CheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewManagementClient(cc *grpc.ClientConn) ManagementClient {
-	return &managementClient{cc}
+func (m *CheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointRequest.Merge(m, src)
 }
-
-func (c *managementClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
-	out := new(GetMetadataResponse)
-	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/GetMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CheckpointRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointRequest.DiscardUnknown(m)
 }
 
-func (c *managementClient) AddLogStreamReplica(ctx context.Context, in *AddLogStreamReplicaRequest, opts ...grpc.CallOption) (*AddLogStreamReplicaResponse, error) {
-	out := new(AddLogStreamReplicaResponse)
-	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/AddLogStreamReplica", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CheckpointRequest proto.InternalMessageInfo
+
+func (m *CheckpointRequest) GetClusterID() github_com_kakao_varlog_pkg_types.ClusterID {
+	if m != nil {
+		return m.ClusterID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *managementClient) RemoveLogStream(ctx context.Context, in *RemoveLogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/RemoveLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CheckpointRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.StorageNodeID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *managementClient) Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error) {
-	out := new(SealResponse)
-	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/Seal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CheckpointRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *managementClient) Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/Unseal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CheckpointRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *managementClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
-	out := new(SyncResponse)
-	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/Sync", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CheckpointRequest) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	return out, nil
+	return ""
 }
 
-func (c *managementClient) Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error) {
-	out := new(TrimResponse)
-	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/Trim", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CheckpointRequest) GetCommitResult() LogStreamCommitResult {
+	if m != nil {
+		return m.CommitResult
 	}
-	return out, nil
+	return LogStreamCommitResult{}
 }
 
-// ManagementServer is the server API for Management service.
-type ManagementServer interface {
-	// GetMetadata returns the metadata of the storage node. It produces a gRPC
-	// Unavailable error if the storage node is shutting down.
-	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
-	// AddLogStreamReplica adds a new log stream replica to a storage node.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The storage node is shutting down.
-	// - ResourceExhausted: The number of log stream replicas in the storage node
-	// reaches the upper limit.
-	// - Canceled: The client canceled the request.
-	// DeadlineExceeded: The client's timeout has expired.
-	AddLogStreamReplica(context.Context, *AddLogStreamReplicaRequest) (*AddLogStreamReplicaResponse, error)
-	// RemoveLogStream removes a log stream replica from the storage node.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The storage node is shutting down.
-	// - NotFound: The log stream replica does not exist.
-	// - Canceled: The client canceled the request.
-	// - DeadlineExceeded: The client's timeout has expired.
-	//
-	// TODO: It should be renamed to RemoveLogStreamReplica to represent its
-	// purpose precisely.
-	RemoveLogStream(context.Context, *RemoveLogStreamRequest) (*types.Empty, error)
-	// Seal changes the status of the log stream replica to LogStreamStatusSealing
-	// or LogStreamStatusSealed.
-	//
-	// It returns the following gRPC errors:
-	// - InvalidArgument: SealRequest has invalid fields; for instance, TopicID
-	// is invalid.
-	// - Unavailable: The storage node is shutting down.
-	// - NotFound: The log stream replica does not exist.
-	// - Canceled: The client canceled the request.
-	// - DeadlineExceeded: The client's timeout has expired.
-	Seal(context.Context, *SealRequest) (*SealResponse, error)
-	// Unseal changes the status of the log stream replica to
-	// LogStreamStatusRunning.
-	//
-	// It returns the following gRPC errors:
-	// - InvalidArgument: UnsealRequest has invalid fields; for instance, TopicID
-	// is invalid.
-	// - Unavailable: The storage node is shutting down.
-	// - NotFound: The log stream replica does not exist.
-	// - Canceled: The client canceled the request.
-	// - DeadlineExceeded: The client's timeout has expired.
-	Unseal(context.Context, *UnsealRequest) (*types.Empty, error)
-	// Sync duplicates log entries from the source log stream replica to the
-	// destination log stream replica.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The storage node is shutting down.
-	// - NotFound: The log stream replica does not exist.
-	// - Canceled: The client canceled the request.
-	// - DeadlineExceeded: The client's timeout has expired.
-	Sync(context.Context, *SyncRequest) (*SyncResponse, error)
-	// Trim removes prefix log entries from each log stream replica in the topic.
-	Trim(context.Context, *TrimRequest) (*TrimResponse, error)
+func (m *CheckpointRequest) GetColdTierPrefix() string {
+	if m != nil {
+		return m.ColdTierPrefix
+	}
+	return ""
 }
 
-// UnimplementedManagementServer can be embedded to have forward compatible implementations.
-type UnimplementedManagementServer struct {
+type CheckpointResponse struct {
+	// Path is the directory where the copy of the log stream replica is.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (*UnimplementedManagementServer) GetMetadata(ctx context.Context, req *GetMetadataRequest) (*GetMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+func (m *CheckpointResponse) Reset()         { *m = CheckpointResponse{} }
+func (m *CheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckpointResponse) ProtoMessage()    {}
+func (*CheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a108895042472a, []int{13}
 }
-func (*UnimplementedManagementServer) AddLogStreamReplica(ctx context.Context, req *AddLogStreamReplicaRequest) (*AddLogStreamReplicaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddLogStreamReplica not implemented")
+func (m *CheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedManagementServer) RemoveLogStream(ctx context.Context, req *RemoveLogStreamRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveLogStream not implemented")
+func (m *CheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedManagementServer) Seal(ctx context.Context, req *SealRequest) (*SealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Seal not implemented")
+func (m *CheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointResponse.Merge(m, src)
 }
-func (*UnimplementedManagementServer) Unseal(ctx context.Context, req *UnsealRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
+func (m *CheckpointResponse) XXX_Size() int {
+	return m.ProtoSize()
 }
-func (*UnimplementedManagementServer) Sync(ctx context.Context, req *SyncRequest) (*SyncResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
+func (m *CheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointResponse.DiscardUnknown(m)
 }
-func (*UnimplementedManagementServer) Trim(ctx context.Context, req *TrimRequest) (*TrimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Trim not implemented")
+
+var xxx_messageInfo_CheckpointResponse proto.InternalMessageInfo
+
+func (m *CheckpointResponse) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
 }
 
-func RegisterManagementServer(s *grpc.Server, srv ManagementServer) {
-	s.RegisterService(&_Management_serviceDesc, srv)
+type ExportRequest struct {
+	ClusterID     github_com_kakao_varlog_pkg_types.ClusterID     `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"cluster_id,omitempty"`
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,2,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
+	TopicID       github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID   github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,4,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 }
 
-func _Management_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ManagementServer).GetMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.snpb.Management/GetMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a108895042472a, []int{14}
+}
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
 }
 
-func _Management_AddLogStreamReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddLogStreamReplicaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ManagementServer).AddLogStreamReplica(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.snpb.Management/AddLogStreamReplica",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServer).AddLogStreamReplica(ctx, req.(*AddLogStreamReplicaRequest))
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetClusterID() github_com_kakao_varlog_pkg_types.ClusterID {
+	if m != nil {
+		return m.ClusterID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Management_RemoveLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveLogStreamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ManagementServer).RemoveLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.snpb.Management/RemoveLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServer).RemoveLogStream(ctx, req.(*RemoveLogStreamRequest))
+func (m *ExportRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.StorageNodeID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Management_Seal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SealRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ManagementServer).Seal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.snpb.Management/Seal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServer).Seal(ctx, req.(*SealRequest))
+func (m *ExportRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Management_Unseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsealRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ManagementServer).Unseal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.snpb.Management/Unseal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServer).Unseal(ctx, req.(*UnsealRequest))
+func (m *ExportRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Management_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SyncRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ManagementServer).Sync(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.snpb.Management/Sync",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServer).Sync(ctx, req.(*SyncRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ExportResponse struct {
+	// Data is a chunk of the archive. Chunks of all responses in the stream
+	// make up the archive.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func _Management_Trim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TrimRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ManagementServer).Trim(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.snpb.Management/Trim",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServer).Trim(ctx, req.(*TrimRequest))
+func (m *ExportResponse) Reset()         { *m = ExportResponse{} }
+func (m *ExportResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportResponse) ProtoMessage()    {}
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a108895042472a, []int{15}
+}
+func (m *ExportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Management_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "varlog.snpb.Management",
-	HandlerType: (*ManagementServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetMetadata",
-			Handler:    _Management_GetMetadata_Handler,
-		},
-		{
-			MethodName: "AddLogStreamReplica",
-			Handler:    _Management_AddLogStreamReplica_Handler,
-		},
-		{
-			MethodName: "RemoveLogStream",
-			Handler:    _Management_RemoveLogStream_Handler,
-		},
-		{
-			MethodName: "Seal",
-			Handler:    _Management_Seal_Handler,
-		},
-		{
-			MethodName: "Unseal",
-			Handler:    _Management_Unseal_Handler,
-		},
-		{
-			MethodName: "Sync",
-			Handler:    _Management_Sync_Handler,
-		},
-		{
-			MethodName: "Trim",
-			Handler:    _Management_Trim_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/snpb/management.proto",
+func (m *ExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResponse.Merge(m, src)
+}
+func (m *ExportResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResponse.DiscardUnknown(m)
 }
 
-func (m *GetMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ExportResponse proto.InternalMessageInfo
+
+func (m *ExportResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ImportRequest is a chunk of the archive imported into the storage node. The
+// first request of the stream specifies the log stream replica; the storage
+// node ignores those fields in the following requests.
+type ImportRequest struct {
+	ClusterID     github_com_kakao_varlog_pkg_types.ClusterID     `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"cluster_id,omitempty"`
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,2,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
+	TopicID       github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID   github_com_kakao_varlog_pkg_types.LogStreamID   `protobuf:"varint,4,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	// StorageNodePath is the storage node path where the replica is created.
+	// If it is empty, the first storage node path is used.
+	StorageNodePath string `protobuf:"bytes,5,opt,name=storage_node_path,json=storageNodePath,proto3" json:"storage_node_path,omitempty"`
+	Data            []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *GetMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ClusterID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
-		i--
-		dAtA[i] = 0x8
+func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
+func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportRequest) ProtoMessage()    {}
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a108895042472a, []int{16}
+}
+func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRequest.Merge(m, src)
+}
+func (m *ImportRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRequest.DiscardUnknown(m)
 }
 
-func (m *GetMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ImportRequest proto.InternalMessageInfo
+
+func (m *ImportRequest) GetClusterID() github_com_kakao_varlog_pkg_types.ClusterID {
+	if m != nil {
+		return m.ClusterID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ImportRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.StorageNodeID
+	}
+	return 0
 }
 
-func (m *GetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageNodeMetadata != nil {
-		{
-			size, err := m.StorageNodeMetadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintManagement(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *ImportRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AddLogStreamReplicaRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ImportRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddLogStreamReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return 0
 }
 
-func (m *AddLogStreamReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StorageNodePath) > 0 {
-		i -= len(m.StorageNodePath)
-		copy(dAtA[i:], m.StorageNodePath)
-		i = encodeVarintManagement(dAtA, i, uint64(len(m.StorageNodePath)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.LogStreamID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.StorageNodeID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ClusterID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
-		i--
-		dAtA[i] = 0x8
+func (m *ImportRequest) GetStorageNodePath() string {
+	if m != nil {
+		return m.StorageNodePath
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AddLogStreamReplicaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ImportRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AddLogStreamReplicaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ImportResponse struct {
+	LogStreamReplica LogStreamReplicaMetadataDescriptor `protobuf:"bytes,1,opt,name=log_stream_replica,json=logStreamReplica,proto3" json:"log_stream_replica"`
 }
 
-func (m *AddLogStreamReplicaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.LogStreamReplica.MarshalToSizedBuffer(dAtA[:i])
+func (m *ImportResponse) Reset()         { *m = ImportResponse{} }
+func (m *ImportResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportResponse) ProtoMessage()    {}
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a108895042472a, []int{17}
+}
+func (m *ImportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintManagement(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *RemoveLogStreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ImportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResponse.Merge(m, src)
 }
-
-func (m *RemoveLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ImportResponse) XXX_Size() int {
+	return m.ProtoSize()
 }
-
-func (m *RemoveLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.StorageNodeID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ClusterID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *ImportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResponse.DiscardUnknown(m)
 }
 
-func (m *SealRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ImportResponse proto.InternalMessageInfo
+
+func (m *ImportResponse) GetLogStreamReplica() LogStreamReplicaMetadataDescriptor {
+	if m != nil {
+		return m.LogStreamReplica
 	}
-	return dAtA[:n], nil
+	return LogStreamReplicaMetadataDescriptor{}
 }
 
-func (m *SealRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CheckBackupPathRequest asks the storage node whether it can access the
+// backup directory path as the admin server does. The admin server writes the
+// token into a probe file in the directory, and the storage node reads it.
+type CheckBackupPathRequest struct {
+	ClusterID     github_com_kakao_varlog_pkg_types.ClusterID     `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"cluster_id,omitempty"`
+	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,2,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
+	Path          string                                          `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Token         string                                          `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (m *SealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastCommittedGLSN != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.LastCommittedGLSN))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.LogStreamID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.StorageNodeID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ClusterID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
-		i--
-		dAtA[i] = 0x8
+func (m *CheckBackupPathRequest) Reset()         { *m = CheckBackupPathRequest{} }
+func (m *CheckBackupPathRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckBackupPathRequest) ProtoMessage()    {}
+func (*CheckBackupPathRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a108895042472a, []int{18}
+}
+func (m *CheckBackupPathRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckBackupPathRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckBackupPathRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CheckBackupPathRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckBackupPathRequest.Merge(m, src)
+}
+func (m *CheckBackupPathRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CheckBackupPathRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckBackupPathRequest.DiscardUnknown(m)
 }
 
-func (m *SealResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CheckBackupPathRequest proto.InternalMessageInfo
+
+func (m *CheckBackupPathRequest) GetClusterID() github_com_kakao_varlog_pkg_types.ClusterID {
+	if m != nil {
+		return m.ClusterID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SealResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CheckBackupPathRequest) GetStorageNodeID() github_com_kakao_varlog_pkg_types.StorageNodeID {
+	if m != nil {
+		return m.StorageNodeID
+	}
+	return 0
 }
 
-func (m *SealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastCommittedGLSN != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.LastCommittedGLSN))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Status != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+func (m *CheckBackupPathRequest) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UnsealRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CheckBackupPathRequest) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UnsealRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CheckBackupPathResponse struct {
 }
 
-func (m *UnsealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Replicas) > 0 {
-		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintManagement(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+func (m *CheckBackupPathResponse) Reset()         { *m = CheckBackupPathResponse{} }
+func (m *CheckBackupPathResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckBackupPathResponse) ProtoMessage()    {}
+func (*CheckBackupPathResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a108895042472a, []int{19}
+}
+func (m *CheckBackupPathResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckBackupPathResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckBackupPathResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.LogStreamID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.StorageNodeID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ClusterID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *CheckBackupPathResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckBackupPathResponse.Merge(m, src)
+}
+func (m *CheckBackupPathResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CheckBackupPathResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckBackupPathResponse.DiscardUnknown(m)
 }
 
-func (m *SyncRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_CheckBackupPathResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GetMetadataRequest)(nil), "varlog.snpb.GetMetadataRequest")
+	proto.RegisterType((*GetMetadataResponse)(nil), "varlog.snpb.GetMetadataResponse")
+	proto.RegisterType((*AddLogStreamReplicaRequest)(nil), "varlog.snpb.AddLogStreamReplicaRequest")
+	proto.RegisterType((*AddLogStreamReplicaResponse)(nil), "varlog.snpb.AddLogStreamReplicaResponse")
+	proto.RegisterType((*RemoveLogStreamRequest)(nil), "varlog.snpb.RemoveLogStreamRequest")
+	proto.RegisterType((*SealRequest)(nil), "varlog.snpb.SealRequest")
+	proto.RegisterType((*SealResponse)(nil), "varlog.snpb.SealResponse")
+	proto.RegisterType((*UnsealRequest)(nil), "varlog.snpb.UnsealRequest")
+	proto.RegisterType((*SyncRequest)(nil), "varlog.snpb.SyncRequest")
+	proto.RegisterType((*SyncRequest_BackupNode)(nil), "varlog.snpb.SyncRequest.BackupNode")
+	proto.RegisterType((*SyncResponse)(nil), "varlog.snpb.SyncResponse")
+	proto.RegisterType((*TrimRequest)(nil), "varlog.snpb.TrimRequest")
+	proto.RegisterType((*TrimResponse)(nil), "varlog.snpb.TrimResponse")
+	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.LogStreamID]string)(nil), "varlog.snpb.TrimResponse.ResultsEntry")
+	proto.RegisterType((*CheckpointRequest)(nil), "varlog.snpb.CheckpointRequest")
+	proto.RegisterType((*CheckpointResponse)(nil), "varlog.snpb.CheckpointResponse")
+	proto.RegisterType((*ExportRequest)(nil), "varlog.snpb.ExportRequest")
+	proto.RegisterType((*ExportResponse)(nil), "varlog.snpb.ExportResponse")
+	proto.RegisterType((*ImportRequest)(nil), "varlog.snpb.ImportRequest")
+	proto.RegisterType((*ImportResponse)(nil), "varlog.snpb.ImportResponse")
+	proto.RegisterType((*CheckBackupPathRequest)(nil), "varlog.snpb.CheckBackupPathRequest")
+	proto.RegisterType((*CheckBackupPathResponse)(nil), "varlog.snpb.CheckBackupPathResponse")
 }
 
-func (m *SyncRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("proto/snpb/management.proto", fileDescriptor_b2a108895042472a) }
+
+var fileDescriptor_b2a108895042472a = []byte{
+	// 1281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x8f, 0xdb, 0xc4,
+	0x17, 0x8f, 0x37, 0xd9, 0xec, 0xe6, 0x25, 0xd9, 0xed, 0xce, 0xf6, 0x47, 0xd6, 0x2b, 0x25, 0xf9,
+	0xfa, 0x5b, 0x95, 0x50, 0xd4, 0x04, 0x82, 0x84, 0xaa, 0xaa, 0xa5, 0x34, 0xdb, 0x55, 0x15, 0xa9,
+	0x2d, 0x95, 0x53, 0x2e, 0x20, 0x35, 0xf2, 0xda, 0x53, 0xaf, 0x89, 0xed, 0x71, 0xed, 0xc9, 0xaa,
+	0xb9, 0x56, 0x5c, 0x91, 0x38, 0xc0, 0x15, 0x71, 0xe2, 0x5f, 0x40, 0x9c, 0xb8, 0xf6, 0x84, 0x7a,
+	0x41, 0x42, 0x3d, 0x04, 0x29, 0x7b, 0xe6, 0x1f, 0xe8, 0x09, 0x79, 0xc6, 0x76, 0xec, 0xfc, 0x20,
+	0xaa, 0x44, 0xab, 0x80, 0x72, 0xf3, 0xcc, 0x7b, 0xf3, 0xde, 0xbc, 0xf7, 0x3e, 0xf3, 0xe6, 0xcd,
+	0x33, 0xec, 0x3b, 0x2e, 0xa1, 0xa4, 0xe1, 0xd9, 0xce, 0x51, 0xc3, 0x52, 0x6c, 0x45, 0xc7, 0x16,
+	0xb6, 0x69, 0x9d, 0xcd, 0xa2, 0xfc, 0x89, 0xe2, 0x9a, 0x44, 0xaf, 0xfb, 0x54, 0xf1, 0x8a, 0x6e,
+	0xd0, 0xe3, 0xfe, 0x51, 0x5d, 0x25, 0x56, 0x43, 0x27, 0x3a, 0x69, 0x30, 0x9e, 0xa3, 0xfe, 0x63,
+	0x36, 0xe2, 0x62, 0xfc, 0x2f, 0xbe, 0x56, 0xdc, 0xd7, 0x09, 0xd1, 0x4d, 0x3c, 0xe6, 0xc2, 0x96,
+	0x43, 0x07, 0x01, 0xf1, 0x02, 0x17, 0xec, 0xeb, 0xc4, 0x54, 0xd1, 0x14, 0xaa, 0x04, 0x84, 0x5d,
+	0xcf, 0x9e, 0x9e, 0x3c, 0xc7, 0x26, 0x5d, 0xec, 0x98, 0x86, 0xaa, 0x50, 0xe2, 0x06, 0xd3, 0x65,
+	0x36, 0x6d, 0x12, 0xbd, 0xeb, 0x51, 0x17, 0x2b, 0x56, 0xd7, 0xc5, 0x0e, 0x71, 0x29, 0x0e, 0xe8,
+	0xd2, 0x13, 0x40, 0x77, 0x30, 0xbd, 0x17, 0xc8, 0x92, 0xf1, 0x93, 0x3e, 0xf6, 0x28, 0xfa, 0x02,
+	0x40, 0x35, 0xfb, 0x1e, 0xc5, 0x6e, 0xd7, 0xd0, 0x4a, 0x42, 0x55, 0xa8, 0x15, 0x5b, 0xd7, 0x47,
+	0xc3, 0x4a, 0xee, 0x80, 0xcf, 0xb6, 0x6f, 0xbf, 0x1a, 0x56, 0xde, 0x8b, 0xd9, 0xda, 0x53, 0x7a,
+	0x0a, 0x69, 0xf0, 0x0d, 0x37, 0x9c, 0x9e, 0xde, 0xa0, 0x03, 0x07, 0x7b, 0xf5, 0x88, 0x5d, 0xce,
+	0x05, 0xf2, 0xda, 0x9a, 0xd4, 0x87, 0xdd, 0x84, 0x4a, 0xcf, 0x21, 0xb6, 0x87, 0xd1, 0x23, 0x38,
+	0xe7, 0x51, 0xe2, 0x2a, 0x3a, 0xee, 0xda, 0x44, 0xc3, 0xdd, 0xd0, 0x3e, 0xa6, 0x3e, 0xdf, 0xbc,
+	0x5c, 0x8f, 0xf9, 0xb9, 0xde, 0xe1, 0x9c, 0xf7, 0x89, 0x86, 0x43, 0x41, 0xb7, 0xb1, 0xa7, 0xba,
+	0x86, 0x43, 0x89, 0x2b, 0xef, 0x7a, 0xd3, 0x64, 0xe9, 0xd7, 0x34, 0x88, 0xb7, 0x34, 0xed, 0x2e,
+	0xd1, 0x3b, 0xcc, 0x13, 0x32, 0x77, 0xd5, 0xdb, 0x30, 0x19, 0x99, 0xb0, 0x9d, 0xb0, 0xcd, 0xd0,
+	0x4a, 0x6b, 0x55, 0xa1, 0xb6, 0xde, 0xba, 0x3d, 0x1a, 0x56, 0x8a, 0x31, 0x63, 0x98, 0x96, 0xc6,
+	0x62, 0x2d, 0x89, 0x25, 0x72, 0x31, 0x66, 0x6f, 0x5b, 0x43, 0x1d, 0xd8, 0xa4, 0xc4, 0x31, 0x54,
+	0x5f, 0x4d, 0x9a, 0xa9, 0xb9, 0x3a, 0x1a, 0x56, 0x36, 0x1e, 0xfa, 0x73, 0x4c, 0xc1, 0xbb, 0x8b,
+	0x15, 0x04, 0xcc, 0xf2, 0x06, 0x93, 0xd4, 0xd6, 0x90, 0x06, 0xc5, 0x18, 0x8a, 0x0c, 0xad, 0x94,
+	0x61, 0x92, 0x3f, 0x19, 0x0d, 0x2b, 0xf9, 0xc8, 0xa7, 0x4c, 0xfa, 0x95, 0xc5, 0xd2, 0x63, 0x0b,
+	0xe4, 0xbc, 0x19, 0x0d, 0x34, 0x74, 0x19, 0x76, 0x12, 0x8e, 0x72, 0x14, 0x7a, 0x5c, 0x5a, 0xaf,
+	0x0a, 0xb5, 0x9c, 0xbc, 0x1d, 0x33, 0xf2, 0x81, 0x42, 0x8f, 0xa5, 0x67, 0x02, 0xec, 0xcf, 0x0c,
+	0x68, 0x00, 0x28, 0x15, 0x50, 0x12, 0xf7, 0x3e, 0x35, 0x40, 0x53, 0x23, 0x81, 0xa6, 0x49, 0x11,
+	0xd3, 0x90, 0x6a, 0x65, 0x9e, 0x0f, 0x2b, 0x29, 0xf9, 0x8c, 0x39, 0xc1, 0x29, 0x7d, 0x9f, 0x86,
+	0xf3, 0x32, 0xb6, 0xc8, 0x09, 0x8e, 0x09, 0x59, 0x21, 0x6a, 0x69, 0x10, 0x25, 0x7d, 0x95, 0x81,
+	0x7c, 0x07, 0x2b, 0xe6, 0x2a, 0x2a, 0xcb, 0x74, 0xce, 0x09, 0xec, 0x9a, 0x8a, 0x47, 0xbb, 0x2a,
+	0xb1, 0x2c, 0x83, 0x52, 0xac, 0x75, 0x75, 0xd3, 0xb3, 0xd9, 0x49, 0xcf, 0xb4, 0x6e, 0x8e, 0x86,
+	0x95, 0x9d, 0xbb, 0x8a, 0x47, 0x0f, 0x42, 0xea, 0x9d, 0xbb, 0x9d, 0xfb, 0xaf, 0x86, 0x95, 0x4b,
+	0x8b, 0x35, 0xfa, 0x9c, 0xf2, 0x8e, 0x99, 0x58, 0x6c, 0x7a, 0xb6, 0xf4, 0xb3, 0x00, 0x05, 0x0e,
+	0x83, 0x20, 0x3b, 0x5c, 0x85, 0xac, 0x47, 0x15, 0xda, 0xf7, 0x18, 0x06, 0xb6, 0x9a, 0xd5, 0x30,
+	0x23, 0x84, 0xb7, 0xee, 0x78, 0xf3, 0x1d, 0xc6, 0x27, 0x07, 0xfc, 0xf3, 0xf6, 0xbe, 0xf6, 0xc6,
+	0xf6, 0xfe, 0x32, 0x0d, 0xc5, 0xcf, 0x6c, 0x6f, 0x05, 0xe2, 0x25, 0x03, 0xf1, 0x01, 0x6c, 0x06,
+	0xb7, 0x8a, 0x57, 0x5a, 0xaf, 0xa6, 0x6b, 0xf9, 0xe6, 0xff, 0xe6, 0x83, 0x28, 0xb8, 0x30, 0x82,
+	0x8b, 0x24, 0x5a, 0x28, 0xfd, 0xe9, 0xe7, 0xa7, 0x81, 0xad, 0xae, 0x42, 0xbb, 0x4c, 0xa1, 0xbd,
+	0x05, 0xd9, 0x23, 0x45, 0xed, 0xf5, 0x1d, 0x96, 0x92, 0xf2, 0xcd, 0xff, 0x27, 0xab, 0xcf, 0x71,
+	0xbc, 0xea, 0x2d, 0xc6, 0xe6, 0x5b, 0xcc, 0x42, 0x2b, 0xc8, 0xc1, 0x42, 0xf1, 0x5b, 0x01, 0x60,
+	0x4c, 0x9c, 0xe5, 0x7a, 0xe1, 0xcd, 0xb9, 0xbe, 0x04, 0x1b, 0x8a, 0xa6, 0xb9, 0xd8, 0xf3, 0x58,
+	0x80, 0x73, 0x72, 0x38, 0x94, 0x6e, 0x42, 0x81, 0x6f, 0x3f, 0xc8, 0x83, 0x8d, 0x44, 0x1e, 0xcc,
+	0x37, 0x2f, 0x4c, 0x59, 0x9a, 0x4c, 0x7f, 0xd2, 0x4f, 0x02, 0xe4, 0x1f, 0xba, 0x46, 0x54, 0xe6,
+	0xc4, 0xa3, 0x2c, 0xfc, 0x53, 0x51, 0xee, 0x40, 0x8e, 0xe5, 0xd8, 0x58, 0x66, 0xfd, 0x68, 0x34,
+	0xac, 0x6c, 0xfa, 0x99, 0xf5, 0x35, 0x13, 0xea, 0xa6, 0x2f, 0x88, 0xe5, 0xd1, 0x5f, 0x04, 0x28,
+	0xf0, 0x9d, 0x07, 0xb6, 0x7b, 0xb0, 0xe1, 0x62, 0xaf, 0x6f, 0x52, 0xdf, 0x78, 0xff, 0xfc, 0x5e,
+	0x4a, 0x18, 0x1f, 0xe7, 0xad, 0xcb, 0x9c, 0xf1, 0xd0, 0xa6, 0xee, 0xa0, 0xf5, 0xc1, 0xb3, 0x3f,
+	0x5e, 0x17, 0x5e, 0xa1, 0x26, 0xf1, 0x1a, 0x14, 0xe2, 0xb2, 0xd0, 0x19, 0x48, 0xf7, 0xf0, 0x80,
+	0xbb, 0x4e, 0xf6, 0x3f, 0xd1, 0x59, 0x58, 0x3f, 0x51, 0xcc, 0x3e, 0x0e, 0x42, 0xc7, 0x07, 0xd7,
+	0xd6, 0xae, 0x0a, 0xd2, 0x8f, 0x19, 0xd8, 0x39, 0x38, 0xc6, 0x6a, 0xcf, 0x21, 0x86, 0x4d, 0x57,
+	0x29, 0x63, 0x99, 0x52, 0x06, 0x82, 0x4c, 0xec, 0xb5, 0xc2, 0xbe, 0xd1, 0x3d, 0x28, 0xf2, 0x2a,
+	0xa1, 0xcb, 0xa3, 0x5f, 0xca, 0xb2, 0x33, 0x26, 0xcd, 0x7e, 0x7d, 0xf0, 0x5b, 0x9f, 0x83, 0x23,
+	0xb8, 0x27, 0x0a, 0x6a, 0x6c, 0x0e, 0xd5, 0xe0, 0x8c, 0x4a, 0x4c, 0xad, 0x4b, 0x0d, 0xec, 0x76,
+	0x1d, 0x17, 0x3f, 0x36, 0x9e, 0x96, 0x36, 0x98, 0xba, 0x2d, 0x7f, 0xfe, 0xa1, 0x81, 0xdd, 0x07,
+	0x6c, 0x56, 0xaa, 0x01, 0x8a, 0xe3, 0x24, 0xc0, 0x7b, 0xb8, 0x45, 0x61, 0xbc, 0x45, 0xe9, 0xbb,
+	0x34, 0x14, 0x0f, 0x9f, 0x3a, 0xc4, 0x5d, 0xc1, 0x69, 0xa9, 0xde, 0x2d, 0x17, 0x61, 0x2b, 0x0c,
+	0xcb, 0x38, 0x7a, 0x51, 0x3f, 0xa4, 0x20, 0xb3, 0x6f, 0xe9, 0xb7, 0x34, 0x14, 0xdb, 0xd6, 0x2a,
+	0x7a, 0xff, 0xe2, 0x3e, 0x46, 0x14, 0xd7, 0x6c, 0x2c, 0xae, 0x7d, 0xd8, 0x6a, 0x5b, 0x89, 0xe8,
+	0xbf, 0x95, 0x6e, 0xc6, 0xd7, 0x6b, 0x70, 0x9e, 0xe5, 0x0d, 0x5e, 0xb8, 0xf8, 0xdb, 0xfb, 0x0f,
+	0xe2, 0x2a, 0x4c, 0x83, 0xe9, 0x58, 0xa6, 0x3e, 0x0b, 0xeb, 0x94, 0xf4, 0xb0, 0xcd, 0xe0, 0x90,
+	0x93, 0xf9, 0x40, 0xda, 0x83, 0x0b, 0x53, 0xee, 0xe0, 0xf1, 0x68, 0xbe, 0xcc, 0x02, 0xdc, 0x8b,
+	0x7a, 0xc1, 0x48, 0x86, 0x7c, 0xac, 0xa9, 0x89, 0x2a, 0x89, 0x88, 0x4c, 0x77, 0x58, 0xc5, 0xea,
+	0x7c, 0x06, 0xae, 0x40, 0x4a, 0xa1, 0x2f, 0x61, 0x77, 0x46, 0x7f, 0x0b, 0xbd, 0x93, 0x58, 0x3a,
+	0xbf, 0xa5, 0x29, 0xd6, 0x16, 0x33, 0x46, 0xba, 0x1e, 0xc0, 0xf6, 0x44, 0x1b, 0x0b, 0x25, 0x6b,
+	0xde, 0xd9, 0x4d, 0x2e, 0xf1, 0x7c, 0x9d, 0xb7, 0xb0, 0xeb, 0x61, 0x0b, 0xbb, 0x7e, 0xe8, 0xb7,
+	0xb0, 0xa5, 0x14, 0xba, 0x01, 0x19, 0xff, 0xc1, 0x8d, 0x4a, 0xc9, 0x82, 0x72, 0xfc, 0x8a, 0x15,
+	0xf7, 0x66, 0x50, 0xa2, 0x0d, 0x7d, 0x0c, 0x59, 0xfe, 0xe6, 0x45, 0x62, 0x82, 0x2d, 0xf1, 0x10,
+	0x5e, 0xa0, 0x7e, 0x60, 0xab, 0x93, 0xea, 0xc7, 0x95, 0xbb, 0xb8, 0x37, 0x83, 0x12, 0xa9, 0xbf,
+	0x01, 0x19, 0xbf, 0xfc, 0x9b, 0x58, 0x1e, 0xab, 0x7b, 0xc5, 0xbd, 0x19, 0x94, 0x68, 0xf9, 0xa7,
+	0x00, 0xe3, 0xfb, 0x17, 0x95, 0x13, 0xac, 0x53, 0x05, 0x9c, 0x58, 0x99, 0x4b, 0x8f, 0x04, 0x3e,
+	0x82, 0xed, 0x09, 0x24, 0x4e, 0xc4, 0x67, 0xf6, 0xb1, 0x15, 0x2f, 0xfe, 0x3d, 0x53, 0x24, 0xff,
+	0x10, 0xb2, 0xfc, 0xba, 0x99, 0x70, 0x77, 0xa2, 0x34, 0x10, 0xf7, 0x67, 0xd2, 0x42, 0x21, 0xef,
+	0x0b, 0xbe, 0x98, 0xb6, 0x35, 0x43, 0x4c, 0xdb, 0x9a, 0x2f, 0x26, 0x99, 0xe8, 0xa4, 0x54, 0x4d,
+	0x68, 0x5d, 0x7f, 0x3e, 0x2a, 0x0b, 0x2f, 0x46, 0x65, 0xe1, 0x9b, 0xd3, 0x72, 0xea, 0x87, 0xd3,
+	0xb2, 0xf0, 0xe2, 0xb4, 0x9c, 0xfa, 0xfd, 0xb4, 0x9c, 0xfa, 0x5c, 0x9a, 0x7b, 0xf6, 0xa3, 0x5f,
+	0x34, 0x47, 0x59, 0xf6, 0xfd, 0xe1, 0x5f, 0x03, 0x00, 0xee, 0xa2, 0x36, 0x26, 0xb7, 0x19, 0x00,
+	0x00,
 }
 
-func (m *SyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Backup != nil {
-		{
-			size, err := m.Backup.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintManagement(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.LogStreamID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.StorageNodeID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ClusterID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ManagementClient is the client API for Management service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ManagementClient interface {
+	// GetMetadata returns the metadata of the storage node. It produces a gRPC
+	// Unavailable error if the storage node is shutting down.
+	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
+	// AddLogStreamReplica adds a new log stream replica to a storage node.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The storage node is shutting down.
+	// - ResourceExhausted: The number of log stream replicas in the storage node
+	// reaches the upper limit.
+	// - Canceled: The client canceled the request.
+	// DeadlineExceeded: The client's timeout has expired.
+	AddLogStreamReplica(ctx context.Context, in *AddLogStreamReplicaRequest, opts ...grpc.CallOption) (*AddLogStreamReplicaResponse, error)
+	// RemoveLogStream removes a log stream replica from the storage node.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The storage node is shutting down.
+	// - NotFound: The log stream replica does not exist.
+	// - Canceled: The client canceled the request.
+	// - DeadlineExceeded: The client's timeout has expired.
+	//
+	// TODO: It should be renamed to RemoveLogStreamReplica to represent its
+	// purpose precisely.
+	RemoveLogStream(ctx context.Context, in *RemoveLogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Seal changes the status of the log stream replica to LogStreamStatusSealing
+	// or LogStreamStatusSealed.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: SealRequest has invalid fields; for instance, TopicID
+	// is invalid.
+	// - Unavailable: The storage node is shutting down.
+	// - NotFound: The log stream replica does not exist.
+	// - Canceled: The client canceled the request.
+	// - DeadlineExceeded: The client's timeout has expired.
+	Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error)
+	// Unseal changes the status of the log stream replica to
+	// LogStreamStatusRunning.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: UnsealRequest has invalid fields; for instance, TopicID
+	// is invalid.
+	// - Unavailable: The storage node is shutting down.
+	// - NotFound: The log stream replica does not exist.
+	// - Canceled: The client canceled the request.
+	// - DeadlineExceeded: The client's timeout has expired.
+	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Sync duplicates log entries from the source log stream replica to the
+	// destination log stream replica.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The storage node is shutting down.
+	// - NotFound: The log stream replica does not exist.
+	// - Canceled: The client canceled the request.
+	// - DeadlineExceeded: The client's timeout has expired.
+	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
+	// Trim removes prefix log entries from each log stream replica in the topic.
+	Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error)
+	// Checkpoint copies the log stream replica into a directory. It waits for
+	// the replica to commit log entries in the requested commit result.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The storage node is shutting down.
+	// - NotFound: The log stream replica does not exist.
+	// - AlreadyExists: The directory for the copy already exists.
+	// - FailedPrecondition: The replica is sealed or learning before committing
+	// the log entries.
+	// - Canceled: The client canceled the request.
+	// - DeadlineExceeded: The client's timeout has expired.
+	Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error)
+	// CheckBackupPath checks whether the storage node can read and write the
+	// backup directory written by the admin server, that is, whether they share
+	// the file system having the directory.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The storage node is shutting down.
+	// - InvalidArgument: The path is not absolute.
+	// - FailedPrecondition: The storage node cannot read the probe file having
+	// the token or cannot write into the directory.
+	CheckBackupPath(ctx context.Context, in *CheckBackupPathRequest, opts ...grpc.CallOption) (*CheckBackupPathResponse, error)
+	// Export streams the archive of committed log entries in the log stream
+	// replica, including ones in the cold tier. The archive can be restored by
+	// importing it into an empty replica.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The storage node is shutting down.
+	// - NotFound: The log stream replica does not exist.
+	// - FailedPrecondition: The log stream replica has never committed.
+	// - Canceled: The client canceled the request.
+	// - DeadlineExceeded: The client's timeout has expired.
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Management_ExportClient, error)
+	// Import creates a log stream replica from the archive created by Export
+	// and adds it to the storage node. The storage node restores the archive
+	// with its own storage options, for instance, the cold tier.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The storage node is shutting down.
+	// - InvalidArgument: The storage node has no such storage node path, or the
+	// archive is invalid.
+	// - AlreadyExists: The log stream replica already exists.
+	// - ResourceExhausted: The storage node has too many replicas.
+	// - Canceled: The client canceled the request.
+	// - DeadlineExceeded: The client's timeout has expired.
+	Import(ctx context.Context, opts ...grpc.CallOption) (Management_ImportClient, error)
 }
 
-func (m *SyncRequest_BackupNode) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type managementClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *SyncRequest_BackupNode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func NewManagementClient(cc *grpc.ClientConn) ManagementClient {
+	return &managementClient{cc}
 }
 
-func (m *SyncRequest_BackupNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintManagement(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.StorageNodeID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
-		i--
-		dAtA[i] = 0x8
+func (c *managementClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
+	out := new(GetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/GetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SyncResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *managementClient) AddLogStreamReplica(ctx context.Context, in *AddLogStreamReplicaRequest, opts ...grpc.CallOption) (*AddLogStreamReplicaResponse, error) {
+	out := new(AddLogStreamReplicaResponse)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/AddLogStreamReplica", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SyncResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintManagement(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *managementClient) RemoveLogStream(ctx context.Context, in *RemoveLogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/RemoveLogStream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TrimRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *managementClient) Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error) {
+	out := new(SealResponse)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/Seal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TrimRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *managementClient) Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/Unseal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TrimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastGLSN != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.LastGLSN))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+func (c *managementClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
+	out := new(SyncResponse)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/Sync", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TrimResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *managementClient) Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error) {
+	out := new(TrimResponse)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/Trim", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TrimResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *managementClient) Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error) {
+	out := new(CheckpointResponse)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/Checkpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TrimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for k := range m.Results {
-			v := m.Results[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintManagement(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarintManagement(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintManagement(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *managementClient) CheckBackupPath(ctx context.Context, in *CheckBackupPathRequest, opts ...grpc.CallOption) (*CheckBackupPathResponse, error) {
+	out := new(CheckBackupPathResponse)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.Management/CheckBackupPath", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintManagement(dAtA []byte, offset int, v uint64) int {
-	offset -= sovManagement(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *managementClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Management_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Management_serviceDesc.Streams[0], "/varlog.snpb.Management/Export", opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GetMetadataRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	x := &managementExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ClusterID != 0 {
-		n += 1 + sovManagement(uint64(m.ClusterID))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return n
+	return x, nil
 }
 
-func (m *GetMetadataResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StorageNodeMetadata != nil {
-		l = m.StorageNodeMetadata.ProtoSize()
-		n += 1 + l + sovManagement(uint64(l))
-	}
-	return n
+type Management_ExportClient interface {
+	Recv() (*ExportResponse, error)
+	grpc.ClientStream
 }
 
-func (m *AddLogStreamReplicaRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClusterID != 0 {
-		n += 1 + sovManagement(uint64(m.ClusterID))
-	}
-	if m.StorageNodeID != 0 {
-		n += 1 + sovManagement(uint64(m.StorageNodeID))
-	}
-	if m.TopicID != 0 {
-		n += 1 + sovManagement(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovManagement(uint64(m.LogStreamID))
-	}
-	l = len(m.StorageNodePath)
-	if l > 0 {
-		n += 1 + l + sovManagement(uint64(l))
-	}
-	return n
+type managementExportClient struct {
+	grpc.ClientStream
 }
 
-func (m *AddLogStreamReplicaResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (x *managementExportClient) Recv() (*ExportResponse, error) {
+	m := new(ExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.LogStreamReplica.ProtoSize()
-	n += 1 + l + sovManagement(uint64(l))
-	return n
+	return m, nil
 }
 
-func (m *RemoveLogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClusterID != 0 {
-		n += 1 + sovManagement(uint64(m.ClusterID))
-	}
-	if m.StorageNodeID != 0 {
-		n += 1 + sovManagement(uint64(m.StorageNodeID))
-	}
-	if m.TopicID != 0 {
-		n += 1 + sovManagement(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovManagement(uint64(m.LogStreamID))
+func (c *managementClient) Import(ctx context.Context, opts ...grpc.CallOption) (Management_ImportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Management_serviceDesc.Streams[1], "/varlog.snpb.Management/Import", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	x := &managementImportClient{stream}
+	return x, nil
 }
 
-func (m *SealRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClusterID != 0 {
-		n += 1 + sovManagement(uint64(m.ClusterID))
-	}
-	if m.StorageNodeID != 0 {
-		n += 1 + sovManagement(uint64(m.StorageNodeID))
-	}
-	if m.TopicID != 0 {
-		n += 1 + sovManagement(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovManagement(uint64(m.LogStreamID))
-	}
-	if m.LastCommittedGLSN != 0 {
-		n += 1 + sovManagement(uint64(m.LastCommittedGLSN))
-	}
-	return n
+type Management_ImportClient interface {
+	Send(*ImportRequest) error
+	CloseAndRecv() (*ImportResponse, error)
+	grpc.ClientStream
 }
 
-func (m *SealResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovManagement(uint64(m.Status))
-	}
-	if m.LastCommittedGLSN != 0 {
-		n += 1 + sovManagement(uint64(m.LastCommittedGLSN))
-	}
-	return n
+type managementImportClient struct {
+	grpc.ClientStream
 }
 
-func (m *UnsealRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClusterID != 0 {
-		n += 1 + sovManagement(uint64(m.ClusterID))
-	}
-	if m.StorageNodeID != 0 {
-		n += 1 + sovManagement(uint64(m.StorageNodeID))
-	}
-	if m.TopicID != 0 {
-		n += 1 + sovManagement(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovManagement(uint64(m.LogStreamID))
+func (x *managementImportClient) Send(m *ImportRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *managementImportClient) CloseAndRecv() (*ImportResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if len(m.Replicas) > 0 {
-		for _, e := range m.Replicas {
-			l = e.ProtoSize()
-			n += 1 + l + sovManagement(uint64(l))
-		}
+	m := new(ImportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *SyncRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClusterID != 0 {
-		n += 1 + sovManagement(uint64(m.ClusterID))
+// ManagementServer is the server API for Management service.
+type ManagementServer interface {
+	// GetMetadata returns the metadata of the storage node. It produces a gRPC
+	// Unavailable error if the storage node is shutting down.
+	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
+	// AddLogStreamReplica adds a new log stream replica to a storage node.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The storage node is shutting down.
+	// - ResourceExhausted: The number of log stream replicas in the storage node
+	// reaches the upper limit.
+	// - Canceled: The client canceled the request.
+	// DeadlineExceeded: The client's timeout has expired.
+	AddLogStreamReplica(context.Context, *AddLogStreamReplicaRequest) (*AddLogStreamReplicaResponse, error)
+	// RemoveLogStream removes a log stream replica from the storage node.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The storage node is shutting down.
+	// - NotFound: The log stream replica does not exist.
+	// - Canceled: The client canceled the request.
+	// - DeadlineExceeded: The client's timeout has expired.
+	//
+	// TODO: It should be renamed to RemoveLogStreamReplica to represent its
+	// purpose precisely.
+	RemoveLogStream(context.Context, *RemoveLogStreamRequest) (*types.Empty, error)
+	// Seal changes the status of the log stream replica to LogStreamStatusSealing
+	// or LogStreamStatusSealed.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: SealRequest has invalid fields; for instance, TopicID
+	// is invalid.
+	// - Unavailable: The storage node is shutting down.
+	// - NotFound: The log stream replica does not exist.
+	// - Canceled: The client canceled the request.
+	// - DeadlineExceeded: The client's timeout has expired.
+	Seal(context.Context, *SealRequest) (*SealResponse, error)
+	// Unseal changes the status of the log stream replica to
+	// LogStreamStatusRunning.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: UnsealRequest has invalid fields; for instance, TopicID
+	// is invalid.
+	// - Unavailable: The storage node is shutting down.
+	// - NotFound: The log stream replica does not exist.
+	// - Canceled: The client canceled the request.
+	// - DeadlineExceeded: The client's timeout has expired.
+	Unseal(context.Context, *UnsealRequest) (*types.Empty, error)
+	// Sync duplicates log entries from the source log stream replica to the
+	// destination log stream replica.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The storage node is shutting down.
+	// - NotFound: The log stream replica does not exist.
+	// - Canceled: The client canceled the request.
+	// - DeadlineExceeded: The client's timeout has expired.
+	Sync(context.Context, *SyncRequest) (*SyncResponse, error)
+	// Trim removes prefix log entries from each log stream replica in the topic.
+	Trim(context.Context, *TrimRequest) (*TrimResponse, error)
+	// Checkpoint copies the log stream replica into a directory. It waits for
+	// the replica to commit log entries in the requested commit result.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The storage node is shutting down.
+	// - NotFound: The log stream replica does not exist.
+	// - AlreadyExists: The directory for the copy already exists.
+	// - FailedPrecondition: The replica is sealed or learning before committing
+	// the log entries.
+	// - Canceled: The client canceled the request.
+	// - DeadlineExceeded: The client's timeout has expired.
+	Checkpoint(context.Context, *CheckpointRequest) (*CheckpointResponse, error)
+	// CheckBackupPath checks whether the storage node can read and write the
+	// backup directory written by the admin server, that is, whether they share
+	// the file system having the directory.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The storage node is shutting down.
+	// - InvalidArgument: The path is not absolute.
+	// - FailedPrecondition: The storage node cannot read the probe file having
+	// the token or cannot write into the directory.
+	CheckBackupPath(context.Context, *CheckBackupPathRequest) (*CheckBackupPathResponse, error)
+	// Export streams the archive of committed log entries in the log stream
+	// replica, including ones in the cold tier. The archive can be restored by
+	// importing it into an empty replica.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The storage node is shutting down.
+	// - NotFound: The log stream replica does not exist.
+	// - FailedPrecondition: The log stream replica has never committed.
+	// - Canceled: The client canceled the request.
+	// - DeadlineExceeded: The client's timeout has expired.
+	Export(*ExportRequest, Management_ExportServer) error
+	// Import creates a log stream replica from the archive created by Export
+	// and adds it to the storage node. The storage node restores the archive
+	// with its own storage options, for instance, the cold tier.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The storage node is shutting down.
+	// - InvalidArgument: The storage node has no such storage node path, or the
+	// archive is invalid.
+	// - AlreadyExists: The log stream replica already exists.
+	// - ResourceExhausted: The storage node has too many replicas.
+	// - Canceled: The client canceled the request.
+	// - DeadlineExceeded: The client's timeout has expired.
+	Import(Management_ImportServer) error
+}
+
+// UnimplementedManagementServer can be embedded to have forward compatible implementations.
+type UnimplementedManagementServer struct {
+}
+
+func (*UnimplementedManagementServer) GetMetadata(ctx context.Context, req *GetMetadataRequest) (*GetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+}
+func (*UnimplementedManagementServer) AddLogStreamReplica(ctx context.Context, req *AddLogStreamReplicaRequest) (*AddLogStreamReplicaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLogStreamReplica not implemented")
+}
+func (*UnimplementedManagementServer) RemoveLogStream(ctx context.Context, req *RemoveLogStreamRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLogStream not implemented")
+}
+func (*UnimplementedManagementServer) Seal(ctx context.Context, req *SealRequest) (*SealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Seal not implemented")
+}
+func (*UnimplementedManagementServer) Unseal(ctx context.Context, req *UnsealRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
+}
+func (*UnimplementedManagementServer) Sync(ctx context.Context, req *SyncRequest) (*SyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
+func (*UnimplementedManagementServer) Trim(ctx context.Context, req *TrimRequest) (*TrimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Trim not implemented")
+}
+func (*UnimplementedManagementServer) Checkpoint(ctx context.Context, req *CheckpointRequest) (*CheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoint not implemented")
+}
+func (*UnimplementedManagementServer) CheckBackupPath(ctx context.Context, req *CheckBackupPathRequest) (*CheckBackupPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckBackupPath not implemented")
+}
+func (*UnimplementedManagementServer) Export(req *ExportRequest, srv Management_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedManagementServer) Import(srv Management_ImportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+
+func RegisterManagementServer(s *grpc.Server, srv ManagementServer) {
+	s.RegisterService(&_Management_serviceDesc, srv)
+}
+
+func _Management_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.StorageNodeID != 0 {
-		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	if interceptor == nil {
+		return srv.(ManagementServer).GetMetadata(ctx, in)
 	}
-	if m.TopicID != 0 {
-		n += 1 + sovManagement(uint64(m.TopicID))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.Management/GetMetadata",
 	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovManagement(uint64(m.LogStreamID))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).GetMetadata(ctx, req.(*GetMetadataRequest))
 	}
-	if m.Backup != nil {
-		l = m.Backup.ProtoSize()
-		n += 1 + l + sovManagement(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_AddLogStreamReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLogStreamReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(ManagementServer).AddLogStreamReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.Management/AddLogStreamReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).AddLogStreamReplica(ctx, req.(*AddLogStreamReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SyncRequest_BackupNode) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func _Management_RemoveLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLogStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.StorageNodeID != 0 {
-		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	if interceptor == nil {
+		return srv.(ManagementServer).RemoveLogStream(ctx, in)
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovManagement(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.Management/RemoveLogStream",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).RemoveLogStream(ctx, req.(*RemoveLogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SyncResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func _Management_Seal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status != nil {
-		l = m.Status.ProtoSize()
-		n += 1 + l + sovManagement(uint64(l))
+	if interceptor == nil {
+		return srv.(ManagementServer).Seal(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.Management/Seal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).Seal(ctx, req.(*SealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TrimRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func _Management_Unseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovManagement(uint64(m.TopicID))
+	if interceptor == nil {
+		return srv.(ManagementServer).Unseal(ctx, in)
 	}
-	if m.LastGLSN != 0 {
-		n += 1 + sovManagement(uint64(m.LastGLSN))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.Management/Unseal",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).Unseal(ctx, req.(*UnsealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TrimResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func _Management_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for k, v := range m.Results {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovManagement(uint64(k)) + 1 + len(v) + sovManagement(uint64(len(v)))
-			n += mapEntrySize + 1 + sovManagement(uint64(mapEntrySize))
+	if interceptor == nil {
+		return srv.(ManagementServer).Sync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.Management/Sync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).Sync(ctx, req.(*SyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_Trim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).Trim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.Management/Trim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).Trim(ctx, req.(*TrimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.Management/Checkpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).Checkpoint(ctx, req.(*CheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_CheckBackupPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckBackupPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).CheckBackupPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.Management/CheckBackupPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).CheckBackupPath(ctx, req.(*CheckBackupPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServer).Export(m, &managementExportServer{stream})
+}
+
+type Management_ExportServer interface {
+	Send(*ExportResponse) error
+	grpc.ServerStream
+}
+
+type managementExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementExportServer) Send(m *ExportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Management_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ManagementServer).Import(&managementImportServer{stream})
+}
+
+type Management_ImportServer interface {
+	SendAndClose(*ImportResponse) error
+	Recv() (*ImportRequest, error)
+	grpc.ServerStream
+}
+
+type managementImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementImportServer) SendAndClose(m *ImportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *managementImportServer) Recv() (*ImportRequest, error) {
+	m := new(ImportRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _Management_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "varlog.snpb.Management",
+	HandlerType: (*ManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMetadata",
+			Handler:    _Management_GetMetadata_Handler,
+		},
+		{
+			MethodName: "AddLogStreamReplica",
+			Handler:    _Management_AddLogStreamReplica_Handler,
+		},
+		{
+			MethodName: "RemoveLogStream",
+			Handler:    _Management_RemoveLogStream_Handler,
+		},
+		{
+			MethodName: "Seal",
+			Handler:    _Management_Seal_Handler,
+		},
+		{
+			MethodName: "Unseal",
+			Handler:    _Management_Unseal_Handler,
+		},
+		{
+			MethodName: "Sync",
+			Handler:    _Management_Sync_Handler,
+		},
+		{
+			MethodName: "Trim",
+			Handler:    _Management_Trim_Handler,
+		},
+		{
+			MethodName: "Checkpoint",
+			Handler:    _Management_Checkpoint_Handler,
+		},
+		{
+			MethodName: "CheckBackupPath",
+			Handler:    _Management_CheckBackupPath_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _Management_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _Management_Import_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/snpb/management.proto",
+}
+
+func (m *GetMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StorageNodeMetadata != nil {
+		{
+			size, err := m.StorageNodeMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintManagement(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddLogStreamReplicaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddLogStreamReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddLogStreamReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageNodePath) > 0 {
+		i -= len(m.StorageNodePath)
+		copy(dAtA[i:], m.StorageNodePath)
+		i = encodeVarintManagement(dAtA, i, uint64(len(m.StorageNodePath)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddLogStreamReplicaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddLogStreamReplicaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddLogStreamReplicaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LogStreamReplica.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintManagement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveLogStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStreamID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SealRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastCommittedGLSN != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.LastCommittedGLSN))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastCommittedGLSN != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.LastCommittedGLSN))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsealRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsealRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Replicas) > 0 {
+		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintManagement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Backup != nil {
+		{
+			size, err := m.Backup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintManagement(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncRequest_BackupNode) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncRequest_BackupNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncRequest_BackupNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintManagement(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintManagement(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrimRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrimRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastGLSN != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.LastGLSN))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for k := range m.Results {
+			v := m.Results[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintManagement(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintManagement(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintManagement(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ColdTierPrefix) > 0 {
+		i -= len(m.ColdTierPrefix)
+		copy(dAtA[i:], m.ColdTierPrefix)
+		i = encodeVarintManagement(dAtA, i, uint64(len(m.ColdTierPrefix)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.CommitResult.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintManagement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintManagement(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintManagement(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStreamID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintManagement(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintManagement(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StorageNodePath) > 0 {
+		i -= len(m.StorageNodePath)
+		copy(dAtA[i:], m.StorageNodePath)
+		i = encodeVarintManagement(dAtA, i, uint64(len(m.StorageNodePath)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ImportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LogStreamReplica.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintManagement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckBackupPathRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckBackupPathRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckBackupPathRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintManagement(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintManagement(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintManagement(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckBackupPathResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckBackupPathResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckBackupPathResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintManagement(dAtA []byte, offset int, v uint64) int {
+	offset -= sovManagement(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetMetadataRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	return n
+}
+
+func (m *GetMetadataResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeMetadata != nil {
+		l = m.StorageNodeMetadata.ProtoSize()
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	return n
+}
+
+func (m *AddLogStreamReplicaRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovManagement(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovManagement(uint64(m.LogStreamID))
+	}
+	l = len(m.StorageNodePath)
+	if l > 0 {
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	return n
+}
+
+func (m *AddLogStreamReplicaResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LogStreamReplica.ProtoSize()
+	n += 1 + l + sovManagement(uint64(l))
+	return n
+}
+
+func (m *RemoveLogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovManagement(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovManagement(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *SealRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovManagement(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovManagement(uint64(m.LogStreamID))
+	}
+	if m.LastCommittedGLSN != 0 {
+		n += 1 + sovManagement(uint64(m.LastCommittedGLSN))
+	}
+	return n
+}
+
+func (m *SealResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovManagement(uint64(m.Status))
+	}
+	if m.LastCommittedGLSN != 0 {
+		n += 1 + sovManagement(uint64(m.LastCommittedGLSN))
+	}
+	return n
+}
+
+func (m *UnsealRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovManagement(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovManagement(uint64(m.LogStreamID))
+	}
+	if len(m.Replicas) > 0 {
+		for _, e := range m.Replicas {
+			l = e.ProtoSize()
+			n += 1 + l + sovManagement(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SyncRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovManagement(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovManagement(uint64(m.LogStreamID))
+	}
+	if m.Backup != nil {
+		l = m.Backup.ProtoSize()
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncRequest_BackupNode) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.ProtoSize()
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	return n
+}
+
+func (m *TrimRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovManagement(uint64(m.TopicID))
+	}
+	if m.LastGLSN != 0 {
+		n += 1 + sovManagement(uint64(m.LastGLSN))
+	}
+	return n
+}
+
+func (m *TrimResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for k, v := range m.Results {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovManagement(uint64(k)) + 1 + len(v) + sovManagement(uint64(len(v)))
+			n += mapEntrySize + 1 + sovManagement(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovManagement(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovManagement(uint64(m.LogStreamID))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	l = m.CommitResult.ProtoSize()
+	n += 1 + l + sovManagement(uint64(l))
+	l = len(m.ColdTierPrefix)
+	if l > 0 {
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckpointResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	return n
+}
+
+func (m *ExportRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovManagement(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovManagement(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *ExportResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	return n
+}
+
+func (m *ImportRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovManagement(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovManagement(uint64(m.LogStreamID))
+	}
+	l = len(m.StorageNodePath)
+	if l > 0 {
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	return n
+}
+
+func (m *ImportResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LogStreamReplica.ProtoSize()
+	n += 1 + l + sovManagement(uint64(l))
+	return n
+}
+
+func (m *CheckBackupPathRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovManagement(uint64(m.ClusterID))
+	}
+	if m.StorageNodeID != 0 {
+		n += 1 + sovManagement(uint64(m.StorageNodeID))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovManagement(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckBackupPathResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovManagement(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozManagement(x uint64) (n int) {
+	return sovManagement(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManagement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageNodeMetadata == nil {
+				m.StorageNodeMetadata = &StorageNodeMetadataDescriptor{}
+			}
+			if err := m.StorageNodeMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddLogStreamReplicaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddLogStreamReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManagement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageNodePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddLogStreamReplicaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddLogStreamReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamReplica", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManagement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LogStreamReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveLogStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveLogStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommittedGLSN", wireType)
+			}
+			m.LastCommittedGLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCommittedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovManagement(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozManagement(x uint64) (n int) {
-	return sovManagement(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *SealResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2060,17 +4021,17 @@ func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SealResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.ClusterID = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManagement
@@ -2080,7 +4041,26 @@ func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommittedGLSN", wireType)
+			}
+			m.LastCommittedGLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCommittedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2106,7 +4086,7 @@ func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2129,15 +4109,91 @@ func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsealRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2164,10 +4220,8 @@ func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageNodeMetadata == nil {
-				m.StorageNodeMetadata = &StorageNodeMetadataDescriptor{}
-			}
-			if err := m.StorageNodeMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Replicas = append(m.Replicas, varlogpb.LogStreamReplica{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2192,7 +4246,7 @@ func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
+func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2215,10 +4269,10 @@ func (m *AddLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddLogStreamReplicaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddLogStreamReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2280,9 +4334,114 @@ func (m *AddLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManagement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backup == nil {
+				m.Backup = &SyncRequest_BackupNode{}
+			}
+			if err := m.Backup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncRequest_BackupNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
 			}
-			m.LogStreamID = 0
+			m.StorageNodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManagement
@@ -2292,14 +4451,14 @@ func (m *AddLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2327,7 +4486,7 @@ func (m *AddLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageNodePath = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2350,7 +4509,7 @@ func (m *AddLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
+func (m *SyncResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2373,15 +4532,15 @@ func (m *AddLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddLogStreamReplicaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddLogStreamReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamReplica", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2408,7 +4567,10 @@ func (m *AddLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LogStreamReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Status == nil {
+				m.Status = &SyncStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2433,7 +4595,7 @@ func (m *AddLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveLogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *TrimRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2456,17 +4618,17 @@ func (m *RemoveLogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveLogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrimRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrimRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			m.ClusterID = 0
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManagement
@@ -2476,16 +4638,16 @@ func (m *RemoveLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastGLSN", wireType)
 			}
-			m.StorageNodeID = 0
+			m.LastGLSN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManagement
@@ -2495,16 +4657,66 @@ func (m *RemoveLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				m.LastGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TopicID = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManagement
@@ -2514,30 +4726,105 @@ func (m *RemoveLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthManagement
 			}
-			m.LogStreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowManagement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Results == nil {
+				m.Results = make(map[github_com_kakao_varlog_pkg_types.LogStreamID]string)
+			}
+			var mapkey int32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowManagement
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowManagement
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowManagement
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthManagement
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthManagement
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipManagement(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthManagement
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
+			m.Results[github_com_kakao_varlog_pkg_types.LogStreamID(mapkey)] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipManagement(dAtA[iNdEx:])
@@ -2559,7 +4846,7 @@ func (m *RemoveLogStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SealRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckpointRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2582,10 +4869,10 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SealRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2640,16 +4927,67 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManagement
 			}
-			m.LogStreamID = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitResult", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManagement
@@ -2659,16 +4997,30 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCommittedGLSN", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthManagement
 			}
-			m.LastCommittedGLSN = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommitResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColdTierPrefix", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManagement
@@ -2678,11 +5030,24 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastCommittedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManagement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColdTierPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipManagement(dAtA[iNdEx:])
@@ -2704,7 +5069,7 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SealResponse) Unmarshal(dAtA []byte) error {
+func (m *CheckpointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2727,17 +5092,17 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SealResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManagement
@@ -2747,30 +5112,24 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCommittedGLSN", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManagement
 			}
-			m.LastCommittedGLSN = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowManagement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastCommittedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipManagement(dAtA[iNdEx:])
@@ -2792,7 +5151,7 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
+func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2815,10 +5174,10 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsealRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2897,11 +5256,61 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManagement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManagement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManagement
@@ -2911,24 +5320,24 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthManagement
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthManagement
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Replicas = append(m.Replicas, varlogpb.LogStreamReplica{})
-			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2952,7 +5361,7 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncRequest) Unmarshal(dAtA []byte) error {
+func (m *ImportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2975,10 +5384,10 @@ func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3031,123 +5440,18 @@ func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
-			}
-			m.LogStreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowManagement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backup", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowManagement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthManagement
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthManagement
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Backup == nil {
-				m.Backup = &SyncRequest_BackupNode{}
-			}
-			if err := m.Backup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipManagement(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthManagement
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncRequest_BackupNode) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowManagement
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BackupNode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupNode: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
-			m.StorageNodeID = 0
+			m.LogStreamID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManagement
@@ -3157,14 +5461,14 @@ func (m *SyncRequest_BackupNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodePath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3192,7 +5496,41 @@ func (m *SyncRequest_BackupNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.StorageNodePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthManagement
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3215,7 +5553,7 @@ func (m *SyncRequest_BackupNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncResponse) Unmarshal(dAtA []byte) error {
+func (m *ImportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3238,15 +5576,15 @@ func (m *SyncResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamReplica", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3273,10 +5611,7 @@ func (m *SyncResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &SyncStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LogStreamReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3301,7 +5636,7 @@ func (m *SyncResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TrimRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckBackupPathRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3324,17 +5659,17 @@ func (m *TrimRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TrimRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckBackupPathRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrimRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckBackupPathRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
 			}
-			m.TopicID = 0
+			m.ClusterID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManagement
@@ -3344,16 +5679,16 @@ func (m *TrimRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastGLSN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
 			}
-			m.LastGLSN = 0
+			m.StorageNodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManagement
@@ -3363,11 +5698,75 @@ func (m *TrimRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManagement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManagement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManagement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManagement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipManagement(dAtA[iNdEx:])
@@ -3389,7 +5788,7 @@ func (m *TrimRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TrimResponse) Unmarshal(dAtA []byte) error {
+func (m *CheckBackupPathResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3412,125 +5811,12 @@ func (m *TrimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TrimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckBackupPathResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckBackupPathResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowManagement
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthManagement
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthManagement
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Results == nil {
-				m.Results = make(map[github_com_kakao_varlog_pkg_types.LogStreamID]string)
-			}
-			var mapkey int32
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowManagement
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowManagement
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowManagement
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthManagement
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthManagement
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipManagement(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthManagement
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Results[github_com_kakao_varlog_pkg_types.LogStreamID(mapkey)] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipManagement(dAtA[iNdEx:])
@@ -12,6 +12,7 @@ func (snmd *StorageNodeMetadataDescriptor) ToStorageNodeDescriptor() *varlogpb.S
 	snd := &varlogpb.StorageNodeDescriptor{
 		StorageNode: snmd.StorageNode,
 		Paths:       make([]string, len(snmd.Storages)),
+		Tags:        snmd.Tags,
 	}
 	for i := range snmd.Storages {
 		snd.Paths[i] = snmd.Storages[i].Path
@@ -44,6 +44,9 @@ type StorageNodeMetadataDescriptor struct {
 	LogStreamReplicas []LogStreamReplicaMetadataDescriptor `protobuf:"bytes,4,rep,name=log_stream_replicas,json=logStreamReplicas,proto3" json:"logStreams"`
 	Status            varlogpb.StorageNodeStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=varlog.varlogpb.StorageNodeStatus" json:"status,omitempty"`
 	StartTime         time.Time                            `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"startTime"`
+	// Tags are the labels of the storage node, for instance, the zone where
+	// the storage node runs.
+	Tags map[string]string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *StorageNodeMetadataDescriptor) Reset()         { *m = StorageNodeMetadataDescriptor{} }
@@ -114,6 +117,13 @@ func (m *StorageNodeMetadataDescriptor) GetStartTime() time.Time {
 	return time.Time{}
 }
 
+func (m *StorageNodeMetadataDescriptor) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 // LogStreamReplicaMetadataDescriptor represents the metadata of log stream
 // replica.
 type LogStreamReplicaMetadataDescriptor struct {
@@ -149,6 +159,11 @@ type LogStreamReplicaMetadataDescriptor struct {
 	//
 	// Deprecated:
 	UpdatedTime time.Time `protobuf:"bytes,10,opt,name=updated_time,json=updatedTime,proto3,stdtime" json:"updatedTime"`
+	// LiveStorageSizeBytes is the estimated size of log entries not trimmed in
+	// the storage. Unlike StorageSizeBytes, it excludes space of trimmed log
+	// entries that is not reclaimed yet. It is approximate since it counts
+	// only log entries flushed to files.
+	LiveStorageSizeBytes uint64 `protobuf:"varint,11,opt,name=live_storage_size_bytes,json=liveStorageSizeBytes,proto3" json:"liveStorageSizeBytes,omitempty"`
 }
 
 func (m *LogStreamReplicaMetadataDescriptor) Reset()         { *m = LogStreamReplicaMetadataDescriptor{} }
@@ -247,61 +262,75 @@ func (m *LogStreamReplicaMetadataDescriptor) GetUpdatedTime() time.Time {
 	return time.Time{}
 }
 
+func (m *LogStreamReplicaMetadataDescriptor) GetLiveStorageSizeBytes() uint64 {
+	if m != nil {
+		return m.LiveStorageSizeBytes
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StorageNodeMetadataDescriptor)(nil), "varlog.snpb.StorageNodeMetadataDescriptor")
+	proto.RegisterMapType((map[string]string)(nil), "varlog.snpb.StorageNodeMetadataDescriptor.TagsEntry")
 	proto.RegisterType((*LogStreamReplicaMetadataDescriptor)(nil), "varlog.snpb.LogStreamReplicaMetadataDescriptor")
 }
 
 func init() { proto.RegisterFile("proto/snpb/metadata.proto", fileDescriptor_b0d7c3885ca513ae) }
 
 var fileDescriptor_b0d7c3885ca513ae = []byte{
-	// 727 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x8d, 0x21, 0x04, 0x32, 0xe1, 0x3d, 0xc1, 0xf0, 0x10, 0x26, 0xef, 0xbd, 0x38, 0xcd, 0xa2,
-	0x4a, 0xd5, 0x62, 0x4b, 0x74, 0x53, 0xa1, 0x6e, 0xea, 0x22, 0x51, 0x24, 0x40, 0x95, 0x53, 0x51,
-	0xa9, 0x52, 0x65, 0x4d, 0x92, 0xa9, 0x63, 0xc5, 0xce, 0xb8, 0x33, 0x63, 0x10, 0x7c, 0x05, 0x9f,
-	0xc0, 0xae, 0xbf, 0xc2, 0x92, 0x55, 0xd5, 0x95, 0x2b, 0x91, 0x4d, 0x95, 0x4f, 0x60, 0x55, 0x79,
-	0x3c, 0x8e, 0x53, 0x87, 0x28, 0xec, 0x66, 0xee, 0xbd, 0xe7, 0xdc, 0x7b, 0xcf, 0x9c, 0xc4, 0x60,
-	0x3b, 0xa0, 0x84, 0x13, 0x83, 0x0d, 0x82, 0xb6, 0xe1, 0x63, 0x8e, 0xba, 0x88, 0x23, 0x5d, 0xc4,
-	0x60, 0xe5, 0x0c, 0x51, 0x8f, 0x38, 0x7a, 0x9c, 0xab, 0xee, 0x38, 0x2e, 0xef, 0x85, 0x6d, 0xbd,
-	0x43, 0x7c, 0xc3, 0x21, 0x0e, 0x31, 0x44, 0x4d, 0x3b, 0xfc, 0x22, 0x6e, 0x09, 0x49, 0x7c, 0x4a,
-	0xb0, 0xd5, 0x7f, 0x1d, 0x42, 0x1c, 0x0f, 0x67, 0x55, 0xd8, 0x0f, 0xf8, 0x85, 0x4c, 0x6a, 0xf9,
-	0x24, 0x77, 0x7d, 0xcc, 0x38, 0xf2, 0x03, 0x59, 0xb0, 0x95, 0x74, 0x9e, 0x1a, 0xa9, 0xf1, 0xad,
-	0x08, 0xfe, 0x6f, 0x71, 0x42, 0x91, 0x83, 0x4f, 0x48, 0x17, 0x1f, 0xcb, 0xec, 0x3e, 0x66, 0x1d,
-	0xea, 0x06, 0x9c, 0x50, 0xd8, 0x03, 0xa0, 0xe3, 0x85, 0x8c, 0x63, 0x6a, 0xbb, 0x5d, 0x55, 0xa9,
-	0x2b, 0xcd, 0xbf, 0xcc, 0xc3, 0xbb, 0x48, 0x2b, 0xbf, 0x4d, 0xa2, 0x87, 0xfb, 0xa3, 0x48, 0x2b,
-	0xcb, 0x92, 0xc3, 0xee, 0x7d, 0xa4, 0x3d, 0x9f, 0xd8, 0xac, 0x8f, 0xfa, 0x88, 0x18, 0x49, 0x77,
-	0x23, 0xe8, 0x3b, 0x06, 0xbf, 0x08, 0x30, 0xd3, 0xc7, 0x58, 0x2b, 0x43, 0xc2, 0x63, 0xb0, 0xca,
-	0x92, 0x51, 0xec, 0x01, 0xe9, 0x62, 0x75, 0xa1, 0xae, 0x34, 0x2b, 0xbb, 0xff, 0xe9, 0x52, 0xb5,
-	0x74, 0x05, 0x7d, 0x62, 0x5e, 0x73, 0xf5, 0x26, 0xd2, 0x0a, 0xb7, 0x91, 0xa6, 0x8c, 0x22, 0xad,
-	0x60, 0x55, 0x58, 0x96, 0x82, 0xfb, 0x60, 0x45, 0x5e, 0x99, 0xba, 0x58, 0x5f, 0x6c, 0x56, 0x76,
-	0x1b, 0xb3, 0xa8, 0xb2, 0x75, 0xcd, 0x62, 0x4c, 0x68, 0x8d, 0x91, 0x90, 0x81, 0x0d, 0x8f, 0x38,
-	0x36, 0xe3, 0x14, 0x23, 0xdf, 0xa6, 0x38, 0xf0, 0xdc, 0x0e, 0x62, 0x6a, 0x51, 0x10, 0x1a, 0xfa,
-	0xc4, 0x8b, 0xea, 0x47, 0xc4, 0x69, 0x89, 0x32, 0x2b, 0xa9, 0x9a, 0x16, 0xd3, 0x84, 0x31, 0xfb,
-	0x28, 0xd2, 0x80, 0x97, 0xd6, 0x32, 0x6b, 0xdd, 0xcb, 0xe1, 0x18, 0xdc, 0x03, 0x25, 0xc6, 0x11,
-	0x0f, 0x99, 0xba, 0x54, 0x57, 0x9a, 0x7f, 0xcf, 0x1e, 0x3c, 0x5e, 0xb4, 0x25, 0x2a, 0x2d, 0x89,
-	0x80, 0xef, 0x01, 0x60, 0x1c, 0x51, 0x6e, 0xc7, 0x1e, 0x50, 0x4b, 0x42, 0xc3, 0xaa, 0x9e, 0x18,
-	0x44, 0x4f, 0x0d, 0xa2, 0x7f, 0x48, 0x0d, 0x62, 0x6e, 0xca, 0x91, 0xca, 0x02, 0x15, 0xc7, 0xaf,
-	0x7e, 0x6a, 0x8a, 0x95, 0x5d, 0xf7, 0x8a, 0xbf, 0xae, 0x35, 0xa5, 0xf1, 0xbd, 0x04, 0x1a, 0xf3,
-	0x37, 0x84, 0x9f, 0x01, 0x9c, 0xd6, 0x4b, 0xd8, 0xa6, 0xb2, 0xfb, 0x64, 0x6a, 0x8d, 0x3c, 0x61,
-	0xee, 0x3d, 0xd7, 0xf2, 0xd2, 0xc0, 0x57, 0x63, 0x65, 0x16, 0x84, 0x32, 0xf5, 0xd9, 0x94, 0x39,
-	0x5d, 0x0e, 0xc0, 0xf2, 0x19, 0xa6, 0xcc, 0x25, 0x03, 0x75, 0xb1, 0xae, 0x34, 0x8b, 0xe6, 0xce,
-	0x7d, 0xa4, 0x3d, 0x9b, 0x6f, 0xd5, 0xd3, 0x04, 0x64, 0xa5, 0x68, 0x18, 0x82, 0x4d, 0xc7, 0x23,
-	0x6d, 0xe4, 0xd9, 0x3d, 0xd7, 0xe9, 0xd9, 0xe7, 0x88, 0x63, 0xea, 0x23, 0xda, 0x57, 0x8b, 0x82,
-	0xf6, 0xcd, 0x28, 0xd2, 0x36, 0x92, 0x82, 0x77, 0xae, 0xd3, 0xfb, 0x98, 0xa6, 0xef, 0x23, 0xed,
-	0xe9, 0xfc, 0x6e, 0x07, 0x47, 0xad, 0x13, 0xeb, 0x21, 0x38, 0xf4, 0x63, 0x23, 0x76, 0x90, 0x67,
-	0x7b, 0xe4, 0x7c, 0xa2, 0xe9, 0x92, 0x50, 0xb6, 0xf1, 0xa0, 0x0c, 0xf8, 0x6b, 0x88, 0x07, 0x1d,
-	0x7c, 0x12, 0xfa, 0x6d, 0x4c, 0xcd, 0x6d, 0xf9, 0xd0, 0xeb, 0x82, 0xe6, 0x88, 0x9c, 0x8f, 0xb9,
-	0xad, 0xe9, 0x10, 0x0c, 0xc0, 0x3f, 0x49, 0xbb, 0xdc, 0x92, 0xa5, 0x47, 0xf7, 0xab, 0xca, 0x7e,
-	0x50, 0xf0, 0xfc, 0xb1, 0x8c, 0xf5, 0x40, 0x0c, 0x42, 0x50, 0x0c, 0x10, 0xef, 0xa9, 0xcb, 0x75,
-	0xa5, 0x59, 0xb6, 0xc4, 0x19, 0xbe, 0x00, 0x30, 0xfd, 0x4b, 0x60, 0xee, 0x25, 0xb6, 0xdb, 0x17,
-	0x1c, 0x33, 0x75, 0x25, 0x16, 0xda, 0x5a, 0x93, 0x99, 0x96, 0x7b, 0x89, 0xcd, 0x38, 0x0e, 0x4f,
-	0xc1, 0x6a, 0x87, 0x62, 0xc4, 0x71, 0x37, 0x31, 0x7f, 0x79, 0xae, 0xf9, 0xb7, 0xe4, 0x8c, 0x15,
-	0x89, 0x1b, 0xdb, 0x7f, 0x32, 0x10, 0xf3, 0x86, 0x41, 0x37, 0xe3, 0x05, 0x8f, 0xe7, 0x95, 0xb8,
-	0x8c, 0x77, 0x22, 0x90, 0xfc, 0xb0, 0xcc, 0xd7, 0x37, 0x77, 0x35, 0xe5, 0xf6, 0xae, 0xa6, 0x5c,
-	0x0d, 0x6b, 0x85, 0xeb, 0x61, 0x4d, 0xb9, 0x1d, 0xd6, 0x0a, 0x3f, 0x86, 0xb5, 0xc2, 0xa7, 0xc6,
-	0x4c, 0xbf, 0x8c, 0x3f, 0x31, 0xed, 0x92, 0x38, 0xbf, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xa6,
-	0xcd, 0x63, 0x29, 0x77, 0x06, 0x00, 0x00,
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4f, 0x4f, 0xe3, 0x46,
+	0x14, 0x8f, 0xc9, 0x3f, 0x32, 0xa1, 0x15, 0x0c, 0x20, 0x4c, 0xda, 0xc6, 0x69, 0x0e, 0x55, 0xaa,
+	0x82, 0x2d, 0xd1, 0x4a, 0x45, 0xa8, 0x97, 0xba, 0x54, 0x80, 0x04, 0xa8, 0x9a, 0x20, 0xaa, 0x56,
+	0x5a, 0x59, 0x93, 0x64, 0xd6, 0xb1, 0x62, 0x67, 0xbc, 0x9e, 0x71, 0x50, 0xf8, 0x14, 0x7c, 0x04,
+	0xce, 0x7b, 0xdb, 0x6f, 0xc1, 0x91, 0xe3, 0x9e, 0xbc, 0x12, 0xb9, 0xac, 0xf2, 0x11, 0x38, 0xad,
+	0x3c, 0x76, 0xfe, 0xe0, 0x24, 0x1b, 0x6e, 0x33, 0xef, 0xbd, 0xdf, 0xef, 0xcd, 0x7b, 0xef, 0xf7,
+	0x6c, 0xb0, 0xeb, 0x7a, 0x94, 0x53, 0x8d, 0x75, 0xdd, 0x86, 0xe6, 0x10, 0x8e, 0x5b, 0x98, 0x63,
+	0x55, 0xd8, 0x60, 0xb1, 0x87, 0x3d, 0x9b, 0x9a, 0x6a, 0xe8, 0x2b, 0xed, 0x9b, 0x16, 0x6f, 0xfb,
+	0x0d, 0xb5, 0x49, 0x1d, 0xcd, 0xa4, 0x26, 0xd5, 0x44, 0x4c, 0xc3, 0x7f, 0x2b, 0x6e, 0x11, 0x49,
+	0x78, 0x8a, 0xb0, 0xa5, 0xef, 0x4c, 0x4a, 0x4d, 0x9b, 0x4c, 0xa2, 0x88, 0xe3, 0xf2, 0x7e, 0xec,
+	0x54, 0x92, 0x4e, 0x6e, 0x39, 0x84, 0x71, 0xec, 0xb8, 0x71, 0xc0, 0x4e, 0x94, 0x79, 0xe6, 0x49,
+	0xd5, 0xf7, 0x59, 0xf0, 0x43, 0x9d, 0x53, 0x0f, 0x9b, 0xe4, 0x92, 0xb6, 0xc8, 0x45, 0xec, 0x3d,
+	0x26, 0xac, 0xe9, 0x59, 0x2e, 0xa7, 0x1e, 0x6c, 0x03, 0xd0, 0xb4, 0x7d, 0xc6, 0x89, 0x67, 0x58,
+	0x2d, 0x59, 0xaa, 0x48, 0xb5, 0x6f, 0xf4, 0xb3, 0xa7, 0x40, 0x29, 0xfc, 0x15, 0x59, 0xcf, 0x8e,
+	0x87, 0x81, 0x52, 0x88, 0x43, 0xce, 0x5a, 0xcf, 0x81, 0xf2, 0xcb, 0x54, 0x65, 0x1d, 0xdc, 0xc1,
+	0x54, 0x8b, 0xb2, 0x6b, 0x6e, 0xc7, 0xd4, 0x78, 0xdf, 0x25, 0x4c, 0x1d, 0x63, 0xd1, 0x04, 0x09,
+	0x2f, 0xc0, 0x1a, 0x8b, 0x9e, 0x62, 0x74, 0x69, 0x8b, 0xc8, 0x2b, 0x15, 0xa9, 0x56, 0x3c, 0xf8,
+	0x5e, 0x8d, 0xbb, 0x36, 0x2a, 0x41, 0x9d, 0x7a, 0xaf, 0xbe, 0xf6, 0x10, 0x28, 0xa9, 0xc7, 0x40,
+	0x91, 0x86, 0x81, 0x92, 0x42, 0x45, 0x36, 0x71, 0xc1, 0x63, 0xb0, 0x1a, 0x5f, 0x99, 0x9c, 0xae,
+	0xa4, 0x6b, 0xc5, 0x83, 0xea, 0x22, 0xaa, 0x49, 0xb9, 0x7a, 0x26, 0x24, 0x44, 0x63, 0x24, 0x64,
+	0x60, 0xd3, 0xa6, 0xa6, 0xc1, 0xb8, 0x47, 0xb0, 0x63, 0x78, 0xc4, 0xb5, 0xad, 0x26, 0x66, 0x72,
+	0x46, 0x10, 0x6a, 0xea, 0xd4, 0x44, 0xd5, 0x73, 0x6a, 0xd6, 0x45, 0x18, 0x8a, 0xa2, 0x66, 0x9b,
+	0xa9, 0xc3, 0x90, 0x7d, 0x18, 0x28, 0xc0, 0x1e, 0xc5, 0x32, 0xb4, 0x61, 0x27, 0x70, 0x0c, 0x1e,
+	0x81, 0x1c, 0xe3, 0x98, 0xfb, 0x4c, 0xce, 0x56, 0xa4, 0xda, 0xb7, 0x8b, 0x1f, 0x1e, 0x16, 0x5a,
+	0x17, 0x91, 0x28, 0x46, 0xc0, 0x7f, 0x00, 0x60, 0x1c, 0x7b, 0xdc, 0x08, 0x35, 0x20, 0xe7, 0x44,
+	0x0f, 0x4b, 0x6a, 0x24, 0x10, 0x75, 0x24, 0x10, 0xf5, 0x6a, 0x24, 0x10, 0x7d, 0x3b, 0x7e, 0x52,
+	0x41, 0xa0, 0x42, 0xfb, 0xdd, 0x27, 0x45, 0x42, 0x93, 0x2b, 0x3c, 0x05, 0x19, 0x8e, 0x4d, 0x26,
+	0xe7, 0x45, 0xcd, 0xbf, 0xbd, 0xa8, 0xf9, 0xab, 0xda, 0x51, 0xaf, 0xb0, 0xc9, 0xfe, 0xee, 0x72,
+	0xaf, 0x8f, 0x04, 0x43, 0xe9, 0x77, 0x50, 0x18, 0x9b, 0xe0, 0x3a, 0x48, 0x77, 0x48, 0x5f, 0x28,
+	0xaa, 0x80, 0xc2, 0x23, 0xdc, 0x02, 0xd9, 0x1e, 0xb6, 0xfd, 0x68, 0xf2, 0x05, 0x14, 0x5d, 0x8e,
+	0x56, 0x0e, 0xa5, 0xa3, 0xcc, 0xe7, 0x7b, 0x45, 0xaa, 0x7e, 0xc8, 0x83, 0xea, 0xf2, 0x26, 0xc3,
+	0x37, 0x00, 0xce, 0x8e, 0x4c, 0xe4, 0x29, 0x1e, 0xfc, 0x38, 0xd3, 0xc9, 0x24, 0x61, 0x42, 0x52,
+	0xeb, 0xc9, 0xe9, 0xc0, 0xc3, 0xf1, 0x70, 0x56, 0xc4, 0x70, 0x2a, 0x8b, 0x29, 0x13, 0xa3, 0x39,
+	0x01, 0xf9, 0x1e, 0xf1, 0x98, 0x45, 0xbb, 0x72, 0xba, 0x22, 0xd5, 0x32, 0xfa, 0xfe, 0x73, 0xa0,
+	0xfc, 0xbc, 0x7c, 0x5b, 0xae, 0x23, 0x10, 0x1a, 0xa1, 0xa1, 0x0f, 0xb6, 0x4d, 0x9b, 0x36, 0xb0,
+	0x6d, 0xb4, 0x2d, 0xb3, 0x6d, 0xdc, 0x60, 0x4e, 0x3c, 0x07, 0x7b, 0x1d, 0x39, 0x23, 0x68, 0xff,
+	0x1c, 0x06, 0xca, 0x66, 0x14, 0x70, 0x6a, 0x99, 0xed, 0x7f, 0x47, 0xee, 0xe7, 0x40, 0xf9, 0x69,
+	0x79, 0xb6, 0x93, 0xf3, 0xfa, 0x25, 0x9a, 0x07, 0x87, 0x4e, 0xb8, 0x0b, 0x4d, 0x6c, 0x1b, 0x36,
+	0xbd, 0x99, 0x4a, 0x9a, 0x15, 0x9d, 0xad, 0xce, 0x6d, 0x03, 0x79, 0xe7, 0x93, 0x6e, 0x93, 0x5c,
+	0xfa, 0x4e, 0x83, 0x78, 0xfa, 0x6e, 0xac, 0xb5, 0x0d, 0x41, 0x73, 0x4e, 0x6f, 0xc6, 0xdc, 0x68,
+	0xd6, 0x04, 0x5d, 0xb0, 0x15, 0xa5, 0x4b, 0x14, 0x99, 0x7b, 0x75, 0xbe, 0x52, 0x9c, 0x0f, 0x0a,
+	0x9e, 0x17, 0xc5, 0xa0, 0x39, 0x36, 0x08, 0x41, 0xc6, 0xc5, 0xbc, 0x2d, 0xe7, 0x85, 0xfe, 0xc4,
+	0x19, 0xee, 0x01, 0x38, 0xfa, 0x2a, 0x31, 0xeb, 0x96, 0x18, 0x8d, 0x3e, 0x27, 0x4c, 0x5e, 0x0d,
+	0x1b, 0x8d, 0xd6, 0x63, 0x4f, 0xdd, 0xba, 0x25, 0x7a, 0x68, 0x87, 0xd7, 0x60, 0xad, 0xe9, 0x11,
+	0xcc, 0x49, 0x2b, 0xda, 0xbf, 0xc2, 0xd2, 0xfd, 0xdb, 0x89, 0xdf, 0x58, 0x8c, 0x71, 0xe3, 0x0d,
+	0x9c, 0x36, 0x84, 0xbc, 0xbe, 0xdb, 0x9a, 0xf0, 0x82, 0xd7, 0xf3, 0xc6, 0xb8, 0x09, 0xef, 0x94,
+	0x01, 0xfe, 0x07, 0x76, 0x6c, 0xab, 0x47, 0x8c, 0x39, 0x25, 0x16, 0x85, 0x96, 0xaa, 0xc3, 0x40,
+	0x29, 0x87, 0x21, 0xf5, 0x44, 0xa9, 0x7b, 0xd4, 0xb1, 0xb8, 0xf8, 0x09, 0xa1, 0xad, 0x79, 0xfe,
+	0x68, 0x67, 0xf5, 0x3f, 0x1e, 0x9e, 0xca, 0xd2, 0xe3, 0x53, 0x59, 0xba, 0x1b, 0x94, 0x53, 0xf7,
+	0x83, 0xb2, 0xf4, 0x38, 0x28, 0xa7, 0x3e, 0x0e, 0xca, 0xa9, 0xff, 0xab, 0x0b, 0xa5, 0x38, 0xfe,
+	0x81, 0x36, 0x72, 0xe2, 0xfc, 0xeb, 0x97, 0x01, 0x00, 0x38, 0x51, 0x79, 0xd0, 0x55, 0x07, 0x00,
+	0x00,
 }
 
 func (this *StorageNodeMetadataDescriptor) Equal(that interface{}) bool {
@@ -351,6 +380,14 @@ func (this *StorageNodeMetadataDescriptor) Equal(that interface{}) bool {
 	if !this.StartTime.Equal(that1.StartTime) {
 		return false
 	}
+	if len(this.Tags) != len(that1.Tags) {
+		return false
+	}
+	for i := range this.Tags {
+		if this.Tags[i] != that1.Tags[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *LogStreamReplicaMetadataDescriptor) Equal(that interface{}) bool {
@@ -402,6 +439,9 @@ func (this *LogStreamReplicaMetadataDescriptor) Equal(that interface{}) bool {
 	if !this.UpdatedTime.Equal(that1.UpdatedTime) {
 		return false
 	}
+	if this.LiveStorageSizeBytes != that1.LiveStorageSizeBytes {
+		return false
+	}
 	return true
 }
 func (m *StorageNodeMetadataDescriptor) Marshal() (dAtA []byte, err error) {
@@ -424,6 +464,25 @@ func (m *StorageNodeMetadataDescriptor) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Tags) > 0 {
+		for k := range m.Tags {
+			v := m.Tags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintMetadata(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMetadata(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMetadata(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
 	if err1 != nil {
 		return 0, err1
@@ -503,6 +562,11 @@ func (m *LogStreamReplicaMetadataDescriptor) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if m.LiveStorageSizeBytes != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.LiveStorageSizeBytes))
+		i--
+		dAtA[i] = 0x58
+	}
 	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedTime):])
 	if err3 != nil {
 		return 0, err3
@@ -618,6 +682,14 @@ func (m *StorageNodeMetadataDescriptor) ProtoSize() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
 	n += 1 + l + sovMetadata(uint64(l))
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMetadata(uint64(len(k))) + 1 + len(v) + sovMetadata(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMetadata(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -653,6 +725,9 @@ func (m *LogStreamReplicaMetadataDescriptor) ProtoSize() (n int) {
 	n += 1 + l + sovMetadata(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedTime)
 	n += 1 + l + sovMetadata(uint64(l))
+	if m.LiveStorageSizeBytes != 0 {
+		n += 1 + sovMetadata(uint64(m.LiveStorageSizeBytes))
+	}
 	return n
 }
 
@@ -863,6 +938,133 @@ func (m *StorageNodeMetadataDescriptor) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMetadata(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Tags[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -1186,6 +1388,25 @@ func (m *LogStreamReplicaMetadataDescriptor) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveStorageSizeBytes", wireType)
+			}
+			m.LiveStorageSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiveStorageSizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
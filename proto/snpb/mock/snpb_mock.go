@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/kakao/varlog/proto/snpb (interfaces: ReplicatorClient,ReplicatorServer,Replicator_ReplicateClient,LogIOClient,LogIOServer,LogIO_SubscribeClient,LogIO_SubscribeServer,LogStreamReporterClient,LogStreamReporterServer,ManagementClient,ManagementServer)
+// Source: github.com/kakao/varlog/proto/snpb (interfaces: ReplicatorClient,ReplicatorServer,Replicator_ReplicateClient,LogIOClient,LogIOServer,LogIO_AppendStreamClient,LogIO_AppendStreamServer,LogIO_SubscribeClient,LogIO_SubscribeServer,LogIO_ReadBatchClient,LogIO_ReadBatchServer,LogStreamReporterClient,LogStreamReporterServer,ManagementClient,ManagementServer,Management_ExportClient,Management_ExportServer,Management_ImportClient,Management_ImportServer)
 
 // Package mock is a generated GoMock package.
 package mock
@@ -380,6 +380,26 @@ func (mr *MockLogIOClientMockRecorder) Append(arg0, arg1 interface{}, arg2 ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockLogIOClient)(nil).Append), varargs...)
 }
 
+// AppendStream mocks base method.
+func (m *MockLogIOClient) AppendStream(arg0 context.Context, arg1 ...grpc.CallOption) (snpb.LogIO_AppendStreamClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AppendStream", varargs...)
+	ret0, _ := ret[0].(snpb.LogIO_AppendStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendStream indicates an expected call of AppendStream.
+func (mr *MockLogIOClientMockRecorder) AppendStream(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendStream", reflect.TypeOf((*MockLogIOClient)(nil).AppendStream), varargs...)
+}
+
 // LogStreamReplicaMetadata mocks base method.
 func (m *MockLogIOClient) LogStreamReplicaMetadata(arg0 context.Context, arg1 *snpb.LogStreamReplicaMetadataRequest, arg2 ...grpc.CallOption) (*snpb.LogStreamReplicaMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -420,6 +440,46 @@ func (mr *MockLogIOClientMockRecorder) Read(arg0, arg1 interface{}, arg2 ...inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockLogIOClient)(nil).Read), varargs...)
 }
 
+// ReadBatch mocks base method.
+func (m *MockLogIOClient) ReadBatch(arg0 context.Context, arg1 *snpb.ReadBatchRequest, arg2 ...grpc.CallOption) (snpb.LogIO_ReadBatchClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReadBatch", varargs...)
+	ret0, _ := ret[0].(snpb.LogIO_ReadBatchClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadBatch indicates an expected call of ReadBatch.
+func (mr *MockLogIOClientMockRecorder) ReadBatch(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadBatch", reflect.TypeOf((*MockLogIOClient)(nil).ReadBatch), varargs...)
+}
+
+// SeekTime mocks base method.
+func (m *MockLogIOClient) SeekTime(arg0 context.Context, arg1 *snpb.SeekTimeRequest, arg2 ...grpc.CallOption) (*snpb.SeekTimeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SeekTime", varargs...)
+	ret0, _ := ret[0].(*snpb.SeekTimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeekTime indicates an expected call of SeekTime.
+func (mr *MockLogIOClientMockRecorder) SeekTime(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeekTime", reflect.TypeOf((*MockLogIOClient)(nil).SeekTime), varargs...)
+}
+
 // Subscribe mocks base method.
 func (m *MockLogIOClient) Subscribe(arg0 context.Context, arg1 *snpb.SubscribeRequest, arg2 ...grpc.CallOption) (snpb.LogIO_SubscribeClient, error) {
 	m.ctrl.T.Helper()
@@ -518,6 +578,20 @@ func (mr *MockLogIOServerMockRecorder) Append(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockLogIOServer)(nil).Append), arg0, arg1)
 }
 
+// AppendStream mocks base method.
+func (m *MockLogIOServer) AppendStream(arg0 snpb.LogIO_AppendStreamServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendStream", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendStream indicates an expected call of AppendStream.
+func (mr *MockLogIOServerMockRecorder) AppendStream(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendStream", reflect.TypeOf((*MockLogIOServer)(nil).AppendStream), arg0)
+}
+
 // LogStreamReplicaMetadata mocks base method.
 func (m *MockLogIOServer) LogStreamReplicaMetadata(arg0 context.Context, arg1 *snpb.LogStreamReplicaMetadataRequest) (*snpb.LogStreamReplicaMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -548,6 +622,35 @@ func (mr *MockLogIOServerMockRecorder) Read(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockLogIOServer)(nil).Read), arg0, arg1)
 }
 
+// ReadBatch mocks base method.
+func (m *MockLogIOServer) ReadBatch(arg0 *snpb.ReadBatchRequest, arg1 snpb.LogIO_ReadBatchServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadBatch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadBatch indicates an expected call of ReadBatch.
+func (mr *MockLogIOServerMockRecorder) ReadBatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadBatch", reflect.TypeOf((*MockLogIOServer)(nil).ReadBatch), arg0, arg1)
+}
+
+// SeekTime mocks base method.
+func (m *MockLogIOServer) SeekTime(arg0 context.Context, arg1 *snpb.SeekTimeRequest) (*snpb.SeekTimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeekTime", arg0, arg1)
+	ret0, _ := ret[0].(*snpb.SeekTimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeekTime indicates an expected call of SeekTime.
+func (mr *MockLogIOServerMockRecorder) SeekTime(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeekTime", reflect.TypeOf((*MockLogIOServer)(nil).SeekTime), arg0, arg1)
+}
+
 // Subscribe mocks base method.
 func (m *MockLogIOServer) Subscribe(arg0 *snpb.SubscribeRequest, arg1 snpb.LogIO_SubscribeServer) error {
 	m.ctrl.T.Helper()
@@ -591,31 +694,31 @@ func (mr *MockLogIOServerMockRecorder) TrimDeprecated(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrimDeprecated", reflect.TypeOf((*MockLogIOServer)(nil).TrimDeprecated), arg0, arg1)
 }
 
-// MockLogIO_SubscribeClient is a mock of LogIO_SubscribeClient interface.
-type MockLogIO_SubscribeClient struct {
+// MockLogIO_AppendStreamClient is a mock of LogIO_AppendStreamClient interface.
+type MockLogIO_AppendStreamClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockLogIO_SubscribeClientMockRecorder
+	recorder *MockLogIO_AppendStreamClientMockRecorder
 }
 
-// MockLogIO_SubscribeClientMockRecorder is the mock recorder for MockLogIO_SubscribeClient.
-type MockLogIO_SubscribeClientMockRecorder struct {
-	mock *MockLogIO_SubscribeClient
+// MockLogIO_AppendStreamClientMockRecorder is the mock recorder for MockLogIO_AppendStreamClient.
+type MockLogIO_AppendStreamClientMockRecorder struct {
+	mock *MockLogIO_AppendStreamClient
 }
 
-// NewMockLogIO_SubscribeClient creates a new mock instance.
-func NewMockLogIO_SubscribeClient(ctrl *gomock.Controller) *MockLogIO_SubscribeClient {
-	mock := &MockLogIO_SubscribeClient{ctrl: ctrl}
-	mock.recorder = &MockLogIO_SubscribeClientMockRecorder{mock}
+// NewMockLogIO_AppendStreamClient creates a new mock instance.
+func NewMockLogIO_AppendStreamClient(ctrl *gomock.Controller) *MockLogIO_AppendStreamClient {
+	mock := &MockLogIO_AppendStreamClient{ctrl: ctrl}
+	mock.recorder = &MockLogIO_AppendStreamClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockLogIO_SubscribeClient) EXPECT() *MockLogIO_SubscribeClientMockRecorder {
+func (m *MockLogIO_AppendStreamClient) EXPECT() *MockLogIO_AppendStreamClientMockRecorder {
 	return m.recorder
 }
 
 // CloseSend mocks base method.
-func (m *MockLogIO_SubscribeClient) CloseSend() error {
+func (m *MockLogIO_AppendStreamClient) CloseSend() error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CloseSend")
 	ret0, _ := ret[0].(error)
@@ -623,13 +726,13 @@ func (m *MockLogIO_SubscribeClient) CloseSend() error {
 }
 
 // CloseSend indicates an expected call of CloseSend.
-func (mr *MockLogIO_SubscribeClientMockRecorder) CloseSend() *gomock.Call {
+func (mr *MockLogIO_AppendStreamClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockLogIO_SubscribeClient)(nil).CloseSend))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockLogIO_AppendStreamClient)(nil).CloseSend))
 }
 
 // Context mocks base method.
-func (m *MockLogIO_SubscribeClient) Context() context.Context {
+func (m *MockLogIO_AppendStreamClient) Context() context.Context {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Context")
 	ret0, _ := ret[0].(context.Context)
@@ -637,13 +740,13 @@ func (m *MockLogIO_SubscribeClient) Context() context.Context {
 }
 
 // Context indicates an expected call of Context.
-func (mr *MockLogIO_SubscribeClientMockRecorder) Context() *gomock.Call {
+func (mr *MockLogIO_AppendStreamClientMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockLogIO_SubscribeClient)(nil).Context))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockLogIO_AppendStreamClient)(nil).Context))
 }
 
 // Header mocks base method.
-func (m *MockLogIO_SubscribeClient) Header() (metadata.MD, error) {
+func (m *MockLogIO_AppendStreamClient) Header() (metadata.MD, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Header")
 	ret0, _ := ret[0].(metadata.MD)
@@ -652,28 +755,28 @@ func (m *MockLogIO_SubscribeClient) Header() (metadata.MD, error) {
 }
 
 // Header indicates an expected call of Header.
-func (mr *MockLogIO_SubscribeClientMockRecorder) Header() *gomock.Call {
+func (mr *MockLogIO_AppendStreamClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockLogIO_SubscribeClient)(nil).Header))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockLogIO_AppendStreamClient)(nil).Header))
 }
 
 // Recv mocks base method.
-func (m *MockLogIO_SubscribeClient) Recv() (*snpb.SubscribeResponse, error) {
+func (m *MockLogIO_AppendStreamClient) Recv() (*snpb.AppendResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Recv")
-	ret0, _ := ret[0].(*snpb.SubscribeResponse)
+	ret0, _ := ret[0].(*snpb.AppendResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Recv indicates an expected call of Recv.
-func (mr *MockLogIO_SubscribeClientMockRecorder) Recv() *gomock.Call {
+func (mr *MockLogIO_AppendStreamClientMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockLogIO_SubscribeClient)(nil).Recv))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockLogIO_AppendStreamClient)(nil).Recv))
 }
 
 // RecvMsg mocks base method.
-func (m *MockLogIO_SubscribeClient) RecvMsg(arg0 interface{}) error {
+func (m *MockLogIO_AppendStreamClient) RecvMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RecvMsg", arg0)
 	ret0, _ := ret[0].(error)
@@ -681,13 +784,27 @@ func (m *MockLogIO_SubscribeClient) RecvMsg(arg0 interface{}) error {
 }
 
 // RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockLogIO_SubscribeClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+func (mr *MockLogIO_AppendStreamClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockLogIO_SubscribeClient)(nil).RecvMsg), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockLogIO_AppendStreamClient)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockLogIO_AppendStreamClient) Send(arg0 *snpb.AppendRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockLogIO_AppendStreamClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockLogIO_AppendStreamClient)(nil).Send), arg0)
 }
 
 // SendMsg mocks base method.
-func (m *MockLogIO_SubscribeClient) SendMsg(arg0 interface{}) error {
+func (m *MockLogIO_AppendStreamClient) SendMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendMsg", arg0)
 	ret0, _ := ret[0].(error)
@@ -695,13 +812,13 @@ func (m *MockLogIO_SubscribeClient) SendMsg(arg0 interface{}) error {
 }
 
 // SendMsg indicates an expected call of SendMsg.
-func (mr *MockLogIO_SubscribeClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+func (mr *MockLogIO_AppendStreamClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockLogIO_SubscribeClient)(nil).SendMsg), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockLogIO_AppendStreamClient)(nil).SendMsg), arg0)
 }
 
 // Trailer mocks base method.
-func (m *MockLogIO_SubscribeClient) Trailer() metadata.MD {
+func (m *MockLogIO_AppendStreamClient) Trailer() metadata.MD {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Trailer")
 	ret0, _ := ret[0].(metadata.MD)
@@ -709,36 +826,36 @@ func (m *MockLogIO_SubscribeClient) Trailer() metadata.MD {
 }
 
 // Trailer indicates an expected call of Trailer.
-func (mr *MockLogIO_SubscribeClientMockRecorder) Trailer() *gomock.Call {
+func (mr *MockLogIO_AppendStreamClientMockRecorder) Trailer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockLogIO_SubscribeClient)(nil).Trailer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockLogIO_AppendStreamClient)(nil).Trailer))
 }
 
-// MockLogIO_SubscribeServer is a mock of LogIO_SubscribeServer interface.
-type MockLogIO_SubscribeServer struct {
+// MockLogIO_AppendStreamServer is a mock of LogIO_AppendStreamServer interface.
+type MockLogIO_AppendStreamServer struct {
 	ctrl     *gomock.Controller
-	recorder *MockLogIO_SubscribeServerMockRecorder
+	recorder *MockLogIO_AppendStreamServerMockRecorder
 }
 
-// MockLogIO_SubscribeServerMockRecorder is the mock recorder for MockLogIO_SubscribeServer.
-type MockLogIO_SubscribeServerMockRecorder struct {
-	mock *MockLogIO_SubscribeServer
+// MockLogIO_AppendStreamServerMockRecorder is the mock recorder for MockLogIO_AppendStreamServer.
+type MockLogIO_AppendStreamServerMockRecorder struct {
+	mock *MockLogIO_AppendStreamServer
 }
 
-// NewMockLogIO_SubscribeServer creates a new mock instance.
-func NewMockLogIO_SubscribeServer(ctrl *gomock.Controller) *MockLogIO_SubscribeServer {
-	mock := &MockLogIO_SubscribeServer{ctrl: ctrl}
-	mock.recorder = &MockLogIO_SubscribeServerMockRecorder{mock}
+// NewMockLogIO_AppendStreamServer creates a new mock instance.
+func NewMockLogIO_AppendStreamServer(ctrl *gomock.Controller) *MockLogIO_AppendStreamServer {
+	mock := &MockLogIO_AppendStreamServer{ctrl: ctrl}
+	mock.recorder = &MockLogIO_AppendStreamServerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockLogIO_SubscribeServer) EXPECT() *MockLogIO_SubscribeServerMockRecorder {
+func (m *MockLogIO_AppendStreamServer) EXPECT() *MockLogIO_AppendStreamServerMockRecorder {
 	return m.recorder
 }
 
 // Context mocks base method.
-func (m *MockLogIO_SubscribeServer) Context() context.Context {
+func (m *MockLogIO_AppendStreamServer) Context() context.Context {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Context")
 	ret0, _ := ret[0].(context.Context)
@@ -746,13 +863,28 @@ func (m *MockLogIO_SubscribeServer) Context() context.Context {
 }
 
 // Context indicates an expected call of Context.
-func (mr *MockLogIO_SubscribeServerMockRecorder) Context() *gomock.Call {
+func (mr *MockLogIO_AppendStreamServerMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockLogIO_SubscribeServer)(nil).Context))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockLogIO_AppendStreamServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockLogIO_AppendStreamServer) Recv() (*snpb.AppendRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*snpb.AppendRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockLogIO_AppendStreamServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockLogIO_AppendStreamServer)(nil).Recv))
 }
 
 // RecvMsg mocks base method.
-func (m *MockLogIO_SubscribeServer) RecvMsg(arg0 interface{}) error {
+func (m *MockLogIO_AppendStreamServer) RecvMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RecvMsg", arg0)
 	ret0, _ := ret[0].(error)
@@ -760,13 +892,13 @@ func (m *MockLogIO_SubscribeServer) RecvMsg(arg0 interface{}) error {
 }
 
 // RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockLogIO_SubscribeServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+func (mr *MockLogIO_AppendStreamServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockLogIO_SubscribeServer)(nil).RecvMsg), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockLogIO_AppendStreamServer)(nil).RecvMsg), arg0)
 }
 
 // Send mocks base method.
-func (m *MockLogIO_SubscribeServer) Send(arg0 *snpb.SubscribeResponse) error {
+func (m *MockLogIO_AppendStreamServer) Send(arg0 *snpb.AppendResponse) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Send", arg0)
 	ret0, _ := ret[0].(error)
@@ -774,13 +906,13 @@ func (m *MockLogIO_SubscribeServer) Send(arg0 *snpb.SubscribeResponse) error {
 }
 
 // Send indicates an expected call of Send.
-func (mr *MockLogIO_SubscribeServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+func (mr *MockLogIO_AppendStreamServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockLogIO_SubscribeServer)(nil).Send), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockLogIO_AppendStreamServer)(nil).Send), arg0)
 }
 
 // SendHeader mocks base method.
-func (m *MockLogIO_SubscribeServer) SendHeader(arg0 metadata.MD) error {
+func (m *MockLogIO_AppendStreamServer) SendHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -788,13 +920,13 @@ func (m *MockLogIO_SubscribeServer) SendHeader(arg0 metadata.MD) error {
 }
 
 // SendHeader indicates an expected call of SendHeader.
-func (mr *MockLogIO_SubscribeServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockLogIO_AppendStreamServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockLogIO_SubscribeServer)(nil).SendHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockLogIO_AppendStreamServer)(nil).SendHeader), arg0)
 }
 
 // SendMsg mocks base method.
-func (m *MockLogIO_SubscribeServer) SendMsg(arg0 interface{}) error {
+func (m *MockLogIO_AppendStreamServer) SendMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendMsg", arg0)
 	ret0, _ := ret[0].(error)
@@ -802,13 +934,13 @@ func (m *MockLogIO_SubscribeServer) SendMsg(arg0 interface{}) error {
 }
 
 // SendMsg indicates an expected call of SendMsg.
-func (mr *MockLogIO_SubscribeServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+func (mr *MockLogIO_AppendStreamServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockLogIO_SubscribeServer)(nil).SendMsg), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockLogIO_AppendStreamServer)(nil).SendMsg), arg0)
 }
 
 // SetHeader mocks base method.
-func (m *MockLogIO_SubscribeServer) SetHeader(arg0 metadata.MD) error {
+func (m *MockLogIO_AppendStreamServer) SetHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -816,458 +948,1593 @@ func (m *MockLogIO_SubscribeServer) SetHeader(arg0 metadata.MD) error {
 }
 
 // SetHeader indicates an expected call of SetHeader.
-func (mr *MockLogIO_SubscribeServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockLogIO_AppendStreamServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockLogIO_SubscribeServer)(nil).SetHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockLogIO_AppendStreamServer)(nil).SetHeader), arg0)
 }
 
 // SetTrailer mocks base method.
-func (m *MockLogIO_SubscribeServer) SetTrailer(arg0 metadata.MD) {
+func (m *MockLogIO_AppendStreamServer) SetTrailer(arg0 metadata.MD) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetTrailer", arg0)
 }
 
 // SetTrailer indicates an expected call of SetTrailer.
-func (mr *MockLogIO_SubscribeServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+func (mr *MockLogIO_AppendStreamServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockLogIO_SubscribeServer)(nil).SetTrailer), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockLogIO_AppendStreamServer)(nil).SetTrailer), arg0)
 }
 
-// MockLogStreamReporterClient is a mock of LogStreamReporterClient interface.
-type MockLogStreamReporterClient struct {
+// MockLogIO_SubscribeClient is a mock of LogIO_SubscribeClient interface.
+type MockLogIO_SubscribeClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockLogStreamReporterClientMockRecorder
+	recorder *MockLogIO_SubscribeClientMockRecorder
 }
 
-// MockLogStreamReporterClientMockRecorder is the mock recorder for MockLogStreamReporterClient.
-type MockLogStreamReporterClientMockRecorder struct {
-	mock *MockLogStreamReporterClient
+// MockLogIO_SubscribeClientMockRecorder is the mock recorder for MockLogIO_SubscribeClient.
+type MockLogIO_SubscribeClientMockRecorder struct {
+	mock *MockLogIO_SubscribeClient
 }
 
-// NewMockLogStreamReporterClient creates a new mock instance.
-func NewMockLogStreamReporterClient(ctrl *gomock.Controller) *MockLogStreamReporterClient {
-	mock := &MockLogStreamReporterClient{ctrl: ctrl}
-	mock.recorder = &MockLogStreamReporterClientMockRecorder{mock}
+// NewMockLogIO_SubscribeClient creates a new mock instance.
+func NewMockLogIO_SubscribeClient(ctrl *gomock.Controller) *MockLogIO_SubscribeClient {
+	mock := &MockLogIO_SubscribeClient{ctrl: ctrl}
+	mock.recorder = &MockLogIO_SubscribeClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockLogStreamReporterClient) EXPECT() *MockLogStreamReporterClientMockRecorder {
+func (m *MockLogIO_SubscribeClient) EXPECT() *MockLogIO_SubscribeClientMockRecorder {
 	return m.recorder
 }
 
-// Commit mocks base method.
-func (m *MockLogStreamReporterClient) Commit(arg0 context.Context, arg1 ...grpc.CallOption) (snpb.LogStreamReporter_CommitClient, error) {
+// CloseSend mocks base method.
+func (m *MockLogIO_SubscribeClient) CloseSend() error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0}
-	for _, a := range arg1 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Commit", varargs...)
-	ret0, _ := ret[0].(snpb.LogStreamReporter_CommitClient)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Commit indicates an expected call of Commit.
-func (mr *MockLogStreamReporterClientMockRecorder) Commit(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockLogIO_SubscribeClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0}, arg1...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockLogStreamReporterClient)(nil).Commit), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockLogIO_SubscribeClient)(nil).CloseSend))
 }
 
-// CommitBatch mocks base method.
-func (m *MockLogStreamReporterClient) CommitBatch(arg0 context.Context, arg1 ...grpc.CallOption) (snpb.LogStreamReporter_CommitBatchClient, error) {
+// Context mocks base method.
+func (m *MockLogIO_SubscribeClient) Context() context.Context {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0}
-	for _, a := range arg1 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "CommitBatch", varargs...)
-	ret0, _ := ret[0].(snpb.LogStreamReporter_CommitBatchClient)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
 }
 
-// CommitBatch indicates an expected call of CommitBatch.
-func (mr *MockLogStreamReporterClientMockRecorder) CommitBatch(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+// Context indicates an expected call of Context.
+func (mr *MockLogIO_SubscribeClientMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0}, arg1...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitBatch", reflect.TypeOf((*MockLogStreamReporterClient)(nil).CommitBatch), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockLogIO_SubscribeClient)(nil).Context))
 }
 
-// GetReport mocks base method.
-func (m *MockLogStreamReporterClient) GetReport(arg0 context.Context, arg1 ...grpc.CallOption) (snpb.LogStreamReporter_GetReportClient, error) {
+// Header mocks base method.
+func (m *MockLogIO_SubscribeClient) Header() (metadata.MD, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0}
-	for _, a := range arg1 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetReport", varargs...)
-	ret0, _ := ret[0].(snpb.LogStreamReporter_GetReportClient)
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetReport indicates an expected call of GetReport.
-func (mr *MockLogStreamReporterClientMockRecorder) GetReport(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+// Header indicates an expected call of Header.
+func (mr *MockLogIO_SubscribeClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0}, arg1...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockLogStreamReporterClient)(nil).GetReport), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockLogIO_SubscribeClient)(nil).Header))
 }
 
-// MockLogStreamReporterServer is a mock of LogStreamReporterServer interface.
-type MockLogStreamReporterServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockLogStreamReporterServerMockRecorder
+// Recv mocks base method.
+func (m *MockLogIO_SubscribeClient) Recv() (*snpb.SubscribeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*snpb.SubscribeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockLogStreamReporterServerMockRecorder is the mock recorder for MockLogStreamReporterServer.
-type MockLogStreamReporterServerMockRecorder struct {
-	mock *MockLogStreamReporterServer
+// Recv indicates an expected call of Recv.
+func (mr *MockLogIO_SubscribeClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockLogIO_SubscribeClient)(nil).Recv))
 }
 
-// NewMockLogStreamReporterServer creates a new mock instance.
-func NewMockLogStreamReporterServer(ctrl *gomock.Controller) *MockLogStreamReporterServer {
+// RecvMsg mocks base method.
+func (m *MockLogIO_SubscribeClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockLogIO_SubscribeClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockLogIO_SubscribeClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockLogIO_SubscribeClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockLogIO_SubscribeClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockLogIO_SubscribeClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method.
+func (m *MockLogIO_SubscribeClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockLogIO_SubscribeClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockLogIO_SubscribeClient)(nil).Trailer))
+}
+
+// MockLogIO_SubscribeServer is a mock of LogIO_SubscribeServer interface.
+type MockLogIO_SubscribeServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogIO_SubscribeServerMockRecorder
+}
+
+// MockLogIO_SubscribeServerMockRecorder is the mock recorder for MockLogIO_SubscribeServer.
+type MockLogIO_SubscribeServerMockRecorder struct {
+	mock *MockLogIO_SubscribeServer
+}
+
+// NewMockLogIO_SubscribeServer creates a new mock instance.
+func NewMockLogIO_SubscribeServer(ctrl *gomock.Controller) *MockLogIO_SubscribeServer {
+	mock := &MockLogIO_SubscribeServer{ctrl: ctrl}
+	mock.recorder = &MockLogIO_SubscribeServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogIO_SubscribeServer) EXPECT() *MockLogIO_SubscribeServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockLogIO_SubscribeServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockLogIO_SubscribeServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockLogIO_SubscribeServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m *MockLogIO_SubscribeServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockLogIO_SubscribeServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockLogIO_SubscribeServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockLogIO_SubscribeServer) Send(arg0 *snpb.SubscribeResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockLogIO_SubscribeServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockLogIO_SubscribeServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockLogIO_SubscribeServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockLogIO_SubscribeServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockLogIO_SubscribeServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockLogIO_SubscribeServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockLogIO_SubscribeServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockLogIO_SubscribeServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockLogIO_SubscribeServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockLogIO_SubscribeServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockLogIO_SubscribeServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockLogIO_SubscribeServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockLogIO_SubscribeServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockLogIO_SubscribeServer)(nil).SetTrailer), arg0)
+}
+
+// MockLogIO_ReadBatchClient is a mock of LogIO_ReadBatchClient interface.
+type MockLogIO_ReadBatchClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogIO_ReadBatchClientMockRecorder
+}
+
+// MockLogIO_ReadBatchClientMockRecorder is the mock recorder for MockLogIO_ReadBatchClient.
+type MockLogIO_ReadBatchClientMockRecorder struct {
+	mock *MockLogIO_ReadBatchClient
+}
+
+// NewMockLogIO_ReadBatchClient creates a new mock instance.
+func NewMockLogIO_ReadBatchClient(ctrl *gomock.Controller) *MockLogIO_ReadBatchClient {
+	mock := &MockLogIO_ReadBatchClient{ctrl: ctrl}
+	mock.recorder = &MockLogIO_ReadBatchClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogIO_ReadBatchClient) EXPECT() *MockLogIO_ReadBatchClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockLogIO_ReadBatchClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockLogIO_ReadBatchClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockLogIO_ReadBatchClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockLogIO_ReadBatchClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockLogIO_ReadBatchClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockLogIO_ReadBatchClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockLogIO_ReadBatchClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockLogIO_ReadBatchClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockLogIO_ReadBatchClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockLogIO_ReadBatchClient) Recv() (*snpb.ReadBatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*snpb.ReadBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockLogIO_ReadBatchClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockLogIO_ReadBatchClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m *MockLogIO_ReadBatchClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockLogIO_ReadBatchClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockLogIO_ReadBatchClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockLogIO_ReadBatchClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockLogIO_ReadBatchClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockLogIO_ReadBatchClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method.
+func (m *MockLogIO_ReadBatchClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockLogIO_ReadBatchClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockLogIO_ReadBatchClient)(nil).Trailer))
+}
+
+// MockLogIO_ReadBatchServer is a mock of LogIO_ReadBatchServer interface.
+type MockLogIO_ReadBatchServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogIO_ReadBatchServerMockRecorder
+}
+
+// MockLogIO_ReadBatchServerMockRecorder is the mock recorder for MockLogIO_ReadBatchServer.
+type MockLogIO_ReadBatchServerMockRecorder struct {
+	mock *MockLogIO_ReadBatchServer
+}
+
+// NewMockLogIO_ReadBatchServer creates a new mock instance.
+func NewMockLogIO_ReadBatchServer(ctrl *gomock.Controller) *MockLogIO_ReadBatchServer {
+	mock := &MockLogIO_ReadBatchServer{ctrl: ctrl}
+	mock.recorder = &MockLogIO_ReadBatchServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogIO_ReadBatchServer) EXPECT() *MockLogIO_ReadBatchServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockLogIO_ReadBatchServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockLogIO_ReadBatchServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockLogIO_ReadBatchServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m *MockLogIO_ReadBatchServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockLogIO_ReadBatchServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockLogIO_ReadBatchServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockLogIO_ReadBatchServer) Send(arg0 *snpb.ReadBatchResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockLogIO_ReadBatchServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockLogIO_ReadBatchServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockLogIO_ReadBatchServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockLogIO_ReadBatchServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockLogIO_ReadBatchServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockLogIO_ReadBatchServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockLogIO_ReadBatchServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockLogIO_ReadBatchServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockLogIO_ReadBatchServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockLogIO_ReadBatchServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockLogIO_ReadBatchServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockLogIO_ReadBatchServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockLogIO_ReadBatchServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockLogIO_ReadBatchServer)(nil).SetTrailer), arg0)
+}
+
+// MockLogStreamReporterClient is a mock of LogStreamReporterClient interface.
+type MockLogStreamReporterClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogStreamReporterClientMockRecorder
+}
+
+// MockLogStreamReporterClientMockRecorder is the mock recorder for MockLogStreamReporterClient.
+type MockLogStreamReporterClientMockRecorder struct {
+	mock *MockLogStreamReporterClient
+}
+
+// NewMockLogStreamReporterClient creates a new mock instance.
+func NewMockLogStreamReporterClient(ctrl *gomock.Controller) *MockLogStreamReporterClient {
+	mock := &MockLogStreamReporterClient{ctrl: ctrl}
+	mock.recorder = &MockLogStreamReporterClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogStreamReporterClient) EXPECT() *MockLogStreamReporterClientMockRecorder {
+	return m.recorder
+}
+
+// Commit mocks base method.
+func (m *MockLogStreamReporterClient) Commit(arg0 context.Context, arg1 ...grpc.CallOption) (snpb.LogStreamReporter_CommitClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Commit", varargs...)
+	ret0, _ := ret[0].(snpb.LogStreamReporter_CommitClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockLogStreamReporterClientMockRecorder) Commit(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockLogStreamReporterClient)(nil).Commit), varargs...)
+}
+
+// CommitBatch mocks base method.
+func (m *MockLogStreamReporterClient) CommitBatch(arg0 context.Context, arg1 ...grpc.CallOption) (snpb.LogStreamReporter_CommitBatchClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitBatch", varargs...)
+	ret0, _ := ret[0].(snpb.LogStreamReporter_CommitBatchClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitBatch indicates an expected call of CommitBatch.
+func (mr *MockLogStreamReporterClientMockRecorder) CommitBatch(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitBatch", reflect.TypeOf((*MockLogStreamReporterClient)(nil).CommitBatch), varargs...)
+}
+
+// GetReport mocks base method.
+func (m *MockLogStreamReporterClient) GetReport(arg0 context.Context, arg1 ...grpc.CallOption) (snpb.LogStreamReporter_GetReportClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReport", varargs...)
+	ret0, _ := ret[0].(snpb.LogStreamReporter_GetReportClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockLogStreamReporterClientMockRecorder) GetReport(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockLogStreamReporterClient)(nil).GetReport), varargs...)
+}
+
+// MockLogStreamReporterServer is a mock of LogStreamReporterServer interface.
+type MockLogStreamReporterServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogStreamReporterServerMockRecorder
+}
+
+// MockLogStreamReporterServerMockRecorder is the mock recorder for MockLogStreamReporterServer.
+type MockLogStreamReporterServerMockRecorder struct {
+	mock *MockLogStreamReporterServer
+}
+
+// NewMockLogStreamReporterServer creates a new mock instance.
+func NewMockLogStreamReporterServer(ctrl *gomock.Controller) *MockLogStreamReporterServer {
 	mock := &MockLogStreamReporterServer{ctrl: ctrl}
 	mock.recorder = &MockLogStreamReporterServerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockLogStreamReporterServer) EXPECT() *MockLogStreamReporterServerMockRecorder {
+func (m *MockLogStreamReporterServer) EXPECT() *MockLogStreamReporterServerMockRecorder {
+	return m.recorder
+}
+
+// Commit mocks base method.
+func (m *MockLogStreamReporterServer) Commit(arg0 snpb.LogStreamReporter_CommitServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockLogStreamReporterServerMockRecorder) Commit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockLogStreamReporterServer)(nil).Commit), arg0)
+}
+
+// CommitBatch mocks base method.
+func (m *MockLogStreamReporterServer) CommitBatch(arg0 snpb.LogStreamReporter_CommitBatchServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitBatch", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitBatch indicates an expected call of CommitBatch.
+func (mr *MockLogStreamReporterServerMockRecorder) CommitBatch(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitBatch", reflect.TypeOf((*MockLogStreamReporterServer)(nil).CommitBatch), arg0)
+}
+
+// GetReport mocks base method.
+func (m *MockLogStreamReporterServer) GetReport(arg0 snpb.LogStreamReporter_GetReportServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockLogStreamReporterServerMockRecorder) GetReport(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockLogStreamReporterServer)(nil).GetReport), arg0)
+}
+
+// MockManagementClient is a mock of ManagementClient interface.
+type MockManagementClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagementClientMockRecorder
+}
+
+// MockManagementClientMockRecorder is the mock recorder for MockManagementClient.
+type MockManagementClientMockRecorder struct {
+	mock *MockManagementClient
+}
+
+// NewMockManagementClient creates a new mock instance.
+func NewMockManagementClient(ctrl *gomock.Controller) *MockManagementClient {
+	mock := &MockManagementClient{ctrl: ctrl}
+	mock.recorder = &MockManagementClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagementClient) EXPECT() *MockManagementClientMockRecorder {
+	return m.recorder
+}
+
+// AddLogStreamReplica mocks base method.
+func (m *MockManagementClient) AddLogStreamReplica(arg0 context.Context, arg1 *snpb.AddLogStreamReplicaRequest, arg2 ...grpc.CallOption) (*snpb.AddLogStreamReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddLogStreamReplica", varargs...)
+	ret0, _ := ret[0].(*snpb.AddLogStreamReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddLogStreamReplica indicates an expected call of AddLogStreamReplica.
+func (mr *MockManagementClientMockRecorder) AddLogStreamReplica(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLogStreamReplica", reflect.TypeOf((*MockManagementClient)(nil).AddLogStreamReplica), varargs...)
+}
+
+// CheckBackupPath mocks base method.
+func (m *MockManagementClient) CheckBackupPath(arg0 context.Context, arg1 *snpb.CheckBackupPathRequest, arg2 ...grpc.CallOption) (*snpb.CheckBackupPathResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckBackupPath", varargs...)
+	ret0, _ := ret[0].(*snpb.CheckBackupPathResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckBackupPath indicates an expected call of CheckBackupPath.
+func (mr *MockManagementClientMockRecorder) CheckBackupPath(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBackupPath", reflect.TypeOf((*MockManagementClient)(nil).CheckBackupPath), varargs...)
+}
+
+// Checkpoint mocks base method.
+func (m *MockManagementClient) Checkpoint(arg0 context.Context, arg1 *snpb.CheckpointRequest, arg2 ...grpc.CallOption) (*snpb.CheckpointResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Checkpoint", varargs...)
+	ret0, _ := ret[0].(*snpb.CheckpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkpoint indicates an expected call of Checkpoint.
+func (mr *MockManagementClientMockRecorder) Checkpoint(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkpoint", reflect.TypeOf((*MockManagementClient)(nil).Checkpoint), varargs...)
+}
+
+// Export mocks base method.
+func (m *MockManagementClient) Export(arg0 context.Context, arg1 *snpb.ExportRequest, arg2 ...grpc.CallOption) (snpb.Management_ExportClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Export", varargs...)
+	ret0, _ := ret[0].(snpb.Management_ExportClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockManagementClientMockRecorder) Export(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockManagementClient)(nil).Export), varargs...)
+}
+
+// GetMetadata mocks base method.
+func (m *MockManagementClient) GetMetadata(arg0 context.Context, arg1 *snpb.GetMetadataRequest, arg2 ...grpc.CallOption) (*snpb.GetMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMetadata", varargs...)
+	ret0, _ := ret[0].(*snpb.GetMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetadata indicates an expected call of GetMetadata.
+func (mr *MockManagementClientMockRecorder) GetMetadata(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockManagementClient)(nil).GetMetadata), varargs...)
+}
+
+// Import mocks base method.
+func (m *MockManagementClient) Import(arg0 context.Context, arg1 ...grpc.CallOption) (snpb.Management_ImportClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Import", varargs...)
+	ret0, _ := ret[0].(snpb.Management_ImportClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockManagementClientMockRecorder) Import(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockManagementClient)(nil).Import), varargs...)
+}
+
+// RemoveLogStream mocks base method.
+func (m *MockManagementClient) RemoveLogStream(arg0 context.Context, arg1 *snpb.RemoveLogStreamRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveLogStream", varargs...)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveLogStream indicates an expected call of RemoveLogStream.
+func (mr *MockManagementClientMockRecorder) RemoveLogStream(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLogStream", reflect.TypeOf((*MockManagementClient)(nil).RemoveLogStream), varargs...)
+}
+
+// Seal mocks base method.
+func (m *MockManagementClient) Seal(arg0 context.Context, arg1 *snpb.SealRequest, arg2 ...grpc.CallOption) (*snpb.SealResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Seal", varargs...)
+	ret0, _ := ret[0].(*snpb.SealResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Seal indicates an expected call of Seal.
+func (mr *MockManagementClientMockRecorder) Seal(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockManagementClient)(nil).Seal), varargs...)
+}
+
+// Sync mocks base method.
+func (m *MockManagementClient) Sync(arg0 context.Context, arg1 *snpb.SyncRequest, arg2 ...grpc.CallOption) (*snpb.SyncResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Sync", varargs...)
+	ret0, _ := ret[0].(*snpb.SyncResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockManagementClientMockRecorder) Sync(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockManagementClient)(nil).Sync), varargs...)
+}
+
+// Trim mocks base method.
+func (m *MockManagementClient) Trim(arg0 context.Context, arg1 *snpb.TrimRequest, arg2 ...grpc.CallOption) (*snpb.TrimResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Trim", varargs...)
+	ret0, _ := ret[0].(*snpb.TrimResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Trim indicates an expected call of Trim.
+func (mr *MockManagementClientMockRecorder) Trim(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trim", reflect.TypeOf((*MockManagementClient)(nil).Trim), varargs...)
+}
+
+// Unseal mocks base method.
+func (m *MockManagementClient) Unseal(arg0 context.Context, arg1 *snpb.UnsealRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Unseal", varargs...)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unseal indicates an expected call of Unseal.
+func (mr *MockManagementClientMockRecorder) Unseal(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unseal", reflect.TypeOf((*MockManagementClient)(nil).Unseal), varargs...)
+}
+
+// MockManagementServer is a mock of ManagementServer interface.
+type MockManagementServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagementServerMockRecorder
+}
+
+// MockManagementServerMockRecorder is the mock recorder for MockManagementServer.
+type MockManagementServerMockRecorder struct {
+	mock *MockManagementServer
+}
+
+// NewMockManagementServer creates a new mock instance.
+func NewMockManagementServer(ctrl *gomock.Controller) *MockManagementServer {
+	mock := &MockManagementServer{ctrl: ctrl}
+	mock.recorder = &MockManagementServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagementServer) EXPECT() *MockManagementServerMockRecorder {
+	return m.recorder
+}
+
+// AddLogStreamReplica mocks base method.
+func (m *MockManagementServer) AddLogStreamReplica(arg0 context.Context, arg1 *snpb.AddLogStreamReplicaRequest) (*snpb.AddLogStreamReplicaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLogStreamReplica", arg0, arg1)
+	ret0, _ := ret[0].(*snpb.AddLogStreamReplicaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddLogStreamReplica indicates an expected call of AddLogStreamReplica.
+func (mr *MockManagementServerMockRecorder) AddLogStreamReplica(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLogStreamReplica", reflect.TypeOf((*MockManagementServer)(nil).AddLogStreamReplica), arg0, arg1)
+}
+
+// CheckBackupPath mocks base method.
+func (m *MockManagementServer) CheckBackupPath(arg0 context.Context, arg1 *snpb.CheckBackupPathRequest) (*snpb.CheckBackupPathResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckBackupPath", arg0, arg1)
+	ret0, _ := ret[0].(*snpb.CheckBackupPathResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckBackupPath indicates an expected call of CheckBackupPath.
+func (mr *MockManagementServerMockRecorder) CheckBackupPath(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBackupPath", reflect.TypeOf((*MockManagementServer)(nil).CheckBackupPath), arg0, arg1)
+}
+
+// Checkpoint mocks base method.
+func (m *MockManagementServer) Checkpoint(arg0 context.Context, arg1 *snpb.CheckpointRequest) (*snpb.CheckpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkpoint", arg0, arg1)
+	ret0, _ := ret[0].(*snpb.CheckpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkpoint indicates an expected call of Checkpoint.
+func (mr *MockManagementServerMockRecorder) Checkpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkpoint", reflect.TypeOf((*MockManagementServer)(nil).Checkpoint), arg0, arg1)
+}
+
+// Export mocks base method.
+func (m *MockManagementServer) Export(arg0 *snpb.ExportRequest, arg1 snpb.Management_ExportServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockManagementServerMockRecorder) Export(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockManagementServer)(nil).Export), arg0, arg1)
+}
+
+// GetMetadata mocks base method.
+func (m *MockManagementServer) GetMetadata(arg0 context.Context, arg1 *snpb.GetMetadataRequest) (*snpb.GetMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*snpb.GetMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetadata indicates an expected call of GetMetadata.
+func (mr *MockManagementServerMockRecorder) GetMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockManagementServer)(nil).GetMetadata), arg0, arg1)
+}
+
+// Import mocks base method.
+func (m *MockManagementServer) Import(arg0 snpb.Management_ImportServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockManagementServerMockRecorder) Import(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockManagementServer)(nil).Import), arg0)
+}
+
+// RemoveLogStream mocks base method.
+func (m *MockManagementServer) RemoveLogStream(arg0 context.Context, arg1 *snpb.RemoveLogStreamRequest) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveLogStream", arg0, arg1)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveLogStream indicates an expected call of RemoveLogStream.
+func (mr *MockManagementServerMockRecorder) RemoveLogStream(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLogStream", reflect.TypeOf((*MockManagementServer)(nil).RemoveLogStream), arg0, arg1)
+}
+
+// Seal mocks base method.
+func (m *MockManagementServer) Seal(arg0 context.Context, arg1 *snpb.SealRequest) (*snpb.SealResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seal", arg0, arg1)
+	ret0, _ := ret[0].(*snpb.SealResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Seal indicates an expected call of Seal.
+func (mr *MockManagementServerMockRecorder) Seal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockManagementServer)(nil).Seal), arg0, arg1)
+}
+
+// Sync mocks base method.
+func (m *MockManagementServer) Sync(arg0 context.Context, arg1 *snpb.SyncRequest) (*snpb.SyncResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", arg0, arg1)
+	ret0, _ := ret[0].(*snpb.SyncResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockManagementServerMockRecorder) Sync(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockManagementServer)(nil).Sync), arg0, arg1)
+}
+
+// Trim mocks base method.
+func (m *MockManagementServer) Trim(arg0 context.Context, arg1 *snpb.TrimRequest) (*snpb.TrimResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trim", arg0, arg1)
+	ret0, _ := ret[0].(*snpb.TrimResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Trim indicates an expected call of Trim.
+func (mr *MockManagementServerMockRecorder) Trim(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trim", reflect.TypeOf((*MockManagementServer)(nil).Trim), arg0, arg1)
+}
+
+// Unseal mocks base method.
+func (m *MockManagementServer) Unseal(arg0 context.Context, arg1 *snpb.UnsealRequest) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unseal", arg0, arg1)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unseal indicates an expected call of Unseal.
+func (mr *MockManagementServerMockRecorder) Unseal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unseal", reflect.TypeOf((*MockManagementServer)(nil).Unseal), arg0, arg1)
+}
+
+// MockManagement_ExportClient is a mock of Management_ExportClient interface.
+type MockManagement_ExportClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagement_ExportClientMockRecorder
+}
+
+// MockManagement_ExportClientMockRecorder is the mock recorder for MockManagement_ExportClient.
+type MockManagement_ExportClientMockRecorder struct {
+	mock *MockManagement_ExportClient
+}
+
+// NewMockManagement_ExportClient creates a new mock instance.
+func NewMockManagement_ExportClient(ctrl *gomock.Controller) *MockManagement_ExportClient {
+	mock := &MockManagement_ExportClient{ctrl: ctrl}
+	mock.recorder = &MockManagement_ExportClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagement_ExportClient) EXPECT() *MockManagement_ExportClientMockRecorder {
 	return m.recorder
 }
 
-// Commit mocks base method.
-func (m *MockLogStreamReporterServer) Commit(arg0 snpb.LogStreamReporter_CommitServer) error {
+// CloseSend mocks base method.
+func (m *MockManagement_ExportClient) CloseSend() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Commit", arg0)
+	ret := m.ctrl.Call(m, "CloseSend")
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Commit indicates an expected call of Commit.
-func (mr *MockLogStreamReporterServerMockRecorder) Commit(arg0 interface{}) *gomock.Call {
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockManagement_ExportClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockLogStreamReporterServer)(nil).Commit), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockManagement_ExportClient)(nil).CloseSend))
 }
 
-// CommitBatch mocks base method.
-func (m *MockLogStreamReporterServer) CommitBatch(arg0 snpb.LogStreamReporter_CommitBatchServer) error {
+// Context mocks base method.
+func (m *MockManagement_ExportClient) Context() context.Context {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CommitBatch", arg0)
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockManagement_ExportClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockManagement_ExportClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockManagement_ExportClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockManagement_ExportClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockManagement_ExportClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockManagement_ExportClient) Recv() (*snpb.ExportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*snpb.ExportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockManagement_ExportClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockManagement_ExportClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m *MockManagement_ExportClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CommitBatch indicates an expected call of CommitBatch.
-func (mr *MockLogStreamReporterServerMockRecorder) CommitBatch(arg0 interface{}) *gomock.Call {
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockManagement_ExportClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitBatch", reflect.TypeOf((*MockLogStreamReporterServer)(nil).CommitBatch), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockManagement_ExportClient)(nil).RecvMsg), arg0)
 }
 
-// GetReport mocks base method.
-func (m *MockLogStreamReporterServer) GetReport(arg0 snpb.LogStreamReporter_GetReportServer) error {
+// SendMsg mocks base method.
+func (m *MockManagement_ExportClient) SendMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReport", arg0)
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GetReport indicates an expected call of GetReport.
-func (mr *MockLogStreamReporterServerMockRecorder) GetReport(arg0 interface{}) *gomock.Call {
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockManagement_ExportClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockLogStreamReporterServer)(nil).GetReport), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockManagement_ExportClient)(nil).SendMsg), arg0)
 }
 
-// MockManagementClient is a mock of ManagementClient interface.
-type MockManagementClient struct {
+// Trailer mocks base method.
+func (m *MockManagement_ExportClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockManagement_ExportClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockManagement_ExportClient)(nil).Trailer))
+}
+
+// MockManagement_ExportServer is a mock of Management_ExportServer interface.
+type MockManagement_ExportServer struct {
 	ctrl     *gomock.Controller
-	recorder *MockManagementClientMockRecorder
+	recorder *MockManagement_ExportServerMockRecorder
+}
+
+// MockManagement_ExportServerMockRecorder is the mock recorder for MockManagement_ExportServer.
+type MockManagement_ExportServerMockRecorder struct {
+	mock *MockManagement_ExportServer
+}
+
+// NewMockManagement_ExportServer creates a new mock instance.
+func NewMockManagement_ExportServer(ctrl *gomock.Controller) *MockManagement_ExportServer {
+	mock := &MockManagement_ExportServer{ctrl: ctrl}
+	mock.recorder = &MockManagement_ExportServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagement_ExportServer) EXPECT() *MockManagement_ExportServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockManagement_ExportServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockManagement_ExportServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockManagement_ExportServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m *MockManagement_ExportServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockManagement_ExportServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockManagement_ExportServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockManagement_ExportServer) Send(arg0 *snpb.ExportResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockManagement_ExportServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockManagement_ExportServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockManagement_ExportServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockManagement_ExportServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockManagement_ExportServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockManagement_ExportServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockManagement_ExportServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockManagement_ExportServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockManagement_ExportServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockManagement_ExportServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockManagement_ExportServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockManagement_ExportServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockManagement_ExportServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockManagement_ExportServer)(nil).SetTrailer), arg0)
+}
+
+// MockManagement_ImportClient is a mock of Management_ImportClient interface.
+type MockManagement_ImportClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagement_ImportClientMockRecorder
 }
 
-// MockManagementClientMockRecorder is the mock recorder for MockManagementClient.
-type MockManagementClientMockRecorder struct {
-	mock *MockManagementClient
+// MockManagement_ImportClientMockRecorder is the mock recorder for MockManagement_ImportClient.
+type MockManagement_ImportClientMockRecorder struct {
+	mock *MockManagement_ImportClient
 }
 
-// NewMockManagementClient creates a new mock instance.
-func NewMockManagementClient(ctrl *gomock.Controller) *MockManagementClient {
-	mock := &MockManagementClient{ctrl: ctrl}
-	mock.recorder = &MockManagementClientMockRecorder{mock}
+// NewMockManagement_ImportClient creates a new mock instance.
+func NewMockManagement_ImportClient(ctrl *gomock.Controller) *MockManagement_ImportClient {
+	mock := &MockManagement_ImportClient{ctrl: ctrl}
+	mock.recorder = &MockManagement_ImportClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockManagementClient) EXPECT() *MockManagementClientMockRecorder {
+func (m *MockManagement_ImportClient) EXPECT() *MockManagement_ImportClientMockRecorder {
 	return m.recorder
 }
 
-// AddLogStreamReplica mocks base method.
-func (m *MockManagementClient) AddLogStreamReplica(arg0 context.Context, arg1 *snpb.AddLogStreamReplicaRequest, arg2 ...grpc.CallOption) (*snpb.AddLogStreamReplicaResponse, error) {
+// CloseAndRecv mocks base method.
+func (m *MockManagement_ImportClient) CloseAndRecv() (*snpb.ImportResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "AddLogStreamReplica", varargs...)
-	ret0, _ := ret[0].(*snpb.AddLogStreamReplicaResponse)
+	ret := m.ctrl.Call(m, "CloseAndRecv")
+	ret0, _ := ret[0].(*snpb.ImportResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddLogStreamReplica indicates an expected call of AddLogStreamReplica.
-func (mr *MockManagementClientMockRecorder) AddLogStreamReplica(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// CloseAndRecv indicates an expected call of CloseAndRecv.
+func (mr *MockManagement_ImportClientMockRecorder) CloseAndRecv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLogStreamReplica", reflect.TypeOf((*MockManagementClient)(nil).AddLogStreamReplica), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAndRecv", reflect.TypeOf((*MockManagement_ImportClient)(nil).CloseAndRecv))
 }
 
-// GetMetadata mocks base method.
-func (m *MockManagementClient) GetMetadata(arg0 context.Context, arg1 *snpb.GetMetadataRequest, arg2 ...grpc.CallOption) (*snpb.GetMetadataResponse, error) {
+// CloseSend mocks base method.
+func (m *MockManagement_ImportClient) CloseSend() error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetMetadata", varargs...)
-	ret0, _ := ret[0].(*snpb.GetMetadataResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetMetadata indicates an expected call of GetMetadata.
-func (mr *MockManagementClientMockRecorder) GetMetadata(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockManagement_ImportClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockManagementClient)(nil).GetMetadata), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockManagement_ImportClient)(nil).CloseSend))
 }
 
-// RemoveLogStream mocks base method.
-func (m *MockManagementClient) RemoveLogStream(arg0 context.Context, arg1 *snpb.RemoveLogStreamRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
+// Context mocks base method.
+func (m *MockManagement_ImportClient) Context() context.Context {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "RemoveLogStream", varargs...)
-	ret0, _ := ret[0].(*types.Empty)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
 }
 
-// RemoveLogStream indicates an expected call of RemoveLogStream.
-func (mr *MockManagementClientMockRecorder) RemoveLogStream(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// Context indicates an expected call of Context.
+func (mr *MockManagement_ImportClientMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLogStream", reflect.TypeOf((*MockManagementClient)(nil).RemoveLogStream), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockManagement_ImportClient)(nil).Context))
 }
 
-// Seal mocks base method.
-func (m *MockManagementClient) Seal(arg0 context.Context, arg1 *snpb.SealRequest, arg2 ...grpc.CallOption) (*snpb.SealResponse, error) {
+// Header mocks base method.
+func (m *MockManagement_ImportClient) Header() (metadata.MD, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Seal", varargs...)
-	ret0, _ := ret[0].(*snpb.SealResponse)
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Seal indicates an expected call of Seal.
-func (mr *MockManagementClientMockRecorder) Seal(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// Header indicates an expected call of Header.
+func (mr *MockManagement_ImportClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockManagementClient)(nil).Seal), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockManagement_ImportClient)(nil).Header))
 }
 
-// Sync mocks base method.
-func (m *MockManagementClient) Sync(arg0 context.Context, arg1 *snpb.SyncRequest, arg2 ...grpc.CallOption) (*snpb.SyncResponse, error) {
+// RecvMsg mocks base method.
+func (m *MockManagement_ImportClient) RecvMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Sync", varargs...)
-	ret0, _ := ret[0].(*snpb.SyncResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Sync indicates an expected call of Sync.
-func (mr *MockManagementClientMockRecorder) Sync(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockManagement_ImportClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockManagementClient)(nil).Sync), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockManagement_ImportClient)(nil).RecvMsg), arg0)
 }
 
-// Trim mocks base method.
-func (m *MockManagementClient) Trim(arg0 context.Context, arg1 *snpb.TrimRequest, arg2 ...grpc.CallOption) (*snpb.TrimResponse, error) {
+// Send mocks base method.
+func (m *MockManagement_ImportClient) Send(arg0 *snpb.ImportRequest) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Trim", varargs...)
-	ret0, _ := ret[0].(*snpb.TrimResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Trim indicates an expected call of Trim.
-func (mr *MockManagementClientMockRecorder) Trim(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// Send indicates an expected call of Send.
+func (mr *MockManagement_ImportClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trim", reflect.TypeOf((*MockManagementClient)(nil).Trim), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockManagement_ImportClient)(nil).Send), arg0)
 }
 
-// Unseal mocks base method.
-func (m *MockManagementClient) Unseal(arg0 context.Context, arg1 *snpb.UnsealRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
+// SendMsg mocks base method.
+func (m *MockManagement_ImportClient) SendMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Unseal", varargs...)
-	ret0, _ := ret[0].(*types.Empty)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Unseal indicates an expected call of Unseal.
-func (mr *MockManagementClientMockRecorder) Unseal(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockManagement_ImportClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unseal", reflect.TypeOf((*MockManagementClient)(nil).Unseal), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockManagement_ImportClient)(nil).SendMsg), arg0)
 }
 
-// MockManagementServer is a mock of ManagementServer interface.
-type MockManagementServer struct {
+// Trailer mocks base method.
+func (m *MockManagement_ImportClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockManagement_ImportClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockManagement_ImportClient)(nil).Trailer))
+}
+
+// MockManagement_ImportServer is a mock of Management_ImportServer interface.
+type MockManagement_ImportServer struct {
 	ctrl     *gomock.Controller
-	recorder *MockManagementServerMockRecorder
+	recorder *MockManagement_ImportServerMockRecorder
 }
 
-// MockManagementServerMockRecorder is the mock recorder for MockManagementServer.
-type MockManagementServerMockRecorder struct {
-	mock *MockManagementServer
+// MockManagement_ImportServerMockRecorder is the mock recorder for MockManagement_ImportServer.
+type MockManagement_ImportServerMockRecorder struct {
+	mock *MockManagement_ImportServer
 }
 
-// NewMockManagementServer creates a new mock instance.
-func NewMockManagementServer(ctrl *gomock.Controller) *MockManagementServer {
-	mock := &MockManagementServer{ctrl: ctrl}
-	mock.recorder = &MockManagementServerMockRecorder{mock}
+// NewMockManagement_ImportServer creates a new mock instance.
+func NewMockManagement_ImportServer(ctrl *gomock.Controller) *MockManagement_ImportServer {
+	mock := &MockManagement_ImportServer{ctrl: ctrl}
+	mock.recorder = &MockManagement_ImportServerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockManagementServer) EXPECT() *MockManagementServerMockRecorder {
+func (m *MockManagement_ImportServer) EXPECT() *MockManagement_ImportServerMockRecorder {
 	return m.recorder
 }
 
-// AddLogStreamReplica mocks base method.
-func (m *MockManagementServer) AddLogStreamReplica(arg0 context.Context, arg1 *snpb.AddLogStreamReplicaRequest) (*snpb.AddLogStreamReplicaResponse, error) {
+// Context mocks base method.
+func (m *MockManagement_ImportServer) Context() context.Context {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddLogStreamReplica", arg0, arg1)
-	ret0, _ := ret[0].(*snpb.AddLogStreamReplicaResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
 }
 
-// AddLogStreamReplica indicates an expected call of AddLogStreamReplica.
-func (mr *MockManagementServerMockRecorder) AddLogStreamReplica(arg0, arg1 interface{}) *gomock.Call {
+// Context indicates an expected call of Context.
+func (mr *MockManagement_ImportServerMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLogStreamReplica", reflect.TypeOf((*MockManagementServer)(nil).AddLogStreamReplica), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockManagement_ImportServer)(nil).Context))
 }
 
-// GetMetadata mocks base method.
-func (m *MockManagementServer) GetMetadata(arg0 context.Context, arg1 *snpb.GetMetadataRequest) (*snpb.GetMetadataResponse, error) {
+// Recv mocks base method.
+func (m *MockManagement_ImportServer) Recv() (*snpb.ImportRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMetadata", arg0, arg1)
-	ret0, _ := ret[0].(*snpb.GetMetadataResponse)
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*snpb.ImportRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMetadata indicates an expected call of GetMetadata.
-func (mr *MockManagementServerMockRecorder) GetMetadata(arg0, arg1 interface{}) *gomock.Call {
+// Recv indicates an expected call of Recv.
+func (mr *MockManagement_ImportServerMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockManagementServer)(nil).GetMetadata), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockManagement_ImportServer)(nil).Recv))
 }
 
-// RemoveLogStream mocks base method.
-func (m *MockManagementServer) RemoveLogStream(arg0 context.Context, arg1 *snpb.RemoveLogStreamRequest) (*types.Empty, error) {
+// RecvMsg mocks base method.
+func (m *MockManagement_ImportServer) RecvMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveLogStream", arg0, arg1)
-	ret0, _ := ret[0].(*types.Empty)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RemoveLogStream indicates an expected call of RemoveLogStream.
-func (mr *MockManagementServerMockRecorder) RemoveLogStream(arg0, arg1 interface{}) *gomock.Call {
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockManagement_ImportServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLogStream", reflect.TypeOf((*MockManagementServer)(nil).RemoveLogStream), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockManagement_ImportServer)(nil).RecvMsg), arg0)
 }
 
-// Seal mocks base method.
-func (m *MockManagementServer) Seal(arg0 context.Context, arg1 *snpb.SealRequest) (*snpb.SealResponse, error) {
+// SendAndClose mocks base method.
+func (m *MockManagement_ImportServer) SendAndClose(arg0 *snpb.ImportResponse) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Seal", arg0, arg1)
-	ret0, _ := ret[0].(*snpb.SealResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SendAndClose", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Seal indicates an expected call of Seal.
-func (mr *MockManagementServerMockRecorder) Seal(arg0, arg1 interface{}) *gomock.Call {
+// SendAndClose indicates an expected call of SendAndClose.
+func (mr *MockManagement_ImportServerMockRecorder) SendAndClose(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockManagementServer)(nil).Seal), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndClose", reflect.TypeOf((*MockManagement_ImportServer)(nil).SendAndClose), arg0)
 }
 
-// Sync mocks base method.
-func (m *MockManagementServer) Sync(arg0 context.Context, arg1 *snpb.SyncRequest) (*snpb.SyncResponse, error) {
+// SendHeader mocks base method.
+func (m *MockManagement_ImportServer) SendHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Sync", arg0, arg1)
-	ret0, _ := ret[0].(*snpb.SyncResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Sync indicates an expected call of Sync.
-func (mr *MockManagementServerMockRecorder) Sync(arg0, arg1 interface{}) *gomock.Call {
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockManagement_ImportServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockManagementServer)(nil).Sync), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockManagement_ImportServer)(nil).SendHeader), arg0)
 }
 
-// Trim mocks base method.
-func (m *MockManagementServer) Trim(arg0 context.Context, arg1 *snpb.TrimRequest) (*snpb.TrimResponse, error) {
+// SendMsg mocks base method.
+func (m *MockManagement_ImportServer) SendMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Trim", arg0, arg1)
-	ret0, _ := ret[0].(*snpb.TrimResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Trim indicates an expected call of Trim.
-func (mr *MockManagementServerMockRecorder) Trim(arg0, arg1 interface{}) *gomock.Call {
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockManagement_ImportServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trim", reflect.TypeOf((*MockManagementServer)(nil).Trim), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockManagement_ImportServer)(nil).SendMsg), arg0)
 }
 
-// Unseal mocks base method.
-func (m *MockManagementServer) Unseal(arg0 context.Context, arg1 *snpb.UnsealRequest) (*types.Empty, error) {
+// SetHeader mocks base method.
+func (m *MockManagement_ImportServer) SetHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Unseal", arg0, arg1)
-	ret0, _ := ret[0].(*types.Empty)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Unseal indicates an expected call of Unseal.
-func (mr *MockManagementServerMockRecorder) Unseal(arg0, arg1 interface{}) *gomock.Call {
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockManagement_ImportServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unseal", reflect.TypeOf((*MockManagementServer)(nil).Unseal), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockManagement_ImportServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockManagement_ImportServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockManagement_ImportServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockManagement_ImportServer)(nil).SetTrailer), arg0)
 }
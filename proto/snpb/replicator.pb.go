@@ -5,6 +5,7 @@ package snpb
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -71,6 +72,20 @@ type ReplicateRequest struct {
 	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 	LLSN        []github_com_kakao_varlog_pkg_types.LLSN      `protobuf:"varint,3,rep,packed,name=llsn,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn,omitempty"`
 	Data        [][]byte                                      `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	// headers are headers of log entries in the data. If it is not empty, its
+	// length should be the same as that of the data.
+	Headers []varlogpb.LogEntryHeaders `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers"`
+	// producer_id and sequence are those of snpb.AppendRequest. They are set
+	// only if the log entries are appended by an idempotent producer.
+	ProducerID string `protobuf:"bytes,6,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	Sequence   uint64 `protobuf:"varint,7,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// trace_context carries the W3C trace context of the append being
+	// replicated. It is empty if the append is not traced.
+	TraceContext map[string]string `protobuf:"bytes,8,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// checksums are CRC32C checksums of log entries in the data. The backup
+	// replica verifies them before writing the log entries. If it is empty,
+	// the backup replica computes them.
+	Checksums []uint32 `protobuf:"fixed32,9,rep,packed,name=checksums,proto3" json:"checksums,omitempty"`
 }
 
 func (m *ReplicateRequest) Reset()         { *m = ReplicateRequest{} }
@@ -134,6 +149,41 @@ func (m *ReplicateRequest) GetData() [][]byte {
 	return nil
 }
 
+func (m *ReplicateRequest) GetHeaders() []varlogpb.LogEntryHeaders {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *ReplicateRequest) GetProducerID() string {
+	if m != nil {
+		return m.ProducerID
+	}
+	return ""
+}
+
+func (m *ReplicateRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ReplicateRequest) GetTraceContext() map[string]string {
+	if m != nil {
+		return m.TraceContext
+	}
+	return nil
+}
+
+func (m *ReplicateRequest) GetChecksums() []uint32 {
+	if m != nil {
+		return m.Checksums
+	}
+	return nil
+}
+
 type ReplicateResponse struct {
 }
 
@@ -482,16 +532,82 @@ func (m *SyncStatus) GetCurrent() SyncPosition {
 	return SyncPosition{}
 }
 
+// SyncCommitTime is an entry of the time index in the source replica. It maps
+// the time when the source replica committed log entries to the range of their
+// GLSNs.
+type SyncCommitTime struct {
+	// UnixNano is the commit time in nanoseconds.
+	UnixNano  int64                                  `protobuf:"varint,1,opt,name=unix_nano,json=unixNano,proto3" json:"unix_nano,omitempty"`
+	GLSNBegin github_com_kakao_varlog_pkg_types.GLSN `protobuf:"varint,2,opt,name=glsn_begin,json=glsnBegin,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn_begin,omitempty"`
+	GLSNEnd   github_com_kakao_varlog_pkg_types.GLSN `protobuf:"varint,3,opt,name=glsn_end,json=glsnEnd,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn_end,omitempty"`
+}
+
+func (m *SyncCommitTime) Reset()         { *m = SyncCommitTime{} }
+func (m *SyncCommitTime) String() string { return proto.CompactTextString(m) }
+func (*SyncCommitTime) ProtoMessage()    {}
+func (*SyncCommitTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85705cb817486b63, []int{7}
+}
+func (m *SyncCommitTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncCommitTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncCommitTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncCommitTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncCommitTime.Merge(m, src)
+}
+func (m *SyncCommitTime) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SyncCommitTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncCommitTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncCommitTime proto.InternalMessageInfo
+
+func (m *SyncCommitTime) GetUnixNano() int64 {
+	if m != nil {
+		return m.UnixNano
+	}
+	return 0
+}
+
+func (m *SyncCommitTime) GetGLSNBegin() github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.GLSNBegin
+	}
+	return 0
+}
+
+func (m *SyncCommitTime) GetGLSNEnd() github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.GLSNEnd
+	}
+	return 0
+}
+
 type SyncPayload struct {
 	CommitContext *varlogpb.CommitContext `protobuf:"bytes,1,opt,name=commit_context,json=commitContext,proto3" json:"commit_context,omitempty"`
 	LogEntry      *varlogpb.LogEntry      `protobuf:"bytes,2,opt,name=log_entry,json=logEntry,proto3" json:"log_entry,omitempty"`
+	ProducerState *varlogpb.ProducerState `protobuf:"bytes,3,opt,name=producer_state,json=producerState,proto3" json:"producer_state,omitempty"`
+	CommitTime    *SyncCommitTime         `protobuf:"bytes,4,opt,name=commit_time,json=commitTime,proto3" json:"commit_time,omitempty"`
 }
 
 func (m *SyncPayload) Reset()         { *m = SyncPayload{} }
 func (m *SyncPayload) String() string { return proto.CompactTextString(m) }
 func (*SyncPayload) ProtoMessage()    {}
 func (*SyncPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85705cb817486b63, []int{7}
+	return fileDescriptor_85705cb817486b63, []int{8}
 }
 func (m *SyncPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,6 +650,20 @@ func (m *SyncPayload) GetLogEntry() *varlogpb.LogEntry {
 	return nil
 }
 
+func (m *SyncPayload) GetProducerState() *varlogpb.ProducerState {
+	if m != nil {
+		return m.ProducerState
+	}
+	return nil
+}
+
+func (m *SyncPayload) GetCommitTime() *SyncCommitTime {
+	if m != nil {
+		return m.CommitTime
+	}
+	return nil
+}
+
 type SyncReplicateRequest struct {
 	ClusterID   github_com_kakao_varlog_pkg_types.ClusterID `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"cluster_id,omitempty"`
 	Source      varlogpb.LogStreamReplica                   `protobuf:"bytes,2,opt,name=source,proto3" json:"source"`
@@ -545,7 +675,7 @@ func (m *SyncReplicateRequest) Reset()         { *m = SyncReplicateRequest{} }
 func (m *SyncReplicateRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncReplicateRequest) ProtoMessage()    {}
 func (*SyncReplicateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85705cb817486b63, []int{8}
+	return fileDescriptor_85705cb817486b63, []int{9}
 }
 func (m *SyncReplicateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,7 +740,7 @@ func (m *SyncReplicateResponse) Reset()         { *m = SyncReplicateResponse{} }
 func (m *SyncReplicateResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncReplicateResponse) ProtoMessage()    {}
 func (*SyncReplicateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85705cb817486b63, []int{9}
+	return fileDescriptor_85705cb817486b63, []int{10}
 }
 func (m *SyncReplicateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,12 +779,14 @@ func (m *SyncReplicateResponse) GetStatus() *SyncStatus {
 func init() {
 	proto.RegisterEnum("varlog.snpb.SyncState", SyncState_name, SyncState_value)
 	proto.RegisterType((*ReplicateRequest)(nil), "varlog.snpb.ReplicateRequest")
+	proto.RegisterMapType((map[string]string)(nil), "varlog.snpb.ReplicateRequest.TraceContextEntry")
 	proto.RegisterType((*ReplicateResponse)(nil), "varlog.snpb.ReplicateResponse")
 	proto.RegisterType((*SyncPosition)(nil), "varlog.snpb.SyncPosition")
 	proto.RegisterType((*SyncRange)(nil), "varlog.snpb.SyncRange")
 	proto.RegisterType((*SyncInitRequest)(nil), "varlog.snpb.SyncInitRequest")
 	proto.RegisterType((*SyncInitResponse)(nil), "varlog.snpb.SyncInitResponse")
 	proto.RegisterType((*SyncStatus)(nil), "varlog.snpb.SyncStatus")
+	proto.RegisterType((*SyncCommitTime)(nil), "varlog.snpb.SyncCommitTime")
 	proto.RegisterType((*SyncPayload)(nil), "varlog.snpb.SyncPayload")
 	proto.RegisterType((*SyncReplicateRequest)(nil), "varlog.snpb.SyncReplicateRequest")
 	proto.RegisterType((*SyncReplicateResponse)(nil), "varlog.snpb.SyncReplicateResponse")
@@ -663,70 +795,87 @@ func init() {
 func init() { proto.RegisterFile("proto/snpb/replicator.proto", fileDescriptor_85705cb817486b63) }
 
 var fileDescriptor_85705cb817486b63 = []byte{
-	// 1002 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x13, 0xa7, 0x4d, 0x5e, 0xda, 0x92, 0x4e, 0x59, 0x1a, 0x02, 0xb5, 0xb3, 0x46, 0x42,
-	0xe1, 0xc7, 0xc6, 0x52, 0x57, 0x2c, 0xcb, 0x6a, 0xa5, 0x85, 0x96, 0xb4, 0x44, 0x0a, 0x6d, 0x35,
-	0xae, 0x10, 0x82, 0x43, 0x71, 0x9d, 0x59, 0x63, 0xd5, 0xf1, 0x18, 0x7b, 0x82, 0xe8, 0x7f, 0x80,
-	0x7a, 0x42, 0xdc, 0x2b, 0x56, 0xa2, 0x42, 0x70, 0xe3, 0x08, 0xff, 0x41, 0x8f, 0x7b, 0xe4, 0x14,
-	0x89, 0xf4, 0xc2, 0xdf, 0xb0, 0x27, 0x34, 0xe3, 0xb1, 0x9b, 0x26, 0x5b, 0xda, 0x0a, 0x6e, 0xdc,
-	0xec, 0x79, 0xdf, 0xfb, 0xe6, 0xcd, 0xfb, 0xbe, 0x37, 0x36, 0xbc, 0x12, 0x46, 0x94, 0x51, 0x33,
-	0x0e, 0xc2, 0x7d, 0x33, 0x22, 0xa1, 0xef, 0x39, 0x36, 0xa3, 0x51, 0x4b, 0xac, 0xa2, 0xca, 0xd7,
-	0x76, 0xe4, 0x53, 0xb7, 0xc5, 0xa3, 0x75, 0xdd, 0xa5, 0xd4, 0xf5, 0x89, 0x29, 0x42, 0xfb, 0x83,
-	0xc7, 0x26, 0xf3, 0xfa, 0x24, 0x66, 0x76, 0x3f, 0x4c, 0xd0, 0xf5, 0x3b, 0xae, 0xc7, 0xbe, 0x1c,
-	0xec, 0xb7, 0x1c, 0xda, 0x37, 0x5d, 0xea, 0xd2, 0x73, 0x24, 0x7f, 0x4b, 0xf6, 0xe1, 0x4f, 0x12,
-	0xbe, 0x9c, 0x90, 0x87, 0xfb, 0x66, 0x9f, 0x30, 0xbb, 0x67, 0x33, 0x3b, 0x09, 0x18, 0xbf, 0xe4,
-	0xa1, 0x8a, 0x65, 0x29, 0x04, 0x93, 0xaf, 0x06, 0x24, 0x66, 0xc8, 0x82, 0x12, 0xa3, 0xa1, 0xe7,
-	0xec, 0x79, 0xbd, 0x9a, 0xd2, 0x50, 0x9a, 0xc5, 0xb5, 0xfb, 0xa3, 0xa1, 0x3e, 0xbb, 0xcb, 0xd7,
-	0x3a, 0x1f, 0x3e, 0x1b, 0xea, 0x6f, 0x8c, 0xed, 0x7e, 0x60, 0x1f, 0xd8, 0xd4, 0x4c, 0xf8, 0xcd,
-	0xf0, 0xc0, 0x35, 0xd9, 0x61, 0x48, 0xe2, 0x96, 0x04, 0xe3, 0x59, 0xc1, 0xd4, 0xe9, 0xa1, 0x1e,
-	0xcc, 0xfb, 0xd4, 0xdd, 0x8b, 0x59, 0x44, 0xec, 0x3e, 0x67, 0xce, 0x0b, 0xe6, 0xf7, 0x47, 0x43,
-	0xbd, 0xd2, 0xa5, 0xae, 0x25, 0xd6, 0x05, 0xfb, 0x9d, 0xab, 0xd9, 0xc7, 0x12, 0x70, 0xc5, 0xcf,
-	0x5e, 0x7a, 0x68, 0x03, 0x54, 0xdf, 0x8f, 0x83, 0x5a, 0xa1, 0x51, 0x68, 0xaa, 0x6b, 0xab, 0xa3,
-	0xa1, 0xae, 0x76, 0xbb, 0xd6, 0xd6, 0xb3, 0xa1, 0xfe, 0xfa, 0x35, 0x58, 0xbb, 0xd6, 0x16, 0x16,
-	0xf9, 0x08, 0x81, 0xca, 0xbb, 0x54, 0x53, 0x1b, 0x85, 0xe6, 0x1c, 0x16, 0xcf, 0xc6, 0x12, 0x2c,
-	0x8e, 0xb5, 0x2a, 0x0e, 0x69, 0x10, 0x13, 0xe3, 0x44, 0x81, 0x39, 0xeb, 0x30, 0x70, 0x76, 0x68,
-	0xec, 0x31, 0x8f, 0x06, 0x59, 0x05, 0xbc, 0x71, 0xff, 0xa6, 0x82, 0x0d, 0x50, 0x5d, 0xce, 0x93,
-	0x3f, 0xe7, 0xd9, 0xbc, 0x36, 0xcf, 0xa6, 0xe0, 0xe1, 0xf9, 0x0f, 0xd4, 0xbf, 0x9e, 0xe8, 0x8a,
-	0xf1, 0x9b, 0x02, 0x65, 0x5e, 0x26, 0xb6, 0x03, 0x97, 0xa0, 0x4f, 0x00, 0x1e, 0x7b, 0x51, 0xcc,
-	0xf6, 0xc6, 0x2a, 0x7d, 0x77, 0x34, 0xd4, 0xcb, 0x1b, 0x7c, 0xf5, 0x86, 0xe5, 0x96, 0x05, 0x55,
-	0x97, 0xd7, 0x6c, 0x41, 0xd9, 0xb7, 0x53, 0xda, 0xa4, 0xf0, 0x7b, 0xa3, 0xa1, 0x5e, 0xea, 0xda,
-	0x37, 0x66, 0x2d, 0x71, 0x22, 0x4e, 0x6a, 0xfc, 0x50, 0x80, 0x17, 0x78, 0xe9, 0x9d, 0xc0, 0x63,
-	0xa9, 0x43, 0x3f, 0x07, 0x70, 0xfc, 0x41, 0xcc, 0x48, 0x94, 0x7a, 0x74, 0x7e, 0xed, 0x21, 0x3f,
-	0xc0, 0x7a, 0xb2, 0x2a, 0x7c, 0xf4, 0xd6, 0xd5, 0x5b, 0x65, 0x70, 0x5c, 0x96, 0x7c, 0x9d, 0x1e,
-	0x7a, 0x04, 0x33, 0x31, 0x1d, 0x44, 0x0e, 0x11, 0x47, 0xa8, 0xac, 0xde, 0x6e, 0xc9, 0xd1, 0x4c,
-	0x87, 0xe8, 0xdc, 0x7e, 0xd2, 0x0f, 0x6b, 0xea, 0xe9, 0x50, 0xcf, 0x61, 0x99, 0x86, 0x3a, 0x50,
-	0xe9, 0x91, 0x98, 0x79, 0x81, 0xcd, 0x1d, 0x51, 0x2b, 0xdc, 0x8c, 0x65, 0x3c, 0x17, 0xad, 0x42,
-	0x31, 0xe2, 0x92, 0xd5, 0x54, 0x41, 0xf2, 0x52, 0x6b, 0xec, 0x96, 0x68, 0x65, 0x82, 0xca, 0xcc,
-	0x04, 0x8a, 0x28, 0x2c, 0x09, 0x15, 0x1c, 0xda, 0xef, 0x7b, 0x8c, 0x91, 0x5e, 0xa2, 0x47, 0x51,
-	0xe8, 0xf1, 0x68, 0x34, 0xd4, 0x17, 0xb9, 0x1e, 0xeb, 0x69, 0xf4, 0x86, 0xc2, 0x2c, 0xfa, 0x17,
-	0x92, 0xb9, 0x42, 0x1b, 0x50, 0x3d, 0x17, 0x28, 0x99, 0x8b, 0xf3, 0xc2, 0x95, 0x6b, 0x17, 0x6e,
-	0xfc, 0xa9, 0x00, 0xf0, 0x90, 0xc5, 0x6c, 0x36, 0x88, 0xd1, 0xdb, 0x50, 0x8c, 0x99, 0xcd, 0x12,
-	0x8a, 0x85, 0xe7, 0x50, 0x70, 0x1c, 0xc1, 0x09, 0x08, 0xbd, 0x03, 0x45, 0x61, 0x44, 0x29, 0xda,
-	0xcb, 0x53, 0xe8, 0x74, 0x42, 0xd3, 0x3d, 0x05, 0x1a, 0xdd, 0x05, 0x95, 0x1f, 0x48, 0x8a, 0x74,
-	0x65, 0x96, 0x00, 0xa3, 0xf7, 0x60, 0xd6, 0x19, 0x44, 0x11, 0x09, 0x98, 0xd4, 0xe5, 0xca, 0xbc,
-	0x14, 0x6f, 0x7c, 0xaf, 0x40, 0x45, 0xc4, 0xed, 0x43, 0x9f, 0xda, 0x3d, 0xd4, 0x86, 0x85, 0x44,
-	0xa7, 0x3d, 0x87, 0x06, 0x8c, 0x7c, 0xc3, 0x64, 0xc3, 0xb4, 0x29, 0xbb, 0x24, 0x3d, 0x5f, 0x4f,
-	0x50, 0x78, 0xde, 0x19, 0x7f, 0x45, 0xf7, 0xa0, 0xcc, 0x6f, 0x57, 0x12, 0xb0, 0xe8, 0x70, 0xb2,
-	0x03, 0xe3, 0x86, 0x6b, 0x73, 0x00, 0x2e, 0xf9, 0xf2, 0xe9, 0x81, 0x7a, 0xca, 0x6f, 0x87, 0xdf,
-	0xf3, 0xf0, 0xa2, 0xd0, 0x64, 0xf2, 0x4b, 0xf0, 0xbf, 0x99, 0xb3, 0xfb, 0x30, 0x1b, 0x26, 0x8a,
-	0x48, 0x45, 0x6b, 0xd3, 0x8a, 0x26, 0xf1, 0x54, 0x50, 0x09, 0x37, 0x3e, 0x82, 0x5b, 0x13, 0xad,
-	0x93, 0x13, 0x60, 0xc2, 0x4c, 0x2c, 0x8c, 0x2c, 0x15, 0x5d, 0x7e, 0xae, 0x7f, 0x07, 0x31, 0x96,
-	0xb0, 0x37, 0x7f, 0x92, 0x77, 0xb4, 0xb0, 0x35, 0x5a, 0x81, 0x62, 0x1b, 0xe3, 0x6d, 0x5c, 0xcd,
-	0xd5, 0xd1, 0xd1, 0x71, 0x63, 0x21, 0x8b, 0xb4, 0xa3, 0x88, 0x46, 0xa8, 0x09, 0x95, 0xce, 0xd6,
-	0xde, 0x0e, 0xde, 0xde, 0xc4, 0x6d, 0xcb, 0xaa, 0x2a, 0xf5, 0xe5, 0xa3, 0xe3, 0xc6, 0x52, 0x06,
-	0xea, 0x04, 0x3b, 0x11, 0x75, 0x23, 0x12, 0xc7, 0xe8, 0x35, 0x28, 0xad, 0x6f, 0x7f, 0xbc, 0xd3,
-	0x6d, 0xef, 0xb6, 0xab, 0xf9, 0xfa, 0xad, 0xa3, 0xe3, 0xc6, 0x62, 0x06, 0x5b, 0xa7, 0xfd, 0xd0,
-	0x27, 0xc9, 0x6e, 0xd6, 0xee, 0x07, 0x78, 0xb7, 0x5a, 0x98, 0xd8, 0xcd, 0x62, 0x76, 0xc4, 0xea,
-	0x73, 0xdf, 0xfe, 0xa8, 0xe5, 0x7e, 0x3e, 0xd1, 0x72, 0xbf, 0x9e, 0x68, 0xca, 0xea, 0x59, 0x1e,
-	0x00, 0x67, 0xff, 0x2f, 0x68, 0x0b, 0xca, 0xd9, 0xe9, 0xd1, 0xca, 0x85, 0x53, 0x4e, 0x1a, 0xaa,
-	0xae, 0x5d, 0x16, 0x96, 0x9f, 0xd3, 0x5c, 0x53, 0x41, 0x1d, 0x28, 0xa5, 0xd7, 0x09, 0x7a, 0x75,
-	0xaa, 0x69, 0x63, 0x9f, 0x81, 0xfa, 0xca, 0x25, 0xd1, 0x94, 0x0c, 0x7d, 0x0a, 0xf3, 0x17, 0xc4,
-	0x41, 0xb7, 0xa7, 0xef, 0xa1, 0xc9, 0x12, 0x8d, 0x7f, 0x82, 0x64, 0xcc, 0x5f, 0xc0, 0xd2, 0x85,
-	0x50, 0xe2, 0xb0, 0xff, 0x8c, 0xbf, 0xa9, 0xac, 0x3d, 0x3c, 0x1d, 0x69, 0xca, 0xd3, 0x91, 0xa6,
-	0x7c, 0x77, 0xa6, 0xe5, 0x9e, 0x9c, 0x69, 0xca, 0xd3, 0x33, 0x2d, 0xf7, 0xc7, 0x99, 0x96, 0xfb,
-	0xcc, 0xb8, 0x74, 0xe0, 0xb2, 0xff, 0xcb, 0xfd, 0x19, 0xf1, 0x7c, 0xf7, 0xef, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xf2, 0xb0, 0x60, 0xe6, 0x74, 0x0a, 0x00, 0x00,
+	// 1273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0xeb, 0xd8, 0xfb, 0x9c, 0x04, 0x67, 0xd2, 0x52, 0xe3, 0xb6, 0xb6, 0x6b, 0x24,
+	0x64, 0xfe, 0xd4, 0x96, 0x52, 0xb5, 0x94, 0xaa, 0x52, 0x8b, 0x53, 0xa7, 0xb5, 0x64, 0xd2, 0x68,
+	0x6c, 0x21, 0x04, 0x07, 0x33, 0xd9, 0x9d, 0x6e, 0x57, 0x59, 0xef, 0x2c, 0xbb, 0xe3, 0xaa, 0xf9,
+	0x06, 0xa8, 0x27, 0x6e, 0x9c, 0x2a, 0x2a, 0x51, 0x21, 0x8e, 0x1c, 0xe1, 0x1b, 0xf4, 0xd8, 0x1b,
+	0x9c, 0x2c, 0xe1, 0x5c, 0xb8, 0xf0, 0x05, 0x7a, 0x42, 0x33, 0xb3, 0xbb, 0x71, 0xe2, 0xa4, 0x4d,
+	0x04, 0x37, 0x6e, 0x33, 0xf3, 0x7e, 0xef, 0x37, 0x6f, 0xe6, 0xf7, 0xde, 0x9b, 0x5d, 0x38, 0xef,
+	0x07, 0x8c, 0xb3, 0x56, 0xe8, 0xf9, 0xdb, 0xad, 0x80, 0xfa, 0xae, 0x63, 0x12, 0xce, 0x82, 0xa6,
+	0x5c, 0x45, 0x85, 0x47, 0x24, 0x70, 0x99, 0xdd, 0x14, 0xd6, 0x72, 0xd5, 0x66, 0xcc, 0x76, 0x69,
+	0x4b, 0x9a, 0xb6, 0xc7, 0x0f, 0x5a, 0xdc, 0x19, 0xd1, 0x90, 0x93, 0x91, 0xaf, 0xd0, 0xe5, 0xcb,
+	0xb6, 0xc3, 0x1f, 0x8e, 0xb7, 0x9b, 0x26, 0x1b, 0xb5, 0x6c, 0x66, 0xb3, 0x7d, 0xa4, 0x98, 0xa9,
+	0x7d, 0xc4, 0x28, 0x82, 0x9f, 0x53, 0xe4, 0xfe, 0x76, 0x6b, 0x44, 0x39, 0xb1, 0x08, 0x27, 0xca,
+	0x50, 0xff, 0x5b, 0x87, 0x22, 0x8e, 0x42, 0xa1, 0x98, 0x7e, 0x33, 0xa6, 0x21, 0x47, 0x7d, 0xc8,
+	0x73, 0xe6, 0x3b, 0xe6, 0xd0, 0xb1, 0x4a, 0x5a, 0x4d, 0x6b, 0x64, 0xdb, 0xd7, 0xa7, 0x93, 0x6a,
+	0x6e, 0x20, 0xd6, 0xba, 0x77, 0x5e, 0x4d, 0xaa, 0xef, 0xcf, 0xec, 0xbe, 0x43, 0x76, 0x08, 0x6b,
+	0x29, 0xfe, 0x96, 0xbf, 0x63, 0xb7, 0xf8, 0xae, 0x4f, 0xc3, 0x66, 0x04, 0xc6, 0x39, 0xc9, 0xd4,
+	0xb5, 0x90, 0x05, 0x4b, 0x2e, 0xb3, 0x87, 0x21, 0x0f, 0x28, 0x19, 0x09, 0xe6, 0xb4, 0x64, 0xbe,
+	0x3d, 0x9d, 0x54, 0x0b, 0x3d, 0x66, 0xf7, 0xe5, 0xba, 0x64, 0xbf, 0xfc, 0x66, 0xf6, 0x19, 0x07,
+	0x5c, 0x70, 0x93, 0x89, 0x85, 0x36, 0x40, 0x77, 0xdd, 0xd0, 0x2b, 0x65, 0x6a, 0x99, 0x86, 0xde,
+	0x5e, 0x9b, 0x4e, 0xaa, 0x7a, 0xaf, 0xd7, 0xdf, 0x7c, 0x35, 0xa9, 0xbe, 0x77, 0x02, 0xd6, 0x5e,
+	0x7f, 0x13, 0x4b, 0x7f, 0x84, 0x40, 0x17, 0xb7, 0x54, 0xd2, 0x6b, 0x99, 0xc6, 0x22, 0x96, 0x63,
+	0x74, 0x1b, 0x72, 0x0f, 0x29, 0xb1, 0x68, 0x10, 0x96, 0xb2, 0xb5, 0x4c, 0xa3, 0xb0, 0x56, 0x6b,
+	0x46, 0x9a, 0xc5, 0xb7, 0x2b, 0xe2, 0xea, 0x78, 0x3c, 0xd8, 0xbd, 0xa7, 0x70, 0x6d, 0xfd, 0xc5,
+	0xa4, 0x9a, 0xc2, 0xb1, 0x1b, 0x6a, 0x41, 0xc1, 0x0f, 0x98, 0x35, 0x36, 0x69, 0x20, 0x6e, 0x60,
+	0xa1, 0xa6, 0x35, 0x8c, 0xf6, 0xf2, 0x74, 0x52, 0x85, 0xad, 0x68, 0xb9, 0x7b, 0x07, 0x43, 0x0c,
+	0xe9, 0x5a, 0xa8, 0x0c, 0xf9, 0x50, 0x88, 0xe2, 0x99, 0xb4, 0x94, 0xab, 0x69, 0x0d, 0x1d, 0x27,
+	0x73, 0x34, 0x80, 0x25, 0x1e, 0x10, 0x93, 0x0e, 0x4d, 0xe6, 0x71, 0xfa, 0x98, 0x97, 0xf2, 0x32,
+	0xa8, 0x56, 0x73, 0x26, 0x91, 0x9a, 0x87, 0xb5, 0x6d, 0x0e, 0x84, 0xcb, 0xba, 0xf2, 0x90, 0xa1,
+	0xe2, 0x45, 0x3e, 0xb3, 0x84, 0x2e, 0x80, 0x61, 0x3e, 0xa4, 0xe6, 0x4e, 0x38, 0x1e, 0x85, 0x25,
+	0xa3, 0x96, 0x69, 0xe4, 0xf0, 0xfe, 0x42, 0xf9, 0x16, 0xac, 0xcc, 0x11, 0xa0, 0x22, 0x64, 0x76,
+	0xe8, 0xae, 0xcc, 0x14, 0x03, 0x8b, 0x21, 0x3a, 0x03, 0xd9, 0x47, 0xc4, 0x1d, 0x53, 0xa9, 0xb1,
+	0x81, 0xd5, 0xe4, 0x46, 0xfa, 0xba, 0x56, 0x5f, 0x85, 0x95, 0x99, 0x90, 0x42, 0x9f, 0x79, 0x21,
+	0xad, 0x3f, 0xd7, 0x60, 0xb1, 0xbf, 0xeb, 0x99, 0x5b, 0x2c, 0x74, 0xb8, 0xc3, 0xbc, 0x44, 0x45,
+	0x41, 0xf9, 0x6f, 0x54, 0xdc, 0x00, 0xdd, 0x16, 0x3c, 0xe9, 0x7d, 0x9e, 0xbb, 0x27, 0xe6, 0xb9,
+	0x2b, 0x79, 0x84, 0xff, 0x0d, 0xfd, 0xaf, 0x67, 0x55, 0xad, 0xfe, 0xab, 0x06, 0x86, 0x08, 0x13,
+	0x13, 0xcf, 0xa6, 0xe8, 0x73, 0x80, 0x07, 0x4e, 0x10, 0xf2, 0xe1, 0x4c, 0xa4, 0x1f, 0x4f, 0x27,
+	0x55, 0x63, 0x43, 0xac, 0x9e, 0x32, 0x5c, 0x43, 0x52, 0xf5, 0x44, 0xcc, 0x7d, 0x30, 0x5c, 0x12,
+	0xd3, 0xaa, 0xc0, 0xaf, 0x4d, 0x27, 0xd5, 0x7c, 0x8f, 0x9c, 0x9a, 0x35, 0xef, 0x12, 0x45, 0x5a,
+	0xff, 0x21, 0x03, 0x6f, 0x89, 0xd0, 0xbb, 0x9e, 0xc3, 0xe3, 0x2a, 0xff, 0x0a, 0xc0, 0x74, 0xc7,
+	0x21, 0x57, 0xb9, 0x28, 0x0e, 0xb0, 0xd4, 0xbe, 0x29, 0x0e, 0xb0, 0xae, 0x56, 0x65, 0x2d, 0x7e,
+	0xf8, 0xe6, 0xad, 0x12, 0x38, 0x36, 0x22, 0xbe, 0xae, 0x85, 0x6e, 0xc1, 0x42, 0xc8, 0xc6, 0x81,
+	0xa9, 0x52, 0xa0, 0xb0, 0x76, 0xe9, 0xa8, 0x52, 0x51, 0x55, 0x1b, 0xe5, 0x43, 0x54, 0x2b, 0x91,
+	0x1b, 0xea, 0x42, 0xc1, 0xa2, 0x21, 0x77, 0x3c, 0x22, 0x32, 0xa2, 0x94, 0x39, 0x1d, 0xcb, 0xac,
+	0x2f, 0x5a, 0x83, 0x6c, 0x20, 0x24, 0x2b, 0xe9, 0x92, 0xe4, 0xed, 0x03, 0x05, 0x92, 0x08, 0x1a,
+	0x79, 0x2a, 0x28, 0x62, 0xb0, 0x2a, 0x55, 0x30, 0xd9, 0x68, 0xe4, 0x70, 0x4e, 0x2d, 0xa5, 0x47,
+	0x56, 0xea, 0x71, 0x6b, 0x3a, 0xa9, 0xae, 0x08, 0x3d, 0xd6, 0x63, 0xeb, 0x29, 0x85, 0x59, 0x71,
+	0x0f, 0x38, 0x0b, 0x85, 0x36, 0xa0, 0xb8, 0x2f, 0x90, 0xaa, 0x8b, 0xfd, 0xc0, 0xb5, 0x13, 0x07,
+	0x5e, 0xff, 0x53, 0x03, 0x10, 0xa6, 0x3e, 0x27, 0x7c, 0x1c, 0xa2, 0x8f, 0x20, 0x1b, 0x72, 0xc2,
+	0x15, 0xc5, 0xf2, 0x11, 0x14, 0x02, 0x47, 0xb1, 0x02, 0xa1, 0xab, 0x90, 0x95, 0x89, 0x18, 0x89,
+	0xf6, 0xce, 0x1c, 0x3a, 0xae, 0xd0, 0x78, 0x4f, 0x89, 0x46, 0x57, 0x40, 0x17, 0x07, 0x2a, 0x65,
+	0x4e, 0xe6, 0x25, 0xc1, 0xe8, 0x13, 0xc8, 0x99, 0xe3, 0x20, 0xa0, 0x1e, 0x2f, 0xe9, 0x27, 0xf3,
+	0x8b, 0xf1, 0xf5, 0xdf, 0x35, 0x58, 0x16, 0x76, 0x75, 0x83, 0x03, 0x67, 0x44, 0xd1, 0x79, 0x30,
+	0xc6, 0x9e, 0xf3, 0x78, 0xe8, 0x11, 0x8f, 0xc9, 0xb3, 0x66, 0x70, 0x5e, 0x2c, 0x6c, 0x12, 0x8f,
+	0x89, 0x52, 0x15, 0x65, 0x3c, 0xdc, 0xa6, 0xb6, 0x13, 0xd7, 0x94, 0x2c, 0x55, 0x51, 0xe2, 0x6d,
+	0xb1, 0x78, 0x8a, 0x8e, 0x60, 0x08, 0x2a, 0xe9, 0x84, 0xb6, 0x20, 0x2f, 0x79, 0xa9, 0x67, 0xc9,
+	0xb3, 0xeb, 0xed, 0xab, 0xe2, 0x9d, 0x14, 0xb0, 0x8e, 0x67, 0x9d, 0x82, 0x33, 0x27, 0x68, 0x3a,
+	0x9e, 0x55, 0xff, 0x3e, 0x0d, 0x05, 0x79, 0x72, 0xb2, 0xeb, 0x32, 0x62, 0xa1, 0x0e, 0x2c, 0xab,
+	0x0c, 0x4c, 0x9a, 0xbc, 0x4a, 0x85, 0xca, 0x5c, 0x21, 0xa8, 0xbb, 0x88, 0xfa, 0x32, 0x5e, 0x32,
+	0x67, 0xa7, 0xe8, 0x1a, 0x18, 0xe2, 0xed, 0xa5, 0xa2, 0x5d, 0x1f, 0xd6, 0x76, 0xee, 0xed, 0xc2,
+	0x79, 0x37, 0x1a, 0x89, 0xed, 0x93, 0xf7, 0x4a, 0xa5, 0x51, 0xe6, 0x98, 0xed, 0xe3, 0xf7, 0x4b,
+	0xa5, 0xd3, 0x92, 0x3f, 0x3b, 0x45, 0x37, 0xa1, 0x10, 0x9d, 0x42, 0x7c, 0xc6, 0x44, 0x72, 0x9f,
+	0x9f, 0x93, 0x7b, 0x5f, 0x4e, 0x0c, 0x66, 0x32, 0xbe, 0xa1, 0xbf, 0x10, 0xcd, 0xf7, 0xb7, 0x34,
+	0x9c, 0x91, 0x29, 0x7f, 0xf8, 0x63, 0xe5, 0x7f, 0xd3, 0xc6, 0xae, 0x43, 0xce, 0x57, 0x69, 0x11,
+	0xdd, 0x60, 0x69, 0xbe, 0x60, 0x94, 0x3d, 0xae, 0x97, 0x08, 0x5e, 0xbf, 0x07, 0x67, 0x0f, 0x5d,
+	0x5d, 0xd4, 0x60, 0x5a, 0xb0, 0x10, 0xca, 0x3e, 0x11, 0xa5, 0xd5, 0xb9, 0x23, 0xdb, 0xc3, 0x38,
+	0xc4, 0x11, 0xec, 0x83, 0x9f, 0xa2, 0x27, 0x50, 0xe9, 0x7a, 0x11, 0xb2, 0x1d, 0x8c, 0xef, 0xe3,
+	0x62, 0xaa, 0x8c, 0x9e, 0x3c, 0xad, 0x2d, 0x27, 0x96, 0x4e, 0x10, 0xb0, 0x00, 0x35, 0xa0, 0xd0,
+	0xdd, 0x1c, 0x6e, 0xe1, 0xfb, 0x77, 0x71, 0xa7, 0xdf, 0x2f, 0x6a, 0xe5, 0x73, 0x4f, 0x9e, 0xd6,
+	0x56, 0x13, 0x50, 0xd7, 0xdb, 0x0a, 0x98, 0x1d, 0xd0, 0x30, 0x44, 0xef, 0x42, 0x7e, 0xfd, 0xfe,
+	0x67, 0x5b, 0xbd, 0xce, 0xa0, 0x53, 0x4c, 0x97, 0xcf, 0x3e, 0x79, 0x5a, 0x5b, 0x49, 0x60, 0xeb,
+	0x6c, 0xe4, 0xbb, 0x54, 0xed, 0xd6, 0x1f, 0x7c, 0x8a, 0x07, 0xc5, 0xcc, 0xa1, 0xdd, 0xfa, 0x9c,
+	0x04, 0xbc, 0xbc, 0xf8, 0xed, 0x8f, 0x95, 0xd4, 0xcf, 0xcf, 0x2b, 0xa9, 0x5f, 0x9e, 0x57, 0xb4,
+	0xb5, 0xbd, 0x34, 0x00, 0x4e, 0x3e, 0xb1, 0xd1, 0x26, 0x18, 0xf1, 0x8c, 0xa2, 0x8b, 0xaf, 0xfd,
+	0x42, 0x2a, 0x57, 0x8e, 0x33, 0x47, 0x5f, 0x2b, 0xa9, 0x86, 0x86, 0xba, 0x90, 0x8f, 0xbb, 0x35,
+	0xba, 0x30, 0x77, 0x69, 0x33, 0xaf, 0x6c, 0xf9, 0xe2, 0x31, 0xd6, 0x98, 0x0c, 0x7d, 0x01, 0x4b,
+	0x07, 0xc4, 0x41, 0x97, 0xe6, 0x3c, 0xe6, 0x42, 0xac, 0xbf, 0x0e, 0x92, 0x30, 0x7f, 0x0d, 0xab,
+	0x07, 0x4c, 0x2a, 0xc3, 0xfe, 0x33, 0xfe, 0x86, 0xd6, 0xbe, 0xf9, 0x62, 0x5a, 0xd1, 0x5e, 0x4e,
+	0x2b, 0xda, 0x77, 0x7b, 0x95, 0xd4, 0xb3, 0xbd, 0x8a, 0xf6, 0x72, 0xaf, 0x92, 0xfa, 0x63, 0xaf,
+	0x92, 0xfa, 0xb2, 0x7e, 0x6c, 0xc1, 0x25, 0xbf, 0x40, 0xdb, 0x0b, 0x72, 0x7c, 0xe5, 0x9f, 0x01,
+	0x00, 0x88, 0x40, 0x47, 0x8f, 0x17, 0x0d, 0x00, 0x00,
 }
 
 func (x SyncState) String() string {
@@ -1133,6 +1282,60 @@ func (m *ReplicateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Checksums) > 0 {
+		for iNdEx := len(m.Checksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.Checksums[iNdEx]))
+		}
+		i = encodeVarintReplicator(dAtA, i, uint64(len(m.Checksums)*4))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.TraceContext) > 0 {
+		for k := range m.TraceContext {
+			v := m.TraceContext[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintReplicator(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintReplicator(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintReplicator(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintReplicator(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ProducerID) > 0 {
+		i -= len(m.ProducerID)
+		copy(dAtA[i:], m.ProducerID)
+		i = encodeVarintReplicator(dAtA, i, uint64(len(m.ProducerID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplicator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Data) > 0 {
 		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Data[iNdEx])
@@ -1416,6 +1619,44 @@ func (m *SyncStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncCommitTime) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncCommitTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncCommitTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GLSNEnd != 0 {
+		i = encodeVarintReplicator(dAtA, i, uint64(m.GLSNEnd))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GLSNBegin != 0 {
+		i = encodeVarintReplicator(dAtA, i, uint64(m.GLSNBegin))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UnixNano != 0 {
+		i = encodeVarintReplicator(dAtA, i, uint64(m.UnixNano))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncPayload) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1436,6 +1677,30 @@ func (m *SyncPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommitTime != nil {
+		{
+			size, err := m.CommitTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplicator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProducerState != nil {
+		{
+			size, err := m.ProducerState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplicator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.LogEntry != nil {
 		{
 			size, err := m.LogEntry.MarshalToSizedBuffer(dAtA[:i])
@@ -1592,6 +1857,30 @@ func (m *ReplicateRequest) ProtoSize() (n int) {
 			n += 1 + l + sovReplicator(uint64(l))
 		}
 	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.ProtoSize()
+			n += 1 + l + sovReplicator(uint64(l))
+		}
+	}
+	l = len(m.ProducerID)
+	if l > 0 {
+		n += 1 + l + sovReplicator(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovReplicator(uint64(m.Sequence))
+	}
+	if len(m.TraceContext) > 0 {
+		for k, v := range m.TraceContext {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovReplicator(uint64(len(k))) + 1 + len(v) + sovReplicator(uint64(len(v)))
+			n += mapEntrySize + 1 + sovReplicator(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Checksums) > 0 {
+		n += 1 + sovReplicator(uint64(len(m.Checksums)*4)) + len(m.Checksums)*4
+	}
 	return n
 }
 
@@ -1684,6 +1973,24 @@ func (m *SyncStatus) ProtoSize() (n int) {
 	return n
 }
 
+func (m *SyncCommitTime) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnixNano != 0 {
+		n += 1 + sovReplicator(uint64(m.UnixNano))
+	}
+	if m.GLSNBegin != 0 {
+		n += 1 + sovReplicator(uint64(m.GLSNBegin))
+	}
+	if m.GLSNEnd != 0 {
+		n += 1 + sovReplicator(uint64(m.GLSNEnd))
+	}
+	return n
+}
+
 func (m *SyncPayload) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -1698,6 +2005,14 @@ func (m *SyncPayload) ProtoSize() (n int) {
 		l = m.LogEntry.ProtoSize()
 		n += 1 + l + sovReplicator(uint64(l))
 	}
+	if m.ProducerState != nil {
+		l = m.ProducerState.ProtoSize()
+		n += 1 + l + sovReplicator(uint64(l))
+	}
+	if m.CommitTime != nil {
+		l = m.CommitTime.ProtoSize()
+		n += 1 + l + sovReplicator(uint64(l))
+	}
 	return n
 }
 
@@ -1745,6 +2060,12 @@ func (this *SyncPayload) GetValue() interface{} {
 	if this.LogEntry != nil {
 		return this.LogEntry
 	}
+	if this.ProducerState != nil {
+		return this.ProducerState
+	}
+	if this.CommitTime != nil {
+		return this.CommitTime
+	}
 	return nil
 }
 
@@ -1754,6 +2075,10 @@ func (this *SyncPayload) SetValue(value interface{}) bool {
 		this.CommitContext = vt
 	case *varlogpb.LogEntry:
 		this.LogEntry = vt
+	case *varlogpb.ProducerState:
+		this.ProducerState = vt
+	case *SyncCommitTime:
+		this.CommitTime = vt
 	default:
 		return false
 	}
@@ -1934,6 +2259,270 @@ func (m *ReplicateRequest) Unmarshal(dAtA []byte) error {
 			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
 			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, varlogpb.LogEntryHeaders{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProducerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceContext == nil {
+				m.TraceContext = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReplicator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReplicator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthReplicator
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthReplicator
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowReplicator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthReplicator
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthReplicator
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipReplicator(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthReplicator
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TraceContext[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.Checksums = append(m.Checksums, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowReplicator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthReplicator
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthReplicator
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.Checksums) == 0 {
+					m.Checksums = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.Checksums = append(m.Checksums, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksums", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplicator(dAtA[iNdEx:])
@@ -2619,6 +3208,113 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncCommitTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplicator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncCommitTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncCommitTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixNano", wireType)
+			}
+			m.UnixNano = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnixNano |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSNBegin", wireType)
+			}
+			m.GLSNBegin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GLSNBegin |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSNEnd", wireType)
+			}
+			m.GLSNEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GLSNEnd |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplicator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SyncPayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2720,6 +3416,78 @@ func (m *SyncPayload) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProducerState == nil {
+				m.ProducerState = &varlogpb.ProducerState{}
+			}
+			if err := m.ProducerState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitTime == nil {
+				m.CommitTime = &SyncCommitTime{}
+			}
+			if err := m.CommitTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplicator(dAtA[iNdEx:])
@@ -1,5 +1,9 @@
 package varlogpb
 
+import "hash/crc32"
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
 func InvalidLogEntryMeta() LogEntryMeta {
 	return LogEntryMeta{}
 }
@@ -11,3 +15,15 @@ func InvalidLogEntry() LogEntry {
 func (le LogEntry) Invalid() bool {
 	return le.GLSN.Invalid() && le.LLSN.Invalid() && len(le.Data) == 0
 }
+
+// Checksum returns the CRC32C checksum of the data of a log entry.
+func Checksum(data []byte) uint32 {
+	return crc32.Checksum(data, crc32cTable)
+}
+
+// UpdateChecksum returns the CRC32C checksum of the data appended to the
+// bytes whose checksum is the argument crc. It is useful to compute a
+// checksum over bytes split into several slices.
+func UpdateChecksum(crc uint32, data []byte) uint32 {
+	return crc32.Update(crc, crc32cTable, data)
+}
@@ -544,6 +544,12 @@ func (t *TopicDescriptor) HasLogStream(lsID types.LogStreamID) bool {
 	return match
 }
 
+// Empty returns true if the retention policy has no limit. A nil retention
+// policy is empty.
+func (rp *RetentionPolicy) Empty() bool {
+	return rp == nil || (rp.MaxAge <= 0 && rp.MaxBytes == 0 && rp.MaxEntries == 0)
+}
+
 func (lsn LogSequenceNumber) Invalid() bool {
 	return lsn.LLSN.Invalid() || lsn.GLSN.Invalid()
 }
@@ -5,6 +5,7 @@ package varlogpb
 
 import (
 	bytes "bytes"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -13,6 +14,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 
@@ -195,6 +197,10 @@ type StorageNodeDescriptor struct {
 	Status      StorageNodeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=varlog.varlogpb.StorageNodeStatus" json:"status,omitempty"`
 	Paths       []string          `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
 	CreateTime  time.Time         `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3,stdtime" json:"createTime"`
+	// Tags are the labels of the storage node given by its operator, for
+	// instance, the zone where the storage node runs. Clients can use them to
+	// choose log streams.
+	Tags map[string]string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *StorageNodeDescriptor) Reset()         { *m = StorageNodeDescriptor{} }
@@ -251,6 +257,13 @@ func (m *StorageNodeDescriptor) GetCreateTime() time.Time {
 	return time.Time{}
 }
 
+func (m *StorageNodeDescriptor) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type StorageDescriptor struct {
 	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	Used  uint64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
@@ -451,9 +464,10 @@ func (m *ReplicaDescriptor) GetDataPath() string {
 // TopicDescriptor is metadata to persist information of a topic in the metadata
 // repository.
 type TopicDescriptor struct {
-	TopicID    github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId"`
-	Status     TopicStatus                                     `protobuf:"varint,2,opt,name=status,proto3,enum=varlog.varlogpb.TopicStatus" json:"status"`
-	LogStreams []github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,3,rep,packed,name=log_streams,json=logStreams,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"logStreams,omitempty"`
+	TopicID         github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId"`
+	Status          TopicStatus                                     `protobuf:"varint,2,opt,name=status,proto3,enum=varlog.varlogpb.TopicStatus" json:"status"`
+	LogStreams      []github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,3,rep,packed,name=log_streams,json=logStreams,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"logStreams,omitempty"`
+	RetentionPolicy *RetentionPolicy                                `protobuf:"bytes,4,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retentionPolicy,omitempty"`
 }
 
 func (m *TopicDescriptor) Reset()         { *m = TopicDescriptor{} }
@@ -510,6 +524,85 @@ func (m *TopicDescriptor) GetLogStreams() []github_com_kakao_varlog_pkg_types.Lo
 	return nil
 }
 
+func (m *TopicDescriptor) GetRetentionPolicy() *RetentionPolicy {
+	if m != nil {
+		return m.RetentionPolicy
+	}
+	return nil
+}
+
+// RetentionPolicy makes the admin server trim log entries of a topic
+// automatically. Each limit applies to each log stream in the topic, and the
+// zero value of a limit means no limit. All log streams in the topic are
+// trimmed at the same GLSN so that subscribers can read the topic from any
+// GLSN not trimmed, thus, a log stream may keep more than its limits.
+type RetentionPolicy struct {
+	// max_age is the age of log entries to be kept, which is measured from
+	// their commit times.
+	MaxAge time.Duration `protobuf:"bytes,1,opt,name=max_age,json=maxAge,proto3,stdduration" json:"maxAge,omitempty"`
+	// max_bytes is the storage size of a log stream replica to be kept. It is
+	// approximate: the size is estimated from the storage files having log
+	// entries not trimmed, thus, it neither counts log entries not flushed yet
+	// nor includes space of trimmed log entries not reclaimed yet.
+	MaxBytes uint64 `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"maxBytes,omitempty"`
+	// max_entries is the number of log entries of a log stream to be kept.
+	MaxEntries uint64 `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"maxEntries,omitempty"`
+}
+
+func (m *RetentionPolicy) Reset()         { *m = RetentionPolicy{} }
+func (m *RetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetentionPolicy) ProtoMessage()    {}
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb4411772ca3492a, []int{6}
+}
+func (m *RetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetentionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetentionPolicy.Merge(m, src)
+}
+func (m *RetentionPolicy) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetentionPolicy proto.InternalMessageInfo
+
+func (m *RetentionPolicy) GetMaxAge() time.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
+func (m *RetentionPolicy) GetMaxBytes() uint64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *RetentionPolicy) GetMaxEntries() uint64 {
+	if m != nil {
+		return m.MaxEntries
+	}
+	return 0
+}
+
 // StorageNode is a structure to represent identifier and address of storage
 // node.
 type StorageNode struct {
@@ -521,7 +614,7 @@ func (m *StorageNode) Reset()         { *m = StorageNode{} }
 func (m *StorageNode) String() string { return proto.CompactTextString(m) }
 func (*StorageNode) ProtoMessage()    {}
 func (*StorageNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{6}
+	return fileDescriptor_eb4411772ca3492a, []int{7}
 }
 func (m *StorageNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,7 +667,7 @@ func (m *TopicLogStream) Reset()         { *m = TopicLogStream{} }
 func (m *TopicLogStream) String() string { return proto.CompactTextString(m) }
 func (*TopicLogStream) ProtoMessage()    {}
 func (*TopicLogStream) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{7}
+	return fileDescriptor_eb4411772ca3492a, []int{8}
 }
 func (m *TopicLogStream) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +720,7 @@ func (m *LogStreamReplica) Reset()         { *m = LogStreamReplica{} }
 func (m *LogStreamReplica) String() string { return proto.CompactTextString(m) }
 func (*LogStreamReplica) ProtoMessage()    {}
 func (*LogStreamReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{8}
+	return fileDescriptor_eb4411772ca3492a, []int{9}
 }
 func (m *LogStreamReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -668,7 +761,7 @@ func (m *LogSequenceNumber) Reset()         { *m = LogSequenceNumber{} }
 func (m *LogSequenceNumber) String() string { return proto.CompactTextString(m) }
 func (*LogSequenceNumber) ProtoMessage()    {}
 func (*LogSequenceNumber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{9}
+	return fileDescriptor_eb4411772ca3492a, []int{10}
 }
 func (m *LogSequenceNumber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -722,7 +815,7 @@ func (m *LogEntryMeta) Reset()         { *m = LogEntryMeta{} }
 func (m *LogEntryMeta) String() string { return proto.CompactTextString(m) }
 func (*LogEntryMeta) ProtoMessage()    {}
 func (*LogEntryMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{10}
+	return fileDescriptor_eb4411772ca3492a, []int{11}
 }
 func (m *LogEntryMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -782,13 +875,19 @@ func (m *LogEntryMeta) GetLLSN() github_com_kakao_varlog_pkg_types.LLSN {
 type LogEntry struct {
 	LogEntryMeta `protobuf:"bytes,1,opt,name=meta,proto3,embedded=meta" json:"meta"`
 	Data         []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// headers are optional key-value metadata of the log entry. They are stored
+	// and replicated together with the data.
+	Headers map[string][]byte `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// checksum is the CRC32C checksum of the data. Storage nodes verify it
+	// whenever they read or receive the log entry.
+	Checksum uint32 `protobuf:"fixed32,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
 }
 
 func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{11}
+	return fileDescriptor_eb4411772ca3492a, []int{12}
 }
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -824,6 +923,133 @@ func (m *LogEntry) GetData() []byte {
 	return nil
 }
 
+func (m *LogEntry) GetHeaders() map[string][]byte {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *LogEntry) GetChecksum() uint32 {
+	if m != nil {
+		return m.Checksum
+	}
+	return 0
+}
+
+// LogEntryHeaders wraps headers of a log entry to carry headers of several log
+// entries in a message, for instance, snpb.AppendRequest.
+type LogEntryHeaders struct {
+	Values map[string][]byte `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *LogEntryHeaders) Reset()         { *m = LogEntryHeaders{} }
+func (m *LogEntryHeaders) String() string { return proto.CompactTextString(m) }
+func (*LogEntryHeaders) ProtoMessage()    {}
+func (*LogEntryHeaders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb4411772ca3492a, []int{13}
+}
+func (m *LogEntryHeaders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogEntryHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogEntryHeaders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogEntryHeaders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogEntryHeaders.Merge(m, src)
+}
+func (m *LogEntryHeaders) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LogEntryHeaders) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogEntryHeaders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogEntryHeaders proto.InternalMessageInfo
+
+func (m *LogEntryHeaders) GetValues() map[string][]byte {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// ProducerState is the state of an idempotent producer in a log stream. It
+// remembers metadata of log entries in the last batch appended by the
+// producer so that the log stream can answer a retried batch without
+// appending it again.
+type ProducerState struct {
+	ProducerID string `protobuf:"bytes,1,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	// last_batch_sequence is the sequence number of the first log entry in the
+	// last batch. Log entries in a batch have consecutive sequence numbers.
+	LastBatchSequence uint64 `protobuf:"varint,2,opt,name=last_batch_sequence,json=lastBatchSequence,proto3" json:"last_batch_sequence,omitempty"`
+	// last_batch is metadata of log entries in the last batch.
+	LastBatch []LogEntryMeta `protobuf:"bytes,3,rep,name=last_batch,json=lastBatch,proto3" json:"last_batch"`
+}
+
+func (m *ProducerState) Reset()         { *m = ProducerState{} }
+func (m *ProducerState) String() string { return proto.CompactTextString(m) }
+func (*ProducerState) ProtoMessage()    {}
+func (*ProducerState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb4411772ca3492a, []int{14}
+}
+func (m *ProducerState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProducerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProducerState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProducerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProducerState.Merge(m, src)
+}
+func (m *ProducerState) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ProducerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProducerState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProducerState proto.InternalMessageInfo
+
+func (m *ProducerState) GetProducerID() string {
+	if m != nil {
+		return m.ProducerID
+	}
+	return ""
+}
+
+func (m *ProducerState) GetLastBatchSequence() uint64 {
+	if m != nil {
+		return m.LastBatchSequence
+	}
+	return 0
+}
+
+func (m *ProducerState) GetLastBatch() []LogEntryMeta {
+	if m != nil {
+		return m.LastBatch
+	}
+	return nil
+}
+
 type CommitContext struct {
 	Version            github_com_kakao_varlog_pkg_types.Version `protobuf:"varint,1,opt,name=version,proto3,casttype=github.com/kakao/varlog/pkg/types.Version" json:"version,omitempty"`
 	HighWatermark      github_com_kakao_varlog_pkg_types.GLSN    `protobuf:"varint,2,opt,name=high_watermark,json=highWatermark,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"high_watermark,omitempty"`
@@ -836,7 +1062,7 @@ func (m *CommitContext) Reset()         { *m = CommitContext{} }
 func (m *CommitContext) String() string { return proto.CompactTextString(m) }
 func (*CommitContext) ProtoMessage()    {}
 func (*CommitContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{12}
+	return fileDescriptor_eb4411772ca3492a, []int{15}
 }
 func (m *CommitContext) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +1138,7 @@ func (m *MetadataRepositoryNode) Reset()         { *m = MetadataRepositoryNode{}
 func (m *MetadataRepositoryNode) String() string { return proto.CompactTextString(m) }
 func (*MetadataRepositoryNode) ProtoMessage()    {}
 func (*MetadataRepositoryNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{13}
+	return fileDescriptor_eb4411772ca3492a, []int{16}
 }
 func (m *MetadataRepositoryNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -982,16 +1208,22 @@ func init() {
 	proto.RegisterEnum("varlog.varlogpb.TopicStatus", TopicStatus_name, TopicStatus_value)
 	proto.RegisterType((*MetadataDescriptor)(nil), "varlog.varlogpb.MetadataDescriptor")
 	proto.RegisterType((*StorageNodeDescriptor)(nil), "varlog.varlogpb.StorageNodeDescriptor")
+	proto.RegisterMapType((map[string]string)(nil), "varlog.varlogpb.StorageNodeDescriptor.TagsEntry")
 	proto.RegisterType((*StorageDescriptor)(nil), "varlog.varlogpb.StorageDescriptor")
 	proto.RegisterType((*LogStreamDescriptor)(nil), "varlog.varlogpb.LogStreamDescriptor")
 	proto.RegisterType((*ReplicaDescriptor)(nil), "varlog.varlogpb.ReplicaDescriptor")
 	proto.RegisterType((*TopicDescriptor)(nil), "varlog.varlogpb.TopicDescriptor")
+	proto.RegisterType((*RetentionPolicy)(nil), "varlog.varlogpb.RetentionPolicy")
 	proto.RegisterType((*StorageNode)(nil), "varlog.varlogpb.StorageNode")
 	proto.RegisterType((*TopicLogStream)(nil), "varlog.varlogpb.TopicLogStream")
 	proto.RegisterType((*LogStreamReplica)(nil), "varlog.varlogpb.LogStreamReplica")
 	proto.RegisterType((*LogSequenceNumber)(nil), "varlog.varlogpb.LogSequenceNumber")
 	proto.RegisterType((*LogEntryMeta)(nil), "varlog.varlogpb.LogEntryMeta")
 	proto.RegisterType((*LogEntry)(nil), "varlog.varlogpb.LogEntry")
+	proto.RegisterMapType((map[string][]byte)(nil), "varlog.varlogpb.LogEntry.HeadersEntry")
+	proto.RegisterType((*LogEntryHeaders)(nil), "varlog.varlogpb.LogEntryHeaders")
+	proto.RegisterMapType((map[string][]byte)(nil), "varlog.varlogpb.LogEntryHeaders.ValuesEntry")
+	proto.RegisterType((*ProducerState)(nil), "varlog.varlogpb.ProducerState")
 	proto.RegisterType((*CommitContext)(nil), "varlog.varlogpb.CommitContext")
 	proto.RegisterType((*MetadataRepositoryNode)(nil), "varlog.varlogpb.MetadataRepositoryNode")
 }
@@ -999,99 +1231,122 @@ func init() {
 func init() { proto.RegisterFile("proto/varlogpb/metadata.proto", fileDescriptor_eb4411772ca3492a) }
 
 var fileDescriptor_eb4411772ca3492a = []byte{
-	// 1465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcb, 0x6f, 0x1b, 0x45,
-	0x1c, 0xce, 0xda, 0x9b, 0xc4, 0x19, 0xc7, 0x89, 0x33, 0x4d, 0x23, 0x63, 0x5a, 0xaf, 0x15, 0x41,
-	0x95, 0x56, 0xd4, 0xa6, 0x41, 0x95, 0xaa, 0x56, 0x40, 0xe3, 0xd8, 0xa4, 0x91, 0x5c, 0xa7, 0x1a,
-	0x3b, 0x54, 0xe5, 0x80, 0x35, 0xf6, 0x4e, 0xd7, 0xab, 0xac, 0x77, 0x97, 0xdd, 0x71, 0xdb, 0x1c,
-	0xb8, 0x71, 0x40, 0x39, 0x55, 0x70, 0x80, 0x4b, 0xa4, 0x4a, 0x70, 0x41, 0xe2, 0x8f, 0xe0, 0xd8,
-	0x63, 0x8f, 0x70, 0x71, 0x25, 0xe7, 0x82, 0xc2, 0x85, 0x73, 0x4f, 0x68, 0x66, 0x67, 0xed, 0xdd,
-	0xb5, 0xd3, 0x36, 0x14, 0x84, 0xc4, 0xc9, 0xf3, 0xfa, 0x7e, 0x8f, 0xef, 0xf7, 0xcd, 0x63, 0x0d,
-	0xce, 0xdb, 0x8e, 0x45, 0xad, 0xe2, 0x03, 0xec, 0x18, 0x96, 0x66, 0xb7, 0x8a, 0x5d, 0x42, 0xb1,
-	0x8a, 0x29, 0x2e, 0xf0, 0x71, 0xb8, 0xe8, 0x4d, 0x14, 0xfc, 0xf9, 0xac, 0xa2, 0x59, 0x96, 0x66,
-	0x90, 0x22, 0x9f, 0x6e, 0xf5, 0xee, 0x17, 0xa9, 0xde, 0x25, 0x2e, 0xc5, 0x5d, 0xdb, 0x43, 0x64,
-	0x2f, 0x6b, 0x3a, 0xed, 0xf4, 0x5a, 0x85, 0xb6, 0xd5, 0x2d, 0x6a, 0x96, 0x66, 0x8d, 0x56, 0xb2,
-	0x9e, 0xe7, 0x8d, 0xb5, 0xbc, 0xe5, 0xab, 0xbf, 0xc5, 0x00, 0xbc, 0x2d, 0x7c, 0x96, 0x89, 0xdb,
-	0x76, 0x74, 0x9b, 0x5a, 0x0e, 0xbc, 0x0a, 0x52, 0xd8, 0xb6, 0x0d, 0x9d, 0xa8, 0x4d, 0xdd, 0x54,
-	0xc9, 0xa3, 0x8c, 0x94, 0x97, 0xd6, 0xe4, 0x52, 0xfa, 0xb8, 0xaf, 0xcc, 0x8b, 0x89, 0x6d, 0x36,
-	0x8e, 0x42, 0x3d, 0x88, 0x41, 0xca, 0xa5, 0x96, 0x83, 0x35, 0xd2, 0x34, 0x2d, 0x95, 0xb8, 0x99,
-	0x58, 0x3e, 0xbe, 0x96, 0x5c, 0xbf, 0x50, 0x88, 0xa4, 0x51, 0xa8, 0x7b, 0xab, 0x6a, 0x96, 0x4a,
-	0x46, 0x5e, 0x4b, 0xcb, 0x4f, 0xfb, 0x8a, 0xc4, 0x5c, 0xb8, 0xa3, 0x69, 0x17, 0x85, 0x7a, 0xf0,
-	0x1e, 0x48, 0x1a, 0x96, 0xd6, 0x74, 0xa9, 0x43, 0x70, 0xd7, 0xcd, 0xc4, 0xb9, 0x83, 0x77, 0xc6,
-	0x1c, 0x54, 0x2d, 0xad, 0xce, 0x97, 0x04, 0xcc, 0x43, 0x61, 0x1e, 0x18, 0xfe, 0xa4, 0x8b, 0x02,
-	0x6d, 0x78, 0x0b, 0xcc, 0x50, 0xcb, 0xd6, 0xdb, 0x6e, 0x46, 0xe6, 0x56, 0xf3, 0x63, 0x56, 0x1b,
-	0x6c, 0x3a, 0x60, 0x71, 0x41, 0x58, 0x14, 0x38, 0x24, 0x7e, 0xaf, 0xcb, 0xbf, 0x3f, 0x51, 0xa4,
-	0xd5, 0x6f, 0x63, 0xe0, 0xec, 0xc4, 0x44, 0xe1, 0x6d, 0x30, 0x1f, 0xe4, 0x89, 0xb3, 0x9b, 0x5c,
-	0x3f, 0xf7, 0x32, 0x9a, 0x4a, 0xf3, 0x4f, 0xfb, 0xca, 0xd4, 0x33, 0xcf, 0xdf, 0x14, 0x4a, 0x06,
-	0x48, 0x81, 0xd7, 0xc1, 0x8c, 0x4b, 0x31, 0xed, 0x31, 0xbe, 0xa5, 0xb5, 0x85, 0xf5, 0xd5, 0x97,
-	0x19, 0xaa, 0xf3, 0x95, 0x48, 0x20, 0xe0, 0x32, 0x98, 0xb6, 0x31, 0xed, 0x78, 0x4c, 0xce, 0x21,
-	0xaf, 0x03, 0xeb, 0x20, 0xd9, 0x76, 0x08, 0xa6, 0xa4, 0xc9, 0xf4, 0x95, 0x91, 0x79, 0x7c, 0xd9,
-	0x82, 0x27, 0xbe, 0x82, 0x2f, 0xa9, 0x42, 0xc3, 0x17, 0x5f, 0x69, 0x85, 0x45, 0xc7, 0xb8, 0xf5,
-	0x60, 0x6c, 0xe2, 0xf1, 0x73, 0x45, 0x42, 0x81, 0xbe, 0x60, 0xe5, 0x2e, 0x58, 0x12, 0xd1, 0x04,
-	0x08, 0x81, 0x40, 0x66, 0x8e, 0x39, 0x11, 0x73, 0x88, 0xb7, 0xd9, 0x58, 0xcf, 0x25, 0x2a, 0xcf,
-	0x49, 0x46, 0xbc, 0xcd, 0xa2, 0xa5, 0x16, 0xc5, 0x46, 0x26, 0xce, 0x07, 0xbd, 0x8e, 0x30, 0xfc,
-	0x67, 0x0c, 0x9c, 0x99, 0x50, 0x76, 0xf8, 0x39, 0x48, 0xf0, 0xb2, 0x34, 0x75, 0x95, 0xdb, 0x9f,
-	0x2e, 0x6d, 0x0e, 0xfa, 0xca, 0x2c, 0xaf, 0xe5, 0x76, 0xf9, 0xb8, 0xaf, 0xcc, 0xf2, 0xe9, 0x6d,
-	0xf5, 0x45, 0x5f, 0xb9, 0x18, 0xd8, 0x3d, 0x7b, 0x78, 0x0f, 0xfb, 0x3b, 0xb3, 0x68, 0xef, 0x69,
-	0x45, 0xba, 0x6f, 0x13, 0xb7, 0x20, 0x70, 0xc8, 0x47, 0x41, 0x17, 0xa4, 0x46, 0x8a, 0x64, 0x4e,
-	0x62, 0xdc, 0xc9, 0xce, 0xa0, 0xaf, 0x24, 0x87, 0xf1, 0x70, 0x47, 0xc9, 0xa1, 0xd8, 0xb8, 0xb3,
-	0xcb, 0xaf, 0x76, 0x16, 0xc0, 0xa3, 0x20, 0x1a, 0x5e, 0x1b, 0x96, 0x3c, 0xce, 0x4b, 0x9e, 0x3f,
-	0x79, 0x07, 0x44, 0x0a, 0x5e, 0x06, 0x09, 0x87, 0xd8, 0x86, 0xde, 0xc6, 0xbe, 0xce, 0xc7, 0xe5,
-	0x82, 0xbc, 0x05, 0x01, 0xa5, 0xcb, 0x4c, 0xe9, 0x68, 0x88, 0x14, 0x94, 0x7f, 0x15, 0x03, 0x4b,
-	0x63, 0x6b, 0xe1, 0x97, 0x60, 0x31, 0xa8, 0xee, 0x11, 0xef, 0xbb, 0x83, 0xbe, 0x92, 0x0a, 0x48,
-	0x91, 0x93, 0x92, 0x0a, 0x28, 0x99, 0xd3, 0x52, 0x7c, 0x35, 0x2d, 0x21, 0x1b, 0x28, 0x6c, 0x01,
-	0x7e, 0x0c, 0x96, 0x42, 0xee, 0xb9, 0xb0, 0x58, 0x4d, 0xe6, 0x4a, 0x67, 0x8e, 0xfb, 0xca, 0x62,
-	0x60, 0xf5, 0x1d, 0x4c, 0x3b, 0x28, 0x3a, 0x00, 0x2f, 0x82, 0x39, 0x76, 0x1c, 0x7a, 0xc0, 0x38,
-	0x07, 0xce, 0x1f, 0xf7, 0x95, 0x04, 0x1b, 0xe4, 0x88, 0x61, 0x4b, 0xd0, 0xf0, 0x53, 0x0c, 0x2c,
-	0x46, 0x8e, 0x86, 0x7f, 0x5d, 0x75, 0x37, 0x23, 0x7b, 0xfe, 0xdc, 0xe4, 0xc3, 0xca, 0x2b, 0x7e,
-	0x09, 0xb0, 0x43, 0xca, 0x0d, 0x0b, 0xc1, 0x1c, 0x3f, 0x49, 0xa7, 0x4b, 0xb7, 0xc5, 0x89, 0xb6,
-	0x3c, 0x3a, 0x17, 0xdf, 0xb3, 0xba, 0x3a, 0x25, 0x5d, 0x9b, 0xee, 0x9f, 0x5e, 0xb3, 0x81, 0xe3,
-	0x55, 0x70, 0xf5, 0xb3, 0x04, 0x92, 0x81, 0xf2, 0xfd, 0xd7, 0x62, 0xc9, 0x80, 0x59, 0xac, 0xaa,
-	0x0e, 0x71, 0x3d, 0x1e, 0xe7, 0x90, 0xdf, 0x15, 0xe1, 0xfe, 0x21, 0x81, 0x05, 0x4e, 0xe4, 0x30,
-	0xab, 0xff, 0xe5, 0x79, 0x22, 0xb2, 0xfd, 0x45, 0x02, 0xe9, 0xe1, 0x12, 0xb1, 0xb1, 0xff, 0xe9,
-	0xcb, 0xea, 0x2e, 0x48, 0x7b, 0xf4, 0x8d, 0x92, 0xe4, 0x19, 0x26, 0xd7, 0x95, 0xc9, 0x12, 0x1e,
-	0x06, 0x14, 0xb1, 0xba, 0x40, 0x43, 0xb3, 0xfe, 0x5e, 0x94, 0xc0, 0x12, 0x1b, 0x23, 0x5f, 0xf4,
-	0x88, 0xd9, 0x26, 0xb5, 0x5e, 0xb7, 0x45, 0x1c, 0xf8, 0x09, 0x90, 0x0d, 0xc3, 0x35, 0xc5, 0x33,
-	0x66, 0x7d, 0xd0, 0x57, 0xe4, 0x6a, 0xb5, 0x5e, 0x7b, 0xd1, 0x57, 0x2e, 0xbc, 0x06, 0x69, 0xd5,
-	0x7a, 0x0d, 0x71, 0x3c, 0xb3, 0xa3, 0x31, 0x3b, 0xb1, 0x91, 0x9d, 0xad, 0xd7, 0xb6, 0xb3, 0xc5,
-	0xed, 0x30, 0xbc, 0x88, 0xf5, 0x79, 0x0c, 0xcc, 0x57, 0x2d, 0xad, 0x62, 0x52, 0x67, 0x9f, 0x3d,
-	0xc2, 0x60, 0x7d, 0x4c, 0x5a, 0xd7, 0x02, 0xd2, 0xfa, 0x9b, 0x7a, 0x52, 0x27, 0xeb, 0xe9, 0x66,
-	0x44, 0x4f, 0x6f, 0x78, 0x21, 0xf9, 0xcc, 0xc4, 0xdf, 0x8c, 0x99, 0x61, 0xa5, 0xe4, 0x37, 0xab,
-	0x94, 0x60, 0x18, 0x83, 0x84, 0x4f, 0x30, 0xbc, 0x01, 0x64, 0xf6, 0xba, 0x16, 0xfa, 0x3d, 0x3f,
-	0xe9, 0xc2, 0x1c, 0x56, 0xa2, 0x94, 0xf0, 0xa5, 0x86, 0x38, 0x88, 0x3d, 0x46, 0xd8, 0xa1, 0xcf,
-	0xb9, 0x9b, 0x47, 0xbc, 0x2d, 0x5c, 0x7c, 0x23, 0x83, 0xd4, 0xa6, 0xd5, 0xed, 0xea, 0x74, 0xd3,
-	0x32, 0x29, 0x79, 0x44, 0xe1, 0x16, 0x98, 0x7d, 0x40, 0x1c, 0x57, 0xb7, 0x7c, 0xbd, 0x5d, 0x7e,
-	0xbd, 0xca, 0x7d, 0xea, 0x81, 0x90, 0x8f, 0x86, 0x2d, 0xb0, 0xd0, 0xd1, 0xb5, 0x4e, 0xf3, 0x21,
-	0xa6, 0xc4, 0xe9, 0x62, 0x67, 0x4f, 0xe8, 0xee, 0x06, 0x3b, 0x1a, 0x6f, 0xe9, 0x5a, 0xe7, 0xae,
-	0x3f, 0x71, 0x0a, 0x9a, 0x53, 0x9d, 0x20, 0x10, 0x3a, 0x60, 0xb9, 0xcd, 0xa3, 0xa7, 0x44, 0x6d,
-	0xb2, 0x0a, 0x34, 0x5b, 0x44, 0xd3, 0xfd, 0x3a, 0x32, 0x91, 0xc0, 0x4d, 0x7f, 0x9e, 0xe1, 0x4b,
-	0x6c, 0xf6, 0x14, 0xee, 0xe0, 0xd0, 0xfa, 0x96, 0xe1, 0x9a, 0x1c, 0x0d, 0x0d, 0x00, 0x23, 0x3e,
-	0x89, 0xa9, 0x8a, 0x8a, 0x7f, 0x34, 0xe8, 0x2b, 0xe9, 0x90, 0xc7, 0x8a, 0xa9, 0x9e, 0xc2, 0x5f,
-	0x3a, 0xe4, 0xaf, 0x62, 0xaa, 0xe1, 0x0c, 0x8d, 0x51, 0x86, 0xd3, 0x13, 0x32, 0xac, 0x9e, 0x2e,
-	0xc3, 0x6a, 0x38, 0xc3, 0xaa, 0x9f, 0xe1, 0xea, 0x8f, 0x31, 0xb0, 0xe2, 0x7f, 0x56, 0x21, 0x62,
-	0x5b, 0xae, 0x4e, 0x2d, 0x67, 0x9f, 0x9f, 0x7f, 0xf7, 0xc0, 0x6c, 0xf0, 0xa2, 0xf3, 0x22, 0x98,
-	0x19, 0xde, 0x70, 0x33, 0xa6, 0x7f, 0xb5, 0xad, 0xbd, 0xda, 0xbf, 0xb8, 0xd3, 0x04, 0x06, 0x5e,
-	0x01, 0x09, 0x07, 0xdf, 0xa7, 0xcd, 0x9e, 0x63, 0x88, 0x07, 0xcf, 0x0a, 0x3b, 0x3e, 0x10, 0xbe,
-	0x4f, 0x77, 0x51, 0x95, 0xdd, 0x4c, 0x8e, 0xd7, 0x44, 0x5e, 0xc3, 0x31, 0x38, 0xc4, 0x6e, 0x37,
-	0xd9, 0xa5, 0x27, 0x9e, 0x3a, 0x1e, 0xe4, 0xce, 0xe6, 0x86, 0xaa, 0x3a, 0x1c, 0x62, 0xb7, 0x59,
-	0x13, 0xf9, 0x0d, 0xb8, 0x0a, 0x66, 0x0c, 0x82, 0x55, 0xe2, 0xf0, 0x8a, 0x25, 0xbc, 0xb7, 0x85,
-	0x37, 0x82, 0xc4, 0x2f, 0x7c, 0x17, 0xcc, 0x1a, 0x04, 0x3b, 0x26, 0x71, 0x38, 0xcd, 0x89, 0x52,
-	0x92, 0x99, 0x12, 0x43, 0xc8, 0x6f, 0x5c, 0xfa, 0x4e, 0x1a, 0x7e, 0x0c, 0x8c, 0x3e, 0x4d, 0xe0,
-	0x87, 0xe0, 0xed, 0x7a, 0x63, 0x07, 0x6d, 0x6c, 0x55, 0x9a, 0xb5, 0x9d, 0x72, 0xa5, 0x59, 0x6f,
-	0x6c, 0x34, 0x76, 0xeb, 0x4d, 0xb4, 0x5b, 0xab, 0x6d, 0xd7, 0xb6, 0xd2, 0x53, 0xd9, 0x73, 0x07,
-	0x87, 0xf9, 0xcc, 0xf8, 0x27, 0x4d, 0xcf, 0x34, 0x75, 0x53, 0x3b, 0x09, 0x5e, 0xae, 0x54, 0x2b,
-	0x8d, 0x4a, 0x39, 0x2d, 0x9d, 0x00, 0x2f, 0x13, 0x83, 0x50, 0xa2, 0x66, 0xe5, 0xaf, 0x7f, 0xc8,
-	0x4d, 0x5d, 0xfa, 0x3e, 0x06, 0x16, 0x23, 0x2f, 0x68, 0x78, 0x05, 0x2c, 0x55, 0xeb, 0xe3, 0xd1,
-	0x64, 0x0f, 0x0e, 0xf3, 0x2b, 0xd1, 0xd7, 0xb6, 0x88, 0x25, 0x04, 0xa9, 0x57, 0x36, 0xaa, 0x0c,
-	0x22, 0x4d, 0x84, 0xd4, 0x09, 0x36, 0x18, 0xa4, 0x08, 0xd2, 0x61, 0x48, 0xa5, 0x9c, 0x8e, 0x65,
-	0xdf, 0x3a, 0x38, 0xcc, 0x9f, 0x9d, 0x80, 0x20, 0x6a, 0xd8, 0x87, 0x9f, 0x65, 0x7c, 0xa2, 0x0f,
-	0x91, 0x23, 0xbc, 0x0a, 0xce, 0x8c, 0x20, 0xbb, 0x35, 0x3f, 0x30, 0xd9, 0xa3, 0x26, 0x02, 0xda,
-	0x35, 0x5d, 0x2f, 0x34, 0x41, 0xcd, 0x43, 0x90, 0x0c, 0x3c, 0x2d, 0xe1, 0xfb, 0x60, 0xb9, 0xb1,
-	0x73, 0x67, 0x7b, 0x73, 0x9c, 0x98, 0x95, 0x83, 0xc3, 0x3c, 0x0c, 0x2c, 0xf5, 0x49, 0x89, 0x22,
-	0x46, 0x95, 0x89, 0x22, 0x42, 0x35, 0x29, 0xdd, 0x7c, 0x3a, 0xc8, 0x49, 0xcf, 0x06, 0x39, 0xe9,
-	0xf1, 0x51, 0x6e, 0xea, 0xc9, 0x51, 0x4e, 0x7a, 0x76, 0x94, 0x9b, 0xfa, 0xf5, 0x28, 0x37, 0xf5,
-	0xd9, 0xc9, 0x5b, 0x35, 0xf4, 0xef, 0x4a, 0x6b, 0x86, 0xf7, 0x3f, 0xf8, 0x2b, 0x00, 0x00, 0xff,
-	0xff, 0x6c, 0x3e, 0x87, 0x81, 0x76, 0x11, 0x00, 0x00,
+	// 1829 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0x94, 0x48, 0x3d, 0xea, 0x83, 0x1a, 0x2b, 0x02, 0xcd, 0x3a, 0x5a, 0x56, 0x68,
+	0x0d, 0x27, 0x88, 0xc9, 0x44, 0x41, 0x50, 0xc7, 0x41, 0x5b, 0x6b, 0x45, 0x56, 0x16, 0x40, 0xd3,
+	0xc2, 0x50, 0x8a, 0x91, 0x1e, 0xba, 0x18, 0x72, 0xc7, 0xcb, 0x85, 0x96, 0xbb, 0xec, 0xee, 0xd0,
+	0x91, 0x0e, 0xbd, 0xf5, 0x50, 0xf8, 0x14, 0xf4, 0x52, 0xf7, 0x60, 0x20, 0x40, 0x7b, 0x29, 0xd0,
+	0xbf, 0xa0, 0xa7, 0x1e, 0x7d, 0xf4, 0xb1, 0xb9, 0x30, 0x80, 0x7c, 0x29, 0xd4, 0x4b, 0xcf, 0xe9,
+	0xa5, 0x98, 0xd9, 0x19, 0x72, 0x97, 0xa4, 0x22, 0x2b, 0x6e, 0x51, 0xa0, 0x27, 0xce, 0xbc, 0xf7,
+	0x7e, 0xef, 0x6b, 0xde, 0x7b, 0x33, 0x4b, 0x78, 0xbb, 0x1f, 0xf8, 0xcc, 0xaf, 0x3e, 0x21, 0x81,
+	0xeb, 0xdb, 0xfd, 0x76, 0xb5, 0x47, 0x19, 0xb1, 0x08, 0x23, 0x15, 0x41, 0x47, 0xab, 0x11, 0xa3,
+	0xa2, 0xf8, 0xa5, 0x4d, 0xdb, 0xf7, 0x6d, 0x97, 0x56, 0x05, 0xbb, 0x3d, 0x78, 0x5c, 0xb5, 0x06,
+	0x01, 0x61, 0x8e, 0xef, 0x45, 0x80, 0x92, 0x3e, 0xc9, 0x67, 0x4e, 0x8f, 0x86, 0x8c, 0xf4, 0xfa,
+	0x52, 0xe0, 0xb6, 0xed, 0xb0, 0xee, 0xa0, 0x5d, 0xe9, 0xf8, 0xbd, 0xaa, 0xed, 0xdb, 0xfe, 0x58,
+	0x92, 0xef, 0x22, 0x6f, 0xf8, 0x2a, 0x12, 0xdf, 0xfa, 0x2a, 0x05, 0xe8, 0x81, 0xf4, 0xa9, 0x46,
+	0xc3, 0x4e, 0xe0, 0xf4, 0x99, 0x1f, 0xa0, 0x8f, 0x60, 0x99, 0xf4, 0xfb, 0xae, 0x43, 0x2d, 0xd3,
+	0xf1, 0x2c, 0x7a, 0x52, 0xd4, 0xca, 0xda, 0xad, 0x8c, 0x51, 0x38, 0x1f, 0xea, 0x4b, 0x92, 0xb1,
+	0xcf, 0xe9, 0x38, 0xb1, 0x43, 0x04, 0x96, 0x43, 0xe6, 0x07, 0xc4, 0xa6, 0xa6, 0xe7, 0x5b, 0x34,
+	0x2c, 0xa6, 0xca, 0xe9, 0x5b, 0xf9, 0xed, 0x9b, 0x95, 0x89, 0x30, 0x2b, 0xad, 0x48, 0xaa, 0xe9,
+	0x5b, 0x74, 0x6c, 0xd5, 0x58, 0x7f, 0x31, 0xd4, 0x35, 0x6e, 0x22, 0x1c, 0xb3, 0x43, 0x9c, 0xd8,
+	0xa1, 0xcf, 0x20, 0xef, 0xfa, 0xb6, 0x19, 0xb2, 0x80, 0x92, 0x5e, 0x58, 0x4c, 0x0b, 0x03, 0x3f,
+	0x98, 0x32, 0xd0, 0xf0, 0xed, 0x96, 0x10, 0x89, 0xa9, 0x47, 0x52, 0x3d, 0xb8, 0x8a, 0x19, 0xe2,
+	0xd8, 0x1a, 0xdd, 0x87, 0x05, 0xe6, 0xf7, 0x9d, 0x4e, 0x58, 0xcc, 0x08, 0xad, 0xe5, 0x29, 0xad,
+	0x87, 0x9c, 0x1d, 0xd3, 0xb8, 0x22, 0x35, 0x4a, 0x1c, 0x96, 0xbf, 0x77, 0x33, 0x7f, 0xff, 0x52,
+	0xd7, 0xb6, 0x7e, 0x9b, 0x86, 0xb7, 0x66, 0x06, 0x8a, 0x1e, 0xc0, 0x52, 0x3c, 0x4f, 0x22, 0xbb,
+	0xf9, 0xed, 0x1b, 0xdf, 0x96, 0x26, 0x63, 0xe9, 0xc5, 0x50, 0x9f, 0x7b, 0x19, 0xd9, 0x9b, 0xc3,
+	0xf9, 0x58, 0x52, 0xd0, 0x5d, 0x58, 0x08, 0x19, 0x61, 0x03, 0x9e, 0x6f, 0xed, 0xd6, 0xca, 0xf6,
+	0xd6, 0xb7, 0x29, 0x6a, 0x09, 0x49, 0x2c, 0x11, 0x68, 0x1d, 0xe6, 0xfb, 0x84, 0x75, 0xa3, 0x4c,
+	0x2e, 0xe2, 0x68, 0x83, 0x5a, 0x90, 0xef, 0x04, 0x94, 0x30, 0x6a, 0xf2, 0xfa, 0x2a, 0x66, 0x84,
+	0x7f, 0xa5, 0x4a, 0x54, 0x7c, 0x15, 0x55, 0x52, 0x95, 0x43, 0x55, 0x7c, 0xc6, 0x06, 0xf7, 0x8e,
+	0xe7, 0x36, 0x82, 0x71, 0xc6, 0x17, 0x5f, 0xeb, 0x1a, 0x8e, 0xed, 0x51, 0x0d, 0x32, 0x8c, 0xd8,
+	0x61, 0x71, 0x5e, 0x64, 0xf7, 0xfd, 0xd7, 0x2b, 0x8a, 0xca, 0x21, 0xb1, 0xc3, 0xba, 0xc7, 0x82,
+	0x53, 0x2c, 0xd0, 0xa5, 0x1f, 0xc1, 0xe2, 0x88, 0x84, 0x0a, 0x90, 0x3e, 0xa6, 0xa7, 0x22, 0x7f,
+	0x8b, 0x98, 0x2f, 0x79, 0x3c, 0x4f, 0x88, 0x3b, 0xa0, 0x22, 0x15, 0x8b, 0x38, 0xda, 0xdc, 0x4d,
+	0xdd, 0xd1, 0xe4, 0xa1, 0x3c, 0x82, 0x35, 0x69, 0x27, 0x76, 0x1e, 0x08, 0x32, 0x3c, 0x6e, 0xa9,
+	0x47, 0xac, 0x39, 0x6d, 0x10, 0x52, 0x4b, 0xe8, 0xc9, 0x60, 0xb1, 0xe6, 0xca, 0x99, 0xcf, 0x88,
+	0x5b, 0x4c, 0x0b, 0x62, 0xb4, 0x91, 0x8a, 0xff, 0x99, 0x82, 0x6b, 0x33, 0xaa, 0x0e, 0xfd, 0x02,
+	0x72, 0xa2, 0x2a, 0x4c, 0xc7, 0x12, 0xfa, 0xe7, 0x8d, 0xdd, 0xb3, 0xa1, 0x9e, 0x15, 0xa5, 0xb4,
+	0x5f, 0x3b, 0x1f, 0xea, 0x59, 0xc1, 0xde, 0xb7, 0xbe, 0x19, 0xea, 0xef, 0xc4, 0x9a, 0xf7, 0x98,
+	0x1c, 0x13, 0x35, 0x38, 0xaa, 0xfd, 0x63, 0xbb, 0xca, 0x4e, 0xfb, 0x34, 0xac, 0x48, 0x1c, 0x56,
+	0x28, 0x14, 0xc2, 0xf2, 0xb8, 0x21, 0x4c, 0x27, 0x72, 0x78, 0xde, 0x78, 0x78, 0x36, 0xd4, 0xf3,
+	0x23, 0x7f, 0x84, 0xa1, 0xfc, 0xa8, 0xd6, 0x85, 0xb1, 0xdb, 0x97, 0x1b, 0x8b, 0xe1, 0x71, 0x1c,
+	0x8d, 0xee, 0x8c, 0x2a, 0x2e, 0x2d, 0x2a, 0xae, 0x7c, 0x71, 0x03, 0x4e, 0xd4, 0x5b, 0x0d, 0x72,
+	0x01, 0xed, 0xbb, 0x4e, 0x87, 0xa8, 0x36, 0x9b, 0xae, 0x56, 0x1c, 0x09, 0xc4, 0x1a, 0x2d, 0xc3,
+	0x1b, 0x0d, 0x8f, 0x90, 0x32, 0xe5, 0xbf, 0x4e, 0xc1, 0xda, 0x94, 0x2c, 0xfa, 0x15, 0xac, 0xc6,
+	0x9b, 0x6b, 0x9c, 0xf7, 0xa3, 0xb3, 0xa1, 0xbe, 0x1c, 0x2b, 0x32, 0x91, 0x94, 0xe5, 0x58, 0x23,
+	0x89, 0xb4, 0x54, 0x2f, 0x4f, 0x4b, 0x42, 0x07, 0x4e, 0x6a, 0x40, 0x3f, 0x85, 0xb5, 0x84, 0x79,
+	0x51, 0x58, 0xa2, 0x18, 0x8d, 0x6b, 0xe7, 0x43, 0x7d, 0x35, 0x26, 0x7d, 0x40, 0x58, 0x17, 0x4f,
+	0x12, 0xd0, 0x3b, 0xb0, 0xc8, 0xa7, 0x71, 0x04, 0x4c, 0x0b, 0xe0, 0xd2, 0xf9, 0x50, 0xcf, 0x71,
+	0xa2, 0x40, 0x8c, 0x56, 0x32, 0x0d, 0xbf, 0x4f, 0xc3, 0xea, 0xc4, 0x64, 0xfa, 0xaf, 0x57, 0xdd,
+	0xbd, 0x89, 0x91, 0x73, 0x63, 0xf6, 0xac, 0x8c, 0x0e, 0xdf, 0x00, 0x3e, 0x23, 0xc3, 0x64, 0x21,
+	0x78, 0xd3, 0x83, 0x7c, 0xde, 0x78, 0x20, 0x07, 0xea, 0xfa, 0x78, 0x2c, 0xbf, 0xe7, 0xf7, 0x1c,
+	0x46, 0x7b, 0x7d, 0x76, 0x7a, 0xf5, 0x9a, 0x8d, 0x4f, 0x77, 0x0f, 0x0a, 0x01, 0x65, 0xd4, 0xe3,
+	0x97, 0xa9, 0xd9, 0xf7, 0x5d, 0xa7, 0x73, 0x2a, 0xe7, 0x5a, 0x79, 0x46, 0x01, 0x4a, 0xc1, 0x03,
+	0x21, 0x67, 0x7c, 0x5f, 0xba, 0x75, 0x3d, 0x48, 0x32, 0xc6, 0xbe, 0xe1, 0xd5, 0x09, 0x96, 0x3c,
+	0x9b, 0xaf, 0x34, 0x58, 0x9d, 0xd0, 0x86, 0x0e, 0x20, 0xdb, 0x23, 0x27, 0x26, 0xb1, 0xd5, 0xe0,
+	0xbf, 0x3e, 0x35, 0x58, 0x6b, 0xf2, 0xd6, 0x37, 0x6e, 0xc8, 0xb9, 0x5a, 0xe8, 0x91, 0x93, 0x1d,
+	0x9b, 0x8e, 0x0d, 0x3e, 0xe3, 0xd3, 0x75, 0x21, 0xa2, 0xa2, 0x0f, 0x61, 0x91, 0x6b, 0x6c, 0x9f,
+	0x32, 0x1a, 0x1d, 0x48, 0xc6, 0xd8, 0x38, 0x1f, 0xea, 0xa8, 0x47, 0x4e, 0x0c, 0x4e, 0x8b, 0xf9,
+	0x99, 0x53, 0x34, 0xf4, 0x31, 0xe4, 0x39, 0x88, 0x7a, 0x2c, 0x70, 0x68, 0xd4, 0xc8, 0x19, 0xa3,
+	0xc8, 0x93, 0xdf, 0x23, 0x27, 0xf5, 0x88, 0x1a, 0x03, 0xc2, 0x98, 0x2a, 0x63, 0xfb, 0xb3, 0x06,
+	0xf9, 0x58, 0x2b, 0xfc, 0xaf, 0x1b, 0xaf, 0x08, 0x59, 0x62, 0x59, 0x01, 0x0d, 0x43, 0x39, 0xfb,
+	0xd5, 0x56, 0xba, 0xfb, 0x0f, 0x0d, 0x56, 0x44, 0x51, 0x8e, 0x2a, 0xe4, 0xff, 0x72, 0x36, 0xcb,
+	0x68, 0xff, 0xaa, 0x41, 0x61, 0x24, 0x22, 0x87, 0xe4, 0x7f, 0xfa, 0xdd, 0xf1, 0x08, 0x0a, 0x51,
+	0xfa, 0xc6, 0x41, 0x8a, 0x08, 0xf3, 0xdb, 0xfa, 0xec, 0x71, 0x30, 0x72, 0x68, 0x42, 0xeb, 0x0a,
+	0x4b, 0x70, 0x65, 0x08, 0x7f, 0xd2, 0x60, 0x8d, 0xd3, 0xe8, 0x2f, 0x07, 0xd4, 0xeb, 0xd0, 0xe6,
+	0xa0, 0xd7, 0xa6, 0x01, 0xfa, 0x19, 0x64, 0x5c, 0x37, 0xf4, 0xe4, 0x8b, 0x74, 0xfb, 0x6c, 0xa8,
+	0x67, 0x1a, 0x8d, 0x56, 0xf3, 0x9b, 0xa1, 0x7e, 0xf3, 0x35, 0x92, 0xd6, 0x68, 0x35, 0xb1, 0xc0,
+	0x73, 0x3d, 0x36, 0xd7, 0x93, 0x1a, 0xeb, 0xd9, 0x7b, 0x6d, 0x3d, 0x7b, 0x42, 0x0f, 0xc7, 0x4b,
+	0x5f, 0xbf, 0x4e, 0xc1, 0x52, 0xc3, 0xb7, 0xc5, 0xab, 0x84, 0xbf, 0xa7, 0x51, 0x6b, 0xaa, 0xb4,
+	0xee, 0xc4, 0x4a, 0xeb, 0x3b, 0xd6, 0x93, 0x35, 0xbb, 0x9e, 0xee, 0x4d, 0xd4, 0xd3, 0x1b, 0x5e,
+	0xee, 0x2a, 0x33, 0xe9, 0x37, 0xcb, 0xcc, 0xe8, 0xa4, 0x32, 0x6f, 0x76, 0x52, 0x32, 0xc3, 0xff,
+	0xd2, 0x20, 0xa7, 0x32, 0x8c, 0x3e, 0x81, 0x0c, 0xff, 0x92, 0x92, 0x05, 0xfc, 0xf6, 0xac, 0xd7,
+	0xc7, 0xe8, 0x28, 0x8c, 0x9c, 0xaa, 0x35, 0x2c, 0x40, 0xfc, 0x65, 0xc7, 0x6f, 0x50, 0x91, 0xbc,
+	0x25, 0x2c, 0xd6, 0xe8, 0x1e, 0x64, 0xbb, 0x94, 0x58, 0x34, 0x50, 0x9f, 0x14, 0x37, 0x2f, 0xd4,
+	0x59, 0xb9, 0x1f, 0x09, 0x8a, 0x0d, 0x56, 0x30, 0x54, 0x82, 0x5c, 0xa7, 0x4b, 0x3b, 0xc7, 0xe1,
+	0xa0, 0x27, 0x22, 0xce, 0xe2, 0xd1, 0xbe, 0x74, 0x17, 0x96, 0xe2, 0xa0, 0xcb, 0x9e, 0xad, 0x4b,
+	0xd3, 0xcf, 0xd6, 0x67, 0x1a, 0xac, 0x2a, 0x07, 0xa4, 0x2a, 0x54, 0x83, 0x05, 0x21, 0x16, 0x16,
+	0x35, 0xe1, 0xf2, 0x7b, 0x17, 0xba, 0x2c, 0x11, 0x95, 0x4f, 0x85, 0x78, 0xe4, 0xb8, 0xc4, 0x96,
+	0x3e, 0x86, 0x7c, 0x8c, 0xfc, 0x1d, 0x5c, 0xfb, 0x8b, 0x06, 0xcb, 0x07, 0x81, 0x6f, 0x0d, 0x3a,
+	0x34, 0xe0, 0xf7, 0x3d, 0x45, 0x55, 0xc8, 0xf7, 0x25, 0x41, 0x95, 0xff, 0xa2, 0xb1, 0x72, 0x36,
+	0xd4, 0x41, 0xc9, 0xf1, 0xbb, 0x59, 0x89, 0xec, 0x5b, 0xa8, 0x02, 0xd7, 0x5c, 0x12, 0x32, 0xb3,
+	0x4d, 0x58, 0xa7, 0x6b, 0x86, 0xb2, 0xe1, 0xe5, 0xd3, 0x7b, 0x8d, 0xb3, 0x0c, 0xce, 0x51, 0x93,
+	0x00, 0x19, 0x00, 0x63, 0x79, 0x79, 0x60, 0x97, 0x14, 0x01, 0x7f, 0x41, 0xce, 0xe1, 0xc5, 0x91,
+	0x2e, 0xf5, 0x8d, 0x96, 0x81, 0xe5, 0x5d, 0xbf, 0xd7, 0x73, 0xd8, 0xae, 0xef, 0x31, 0x7a, 0xc2,
+	0xd0, 0x1e, 0x64, 0x9f, 0xd0, 0x20, 0x74, 0x7c, 0x35, 0x62, 0x6e, 0xbf, 0x5e, 0xb3, 0x7e, 0x1a,
+	0x81, 0xb0, 0x42, 0xa3, 0x36, 0xac, 0x74, 0x1d, 0xbb, 0x6b, 0x7e, 0x4e, 0x18, 0x0d, 0x7a, 0x24,
+	0x38, 0x96, 0xa3, 0xe6, 0x13, 0x7e, 0x1b, 0xde, 0x77, 0xec, 0xee, 0x23, 0xc5, 0xb8, 0x42, 0x67,
+	0x2d, 0x77, 0xe3, 0x40, 0x14, 0xc0, 0x7a, 0x47, 0x78, 0xcf, 0xa8, 0x65, 0xf2, 0xa6, 0x33, 0xdb,
+	0xd4, 0x76, 0x54, 0xeb, 0xf2, 0xb9, 0x80, 0x76, 0x15, 0x9f, 0xe3, 0x0d, 0xce, 0xbd, 0x82, 0x39,
+	0x34, 0xd2, 0xbe, 0xe7, 0x86, 0x9e, 0x40, 0x23, 0x17, 0xd0, 0x84, 0x4d, 0xea, 0x59, 0xb2, 0xc9,
+	0x7f, 0x72, 0x36, 0xd4, 0x0b, 0x09, 0x8b, 0x75, 0xcf, 0xba, 0x82, 0xbd, 0x42, 0xc2, 0x5e, 0xdd,
+	0xb3, 0x92, 0x11, 0xba, 0xe3, 0x08, 0xe7, 0x67, 0x44, 0xd8, 0xb8, 0x5a, 0x84, 0x8d, 0x64, 0x84,
+	0x0d, 0x15, 0xe1, 0xd6, 0x1f, 0x53, 0xb0, 0xa1, 0xfe, 0x14, 0xc1, 0xb4, 0xef, 0x87, 0x0e, 0xf3,
+	0x83, 0x53, 0x71, 0xe5, 0x7d, 0x06, 0xd9, 0xf8, 0xdb, 0x26, 0xf2, 0x60, 0x61, 0xf4, 0xa8, 0x59,
+	0xf0, 0xd4, 0x6b, 0xe6, 0xd6, 0xe5, 0xf6, 0x23, 0x14, 0x96, 0x18, 0xf4, 0x01, 0xe4, 0x02, 0xf2,
+	0x98, 0x99, 0x83, 0xc0, 0x95, 0xdf, 0x0b, 0x1b, 0xfc, 0xc6, 0xc0, 0xe4, 0x31, 0x3b, 0xc2, 0x0d,
+	0xfe, 0x18, 0x09, 0xa2, 0x25, 0x8e, 0x16, 0x81, 0x2b, 0x20, 0xfd, 0x8e, 0xc9, 0xdf, 0x39, 0xc5,
+	0x74, 0x0c, 0x72, 0xb0, 0xbb, 0x63, 0x59, 0x81, 0x80, 0xf4, 0x3b, 0x7c, 0x89, 0xd5, 0x02, 0x6d,
+	0xc1, 0x82, 0x2b, 0xa6, 0x81, 0x38, 0xb1, 0x5c, 0xf4, 0x34, 0x8f, 0x28, 0x58, 0xfe, 0xa2, 0x1f,
+	0x42, 0xd6, 0xa5, 0x24, 0xf0, 0x68, 0x20, 0xd2, 0x9c, 0x33, 0xf2, 0x5c, 0x95, 0x24, 0x61, 0xb5,
+	0x78, 0xf7, 0x77, 0xda, 0xe8, 0x5b, 0x7a, 0xfc, 0xc7, 0x02, 0xfa, 0x31, 0x7c, 0xaf, 0x75, 0xf8,
+	0x10, 0xef, 0xec, 0xd5, 0xcd, 0xe6, 0xc3, 0x5a, 0xdd, 0x6c, 0x1d, 0xee, 0x1c, 0x1e, 0xb5, 0x4c,
+	0x7c, 0xd4, 0x6c, 0xee, 0x37, 0xf7, 0x0a, 0x73, 0xa5, 0x1b, 0x4f, 0x9f, 0x97, 0x8b, 0x53, 0x38,
+	0x3c, 0xf0, 0x3c, 0xc7, 0xb3, 0x2f, 0x82, 0xd7, 0xea, 0x8d, 0xfa, 0x61, 0xbd, 0x56, 0xd0, 0x2e,
+	0x80, 0xd7, 0xa8, 0x4b, 0x19, 0xb5, 0x4a, 0x99, 0xdf, 0xfc, 0x61, 0x73, 0xee, 0xdd, 0x67, 0x29,
+	0x31, 0x2d, 0xe3, 0x1f, 0xa0, 0xe8, 0x03, 0x58, 0x6b, 0xb4, 0xa6, 0xbd, 0x29, 0x3d, 0x7d, 0x5e,
+	0xde, 0x98, 0x90, 0x55, 0xbe, 0x24, 0x20, 0xad, 0xfa, 0x4e, 0x83, 0x43, 0xb4, 0x99, 0x90, 0x16,
+	0x25, 0x2e, 0x87, 0x54, 0xa1, 0x90, 0x84, 0xd4, 0x6b, 0x85, 0x54, 0xe9, 0xfa, 0xd3, 0xe7, 0xe5,
+	0xb7, 0x66, 0x20, 0xa8, 0x95, 0xb4, 0xa1, 0xa2, 0x4c, 0xcf, 0xb4, 0x21, 0x63, 0x44, 0x1f, 0xc1,
+	0xb5, 0x31, 0xe4, 0xa8, 0xa9, 0x1c, 0xcb, 0x44, 0xa9, 0x99, 0x00, 0x1d, 0x79, 0x61, 0xe4, 0x9a,
+	0x4c, 0xcd, 0xe7, 0x90, 0x8f, 0x7d, 0x99, 0xa1, 0xf7, 0x61, 0xfd, 0xf0, 0xe1, 0xc1, 0xfe, 0xee,
+	0x74, 0x62, 0x36, 0x9e, 0x3e, 0x2f, 0xa3, 0x98, 0xa8, 0x4a, 0xca, 0x24, 0x62, 0x7c, 0x32, 0x93,
+	0x88, 0xc4, 0x99, 0x18, 0xf7, 0x5e, 0x9c, 0x6d, 0x6a, 0x2f, 0xcf, 0x36, 0xb5, 0x2f, 0x5e, 0x6d,
+	0xce, 0x7d, 0xf9, 0x6a, 0x53, 0x7b, 0xf9, 0x6a, 0x73, 0xee, 0x6f, 0xaf, 0x36, 0xe7, 0x7e, 0x7e,
+	0x71, 0xab, 0x26, 0xfe, 0x3b, 0x6d, 0x2f, 0x88, 0xfd, 0x87, 0xff, 0x1e, 0x00, 0x0a, 0x93, 0xf7,
+	0xfd, 0x54, 0x15, 0x00, 0x00,
 }
 
 func (this *MetadataDescriptor) Equal(that interface{}) bool {
@@ -1178,6 +1433,14 @@ func (this *StorageNodeDescriptor) Equal(that interface{}) bool {
 	if !this.CreateTime.Equal(that1.CreateTime) {
 		return false
 	}
+	if len(this.Tags) != len(that1.Tags) {
+		return false
+	}
+	for i := range this.Tags {
+		if this.Tags[i] != that1.Tags[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *StorageDescriptor) Equal(that interface{}) bool {
@@ -1311,6 +1574,39 @@ func (this *TopicDescriptor) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.RetentionPolicy.Equal(that1.RetentionPolicy) {
+		return false
+	}
+	return true
+}
+func (this *RetentionPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RetentionPolicy)
+	if !ok {
+		that2, ok := that.(RetentionPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxAge != that1.MaxAge {
+		return false
+	}
+	if this.MaxBytes != that1.MaxBytes {
+		return false
+	}
+	if this.MaxEntries != that1.MaxEntries {
+		return false
+	}
 	return true
 }
 func (this *StorageNode) Equal(that interface{}) bool {
@@ -1479,6 +1775,81 @@ func (this *LogEntry) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Data, that1.Data) {
 		return false
 	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if !bytes.Equal(this.Headers[i], that1.Headers[i]) {
+			return false
+		}
+	}
+	if this.Checksum != that1.Checksum {
+		return false
+	}
+	return true
+}
+func (this *LogEntryHeaders) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LogEntryHeaders)
+	if !ok {
+		that2, ok := that.(LogEntryHeaders)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !bytes.Equal(this.Values[i], that1.Values[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ProducerState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProducerState)
+	if !ok {
+		that2, ok := that.(ProducerState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProducerID != that1.ProducerID {
+		return false
+	}
+	if this.LastBatchSequence != that1.LastBatchSequence {
+		return false
+	}
+	if len(this.LastBatch) != len(that1.LastBatch) {
+		return false
+	}
+	for i := range this.LastBatch {
+		if !this.LastBatch[i].Equal(&that1.LastBatch[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *MetadataDescriptor) Marshal() (dAtA []byte, err error) {
@@ -1571,6 +1942,25 @@ func (m *StorageNodeDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Tags) > 0 {
+		for k := range m.Tags {
+			v := m.Tags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintMetadata(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMetadata(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMetadata(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime):])
 	if err1 != nil {
 		return 0, err1
@@ -1760,22 +2150,34 @@ func (m *TopicDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetentionPolicy != nil {
+		{
+			size, err := m.RetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.LogStreams) > 0 {
-		dAtA4 := make([]byte, len(m.LogStreams)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.LogStreams)*10)
+		var j4 int
 		for _, num1 := range m.LogStreams {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintMetadata(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintMetadata(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1792,7 +2194,7 @@ func (m *TopicDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *StorageNode) Marshal() (dAtA []byte, err error) {
+func (m *RetentionPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1802,32 +2204,38 @@ func (m *StorageNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StorageNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *RetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StorageNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RetentionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Address)))
+	if m.MaxEntries != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxEntries))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if m.StorageNodeID != 0 {
-		i = encodeVarintMetadata(dAtA, i, uint64(m.StorageNodeID))
+	if m.MaxBytes != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxBytes))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
 	}
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAge):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintMetadata(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TopicLogStream) Marshal() (dAtA []byte, err error) {
+func (m *StorageNode) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1837,19 +2245,54 @@ func (m *TopicLogStream) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TopicLogStream) MarshalTo(dAtA []byte) (int, error) {
+func (m *StorageNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TopicLogStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StorageNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintMetadata(dAtA, i, uint64(m.LogStreamID))
-		i--
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicLogStream) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicLogStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicLogStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStreamID != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.LogStreamID))
+		i--
 		dAtA[i] = 0x10
 	}
 	if m.TopicID != 0 {
@@ -1999,6 +2442,33 @@ func (m *LogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Checksum != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.Checksum))
+		i--
+		dAtA[i] = 0x25
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintMetadata(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMetadata(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMetadata(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
@@ -2019,6 +2489,99 @@ func (m *LogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LogEntryHeaders) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogEntryHeaders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogEntryHeaders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for k := range m.Values {
+			v := m.Values[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintMetadata(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMetadata(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMetadata(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProducerState) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProducerState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProducerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastBatch) > 0 {
+		for iNdEx := len(m.LastBatch) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastBatch[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastBatchSequence != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.LastBatchSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProducerID) > 0 {
+		i -= len(m.ProducerID)
+		copy(dAtA[i:], m.ProducerID)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.ProducerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommitContext) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2189,6 +2752,14 @@ func (m *StorageNodeDescriptor) ProtoSize() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime)
 	n += 1 + l + sovMetadata(uint64(l))
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMetadata(uint64(len(k))) + 1 + len(v) + sovMetadata(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMetadata(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -2274,6 +2845,27 @@ func (m *TopicDescriptor) ProtoSize() (n int) {
 		}
 		n += 1 + sovMetadata(uint64(l)) + l
 	}
+	if m.RetentionPolicy != nil {
+		l = m.RetentionPolicy.ProtoSize()
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *RetentionPolicy) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAge)
+	n += 1 + l + sovMetadata(uint64(l))
+	if m.MaxBytes != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxBytes))
+	}
+	if m.MaxEntries != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxEntries))
+	}
 	return n
 }
 
@@ -2369,6 +2961,64 @@ func (m *LogEntry) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetadata(uint64(l))
 	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovMetadata(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovMetadata(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMetadata(uint64(mapEntrySize))
+		}
+	}
+	if m.Checksum != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *LogEntryHeaders) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for k, v := range m.Values {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovMetadata(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovMetadata(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovMetadata(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ProducerState) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProducerID)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if m.LastBatchSequence != 0 {
+		n += 1 + sovMetadata(uint64(m.LastBatchSequence))
+	}
+	if len(m.LastBatch) > 0 {
+		for _, e := range m.LastBatch {
+			l = e.ProtoSize()
+			n += 1 + l + sovMetadata(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2745,6 +3395,133 @@ func (m *StorageNodeDescriptor) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMetadata(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Tags[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -3303,14 +4080,171 @@ func (m *TopicDescriptor) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetadata(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPolicy", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMetadata
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetentionPolicy == nil {
+				m.RetentionPolicy = &RetentionPolicy{}
+			}
+			if err := m.RetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetentionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetentionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -3939,6 +4873,457 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMetadata(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			m.Checksum = 0
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogEntryHeaders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogEntryHeaders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogEntryHeaders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Values == nil {
+				m.Values = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMetadata(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthMetadata
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Values[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProducerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProducerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProducerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProducerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBatchSequence", wireType)
+			}
+			m.LastBatchSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBatchSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastBatch = append(m.LastBatch, LogEntryMeta{})
+			if err := m.LastBatch[len(m.LastBatch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -0,0 +1,7 @@
+package varlogpb
+
+// LastSequence returns the sequence number of the last log entry appended by
+// the producer.
+func (ps ProducerState) LastSequence() uint64 {
+	return ps.LastBatchSequence + uint64(len(ps.LastBatch)) - 1
+}
@@ -2,8 +2,11 @@ package cluster
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -11,10 +14,19 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/kakao/varlog/internal/admin"
+	"github.com/kakao/varlog/internal/admin/retention"
+	"github.com/kakao/varlog/pkg/schema"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/testutil"
 	"github.com/kakao/varlog/pkg/varlog"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 	"github.com/kakao/varlog/tests/it"
 )
@@ -110,15 +122,1392 @@ func TestClientAppendToSubscribeTo(t *testing.T) {
 	require.NoError(t, subscriber.Close())
 }
 
-func TestClientAppendTo(t *testing.T) {
-	// defer goleak.VerifyNone(t)
+func TestClientAppendTo(t *testing.T) {
+	// defer goleak.VerifyNone(t)
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(3),
+		it.WithNumberOfStorageNodes(3),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	// FIXME: remove this ugly code
+	topicID := clus.TopicIDs()[0]
+	lsID := clus.LogStreamID(t, topicID, 0)
+	client := clus.ClientAtIndex(t, 0)
+
+	res := client.AppendTo(context.TODO(), topicID, lsID+1, [][]byte{[]byte("foo")})
+	require.Error(t, res.Err)
+
+	res = client.AppendTo(context.TODO(), topicID, lsID, [][]byte{[]byte("foo")})
+	require.NoError(t, res.Err)
+
+	le, err := client.Read(context.Background(), topicID, res.Metadata[0].GLSN)
+	require.NoError(t, err)
+	require.Equal(t, res.Metadata[0], le.LogEntryMeta)
+	require.EqualValues(t, []byte("foo"), le.Data)
+
+	le, err = client.ReadFrom(context.Background(), topicID, lsID, res.Metadata[0].LLSN)
+	require.NoError(t, err)
+	require.Equal(t, res.Metadata[0], le.LogEntryMeta)
+	require.EqualValues(t, []byte("foo"), le.Data)
+
+	_, err = client.Read(context.Background(), topicID, res.Metadata[0].GLSN+1)
+	require.ErrorIs(t, err, verrors.ErrNoEntry)
+
+	_, err = client.ReadFrom(context.Background(), topicID, lsID, res.Metadata[0].LLSN+1)
+	require.ErrorIs(t, err, verrors.ErrNoEntry)
+}
+
+func TestClientAppend(t *testing.T) {
+	// defer goleak.VerifyNone(t)
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(1),
+		it.WithNumberOfStorageNodes(3),
+		it.WithNumberOfLogStreams(3),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	expectedLLSNs := make(map[types.LogStreamID]types.LLSN, clus.NumberOfLogStreams(topicID))
+	for _, lsID := range clus.LogStreamIDs(topicID) {
+		expectedLLSNs[lsID] = types.MinLLSN
+	}
+	expectedGLSN := types.MinGLSN
+
+	for _, logStreamID := range clus.LogStreamIDs(topicID) {
+		res := client.AppendTo(context.Background(), topicID, logStreamID, [][]byte{nil})
+		require.NoError(t, res.Err)
+		lem := res.Metadata[0]
+		require.Equal(t, expectedGLSN, lem.GLSN)
+		expectedGLSN++
+		require.Equal(t, expectedLLSNs[lem.LogStreamID], lem.LLSN)
+		expectedLLSNs[lem.LogStreamID]++
+		require.Equal(t, topicID, lem.TopicID)
+		require.Equal(t, logStreamID, lem.LogStreamID)
+	}
+
+	for i := 0; i < 10; i++ {
+		res := client.Append(context.TODO(), topicID, [][]byte{[]byte("foo")})
+		require.NoError(t, res.Err)
+		lem := res.Metadata[0]
+		require.Equal(t, expectedGLSN, lem.GLSN)
+		expectedGLSN++
+		require.Equal(t, expectedLLSNs[lem.LogStreamID], lem.LLSN)
+		expectedLLSNs[lem.LogStreamID]++
+		require.Equal(t, topicID, lem.TopicID)
+	}
+
+	le, err := client.Read(context.Background(), topicID, types.MinGLSN)
+	require.NoError(t, err)
+	require.Equal(t, types.MinGLSN, le.GLSN)
+	require.Equal(t, types.MinLLSN, le.LLSN)
+
+	for _, logStreamID := range clus.LogStreamIDs(topicID) {
+		first, last, err := client.PeekLogStream(context.Background(), topicID, logStreamID)
+		require.NoError(t, err)
+		require.Equal(t, types.MinLLSN, first.LLSN)
+		require.GreaterOrEqual(t, first.GLSN, types.MinGLSN)
+		require.GreaterOrEqual(t, last.LLSN, types.MinLLSN)
+		require.GreaterOrEqual(t, last.GLSN, types.MinGLSN)
+	}
+}
+
+func TestClientReadBatch(t *testing.T) {
+	const numLogs = 10
+
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(2),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(3),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	var lems []varlogpb.LogEntryMeta
+	for i := 0; i < numLogs; i++ {
+		res := client.Append(context.Background(), topicID, [][]byte{[]byte(fmt.Sprintf("log-%d", i))})
+		require.NoError(t, res.Err)
+		lems = append(lems, res.Metadata[0])
+	}
+
+	results, err := client.ReadBatch(context.Background(), topicID, nil)
+	require.NoError(t, err)
+	require.Empty(t, results)
+
+	glsns := []types.GLSN{types.GLSN(numLogs + 1), types.InvalidGLSN}
+	for i := numLogs - 1; i >= 0; i-- {
+		glsns = append(glsns, lems[i].GLSN)
+	}
+	results, err = client.ReadBatch(context.Background(), topicID, glsns)
+	require.NoError(t, err)
+	require.Len(t, results, len(glsns))
+	require.ErrorIs(t, results[0].Err, verrors.ErrNoEntry)
+	require.ErrorIs(t, results[1].Err, verrors.ErrInvalid)
+	for i, result := range results[2:] {
+		idx := numLogs - 1 - i
+		require.NoError(t, result.Err)
+		require.Equal(t, lems[idx], result.LogEntryMeta)
+		require.Equal(t, []byte(fmt.Sprintf("log-%d", idx)), result.Data)
+	}
+}
+
+func TestClientAppendWithHeaders(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(2),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	logStreamID := clus.LogStreamIDs(topicID)[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	headers := map[string][]byte{"key": []byte("value")}
+	dataBatch := [][]byte{[]byte("foo"), []byte("bar")}
+
+	// unmatched headers
+	res := client.Append(context.Background(), topicID, dataBatch, varlog.WithHeaders(headers))
+	require.ErrorIs(t, res.Err, verrors.ErrInvalid)
+
+	res = client.Append(context.Background(), topicID, dataBatch, varlog.WithHeaders(headers, nil))
+	require.NoError(t, res.Err)
+	require.Len(t, res.Metadata, len(dataBatch))
+
+	checkHeaders := func(le varlogpb.LogEntry) {
+		if le.LLSN == res.Metadata[0].LLSN {
+			require.Equal(t, headers, le.Headers)
+		} else {
+			require.Empty(t, le.Headers)
+		}
+	}
+
+	for _, lem := range res.Metadata {
+		le, err := client.Read(context.Background(), topicID, lem.GLSN)
+		require.NoError(t, err)
+		checkHeaders(le)
+	}
+
+	subscriber := client.SubscribeTo(context.Background(), topicID, logStreamID, types.MinLLSN, types.LLSN(len(dataBatch)+1))
+	for range dataBatch {
+		le, err := subscriber.Next()
+		require.NoError(t, err)
+		checkHeaders(le)
+	}
+	require.NoError(t, subscriber.Close())
+
+	logEntryC := make(chan varlogpb.LogEntry, len(dataBatch))
+	closer, err := client.Subscribe(context.Background(), topicID, types.MinGLSN, types.GLSN(len(dataBatch)+1), func(le varlogpb.LogEntry, err error) {
+		if err != nil {
+			assert.ErrorIs(t, err, io.EOF)
+			close(logEntryC)
+			return
+		}
+		logEntryC <- le
+	})
+	require.NoError(t, err)
+	defer closer()
+
+	numLogEntries := 0
+	for le := range logEntryC {
+		checkHeaders(le)
+		numLogEntries++
+	}
+	require.Equal(t, len(dataBatch), numLogEntries)
+}
+
+func TestClientAppendWithProducer(t *testing.T) {
+	const producerID = "producer"
+
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(2),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	logStreamID := clus.LogStreamIDs(topicID)[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	dataBatch := [][]byte{[]byte("foo"), []byte("bar")}
+	res := client.AppendTo(context.Background(), topicID, logStreamID, dataBatch, varlog.WithProducer(producerID, 1))
+	require.NoError(t, res.Err)
+	require.Len(t, res.Metadata, len(dataBatch))
+
+	// The retried batch returns the original metadata.
+	retried := client.AppendTo(context.Background(), topicID, logStreamID, dataBatch, varlog.WithProducer(producerID, 1))
+	require.NoError(t, retried.Err)
+	require.Equal(t, res.Metadata, retried.Metadata)
+
+	res = client.AppendTo(context.Background(), topicID, logStreamID, [][]byte{[]byte("baz")}, varlog.WithProducer(producerID, 3))
+	require.NoError(t, res.Err)
+	require.Len(t, res.Metadata, 1)
+
+	res = client.AppendTo(context.Background(), topicID, logStreamID, dataBatch, varlog.WithProducer(producerID, 1))
+	require.ErrorIs(t, res.Err, verrors.ErrDuplicateSequence)
+
+	// Duplicates are not stored.
+	subscriber := client.SubscribeTo(context.Background(), topicID, logStreamID, types.MinLLSN, types.LLSN(4))
+	for _, data := range [][]byte{[]byte("foo"), []byte("bar"), []byte("baz")} {
+		le, err := subscriber.Next()
+		require.NoError(t, err)
+		require.Equal(t, data, le.Data)
+	}
+	require.NoError(t, subscriber.Close())
+
+	first, last, err := client.PeekLogStream(context.Background(), topicID, logStreamID)
+	require.NoError(t, err)
+	require.Equal(t, types.MinLLSN, first.LLSN)
+	require.Equal(t, types.LLSN(3), last.LLSN)
+}
+
+func TestClientAppendWithPartitionKey(t *testing.T) {
+	const (
+		numKeys    = 10
+		numAppends = 5
+	)
+
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(1),
+		it.WithNumberOfStorageNodes(1),
+		it.WithNumberOfLogStreams(3),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	logStreamIDs := clus.LogStreamIDs(topicID)
+	client := clus.ClientAtIndex(t, 0)
+
+	for i := 0; i < numKeys; i++ {
+		key := []byte("customer-" + strconv.Itoa(i))
+		var lsid types.LogStreamID
+		var lastLLSN types.LLSN
+		for j := 0; j < numAppends; j++ {
+			res := client.Append(context.Background(), topicID, [][]byte{key}, varlog.WithPartitionKey(key))
+			require.NoError(t, res.Err)
+			require.Len(t, res.Metadata, 1)
+			if j == 0 {
+				lsid = res.Metadata[0].LogStreamID
+			}
+			require.Equal(t, lsid, res.Metadata[0].LogStreamID)
+			require.Greater(t, res.Metadata[0].LLSN, lastLLSN)
+			lastLLSN = res.Metadata[0].LLSN
+		}
+	}
+
+	// The key maps to one of the allowed log streams.
+	allowed := map[types.LogStreamID]struct{}{logStreamIDs[0]: {}}
+	for i := 0; i < numKeys; i++ {
+		key := []byte("customer-" + strconv.Itoa(i))
+		res := client.Append(context.Background(), topicID, [][]byte{key}, varlog.WithPartitionKey(key), varlog.WithAllowedLogStreams(allowed))
+		require.NoError(t, res.Err)
+		require.Equal(t, logStreamIDs[0], res.Metadata[0].LogStreamID)
+	}
+}
+
+func TestClientConsumerGroup(t *testing.T) {
+	const (
+		group   = "group"
+		numLogs = 10
+	)
+
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(1),
+		it.WithNumberOfStorageNodes(1),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(2),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	logStreamID := clus.LogStreamIDs(topicID)[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	for i := 0; i < numLogs; i++ {
+		res := client.Append(context.Background(), topicID, [][]byte{[]byte(strconv.Itoa(i))})
+		require.NoError(t, res.Err)
+	}
+
+	subscribeGroup := func(client varlog.Log) []types.GLSN {
+		var glsns []types.GLSN
+		doneC := make(chan struct{})
+		closer, err := client.SubscribeGroup(context.Background(), group, topicID, types.GLSN(numLogs+1), func(le varlogpb.LogEntry, err error) {
+			if err != nil {
+				assert.ErrorIs(t, err, io.EOF)
+				close(doneC)
+				return
+			}
+			glsns = append(glsns, le.GLSN)
+		})
+		require.NoError(t, err)
+		defer closer()
+		<-doneC
+		return glsns
+	}
+
+	_, err := client.FetchOffset(context.Background(), group, topicID)
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+
+	// The group without committed offsets starts from the first log entry.
+	glsns := subscribeGroup(client)
+	require.Len(t, glsns, numLogs)
+	require.Equal(t, types.MinGLSN, glsns[0])
+
+	err = client.CommitOffset(context.Background(), group, topicID, glsns[4])
+	require.NoError(t, err)
+	err = client.CommitOffset(context.Background(), group, topicID+1, glsns[4])
+	require.Error(t, err)
+
+	// Another client resumes from the committed offset.
+	other := clus.ClientAtIndex(t, 1)
+	glsn, err := other.FetchOffset(context.Background(), group, topicID)
+	require.NoError(t, err)
+	require.Equal(t, glsns[4], glsn)
+	require.Equal(t, glsns[5:], subscribeGroup(other))
+
+	_, err = other.FetchLogStreamOffset(context.Background(), group, topicID, logStreamID)
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+	err = other.CommitLogStreamOffset(context.Background(), group, topicID, logStreamID, types.LLSN(3))
+	require.NoError(t, err)
+	llsn, err := client.FetchLogStreamOffset(context.Background(), group, topicID, logStreamID)
+	require.NoError(t, err)
+	require.Equal(t, types.LLSN(3), llsn)
+
+	// Deleting the group removes all its offsets.
+	err = client.DeleteConsumerGroup(context.Background(), group)
+	require.NoError(t, err)
+	_, err = other.FetchOffset(context.Background(), group, topicID)
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+	_, err = other.FetchLogStreamOffset(context.Background(), group, topicID, logStreamID)
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+	err = other.DeleteConsumerGroup(context.Background(), group)
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+}
+
+func TestClientGroupConsumer(t *testing.T) {
+	const (
+		group             = "group"
+		numLogs           = 10
+		heartbeatInterval = 100 * time.Millisecond
+	)
+
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(1),
+		it.WithNumberOfStorageNodes(1),
+		it.WithNumberOfLogStreams(2),
+		it.WithNumberOfClients(2),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	adm := clus.GetVMSClient(t)
+
+	var (
+		mu       sync.Mutex
+		consumed = make(map[types.LogStreamID]types.LLSN)
+	)
+	onNext := func(le varlogpb.LogEntry) {
+		mu.Lock()
+		defer mu.Unlock()
+		consumed[le.LogStreamID] = le.LLSN
+	}
+	appendLogs := func() {
+		client := clus.ClientAtIndex(t, 0)
+		for _, lsid := range clus.LogStreamIDs(topicID) {
+			for i := 0; i < numLogs; i++ {
+				res := client.AppendTo(context.Background(), topicID, lsid, [][]byte{nil})
+				require.NoError(t, res.Err)
+			}
+		}
+	}
+	consumedAll := func(llsn types.LLSN) func() bool {
+		return func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			for _, lsid := range clus.LogStreamIDs(topicID) {
+				if consumed[lsid] != llsn {
+					return false
+				}
+			}
+			return true
+		}
+	}
+
+	var gcs []varlog.GroupConsumer
+	for i := 0; i < 2; i++ {
+		gc, err := varlog.NewGroupConsumer(clus.ClientAtIndex(t, i), adm, group, topicID, onNext,
+			varlog.WithGroupMemberID(strconv.Itoa(i)),
+			varlog.WithGroupHeartbeatInterval(heartbeatInterval),
+			varlog.WithGroupCommitInterval(heartbeatInterval),
+		)
+		require.NoError(t, err)
+		gcs = append(gcs, gc)
+	}
+	defer func() {
+		for _, gc := range gcs {
+			require.NoError(t, gc.Close())
+		}
+	}()
+
+	// Each member consumes one log stream.
+	require.Eventually(t, func() bool {
+		return len(gcs[0].Assignment()) == 1 && len(gcs[1].Assignment()) == 1
+	}, 10*time.Second, heartbeatInterval)
+	appendLogs()
+	require.Eventually(t, consumedAll(numLogs), 10*time.Second, heartbeatInterval)
+
+	// A new log stream is assigned to one of the members.
+	clus.AddLS(t, topicID)
+	require.Eventually(t, func() bool {
+		return len(gcs[0].Assignment())+len(gcs[1].Assignment()) == 3
+	}, 10*time.Second, heartbeatInterval)
+	appendLogs()
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		lsids := clus.LogStreamIDs(topicID)
+		return consumed[lsids[0]] == 2*numLogs && consumed[lsids[1]] == 2*numLogs && consumed[lsids[2]] == numLogs
+	}, 10*time.Second, heartbeatInterval)
+
+	// The remaining member takes over all log streams, and resumes from the
+	// offsets committed by the member that left.
+	require.NoError(t, gcs[0].Close())
+	require.Eventually(t, func() bool {
+		return len(gcs[1].Assignment()) == 3
+	}, 10*time.Second, heartbeatInterval)
+	appendLogs()
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		lsids := clus.LogStreamIDs(topicID)
+		return consumed[lsids[0]] == 3*numLogs && consumed[lsids[1]] == 3*numLogs && consumed[lsids[2]] == 2*numLogs
+	}, 10*time.Second, heartbeatInterval)
+}
+
+func TestClientWatchMetadata(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(1),
+		it.WithNumberOfStorageNodes(1),
+		it.WithNumberOfLogStreams(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+
+	watching, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(),
+		varlog.WithMetadataRefreshInterval(time.Hour),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, watching.Close())
+	}()
+
+	polling, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(),
+		varlog.WithMetadataRefreshInterval(time.Hour),
+		varlog.WithoutMetadataWatch(),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, polling.Close())
+	}()
+
+	lsid := clus.AddLS(t, topicID)
+
+	// The client watching metadata learns about the new log stream without
+	// waiting for the next refresh.
+	require.Eventually(t, func() bool {
+		res := watching.AppendTo(context.Background(), topicID, lsid, [][]byte{nil})
+		return res.Err == nil
+	}, 5*time.Second, 100*time.Millisecond)
+
+	res := polling.AppendTo(context.Background(), topicID, lsid, [][]byte{nil})
+	require.Error(t, res.Err)
+}
+
+func TestClientBatcher(t *testing.T) {
+	const numLogs = 100
+
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(2),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	logStreamID := clus.LogStreamIDs(topicID)[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	_, err := client.NewBatcher(topicID, varlog.WithBatcherPipelineSize(0))
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	batcher, err := client.NewBatcher(topicID,
+		varlog.WithBatcherMaxRecords(7),
+		varlog.WithBatcherLinger(time.Millisecond),
+		varlog.WithBatcherPipelineSize(3),
+	)
+	require.NoError(t, err)
+
+	headers := map[string][]byte{"key": []byte("value")}
+	var metas []varlogpb.LogEntryMeta
+	for i := 0; i < numLogs; i++ {
+		var hdrs map[string][]byte
+		if i%2 == 0 {
+			hdrs = headers
+		}
+		err := batcher.AppendAsync([]byte(strconv.Itoa(i)), hdrs, func(meta varlogpb.LogEntryMeta, err error) {
+			assert.NoError(t, err)
+			metas = append(metas, meta)
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, batcher.Flush(context.Background()))
+	require.NoError(t, batcher.Close())
+
+	// Log entries are appended in the order of AppendAsync calls.
+	require.Len(t, metas, numLogs)
+	for i, meta := range metas {
+		require.Equal(t, logStreamID, meta.LogStreamID)
+		require.Equal(t, types.LLSN(i+1), meta.LLSN)
+
+		le, err := client.ReadFrom(context.Background(), topicID, logStreamID, meta.LLSN)
+		require.NoError(t, err)
+		require.Equal(t, []byte(strconv.Itoa(i)), le.Data)
+		if i%2 == 0 {
+			require.Equal(t, headers, le.Headers)
+		} else {
+			require.Empty(t, le.Headers)
+		}
+	}
+
+	err = batcher.AppendAsync([]byte("closed"), nil, nil)
+	require.ErrorIs(t, err, verrors.ErrClosed)
+
+	// no such log stream
+	batcher, err = client.NewBatcher(topicID, varlog.WithBatcherLogStream(logStreamID+1), varlog.WithBatcherLinger(0))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, batcher.Close())
+	}()
+	var appendErr error
+	require.NoError(t, batcher.AppendAsync([]byte("foo"), nil, func(_ varlogpb.LogEntryMeta, err error) {
+		appendErr = err
+	}))
+	require.NoError(t, batcher.Flush(context.Background()))
+	require.Error(t, appendErr)
+}
+
+func TestClientAppendCancel(t *testing.T) {
+	// defer goleak.VerifyNone(t)
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(1),
+		it.WithNumberOfStorageNodes(1),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	var (
+		atomicGLSN types.AtomicGLSN
+		wg         sync.WaitGroup
+	)
+	wg.Add(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		defer wg.Done()
+		expectedGLSN := types.MinGLSN
+		for {
+			res := client.Append(ctx, topicID, [][]byte{[]byte("foo")})
+			if res.Err == nil {
+				require.Equal(t, expectedGLSN, res.Metadata[0].GLSN)
+				expectedGLSN++
+				atomicGLSN.Store(res.Metadata[0].GLSN)
+			} else {
+				t.Logf("canceled")
+				return
+			}
+		}
+	}()
+
+	for atomicGLSN.Load() < 10 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	wg.Wait()
+}
+
+func TestClientSubscribe(t *testing.T) {
+	//defer goleak.VerifyNone(t)
+	const (
+		batchSize = 10
+		appendCnt = 10
+		nrLogs    = batchSize * appendCnt
+	)
+
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(3),
+		it.WithNumberOfLogStreams(3),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	newMsg := func(glsn types.GLSN) string {
+		return fmt.Sprintf("msg-%03d", glsn)
+	}
+
+	issuedGLSN := types.InvalidGLSN
+	topicID := clus.TopicIDs()[0]
+	client := clus.ClientAtIndex(t, 0)
+	for i := 0; i < appendCnt; i++ {
+		batch := make([][]byte, batchSize)
+		for j := 0; j < batchSize; j++ {
+			issuedGLSN++
+			msg := newMsg(issuedGLSN)
+			batch[j] = []byte(msg)
+		}
+		res := client.Append(context.TODO(), topicID, batch)
+		require.NoError(t, res.Err)
+		require.Len(t, res.Metadata, batchSize)
+		require.Equal(t, issuedGLSN, res.Metadata[len(res.Metadata)-1].GLSN)
+		require.Equal(t, issuedGLSN-types.GLSN(batchSize)+1, res.Metadata[0].GLSN)
+	}
+
+	errc := make(chan error, nrLogs)
+	expectedGLSN := types.MinGLSN
+	subscribeCloser, err := client.Subscribe(context.TODO(), topicID, types.GLSN(1), types.GLSN(nrLogs+1), func(le varlogpb.LogEntry, err error) {
+		if err != nil {
+			require.ErrorIs(t, io.EOF, err)
+			close(errc)
+			return
+		}
+		assert.Equal(t, expectedGLSN, le.GLSN)
+		expectedMsg := newMsg(expectedGLSN)
+		require.Equal(t, expectedMsg, string(le.Data))
+		expectedGLSN++
+		errc <- err
+	})
+	require.NoError(t, err)
+	defer subscribeCloser()
+
+	for e := range errc {
+		if e != nil {
+			require.ErrorIs(t, io.EOF, e)
+		}
+	}
+}
+
+func TestClientSubscribeIter(t *testing.T) {
+	const (
+		batchSize  = 10
+		appendCnt  = 10
+		nrLogs     = batchSize * appendCnt
+		bufferSize = 4
+	)
+
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(3),
+		it.WithNumberOfLogStreams(3),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	newMsg := func(glsn types.GLSN) string {
+		return fmt.Sprintf("msg-%03d", glsn)
+	}
+
+	issuedGLSN := types.InvalidGLSN
+	topicID := clus.TopicIDs()[0]
+	client := clus.ClientAtIndex(t, 0)
+	for i := 0; i < appendCnt; i++ {
+		batch := make([][]byte, batchSize)
+		for j := 0; j < batchSize; j++ {
+			issuedGLSN++
+			batch[j] = []byte(newMsg(issuedGLSN))
+		}
+		res := client.Append(context.TODO(), topicID, batch)
+		require.NoError(t, res.Err)
+	}
+
+	// invalid range
+	sub := client.SubscribeIter(context.TODO(), topicID, types.GLSN(2), types.GLSN(1))
+	_, err := sub.Next()
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+	require.NoError(t, sub.Close())
+
+	// invalid buffer size
+	sub = client.SubscribeIter(context.TODO(), topicID, types.MinGLSN, types.GLSN(nrLogs+1), varlog.WithSubscribeBufferSize(0))
+	_, err = sub.Next()
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+	require.NoError(t, sub.Close())
+
+	// The consumer pulls log entries slowly.
+	sub = client.SubscribeIter(context.TODO(), topicID, types.MinGLSN, types.GLSN(nrLogs+1), varlog.WithSubscribeBufferSize(bufferSize))
+	for glsn := types.MinGLSN; glsn <= types.GLSN(nrLogs); glsn++ {
+		if glsn <= bufferSize {
+			time.Sleep(10 * time.Millisecond)
+		}
+		le, err := sub.Next()
+		require.NoError(t, err)
+		require.Equal(t, glsn, le.GLSN)
+		require.Equal(t, newMsg(glsn), string(le.Data))
+	}
+	_, err = sub.Next()
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, sub.Close())
+
+	// The consumer closes the subscription while it waits for log entries
+	// not appended yet.
+	sub = client.SubscribeIter(context.TODO(), topicID, types.MinGLSN, types.MaxGLSN, varlog.WithSubscribeBufferSize(bufferSize))
+	le, err := sub.Next()
+	require.NoError(t, err)
+	require.Equal(t, types.MinGLSN, le.GLSN)
+	require.NoError(t, sub.Close())
+	_, err = sub.Next()
+	require.ErrorIs(t, err, verrors.ErrClosed)
+}
+
+func TestClientSubscribeWithFilter(t *testing.T) {
+	const numLogs = 30
+
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(3),
+		it.WithNumberOfLogStreams(3),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	// Every third log entry has the header "type: wanted".
+	wanted := func(glsn types.GLSN) bool {
+		return glsn%3 == 0
+	}
+	for glsn := types.MinGLSN; glsn <= numLogs; glsn++ {
+		headers := map[string][]byte{"type": []byte("unwanted")}
+		if wanted(glsn) {
+			headers["type"] = []byte("wanted")
+		}
+		res := client.Append(context.Background(), topicID, [][]byte{[]byte(fmt.Sprintf("msg-%03d", glsn))}, varlog.WithHeaders(headers))
+		require.NoError(t, res.Err)
+		require.Equal(t, glsn, res.Metadata[0].GLSN)
+	}
+
+	filter := &snpb.SubscribeFilter{
+		Headers:        map[string][]byte{"type": []byte("wanted")},
+		ExcludeHeaders: true,
+	}
+	checkLogEntry := func(le varlogpb.LogEntry) {
+		require.True(t, wanted(le.GLSN))
+		require.Equal(t, fmt.Sprintf("msg-%03d", le.GLSN), string(le.Data))
+		require.Empty(t, le.Headers)
+	}
+
+	// Subscribe
+	var glsns []types.GLSN
+	logEntryC := make(chan varlogpb.LogEntry, numLogs)
+	closer, err := client.Subscribe(context.Background(), topicID, types.MinGLSN, numLogs+1, func(le varlogpb.LogEntry, err error) {
+		if err != nil {
+			assert.ErrorIs(t, err, io.EOF)
+			close(logEntryC)
+			return
+		}
+		logEntryC <- le
+	}, varlog.WithSubscribeFilter(filter))
+	require.NoError(t, err)
+	for le := range logEntryC {
+		checkLogEntry(le)
+		glsns = append(glsns, le.GLSN)
+	}
+	closer()
+	require.Len(t, glsns, numLogs/3)
+
+	// SubscribeIter
+	sub := client.SubscribeIter(context.Background(), topicID, types.MinGLSN, numLogs+1, varlog.WithSubscribeFilter(filter))
+	for _, glsn := range glsns {
+		le, err := sub.Next()
+		require.NoError(t, err)
+		require.Equal(t, glsn, le.GLSN)
+		checkLogEntry(le)
+	}
+	_, err = sub.Next()
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, sub.Close())
+
+	// Log entries not matched are coalesced by storage nodes, and the
+	// subscription moves on past all of them.
+	sub = client.SubscribeIter(context.Background(), topicID, types.MinGLSN, numLogs+1, varlog.WithSubscribeFilter(&snpb.SubscribeFilter{
+		Headers: map[string][]byte{"type": []byte("none")},
+	}))
+	_, err = sub.Next()
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, sub.Close())
+
+	// SubscribeTo
+	// NOTE: Log entries subscribed by SubscribeTo do not have GLSNs.
+	expectedData := make(map[string]bool, len(glsns))
+	for _, glsn := range glsns {
+		expectedData[fmt.Sprintf("msg-%03d", glsn)] = true
+	}
+	numLogEntries := 0
+	for _, lsid := range clus.LogStreamIDs(topicID) {
+		_, last, err := client.PeekLogStream(context.Background(), topicID, lsid)
+		require.NoError(t, err)
+		sub := client.SubscribeTo(context.Background(), topicID, lsid, types.MinLLSN, last.LLSN+1, varlog.WithSubscribeFilter(filter))
+		for {
+			le, err := sub.Next()
+			if err != nil {
+				require.ErrorIs(t, err, io.EOF)
+				break
+			}
+			require.True(t, expectedData[string(le.Data)])
+			require.Empty(t, le.Headers)
+			numLogEntries++
+		}
+		require.NoError(t, sub.Close())
+	}
+	require.Equal(t, numLogs/3, numLogEntries)
+}
+
+func TestClientSeekTime(t *testing.T) {
+	const numLogs = 10
+
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(3),
+		it.WithNumberOfLogStreams(3),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	appendLogs := func() {
+		for i := 0; i < numLogs; i++ {
+			res := client.Append(context.Background(), topicID, [][]byte{[]byte("foo")})
+			require.NoError(t, res.Err)
+		}
+	}
+
+	// No log entry yet.
+	glsn, err := client.SeekTime(context.Background(), topicID, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, types.MinGLSN, glsn)
+
+	appendLogs()
+	time.Sleep(10 * time.Millisecond)
+	pivot := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	appendLogs()
+
+	glsn, err = client.SeekTime(context.Background(), topicID, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, types.MinGLSN, glsn)
+
+	glsn, err = client.SeekTime(context.Background(), topicID, pivot)
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(numLogs+1), glsn)
+
+	// The result can be used as the begin of Subscribe.
+	sub := client.SubscribeIter(context.Background(), topicID, glsn, 2*numLogs+1)
+	for expected := glsn; expected <= 2*numLogs; expected++ {
+		le, err := sub.Next()
+		require.NoError(t, err)
+		require.Equal(t, expected, le.GLSN)
+	}
+	_, err = sub.Next()
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, sub.Close())
+
+	glsn, err = client.SeekTime(context.Background(), topicID, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(2*numLogs+1), glsn)
+}
+
+func TestClientFollow(t *testing.T) {
+	const numLogs = 10
+
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(3),
+		it.WithNumberOfLogStreams(3),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	appendLogs := func() {
+		for i := 0; i < numLogs; i++ {
+			res := client.Append(context.Background(), topicID, [][]byte{[]byte("foo")})
+			require.NoError(t, res.Err)
+		}
+	}
+
+	// No log entry yet.
+	for _, pos := range []varlog.Position{varlog.Earliest, varlog.Latest, varlog.Now} {
+		glsn, err := client.ResolvePosition(context.Background(), topicID, pos)
+		require.NoError(t, err)
+		require.Equal(t, types.MinGLSN, glsn, pos)
+	}
+
+	appendLogs()
+
+	tcs := []struct {
+		pos      varlog.Position
+		expected types.GLSN
+	}{
+		{pos: varlog.Earliest, expected: types.MinGLSN},
+		{pos: varlog.Latest, expected: numLogs},
+		{pos: varlog.Now, expected: numLogs + 1},
+	}
+	for _, tc := range tcs {
+		glsn, err := client.ResolvePosition(context.Background(), topicID, tc.pos)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, glsn, tc.pos)
+	}
+
+	// Follow delivers log entries committed after it starts.
+	glsnC := make(chan types.GLSN, 2*numLogs)
+	closer, err := client.Follow(context.Background(), topicID, varlog.Latest, func(le varlogpb.LogEntry, err error) {
+		if err != nil {
+			return
+		}
+		glsnC <- le.GLSN
+	})
+	require.NoError(t, err)
+	defer closer()
+
+	appendLogs()
+	for expected := types.GLSN(numLogs); expected <= 2*numLogs; expected++ {
+		select {
+		case glsn := <-glsnC:
+			require.Equal(t, expected, glsn)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timeout: glsn %d", expected)
+		}
+	}
+}
+
+func TestClientAppendWithCompression(t *testing.T) {
+	const numLogs = 10
+
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(1),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	logStreamID := clus.LogStreamIDs(topicID)[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	data := func(glsn types.GLSN) []byte {
+		return []byte(fmt.Sprintf("data-%d-%s", glsn, strings.Repeat("x", 128)))
+	}
+	headers := map[string][]byte{"key": []byte("value")}
+
+	// unknown codec
+	res := client.Append(context.Background(), topicID, [][]byte{[]byte("foo")}, varlog.WithCompression(varlog.Compression(-1)))
+	require.ErrorIs(t, res.Err, verrors.ErrInvalid)
+
+	// reserved header
+	res = client.Append(context.Background(), topicID, [][]byte{[]byte("foo")},
+		varlog.WithCompression(varlog.CompressionGzip),
+		varlog.WithHeaders(map[string][]byte{varlog.CompressionHeader: []byte("gzip")}),
+	)
+	require.ErrorIs(t, res.Err, verrors.ErrInvalid)
+
+	// Each batch of two log entries is compressed by a codec.
+	codecs := []varlog.Compression{varlog.CompressionNone, varlog.CompressionGzip, varlog.CompressionZstd, varlog.CompressionSnappy, varlog.CompressionLZ4}
+	for i := 0; i < numLogs; i += 2 {
+		glsn := types.GLSN(i + 1)
+		res := client.Append(context.Background(), topicID, [][]byte{data(glsn), data(glsn + 1)},
+			varlog.WithCompression(codecs[i/2%len(codecs)]),
+			varlog.WithHeaders(headers, headers),
+		)
+		require.NoError(t, res.Err)
+		require.Equal(t, glsn, res.Metadata[0].GLSN)
+		require.Equal(t, glsn+1, res.Metadata[1].GLSN)
+	}
+
+	// The client opened with the default codec compresses log entries
+	// appended by its Batcher.
+	compressing, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(),
+		varlog.WithDefaultCompression(varlog.CompressionZstd),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, compressing.Close())
+	}()
+	batcher, err := compressing.NewBatcher(topicID)
+	require.NoError(t, err)
+	for i := numLogs; i < 2*numLogs; i++ {
+		glsn := types.GLSN(i + 1)
+		err := batcher.AppendAsync(data(glsn), headers, func(meta varlogpb.LogEntryMeta, err error) {
+			assert.NoError(t, err)
+			assert.Equal(t, glsn, meta.GLSN)
+		})
+		require.NoError(t, err)
+		require.NoError(t, batcher.Flush(context.Background()))
+	}
+	require.NoError(t, batcher.Close())
+
+	checkLogEntry := func(logEntry varlogpb.LogEntry) {
+		require.Equal(t, data(logEntry.GLSN), logEntry.Data)
+		require.Equal(t, headers, logEntry.Headers)
+	}
+
+	glsns := make([]types.GLSN, 0, 2*numLogs)
+	for glsn := types.MinGLSN; glsn <= 2*numLogs; glsn++ {
+		logEntry, err := client.Read(context.Background(), topicID, glsn)
+		require.NoError(t, err)
+		checkLogEntry(logEntry)
+		glsns = append(glsns, glsn)
+	}
+
+	results, err := client.ReadBatch(context.Background(), topicID, glsns)
+	require.NoError(t, err)
+	require.Len(t, results, len(glsns))
+	for _, result := range results {
+		require.NoError(t, result.Err)
+		checkLogEntry(result.LogEntry)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	expected := types.MinGLSN
+	closer, err := client.Subscribe(context.Background(), topicID, types.MinGLSN, 2*numLogs+1, func(logEntry varlogpb.LogEntry, err error) {
+		if err == io.EOF {
+			wg.Done()
+			return
+		}
+		if !assert.NoError(t, err) {
+			wg.Done()
+			return
+		}
+		assert.Equal(t, expected, logEntry.GLSN)
+		assert.Equal(t, data(logEntry.GLSN), logEntry.Data)
+		assert.Equal(t, headers, logEntry.Headers)
+		expected++
+	})
+	require.NoError(t, err)
+	wg.Wait()
+	closer()
+	require.EqualValues(t, 2*numLogs+1, expected)
+
+	// Reserved headers are sent even if the filter excludes headers, thus,
+	// the client can decompress data.
+	sub := client.SubscribeIter(context.Background(), topicID, types.MinGLSN, 2*numLogs+1,
+		varlog.WithSubscribeFilter(&snpb.SubscribeFilter{ExcludeHeaders: true}),
+	)
+	for glsn := types.MinGLSN; glsn <= 2*numLogs; glsn++ {
+		logEntry, err := sub.Next()
+		require.NoError(t, err)
+		require.Equal(t, glsn, logEntry.GLSN)
+		require.Equal(t, data(glsn), logEntry.Data)
+		require.Empty(t, logEntry.Headers)
+	}
+	require.NoError(t, sub.Close())
+
+	// Filters on compressed data are rejected.
+	sub = compressing.SubscribeIter(context.Background(), topicID, types.MinGLSN, 2*numLogs+1,
+		varlog.WithSubscribeFilter(&snpb.SubscribeFilter{DataPrefix: []byte("foo")}),
+	)
+	_, err = sub.Next()
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+	require.NoError(t, sub.Close())
+
+	subscriber := client.SubscribeTo(context.Background(), topicID, logStreamID, types.MinLLSN, 2*numLogs+1)
+	for i := 0; i < 2*numLogs; i++ {
+		logEntry, err := subscriber.Next()
+		require.NoError(t, err)
+		// The topic has only one log stream, thus, LLSN equals GLSN.
+		require.Equal(t, data(types.GLSN(logEntry.LLSN)), logEntry.Data)
+		require.Equal(t, headers, logEntry.Headers)
+	}
+	_, err = subscriber.Next()
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, subscriber.Close())
+
+	// Trimming in the middle of a batch leaves the rest of the batch
+	// readable.
+	const trimGLSN = types.GLSN(3)
+	require.NoError(t, client.Trim(context.Background(), topicID, trimGLSN, varlog.TrimOption{}))
+	require.Eventually(t, func() bool {
+		_, err := client.Read(context.Background(), topicID, trimGLSN)
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	logEntry, err := client.Read(context.Background(), topicID, trimGLSN+1)
+	require.NoError(t, err)
+	checkLogEntry(logEntry)
+	sub = client.SubscribeIter(context.Background(), topicID, trimGLSN+1, 2*numLogs+1)
+	for glsn := trimGLSN + 1; glsn <= 2*numLogs; glsn++ {
+		logEntry, err := sub.Next()
+		require.NoError(t, err)
+		require.Equal(t, glsn, logEntry.GLSN)
+		checkLogEntry(logEntry)
+	}
+	require.NoError(t, sub.Close())
+}
+
+func TestClientAppendWithEncryption(t *testing.T) {
+	const numLogs = 10
+
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(1),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	logStreamID := clus.LogStreamIDs(topicID)[0]
+
+	kr, err := varlog.NewKeyRing("k1", []byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+	client, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(),
+		varlog.WithEncryption(kr),
+		varlog.WithDefaultCompression(varlog.CompressionSnappy),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+
+	data := func(glsn types.GLSN) []byte {
+		return []byte(fmt.Sprintf("pii-%d", glsn))
+	}
+	headers := map[string][]byte{"key": []byte("value")}
+
+	for i := 0; i < numLogs; i++ {
+		glsn := types.GLSN(i + 1)
+		res := client.Append(context.Background(), topicID, [][]byte{data(glsn)}, varlog.WithHeaders(headers))
+		require.NoError(t, res.Err)
+		require.Equal(t, glsn, res.Metadata[0].GLSN)
+	}
+
+	// Log entries appended after the rotation are encrypted by the new key.
+	require.NoError(t, kr.Rotate("k2", []byte("fedcba9876543210")))
+	batcher, err := client.NewBatcher(topicID)
+	require.NoError(t, err)
+	for i := numLogs; i < 2*numLogs; i++ {
+		glsn := types.GLSN(i + 1)
+		err := batcher.AppendAsync(data(glsn), headers, func(meta varlogpb.LogEntryMeta, err error) {
+			assert.NoError(t, err)
+			assert.Equal(t, glsn, meta.GLSN)
+		})
+		require.NoError(t, err)
+		require.NoError(t, batcher.Flush(context.Background()))
+	}
+	require.NoError(t, batcher.Close())
+
+	for glsn := types.MinGLSN; glsn <= 2*numLogs; glsn++ {
+		logEntry, err := client.Read(context.Background(), topicID, glsn)
+		require.NoError(t, err)
+		require.Equal(t, data(glsn), logEntry.Data)
+		require.Equal(t, headers, logEntry.Headers)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	expected := types.MinGLSN
+	closer, err := client.Subscribe(context.Background(), topicID, types.MinGLSN, 2*numLogs+1, func(logEntry varlogpb.LogEntry, err error) {
+		if err == io.EOF {
+			wg.Done()
+			return
+		}
+		if !assert.NoError(t, err) {
+			wg.Done()
+			return
+		}
+		assert.Equal(t, expected, logEntry.GLSN)
+		assert.Equal(t, data(logEntry.GLSN), logEntry.Data)
+		assert.Equal(t, headers, logEntry.Headers)
+		expected++
+	})
+	require.NoError(t, err)
+	wg.Wait()
+	closer()
+	require.EqualValues(t, 2*numLogs+1, expected)
+
+	subscriber := client.SubscribeTo(context.Background(), topicID, logStreamID, types.MinLLSN, 2*numLogs+1)
+	for i := 0; i < 2*numLogs; i++ {
+		logEntry, err := subscriber.Next()
+		require.NoError(t, err)
+		// The topic has only one log stream, thus, LLSN equals GLSN.
+		require.Equal(t, data(types.GLSN(logEntry.LLSN)), logEntry.Data)
+		require.Equal(t, headers, logEntry.Headers)
+	}
+	_, err = subscriber.Next()
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, subscriber.Close())
+
+	// A client without the keys cannot read the data.
+	_, err = clus.ClientAtIndex(t, 0).Read(context.Background(), topicID, types.MinGLSN)
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	// A client requiring the encryption rejects log entries not encrypted.
+	res := clus.ClientAtIndex(t, 0).Append(context.Background(), topicID, [][]byte{[]byte("plaintext")})
+	require.NoError(t, res.Err)
+	_, err = varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(), varlog.WithRequireEncryption())
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+	requiring, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(),
+		varlog.WithEncryption(kr),
+		varlog.WithRequireEncryption(),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, requiring.Close())
+	}()
+	logEntry, err := requiring.Read(context.Background(), topicID, types.MinGLSN)
+	require.NoError(t, err)
+	require.Equal(t, data(types.MinGLSN), logEntry.Data)
+	_, err = requiring.Read(context.Background(), topicID, res.Metadata[0].GLSN)
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+}
+
+func TestClientTypedAppendAndSubscribe(t *testing.T) {
+	type user struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name,omitempty"`
+	}
+	const (
+		v1 = `{"type":"object","properties":{"id":{"type":"integer"}},"required":["id"]}`
+		v2 = `{"type":"object","properties":{"id":{"type":"integer"},"name":{"type":"string"}},"required":["id"]}`
+	)
+
 	clus := it.NewVarlogCluster(t,
-		it.WithReplicationFactor(3),
-		it.WithNumberOfStorageNodes(3),
+		it.WithNumberOfStorageNodes(1),
 		it.WithNumberOfLogStreams(1),
 		it.WithNumberOfClients(1),
 		it.WithVMSOptions(it.NewTestVMSOptions()...),
-		it.WithNumberOfTopics(1),
+		it.WithNumberOfTopics(2),
 	)
 
 	defer func() {
@@ -126,32 +1515,72 @@ func TestClientAppendTo(t *testing.T) {
 		testutil.GC()
 	}()
 
-	// FIXME: remove this ugly code
-	topicID := clus.TopicIDs()[0]
-	lsID := clus.LogStreamID(t, topicID, 0)
+	registryTopicID := clus.TopicIDs()[0]
+	topicID := clus.TopicIDs()[1]
 	client := clus.ClientAtIndex(t, 0)
+	reg := schema.NewRegistry(client, registryTopicID)
+	codec := schema.JSONCodec[user]{}
 
-	res := client.AppendTo(context.TODO(), topicID, lsID+1, [][]byte{[]byte("foo")})
-	require.Error(t, res.Err)
+	s1, err := reg.Register(context.Background(), topicID, schema.TypeJSON, []byte(v1))
+	require.NoError(t, err)
+	appender1, err := schema.NewTypedAppender[user](context.Background(), client, reg, topicID, codec)
+	require.NoError(t, err)
+	res := appender1.Append(context.Background(), []user{{ID: 1}, {ID: 2}})
+	require.NoError(t, res.Err)
 
-	res = client.AppendTo(context.TODO(), topicID, lsID, [][]byte{[]byte("foo")})
+	s2, err := reg.Register(context.Background(), topicID, schema.TypeJSON, []byte(v2))
+	require.NoError(t, err)
+	require.Equal(t, 2, s2.Version)
+	appender2, err := schema.NewTypedAppender[user](context.Background(), client, reg, topicID, codec)
+	require.NoError(t, err)
+	res = appender2.Append(context.Background(), []user{{ID: 3, Name: "foo"}})
+	require.NoError(t, res.Err)
+
+	// A log entry without a schema ID.
+	res = client.Append(context.Background(), topicID, [][]byte{[]byte(`{"id":4}`)})
+	require.NoError(t, res.Err)
+
+	// A log entry whose schema is not a version of the subject of the
+	// topic.
+	res = client.Append(context.Background(), topicID, [][]byte{[]byte(`{"id":5}`)}, varlog.WithHeaders(map[string][]byte{
+		schema.SchemaIDHeader: []byte(strconv.FormatUint(uint64(s1.ID)+100, 10)),
+	}))
 	require.NoError(t, res.Err)
 
-	// NOTE: Read API is deprecated.
-	// data, err := client.Read(context.Background(), topicID, lsID, res.Metadata[0].GLSN)
-	// require.NoError(t, err)
-	// require.EqualValues(t, []byte("foo"), data)
+	// Another client reads the registry from the registry topic.
+	sub := schema.NewTypedSubscriber[user](context.Background(), client, schema.NewRegistry(client, registryTopicID), topicID, types.MinGLSN, 6, codec)
+	expected := []struct {
+		schema schema.Schema
+		value  user
+	}{
+		{schema: s1, value: user{ID: 1}},
+		{schema: s1, value: user{ID: 2}},
+		{schema: s2, value: user{ID: 3, Name: "foo"}},
+	}
+	for i, exp := range expected {
+		typed, err := sub.Next()
+		require.NoError(t, err)
+		require.Equal(t, types.GLSN(i+1), typed.GLSN)
+		require.Equal(t, exp.schema, typed.Schema)
+		require.Equal(t, exp.value, typed.Value)
+	}
+	for glsn := types.GLSN(4); glsn <= 5; glsn++ {
+		typed, err := sub.Next()
+		require.ErrorIs(t, err, verrors.ErrInvalid)
+		require.Equal(t, glsn, typed.GLSN)
+	}
+	_, err = sub.Next()
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, sub.Close())
 }
 
-func TestClientAppend(t *testing.T) {
-	// defer goleak.VerifyNone(t)
+func TestClientAppendTxn(t *testing.T) {
 	clus := it.NewVarlogCluster(t,
-		it.WithReplicationFactor(1),
-		it.WithNumberOfStorageNodes(3),
-		it.WithNumberOfLogStreams(3),
-		it.WithNumberOfClients(1),
+		it.WithReplicationFactor(2),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(1),
 		it.WithVMSOptions(it.NewTestVMSOptions()...),
-		it.WithNumberOfTopics(1),
+		it.WithNumberOfTopics(2),
 	)
 
 	defer func() {
@@ -159,170 +1588,226 @@ func TestClientAppend(t *testing.T) {
 		testutil.GC()
 	}()
 
-	topicID := clus.TopicIDs()[0]
-	client := clus.ClientAtIndex(t, 0)
+	tpids := clus.TopicIDs()
+	eventTopicID, auditTopicID := tpids[0], tpids[1]
+	eventLogStreamID := clus.LogStreamIDs(eventTopicID)[0]
 
-	expectedLLSNs := make(map[types.LogStreamID]types.LLSN, clus.NumberOfLogStreams(topicID))
-	for _, lsID := range clus.LogStreamIDs(topicID) {
-		expectedLLSNs[lsID] = types.MinLLSN
-	}
-	expectedGLSN := types.MinGLSN
+	client, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(),
+		varlog.WithTxnTimeout(time.Second),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
 
-	for _, logStreamID := range clus.LogStreamIDs(topicID) {
-		res := client.AppendTo(context.Background(), topicID, logStreamID, [][]byte{nil})
-		require.NoError(t, res.Err)
-		lem := res.Metadata[0]
-		require.Equal(t, expectedGLSN, lem.GLSN)
-		expectedGLSN++
-		require.Equal(t, expectedLLSNs[lem.LogStreamID], lem.LLSN)
-		expectedLLSNs[lem.LogStreamID]++
-		require.Equal(t, topicID, lem.TopicID)
-		require.Equal(t, logStreamID, lem.LogStreamID)
+	subscribeData := func(tpid types.TopicID, end types.GLSN) []string {
+		var data []string
+		sub := client.SubscribeIter(context.Background(), tpid, types.MinGLSN, end)
+		for {
+			le, err := sub.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			data = append(data, string(le.Data))
+		}
+		require.NoError(t, sub.Close())
+		return data
 	}
 
-	for i := 0; i < 10; i++ {
-		res := client.Append(context.TODO(), topicID, [][]byte{[]byte("foo")})
-		require.NoError(t, res.Err)
-		lem := res.Metadata[0]
-		require.Equal(t, expectedGLSN, lem.GLSN)
-		expectedGLSN++
-		require.Equal(t, expectedLLSNs[lem.LogStreamID], lem.LLSN)
-		expectedLLSNs[lem.LogStreamID]++
-		require.Equal(t, topicID, lem.TopicID)
-	}
+	// committed
+	res := client.AppendTxn(context.Background(), []varlog.TxnBatch{
+		{TopicID: eventTopicID, Data: [][]byte{[]byte("event1")}},
+		{TopicID: auditTopicID, Data: [][]byte{[]byte("audit1")}, Headers: []map[string][]byte{{"key": []byte("value")}}},
+	})
+	require.NoError(t, res.Err)
+	require.NotEmpty(t, res.TxnID)
+	require.Len(t, res.Results, 2)
+	require.Equal(t, []string{"event1"}, subscribeData(eventTopicID, 2))
+	require.Equal(t, []string{"audit1"}, subscribeData(auditTopicID, 2))
+	le, err := client.Read(context.Background(), auditTopicID, types.MinGLSN)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), le.Headers["key"])
+	require.Contains(t, le.Headers, varlog.TxnHeader)
+
+	// The batch to the audit topic fails since the log stream does not
+	// exist, thus, the log stream of the event topic aborts the transaction
+	// after its timeout.
+	res = client.AppendTxn(context.Background(), []varlog.TxnBatch{
+		{TopicID: eventTopicID, Data: [][]byte{[]byte("event2")}},
+		{TopicID: auditTopicID, LogStreamID: eventLogStreamID + 100, Data: [][]byte{[]byte("audit2")}},
+	})
+	require.ErrorIs(t, res.Err, varlog.ErrTxnAborted)
+	require.ErrorIs(t, res.Results[0].Err, varlog.ErrTxnAborted)
+	require.Error(t, res.Results[1].Err)
 
-	// NOTE: Read API is deprecated.
-	// require.Condition(t, func() bool {
-	// 	for _, lsid := range clus.LogStreamIDs(topicID) {
-	// 		if _, errRead := client.Read(context.TODO(), topicID, lsid, types.MinGLSN); errRead == nil {
-	//			return true
-	//		}
-	//	}
-	//	return false
-	// })
+	// The aborted log entry has a GLSN, but readers never see it.
+	_, err = client.Read(context.Background(), eventTopicID, types.GLSN(2))
+	require.Error(t, err)
+	require.Equal(t, []string{"event1"}, subscribeData(eventTopicID, 3))
 
-	for _, logStreamID := range clus.LogStreamIDs(topicID) {
-		first, last, err := client.PeekLogStream(context.Background(), topicID, logStreamID)
+	// A malformed transaction header is rejected.
+	ares := client.Append(context.Background(), eventTopicID, [][]byte{[]byte("event3")},
+		varlog.WithHeaders(map[string][]byte{varlog.TxnHeader: []byte("malformed")}),
+	)
+	require.ErrorContains(t, ares.Err, verrors.ErrInvalid.Error())
+
+	// The failed append above denies the log stream for a while, thus, the
+	// log stream is given explicitly.
+	res = client.AppendTxn(context.Background(), []varlog.TxnBatch{
+		{TopicID: eventTopicID, LogStreamID: eventLogStreamID, Data: [][]byte{[]byte("event4")}},
+		{TopicID: auditTopicID, Data: [][]byte{[]byte("audit4")}},
+	})
+	require.NoError(t, res.Err)
+	require.Equal(t, types.GLSN(3), res.Results[0].Metadata[0].GLSN)
+
+	dataC := make(chan string, 4)
+	closer, err := client.Subscribe(context.Background(), eventTopicID, types.MinGLSN, 4, func(le varlogpb.LogEntry, err error) {
+		if err != nil {
+			assert.ErrorIs(t, err, io.EOF)
+			close(dataC)
+			return
+		}
+		dataC <- string(le.Data)
+	})
+	require.NoError(t, err)
+	var data []string
+	for d := range dataC {
+		data = append(data, d)
+	}
+	closer()
+	require.Equal(t, []string{"event1", "event4"}, data)
+	require.Equal(t, []string{"audit1", "audit4"}, subscribeData(auditTopicID, 3))
+
+	sub := client.SubscribeTo(context.Background(), eventTopicID, eventLogStreamID, types.MinLLSN, 4)
+	for _, expected := range []string{"event1", "event4"} {
+		le, err := sub.Next()
 		require.NoError(t, err)
-		require.Equal(t, types.MinLLSN, first.LLSN)
-		require.GreaterOrEqual(t, first.GLSN, types.MinGLSN)
-		require.GreaterOrEqual(t, last.LLSN, types.MinLLSN)
-		require.GreaterOrEqual(t, last.GLSN, types.MinGLSN)
+		require.Equal(t, expected, string(le.Data))
 	}
+	require.NoError(t, sub.Close())
 }
 
-func TestClientAppendCancel(t *testing.T) {
-	// defer goleak.VerifyNone(t)
+func TestClientRetentionPolicy(t *testing.T) {
+	const (
+		numLogs    = 10
+		maxEntries = 3
+	)
+
 	clus := it.NewVarlogCluster(t,
-		it.WithReplicationFactor(1),
 		it.WithNumberOfStorageNodes(1),
 		it.WithNumberOfLogStreams(1),
 		it.WithNumberOfClients(1),
-		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithVMSOptions(it.NewTestVMSOptions(
+			admin.WithRetentionOptions(retention.WithCheckInterval(100*time.Millisecond)),
+		)...),
 		it.WithNumberOfTopics(1),
 	)
-
 	defer func() {
 		clus.Close(t)
 		testutil.GC()
 	}()
 
-	topicID := clus.TopicIDs()[0]
+	tpid := clus.TopicIDs()[0]
+	lsid := clus.LogStreamIDs(tpid)[0]
 	client := clus.ClientAtIndex(t, 0)
+	for i := 0; i < numLogs; i++ {
+		res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo")})
+		require.NoError(t, res.Err)
+	}
 
-	var (
-		atomicGLSN types.AtomicGLSN
-		wg         sync.WaitGroup
-	)
-	wg.Add(1)
-	ctx, cancel := context.WithCancel(context.Background())
-	go func() {
-		defer wg.Done()
-		expectedGLSN := types.MinGLSN
-		for {
-			res := client.Append(ctx, topicID, [][]byte{[]byte("foo")})
-			if res.Err == nil {
-				require.Equal(t, expectedGLSN, res.Metadata[0].GLSN)
-				expectedGLSN++
-				atomicGLSN.Store(res.Metadata[0].GLSN)
-			} else {
-				t.Logf("canceled")
-				return
-			}
-		}
-	}()
+	adm := clus.GetVMSClient(t)
+	td, err := adm.SetRetentionPolicy(context.Background(), tpid, &varlogpb.RetentionPolicy{MaxEntries: maxEntries})
+	require.NoError(t, err)
+	require.EqualValues(t, maxEntries, td.RetentionPolicy.MaxEntries)
 
-	for atomicGLSN.Load() < 10 {
-		time.Sleep(time.Millisecond)
+	_, err = adm.SetRetentionPolicy(context.Background(), tpid+1, &varlogpb.RetentionPolicy{MaxEntries: maxEntries})
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+
+	require.Eventually(t, func() bool {
+		first, last, err := client.PeekLogStream(context.Background(), tpid, lsid)
+		require.NoError(t, err)
+		return first.GLSN == types.GLSN(numLogs-maxEntries+1) && last.GLSN == types.GLSN(numLogs)
+	}, 10*time.Second, 100*time.Millisecond)
+
+	_, err = client.Read(context.Background(), tpid, types.GLSN(numLogs-maxEntries))
+	require.ErrorIs(t, err, verrors.ErrTrimmed)
+
+	// Removing the retention policy stops trimming.
+	td, err = adm.SetRetentionPolicy(context.Background(), tpid, nil)
+	require.NoError(t, err)
+	require.Nil(t, td.RetentionPolicy)
+	for i := 0; i < numLogs; i++ {
+		res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo")})
+		require.NoError(t, res.Err)
 	}
-	cancel()
-	wg.Wait()
+	time.Sleep(500 * time.Millisecond)
+	first, _, err := client.PeekLogStream(context.Background(), tpid, lsid)
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(numLogs-maxEntries+1), first.GLSN)
 }
 
-func TestClientSubscribe(t *testing.T) {
-	//defer goleak.VerifyNone(t)
+func TestClientRetentionPolicyMultipleLogStreams(t *testing.T) {
 	const (
-		batchSize = 10
-		appendCnt = 10
-		nrLogs    = batchSize * appendCnt
+		numLogs    = 6
+		maxEntries = 3
 	)
 
 	clus := it.NewVarlogCluster(t,
-		it.WithNumberOfStorageNodes(3),
-		it.WithNumberOfLogStreams(3),
+		it.WithNumberOfStorageNodes(1),
+		it.WithNumberOfLogStreams(2),
 		it.WithNumberOfClients(1),
-		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithVMSOptions(it.NewTestVMSOptions(
+			admin.WithRetentionOptions(retention.WithCheckInterval(100*time.Millisecond)),
+		)...),
 		it.WithNumberOfTopics(1),
 	)
-
 	defer func() {
 		clus.Close(t)
 		testutil.GC()
 	}()
 
-	newMsg := func(glsn types.GLSN) string {
-		return fmt.Sprintf("msg-%03d", glsn)
-	}
-
-	issuedGLSN := types.InvalidGLSN
-	topicID := clus.TopicIDs()[0]
+	tpid := clus.TopicIDs()[0]
+	lsids := clus.LogStreamIDs(tpid)
 	client := clus.ClientAtIndex(t, 0)
-	for i := 0; i < appendCnt; i++ {
-		batch := make([][]byte, batchSize)
-		for j := 0; j < batchSize; j++ {
-			issuedGLSN++
-			msg := newMsg(issuedGLSN)
-			batch[j] = []byte(msg)
+
+	// GLSNs from 1 to 6 are in the first log stream, and GLSNs from 7 to 12
+	// are in the second one.
+	for _, lsid := range lsids {
+		for i := 0; i < numLogs; i++ {
+			res := client.AppendTo(context.Background(), tpid, lsid, [][]byte{[]byte("foo")})
+			require.NoError(t, res.Err)
 		}
-		res := client.Append(context.TODO(), topicID, batch)
-		require.NoError(t, res.Err)
-		require.Len(t, res.Metadata, batchSize)
-		require.Equal(t, issuedGLSN, res.Metadata[len(res.Metadata)-1].GLSN)
-		require.Equal(t, issuedGLSN-types.GLSN(batchSize)+1, res.Metadata[0].GLSN)
 	}
 
-	errc := make(chan error, nrLogs)
-	expectedGLSN := types.MinGLSN
-	subscribeCloser, err := client.Subscribe(context.TODO(), topicID, types.GLSN(1), types.GLSN(nrLogs+1), func(le varlogpb.LogEntry, err error) {
+	adm := clus.GetVMSClient(t)
+	_, err := adm.SetRetentionPolicy(context.Background(), tpid, &varlogpb.RetentionPolicy{MaxEntries: maxEntries})
+	require.NoError(t, err)
+
+	// The topic is trimmed at the smallest trim point of the log streams
+	// violating the policy until the second log stream keeps maxEntries log
+	// entries. The first log stream, which stops violating the policy
+	// earlier, loses log entries preceding them.
+	require.Eventually(t, func() bool {
+		first, _, err := client.PeekLogStream(context.Background(), tpid, lsids[1])
+		require.NoError(t, err)
+		return first.GLSN == types.GLSN(2*numLogs-maxEntries+1)
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// The topic can be subscribed from the first GLSN not trimmed.
+	var glsns []types.GLSN
+	errC := make(chan error, 1)
+	closer, err := client.Subscribe(context.Background(), tpid, types.GLSN(2*numLogs-maxEntries+1), types.GLSN(2*numLogs+1), func(le varlogpb.LogEntry, err error) {
 		if err != nil {
-			require.ErrorIs(t, io.EOF, err)
-			close(errc)
+			errC <- err
 			return
 		}
-		assert.Equal(t, expectedGLSN, le.GLSN)
-		expectedMsg := newMsg(expectedGLSN)
-		require.Equal(t, expectedMsg, string(le.Data))
-		expectedGLSN++
-		errc <- err
+		glsns = append(glsns, le.GLSN)
 	})
 	require.NoError(t, err)
-	defer subscribeCloser()
-
-	for e := range errc {
-		if e != nil {
-			require.ErrorIs(t, io.EOF, e)
-		}
-	}
+	defer closer()
+	require.ErrorIs(t, <-errC, io.EOF)
+	require.Len(t, glsns, maxEntries)
 }
 
 func TestClientTrim(t *testing.T) {
@@ -380,6 +1865,19 @@ func TestClientTrim(t *testing.T) {
 		return isErr
 	}, time.Second, 10*time.Millisecond)
 
+	_, err = client.Read(context.Background(), topicID, trimPos)
+	require.ErrorIs(t, err, verrors.ErrTrimmed)
+
+	le, err := client.Read(context.Background(), topicID, trimPos+1)
+	require.NoError(t, err)
+	require.Equal(t, trimPos+1, le.GLSN)
+
+	results, err := client.ReadBatch(context.Background(), topicID, []types.GLSN{trimPos, trimPos + 1})
+	require.NoError(t, err)
+	require.ErrorIs(t, results[0].Err, verrors.ErrTrimmed)
+	require.NoError(t, results[1].Err)
+	require.Equal(t, trimPos+1, results[1].GLSN)
+
 	// subscribe remains
 	ch := make(chan varlogpb.LogEntry)
 	onNext := func(logEntry varlogpb.LogEntry, err error) {
@@ -706,3 +2204,140 @@ func TestClientAppendWithAllowedLogStream(t *testing.T) {
 	require.ErrorIs(t, err, io.EOF)
 	require.NoError(t, subscriber.Close())
 }
+
+func TestClientAppendTracing(t *testing.T) {
+	tp := &recordingTracerProvider{}
+	// Storage nodes of the cluster use the global tracer provider.
+	otel.SetTracerProvider(tp)
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(2),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	client, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(),
+		varlog.WithTracerProvider(tp),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+
+	res := client.Append(context.Background(), topicID, [][]byte{[]byte("foo")})
+	require.NoError(t, res.Err)
+
+	// All stages of the append belong to the trace of the append.
+	expected := map[string]int{
+		"varlog.Append":             1,
+		"snpb.LogIO/Append":         2, // client and server
+		"logstream.sequence":        1,
+		"logstream.write":           1,
+		"logstream.replicate":       1,
+		"snpb.Replicator/Replicate": 1,
+		"logstream.commit":          1,
+	}
+	traced := func() map[string]int {
+		spans := tp.endedSpans()
+		names := make(map[string]int)
+		for _, root := range spans {
+			if root.name != "varlog.Append" {
+				continue
+			}
+			for _, span := range spans {
+				if span.sc.TraceID() == root.sc.TraceID() {
+					names[span.name]++
+				}
+			}
+		}
+		return names
+	}
+	require.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual(expected, traced())
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+// recordingTracerProvider is a trace.TracerProvider that records spans in
+// memory.
+type recordingTracerProvider struct {
+	mu     sync.Mutex
+	spans  []*recordingSpan
+	nextID uint64
+}
+
+func (tp *recordingTracerProvider) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return recordingTracer{tp: tp}
+}
+
+func (tp *recordingTracerProvider) endedSpans() []*recordingSpan {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	var spans []*recordingSpan
+	for _, span := range tp.spans {
+		if span.ended {
+			spans = append(spans, span)
+		}
+	}
+	return spans
+}
+
+type recordingTracer struct {
+	tp *recordingTracerProvider
+}
+
+func (tr recordingTracer) Start(ctx context.Context, name string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+	tr.tp.mu.Lock()
+	defer tr.tp.mu.Unlock()
+	tr.tp.nextID++
+
+	var spanID trace.SpanID
+	binary.BigEndian.PutUint64(spanID[:], tr.tp.nextID)
+	traceID := trace.SpanContextFromContext(ctx).TraceID()
+	if !traceID.IsValid() {
+		binary.BigEndian.PutUint64(traceID[:], tr.tp.nextID)
+	}
+	span := &recordingSpan{
+		tp:   tr.tp,
+		name: name,
+		sc: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}),
+	}
+	tr.tp.spans = append(tr.tp.spans, span)
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+type recordingSpan struct {
+	tp    *recordingTracerProvider
+	name  string
+	sc    trace.SpanContext
+	ended bool
+}
+
+var _ trace.Span = (*recordingSpan)(nil)
+
+func (s *recordingSpan) End(...trace.SpanEndOption) {
+	s.tp.mu.Lock()
+	defer s.tp.mu.Unlock()
+	s.ended = true
+}
+
+func (s *recordingSpan) AddEvent(string, ...trace.EventOption)   {}
+func (s *recordingSpan) IsRecording() bool                       { return true }
+func (s *recordingSpan) RecordError(error, ...trace.EventOption) {}
+func (s *recordingSpan) SpanContext() trace.SpanContext          { return s.sc }
+func (s *recordingSpan) SetStatus(codes.Code, string)            {}
+func (s *recordingSpan) SetName(string)                          {}
+func (s *recordingSpan) SetAttributes(...attribute.KeyValue)     {}
+func (s *recordingSpan) TracerProvider() trace.TracerProvider    { return s.tp }
@@ -3,6 +3,7 @@ package cluster
 import (
 	"context"
 	"math/rand"
+	"path/filepath"
 	"sync"
 	"testing"
 
@@ -12,7 +13,9 @@ import (
 	"go.uber.org/goleak"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/kakao/varlog/internal/backup"
 	"github.com/kakao/varlog/internal/metarepos"
+	"github.com/kakao/varlog/internal/storage"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/testutil"
 	"github.com/kakao/varlog/pkg/verrors"
@@ -299,3 +302,82 @@ func TestNewbieLogStream(t *testing.T) {
 		})
 	}))
 }
+
+func TestBackup(t *testing.T) {
+	const numLogs = 10
+
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(2),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(2),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+	defer clus.Close(t)
+
+	tpid := clus.TopicIDs()[0]
+	client := clus.ClientAtIndex(t, 0)
+	for i := 0; i < numLogs; i++ {
+		res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo")})
+		require.NoError(t, res.Err)
+	}
+
+	dir := filepath.Join(t.TempDir(), "backup")
+	adm := clus.GetVMSClient(t)
+	manifest, err := adm.Backup(context.Background(), dir)
+	require.NoError(t, err)
+	require.Len(t, manifest.Replicas, 4)
+
+	_, err = adm.Backup(context.Background(), dir)
+	require.Error(t, err)
+
+	// Log entries appended after the backup are not in it.
+	for i := 0; i < numLogs; i++ {
+		res := client.Append(context.Background(), tpid, [][]byte{[]byte("bar")})
+		require.NoError(t, res.Err)
+	}
+
+	got, err := backup.ReadManifest(dir)
+	require.NoError(t, err)
+	require.Equal(t, manifest, got)
+	require.NoFileExists(t, filepath.Join(dir, backup.ProbeFile))
+	sm, err := backup.ReadStateMachine(dir, got)
+	require.NoError(t, err)
+	require.Len(t, sm.GetMetadata().GetLogStreams(), 2)
+
+	// Every replica of a log stream has the same log entries, and all log
+	// streams have all log entries appended before the backup.
+	numCopied := make(map[types.LogStreamID]int)
+	for _, replica := range manifest.Replicas {
+		cr := replica.CommitResult
+		require.Equal(t, manifest.Version, cr.Version)
+
+		stg, err := storage.New(storage.WithPath(filepath.Join(dir, replica.Path)))
+		require.NoError(t, err)
+		cc, err := stg.ReadCommitContext()
+		require.NoError(t, err)
+		require.Equal(t, cr.CommittedGLSNOffset+types.GLSN(cr.CommittedGLSNLength), cc.CommittedGLSNEnd)
+
+		n := 0
+		scanner := stg.NewScanner(storage.WithLLSN(types.MinLLSN, types.MaxLLSN))
+		for ; scanner.Valid(); scanner.Next() {
+			le, err := scanner.Value()
+			require.NoError(t, err)
+			require.Equal(t, []byte("foo"), le.Data)
+			n++
+		}
+		require.NoError(t, scanner.Close())
+		require.NoError(t, stg.Close())
+
+		if prev, ok := numCopied[replica.LogStreamID]; ok {
+			require.Equal(t, prev, n)
+		}
+		numCopied[replica.LogStreamID] = n
+	}
+	total := 0
+	for _, n := range numCopied {
+		total += n
+	}
+	require.Equal(t, numLogs, total)
+}
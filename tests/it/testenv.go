@@ -1540,7 +1540,7 @@ func (clus *VarlogCluster) AppendUncommittedLog(t *testing.T, topicID types.Topi
 					}()
 				*/
 
-				_, err = cli.Append(ctx, topicID, lsID, [][]byte{data})
+				_, err = cli.Append(ctx, topicID, lsID, [][]byte{data}, nil)
 				assert.Error(t, err)
 			}()
 